@@ -50,3 +50,20 @@ func InvalidFieldValueError(field, fieldDescription string) *status.Status {
 
 	return statWithDetails
 }
+
+// InvalidFieldValuesError - like InvalidFieldValueError, for requests with several invalid fields.
+func InvalidFieldValuesError(message string, fieldViolations []*errdetails.BadRequest_FieldViolation) *status.Status {
+	stat := status.New(codes.InvalidArgument, message)
+
+	badrequest := &errdetails.BadRequest{
+		FieldViolations: fieldViolations,
+	}
+
+	statWithDetails, err := stat.WithDetails(badrequest)
+	if err != nil {
+		log.Error("unexpected error, unable to build status object: ", message)
+		return stat
+	}
+
+	return statWithDetails
+}
@@ -138,9 +138,12 @@ type ListTasksRequest struct {
 	CheckpointId      string `protobuf:"bytes,3,opt,name=checkpointId,proto3" json:"checkpointId,omitempty"`
 	// Tasks are returned lexicographically sorted by taskId
 	// If specified only taskIds >= startTaskId will be returned.
-	StartTaskId          string   `protobuf:"bytes,4,opt,name=startTaskId,proto3" json:"startTaskId,omitempty"`
-	MaxItems             int32    `protobuf:"varint,5,opt,name=maxItems,proto3" json:"maxItems,omitempty"`
-	IncludeInactive      bool     `protobuf:"varint,6,opt,name=includeInactive,proto3" json:"includeInactive,omitempty"`
+	StartTaskId     string `protobuf:"bytes,4,opt,name=startTaskId,proto3" json:"startTaskId,omitempty"`
+	MaxItems        int32  `protobuf:"varint,5,opt,name=maxItems,proto3" json:"maxItems,omitempty"`
+	IncludeInactive bool   `protobuf:"varint,6,opt,name=includeInactive,proto3" json:"includeInactive,omitempty"`
+	// nextPageToken from a previous response. Takes precedence over startTaskId.
+	PageToken            string   `protobuf:"bytes,7,opt,name=pageToken,proto3" json:"pageToken,omitempty"`
+	View                 ListView `protobuf:"varint,8,opt,name=view,proto3,enum=api.ListView" json:"view,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -213,13 +216,29 @@ func (m *ListTasksRequest) GetIncludeInactive() bool {
 	return false
 }
 
+func (m *ListTasksRequest) GetPageToken() string {
+	if m != nil {
+		return m.PageToken
+	}
+	return ""
+}
+
+func (m *ListTasksRequest) GetView() ListView {
+	if m != nil {
+		return m.View
+	}
+	return ListView_IDS
+}
+
 type ListTasksResponse struct {
-	StartTaskId          string   `protobuf:"bytes,1,opt,name=startTaskId,proto3" json:"startTaskId,omitempty"`
-	MaxItems             int32    `protobuf:"varint,2,opt,name=maxItems,proto3" json:"maxItems,omitempty"`
-	TaskIds              []string `protobuf:"bytes,3,rep,name=taskIds,proto3" json:"taskIds,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	StartTaskId          string         `protobuf:"bytes,1,opt,name=startTaskId,proto3" json:"startTaskId,omitempty"`
+	MaxItems             int32          `protobuf:"varint,2,opt,name=maxItems,proto3" json:"maxItems,omitempty"`
+	TaskIds              []string       `protobuf:"bytes,3,rep,name=taskIds,proto3" json:"taskIds,omitempty"`
+	NextPageToken        string         `protobuf:"bytes,4,opt,name=nextPageToken,proto3" json:"nextPageToken,omitempty"`
+	Tasks                []*TaskDetails `protobuf:"bytes,5,rep,name=tasks,proto3" json:"tasks,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
 }
 
 func (m *ListTasksResponse) Reset()         { *m = ListTasksResponse{} }
@@ -268,8 +287,25 @@ func (m *ListTasksResponse) GetTaskIds() []string {
 	return nil
 }
 
+func (m *ListTasksResponse) GetNextPageToken() string {
+	if m != nil {
+		return m.NextPageToken
+	}
+	return ""
+}
+
+func (m *ListTasksResponse) GetTasks() []*TaskDetails {
+	if m != nil {
+		return m.Tasks
+	}
+	return nil
+}
+
 type GetTaskRequest struct {
-	TaskId               string   `protobuf:"bytes,1,opt,name=taskId,proto3" json:"taskId,omitempty"`
+	TaskId string `protobuf:"bytes,1,opt,name=taskId,proto3" json:"taskId,omitempty"`
+	// Return the repository URL of the checkpoint as checkpointLink even if FLEA is configured to
+	// hand out download URLs for checkpoint bundles
+	RawLink              bool     `protobuf:"varint,2,opt,name=rawLink,proto3" json:"rawLink,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -307,6 +343,13 @@ func (m *GetTaskRequest) GetTaskId() string {
 	return ""
 }
 
+func (m *GetTaskRequest) GetRawLink() bool {
+	if m != nil {
+		return m.RawLink
+	}
+	return false
+}
+
 // This is used by both /create_task, /task/<taskId> and /modify_task/<taskId>
 type TaskDetails struct {
 	ModelId              string               `protobuf:"bytes,1,opt,name=modelId,proto3" json:"modelId,omitempty"`
@@ -697,68 +740,82 @@ func init() {
 func init() { proto.RegisterFile("flea.proto", fileDescriptor_c48a4bf4882f2158) }
 
 var fileDescriptor_c48a4bf4882f2158 = []byte{
-	// 961 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x95, 0xdd, 0x72, 0xdb, 0x44,
-	0x14, 0xc7, 0x91, 0xe3, 0xcf, 0x93, 0x34, 0x96, 0xb7, 0x21, 0x51, 0x3d, 0x81, 0x7a, 0x96, 0x0e,
-	0xe3, 0x09, 0x8c, 0x3d, 0x84, 0x99, 0x0e, 0xd3, 0xe1, 0x26, 0x24, 0xa6, 0x38, 0x71, 0x93, 0x54,
-	0x31, 0xe5, 0x32, 0x5d, 0x5b, 0x6b, 0x47, 0xb5, 0xac, 0x15, 0xda, 0x75, 0x07, 0x37, 0xc3, 0x0d,
-	0xaf, 0xc0, 0x35, 0x8f, 0xc2, 0x53, 0xf0, 0x0a, 0x0c, 0xb7, 0xbc, 0x02, 0xa3, 0xa3, 0x95, 0x6c,
-	0xd9, 0x0d, 0x85, 0x9b, 0xde, 0xe9, 0x1c, 0x9d, 0xfd, 0x9d, 0xb3, 0xff, 0x3d, 0x7b, 0x16, 0x60,
-	0xe4, 0x71, 0xd6, 0x0a, 0x42, 0xa1, 0x04, 0xd9, 0x60, 0x81, 0x5b, 0x7f, 0x38, 0x16, 0x62, 0xec,
-	0xf1, 0x36, 0xba, 0x06, 0xb3, 0x51, 0x5b, 0xb9, 0x53, 0x2e, 0x15, 0x9b, 0x06, 0x71, 0x54, 0x7d,
-	0x5f, 0x07, 0xb0, 0xc0, 0x6d, 0x33, 0xdf, 0x17, 0x8a, 0x29, 0x57, 0xf8, 0x52, 0xff, 0x35, 0x43,
-	0x1e, 0x08, 0xe9, 0x2a, 0x11, 0xce, 0x63, 0x0f, 0xbd, 0x85, 0xda, 0x33, 0xe1, 0xb8, 0xa3, 0x79,
-	0x9f, 0xc9, 0x89, 0xcd, 0x7f, 0x9c, 0x71, 0xa9, 0xc8, 0x2e, 0x14, 0x15, 0x93, 0x93, 0xae, 0x63,
-	0x19, 0x0d, 0xa3, 0x59, 0xb1, 0xb5, 0x45, 0x1e, 0x43, 0xd9, 0xe1, 0xcc, 0xf1, 0x5c, 0x9f, 0x5b,
-	0xb9, 0x86, 0xd1, 0xdc, 0x3c, 0xac, 0xb7, 0xe2, 0x7c, 0xad, 0xa4, 0xa0, 0x56, 0x3f, 0x29, 0xc8,
-	0x4e, 0x63, 0x23, 0x1e, 0x1b, 0x2a, 0xf7, 0x35, 0xb7, 0x36, 0x1a, 0x46, 0xb3, 0x6c, 0x6b, 0x8b,
-	0xfe, 0x65, 0x80, 0xd9, 0x73, 0xa5, 0x8a, 0x72, 0xcb, 0x24, 0xb9, 0x05, 0xa5, 0xa9, 0x70, 0xb8,
-	0x97, 0x66, 0x4f, 0x4c, 0xf2, 0x39, 0xd4, 0x6e, 0xe6, 0x01, 0x0f, 0x03, 0x16, 0xb2, 0x29, 0x57,
-	0x3c, 0x94, 0x5d, 0x07, 0xeb, 0xa8, 0xd8, 0xeb, 0x3f, 0x08, 0x85, 0xad, 0xe1, 0x0d, 0x1f, 0x4e,
-	0x02, 0xe1, 0xfa, 0xaa, 0xeb, 0x60, 0xea, 0x8a, 0x9d, 0xf1, 0x91, 0x06, 0x6c, 0x4a, 0xc5, 0x42,
-	0x2c, 0xa0, 0xeb, 0x58, 0x79, 0x0c, 0x59, 0x76, 0x91, 0x3a, 0x94, 0xa7, 0xec, 0xa7, 0xae, 0xe2,
-	0x53, 0x69, 0x15, 0x1a, 0x46, 0xb3, 0x60, 0xa7, 0x36, 0x69, 0x42, 0xd5, 0xf5, 0x87, 0xde, 0xcc,
-	0xe1, 0x5d, 0x5f, 0xef, 0xaf, 0x88, 0xfb, 0x5b, 0x75, 0xd3, 0x09, 0xd4, 0x96, 0xf6, 0x29, 0x03,
-	0xe1, 0x4b, 0xbe, 0x9a, 0xdc, 0xf8, 0xf7, 0xe4, 0xb9, 0x95, 0xe4, 0x16, 0x94, 0xe2, 0x53, 0x91,
-	0xd6, 0x46, 0x63, 0x23, 0x92, 0x49, 0x9b, 0xb4, 0x09, 0xdb, 0x4f, 0xb9, 0xfa, 0x0f, 0xe7, 0x49,
-	0x7f, 0xcb, 0xc1, 0x66, 0x14, 0x77, 0xc2, 0x15, 0x73, 0x3d, 0xf9, 0x5e, 0xa5, 0x5f, 0xee, 0xa5,
-	0xfc, 0xff, 0xeb, 0x25, 0xbd, 0x97, 0x42, 0xa6, 0x37, 0x17, 0x3d, 0x56, 0x5c, 0xee, 0x31, 0x42,
-	0x20, 0xef, 0xb9, 0xfe, 0xc4, 0x2a, 0x61, 0x34, 0x7e, 0x93, 0x4f, 0x61, 0x7b, 0x51, 0x4b, 0x2f,
-	0xfa, 0x5b, 0xc6, 0xbf, 0x2b, 0x5e, 0x7a, 0x00, 0xe6, 0x55, 0x72, 0x1c, 0xef, 0xd2, 0xf2, 0x77,
-	0x03, 0x6a, 0x4b, 0xc1, 0xfa, 0x8c, 0xbf, 0x86, 0xa2, 0x54, 0x4c, 0xcd, 0x24, 0x46, 0x6f, 0x1f,
-	0x3e, 0x6a, 0xb1, 0xc0, 0x6d, 0xad, 0xc5, 0xb5, 0x34, 0xfd, 0x0a, 0x63, 0x6d, 0xbd, 0x86, 0xec,
-	0x40, 0xe1, 0x95, 0x18, 0xa4, 0x4a, 0xc7, 0x46, 0xd4, 0x15, 0xb3, 0xc0, 0x13, 0xcc, 0xe9, 0x0b,
-	0xad, 0x6c, 0x6a, 0xd3, 0xaf, 0xe0, 0x5e, 0x06, 0x45, 0x36, 0xa1, 0xf4, 0xfd, 0xf9, 0xd9, 0xf9,
-	0xc5, 0x0f, 0xe7, 0xe6, 0x07, 0x64, 0x0b, 0xca, 0x76, 0xe7, 0xb4, 0x73, 0xdc, 0xef, 0x9c, 0x98,
-	0x46, 0x64, 0x1d, 0x5d, 0x5e, 0xda, 0x17, 0x2f, 0x3a, 0x27, 0x66, 0x8e, 0xbe, 0x81, 0xad, 0x23,
-	0x67, 0xea, 0xfa, 0xc9, 0x3e, 0x1f, 0x43, 0x5e, 0xcd, 0x03, 0xae, 0xeb, 0xa6, 0x58, 0xf7, 0x72,
-	0x40, 0xc6, 0xe8, 0xcf, 0x03, 0x6e, 0x63, 0x3c, 0x3d, 0x04, 0x73, 0xf5, 0x4f, 0xb6, 0x88, 0x1a,
-	0xdc, 0xb3, 0x3b, 0xbd, 0x8b, 0xa3, 0x93, 0xeb, 0xfe, 0xc5, 0x59, 0xe7, 0xfc, 0xca, 0x34, 0xe8,
-	0x67, 0x50, 0x7d, 0xca, 0x7d, 0x1e, 0xba, 0xc3, 0x54, 0xb8, 0xa8, 0x15, 0xb9, 0x94, 0x6c, 0xcc,
-	0xd3, 0x56, 0x8c, 0x4d, 0x3a, 0x84, 0xea, 0xa9, 0x18, 0x74, 0xc2, 0x50, 0x84, 0xef, 0x9a, 0x57,
-	0x6f, 0xd7, 0x8f, 0xc2, 0x16, 0x8f, 0x56, 0x3f, 0xd3, 0x7c, 0xdd, 0x9d, 0xcb, 0x3e, 0xfa, 0x0d,
-	0x40, 0x4f, 0x8c, 0x13, 0x7e, 0x1d, 0xca, 0x43, 0xcf, 0xe5, 0xd8, 0xcb, 0x71, 0x86, 0xd4, 0x5e,
-	0x2e, 0x34, 0x97, 0x29, 0xf4, 0xf0, 0xef, 0x22, 0xe4, 0xbf, 0xf5, 0x38, 0x23, 0x2f, 0xa0, 0xf4,
-	0x1d, 0x67, 0x9e, 0xba, 0x79, 0x43, 0xf6, 0x50, 0xc7, 0xd8, 0x3a, 0x8e, 0x9a, 0x4d, 0xa7, 0xa8,
-	0x5b, 0xeb, 0x3f, 0x62, 0x25, 0xa8, 0xf5, 0xcb, 0x1f, 0x7f, 0xfe, 0x9a, 0x23, 0xc4, 0x6c, 0xbf,
-	0xfe, 0xa2, 0x1d, 0xbd, 0x07, 0xed, 0x1b, 0x0d, 0x3b, 0x85, 0xe2, 0xb1, 0xf0, 0x47, 0xee, 0x98,
-	0x10, 0x5c, 0x1d, 0x1b, 0x09, 0xf1, 0x7e, 0xc6, 0xa7, 0x61, 0x7b, 0x08, 0xab, 0x91, 0x6a, 0x0a,
-	0x1b, 0xc6, 0x84, 0xe7, 0x00, 0xc7, 0x21, 0x67, 0x8a, 0x47, 0x6d, 0x49, 0x4c, 0x5c, 0xbb, 0x34,
-	0x1a, 0xea, 0x6b, 0x1e, 0xfa, 0x10, 0x51, 0x0f, 0xe8, 0xce, 0x02, 0x85, 0x80, 0xeb, 0x48, 0xfc,
-	0x27, 0xc6, 0x01, 0x79, 0x09, 0xb0, 0x78, 0x5a, 0xc8, 0x2e, 0x02, 0xd6, 0xde, 0x9a, 0xb7, 0x80,
-	0x9b, 0x08, 0xa6, 0xf4, 0xa3, 0x14, 0x3c, 0xc5, 0x55, 0x08, 0x6e, 0xdf, 0xc6, 0x67, 0xfb, 0x73,
-	0x94, 0xc1, 0x86, 0x4a, 0x3a, 0x56, 0xc9, 0x87, 0x08, 0x5a, 0x7d, 0x4e, 0xea, 0xbb, 0xab, 0x6e,
-	0xad, 0xc4, 0x2e, 0x66, 0x31, 0xc9, 0x76, 0x9a, 0x45, 0x21, 0xe6, 0x39, 0x94, 0xf4, 0xf4, 0x24,
-	0xb1, 0x82, 0xd9, 0x59, 0x7a, 0xb7, 0x10, 0x64, 0x2f, 0x4b, 0x4a, 0x2b, 0x25, 0x2f, 0xa1, 0x92,
-	0xde, 0x78, 0x5d, 0xe6, 0xea, 0x58, 0xd1, 0x65, 0xae, 0x0d, 0x06, 0xfa, 0x08, 0xe1, 0x1f, 0x93,
-	0xfd, 0x14, 0x8e, 0x0f, 0x44, 0x56, 0x0b, 0x32, 0x82, 0x72, 0x72, 0x27, 0xc8, 0x0e, 0x92, 0x56,
-	0xae, 0x48, 0x7d, 0x47, 0xef, 0x25, 0x73, 0xcb, 0x68, 0x0b, 0xe9, 0x4d, 0xfa, 0x49, 0x4a, 0x7f,
-	0x25, 0x06, 0xd7, 0x78, 0x13, 0x52, 0x78, 0xfb, 0x16, 0xaf, 0x0d, 0x0a, 0x7e, 0x09, 0x1b, 0x3d,
-	0x31, 0x26, 0xd5, 0x58, 0xd3, 0xf4, 0x82, 0xdc, 0x41, 0xa7, 0x48, 0xdf, 0xa7, 0x0b, 0x61, 0x3c,
-	0x31, 0x6e, 0xdf, 0x26, 0x57, 0x07, 0x89, 0x67, 0x50, 0xc0, 0x71, 0x41, 0x6a, 0x6b, 0x13, 0xe6,
-	0x0e, 0xea, 0x03, 0xa4, 0xde, 0xa7, 0x8b, 0x83, 0x63, 0xd1, 0xa2, 0x27, 0xc6, 0xc1, 0xa0, 0x88,
-	0x2f, 0xc7, 0x97, 0xff, 0x04, 0x00, 0x00, 0xff, 0xff, 0xb8, 0xad, 0x70, 0xcc, 0x37, 0x09, 0x00,
-	0x00,
+	// 1194 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0x4f, 0x73, 0xdb, 0xc4,
+	0x1b, 0xfe, 0xc9, 0x89, 0xff, 0xbd, 0x8e, 0x63, 0x7b, 0x9b, 0x9f, 0xab, 0x18, 0x97, 0x9a, 0x6d,
+	0xe9, 0x78, 0x0a, 0xb5, 0x07, 0x33, 0xd3, 0x61, 0x18, 0x2e, 0x69, 0x6c, 0x8a, 0x5b, 0x37, 0x49,
+	0x55, 0xd3, 0xde, 0x68, 0xd7, 0xd6, 0xc6, 0x51, 0x2d, 0x6b, 0x85, 0xb4, 0x4e, 0x9a, 0x66, 0xb8,
+	0x70, 0xe2, 0xce, 0x70, 0xe4, 0xcc, 0x67, 0xe0, 0xc0, 0xa7, 0xe0, 0x2b, 0xf0, 0x41, 0x98, 0x5d,
+	0xad, 0x64, 0x49, 0x4e, 0x53, 0xb8, 0x70, 0xf3, 0xfb, 0x67, 0x9f, 0xf7, 0x79, 0x9f, 0x7d, 0xdf,
+	0x95, 0x01, 0x8e, 0x6d, 0x4a, 0x3a, 0xae, 0xc7, 0x38, 0x43, 0x1b, 0xc4, 0xb5, 0x1a, 0x37, 0x67,
+	0x8c, 0xcd, 0x6c, 0xda, 0x95, 0xae, 0xc9, 0xf2, 0xb8, 0xcb, 0xad, 0x05, 0xf5, 0x39, 0x59, 0xb8,
+	0x41, 0x56, 0xa3, 0xa9, 0x12, 0x88, 0x6b, 0x75, 0x89, 0xe3, 0x30, 0x4e, 0xb8, 0xc5, 0x1c, 0x5f,
+	0x45, 0xab, 0x1e, 0x75, 0x99, 0x6f, 0x71, 0xe6, 0x9d, 0x07, 0x1e, 0x7c, 0x01, 0xb5, 0x27, 0xcc,
+	0xb4, 0x8e, 0xcf, 0xc7, 0xc4, 0x9f, 0x1b, 0xf4, 0xfb, 0x25, 0xf5, 0x39, 0xaa, 0x43, 0x8e, 0x13,
+	0x7f, 0x3e, 0x34, 0x75, 0xad, 0xa5, 0xb5, 0x8b, 0x86, 0xb2, 0xd0, 0x7d, 0x28, 0x98, 0x94, 0x98,
+	0xb6, 0xe5, 0x50, 0x3d, 0xd3, 0xd2, 0xda, 0xa5, 0x5e, 0xa3, 0x13, 0xd4, 0xeb, 0x84, 0x84, 0x3a,
+	0xe3, 0x90, 0x90, 0x11, 0xe5, 0x0a, 0x3c, 0x32, 0xe5, 0xd6, 0x29, 0xd5, 0x37, 0x5a, 0x5a, 0xbb,
+	0x60, 0x28, 0x0b, 0xff, 0x96, 0x81, 0xea, 0xc8, 0xf2, 0xb9, 0xa8, 0xed, 0x87, 0xc5, 0x75, 0xc8,
+	0x2f, 0x98, 0x49, 0xed, 0xa8, 0x7a, 0x68, 0xa2, 0x4f, 0xa1, 0x76, 0x72, 0xee, 0x52, 0xcf, 0x25,
+	0x1e, 0x59, 0x50, 0x4e, 0x3d, 0x7f, 0x68, 0x4a, 0x1e, 0x45, 0x63, 0x3d, 0x80, 0x30, 0x6c, 0x4d,
+	0x4f, 0xe8, 0x74, 0xee, 0x32, 0xcb, 0xe1, 0x43, 0x53, 0x96, 0x2e, 0x1a, 0x09, 0x1f, 0x6a, 0x41,
+	0xc9, 0xe7, 0xc4, 0x93, 0x04, 0x86, 0xa6, 0xbe, 0x29, 0x53, 0xe2, 0x2e, 0xd4, 0x80, 0xc2, 0x82,
+	0xbc, 0x19, 0x72, 0xba, 0xf0, 0xf5, 0x6c, 0x4b, 0x6b, 0x67, 0x8d, 0xc8, 0x46, 0x6d, 0xa8, 0x58,
+	0xce, 0xd4, 0x5e, 0x9a, 0x74, 0xe8, 0xa8, 0xfe, 0x72, 0xb2, 0xbf, 0xb4, 0x1b, 0x35, 0xa1, 0xe8,
+	0x92, 0x19, 0x1d, 0xb3, 0x39, 0x75, 0xf4, 0xbc, 0xac, 0xb2, 0x72, 0xa0, 0x8f, 0x60, 0xf3, 0xd4,
+	0xa2, 0x67, 0x7a, 0xa1, 0xa5, 0xb5, 0xb7, 0x7b, 0xe5, 0x0e, 0x71, 0xad, 0x8e, 0x90, 0xe5, 0xb9,
+	0x45, 0xcf, 0x0c, 0x19, 0xc2, 0xbf, 0x6b, 0x50, 0x8b, 0x29, 0xe5, 0xbb, 0xcc, 0xf1, 0x69, 0x9a,
+	0xbe, 0x76, 0x35, 0xfd, 0x4c, 0x8a, 0xbe, 0x0e, 0xf9, 0xe0, 0x5e, 0x7d, 0x7d, 0xa3, 0xb5, 0x21,
+	0x84, 0x56, 0x26, 0xba, 0x0d, 0x65, 0x87, 0xbe, 0xe1, 0x47, 0x11, 0xe5, 0x40, 0x98, 0xa4, 0x13,
+	0xdd, 0x81, 0xac, 0x38, 0x20, 0x74, 0xd9, 0x68, 0x97, 0x7a, 0x55, 0xc9, 0x5b, 0xd4, 0xed, 0x53,
+	0x4e, 0x2c, 0xdb, 0x37, 0x82, 0x30, 0x7e, 0x00, 0xdb, 0x0f, 0x29, 0xff, 0x27, 0xf3, 0xa5, 0x43,
+	0xde, 0x23, 0x67, 0x23, 0xcb, 0x99, 0x4b, 0xb2, 0x05, 0x23, 0x34, 0xf1, 0xaf, 0x19, 0x28, 0xc5,
+	0xa0, 0xff, 0xd3, 0x21, 0x89, 0x4f, 0xfd, 0xe6, 0xbf, 0x9b, 0x7a, 0xd5, 0x65, 0x36, 0xd1, 0xe5,
+	0x6a, 0x1b, 0x72, 0xf1, 0x6d, 0x40, 0x08, 0x36, 0x6d, 0xd1, 0x7a, 0x30, 0x1f, 0xf2, 0x37, 0xba,
+	0x03, 0xdb, 0x2b, 0x2e, 0x52, 0x98, 0x82, 0x8c, 0xa6, 0xbc, 0xf8, 0x2e, 0x54, 0x9f, 0x85, 0xd7,
+	0xfe, 0x1e, 0x95, 0xf1, 0x1f, 0x1a, 0xd4, 0x62, 0xc9, 0x6a, 0x96, 0xbe, 0x82, 0x9c, 0xcf, 0x09,
+	0x5f, 0xfa, 0x32, 0x7b, 0xbb, 0x77, 0x5b, 0x5e, 0xe7, 0x5a, 0x5e, 0x47, 0xa1, 0x3f, 0x93, 0xb9,
+	0x86, 0x3a, 0x83, 0x76, 0x20, 0xfb, 0x9a, 0x4d, 0x22, 0xa5, 0x03, 0x43, 0x4c, 0xdf, 0xd2, 0xb5,
+	0x19, 0x31, 0xc7, 0x4c, 0x29, 0x1b, 0xd9, 0xf8, 0x0b, 0x28, 0x27, 0xa0, 0x50, 0x09, 0xf2, 0xdf,
+	0x1e, 0x3c, 0x3e, 0x38, 0x7c, 0x71, 0x50, 0xfd, 0x1f, 0xda, 0x82, 0x82, 0x31, 0x78, 0x34, 0xd8,
+	0x1f, 0x0f, 0xfa, 0x55, 0x4d, 0x58, 0x7b, 0x47, 0x47, 0xc6, 0xe1, 0xf3, 0x41, 0xbf, 0x9a, 0xc1,
+	0x6f, 0x61, 0x6b, 0xcf, 0x5c, 0x58, 0x4e, 0xd8, 0xe7, 0x7d, 0xd8, 0xe4, 0xe7, 0x2e, 0x55, 0xbc,
+	0xb1, 0xe4, 0x1d, 0x4f, 0x48, 0x18, 0xe3, 0x73, 0x97, 0x1a, 0x32, 0x1f, 0xf7, 0xa0, 0x9a, 0x8e,
+	0x24, 0x49, 0xd4, 0xa0, 0x6c, 0x0c, 0x46, 0x87, 0x7b, 0xfd, 0x97, 0xe3, 0xc3, 0xc7, 0x83, 0x83,
+	0x67, 0x55, 0x0d, 0x7f, 0x02, 0x95, 0x87, 0xd4, 0xa1, 0x9e, 0x35, 0x8d, 0x84, 0x13, 0xa3, 0x48,
+	0x7d, 0x9f, 0xcc, 0x68, 0x34, 0x8a, 0x81, 0x89, 0xa7, 0x50, 0x79, 0xc4, 0x26, 0x03, 0xcf, 0x63,
+	0xde, 0xfb, 0x26, 0xff, 0x72, 0xfd, 0x30, 0x6c, 0x51, 0x71, 0xfa, 0x89, 0xc2, 0x57, 0xd3, 0x19,
+	0xf7, 0xe1, 0x07, 0x00, 0x23, 0x36, 0x0b, 0xf1, 0x1b, 0x50, 0x98, 0xda, 0x16, 0x75, 0x78, 0x54,
+	0x21, 0xb2, 0xe3, 0x44, 0x33, 0x09, 0xa2, 0xbd, 0x5f, 0x4a, 0xb0, 0xf9, 0xb5, 0x4d, 0x09, 0x7a,
+	0x0e, 0xf9, 0x6f, 0x28, 0xb1, 0xf9, 0xc9, 0x5b, 0x74, 0x5d, 0xea, 0x18, 0x58, 0xfb, 0x62, 0xd8,
+	0x54, 0x89, 0x86, 0xbe, 0x1e, 0x08, 0x94, 0xc0, 0xfa, 0x8f, 0x7f, 0xfe, 0xf5, 0x73, 0x06, 0xa1,
+	0x6a, 0xf7, 0xf4, 0xb3, 0xae, 0xf8, 0x72, 0x75, 0x4f, 0x14, 0xd8, 0x23, 0xc8, 0xed, 0x33, 0xe7,
+	0xd8, 0x9a, 0x21, 0x24, 0x4f, 0x07, 0x46, 0x88, 0x78, 0x2d, 0xe1, 0x53, 0x60, 0xd7, 0x25, 0x58,
+	0x0d, 0x55, 0x22, 0xb0, 0x69, 0x80, 0xf0, 0x14, 0x60, 0xdf, 0xa3, 0x84, 0x53, 0x31, 0x96, 0x68,
+	0xed, 0xd5, 0x69, 0xac, 0x79, 0xf0, 0x4d, 0x09, 0xb5, 0x8b, 0x77, 0x56, 0x50, 0x12, 0xe0, 0xa5,
+	0x10, 0xff, 0x4b, 0xed, 0x2e, 0x7a, 0x05, 0xb0, 0xfa, 0x08, 0xa2, 0xba, 0x04, 0x58, 0xfb, 0x2a,
+	0x5e, 0x02, 0xdc, 0x96, 0xc0, 0x18, 0xdf, 0x88, 0x80, 0x17, 0xf2, 0x94, 0x04, 0xee, 0x5e, 0x04,
+	0x77, 0xfb, 0x83, 0xa8, 0x60, 0x40, 0x31, 0x7a, 0xbe, 0xd1, 0xff, 0xa3, 0x17, 0x3e, 0xfe, 0xe1,
+	0x6b, 0xd4, 0xd3, 0x6e, 0xa5, 0x44, 0x5d, 0x56, 0xa9, 0xa2, 0xed, 0xa8, 0x0a, 0x97, 0x30, 0x4f,
+	0x21, 0xaf, 0xde, 0x55, 0x14, 0x28, 0x98, 0x7c, 0x65, 0xdf, 0x2d, 0x04, 0xba, 0x9e, 0x44, 0x8a,
+	0x98, 0xa2, 0x57, 0x50, 0x8c, 0x36, 0x5e, 0xd1, 0x4c, 0x3f, 0x2b, 0x8d, 0x7a, 0xda, 0xad, 0x68,
+	0xde, 0x96, 0xe0, 0x1f, 0xa2, 0x66, 0x04, 0x2e, 0x3f, 0x44, 0x49, 0x2d, 0xd0, 0x31, 0x14, 0xc2,
+	0x9d, 0x40, 0x3b, 0x12, 0x29, 0xb5, 0x22, 0x8d, 0x1d, 0xd5, 0x4b, 0x62, 0xcb, 0x70, 0x47, 0xa2,
+	0xb7, 0xf1, 0xad, 0x08, 0xfd, 0x35, 0x9b, 0xbc, 0x94, 0x9b, 0x10, 0x81, 0x77, 0x2f, 0xe4, 0xda,
+	0x48, 0xc1, 0x8f, 0x60, 0x63, 0xc4, 0x66, 0xa8, 0x12, 0x68, 0xca, 0x66, 0x57, 0xa3, 0x63, 0x89,
+	0xde, 0xc4, 0x2b, 0x61, 0x6c, 0x36, 0xeb, 0x5e, 0x84, 0xab, 0x23, 0x11, 0x1f, 0x43, 0x56, 0x3e,
+	0x17, 0xa8, 0xb6, 0xf6, 0xc2, 0xbc, 0x03, 0x75, 0x57, 0xa2, 0x5e, 0xc3, 0xab, 0x8b, 0x23, 0xe2,
+	0x90, 0x00, 0x33, 0xa1, 0x22, 0x2e, 0x7a, 0x6f, 0x69, 0x5a, 0x7c, 0x70, 0x4a, 0x1d, 0xee, 0xa3,
+	0x0f, 0xa2, 0xeb, 0x8f, 0x79, 0xc3, 0x02, 0xcd, 0xcb, 0x83, 0xef, 0x9c, 0x10, 0x22, 0xb2, 0x90,
+	0x09, 0xe5, 0x60, 0x55, 0x5e, 0xd0, 0xc9, 0x09, 0x63, 0x73, 0xb4, 0x1b, 0x6c, 0x5a, 0xdc, 0x17,
+	0x56, 0x68, 0x5c, 0x16, 0x52, 0xf8, 0x4d, 0x89, 0x5f, 0xc7, 0xb5, 0x08, 0xff, 0x2c, 0xc8, 0xf0,
+	0x45, 0x2f, 0xdf, 0xc1, 0x96, 0x20, 0xa6, 0x0e, 0xf9, 0x48, 0x8f, 0xb8, 0x86, 0xae, 0xb0, 0xc6,
+	0xee, 0x25, 0x91, 0xa4, 0x56, 0x68, 0xbd, 0x04, 0x5a, 0x40, 0xb9, 0x4f, 0x6d, 0x9a, 0xee, 0x22,
+	0xe1, 0x4b, 0x76, 0x91, 0x0a, 0xa9, 0x12, 0x1f, 0xcb, 0x12, 0x37, 0xef, 0xde, 0x58, 0x2b, 0xd1,
+	0xbd, 0x50, 0xbf, 0xc4, 0x84, 0xfe, 0xa4, 0x41, 0x3d, 0x46, 0xb1, 0x4f, 0x89, 0x39, 0xa2, 0x5c,
+	0xfc, 0x5f, 0x40, 0x38, 0xcd, 0x3f, 0x16, 0x0c, 0x19, 0xdc, 0xba, 0x32, 0x27, 0x49, 0x05, 0xad,
+	0x51, 0xb9, 0x27, 0xfe, 0x3d, 0xdc, 0xb3, 0x83, 0xf4, 0x49, 0x4e, 0xfe, 0xbf, 0xf8, 0xfc, 0xef,
+	0x01, 0x00, 0xbc, 0x3d, 0x14, 0x5e, 0x07, 0x0c, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -783,6 +840,11 @@ type FleaClient interface {
 	JobError(ctx context.Context, in *JobErrorRequest, opts ...grpc.CallOption) (*GenericResponse, error)
 	Log(ctx context.Context, in *LogRequest, opts ...grpc.CallOption) (*GenericResponse, error)
 	Admin(ctx context.Context, in *AdminRequest, opts ...grpc.CallOption) (*GenericResponse, error)
+	ListAuditEvents(ctx context.Context, in *ListAuditEventsRequest, opts ...grpc.CallOption) (*ListAuditEventsResponse, error)
+	CreateWebhook(ctx context.Context, in *CreateWebhookRequest, opts ...grpc.CallOption) (*CreateWebhookResponse, error)
+	ListWebhooks(ctx context.Context, in *ListWebhooksRequest, opts ...grpc.CallOption) (*ListWebhooksResponse, error)
+	DeleteWebhook(ctx context.Context, in *DeleteWebhookRequest, opts ...grpc.CallOption) (*DeleteWebhookResponse, error)
+	ListWebhookDeadLetters(ctx context.Context, in *ListWebhookDeadLettersRequest, opts ...grpc.CallOption) (*ListWebhookDeadLettersResponse, error)
 }
 
 type fleaClient struct {
@@ -883,6 +945,51 @@ func (c *fleaClient) Admin(ctx context.Context, in *AdminRequest, opts ...grpc.C
 	return out, nil
 }
 
+func (c *fleaClient) ListAuditEvents(ctx context.Context, in *ListAuditEventsRequest, opts ...grpc.CallOption) (*ListAuditEventsResponse, error) {
+	out := new(ListAuditEventsResponse)
+	err := c.cc.Invoke(ctx, "/api.Flea/ListAuditEvents", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fleaClient) CreateWebhook(ctx context.Context, in *CreateWebhookRequest, opts ...grpc.CallOption) (*CreateWebhookResponse, error) {
+	out := new(CreateWebhookResponse)
+	err := c.cc.Invoke(ctx, "/api.Flea/CreateWebhook", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fleaClient) ListWebhooks(ctx context.Context, in *ListWebhooksRequest, opts ...grpc.CallOption) (*ListWebhooksResponse, error) {
+	out := new(ListWebhooksResponse)
+	err := c.cc.Invoke(ctx, "/api.Flea/ListWebhooks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fleaClient) DeleteWebhook(ctx context.Context, in *DeleteWebhookRequest, opts ...grpc.CallOption) (*DeleteWebhookResponse, error) {
+	out := new(DeleteWebhookResponse)
+	err := c.cc.Invoke(ctx, "/api.Flea/DeleteWebhook", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fleaClient) ListWebhookDeadLetters(ctx context.Context, in *ListWebhookDeadLettersRequest, opts ...grpc.CallOption) (*ListWebhookDeadLettersResponse, error) {
+	out := new(ListWebhookDeadLettersResponse)
+	err := c.cc.Invoke(ctx, "/api.Flea/ListWebhookDeadLetters", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // FleaServer is the server API for Flea service.
 type FleaServer interface {
 	Healthz(context.Context, *HealthCheckRequest) (*HealthCheckResponse, error)
@@ -895,6 +1002,11 @@ type FleaServer interface {
 	JobError(context.Context, *JobErrorRequest) (*GenericResponse, error)
 	Log(context.Context, *LogRequest) (*GenericResponse, error)
 	Admin(context.Context, *AdminRequest) (*GenericResponse, error)
+	ListAuditEvents(context.Context, *ListAuditEventsRequest) (*ListAuditEventsResponse, error)
+	CreateWebhook(context.Context, *CreateWebhookRequest) (*CreateWebhookResponse, error)
+	ListWebhooks(context.Context, *ListWebhooksRequest) (*ListWebhooksResponse, error)
+	DeleteWebhook(context.Context, *DeleteWebhookRequest) (*DeleteWebhookResponse, error)
+	ListWebhookDeadLetters(context.Context, *ListWebhookDeadLettersRequest) (*ListWebhookDeadLettersResponse, error)
 }
 
 func RegisterFleaServer(s *grpc.Server, srv FleaServer) {
@@ -1081,6 +1193,96 @@ func _Flea_Admin_Handler(srv interface{}, ctx context.Context, dec func(interfac
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Flea_ListAuditEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAuditEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FleaServer).ListAuditEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Flea/ListAuditEvents",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FleaServer).ListAuditEvents(ctx, req.(*ListAuditEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Flea_CreateWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateWebhookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FleaServer).CreateWebhook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Flea/CreateWebhook",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FleaServer).CreateWebhook(ctx, req.(*CreateWebhookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Flea_ListWebhooks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWebhooksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FleaServer).ListWebhooks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Flea/ListWebhooks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FleaServer).ListWebhooks(ctx, req.(*ListWebhooksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Flea_DeleteWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteWebhookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FleaServer).DeleteWebhook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Flea/DeleteWebhook",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FleaServer).DeleteWebhook(ctx, req.(*DeleteWebhookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Flea_ListWebhookDeadLetters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWebhookDeadLettersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FleaServer).ListWebhookDeadLetters(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Flea/ListWebhookDeadLetters",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FleaServer).ListWebhookDeadLetters(ctx, req.(*ListWebhookDeadLettersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Flea_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "api.Flea",
 	HandlerType: (*FleaServer)(nil),
@@ -1125,6 +1327,26 @@ var _Flea_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Admin",
 			Handler:    _Flea_Admin_Handler,
 		},
+		{
+			MethodName: "ListAuditEvents",
+			Handler:    _Flea_ListAuditEvents_Handler,
+		},
+		{
+			MethodName: "CreateWebhook",
+			Handler:    _Flea_CreateWebhook_Handler,
+		},
+		{
+			MethodName: "ListWebhooks",
+			Handler:    _Flea_ListWebhooks_Handler,
+		},
+		{
+			MethodName: "DeleteWebhook",
+			Handler:    _Flea_DeleteWebhook_Handler,
+		},
+		{
+			MethodName: "ListWebhookDeadLetters",
+			Handler:    _Flea_ListWebhookDeadLetters_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "flea.proto",
@@ -24,6 +24,63 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
 
+// ListView - how much of each listed resource the List* methods return.
+type ListView int32
+
+const (
+	ListView_IDS  ListView = 0
+	ListView_FULL ListView = 1
+)
+
+var ListView_name = map[int32]string{
+	0: "IDS",
+	1: "FULL",
+}
+
+var ListView_value = map[string]int32{
+	"IDS":  0,
+	"FULL": 1,
+}
+
+func (x ListView) String() string {
+	return proto.EnumName(ListView_name, int32(x))
+}
+
+func (ListView) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_10d86afa5a89ec9d, []int{0}
+}
+
+type CheckpointState int32
+
+const (
+	CheckpointState_ACTIVE     CheckpointState = 0
+	CheckpointState_DEPRECATED CheckpointState = 1
+	CheckpointState_ARCHIVED   CheckpointState = 2
+	CheckpointState_PENDING    CheckpointState = 3
+)
+
+var CheckpointState_name = map[int32]string{
+	0: "ACTIVE",
+	1: "DEPRECATED",
+	2: "ARCHIVED",
+	3: "PENDING",
+}
+
+var CheckpointState_value = map[string]int32{
+	"ACTIVE":     0,
+	"DEPRECATED": 1,
+	"ARCHIVED":   2,
+	"PENDING":    3,
+}
+
+func (x CheckpointState) String() string {
+	return proto.EnumName(CheckpointState_name, int32(x))
+}
+
+func (CheckpointState) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_10d86afa5a89ec9d, []int{1}
+}
+
 type HealthCheckResponse_ServingStatus int32
 
 const (
@@ -58,18 +115,27 @@ const (
 	ConfigResponse_INVALID              ConfigResponse_BackendType = 0
 	ConfigResponse_MEMORY               ConfigResponse_BackendType = 1
 	ConfigResponse_GOOGLE_CLOUD_STORAGE ConfigResponse_BackendType = 2
+	ConfigResponse_FILESYSTEM           ConfigResponse_BackendType = 3
+	ConfigResponse_S3                   ConfigResponse_BackendType = 4
+	ConfigResponse_BOLTDB               ConfigResponse_BackendType = 5
 )
 
 var ConfigResponse_BackendType_name = map[int32]string{
 	0: "INVALID",
 	1: "MEMORY",
 	2: "GOOGLE_CLOUD_STORAGE",
+	3: "FILESYSTEM",
+	4: "S3",
+	5: "BOLTDB",
 }
 
 var ConfigResponse_BackendType_value = map[string]int32{
 	"INVALID":              0,
 	"MEMORY":               1,
 	"GOOGLE_CLOUD_STORAGE": 2,
+	"FILESYSTEM":           3,
+	"S3":                   4,
+	"BOLTDB":               5,
 }
 
 func (x ConfigResponse_BackendType) String() string {
@@ -80,6 +146,37 @@ func (ConfigResponse_BackendType) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_10d86afa5a89ec9d, []int{3, 0}
 }
 
+type WatchModelEvent_Type int32
+
+const (
+	WatchModelEvent_UNKNOWN WatchModelEvent_Type = 0
+	WatchModelEvent_CREATED WatchModelEvent_Type = 1
+	WatchModelEvent_UPDATED WatchModelEvent_Type = 2
+	WatchModelEvent_DELETED WatchModelEvent_Type = 3
+)
+
+var WatchModelEvent_Type_name = map[int32]string{
+	0: "UNKNOWN",
+	1: "CREATED",
+	2: "UPDATED",
+	3: "DELETED",
+}
+
+var WatchModelEvent_Type_value = map[string]int32{
+	"UNKNOWN": 0,
+	"CREATED": 1,
+	"UPDATED": 2,
+	"DELETED": 3,
+}
+
+func (x WatchModelEvent_Type) String() string {
+	return proto.EnumName(WatchModelEvent_Type_name, int32(x))
+}
+
+func (WatchModelEvent_Type) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_10d86afa5a89ec9d, []int{70, 0}
+}
+
 //*
 // Health checks inspired by the conventions here:
 // https://github.com/grpc/grpc/blob/master/doc/health-checking.md
@@ -235,12 +332,19 @@ func (m *ConfigResponse) GetBackendType() ConfigResponse_BackendType {
 }
 
 type Model struct {
-	ModelId                  string   `protobuf:"bytes,1,opt,name=modelId,proto3" json:"modelId,omitempty"`
-	Details                  string   `protobuf:"bytes,2,opt,name=details,proto3" json:"details,omitempty"`
-	CanonicalHyperparameters string   `protobuf:"bytes,3,opt,name=canonicalHyperparameters,proto3" json:"canonicalHyperparameters,omitempty"`
-	XXX_NoUnkeyedLiteral     struct{} `json:"-"`
-	XXX_unrecognized         []byte   `json:"-"`
-	XXX_sizecache            int32    `json:"-"`
+	ModelId string `protobuf:"bytes,1,opt,name=modelId,proto3" json:"modelId,omitempty"`
+	// Free-form description of the model. Models with a card default to the card's description.
+	Details                  string `protobuf:"bytes,2,opt,name=details,proto3" json:"details,omitempty"`
+	CanonicalHyperparameters string `protobuf:"bytes,3,opt,name=canonicalHyperparameters,proto3" json:"canonicalHyperparameters,omitempty"`
+	// Labels can be used in the filters of list requests. On update, labels set to "" are removed.
+	Labels map[string]string `protobuf:"bytes,4,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Structured metadata describing the model. On update, a card replaces the stored card.
+	Card *ModelCard `protobuf:"bytes,5,opt,name=card,proto3" json:"card,omitempty"`
+	// Incremented by every update. Ignored on create and update; see UpdateModelRequest.expectedVersion.
+	Version              int64    `protobuf:"varint,6,opt,name=version,proto3" json:"version,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *Model) Reset()         { *m = Model{} }
@@ -289,9 +393,199 @@ func (m *Model) GetCanonicalHyperparameters() string {
 	return ""
 }
 
+func (m *Model) GetLabels() map[string]string {
+	if m != nil {
+		return m.Labels
+	}
+	return nil
+}
+
+func (m *Model) GetCard() *ModelCard {
+	if m != nil {
+		return m.Card
+	}
+	return nil
+}
+
+func (m *Model) GetVersion() int64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+// ModelCard - structured, versioned metadata describing a model.
+type ModelCard struct {
+	// The version of the model card schema the card follows. 0 means the current version, which is 1.
+	SchemaVersion int32         `protobuf:"varint,1,opt,name=schemaVersion,proto3" json:"schemaVersion,omitempty"`
+	Description   string        `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Owners        []string      `protobuf:"bytes,3,rep,name=owners,proto3" json:"owners,omitempty"`
+	Inputs        []*TensorSpec `protobuf:"bytes,4,rep,name=inputs,proto3" json:"inputs,omitempty"`
+	Outputs       []*TensorSpec `protobuf:"bytes,5,rep,name=outputs,proto3" json:"outputs,omitempty"`
+	// SPDX license identifiers are preferred, e.g. Apache-2.0
+	License     string `protobuf:"bytes,6,opt,name=license,proto3" json:"license,omitempty"`
+	IntendedUse string `protobuf:"bytes,7,opt,name=intendedUse,proto3" json:"intendedUse,omitempty"`
+	// e.g. tensorflow, tflite, pytorch
+	Framework            string   `protobuf:"bytes,8,opt,name=framework,proto3" json:"framework,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ModelCard) Reset()         { *m = ModelCard{} }
+func (m *ModelCard) String() string { return proto.CompactTextString(m) }
+func (*ModelCard) ProtoMessage()    {}
+func (*ModelCard) Descriptor() ([]byte, []int) {
+	return fileDescriptor_10d86afa5a89ec9d, []int{5}
+}
+
+func (m *ModelCard) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ModelCard.Unmarshal(m, b)
+}
+func (m *ModelCard) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ModelCard.Marshal(b, m, deterministic)
+}
+func (m *ModelCard) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ModelCard.Merge(m, src)
+}
+func (m *ModelCard) XXX_Size() int {
+	return xxx_messageInfo_ModelCard.Size(m)
+}
+func (m *ModelCard) XXX_DiscardUnknown() {
+	xxx_messageInfo_ModelCard.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ModelCard proto.InternalMessageInfo
+
+func (m *ModelCard) GetSchemaVersion() int32 {
+	if m != nil {
+		return m.SchemaVersion
+	}
+	return 0
+}
+
+func (m *ModelCard) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *ModelCard) GetOwners() []string {
+	if m != nil {
+		return m.Owners
+	}
+	return nil
+}
+
+func (m *ModelCard) GetInputs() []*TensorSpec {
+	if m != nil {
+		return m.Inputs
+	}
+	return nil
+}
+
+func (m *ModelCard) GetOutputs() []*TensorSpec {
+	if m != nil {
+		return m.Outputs
+	}
+	return nil
+}
+
+func (m *ModelCard) GetLicense() string {
+	if m != nil {
+		return m.License
+	}
+	return ""
+}
+
+func (m *ModelCard) GetIntendedUse() string {
+	if m != nil {
+		return m.IntendedUse
+	}
+	return ""
+}
+
+func (m *ModelCard) GetFramework() string {
+	if m != nil {
+		return m.Framework
+	}
+	return ""
+}
+
+// TensorSpec - describes one of the input or output tensors of a model.
+type TensorSpec struct {
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// One of float16, float32, float64, int8, int16, int32, int64, uint8, bool or string
+	Dtype string `protobuf:"bytes,2,opt,name=dtype,proto3" json:"dtype,omitempty"`
+	// The size of each dimension, -1 for dimensions of any size (e.g. batch dimensions)
+	Shape                []int64  `protobuf:"varint,3,rep,packed,name=shape,proto3" json:"shape,omitempty"`
+	Description          string   `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TensorSpec) Reset()         { *m = TensorSpec{} }
+func (m *TensorSpec) String() string { return proto.CompactTextString(m) }
+func (*TensorSpec) ProtoMessage()    {}
+func (*TensorSpec) Descriptor() ([]byte, []int) {
+	return fileDescriptor_10d86afa5a89ec9d, []int{6}
+}
+
+func (m *TensorSpec) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TensorSpec.Unmarshal(m, b)
+}
+func (m *TensorSpec) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TensorSpec.Marshal(b, m, deterministic)
+}
+func (m *TensorSpec) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TensorSpec.Merge(m, src)
+}
+func (m *TensorSpec) XXX_Size() int {
+	return xxx_messageInfo_TensorSpec.Size(m)
+}
+func (m *TensorSpec) XXX_DiscardUnknown() {
+	xxx_messageInfo_TensorSpec.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TensorSpec proto.InternalMessageInfo
+
+func (m *TensorSpec) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *TensorSpec) GetDtype() string {
+	if m != nil {
+		return m.Dtype
+	}
+	return ""
+}
+
+func (m *TensorSpec) GetShape() []int64 {
+	if m != nil {
+		return m.Shape
+	}
+	return nil
+}
+
+func (m *TensorSpec) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
 type ListModelsRequest struct {
-	Marker               string   `protobuf:"bytes,1,opt,name=marker,proto3" json:"marker,omitempty"`
-	MaxItems             int32    `protobuf:"varint,2,opt,name=maxItems,proto3" json:"maxItems,omitempty"`
+	Marker    string   `protobuf:"bytes,1,opt,name=marker,proto3" json:"marker,omitempty"`
+	MaxItems  int32    `protobuf:"varint,2,opt,name=maxItems,proto3" json:"maxItems,omitempty"`
+	PageToken string   `protobuf:"bytes,3,opt,name=pageToken,proto3" json:"pageToken,omitempty"`
+	View      ListView `protobuf:"varint,4,opt,name=view,proto3,enum=api.ListView" json:"view,omitempty"`
+	// Only list models matching this expression, e.g. label.team=vision
+	Filter               string   `protobuf:"bytes,5,opt,name=filter,proto3" json:"filter,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -301,7 +595,7 @@ func (m *ListModelsRequest) Reset()         { *m = ListModelsRequest{} }
 func (m *ListModelsRequest) String() string { return proto.CompactTextString(m) }
 func (*ListModelsRequest) ProtoMessage()    {}
 func (*ListModelsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_10d86afa5a89ec9d, []int{5}
+	return fileDescriptor_10d86afa5a89ec9d, []int{7}
 }
 
 func (m *ListModelsRequest) XXX_Unmarshal(b []byte) error {
@@ -336,8 +630,31 @@ func (m *ListModelsRequest) GetMaxItems() int32 {
 	return 0
 }
 
+func (m *ListModelsRequest) GetPageToken() string {
+	if m != nil {
+		return m.PageToken
+	}
+	return ""
+}
+
+func (m *ListModelsRequest) GetView() ListView {
+	if m != nil {
+		return m.View
+	}
+	return ListView_IDS
+}
+
+func (m *ListModelsRequest) GetFilter() string {
+	if m != nil {
+		return m.Filter
+	}
+	return ""
+}
+
 type ListModelsResponse struct {
 	ModelIds             []string `protobuf:"bytes,1,rep,name=modelIds,proto3" json:"modelIds,omitempty"`
+	NextPageToken        string   `protobuf:"bytes,2,opt,name=nextPageToken,proto3" json:"nextPageToken,omitempty"`
+	Models               []*Model `protobuf:"bytes,3,rep,name=models,proto3" json:"models,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -347,7 +664,7 @@ func (m *ListModelsResponse) Reset()         { *m = ListModelsResponse{} }
 func (m *ListModelsResponse) String() string { return proto.CompactTextString(m) }
 func (*ListModelsResponse) ProtoMessage()    {}
 func (*ListModelsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_10d86afa5a89ec9d, []int{6}
+	return fileDescriptor_10d86afa5a89ec9d, []int{8}
 }
 
 func (m *ListModelsResponse) XXX_Unmarshal(b []byte) error {
@@ -375,6 +692,20 @@ func (m *ListModelsResponse) GetModelIds() []string {
 	return nil
 }
 
+func (m *ListModelsResponse) GetNextPageToken() string {
+	if m != nil {
+		return m.NextPageToken
+	}
+	return ""
+}
+
+func (m *ListModelsResponse) GetModels() []*Model {
+	if m != nil {
+		return m.Models
+	}
+	return nil
+}
+
 type CreateModelRequest struct {
 	Model                *Model   `protobuf:"bytes,1,opt,name=model,proto3" json:"model,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -386,7 +717,7 @@ func (m *CreateModelRequest) Reset()         { *m = CreateModelRequest{} }
 func (m *CreateModelRequest) String() string { return proto.CompactTextString(m) }
 func (*CreateModelRequest) ProtoMessage()    {}
 func (*CreateModelRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_10d86afa5a89ec9d, []int{7}
+	return fileDescriptor_10d86afa5a89ec9d, []int{9}
 }
 
 func (m *CreateModelRequest) XXX_Unmarshal(b []byte) error {
@@ -425,7 +756,7 @@ func (m *CreateModelResponse) Reset()         { *m = CreateModelResponse{} }
 func (m *CreateModelResponse) String() string { return proto.CompactTextString(m) }
 func (*CreateModelResponse) ProtoMessage()    {}
 func (*CreateModelResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_10d86afa5a89ec9d, []int{8}
+	return fileDescriptor_10d86afa5a89ec9d, []int{10}
 }
 
 func (m *CreateModelResponse) XXX_Unmarshal(b []byte) error {
@@ -464,7 +795,7 @@ func (m *GetModelRequest) Reset()         { *m = GetModelRequest{} }
 func (m *GetModelRequest) String() string { return proto.CompactTextString(m) }
 func (*GetModelRequest) ProtoMessage()    {}
 func (*GetModelRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_10d86afa5a89ec9d, []int{9}
+	return fileDescriptor_10d86afa5a89ec9d, []int{11}
 }
 
 func (m *GetModelRequest) XXX_Unmarshal(b []byte) error {
@@ -493,19 +824,22 @@ func (m *GetModelRequest) GetModelId() string {
 }
 
 type GetModelResponse struct {
-	ModelId                  string   `protobuf:"bytes,1,opt,name=modelId,proto3" json:"modelId,omitempty"`
-	Details                  string   `protobuf:"bytes,2,opt,name=details,proto3" json:"details,omitempty"`
-	CanonicalHyperparameters string   `protobuf:"bytes,3,opt,name=canonicalHyperparameters,proto3" json:"canonicalHyperparameters,omitempty"`
-	XXX_NoUnkeyedLiteral     struct{} `json:"-"`
-	XXX_unrecognized         []byte   `json:"-"`
-	XXX_sizecache            int32    `json:"-"`
+	ModelId                  string            `protobuf:"bytes,1,opt,name=modelId,proto3" json:"modelId,omitempty"`
+	Details                  string            `protobuf:"bytes,2,opt,name=details,proto3" json:"details,omitempty"`
+	CanonicalHyperparameters string            `protobuf:"bytes,3,opt,name=canonicalHyperparameters,proto3" json:"canonicalHyperparameters,omitempty"`
+	Labels                   map[string]string `protobuf:"bytes,4,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Card                     *ModelCard        `protobuf:"bytes,5,opt,name=card,proto3" json:"card,omitempty"`
+	Version                  int64             `protobuf:"varint,6,opt,name=version,proto3" json:"version,omitempty"`
+	XXX_NoUnkeyedLiteral     struct{}          `json:"-"`
+	XXX_unrecognized         []byte            `json:"-"`
+	XXX_sizecache            int32             `json:"-"`
 }
 
 func (m *GetModelResponse) Reset()         { *m = GetModelResponse{} }
 func (m *GetModelResponse) String() string { return proto.CompactTextString(m) }
 func (*GetModelResponse) ProtoMessage()    {}
 func (*GetModelResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_10d86afa5a89ec9d, []int{10}
+	return fileDescriptor_10d86afa5a89ec9d, []int{12}
 }
 
 func (m *GetModelResponse) XXX_Unmarshal(b []byte) error {
@@ -547,9 +881,33 @@ func (m *GetModelResponse) GetCanonicalHyperparameters() string {
 	return ""
 }
 
+func (m *GetModelResponse) GetLabels() map[string]string {
+	if m != nil {
+		return m.Labels
+	}
+	return nil
+}
+
+func (m *GetModelResponse) GetCard() *ModelCard {
+	if m != nil {
+		return m.Card
+	}
+	return nil
+}
+
+func (m *GetModelResponse) GetVersion() int64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
 type UpdateModelRequest struct {
-	ModelId              string   `protobuf:"bytes,1,opt,name=modelId,proto3" json:"modelId,omitempty"`
-	Model                *Model   `protobuf:"bytes,2,opt,name=model,proto3" json:"model,omitempty"`
+	ModelId string `protobuf:"bytes,1,opt,name=modelId,proto3" json:"modelId,omitempty"`
+	Model   *Model `protobuf:"bytes,2,opt,name=model,proto3" json:"model,omitempty"`
+	// If set, the update is only applied if the model is still at this version. Otherwise it fails
+	// with ABORTED, and the client should get the model again and retry.
+	ExpectedVersion      int64    `protobuf:"varint,3,opt,name=expectedVersion,proto3" json:"expectedVersion,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -559,7 +917,7 @@ func (m *UpdateModelRequest) Reset()         { *m = UpdateModelRequest{} }
 func (m *UpdateModelRequest) String() string { return proto.CompactTextString(m) }
 func (*UpdateModelRequest) ProtoMessage()    {}
 func (*UpdateModelRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_10d86afa5a89ec9d, []int{11}
+	return fileDescriptor_10d86afa5a89ec9d, []int{13}
 }
 
 func (m *UpdateModelRequest) XXX_Unmarshal(b []byte) error {
@@ -594,6 +952,13 @@ func (m *UpdateModelRequest) GetModel() *Model {
 	return nil
 }
 
+func (m *UpdateModelRequest) GetExpectedVersion() int64 {
+	if m != nil {
+		return m.ExpectedVersion
+	}
+	return 0
+}
+
 type UpdateModelResponse struct {
 	Model                *Model   `protobuf:"bytes,1,opt,name=model,proto3" json:"model,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -605,7 +970,7 @@ func (m *UpdateModelResponse) Reset()         { *m = UpdateModelResponse{} }
 func (m *UpdateModelResponse) String() string { return proto.CompactTextString(m) }
 func (*UpdateModelResponse) ProtoMessage()    {}
 func (*UpdateModelResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_10d86afa5a89ec9d, []int{12}
+	return fileDescriptor_10d86afa5a89ec9d, []int{14}
 }
 
 func (m *UpdateModelResponse) XXX_Unmarshal(b []byte) error {
@@ -633,89 +998,202 @@ func (m *UpdateModelResponse) GetModel() *Model {
 	return nil
 }
 
-type ListHyperparametersRequest struct {
+type DeleteModelRequest struct {
 	ModelId              string   `protobuf:"bytes,1,opt,name=modelId,proto3" json:"modelId,omitempty"`
-	Marker               string   `protobuf:"bytes,2,opt,name=marker,proto3" json:"marker,omitempty"`
-	MaxItems             int32    `protobuf:"varint,3,opt,name=maxItems,proto3" json:"maxItems,omitempty"`
+	Cascade              bool     `protobuf:"varint,2,opt,name=cascade,proto3" json:"cascade,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ListHyperparametersRequest) Reset()         { *m = ListHyperparametersRequest{} }
-func (m *ListHyperparametersRequest) String() string { return proto.CompactTextString(m) }
-func (*ListHyperparametersRequest) ProtoMessage()    {}
-func (*ListHyperparametersRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_10d86afa5a89ec9d, []int{13}
+func (m *DeleteModelRequest) Reset()         { *m = DeleteModelRequest{} }
+func (m *DeleteModelRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteModelRequest) ProtoMessage()    {}
+func (*DeleteModelRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_10d86afa5a89ec9d, []int{15}
 }
 
-func (m *ListHyperparametersRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ListHyperparametersRequest.Unmarshal(m, b)
+func (m *DeleteModelRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteModelRequest.Unmarshal(m, b)
 }
-func (m *ListHyperparametersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ListHyperparametersRequest.Marshal(b, m, deterministic)
+func (m *DeleteModelRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteModelRequest.Marshal(b, m, deterministic)
 }
-func (m *ListHyperparametersRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ListHyperparametersRequest.Merge(m, src)
+func (m *DeleteModelRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteModelRequest.Merge(m, src)
 }
-func (m *ListHyperparametersRequest) XXX_Size() int {
-	return xxx_messageInfo_ListHyperparametersRequest.Size(m)
+func (m *DeleteModelRequest) XXX_Size() int {
+	return xxx_messageInfo_DeleteModelRequest.Size(m)
 }
-func (m *ListHyperparametersRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ListHyperparametersRequest.DiscardUnknown(m)
+func (m *DeleteModelRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteModelRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ListHyperparametersRequest proto.InternalMessageInfo
+var xxx_messageInfo_DeleteModelRequest proto.InternalMessageInfo
 
-func (m *ListHyperparametersRequest) GetModelId() string {
+func (m *DeleteModelRequest) GetModelId() string {
 	if m != nil {
 		return m.ModelId
 	}
 	return ""
 }
 
-func (m *ListHyperparametersRequest) GetMarker() string {
-	if m != nil {
-		return m.Marker
-	}
-	return ""
-}
-
-func (m *ListHyperparametersRequest) GetMaxItems() int32 {
+func (m *DeleteModelRequest) GetCascade() bool {
 	if m != nil {
-		return m.MaxItems
+		return m.Cascade
 	}
-	return 0
+	return false
 }
 
-type ListHyperparametersResponse struct {
-	ModelId              string   `protobuf:"bytes,1,opt,name=modelId,proto3" json:"modelId,omitempty"`
-	HyperparametersIds   []string `protobuf:"bytes,2,rep,name=hyperparametersIds,proto3" json:"hyperparametersIds,omitempty"`
+type DeleteModelResponse struct {
+	ResourcePath         string   `protobuf:"bytes,1,opt,name=resourcePath,proto3" json:"resourcePath,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ListHyperparametersResponse) Reset()         { *m = ListHyperparametersResponse{} }
-func (m *ListHyperparametersResponse) String() string { return proto.CompactTextString(m) }
-func (*ListHyperparametersResponse) ProtoMessage()    {}
-func (*ListHyperparametersResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_10d86afa5a89ec9d, []int{14}
+func (m *DeleteModelResponse) Reset()         { *m = DeleteModelResponse{} }
+func (m *DeleteModelResponse) String() string { return proto.CompactTextString(m) }
+func (*DeleteModelResponse) ProtoMessage()    {}
+func (*DeleteModelResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_10d86afa5a89ec9d, []int{16}
 }
 
-func (m *ListHyperparametersResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ListHyperparametersResponse.Unmarshal(m, b)
+func (m *DeleteModelResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteModelResponse.Unmarshal(m, b)
 }
-func (m *ListHyperparametersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ListHyperparametersResponse.Marshal(b, m, deterministic)
+func (m *DeleteModelResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteModelResponse.Marshal(b, m, deterministic)
 }
-func (m *ListHyperparametersResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ListHyperparametersResponse.Merge(m, src)
+func (m *DeleteModelResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteModelResponse.Merge(m, src)
 }
-func (m *ListHyperparametersResponse) XXX_Size() int {
-	return xxx_messageInfo_ListHyperparametersResponse.Size(m)
+func (m *DeleteModelResponse) XXX_Size() int {
+	return xxx_messageInfo_DeleteModelResponse.Size(m)
 }
-func (m *ListHyperparametersResponse) XXX_DiscardUnknown() {
+func (m *DeleteModelResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteModelResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteModelResponse proto.InternalMessageInfo
+
+func (m *DeleteModelResponse) GetResourcePath() string {
+	if m != nil {
+		return m.ResourcePath
+	}
+	return ""
+}
+
+type ListHyperparametersRequest struct {
+	ModelId   string   `protobuf:"bytes,1,opt,name=modelId,proto3" json:"modelId,omitempty"`
+	Marker    string   `protobuf:"bytes,2,opt,name=marker,proto3" json:"marker,omitempty"`
+	MaxItems  int32    `protobuf:"varint,3,opt,name=maxItems,proto3" json:"maxItems,omitempty"`
+	PageToken string   `protobuf:"bytes,4,opt,name=pageToken,proto3" json:"pageToken,omitempty"`
+	View      ListView `protobuf:"varint,5,opt,name=view,proto3,enum=api.ListView" json:"view,omitempty"`
+	// Only list hyperparameters matching this expression, e.g. hyperparameters.batchSize>=32
+	Filter               string   `protobuf:"bytes,6,opt,name=filter,proto3" json:"filter,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListHyperparametersRequest) Reset()         { *m = ListHyperparametersRequest{} }
+func (m *ListHyperparametersRequest) String() string { return proto.CompactTextString(m) }
+func (*ListHyperparametersRequest) ProtoMessage()    {}
+func (*ListHyperparametersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_10d86afa5a89ec9d, []int{17}
+}
+
+func (m *ListHyperparametersRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListHyperparametersRequest.Unmarshal(m, b)
+}
+func (m *ListHyperparametersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListHyperparametersRequest.Marshal(b, m, deterministic)
+}
+func (m *ListHyperparametersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListHyperparametersRequest.Merge(m, src)
+}
+func (m *ListHyperparametersRequest) XXX_Size() int {
+	return xxx_messageInfo_ListHyperparametersRequest.Size(m)
+}
+func (m *ListHyperparametersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListHyperparametersRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListHyperparametersRequest proto.InternalMessageInfo
+
+func (m *ListHyperparametersRequest) GetModelId() string {
+	if m != nil {
+		return m.ModelId
+	}
+	return ""
+}
+
+func (m *ListHyperparametersRequest) GetMarker() string {
+	if m != nil {
+		return m.Marker
+	}
+	return ""
+}
+
+func (m *ListHyperparametersRequest) GetMaxItems() int32 {
+	if m != nil {
+		return m.MaxItems
+	}
+	return 0
+}
+
+func (m *ListHyperparametersRequest) GetPageToken() string {
+	if m != nil {
+		return m.PageToken
+	}
+	return ""
+}
+
+func (m *ListHyperparametersRequest) GetView() ListView {
+	if m != nil {
+		return m.View
+	}
+	return ListView_IDS
+}
+
+func (m *ListHyperparametersRequest) GetFilter() string {
+	if m != nil {
+		return m.Filter
+	}
+	return ""
+}
+
+type ListHyperparametersResponse struct {
+	ModelId              string                        `protobuf:"bytes,1,opt,name=modelId,proto3" json:"modelId,omitempty"`
+	HyperparametersIds   []string                      `protobuf:"bytes,2,rep,name=hyperparametersIds,proto3" json:"hyperparametersIds,omitempty"`
+	NextPageToken        string                        `protobuf:"bytes,3,opt,name=nextPageToken,proto3" json:"nextPageToken,omitempty"`
+	Hyperparameters      []*GetHyperparametersResponse `protobuf:"bytes,4,rep,name=hyperparameters,proto3" json:"hyperparameters,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                      `json:"-"`
+	XXX_unrecognized     []byte                        `json:"-"`
+	XXX_sizecache        int32                         `json:"-"`
+}
+
+func (m *ListHyperparametersResponse) Reset()         { *m = ListHyperparametersResponse{} }
+func (m *ListHyperparametersResponse) String() string { return proto.CompactTextString(m) }
+func (*ListHyperparametersResponse) ProtoMessage()    {}
+func (*ListHyperparametersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_10d86afa5a89ec9d, []int{18}
+}
+
+func (m *ListHyperparametersResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListHyperparametersResponse.Unmarshal(m, b)
+}
+func (m *ListHyperparametersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListHyperparametersResponse.Marshal(b, m, deterministic)
+}
+func (m *ListHyperparametersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListHyperparametersResponse.Merge(m, src)
+}
+func (m *ListHyperparametersResponse) XXX_Size() int {
+	return xxx_messageInfo_ListHyperparametersResponse.Size(m)
+}
+func (m *ListHyperparametersResponse) XXX_DiscardUnknown() {
 	xxx_messageInfo_ListHyperparametersResponse.DiscardUnknown(m)
 }
 
@@ -735,11 +1213,26 @@ func (m *ListHyperparametersResponse) GetHyperparametersIds() []string {
 	return nil
 }
 
+func (m *ListHyperparametersResponse) GetNextPageToken() string {
+	if m != nil {
+		return m.NextPageToken
+	}
+	return ""
+}
+
+func (m *ListHyperparametersResponse) GetHyperparameters() []*GetHyperparametersResponse {
+	if m != nil {
+		return m.Hyperparameters
+	}
+	return nil
+}
+
 type CreateHyperparametersRequest struct {
 	ModelId              string            `protobuf:"bytes,1,opt,name=modelId,proto3" json:"modelId,omitempty"`
 	HyperparametersId    string            `protobuf:"bytes,2,opt,name=hyperparametersId,proto3" json:"hyperparametersId,omitempty"`
 	CanonicalCheckpoint  string            `protobuf:"bytes,3,opt,name=canonicalCheckpoint,proto3" json:"canonicalCheckpoint,omitempty"`
 	Hyperparameters      map[string]string `protobuf:"bytes,4,rep,name=hyperparameters,proto3" json:"hyperparameters,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Labels               map[string]string `protobuf:"bytes,5,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
 	XXX_unrecognized     []byte            `json:"-"`
 	XXX_sizecache        int32             `json:"-"`
@@ -749,7 +1242,7 @@ func (m *CreateHyperparametersRequest) Reset()         { *m = CreateHyperparamet
 func (m *CreateHyperparametersRequest) String() string { return proto.CompactTextString(m) }
 func (*CreateHyperparametersRequest) ProtoMessage()    {}
 func (*CreateHyperparametersRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_10d86afa5a89ec9d, []int{15}
+	return fileDescriptor_10d86afa5a89ec9d, []int{19}
 }
 
 func (m *CreateHyperparametersRequest) XXX_Unmarshal(b []byte) error {
@@ -798,6 +1291,13 @@ func (m *CreateHyperparametersRequest) GetHyperparameters() map[string]string {
 	return nil
 }
 
+func (m *CreateHyperparametersRequest) GetLabels() map[string]string {
+	if m != nil {
+		return m.Labels
+	}
+	return nil
+}
+
 type CreateHyperparametersResponse struct {
 	ResourcePath         string   `protobuf:"bytes,1,opt,name=resourcePath,proto3" json:"resourcePath,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -809,7 +1309,7 @@ func (m *CreateHyperparametersResponse) Reset()         { *m = CreateHyperparame
 func (m *CreateHyperparametersResponse) String() string { return proto.CompactTextString(m) }
 func (*CreateHyperparametersResponse) ProtoMessage()    {}
 func (*CreateHyperparametersResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_10d86afa5a89ec9d, []int{16}
+	return fileDescriptor_10d86afa5a89ec9d, []int{20}
 }
 
 func (m *CreateHyperparametersResponse) XXX_Unmarshal(b []byte) error {
@@ -838,7 +1338,9 @@ func (m *CreateHyperparametersResponse) GetResourcePath() string {
 }
 
 type GetHyperparametersRequest struct {
-	ModelId              string   `protobuf:"bytes,1,opt,name=modelId,proto3" json:"modelId,omitempty"`
+	ModelId string `protobuf:"bytes,1,opt,name=modelId,proto3" json:"modelId,omitempty"`
+	// Either the ID of the hyperparameters or @ followed by the name of a tag on the model, e.g.
+	// @production
 	HyperparametersId    string   `protobuf:"bytes,2,opt,name=hyperparametersId,proto3" json:"hyperparametersId,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
@@ -849,7 +1351,7 @@ func (m *GetHyperparametersRequest) Reset()         { *m = GetHyperparametersReq
 func (m *GetHyperparametersRequest) String() string { return proto.CompactTextString(m) }
 func (*GetHyperparametersRequest) ProtoMessage()    {}
 func (*GetHyperparametersRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_10d86afa5a89ec9d, []int{17}
+	return fileDescriptor_10d86afa5a89ec9d, []int{21}
 }
 
 func (m *GetHyperparametersRequest) XXX_Unmarshal(b []byte) error {
@@ -885,21 +1387,26 @@ func (m *GetHyperparametersRequest) GetHyperparametersId() string {
 }
 
 type GetHyperparametersResponse struct {
-	ModelId              string            `protobuf:"bytes,1,opt,name=modelId,proto3" json:"modelId,omitempty"`
-	HyperparametersId    string            `protobuf:"bytes,2,opt,name=hyperparametersId,proto3" json:"hyperparametersId,omitempty"`
-	UpgradeTo            string            `protobuf:"bytes,3,opt,name=upgradeTo,proto3" json:"upgradeTo,omitempty"`
-	CanonicalCheckpoint  string            `protobuf:"bytes,4,opt,name=canonicalCheckpoint,proto3" json:"canonicalCheckpoint,omitempty"`
-	Hyperparameters      map[string]string `protobuf:"bytes,5,rep,name=hyperparameters,proto3" json:"hyperparameters,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
-	XXX_unrecognized     []byte            `json:"-"`
-	XXX_sizecache        int32             `json:"-"`
+	ModelId             string            `protobuf:"bytes,1,opt,name=modelId,proto3" json:"modelId,omitempty"`
+	HyperparametersId   string            `protobuf:"bytes,2,opt,name=hyperparametersId,proto3" json:"hyperparametersId,omitempty"`
+	UpgradeTo           string            `protobuf:"bytes,3,opt,name=upgradeTo,proto3" json:"upgradeTo,omitempty"`
+	CanonicalCheckpoint string            `protobuf:"bytes,4,opt,name=canonicalCheckpoint,proto3" json:"canonicalCheckpoint,omitempty"`
+	Hyperparameters     map[string]string `protobuf:"bytes,5,rep,name=hyperparameters,proto3" json:"hyperparameters,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Labels              map[string]string `protobuf:"bytes,6,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Incremented by every update. See UpdateHyperparametersRequest.expectedVersion.
+	Version int64 `protobuf:"varint,7,opt,name=version,proto3" json:"version,omitempty"`
+	// See RampRolloutRequest.rollout. Empty unless a rollout is in progress.
+	Rollout              []*RolloutTarget `protobuf:"bytes,8,rep,name=rollout,proto3" json:"rollout,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
 }
 
 func (m *GetHyperparametersResponse) Reset()         { *m = GetHyperparametersResponse{} }
 func (m *GetHyperparametersResponse) String() string { return proto.CompactTextString(m) }
 func (*GetHyperparametersResponse) ProtoMessage()    {}
 func (*GetHyperparametersResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_10d86afa5a89ec9d, []int{18}
+	return fileDescriptor_10d86afa5a89ec9d, []int{22}
 }
 
 func (m *GetHyperparametersResponse) XXX_Unmarshal(b []byte) error {
@@ -955,22 +1462,47 @@ func (m *GetHyperparametersResponse) GetHyperparameters() map[string]string {
 	return nil
 }
 
+func (m *GetHyperparametersResponse) GetLabels() map[string]string {
+	if m != nil {
+		return m.Labels
+	}
+	return nil
+}
+
+func (m *GetHyperparametersResponse) GetVersion() int64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *GetHyperparametersResponse) GetRollout() []*RolloutTarget {
+	if m != nil {
+		return m.Rollout
+	}
+	return nil
+}
+
 type UpdateHyperparametersRequest struct {
-	ModelId              string            `protobuf:"bytes,1,opt,name=modelId,proto3" json:"modelId,omitempty"`
-	HyperparametersId    string            `protobuf:"bytes,2,opt,name=hyperparametersId,proto3" json:"hyperparametersId,omitempty"`
-	UpgradeTo            string            `protobuf:"bytes,3,opt,name=upgradeTo,proto3" json:"upgradeTo,omitempty"`
-	CanonicalCheckpoint  string            `protobuf:"bytes,4,opt,name=canonicalCheckpoint,proto3" json:"canonicalCheckpoint,omitempty"`
-	Hyperparameters      map[string]string `protobuf:"bytes,5,rep,name=hyperparameters,proto3" json:"hyperparameters,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
-	XXX_unrecognized     []byte            `json:"-"`
-	XXX_sizecache        int32             `json:"-"`
+	ModelId             string            `protobuf:"bytes,1,opt,name=modelId,proto3" json:"modelId,omitempty"`
+	HyperparametersId   string            `protobuf:"bytes,2,opt,name=hyperparametersId,proto3" json:"hyperparametersId,omitempty"`
+	UpgradeTo           string            `protobuf:"bytes,3,opt,name=upgradeTo,proto3" json:"upgradeTo,omitempty"`
+	CanonicalCheckpoint string            `protobuf:"bytes,4,opt,name=canonicalCheckpoint,proto3" json:"canonicalCheckpoint,omitempty"`
+	Hyperparameters     map[string]string `protobuf:"bytes,5,rep,name=hyperparameters,proto3" json:"hyperparameters,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Labels              map[string]string `protobuf:"bytes,6,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// If set, the update is only applied if the hyperparameters are still at this version.
+	// Otherwise it fails with ABORTED, and the client should get them again and retry.
+	ExpectedVersion      int64    `protobuf:"varint,7,opt,name=expectedVersion,proto3" json:"expectedVersion,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *UpdateHyperparametersRequest) Reset()         { *m = UpdateHyperparametersRequest{} }
 func (m *UpdateHyperparametersRequest) String() string { return proto.CompactTextString(m) }
 func (*UpdateHyperparametersRequest) ProtoMessage()    {}
 func (*UpdateHyperparametersRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_10d86afa5a89ec9d, []int{19}
+	return fileDescriptor_10d86afa5a89ec9d, []int{23}
 }
 
 func (m *UpdateHyperparametersRequest) XXX_Unmarshal(b []byte) error {
@@ -1026,22 +1558,40 @@ func (m *UpdateHyperparametersRequest) GetHyperparameters() map[string]string {
 	return nil
 }
 
+func (m *UpdateHyperparametersRequest) GetLabels() map[string]string {
+	if m != nil {
+		return m.Labels
+	}
+	return nil
+}
+
+func (m *UpdateHyperparametersRequest) GetExpectedVersion() int64 {
+	if m != nil {
+		return m.ExpectedVersion
+	}
+	return 0
+}
+
 type UpdateHyperparametersResponse struct {
-	ModelId              string            `protobuf:"bytes,1,opt,name=modelId,proto3" json:"modelId,omitempty"`
-	HyperparametersId    string            `protobuf:"bytes,2,opt,name=hyperparametersId,proto3" json:"hyperparametersId,omitempty"`
-	UpgradeTo            string            `protobuf:"bytes,3,opt,name=upgradeTo,proto3" json:"upgradeTo,omitempty"`
-	CanonicalCheckpoint  string            `protobuf:"bytes,4,opt,name=canonicalCheckpoint,proto3" json:"canonicalCheckpoint,omitempty"`
-	Hyperparameters      map[string]string `protobuf:"bytes,5,rep,name=hyperparameters,proto3" json:"hyperparameters,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
-	XXX_unrecognized     []byte            `json:"-"`
-	XXX_sizecache        int32             `json:"-"`
+	ModelId             string            `protobuf:"bytes,1,opt,name=modelId,proto3" json:"modelId,omitempty"`
+	HyperparametersId   string            `protobuf:"bytes,2,opt,name=hyperparametersId,proto3" json:"hyperparametersId,omitempty"`
+	UpgradeTo           string            `protobuf:"bytes,3,opt,name=upgradeTo,proto3" json:"upgradeTo,omitempty"`
+	CanonicalCheckpoint string            `protobuf:"bytes,4,opt,name=canonicalCheckpoint,proto3" json:"canonicalCheckpoint,omitempty"`
+	Hyperparameters     map[string]string `protobuf:"bytes,5,rep,name=hyperparameters,proto3" json:"hyperparameters,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Labels              map[string]string `protobuf:"bytes,6,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Version             int64             `protobuf:"varint,7,opt,name=version,proto3" json:"version,omitempty"`
+	// See RampRolloutRequest.rollout. Empty unless a rollout is in progress.
+	Rollout              []*RolloutTarget `protobuf:"bytes,8,rep,name=rollout,proto3" json:"rollout,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
 }
 
 func (m *UpdateHyperparametersResponse) Reset()         { *m = UpdateHyperparametersResponse{} }
 func (m *UpdateHyperparametersResponse) String() string { return proto.CompactTextString(m) }
 func (*UpdateHyperparametersResponse) ProtoMessage()    {}
 func (*UpdateHyperparametersResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_10d86afa5a89ec9d, []int{20}
+	return fileDescriptor_10d86afa5a89ec9d, []int{24}
 }
 
 func (m *UpdateHyperparametersResponse) XXX_Unmarshal(b []byte) error {
@@ -1097,11 +1647,139 @@ func (m *UpdateHyperparametersResponse) GetHyperparameters() map[string]string {
 	return nil
 }
 
-type ListCheckpointsRequest struct {
+func (m *UpdateHyperparametersResponse) GetLabels() map[string]string {
+	if m != nil {
+		return m.Labels
+	}
+	return nil
+}
+
+func (m *UpdateHyperparametersResponse) GetVersion() int64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *UpdateHyperparametersResponse) GetRollout() []*RolloutTarget {
+	if m != nil {
+		return m.Rollout
+	}
+	return nil
+}
+
+type DeleteHyperparametersRequest struct {
 	ModelId              string   `protobuf:"bytes,1,opt,name=modelId,proto3" json:"modelId,omitempty"`
 	HyperparametersId    string   `protobuf:"bytes,2,opt,name=hyperparametersId,proto3" json:"hyperparametersId,omitempty"`
-	Marker               string   `protobuf:"bytes,3,opt,name=marker,proto3" json:"marker,omitempty"`
-	MaxItems             int32    `protobuf:"varint,4,opt,name=maxItems,proto3" json:"maxItems,omitempty"`
+	Cascade              bool     `protobuf:"varint,3,opt,name=cascade,proto3" json:"cascade,omitempty"`
+	Force                bool     `protobuf:"varint,4,opt,name=force,proto3" json:"force,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteHyperparametersRequest) Reset()         { *m = DeleteHyperparametersRequest{} }
+func (m *DeleteHyperparametersRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteHyperparametersRequest) ProtoMessage()    {}
+func (*DeleteHyperparametersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_10d86afa5a89ec9d, []int{25}
+}
+
+func (m *DeleteHyperparametersRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteHyperparametersRequest.Unmarshal(m, b)
+}
+func (m *DeleteHyperparametersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteHyperparametersRequest.Marshal(b, m, deterministic)
+}
+func (m *DeleteHyperparametersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteHyperparametersRequest.Merge(m, src)
+}
+func (m *DeleteHyperparametersRequest) XXX_Size() int {
+	return xxx_messageInfo_DeleteHyperparametersRequest.Size(m)
+}
+func (m *DeleteHyperparametersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteHyperparametersRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteHyperparametersRequest proto.InternalMessageInfo
+
+func (m *DeleteHyperparametersRequest) GetModelId() string {
+	if m != nil {
+		return m.ModelId
+	}
+	return ""
+}
+
+func (m *DeleteHyperparametersRequest) GetHyperparametersId() string {
+	if m != nil {
+		return m.HyperparametersId
+	}
+	return ""
+}
+
+func (m *DeleteHyperparametersRequest) GetCascade() bool {
+	if m != nil {
+		return m.Cascade
+	}
+	return false
+}
+
+func (m *DeleteHyperparametersRequest) GetForce() bool {
+	if m != nil {
+		return m.Force
+	}
+	return false
+}
+
+type DeleteHyperparametersResponse struct {
+	ResourcePath         string   `protobuf:"bytes,1,opt,name=resourcePath,proto3" json:"resourcePath,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteHyperparametersResponse) Reset()         { *m = DeleteHyperparametersResponse{} }
+func (m *DeleteHyperparametersResponse) String() string { return proto.CompactTextString(m) }
+func (*DeleteHyperparametersResponse) ProtoMessage()    {}
+func (*DeleteHyperparametersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_10d86afa5a89ec9d, []int{26}
+}
+
+func (m *DeleteHyperparametersResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteHyperparametersResponse.Unmarshal(m, b)
+}
+func (m *DeleteHyperparametersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteHyperparametersResponse.Marshal(b, m, deterministic)
+}
+func (m *DeleteHyperparametersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteHyperparametersResponse.Merge(m, src)
+}
+func (m *DeleteHyperparametersResponse) XXX_Size() int {
+	return xxx_messageInfo_DeleteHyperparametersResponse.Size(m)
+}
+func (m *DeleteHyperparametersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteHyperparametersResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteHyperparametersResponse proto.InternalMessageInfo
+
+func (m *DeleteHyperparametersResponse) GetResourcePath() string {
+	if m != nil {
+		return m.ResourcePath
+	}
+	return ""
+}
+
+type ListCheckpointsRequest struct {
+	ModelId           string   `protobuf:"bytes,1,opt,name=modelId,proto3" json:"modelId,omitempty"`
+	HyperparametersId string   `protobuf:"bytes,2,opt,name=hyperparametersId,proto3" json:"hyperparametersId,omitempty"`
+	Marker            string   `protobuf:"bytes,3,opt,name=marker,proto3" json:"marker,omitempty"`
+	MaxItems          int32    `protobuf:"varint,4,opt,name=maxItems,proto3" json:"maxItems,omitempty"`
+	IncludeArchived   bool     `protobuf:"varint,5,opt,name=includeArchived,proto3" json:"includeArchived,omitempty"`
+	PageToken         string   `protobuf:"bytes,6,opt,name=pageToken,proto3" json:"pageToken,omitempty"`
+	View              ListView `protobuf:"varint,7,opt,name=view,proto3,enum=api.ListView" json:"view,omitempty"`
+	// Only list checkpoints matching this expression, e.g. info.accuracy>0.9 AND label.stage=prod
+	Filter               string   `protobuf:"bytes,8,opt,name=filter,proto3" json:"filter,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -1111,7 +1789,7 @@ func (m *ListCheckpointsRequest) Reset()         { *m = ListCheckpointsRequest{}
 func (m *ListCheckpointsRequest) String() string { return proto.CompactTextString(m) }
 func (*ListCheckpointsRequest) ProtoMessage()    {}
 func (*ListCheckpointsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_10d86afa5a89ec9d, []int{21}
+	return fileDescriptor_10d86afa5a89ec9d, []int{27}
 }
 
 func (m *ListCheckpointsRequest) XXX_Unmarshal(b []byte) error {
@@ -1160,20 +1838,50 @@ func (m *ListCheckpointsRequest) GetMaxItems() int32 {
 	return 0
 }
 
-type ListCheckpointsResponse struct {
-	ModelId              string   `protobuf:"bytes,2,opt,name=modelId,proto3" json:"modelId,omitempty"`
-	HyperparametersId    string   `protobuf:"bytes,3,opt,name=hyperparametersId,proto3" json:"hyperparametersId,omitempty"`
-	CheckpointIds        []string `protobuf:"bytes,1,rep,name=checkpointIds,proto3" json:"checkpointIds,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *ListCheckpointsRequest) GetIncludeArchived() bool {
+	if m != nil {
+		return m.IncludeArchived
+	}
+	return false
 }
 
-func (m *ListCheckpointsResponse) Reset()         { *m = ListCheckpointsResponse{} }
-func (m *ListCheckpointsResponse) String() string { return proto.CompactTextString(m) }
-func (*ListCheckpointsResponse) ProtoMessage()    {}
-func (*ListCheckpointsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_10d86afa5a89ec9d, []int{22}
+func (m *ListCheckpointsRequest) GetPageToken() string {
+	if m != nil {
+		return m.PageToken
+	}
+	return ""
+}
+
+func (m *ListCheckpointsRequest) GetView() ListView {
+	if m != nil {
+		return m.View
+	}
+	return ListView_IDS
+}
+
+func (m *ListCheckpointsRequest) GetFilter() string {
+	if m != nil {
+		return m.Filter
+	}
+	return ""
+}
+
+type ListCheckpointsResponse struct {
+	ModelId              string                   `protobuf:"bytes,2,opt,name=modelId,proto3" json:"modelId,omitempty"`
+	HyperparametersId    string                   `protobuf:"bytes,3,opt,name=hyperparametersId,proto3" json:"hyperparametersId,omitempty"`
+	CheckpointIds        []string                 `protobuf:"bytes,1,rep,name=checkpointIds,proto3" json:"checkpointIds,omitempty"`
+	NextPageToken        string                   `protobuf:"bytes,4,opt,name=nextPageToken,proto3" json:"nextPageToken,omitempty"`
+	Checkpoints          []*GetCheckpointResponse `protobuf:"bytes,5,rep,name=checkpoints,proto3" json:"checkpoints,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
+}
+
+func (m *ListCheckpointsResponse) Reset()         { *m = ListCheckpointsResponse{} }
+func (m *ListCheckpointsResponse) String() string { return proto.CompactTextString(m) }
+func (*ListCheckpointsResponse) ProtoMessage()    {}
+func (*ListCheckpointsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_10d86afa5a89ec9d, []int{28}
 }
 
 func (m *ListCheckpointsResponse) XXX_Unmarshal(b []byte) error {
@@ -1215,22 +1923,45 @@ func (m *ListCheckpointsResponse) GetCheckpointIds() []string {
 	return nil
 }
 
+func (m *ListCheckpointsResponse) GetNextPageToken() string {
+	if m != nil {
+		return m.NextPageToken
+	}
+	return ""
+}
+
+func (m *ListCheckpointsResponse) GetCheckpoints() []*GetCheckpointResponse {
+	if m != nil {
+		return m.Checkpoints
+	}
+	return nil
+}
+
 type CreateCheckpointRequest struct {
-	ModelId              string            `protobuf:"bytes,1,opt,name=modelId,proto3" json:"modelId,omitempty"`
-	HyperparametersId    string            `protobuf:"bytes,2,opt,name=hyperparametersId,proto3" json:"hyperparametersId,omitempty"`
-	CheckpointId         string            `protobuf:"bytes,3,opt,name=checkpointId,proto3" json:"checkpointId,omitempty"`
-	Link                 string            `protobuf:"bytes,4,opt,name=link,proto3" json:"link,omitempty"`
-	Info                 map[string]string `protobuf:"bytes,5,rep,name=info,proto3" json:"info,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
-	XXX_unrecognized     []byte            `json:"-"`
-	XXX_sizecache        int32             `json:"-"`
+	ModelId           string            `protobuf:"bytes,1,opt,name=modelId,proto3" json:"modelId,omitempty"`
+	HyperparametersId string            `protobuf:"bytes,2,opt,name=hyperparametersId,proto3" json:"hyperparametersId,omitempty"`
+	CheckpointId      string            `protobuf:"bytes,3,opt,name=checkpointId,proto3" json:"checkpointId,omitempty"`
+	Link              string            `protobuf:"bytes,4,opt,name=link,proto3" json:"link,omitempty"`
+	Info              map[string]string `protobuf:"bytes,5,rep,name=info,proto3" json:"info,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Labels            map[string]string `protobuf:"bytes,6,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Instead of passing a link, ask for a URL to upload the checkpoint bundle to. The checkpoint
+	// stays PENDING, and out of ListCheckpoints, until FinalizeCheckpoint is called.
+	RequestUploadUrl bool `protobuf:"varint,7,opt,name=requestUploadUrl,proto3" json:"requestUploadUrl,omitempty"`
+	// Optional description of the bundle, returned by GetCheckpoint so that downloads can be
+	// verified. sha256 is the hex encoded SHA-256 digest of the bundle.
+	Sha256               string   `protobuf:"bytes,8,opt,name=sha256,proto3" json:"sha256,omitempty"`
+	SizeBytes            int64    `protobuf:"varint,9,opt,name=sizeBytes,proto3" json:"sizeBytes,omitempty"`
+	ContentType          string   `protobuf:"bytes,10,opt,name=contentType,proto3" json:"contentType,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *CreateCheckpointRequest) Reset()         { *m = CreateCheckpointRequest{} }
 func (m *CreateCheckpointRequest) String() string { return proto.CompactTextString(m) }
 func (*CreateCheckpointRequest) ProtoMessage()    {}
 func (*CreateCheckpointRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_10d86afa5a89ec9d, []int{23}
+	return fileDescriptor_10d86afa5a89ec9d, []int{29}
 }
 
 func (m *CreateCheckpointRequest) XXX_Unmarshal(b []byte) error {
@@ -1286,18 +2017,55 @@ func (m *CreateCheckpointRequest) GetInfo() map[string]string {
 	return nil
 }
 
+func (m *CreateCheckpointRequest) GetLabels() map[string]string {
+	if m != nil {
+		return m.Labels
+	}
+	return nil
+}
+
+func (m *CreateCheckpointRequest) GetRequestUploadUrl() bool {
+	if m != nil {
+		return m.RequestUploadUrl
+	}
+	return false
+}
+
+func (m *CreateCheckpointRequest) GetSha256() string {
+	if m != nil {
+		return m.Sha256
+	}
+	return ""
+}
+
+func (m *CreateCheckpointRequest) GetSizeBytes() int64 {
+	if m != nil {
+		return m.SizeBytes
+	}
+	return 0
+}
+
+func (m *CreateCheckpointRequest) GetContentType() string {
+	if m != nil {
+		return m.ContentType
+	}
+	return ""
+}
+
 type CreateCheckpointResponse struct {
-	ResourcePath         string   `protobuf:"bytes,1,opt,name=resourcePath,proto3" json:"resourcePath,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	ResourcePath         string               `protobuf:"bytes,1,opt,name=resourcePath,proto3" json:"resourcePath,omitempty"`
+	UploadUrl            string               `protobuf:"bytes,2,opt,name=uploadUrl,proto3" json:"uploadUrl,omitempty"`
+	UploadUrlExpiresAt   *timestamp.Timestamp `protobuf:"bytes,3,opt,name=uploadUrlExpiresAt,proto3" json:"uploadUrlExpiresAt,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
 }
 
 func (m *CreateCheckpointResponse) Reset()         { *m = CreateCheckpointResponse{} }
 func (m *CreateCheckpointResponse) String() string { return proto.CompactTextString(m) }
 func (*CreateCheckpointResponse) ProtoMessage()    {}
 func (*CreateCheckpointResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_10d86afa5a89ec9d, []int{24}
+	return fileDescriptor_10d86afa5a89ec9d, []int{30}
 }
 
 func (m *CreateCheckpointResponse) XXX_Unmarshal(b []byte) error {
@@ -1325,7 +2093,21 @@ func (m *CreateCheckpointResponse) GetResourcePath() string {
 	return ""
 }
 
-type GetCheckpointRequest struct {
+func (m *CreateCheckpointResponse) GetUploadUrl() string {
+	if m != nil {
+		return m.UploadUrl
+	}
+	return ""
+}
+
+func (m *CreateCheckpointResponse) GetUploadUrlExpiresAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.UploadUrlExpiresAt
+	}
+	return nil
+}
+
+type FinalizeCheckpointRequest struct {
 	ModelId              string   `protobuf:"bytes,1,opt,name=modelId,proto3" json:"modelId,omitempty"`
 	HyperparametersId    string   `protobuf:"bytes,2,opt,name=hyperparametersId,proto3" json:"hyperparametersId,omitempty"`
 	CheckpointId         string   `protobuf:"bytes,3,opt,name=checkpointId,proto3" json:"checkpointId,omitempty"`
@@ -1334,11 +2116,109 @@ type GetCheckpointRequest struct {
 	XXX_sizecache        int32    `json:"-"`
 }
 
+func (m *FinalizeCheckpointRequest) Reset()         { *m = FinalizeCheckpointRequest{} }
+func (m *FinalizeCheckpointRequest) String() string { return proto.CompactTextString(m) }
+func (*FinalizeCheckpointRequest) ProtoMessage()    {}
+func (*FinalizeCheckpointRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_10d86afa5a89ec9d, []int{31}
+}
+
+func (m *FinalizeCheckpointRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FinalizeCheckpointRequest.Unmarshal(m, b)
+}
+func (m *FinalizeCheckpointRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FinalizeCheckpointRequest.Marshal(b, m, deterministic)
+}
+func (m *FinalizeCheckpointRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FinalizeCheckpointRequest.Merge(m, src)
+}
+func (m *FinalizeCheckpointRequest) XXX_Size() int {
+	return xxx_messageInfo_FinalizeCheckpointRequest.Size(m)
+}
+func (m *FinalizeCheckpointRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_FinalizeCheckpointRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FinalizeCheckpointRequest proto.InternalMessageInfo
+
+func (m *FinalizeCheckpointRequest) GetModelId() string {
+	if m != nil {
+		return m.ModelId
+	}
+	return ""
+}
+
+func (m *FinalizeCheckpointRequest) GetHyperparametersId() string {
+	if m != nil {
+		return m.HyperparametersId
+	}
+	return ""
+}
+
+func (m *FinalizeCheckpointRequest) GetCheckpointId() string {
+	if m != nil {
+		return m.CheckpointId
+	}
+	return ""
+}
+
+type FinalizeCheckpointResponse struct {
+	Checkpoint           *GetCheckpointResponse `protobuf:"bytes,1,opt,name=checkpoint,proto3" json:"checkpoint,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
+}
+
+func (m *FinalizeCheckpointResponse) Reset()         { *m = FinalizeCheckpointResponse{} }
+func (m *FinalizeCheckpointResponse) String() string { return proto.CompactTextString(m) }
+func (*FinalizeCheckpointResponse) ProtoMessage()    {}
+func (*FinalizeCheckpointResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_10d86afa5a89ec9d, []int{32}
+}
+
+func (m *FinalizeCheckpointResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FinalizeCheckpointResponse.Unmarshal(m, b)
+}
+func (m *FinalizeCheckpointResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FinalizeCheckpointResponse.Marshal(b, m, deterministic)
+}
+func (m *FinalizeCheckpointResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FinalizeCheckpointResponse.Merge(m, src)
+}
+func (m *FinalizeCheckpointResponse) XXX_Size() int {
+	return xxx_messageInfo_FinalizeCheckpointResponse.Size(m)
+}
+func (m *FinalizeCheckpointResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_FinalizeCheckpointResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FinalizeCheckpointResponse proto.InternalMessageInfo
+
+func (m *FinalizeCheckpointResponse) GetCheckpoint() *GetCheckpointResponse {
+	if m != nil {
+		return m.Checkpoint
+	}
+	return nil
+}
+
+type GetCheckpointRequest struct {
+	ModelId string `protobuf:"bytes,1,opt,name=modelId,proto3" json:"modelId,omitempty"`
+	// As in GetHyperparametersRequest
+	HyperparametersId string `protobuf:"bytes,2,opt,name=hyperparametersId,proto3" json:"hyperparametersId,omitempty"`
+	// Either the ID of the checkpoint or @ followed by the name of a tag on the hyperparameters
+	CheckpointId string `protobuf:"bytes,3,opt,name=checkpointId,proto3" json:"checkpointId,omitempty"`
+	// Return the stored link even if the server is configured to hand out signed download URLs
+	RawLink              bool     `protobuf:"varint,4,opt,name=rawLink,proto3" json:"rawLink,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
 func (m *GetCheckpointRequest) Reset()         { *m = GetCheckpointRequest{} }
 func (m *GetCheckpointRequest) String() string { return proto.CompactTextString(m) }
 func (*GetCheckpointRequest) ProtoMessage()    {}
 func (*GetCheckpointRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_10d86afa5a89ec9d, []int{25}
+	return fileDescriptor_10d86afa5a89ec9d, []int{33}
 }
 
 func (m *GetCheckpointRequest) XXX_Unmarshal(b []byte) error {
@@ -1380,6 +2260,13 @@ func (m *GetCheckpointRequest) GetCheckpointId() string {
 	return ""
 }
 
+func (m *GetCheckpointRequest) GetRawLink() bool {
+	if m != nil {
+		return m.RawLink
+	}
+	return false
+}
+
 type GetCheckpointResponse struct {
 	ModelId              string               `protobuf:"bytes,1,opt,name=modelId,proto3" json:"modelId,omitempty"`
 	HyperparametersId    string               `protobuf:"bytes,2,opt,name=hyperparametersId,proto3" json:"hyperparametersId,omitempty"`
@@ -1387,6 +2274,11 @@ type GetCheckpointResponse struct {
 	Link                 string               `protobuf:"bytes,4,opt,name=link,proto3" json:"link,omitempty"`
 	CreatedAt            *timestamp.Timestamp `protobuf:"bytes,5,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
 	Info                 map[string]string    `protobuf:"bytes,6,rep,name=info,proto3" json:"info,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	State                CheckpointState      `protobuf:"varint,7,opt,name=state,proto3,enum=api.CheckpointState" json:"state,omitempty"`
+	Labels               map[string]string    `protobuf:"bytes,8,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Sha256               string               `protobuf:"bytes,9,opt,name=sha256,proto3" json:"sha256,omitempty"`
+	SizeBytes            int64                `protobuf:"varint,10,opt,name=sizeBytes,proto3" json:"sizeBytes,omitempty"`
+	ContentType          string               `protobuf:"bytes,11,opt,name=contentType,proto3" json:"contentType,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
 	XXX_unrecognized     []byte               `json:"-"`
 	XXX_sizecache        int32                `json:"-"`
@@ -1396,7 +2288,7 @@ func (m *GetCheckpointResponse) Reset()         { *m = GetCheckpointResponse{} }
 func (m *GetCheckpointResponse) String() string { return proto.CompactTextString(m) }
 func (*GetCheckpointResponse) ProtoMessage()    {}
 func (*GetCheckpointResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_10d86afa5a89ec9d, []int{26}
+	return fileDescriptor_10d86afa5a89ec9d, []int{34}
 }
 
 func (m *GetCheckpointResponse) XXX_Unmarshal(b []byte) error {
@@ -1459,537 +2351,4716 @@ func (m *GetCheckpointResponse) GetInfo() map[string]string {
 	return nil
 }
 
-func init() {
-	proto.RegisterEnum("api.HealthCheckResponse_ServingStatus", HealthCheckResponse_ServingStatus_name, HealthCheckResponse_ServingStatus_value)
-	proto.RegisterEnum("api.ConfigResponse_BackendType", ConfigResponse_BackendType_name, ConfigResponse_BackendType_value)
-	proto.RegisterType((*HealthCheckRequest)(nil), "api.HealthCheckRequest")
-	proto.RegisterType((*HealthCheckResponse)(nil), "api.HealthCheckResponse")
-	proto.RegisterType((*ConfigRequest)(nil), "api.ConfigRequest")
-	proto.RegisterType((*ConfigResponse)(nil), "api.ConfigResponse")
-	proto.RegisterType((*Model)(nil), "api.Model")
-	proto.RegisterType((*ListModelsRequest)(nil), "api.ListModelsRequest")
-	proto.RegisterType((*ListModelsResponse)(nil), "api.ListModelsResponse")
-	proto.RegisterType((*CreateModelRequest)(nil), "api.CreateModelRequest")
-	proto.RegisterType((*CreateModelResponse)(nil), "api.CreateModelResponse")
-	proto.RegisterType((*GetModelRequest)(nil), "api.GetModelRequest")
-	proto.RegisterType((*GetModelResponse)(nil), "api.GetModelResponse")
-	proto.RegisterType((*UpdateModelRequest)(nil), "api.UpdateModelRequest")
-	proto.RegisterType((*UpdateModelResponse)(nil), "api.UpdateModelResponse")
-	proto.RegisterType((*ListHyperparametersRequest)(nil), "api.ListHyperparametersRequest")
-	proto.RegisterType((*ListHyperparametersResponse)(nil), "api.ListHyperparametersResponse")
-	proto.RegisterType((*CreateHyperparametersRequest)(nil), "api.CreateHyperparametersRequest")
-	proto.RegisterMapType((map[string]string)(nil), "api.CreateHyperparametersRequest.HyperparametersEntry")
-	proto.RegisterType((*CreateHyperparametersResponse)(nil), "api.CreateHyperparametersResponse")
-	proto.RegisterType((*GetHyperparametersRequest)(nil), "api.GetHyperparametersRequest")
-	proto.RegisterType((*GetHyperparametersResponse)(nil), "api.GetHyperparametersResponse")
-	proto.RegisterMapType((map[string]string)(nil), "api.GetHyperparametersResponse.HyperparametersEntry")
-	proto.RegisterType((*UpdateHyperparametersRequest)(nil), "api.UpdateHyperparametersRequest")
-	proto.RegisterMapType((map[string]string)(nil), "api.UpdateHyperparametersRequest.HyperparametersEntry")
-	proto.RegisterType((*UpdateHyperparametersResponse)(nil), "api.UpdateHyperparametersResponse")
-	proto.RegisterMapType((map[string]string)(nil), "api.UpdateHyperparametersResponse.HyperparametersEntry")
-	proto.RegisterType((*ListCheckpointsRequest)(nil), "api.ListCheckpointsRequest")
-	proto.RegisterType((*ListCheckpointsResponse)(nil), "api.ListCheckpointsResponse")
-	proto.RegisterType((*CreateCheckpointRequest)(nil), "api.CreateCheckpointRequest")
-	proto.RegisterMapType((map[string]string)(nil), "api.CreateCheckpointRequest.InfoEntry")
-	proto.RegisterType((*CreateCheckpointResponse)(nil), "api.CreateCheckpointResponse")
-	proto.RegisterType((*GetCheckpointRequest)(nil), "api.GetCheckpointRequest")
-	proto.RegisterType((*GetCheckpointResponse)(nil), "api.GetCheckpointResponse")
-	proto.RegisterMapType((map[string]string)(nil), "api.GetCheckpointResponse.InfoEntry")
+func (m *GetCheckpointResponse) GetState() CheckpointState {
+	if m != nil {
+		return m.State
+	}
+	return CheckpointState_ACTIVE
 }
 
-func init() { proto.RegisterFile("repository.proto", fileDescriptor_10d86afa5a89ec9d) }
-
-var fileDescriptor_10d86afa5a89ec9d = []byte{
-	// 1344 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x57, 0xcd, 0x6e, 0xdb, 0x46,
-	0x10, 0x0e, 0x29, 0x4b, 0x89, 0x47, 0x71, 0xac, 0xac, 0xfc, 0xa3, 0x30, 0x72, 0xec, 0x2c, 0x82,
-	0x34, 0x48, 0x0b, 0x32, 0x71, 0x83, 0x38, 0xd5, 0x21, 0xa8, 0xa3, 0x08, 0xb2, 0x10, 0x5b, 0x72,
-	0x69, 0x39, 0x41, 0x8b, 0x20, 0x36, 0x2d, 0xad, 0x65, 0xd6, 0x12, 0xc9, 0x92, 0x94, 0x5b, 0xd7,
-	0xf0, 0xc5, 0x97, 0xde, 0x0a, 0x04, 0x6d, 0x2f, 0x3d, 0x16, 0xe8, 0xa9, 0x40, 0x1e, 0xa1, 0xa7,
-	0xbe, 0x41, 0x2f, 0x3d, 0x04, 0x3d, 0xf5, 0x41, 0x0a, 0x2e, 0x57, 0x3f, 0xfc, 0x93, 0xaa, 0x42,
-	0x71, 0x7b, 0xe3, 0xee, 0xcc, 0xce, 0x7c, 0x3b, 0xf3, 0x71, 0x67, 0x06, 0x52, 0x26, 0x31, 0x74,
-	0x4b, 0xb5, 0x75, 0xf3, 0x58, 0x34, 0x4c, 0xdd, 0xd6, 0x51, 0x4c, 0x31, 0x54, 0x21, 0xdb, 0xd0,
-	0xf5, 0x46, 0x93, 0x48, 0x8a, 0xa1, 0x4a, 0x8a, 0xa6, 0xe9, 0xb6, 0x62, 0xab, 0xba, 0x66, 0xb9,
-	0x2a, 0xc2, 0x22, 0x93, 0xd2, 0xd5, 0x5e, 0x7b, 0x5f, 0xb2, 0xd5, 0x16, 0xb1, 0x6c, 0xa5, 0x65,
-	0xb8, 0x0a, 0x58, 0x04, 0xb4, 0x46, 0x94, 0xa6, 0x7d, 0x90, 0x3f, 0x20, 0xb5, 0x43, 0x99, 0x7c,
-	0xd1, 0x26, 0x96, 0x8d, 0x32, 0x70, 0xd1, 0x22, 0xe6, 0x91, 0x5a, 0x23, 0x19, 0x6e, 0x89, 0xbb,
-	0x33, 0x29, 0x77, 0x96, 0xf8, 0x35, 0x07, 0x69, 0xcf, 0x01, 0xcb, 0xd0, 0x35, 0x8b, 0xa0, 0xc7,
-	0x90, 0xb0, 0x6c, 0xc5, 0x6e, 0x5b, 0xf4, 0xc0, 0x95, 0xe5, 0xdb, 0xa2, 0x62, 0xa8, 0x62, 0x88,
-	0xa6, 0xb8, 0xe5, 0x58, 0xd2, 0x1a, 0x5b, 0x54, 0x5b, 0x66, 0xa7, 0x70, 0x0e, 0xa6, 0x3c, 0x02,
-	0x94, 0x84, 0x8b, 0xdb, 0xe5, 0x67, 0xe5, 0xca, 0x8b, 0x72, 0xea, 0x82, 0xb3, 0xd8, 0x2a, 0xc8,
-	0xcf, 0x4b, 0xe5, 0x62, 0x8a, 0x43, 0xd3, 0x90, 0x2c, 0x57, 0xaa, 0x3b, 0x9d, 0x0d, 0x1e, 0x4f,
-	0xc3, 0x54, 0x5e, 0xd7, 0xf6, 0xd5, 0x06, 0x83, 0x8f, 0x7f, 0xe0, 0xe0, 0x4a, 0x67, 0x87, 0xe1,
-	0x5b, 0x85, 0xe4, 0x9e, 0x52, 0x3b, 0x24, 0x5a, 0xbd, 0x7a, 0x6c, 0x10, 0x06, 0x72, 0x91, 0x82,
-	0xf4, 0x6a, 0x8a, 0x4f, 0x7a, 0x6a, 0x72, 0xff, 0x19, 0xfc, 0x31, 0x24, 0xfb, 0x64, 0x0e, 0xa6,
-	0x52, 0xf9, 0xf9, 0xea, 0x7a, 0xe9, 0x69, 0xea, 0x02, 0x02, 0x48, 0x6c, 0x14, 0x36, 0x2a, 0xf2,
-	0xa7, 0x29, 0x0e, 0x65, 0x60, 0xa6, 0x58, 0xa9, 0x14, 0xd7, 0x0b, 0x3b, 0xf9, 0xf5, 0xca, 0xf6,
-	0xd3, 0x9d, 0xad, 0x6a, 0x45, 0x5e, 0x2d, 0x16, 0x52, 0x3c, 0xfe, 0x12, 0xe2, 0x1b, 0x7a, 0x9d,
-	0x34, 0x9d, 0xf8, 0xb6, 0x9c, 0x8f, 0x52, 0xbd, 0x13, 0x5f, 0xb6, 0x74, 0x24, 0x75, 0x62, 0x2b,
-	0x6a, 0xd3, 0xca, 0xf0, 0xae, 0x84, 0x2d, 0x51, 0x0e, 0x32, 0x35, 0x45, 0xd3, 0x35, 0xb5, 0xa6,
-	0x34, 0xd7, 0x8e, 0x0d, 0x62, 0x1a, 0x8a, 0xa9, 0xb4, 0x88, 0x4d, 0x4c, 0x2b, 0x13, 0xa3, 0xaa,
-	0x91, 0x72, 0x5c, 0x84, 0xab, 0xeb, 0xaa, 0x65, 0x53, 0xe7, 0x56, 0x27, 0xc9, 0x73, 0x90, 0x68,
-	0x29, 0xe6, 0x21, 0x31, 0x19, 0x06, 0xb6, 0x42, 0x02, 0x5c, 0x6a, 0x29, 0x5f, 0x95, 0x6c, 0xd2,
-	0x72, 0x31, 0xc4, 0xe5, 0xee, 0x1a, 0xdf, 0x03, 0xd4, 0x6f, 0x88, 0x05, 0xd7, 0x39, 0xe1, 0xe2,
-	0x77, 0xd2, 0x1f, 0xbb, 0x33, 0x29, 0x77, 0xd7, 0xf8, 0x21, 0xa0, 0xbc, 0x49, 0x14, 0x9b, 0xd0,
-	0x33, 0x1d, 0xdf, 0x4b, 0x10, 0xa7, 0x1a, 0xd4, 0x75, 0x72, 0x19, 0x68, 0x22, 0x5c, 0x0d, 0x57,
-	0x80, 0x3f, 0x82, 0xb4, 0xe7, 0x1c, 0x73, 0x85, 0xe1, 0xb2, 0x49, 0x2c, 0xbd, 0x6d, 0xd6, 0xc8,
-	0xa6, 0x62, 0x1f, 0x30, 0xe8, 0x9e, 0x3d, 0xfc, 0x3e, 0x4c, 0x17, 0x89, 0xed, 0xf1, 0x17, 0x19,
-	0x70, 0x7c, 0xc6, 0x41, 0xaa, 0xa7, 0xcd, 0xbc, 0x9c, 0x77, 0x7e, 0x36, 0x01, 0x6d, 0x1b, 0x75,
-	0x7f, 0x90, 0xa2, 0x51, 0x74, 0xc3, 0xc7, 0x47, 0x85, 0x6f, 0x05, 0xd2, 0x1e, 0x8b, 0xec, 0x62,
-	0xc3, 0xe3, 0xfe, 0x39, 0x08, 0x4e, 0x86, 0x7d, 0x08, 0x87, 0x43, 0xea, 0xb1, 0x89, 0x8f, 0x64,
-	0x53, 0xcc, 0xc7, 0xa6, 0x06, 0x5c, 0x0f, 0xf5, 0x35, 0x34, 0x0b, 0x22, 0xa0, 0x03, 0xef, 0x21,
-	0x87, 0x7a, 0x3c, 0xa5, 0x5e, 0x88, 0x04, 0xff, 0xca, 0x43, 0xd6, 0x65, 0xd3, 0xc8, 0xf7, 0xfa,
-	0x00, 0xae, 0x06, 0x0c, 0xb2, 0x2b, 0x06, 0x05, 0xe8, 0x1e, 0xa4, 0xbb, 0x49, 0xa6, 0xcf, 0x9e,
-	0xa1, 0xab, 0x9a, 0xcd, 0xf2, 0x1f, 0x26, 0x42, 0xbb, 0x30, 0xed, 0x33, 0x93, 0x99, 0x58, 0x8a,
-	0xdd, 0x49, 0x2e, 0x3f, 0x74, 0x1f, 0xa7, 0x01, 0xa8, 0x45, 0xdf, 0x76, 0x41, 0xb3, 0xcd, 0x63,
-	0xd9, 0x6f, 0x4e, 0x78, 0x02, 0x33, 0x61, 0x8a, 0x28, 0x05, 0xb1, 0x43, 0x72, 0xcc, 0xee, 0xeb,
-	0x7c, 0xa2, 0x19, 0x88, 0x1f, 0x29, 0xcd, 0x36, 0x61, 0xf7, 0x73, 0x17, 0x39, 0xfe, 0x11, 0x87,
-	0xf3, 0xb0, 0x10, 0x81, 0x64, 0x84, 0xff, 0xb2, 0x06, 0xd7, 0x8a, 0xc4, 0x7e, 0xb7, 0x19, 0xc0,
-	0x7f, 0xf0, 0x20, 0x84, 0x79, 0x19, 0xca, 0xa9, 0xd1, 0x12, 0x9d, 0x85, 0xc9, 0xb6, 0xd1, 0x30,
-	0x95, 0x3a, 0xa9, 0xea, 0x2c, 0xbd, 0xbd, 0x8d, 0x28, 0x1a, 0x4c, 0x44, 0xd3, 0xe0, 0x55, 0x90,
-	0x06, 0x71, 0x4a, 0x83, 0x07, 0x94, 0x06, 0xd1, 0x37, 0x3a, 0x47, 0x12, 0xbc, 0xe5, 0x21, 0xeb,
-	0x3e, 0x2a, 0xef, 0xf8, 0x2f, 0x1a, 0x77, 0x70, 0x77, 0xa3, 0x82, 0xeb, 0xfe, 0x63, 0x83, 0xee,
-	0x74, 0x8e, 0xe1, 0xfd, 0x93, 0x87, 0x85, 0x08, 0x28, 0xff, 0x73, 0xf2, 0x2a, 0x51, 0xf1, 0x5d,
-	0x19, 0x14, 0xdf, 0x73, 0xe7, 0xef, 0xf7, 0x1c, 0xcc, 0x39, 0xf5, 0xa6, 0x87, 0x7c, 0xec, 0xcc,
-	0xed, 0x55, 0xc1, 0x58, 0x64, 0x15, 0x9c, 0xf0, 0x55, 0xc1, 0x6f, 0x38, 0x98, 0x0f, 0xc0, 0x0a,
-	0x66, 0x9c, 0xff, 0x07, 0xb8, 0x62, 0x51, 0xb8, 0x6e, 0xc1, 0x54, 0xad, 0x6b, 0xbe, 0xd7, 0xa6,
-	0x79, 0x37, 0xf1, 0xb7, 0x3c, 0xcc, 0xbb, 0xcf, 0x7c, 0x0f, 0xcb, 0xb8, 0x23, 0x84, 0xe1, 0x72,
-	0xbf, 0x53, 0x06, 0xd9, 0xb3, 0x87, 0x10, 0x4c, 0x34, 0x55, 0xed, 0x90, 0x51, 0x8e, 0x7e, 0xa3,
-	0x1c, 0x4c, 0xa8, 0xda, 0xbe, 0xce, 0x88, 0x75, 0xbb, 0xaf, 0x38, 0x06, 0xb0, 0x8a, 0x25, 0x6d,
-	0x5f, 0x77, 0x79, 0x44, 0xcf, 0x08, 0x2b, 0x30, 0xd9, 0xdd, 0x1a, 0x89, 0x31, 0x8f, 0x21, 0x13,
-	0xf4, 0x31, 0x42, 0xc5, 0x3b, 0xe3, 0x60, 0xa6, 0x48, 0xec, 0xff, 0x34, 0x9a, 0xf8, 0x37, 0x1e,
-	0x66, 0x7d, 0x20, 0xc6, 0xfc, 0x9e, 0xfc, 0xdb, 0x9c, 0x3e, 0x82, 0xc9, 0x1a, 0x0d, 0x6f, 0x7d,
-	0xd5, 0xce, 0xc4, 0x69, 0x47, 0x2a, 0x88, 0xee, 0xc4, 0x2a, 0x76, 0x26, 0x56, 0xb1, 0xda, 0x99,
-	0x58, 0xe5, 0x9e, 0x32, 0x7a, 0xc4, 0xd8, 0x90, 0xa0, 0x6c, 0xb8, 0xd5, 0xa9, 0x91, 0xc1, 0x3b,
-	0x8e, 0x8d, 0x0b, 0xcb, 0x6f, 0xa7, 0x00, 0xe4, 0xee, 0x08, 0x8e, 0x5e, 0xc2, 0x45, 0x77, 0xba,
-	0xfd, 0x1a, 0xcd, 0x07, 0x67, 0x5d, 0x9a, 0x65, 0x21, 0x13, 0x35, 0x04, 0xe3, 0x1b, 0x67, 0xbf,
-	0xff, 0xf5, 0x1d, 0x9f, 0x41, 0x73, 0xd2, 0xd1, 0x7d, 0xa9, 0x37, 0xd8, 0x4b, 0x07, 0xcc, 0xe4,
-	0x26, 0x24, 0xdc, 0xb1, 0x14, 0x21, 0xcf, 0x8c, 0xea, 0xda, 0x4d, 0x87, 0xcc, 0xad, 0x78, 0x81,
-	0x9a, 0x9c, 0x47, 0xb3, 0x3e, 0x93, 0x35, 0xd7, 0xce, 0x4b, 0x80, 0xde, 0xe4, 0x86, 0xe6, 0xa8,
-	0x85, 0xc0, 0x4c, 0x28, 0xcc, 0x07, 0xf6, 0x87, 0x58, 0x6f, 0xb9, 0xf6, 0xf6, 0x20, 0xd9, 0x37,
-	0xad, 0xb1, 0x88, 0x04, 0xe7, 0x3e, 0x16, 0x91, 0x90, 0xc1, 0x0e, 0x2f, 0x51, 0x07, 0x02, 0x0e,
-	0x77, 0x90, 0xe3, 0xee, 0xa2, 0x5d, 0xb8, 0xd4, 0x19, 0xd4, 0xd0, 0x4c, 0x27, 0xe3, 0x1e, 0xeb,
-	0xb3, 0xbe, 0x5d, 0x66, 0xfa, 0x3d, 0x6a, 0xfa, 0x26, 0x5a, 0x0c, 0x35, 0x2d, 0x9d, 0x30, 0xd2,
-	0x9f, 0xa2, 0x26, 0x24, 0xfb, 0x86, 0x26, 0x76, 0x8b, 0xe0, 0x60, 0xc6, 0x6e, 0x11, 0x32, 0x5f,
-	0xe1, 0xbb, 0xd4, 0xd5, 0x2d, 0x61, 0x98, 0x2b, 0xe7, 0x3e, 0xaf, 0x39, 0x48, 0x87, 0x8c, 0x3f,
-	0x68, 0xb1, 0x9b, 0x83, 0xf0, 0x96, 0x44, 0x58, 0x8a, 0x56, 0x60, 0x30, 0x56, 0x28, 0x8c, 0xfb,
-	0x48, 0x1a, 0x02, 0x43, 0xf2, 0xfd, 0xcb, 0xe8, 0x47, 0x0e, 0x66, 0x43, 0x1b, 0x7d, 0x74, 0x73,
-	0xe8, 0x38, 0x22, 0xe0, 0x41, 0x2a, 0x0c, 0x59, 0x8e, 0x22, 0x7b, 0x80, 0x47, 0x45, 0xe6, 0x04,
-	0xec, 0x27, 0x0e, 0x50, 0xb0, 0x11, 0x46, 0x37, 0x22, 0x3b, 0x64, 0x17, 0xd6, 0xe2, 0x90, 0x0e,
-	0x1a, 0x3f, 0xa3, 0x98, 0x0a, 0x28, 0x3f, 0x22, 0x26, 0xe9, 0x24, 0xf0, 0x14, 0x9e, 0xa2, 0x37,
-	0x1c, 0xcc, 0x86, 0x36, 0x3c, 0x2c, 0x82, 0x83, 0x9a, 0x4d, 0x16, 0xc1, 0x81, 0xfd, 0x12, 0x2e,
-	0x53, 0xb4, 0x6b, 0xc2, 0x38, 0xd0, 0x3a, 0x51, 0xfd, 0x99, 0x83, 0x69, 0x5f, 0xfb, 0x81, 0xae,
-	0x77, 0x19, 0x16, 0xec, 0x95, 0x84, 0x6c, 0xb8, 0x90, 0xc1, 0x7b, 0x41, 0xe1, 0x7d, 0x82, 0x2a,
-	0x63, 0x80, 0x27, 0xd5, 0xfa, 0x30, 0xfd, 0xc2, 0x41, 0xca, 0x5f, 0x8c, 0x51, 0x76, 0x50, 0x1f,
-	0x20, 0x2c, 0x44, 0x48, 0x19, 0xd4, 0xcf, 0x28, 0xd4, 0x2a, 0x1e, 0x37, 0x54, 0x27, 0xaa, 0x6f,
-	0x38, 0x98, 0xf2, 0x14, 0x24, 0x74, 0x2d, 0xac, 0x48, 0xb9, 0x38, 0x85, 0xe8, 0xfa, 0x85, 0xf7,
-	0x29, 0xc8, 0x5d, 0xf4, 0x6a, 0xcc, 0x20, 0xa5, 0x93, 0xfe, 0xf2, 0x7c, 0xba, 0x97, 0xa0, 0x05,
-	0xf7, 0xc3, 0xbf, 0x03, 0x00, 0x00, 0xff, 0xff, 0x88, 0x85, 0x5f, 0xf0, 0x67, 0x16, 0x00, 0x00,
+func (m *GetCheckpointResponse) GetLabels() map[string]string {
+	if m != nil {
+		return m.Labels
+	}
+	return nil
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+func (m *GetCheckpointResponse) GetSha256() string {
+	if m != nil {
+		return m.Sha256
+	}
+	return ""
+}
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+func (m *GetCheckpointResponse) GetSizeBytes() int64 {
+	if m != nil {
+		return m.SizeBytes
+	}
+	return 0
+}
 
-// RepositoryClient is the client API for Repository service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type RepositoryClient interface {
-	Healthz(ctx context.Context, in *HealthCheckRequest, opts ...grpc.CallOption) (*HealthCheckResponse, error)
-	Config(ctx context.Context, in *ConfigRequest, opts ...grpc.CallOption) (*ConfigResponse, error)
-	ListModels(ctx context.Context, in *ListModelsRequest, opts ...grpc.CallOption) (*ListModelsResponse, error)
-	CreateModel(ctx context.Context, in *CreateModelRequest, opts ...grpc.CallOption) (*CreateModelResponse, error)
-	GetModel(ctx context.Context, in *GetModelRequest, opts ...grpc.CallOption) (*GetModelResponse, error)
-	UpdateModel(ctx context.Context, in *UpdateModelRequest, opts ...grpc.CallOption) (*UpdateModelResponse, error)
-	ListHyperparameters(ctx context.Context, in *ListHyperparametersRequest, opts ...grpc.CallOption) (*ListHyperparametersResponse, error)
-	CreateHyperparameters(ctx context.Context, in *CreateHyperparametersRequest, opts ...grpc.CallOption) (*CreateHyperparametersResponse, error)
-	GetHyperparameters(ctx context.Context, in *GetHyperparametersRequest, opts ...grpc.CallOption) (*GetHyperparametersResponse, error)
-	UpdateHyperparameters(ctx context.Context, in *UpdateHyperparametersRequest, opts ...grpc.CallOption) (*UpdateHyperparametersResponse, error)
-	ListCheckpoints(ctx context.Context, in *ListCheckpointsRequest, opts ...grpc.CallOption) (*ListCheckpointsResponse, error)
-	CreateCheckpoint(ctx context.Context, in *CreateCheckpointRequest, opts ...grpc.CallOption) (*CreateCheckpointResponse, error)
-	GetCheckpoint(ctx context.Context, in *GetCheckpointRequest, opts ...grpc.CallOption) (*GetCheckpointResponse, error)
+func (m *GetCheckpointResponse) GetContentType() string {
+	if m != nil {
+		return m.ContentType
+	}
+	return ""
 }
 
-type repositoryClient struct {
-	cc *grpc.ClientConn
+type GetCheckpointManifestRequest struct {
+	ModelId string `protobuf:"bytes,1,opt,name=modelId,proto3" json:"modelId,omitempty"`
+	// hyperparametersId and checkpointId may be tags, as in GetCheckpointRequest
+	HyperparametersId    string   `protobuf:"bytes,2,opt,name=hyperparametersId,proto3" json:"hyperparametersId,omitempty"`
+	CheckpointId         string   `protobuf:"bytes,3,opt,name=checkpointId,proto3" json:"checkpointId,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func NewRepositoryClient(cc *grpc.ClientConn) RepositoryClient {
-	return &repositoryClient{cc}
+func (m *GetCheckpointManifestRequest) Reset()         { *m = GetCheckpointManifestRequest{} }
+func (m *GetCheckpointManifestRequest) String() string { return proto.CompactTextString(m) }
+func (*GetCheckpointManifestRequest) ProtoMessage()    {}
+func (*GetCheckpointManifestRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_10d86afa5a89ec9d, []int{35}
 }
 
-func (c *repositoryClient) Healthz(ctx context.Context, in *HealthCheckRequest, opts ...grpc.CallOption) (*HealthCheckResponse, error) {
-	out := new(HealthCheckResponse)
-	err := c.cc.Invoke(ctx, "/api.Repository/Healthz", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *GetCheckpointManifestRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetCheckpointManifestRequest.Unmarshal(m, b)
+}
+func (m *GetCheckpointManifestRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetCheckpointManifestRequest.Marshal(b, m, deterministic)
+}
+func (m *GetCheckpointManifestRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetCheckpointManifestRequest.Merge(m, src)
+}
+func (m *GetCheckpointManifestRequest) XXX_Size() int {
+	return xxx_messageInfo_GetCheckpointManifestRequest.Size(m)
+}
+func (m *GetCheckpointManifestRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetCheckpointManifestRequest.DiscardUnknown(m)
 }
 
-func (c *repositoryClient) Config(ctx context.Context, in *ConfigRequest, opts ...grpc.CallOption) (*ConfigResponse, error) {
-	out := new(ConfigResponse)
-	err := c.cc.Invoke(ctx, "/api.Repository/Config", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_GetCheckpointManifestRequest proto.InternalMessageInfo
+
+func (m *GetCheckpointManifestRequest) GetModelId() string {
+	if m != nil {
+		return m.ModelId
 	}
-	return out, nil
+	return ""
 }
 
-func (c *repositoryClient) ListModels(ctx context.Context, in *ListModelsRequest, opts ...grpc.CallOption) (*ListModelsResponse, error) {
-	out := new(ListModelsResponse)
-	err := c.cc.Invoke(ctx, "/api.Repository/ListModels", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *GetCheckpointManifestRequest) GetHyperparametersId() string {
+	if m != nil {
+		return m.HyperparametersId
 	}
-	return out, nil
+	return ""
 }
 
-func (c *repositoryClient) CreateModel(ctx context.Context, in *CreateModelRequest, opts ...grpc.CallOption) (*CreateModelResponse, error) {
+func (m *GetCheckpointManifestRequest) GetCheckpointId() string {
+	if m != nil {
+		return m.CheckpointId
+	}
+	return ""
+}
+
+// A manifest of the checkpoint, signed by the repository. Clients verify signature over the exact
+// bytes of manifest (canonical JSON) with the public key they trust for keyId before using any of
+// the manifest's fields.
+type GetCheckpointManifestResponse struct {
+	Manifest             string   `protobuf:"bytes,1,opt,name=manifest,proto3" json:"manifest,omitempty"`
+	Signature            []byte   `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+	KeyId                string   `protobuf:"bytes,3,opt,name=keyId,proto3" json:"keyId,omitempty"`
+	Algorithm            string   `protobuf:"bytes,4,opt,name=algorithm,proto3" json:"algorithm,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetCheckpointManifestResponse) Reset()         { *m = GetCheckpointManifestResponse{} }
+func (m *GetCheckpointManifestResponse) String() string { return proto.CompactTextString(m) }
+func (*GetCheckpointManifestResponse) ProtoMessage()    {}
+func (*GetCheckpointManifestResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_10d86afa5a89ec9d, []int{36}
+}
+
+func (m *GetCheckpointManifestResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetCheckpointManifestResponse.Unmarshal(m, b)
+}
+func (m *GetCheckpointManifestResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetCheckpointManifestResponse.Marshal(b, m, deterministic)
+}
+func (m *GetCheckpointManifestResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetCheckpointManifestResponse.Merge(m, src)
+}
+func (m *GetCheckpointManifestResponse) XXX_Size() int {
+	return xxx_messageInfo_GetCheckpointManifestResponse.Size(m)
+}
+func (m *GetCheckpointManifestResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetCheckpointManifestResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetCheckpointManifestResponse proto.InternalMessageInfo
+
+func (m *GetCheckpointManifestResponse) GetManifest() string {
+	if m != nil {
+		return m.Manifest
+	}
+	return ""
+}
+
+func (m *GetCheckpointManifestResponse) GetSignature() []byte {
+	if m != nil {
+		return m.Signature
+	}
+	return nil
+}
+
+func (m *GetCheckpointManifestResponse) GetKeyId() string {
+	if m != nil {
+		return m.KeyId
+	}
+	return ""
+}
+
+func (m *GetCheckpointManifestResponse) GetAlgorithm() string {
+	if m != nil {
+		return m.Algorithm
+	}
+	return ""
+}
+
+type UpdateCheckpointStateRequest struct {
+	ModelId              string          `protobuf:"bytes,1,opt,name=modelId,proto3" json:"modelId,omitempty"`
+	HyperparametersId    string          `protobuf:"bytes,2,opt,name=hyperparametersId,proto3" json:"hyperparametersId,omitempty"`
+	CheckpointId         string          `protobuf:"bytes,3,opt,name=checkpointId,proto3" json:"checkpointId,omitempty"`
+	State                CheckpointState `protobuf:"varint,4,opt,name=state,proto3,enum=api.CheckpointState" json:"state,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *UpdateCheckpointStateRequest) Reset()         { *m = UpdateCheckpointStateRequest{} }
+func (m *UpdateCheckpointStateRequest) String() string { return proto.CompactTextString(m) }
+func (*UpdateCheckpointStateRequest) ProtoMessage()    {}
+func (*UpdateCheckpointStateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_10d86afa5a89ec9d, []int{37}
+}
+
+func (m *UpdateCheckpointStateRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateCheckpointStateRequest.Unmarshal(m, b)
+}
+func (m *UpdateCheckpointStateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateCheckpointStateRequest.Marshal(b, m, deterministic)
+}
+func (m *UpdateCheckpointStateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateCheckpointStateRequest.Merge(m, src)
+}
+func (m *UpdateCheckpointStateRequest) XXX_Size() int {
+	return xxx_messageInfo_UpdateCheckpointStateRequest.Size(m)
+}
+func (m *UpdateCheckpointStateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateCheckpointStateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateCheckpointStateRequest proto.InternalMessageInfo
+
+func (m *UpdateCheckpointStateRequest) GetModelId() string {
+	if m != nil {
+		return m.ModelId
+	}
+	return ""
+}
+
+func (m *UpdateCheckpointStateRequest) GetHyperparametersId() string {
+	if m != nil {
+		return m.HyperparametersId
+	}
+	return ""
+}
+
+func (m *UpdateCheckpointStateRequest) GetCheckpointId() string {
+	if m != nil {
+		return m.CheckpointId
+	}
+	return ""
+}
+
+func (m *UpdateCheckpointStateRequest) GetState() CheckpointState {
+	if m != nil {
+		return m.State
+	}
+	return CheckpointState_ACTIVE
+}
+
+type UpdateCheckpointStateResponse struct {
+	ModelId              string          `protobuf:"bytes,1,opt,name=modelId,proto3" json:"modelId,omitempty"`
+	HyperparametersId    string          `protobuf:"bytes,2,opt,name=hyperparametersId,proto3" json:"hyperparametersId,omitempty"`
+	CheckpointId         string          `protobuf:"bytes,3,opt,name=checkpointId,proto3" json:"checkpointId,omitempty"`
+	State                CheckpointState `protobuf:"varint,4,opt,name=state,proto3,enum=api.CheckpointState" json:"state,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *UpdateCheckpointStateResponse) Reset()         { *m = UpdateCheckpointStateResponse{} }
+func (m *UpdateCheckpointStateResponse) String() string { return proto.CompactTextString(m) }
+func (*UpdateCheckpointStateResponse) ProtoMessage()    {}
+func (*UpdateCheckpointStateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_10d86afa5a89ec9d, []int{38}
+}
+
+func (m *UpdateCheckpointStateResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateCheckpointStateResponse.Unmarshal(m, b)
+}
+func (m *UpdateCheckpointStateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateCheckpointStateResponse.Marshal(b, m, deterministic)
+}
+func (m *UpdateCheckpointStateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateCheckpointStateResponse.Merge(m, src)
+}
+func (m *UpdateCheckpointStateResponse) XXX_Size() int {
+	return xxx_messageInfo_UpdateCheckpointStateResponse.Size(m)
+}
+func (m *UpdateCheckpointStateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateCheckpointStateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateCheckpointStateResponse proto.InternalMessageInfo
+
+func (m *UpdateCheckpointStateResponse) GetModelId() string {
+	if m != nil {
+		return m.ModelId
+	}
+	return ""
+}
+
+func (m *UpdateCheckpointStateResponse) GetHyperparametersId() string {
+	if m != nil {
+		return m.HyperparametersId
+	}
+	return ""
+}
+
+func (m *UpdateCheckpointStateResponse) GetCheckpointId() string {
+	if m != nil {
+		return m.CheckpointId
+	}
+	return ""
+}
+
+func (m *UpdateCheckpointStateResponse) GetState() CheckpointState {
+	if m != nil {
+		return m.State
+	}
+	return CheckpointState_ACTIVE
+}
+
+type DeleteCheckpointRequest struct {
+	ModelId              string   `protobuf:"bytes,1,opt,name=modelId,proto3" json:"modelId,omitempty"`
+	HyperparametersId    string   `protobuf:"bytes,2,opt,name=hyperparametersId,proto3" json:"hyperparametersId,omitempty"`
+	CheckpointId         string   `protobuf:"bytes,3,opt,name=checkpointId,proto3" json:"checkpointId,omitempty"`
+	Force                bool     `protobuf:"varint,4,opt,name=force,proto3" json:"force,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteCheckpointRequest) Reset()         { *m = DeleteCheckpointRequest{} }
+func (m *DeleteCheckpointRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteCheckpointRequest) ProtoMessage()    {}
+func (*DeleteCheckpointRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_10d86afa5a89ec9d, []int{39}
+}
+
+func (m *DeleteCheckpointRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteCheckpointRequest.Unmarshal(m, b)
+}
+func (m *DeleteCheckpointRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteCheckpointRequest.Marshal(b, m, deterministic)
+}
+func (m *DeleteCheckpointRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteCheckpointRequest.Merge(m, src)
+}
+func (m *DeleteCheckpointRequest) XXX_Size() int {
+	return xxx_messageInfo_DeleteCheckpointRequest.Size(m)
+}
+func (m *DeleteCheckpointRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteCheckpointRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteCheckpointRequest proto.InternalMessageInfo
+
+func (m *DeleteCheckpointRequest) GetModelId() string {
+	if m != nil {
+		return m.ModelId
+	}
+	return ""
+}
+
+func (m *DeleteCheckpointRequest) GetHyperparametersId() string {
+	if m != nil {
+		return m.HyperparametersId
+	}
+	return ""
+}
+
+func (m *DeleteCheckpointRequest) GetCheckpointId() string {
+	if m != nil {
+		return m.CheckpointId
+	}
+	return ""
+}
+
+func (m *DeleteCheckpointRequest) GetForce() bool {
+	if m != nil {
+		return m.Force
+	}
+	return false
+}
+
+type DeleteCheckpointResponse struct {
+	ResourcePath         string   `protobuf:"bytes,1,opt,name=resourcePath,proto3" json:"resourcePath,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteCheckpointResponse) Reset()         { *m = DeleteCheckpointResponse{} }
+func (m *DeleteCheckpointResponse) String() string { return proto.CompactTextString(m) }
+func (*DeleteCheckpointResponse) ProtoMessage()    {}
+func (*DeleteCheckpointResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_10d86afa5a89ec9d, []int{40}
+}
+
+func (m *DeleteCheckpointResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteCheckpointResponse.Unmarshal(m, b)
+}
+func (m *DeleteCheckpointResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteCheckpointResponse.Marshal(b, m, deterministic)
+}
+func (m *DeleteCheckpointResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteCheckpointResponse.Merge(m, src)
+}
+func (m *DeleteCheckpointResponse) XXX_Size() int {
+	return xxx_messageInfo_DeleteCheckpointResponse.Size(m)
+}
+func (m *DeleteCheckpointResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteCheckpointResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteCheckpointResponse proto.InternalMessageInfo
+
+func (m *DeleteCheckpointResponse) GetResourcePath() string {
+	if m != nil {
+		return m.ResourcePath
+	}
+	return ""
+}
+
+type ResolveModelRequest struct {
+	ModelId              string   `protobuf:"bytes,1,opt,name=modelId,proto3" json:"modelId,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ResolveModelRequest) Reset()         { *m = ResolveModelRequest{} }
+func (m *ResolveModelRequest) String() string { return proto.CompactTextString(m) }
+func (*ResolveModelRequest) ProtoMessage()    {}
+func (*ResolveModelRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_10d86afa5a89ec9d, []int{41}
+}
+
+func (m *ResolveModelRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ResolveModelRequest.Unmarshal(m, b)
+}
+func (m *ResolveModelRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ResolveModelRequest.Marshal(b, m, deterministic)
+}
+func (m *ResolveModelRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResolveModelRequest.Merge(m, src)
+}
+func (m *ResolveModelRequest) XXX_Size() int {
+	return xxx_messageInfo_ResolveModelRequest.Size(m)
+}
+func (m *ResolveModelRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResolveModelRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResolveModelRequest proto.InternalMessageInfo
+
+func (m *ResolveModelRequest) GetModelId() string {
+	if m != nil {
+		return m.ModelId
+	}
+	return ""
+}
+
+type ResolveModelResponse struct {
+	Model                *Model                      `protobuf:"bytes,1,opt,name=model,proto3" json:"model,omitempty"`
+	Hyperparameters      *GetHyperparametersResponse `protobuf:"bytes,2,opt,name=hyperparameters,proto3" json:"hyperparameters,omitempty"`
+	Checkpoint           *GetCheckpointResponse      `protobuf:"bytes,3,opt,name=checkpoint,proto3" json:"checkpoint,omitempty"`
+	UpgradePath          []string                    `protobuf:"bytes,4,rep,name=upgradePath,proto3" json:"upgradePath,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                    `json:"-"`
+	XXX_unrecognized     []byte                      `json:"-"`
+	XXX_sizecache        int32                       `json:"-"`
+}
+
+func (m *ResolveModelResponse) Reset()         { *m = ResolveModelResponse{} }
+func (m *ResolveModelResponse) String() string { return proto.CompactTextString(m) }
+func (*ResolveModelResponse) ProtoMessage()    {}
+func (*ResolveModelResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_10d86afa5a89ec9d, []int{42}
+}
+
+func (m *ResolveModelResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ResolveModelResponse.Unmarshal(m, b)
+}
+func (m *ResolveModelResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ResolveModelResponse.Marshal(b, m, deterministic)
+}
+func (m *ResolveModelResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResolveModelResponse.Merge(m, src)
+}
+func (m *ResolveModelResponse) XXX_Size() int {
+	return xxx_messageInfo_ResolveModelResponse.Size(m)
+}
+func (m *ResolveModelResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResolveModelResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResolveModelResponse proto.InternalMessageInfo
+
+func (m *ResolveModelResponse) GetModel() *Model {
+	if m != nil {
+		return m.Model
+	}
+	return nil
+}
+
+func (m *ResolveModelResponse) GetHyperparameters() *GetHyperparametersResponse {
+	if m != nil {
+		return m.Hyperparameters
+	}
+	return nil
+}
+
+func (m *ResolveModelResponse) GetCheckpoint() *GetCheckpointResponse {
+	if m != nil {
+		return m.Checkpoint
+	}
+	return nil
+}
+
+func (m *ResolveModelResponse) GetUpgradePath() []string {
+	if m != nil {
+		return m.UpgradePath
+	}
+	return nil
+}
+
+type UpgradeCheckRequest struct {
+	ModelId              string   `protobuf:"bytes,1,opt,name=modelId,proto3" json:"modelId,omitempty"`
+	HyperparametersId    string   `protobuf:"bytes,2,opt,name=hyperparametersId,proto3" json:"hyperparametersId,omitempty"`
+	CheckpointId         string   `protobuf:"bytes,3,opt,name=checkpointId,proto3" json:"checkpointId,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UpgradeCheckRequest) Reset()         { *m = UpgradeCheckRequest{} }
+func (m *UpgradeCheckRequest) String() string { return proto.CompactTextString(m) }
+func (*UpgradeCheckRequest) ProtoMessage()    {}
+func (*UpgradeCheckRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_10d86afa5a89ec9d, []int{43}
+}
+
+func (m *UpgradeCheckRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpgradeCheckRequest.Unmarshal(m, b)
+}
+func (m *UpgradeCheckRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpgradeCheckRequest.Marshal(b, m, deterministic)
+}
+func (m *UpgradeCheckRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpgradeCheckRequest.Merge(m, src)
+}
+func (m *UpgradeCheckRequest) XXX_Size() int {
+	return xxx_messageInfo_UpgradeCheckRequest.Size(m)
+}
+func (m *UpgradeCheckRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpgradeCheckRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpgradeCheckRequest proto.InternalMessageInfo
+
+func (m *UpgradeCheckRequest) GetModelId() string {
+	if m != nil {
+		return m.ModelId
+	}
+	return ""
+}
+
+func (m *UpgradeCheckRequest) GetHyperparametersId() string {
+	if m != nil {
+		return m.HyperparametersId
+	}
+	return ""
+}
+
+func (m *UpgradeCheckRequest) GetCheckpointId() string {
+	if m != nil {
+		return m.CheckpointId
+	}
+	return ""
+}
+
+type UpgradeHop struct {
+	HyperparametersId    string   `protobuf:"bytes,1,opt,name=hyperparametersId,proto3" json:"hyperparametersId,omitempty"`
+	CanonicalCheckpoint  string   `protobuf:"bytes,2,opt,name=canonicalCheckpoint,proto3" json:"canonicalCheckpoint,omitempty"`
+	UpgradeTo            string   `protobuf:"bytes,3,opt,name=upgradeTo,proto3" json:"upgradeTo,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UpgradeHop) Reset()         { *m = UpgradeHop{} }
+func (m *UpgradeHop) String() string { return proto.CompactTextString(m) }
+func (*UpgradeHop) ProtoMessage()    {}
+func (*UpgradeHop) Descriptor() ([]byte, []int) {
+	return fileDescriptor_10d86afa5a89ec9d, []int{44}
+}
+
+func (m *UpgradeHop) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpgradeHop.Unmarshal(m, b)
+}
+func (m *UpgradeHop) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpgradeHop.Marshal(b, m, deterministic)
+}
+func (m *UpgradeHop) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpgradeHop.Merge(m, src)
+}
+func (m *UpgradeHop) XXX_Size() int {
+	return xxx_messageInfo_UpgradeHop.Size(m)
+}
+func (m *UpgradeHop) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpgradeHop.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpgradeHop proto.InternalMessageInfo
+
+func (m *UpgradeHop) GetHyperparametersId() string {
+	if m != nil {
+		return m.HyperparametersId
+	}
+	return ""
+}
+
+func (m *UpgradeHop) GetCanonicalCheckpoint() string {
+	if m != nil {
+		return m.CanonicalCheckpoint
+	}
+	return ""
+}
+
+func (m *UpgradeHop) GetUpgradeTo() string {
+	if m != nil {
+		return m.UpgradeTo
+	}
+	return ""
+}
+
+type UpgradeCheckResponse struct {
+	UpgradeAvailable     bool                   `protobuf:"varint,1,opt,name=upgradeAvailable,proto3" json:"upgradeAvailable,omitempty"`
+	Target               *GetCheckpointResponse `protobuf:"bytes,2,opt,name=target,proto3" json:"target,omitempty"`
+	Hops                 []*UpgradeHop          `protobuf:"bytes,3,rep,name=hops,proto3" json:"hops,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
+}
+
+func (m *UpgradeCheckResponse) Reset()         { *m = UpgradeCheckResponse{} }
+func (m *UpgradeCheckResponse) String() string { return proto.CompactTextString(m) }
+func (*UpgradeCheckResponse) ProtoMessage()    {}
+func (*UpgradeCheckResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_10d86afa5a89ec9d, []int{45}
+}
+
+func (m *UpgradeCheckResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpgradeCheckResponse.Unmarshal(m, b)
+}
+func (m *UpgradeCheckResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpgradeCheckResponse.Marshal(b, m, deterministic)
+}
+func (m *UpgradeCheckResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpgradeCheckResponse.Merge(m, src)
+}
+func (m *UpgradeCheckResponse) XXX_Size() int {
+	return xxx_messageInfo_UpgradeCheckResponse.Size(m)
+}
+func (m *UpgradeCheckResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpgradeCheckResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpgradeCheckResponse proto.InternalMessageInfo
+
+func (m *UpgradeCheckResponse) GetUpgradeAvailable() bool {
+	if m != nil {
+		return m.UpgradeAvailable
+	}
+	return false
+}
+
+func (m *UpgradeCheckResponse) GetTarget() *GetCheckpointResponse {
+	if m != nil {
+		return m.Target
+	}
+	return nil
+}
+
+func (m *UpgradeCheckResponse) GetHops() []*UpgradeHop {
+	if m != nil {
+		return m.Hops
+	}
+	return nil
+}
+
+type FsckRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *FsckRequest) Reset()         { *m = FsckRequest{} }
+func (m *FsckRequest) String() string { return proto.CompactTextString(m) }
+func (*FsckRequest) ProtoMessage()    {}
+func (*FsckRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_10d86afa5a89ec9d, []int{46}
+}
+
+func (m *FsckRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FsckRequest.Unmarshal(m, b)
+}
+func (m *FsckRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FsckRequest.Marshal(b, m, deterministic)
+}
+func (m *FsckRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FsckRequest.Merge(m, src)
+}
+func (m *FsckRequest) XXX_Size() int {
+	return xxx_messageInfo_FsckRequest.Size(m)
+}
+func (m *FsckRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_FsckRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FsckRequest proto.InternalMessageInfo
+
+type DanglingReference struct {
+	ResourcePath         string   `protobuf:"bytes,1,opt,name=resourcePath,proto3" json:"resourcePath,omitempty"`
+	Field                string   `protobuf:"bytes,2,opt,name=field,proto3" json:"field,omitempty"`
+	Reference            string   `protobuf:"bytes,3,opt,name=reference,proto3" json:"reference,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DanglingReference) Reset()         { *m = DanglingReference{} }
+func (m *DanglingReference) String() string { return proto.CompactTextString(m) }
+func (*DanglingReference) ProtoMessage()    {}
+func (*DanglingReference) Descriptor() ([]byte, []int) {
+	return fileDescriptor_10d86afa5a89ec9d, []int{47}
+}
+
+func (m *DanglingReference) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DanglingReference.Unmarshal(m, b)
+}
+func (m *DanglingReference) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DanglingReference.Marshal(b, m, deterministic)
+}
+func (m *DanglingReference) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DanglingReference.Merge(m, src)
+}
+func (m *DanglingReference) XXX_Size() int {
+	return xxx_messageInfo_DanglingReference.Size(m)
+}
+func (m *DanglingReference) XXX_DiscardUnknown() {
+	xxx_messageInfo_DanglingReference.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DanglingReference proto.InternalMessageInfo
+
+func (m *DanglingReference) GetResourcePath() string {
+	if m != nil {
+		return m.ResourcePath
+	}
+	return ""
+}
+
+func (m *DanglingReference) GetField() string {
+	if m != nil {
+		return m.Field
+	}
+	return ""
+}
+
+func (m *DanglingReference) GetReference() string {
+	if m != nil {
+		return m.Reference
+	}
+	return ""
+}
+
+type FsckResponse struct {
+	DanglingReferences   []*DanglingReference `protobuf:"bytes,1,rep,name=danglingReferences,proto3" json:"danglingReferences,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *FsckResponse) Reset()         { *m = FsckResponse{} }
+func (m *FsckResponse) String() string { return proto.CompactTextString(m) }
+func (*FsckResponse) ProtoMessage()    {}
+func (*FsckResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_10d86afa5a89ec9d, []int{48}
+}
+
+func (m *FsckResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FsckResponse.Unmarshal(m, b)
+}
+func (m *FsckResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FsckResponse.Marshal(b, m, deterministic)
+}
+func (m *FsckResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FsckResponse.Merge(m, src)
+}
+func (m *FsckResponse) XXX_Size() int {
+	return xxx_messageInfo_FsckResponse.Size(m)
+}
+func (m *FsckResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_FsckResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FsckResponse proto.InternalMessageInfo
+
+func (m *FsckResponse) GetDanglingReferences() []*DanglingReference {
+	if m != nil {
+		return m.DanglingReferences
+	}
+	return nil
+}
+
+// A change made through the API. request, before and after are JSON documents; before is empty for
+// resources which were created, after for resources which were deleted.
+type AuditEvent struct {
+	EventId              string               `protobuf:"bytes,1,opt,name=eventId,proto3" json:"eventId,omitempty"`
+	Time                 *timestamp.Timestamp `protobuf:"bytes,2,opt,name=time,proto3" json:"time,omitempty"`
+	Actor                string               `protobuf:"bytes,3,opt,name=actor,proto3" json:"actor,omitempty"`
+	Method               string               `protobuf:"bytes,4,opt,name=method,proto3" json:"method,omitempty"`
+	ResourcePath         string               `protobuf:"bytes,5,opt,name=resourcePath,proto3" json:"resourcePath,omitempty"`
+	Request              string               `protobuf:"bytes,6,opt,name=request,proto3" json:"request,omitempty"`
+	Before               string               `protobuf:"bytes,7,opt,name=before,proto3" json:"before,omitempty"`
+	After                string               `protobuf:"bytes,8,opt,name=after,proto3" json:"after,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *AuditEvent) Reset()         { *m = AuditEvent{} }
+func (m *AuditEvent) String() string { return proto.CompactTextString(m) }
+func (*AuditEvent) ProtoMessage()    {}
+func (*AuditEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_10d86afa5a89ec9d, []int{49}
+}
+
+func (m *AuditEvent) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AuditEvent.Unmarshal(m, b)
+}
+func (m *AuditEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AuditEvent.Marshal(b, m, deterministic)
+}
+func (m *AuditEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuditEvent.Merge(m, src)
+}
+func (m *AuditEvent) XXX_Size() int {
+	return xxx_messageInfo_AuditEvent.Size(m)
+}
+func (m *AuditEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuditEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AuditEvent proto.InternalMessageInfo
+
+func (m *AuditEvent) GetEventId() string {
+	if m != nil {
+		return m.EventId
+	}
+	return ""
+}
+
+func (m *AuditEvent) GetTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.Time
+	}
+	return nil
+}
+
+func (m *AuditEvent) GetActor() string {
+	if m != nil {
+		return m.Actor
+	}
+	return ""
+}
+
+func (m *AuditEvent) GetMethod() string {
+	if m != nil {
+		return m.Method
+	}
+	return ""
+}
+
+func (m *AuditEvent) GetResourcePath() string {
+	if m != nil {
+		return m.ResourcePath
+	}
+	return ""
+}
+
+func (m *AuditEvent) GetRequest() string {
+	if m != nil {
+		return m.Request
+	}
+	return ""
+}
+
+func (m *AuditEvent) GetBefore() string {
+	if m != nil {
+		return m.Before
+	}
+	return ""
+}
+
+func (m *AuditEvent) GetAfter() string {
+	if m != nil {
+		return m.After
+	}
+	return ""
+}
+
+type ListAuditEventsRequest struct {
+	// Only list events for this resource and the resources below it, e.g. /models/faces
+	ResourcePath string `protobuf:"bytes,1,opt,name=resourcePath,proto3" json:"resourcePath,omitempty"`
+	// Only list events which happened at or after since, and before until
+	Since                *timestamp.Timestamp `protobuf:"bytes,2,opt,name=since,proto3" json:"since,omitempty"`
+	Until                *timestamp.Timestamp `protobuf:"bytes,3,opt,name=until,proto3" json:"until,omitempty"`
+	MaxItems             int32                `protobuf:"varint,4,opt,name=maxItems,proto3" json:"maxItems,omitempty"`
+	PageToken            string               `protobuf:"bytes,5,opt,name=pageToken,proto3" json:"pageToken,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *ListAuditEventsRequest) Reset()         { *m = ListAuditEventsRequest{} }
+func (m *ListAuditEventsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListAuditEventsRequest) ProtoMessage()    {}
+func (*ListAuditEventsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_10d86afa5a89ec9d, []int{50}
+}
+
+func (m *ListAuditEventsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListAuditEventsRequest.Unmarshal(m, b)
+}
+func (m *ListAuditEventsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListAuditEventsRequest.Marshal(b, m, deterministic)
+}
+func (m *ListAuditEventsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListAuditEventsRequest.Merge(m, src)
+}
+func (m *ListAuditEventsRequest) XXX_Size() int {
+	return xxx_messageInfo_ListAuditEventsRequest.Size(m)
+}
+func (m *ListAuditEventsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListAuditEventsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListAuditEventsRequest proto.InternalMessageInfo
+
+func (m *ListAuditEventsRequest) GetResourcePath() string {
+	if m != nil {
+		return m.ResourcePath
+	}
+	return ""
+}
+
+func (m *ListAuditEventsRequest) GetSince() *timestamp.Timestamp {
+	if m != nil {
+		return m.Since
+	}
+	return nil
+}
+
+func (m *ListAuditEventsRequest) GetUntil() *timestamp.Timestamp {
+	if m != nil {
+		return m.Until
+	}
+	return nil
+}
+
+func (m *ListAuditEventsRequest) GetMaxItems() int32 {
+	if m != nil {
+		return m.MaxItems
+	}
+	return 0
+}
+
+func (m *ListAuditEventsRequest) GetPageToken() string {
+	if m != nil {
+		return m.PageToken
+	}
+	return ""
+}
+
+// Events are listed in chronological order.
+type ListAuditEventsResponse struct {
+	Events               []*AuditEvent `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	NextPageToken        string        `protobuf:"bytes,2,opt,name=nextPageToken,proto3" json:"nextPageToken,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *ListAuditEventsResponse) Reset()         { *m = ListAuditEventsResponse{} }
+func (m *ListAuditEventsResponse) String() string { return proto.CompactTextString(m) }
+func (*ListAuditEventsResponse) ProtoMessage()    {}
+func (*ListAuditEventsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_10d86afa5a89ec9d, []int{51}
+}
+
+func (m *ListAuditEventsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListAuditEventsResponse.Unmarshal(m, b)
+}
+func (m *ListAuditEventsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListAuditEventsResponse.Marshal(b, m, deterministic)
+}
+func (m *ListAuditEventsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListAuditEventsResponse.Merge(m, src)
+}
+func (m *ListAuditEventsResponse) XXX_Size() int {
+	return xxx_messageInfo_ListAuditEventsResponse.Size(m)
+}
+func (m *ListAuditEventsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListAuditEventsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListAuditEventsResponse proto.InternalMessageInfo
+
+func (m *ListAuditEventsResponse) GetEvents() []*AuditEvent {
+	if m != nil {
+		return m.Events
+	}
+	return nil
+}
+
+func (m *ListAuditEventsResponse) GetNextPageToken() string {
+	if m != nil {
+		return m.NextPageToken
+	}
+	return ""
+}
+
+// A URL which changes made through the API are POSTed to. Webhooks are shared by the repository
+// and FLEA APIs where they use the same backend, but each API only delivers its own changes.
+type Webhook struct {
+	WebhookId string `protobuf:"bytes,1,opt,name=webhookId,proto3" json:"webhookId,omitempty"`
+	Url       string `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	// The names of the methods whose changes are delivered, e.g. CreateCheckpoint
+	EventTypes           []string             `protobuf:"bytes,3,rep,name=eventTypes,proto3" json:"eventTypes,omitempty"`
+	CreatedAt            *timestamp.Timestamp `protobuf:"bytes,4,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *Webhook) Reset()         { *m = Webhook{} }
+func (m *Webhook) String() string { return proto.CompactTextString(m) }
+func (*Webhook) ProtoMessage()    {}
+func (*Webhook) Descriptor() ([]byte, []int) {
+	return fileDescriptor_10d86afa5a89ec9d, []int{52}
+}
+
+func (m *Webhook) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Webhook.Unmarshal(m, b)
+}
+func (m *Webhook) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Webhook.Marshal(b, m, deterministic)
+}
+func (m *Webhook) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Webhook.Merge(m, src)
+}
+func (m *Webhook) XXX_Size() int {
+	return xxx_messageInfo_Webhook.Size(m)
+}
+func (m *Webhook) XXX_DiscardUnknown() {
+	xxx_messageInfo_Webhook.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Webhook proto.InternalMessageInfo
+
+func (m *Webhook) GetWebhookId() string {
+	if m != nil {
+		return m.WebhookId
+	}
+	return ""
+}
+
+func (m *Webhook) GetUrl() string {
+	if m != nil {
+		return m.Url
+	}
+	return ""
+}
+
+func (m *Webhook) GetEventTypes() []string {
+	if m != nil {
+		return m.EventTypes
+	}
+	return nil
+}
+
+func (m *Webhook) GetCreatedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return nil
+}
+
+type CreateWebhookRequest struct {
+	Url        string   `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	EventTypes []string `protobuf:"bytes,2,rep,name=eventTypes,proto3" json:"eventTypes,omitempty"`
+	// The key of the HMAC-SHA256 signature of each delivery. It is never returned.
+	Secret               string   `protobuf:"bytes,3,opt,name=secret,proto3" json:"secret,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CreateWebhookRequest) Reset()         { *m = CreateWebhookRequest{} }
+func (m *CreateWebhookRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateWebhookRequest) ProtoMessage()    {}
+func (*CreateWebhookRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_10d86afa5a89ec9d, []int{53}
+}
+
+func (m *CreateWebhookRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateWebhookRequest.Unmarshal(m, b)
+}
+func (m *CreateWebhookRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateWebhookRequest.Marshal(b, m, deterministic)
+}
+func (m *CreateWebhookRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateWebhookRequest.Merge(m, src)
+}
+func (m *CreateWebhookRequest) XXX_Size() int {
+	return xxx_messageInfo_CreateWebhookRequest.Size(m)
+}
+func (m *CreateWebhookRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateWebhookRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateWebhookRequest proto.InternalMessageInfo
+
+func (m *CreateWebhookRequest) GetUrl() string {
+	if m != nil {
+		return m.Url
+	}
+	return ""
+}
+
+func (m *CreateWebhookRequest) GetEventTypes() []string {
+	if m != nil {
+		return m.EventTypes
+	}
+	return nil
+}
+
+func (m *CreateWebhookRequest) GetSecret() string {
+	if m != nil {
+		return m.Secret
+	}
+	return ""
+}
+
+type CreateWebhookResponse struct {
+	Webhook              *Webhook `protobuf:"bytes,1,opt,name=webhook,proto3" json:"webhook,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CreateWebhookResponse) Reset()         { *m = CreateWebhookResponse{} }
+func (m *CreateWebhookResponse) String() string { return proto.CompactTextString(m) }
+func (*CreateWebhookResponse) ProtoMessage()    {}
+func (*CreateWebhookResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_10d86afa5a89ec9d, []int{54}
+}
+
+func (m *CreateWebhookResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateWebhookResponse.Unmarshal(m, b)
+}
+func (m *CreateWebhookResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateWebhookResponse.Marshal(b, m, deterministic)
+}
+func (m *CreateWebhookResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateWebhookResponse.Merge(m, src)
+}
+func (m *CreateWebhookResponse) XXX_Size() int {
+	return xxx_messageInfo_CreateWebhookResponse.Size(m)
+}
+func (m *CreateWebhookResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateWebhookResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateWebhookResponse proto.InternalMessageInfo
+
+func (m *CreateWebhookResponse) GetWebhook() *Webhook {
+	if m != nil {
+		return m.Webhook
+	}
+	return nil
+}
+
+type ListWebhooksRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListWebhooksRequest) Reset()         { *m = ListWebhooksRequest{} }
+func (m *ListWebhooksRequest) String() string { return proto.CompactTextString(m) }
+func (*ListWebhooksRequest) ProtoMessage()    {}
+func (*ListWebhooksRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_10d86afa5a89ec9d, []int{55}
+}
+
+func (m *ListWebhooksRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListWebhooksRequest.Unmarshal(m, b)
+}
+func (m *ListWebhooksRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListWebhooksRequest.Marshal(b, m, deterministic)
+}
+func (m *ListWebhooksRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListWebhooksRequest.Merge(m, src)
+}
+func (m *ListWebhooksRequest) XXX_Size() int {
+	return xxx_messageInfo_ListWebhooksRequest.Size(m)
+}
+func (m *ListWebhooksRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListWebhooksRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListWebhooksRequest proto.InternalMessageInfo
+
+type ListWebhooksResponse struct {
+	Webhooks             []*Webhook `protobuf:"bytes,1,rep,name=webhooks,proto3" json:"webhooks,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *ListWebhooksResponse) Reset()         { *m = ListWebhooksResponse{} }
+func (m *ListWebhooksResponse) String() string { return proto.CompactTextString(m) }
+func (*ListWebhooksResponse) ProtoMessage()    {}
+func (*ListWebhooksResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_10d86afa5a89ec9d, []int{56}
+}
+
+func (m *ListWebhooksResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListWebhooksResponse.Unmarshal(m, b)
+}
+func (m *ListWebhooksResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListWebhooksResponse.Marshal(b, m, deterministic)
+}
+func (m *ListWebhooksResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListWebhooksResponse.Merge(m, src)
+}
+func (m *ListWebhooksResponse) XXX_Size() int {
+	return xxx_messageInfo_ListWebhooksResponse.Size(m)
+}
+func (m *ListWebhooksResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListWebhooksResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListWebhooksResponse proto.InternalMessageInfo
+
+func (m *ListWebhooksResponse) GetWebhooks() []*Webhook {
+	if m != nil {
+		return m.Webhooks
+	}
+	return nil
+}
+
+type DeleteWebhookRequest struct {
+	WebhookId            string   `protobuf:"bytes,1,opt,name=webhookId,proto3" json:"webhookId,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteWebhookRequest) Reset()         { *m = DeleteWebhookRequest{} }
+func (m *DeleteWebhookRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteWebhookRequest) ProtoMessage()    {}
+func (*DeleteWebhookRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_10d86afa5a89ec9d, []int{57}
+}
+
+func (m *DeleteWebhookRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteWebhookRequest.Unmarshal(m, b)
+}
+func (m *DeleteWebhookRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteWebhookRequest.Marshal(b, m, deterministic)
+}
+func (m *DeleteWebhookRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteWebhookRequest.Merge(m, src)
+}
+func (m *DeleteWebhookRequest) XXX_Size() int {
+	return xxx_messageInfo_DeleteWebhookRequest.Size(m)
+}
+func (m *DeleteWebhookRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteWebhookRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteWebhookRequest proto.InternalMessageInfo
+
+func (m *DeleteWebhookRequest) GetWebhookId() string {
+	if m != nil {
+		return m.WebhookId
+	}
+	return ""
+}
+
+type DeleteWebhookResponse struct {
+	Webhook              *Webhook `protobuf:"bytes,1,opt,name=webhook,proto3" json:"webhook,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteWebhookResponse) Reset()         { *m = DeleteWebhookResponse{} }
+func (m *DeleteWebhookResponse) String() string { return proto.CompactTextString(m) }
+func (*DeleteWebhookResponse) ProtoMessage()    {}
+func (*DeleteWebhookResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_10d86afa5a89ec9d, []int{58}
+}
+
+func (m *DeleteWebhookResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteWebhookResponse.Unmarshal(m, b)
+}
+func (m *DeleteWebhookResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteWebhookResponse.Marshal(b, m, deterministic)
+}
+func (m *DeleteWebhookResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteWebhookResponse.Merge(m, src)
+}
+func (m *DeleteWebhookResponse) XXX_Size() int {
+	return xxx_messageInfo_DeleteWebhookResponse.Size(m)
+}
+func (m *DeleteWebhookResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteWebhookResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteWebhookResponse proto.InternalMessageInfo
+
+func (m *DeleteWebhookResponse) GetWebhook() *Webhook {
+	if m != nil {
+		return m.Webhook
+	}
+	return nil
+}
+
+// A delivery which failed on every attempt.
+type WebhookDeadLetter struct {
+	DeliveryId string `protobuf:"bytes,1,opt,name=deliveryId,proto3" json:"deliveryId,omitempty"`
+	WebhookId  string `protobuf:"bytes,2,opt,name=webhookId,proto3" json:"webhookId,omitempty"`
+	Url        string `protobuf:"bytes,3,opt,name=url,proto3" json:"url,omitempty"`
+	EventType  string `protobuf:"bytes,4,opt,name=eventType,proto3" json:"eventType,omitempty"`
+	// The body of the delivery, a JSON encoded AuditEvent
+	Payload              string               `protobuf:"bytes,5,opt,name=payload,proto3" json:"payload,omitempty"`
+	Attempts             int32                `protobuf:"varint,6,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	LastError            string               `protobuf:"bytes,7,opt,name=lastError,proto3" json:"lastError,omitempty"`
+	FailedAt             *timestamp.Timestamp `protobuf:"bytes,8,opt,name=failedAt,proto3" json:"failedAt,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *WebhookDeadLetter) Reset()         { *m = WebhookDeadLetter{} }
+func (m *WebhookDeadLetter) String() string { return proto.CompactTextString(m) }
+func (*WebhookDeadLetter) ProtoMessage()    {}
+func (*WebhookDeadLetter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_10d86afa5a89ec9d, []int{59}
+}
+
+func (m *WebhookDeadLetter) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WebhookDeadLetter.Unmarshal(m, b)
+}
+func (m *WebhookDeadLetter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WebhookDeadLetter.Marshal(b, m, deterministic)
+}
+func (m *WebhookDeadLetter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WebhookDeadLetter.Merge(m, src)
+}
+func (m *WebhookDeadLetter) XXX_Size() int {
+	return xxx_messageInfo_WebhookDeadLetter.Size(m)
+}
+func (m *WebhookDeadLetter) XXX_DiscardUnknown() {
+	xxx_messageInfo_WebhookDeadLetter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WebhookDeadLetter proto.InternalMessageInfo
+
+func (m *WebhookDeadLetter) GetDeliveryId() string {
+	if m != nil {
+		return m.DeliveryId
+	}
+	return ""
+}
+
+func (m *WebhookDeadLetter) GetWebhookId() string {
+	if m != nil {
+		return m.WebhookId
+	}
+	return ""
+}
+
+func (m *WebhookDeadLetter) GetUrl() string {
+	if m != nil {
+		return m.Url
+	}
+	return ""
+}
+
+func (m *WebhookDeadLetter) GetEventType() string {
+	if m != nil {
+		return m.EventType
+	}
+	return ""
+}
+
+func (m *WebhookDeadLetter) GetPayload() string {
+	if m != nil {
+		return m.Payload
+	}
+	return ""
+}
+
+func (m *WebhookDeadLetter) GetAttempts() int32 {
+	if m != nil {
+		return m.Attempts
+	}
+	return 0
+}
+
+func (m *WebhookDeadLetter) GetLastError() string {
+	if m != nil {
+		return m.LastError
+	}
+	return ""
+}
+
+func (m *WebhookDeadLetter) GetFailedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.FailedAt
+	}
+	return nil
+}
+
+type ListWebhookDeadLettersRequest struct {
+	MaxItems             int32    `protobuf:"varint,1,opt,name=maxItems,proto3" json:"maxItems,omitempty"`
+	PageToken            string   `protobuf:"bytes,2,opt,name=pageToken,proto3" json:"pageToken,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListWebhookDeadLettersRequest) Reset()         { *m = ListWebhookDeadLettersRequest{} }
+func (m *ListWebhookDeadLettersRequest) String() string { return proto.CompactTextString(m) }
+func (*ListWebhookDeadLettersRequest) ProtoMessage()    {}
+func (*ListWebhookDeadLettersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_10d86afa5a89ec9d, []int{60}
+}
+
+func (m *ListWebhookDeadLettersRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListWebhookDeadLettersRequest.Unmarshal(m, b)
+}
+func (m *ListWebhookDeadLettersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListWebhookDeadLettersRequest.Marshal(b, m, deterministic)
+}
+func (m *ListWebhookDeadLettersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListWebhookDeadLettersRequest.Merge(m, src)
+}
+func (m *ListWebhookDeadLettersRequest) XXX_Size() int {
+	return xxx_messageInfo_ListWebhookDeadLettersRequest.Size(m)
+}
+func (m *ListWebhookDeadLettersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListWebhookDeadLettersRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListWebhookDeadLettersRequest proto.InternalMessageInfo
+
+func (m *ListWebhookDeadLettersRequest) GetMaxItems() int32 {
+	if m != nil {
+		return m.MaxItems
+	}
+	return 0
+}
+
+func (m *ListWebhookDeadLettersRequest) GetPageToken() string {
+	if m != nil {
+		return m.PageToken
+	}
+	return ""
+}
+
+// Dead letters are listed in the order in which the changes they deliver were made.
+type ListWebhookDeadLettersResponse struct {
+	DeadLetters          []*WebhookDeadLetter `protobuf:"bytes,1,rep,name=deadLetters,proto3" json:"deadLetters,omitempty"`
+	NextPageToken        string               `protobuf:"bytes,2,opt,name=nextPageToken,proto3" json:"nextPageToken,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *ListWebhookDeadLettersResponse) Reset()         { *m = ListWebhookDeadLettersResponse{} }
+func (m *ListWebhookDeadLettersResponse) String() string { return proto.CompactTextString(m) }
+func (*ListWebhookDeadLettersResponse) ProtoMessage()    {}
+func (*ListWebhookDeadLettersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_10d86afa5a89ec9d, []int{61}
+}
+
+func (m *ListWebhookDeadLettersResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListWebhookDeadLettersResponse.Unmarshal(m, b)
+}
+func (m *ListWebhookDeadLettersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListWebhookDeadLettersResponse.Marshal(b, m, deterministic)
+}
+func (m *ListWebhookDeadLettersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListWebhookDeadLettersResponse.Merge(m, src)
+}
+func (m *ListWebhookDeadLettersResponse) XXX_Size() int {
+	return xxx_messageInfo_ListWebhookDeadLettersResponse.Size(m)
+}
+func (m *ListWebhookDeadLettersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListWebhookDeadLettersResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListWebhookDeadLettersResponse proto.InternalMessageInfo
+
+func (m *ListWebhookDeadLettersResponse) GetDeadLetters() []*WebhookDeadLetter {
+	if m != nil {
+		return m.DeadLetters
+	}
+	return nil
+}
+
+func (m *ListWebhookDeadLettersResponse) GetNextPageToken() string {
+	if m != nil {
+		return m.NextPageToken
+	}
+	return ""
+}
+
+// A model or hyperparameters as they were stored at one of their versions. Revisions are stored by
+// every create and update, and are never changed. Exactly one of model and hyperparameters is set.
+type Revision struct {
+	Version              int64                       `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	CreatedAt            *timestamp.Timestamp        `protobuf:"bytes,2,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	Model                *Model                      `protobuf:"bytes,3,opt,name=model,proto3" json:"model,omitempty"`
+	Hyperparameters      *GetHyperparametersResponse `protobuf:"bytes,4,opt,name=hyperparameters,proto3" json:"hyperparameters,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                    `json:"-"`
+	XXX_unrecognized     []byte                      `json:"-"`
+	XXX_sizecache        int32                       `json:"-"`
+}
+
+func (m *Revision) Reset()         { *m = Revision{} }
+func (m *Revision) String() string { return proto.CompactTextString(m) }
+func (*Revision) ProtoMessage()    {}
+func (*Revision) Descriptor() ([]byte, []int) {
+	return fileDescriptor_10d86afa5a89ec9d, []int{62}
+}
+
+func (m *Revision) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Revision.Unmarshal(m, b)
+}
+func (m *Revision) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Revision.Marshal(b, m, deterministic)
+}
+func (m *Revision) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Revision.Merge(m, src)
+}
+func (m *Revision) XXX_Size() int {
+	return xxx_messageInfo_Revision.Size(m)
+}
+func (m *Revision) XXX_DiscardUnknown() {
+	xxx_messageInfo_Revision.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Revision proto.InternalMessageInfo
+
+func (m *Revision) GetVersion() int64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *Revision) GetCreatedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return nil
+}
+
+func (m *Revision) GetModel() *Model {
+	if m != nil {
+		return m.Model
+	}
+	return nil
+}
+
+func (m *Revision) GetHyperparameters() *GetHyperparametersResponse {
+	if m != nil {
+		return m.Hyperparameters
+	}
+	return nil
+}
+
+// Lists the revisions of a model, or of one of its hyperparameters if hyperparametersId is set.
+type ListRevisionsRequest struct {
+	ModelId              string   `protobuf:"bytes,1,opt,name=modelId,proto3" json:"modelId,omitempty"`
+	HyperparametersId    string   `protobuf:"bytes,2,opt,name=hyperparametersId,proto3" json:"hyperparametersId,omitempty"`
+	MaxItems             int32    `protobuf:"varint,3,opt,name=maxItems,proto3" json:"maxItems,omitempty"`
+	PageToken            string   `protobuf:"bytes,4,opt,name=pageToken,proto3" json:"pageToken,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListRevisionsRequest) Reset()         { *m = ListRevisionsRequest{} }
+func (m *ListRevisionsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListRevisionsRequest) ProtoMessage()    {}
+func (*ListRevisionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_10d86afa5a89ec9d, []int{63}
+}
+
+func (m *ListRevisionsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListRevisionsRequest.Unmarshal(m, b)
+}
+func (m *ListRevisionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListRevisionsRequest.Marshal(b, m, deterministic)
+}
+func (m *ListRevisionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListRevisionsRequest.Merge(m, src)
+}
+func (m *ListRevisionsRequest) XXX_Size() int {
+	return xxx_messageInfo_ListRevisionsRequest.Size(m)
+}
+func (m *ListRevisionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListRevisionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListRevisionsRequest proto.InternalMessageInfo
+
+func (m *ListRevisionsRequest) GetModelId() string {
+	if m != nil {
+		return m.ModelId
+	}
+	return ""
+}
+
+func (m *ListRevisionsRequest) GetHyperparametersId() string {
+	if m != nil {
+		return m.HyperparametersId
+	}
+	return ""
+}
+
+func (m *ListRevisionsRequest) GetMaxItems() int32 {
+	if m != nil {
+		return m.MaxItems
+	}
+	return 0
+}
+
+func (m *ListRevisionsRequest) GetPageToken() string {
+	if m != nil {
+		return m.PageToken
+	}
+	return ""
+}
+
+// Revisions are listed oldest first.
+type ListRevisionsResponse struct {
+	Revisions            []*Revision `protobuf:"bytes,1,rep,name=revisions,proto3" json:"revisions,omitempty"`
+	NextPageToken        string      `protobuf:"bytes,2,opt,name=nextPageToken,proto3" json:"nextPageToken,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *ListRevisionsResponse) Reset()         { *m = ListRevisionsResponse{} }
+func (m *ListRevisionsResponse) String() string { return proto.CompactTextString(m) }
+func (*ListRevisionsResponse) ProtoMessage()    {}
+func (*ListRevisionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_10d86afa5a89ec9d, []int{64}
+}
+
+func (m *ListRevisionsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListRevisionsResponse.Unmarshal(m, b)
+}
+func (m *ListRevisionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListRevisionsResponse.Marshal(b, m, deterministic)
+}
+func (m *ListRevisionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListRevisionsResponse.Merge(m, src)
+}
+func (m *ListRevisionsResponse) XXX_Size() int {
+	return xxx_messageInfo_ListRevisionsResponse.Size(m)
+}
+func (m *ListRevisionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListRevisionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListRevisionsResponse proto.InternalMessageInfo
+
+func (m *ListRevisionsResponse) GetRevisions() []*Revision {
+	if m != nil {
+		return m.Revisions
+	}
+	return nil
+}
+
+func (m *ListRevisionsResponse) GetNextPageToken() string {
+	if m != nil {
+		return m.NextPageToken
+	}
+	return ""
+}
+
+type GetRevisionRequest struct {
+	ModelId              string   `protobuf:"bytes,1,opt,name=modelId,proto3" json:"modelId,omitempty"`
+	HyperparametersId    string   `protobuf:"bytes,2,opt,name=hyperparametersId,proto3" json:"hyperparametersId,omitempty"`
+	Version              int64    `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetRevisionRequest) Reset()         { *m = GetRevisionRequest{} }
+func (m *GetRevisionRequest) String() string { return proto.CompactTextString(m) }
+func (*GetRevisionRequest) ProtoMessage()    {}
+func (*GetRevisionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_10d86afa5a89ec9d, []int{65}
+}
+
+func (m *GetRevisionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetRevisionRequest.Unmarshal(m, b)
+}
+func (m *GetRevisionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetRevisionRequest.Marshal(b, m, deterministic)
+}
+func (m *GetRevisionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetRevisionRequest.Merge(m, src)
+}
+func (m *GetRevisionRequest) XXX_Size() int {
+	return xxx_messageInfo_GetRevisionRequest.Size(m)
+}
+func (m *GetRevisionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetRevisionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetRevisionRequest proto.InternalMessageInfo
+
+func (m *GetRevisionRequest) GetModelId() string {
+	if m != nil {
+		return m.ModelId
+	}
+	return ""
+}
+
+func (m *GetRevisionRequest) GetHyperparametersId() string {
+	if m != nil {
+		return m.HyperparametersId
+	}
+	return ""
+}
+
+func (m *GetRevisionRequest) GetVersion() int64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+type GetRevisionResponse struct {
+	Revision             *Revision `protobuf:"bytes,1,opt,name=revision,proto3" json:"revision,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *GetRevisionResponse) Reset()         { *m = GetRevisionResponse{} }
+func (m *GetRevisionResponse) String() string { return proto.CompactTextString(m) }
+func (*GetRevisionResponse) ProtoMessage()    {}
+func (*GetRevisionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_10d86afa5a89ec9d, []int{66}
+}
+
+func (m *GetRevisionResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetRevisionResponse.Unmarshal(m, b)
+}
+func (m *GetRevisionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetRevisionResponse.Marshal(b, m, deterministic)
+}
+func (m *GetRevisionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetRevisionResponse.Merge(m, src)
+}
+func (m *GetRevisionResponse) XXX_Size() int {
+	return xxx_messageInfo_GetRevisionResponse.Size(m)
+}
+func (m *GetRevisionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetRevisionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetRevisionResponse proto.InternalMessageInfo
+
+func (m *GetRevisionResponse) GetRevision() *Revision {
+	if m != nil {
+		return m.Revision
+	}
+	return nil
+}
+
+// Restores the revision at version by replacing the current state with it, which stores it as a new
+// revision. Fields set since the revision are cleared.
+type RollbackRequest struct {
+	ModelId           string `protobuf:"bytes,1,opt,name=modelId,proto3" json:"modelId,omitempty"`
+	HyperparametersId string `protobuf:"bytes,2,opt,name=hyperparametersId,proto3" json:"hyperparametersId,omitempty"`
+	Version           int64  `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`
+	// As in UpdateModelRequest and UpdateHyperparametersRequest
+	ExpectedVersion      int64    `protobuf:"varint,4,opt,name=expectedVersion,proto3" json:"expectedVersion,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RollbackRequest) Reset()         { *m = RollbackRequest{} }
+func (m *RollbackRequest) String() string { return proto.CompactTextString(m) }
+func (*RollbackRequest) ProtoMessage()    {}
+func (*RollbackRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_10d86afa5a89ec9d, []int{67}
+}
+
+func (m *RollbackRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RollbackRequest.Unmarshal(m, b)
+}
+func (m *RollbackRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RollbackRequest.Marshal(b, m, deterministic)
+}
+func (m *RollbackRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RollbackRequest.Merge(m, src)
+}
+func (m *RollbackRequest) XXX_Size() int {
+	return xxx_messageInfo_RollbackRequest.Size(m)
+}
+func (m *RollbackRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RollbackRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RollbackRequest proto.InternalMessageInfo
+
+func (m *RollbackRequest) GetModelId() string {
+	if m != nil {
+		return m.ModelId
+	}
+	return ""
+}
+
+func (m *RollbackRequest) GetHyperparametersId() string {
+	if m != nil {
+		return m.HyperparametersId
+	}
+	return ""
+}
+
+func (m *RollbackRequest) GetVersion() int64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *RollbackRequest) GetExpectedVersion() int64 {
+	if m != nil {
+		return m.ExpectedVersion
+	}
+	return 0
+}
+
+// The revision created by the rollback.
+type RollbackResponse struct {
+	Revision             *Revision `protobuf:"bytes,1,opt,name=revision,proto3" json:"revision,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *RollbackResponse) Reset()         { *m = RollbackResponse{} }
+func (m *RollbackResponse) String() string { return proto.CompactTextString(m) }
+func (*RollbackResponse) ProtoMessage()    {}
+func (*RollbackResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_10d86afa5a89ec9d, []int{68}
+}
+
+func (m *RollbackResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RollbackResponse.Unmarshal(m, b)
+}
+func (m *RollbackResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RollbackResponse.Marshal(b, m, deterministic)
+}
+func (m *RollbackResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RollbackResponse.Merge(m, src)
+}
+func (m *RollbackResponse) XXX_Size() int {
+	return xxx_messageInfo_RollbackResponse.Size(m)
+}
+func (m *RollbackResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RollbackResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RollbackResponse proto.InternalMessageInfo
+
+func (m *RollbackResponse) GetRevision() *Revision {
+	if m != nil {
+		return m.Revision
+	}
+	return nil
+}
+
+type WatchModelRequest struct {
+	ModelId string `protobuf:"bytes,1,opt,name=modelId,proto3" json:"modelId,omitempty"`
+	// The eventId of the last event received, to resume watching after it. Watching starts with
+	// the next change if it is empty.
+	ResumeToken          string   `protobuf:"bytes,2,opt,name=resumeToken,proto3" json:"resumeToken,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *WatchModelRequest) Reset()         { *m = WatchModelRequest{} }
+func (m *WatchModelRequest) String() string { return proto.CompactTextString(m) }
+func (*WatchModelRequest) ProtoMessage()    {}
+func (*WatchModelRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_10d86afa5a89ec9d, []int{69}
+}
+
+func (m *WatchModelRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WatchModelRequest.Unmarshal(m, b)
+}
+func (m *WatchModelRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WatchModelRequest.Marshal(b, m, deterministic)
+}
+func (m *WatchModelRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WatchModelRequest.Merge(m, src)
+}
+func (m *WatchModelRequest) XXX_Size() int {
+	return xxx_messageInfo_WatchModelRequest.Size(m)
+}
+func (m *WatchModelRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_WatchModelRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WatchModelRequest proto.InternalMessageInfo
+
+func (m *WatchModelRequest) GetModelId() string {
+	if m != nil {
+		return m.ModelId
+	}
+	return ""
+}
+
+func (m *WatchModelRequest) GetResumeToken() string {
+	if m != nil {
+		return m.ResumeToken
+	}
+	return ""
+}
+
+// A change to a model, one of its hyperparameters or one of their checkpoints, or to a tag on the
+// model or its hyperparameters.
+type WatchModelEvent struct {
+	// The ID of the audit event which recorded the change; pass it as resumeToken to resume
+	EventId string               `protobuf:"bytes,1,opt,name=eventId,proto3" json:"eventId,omitempty"`
+	Time    *timestamp.Timestamp `protobuf:"bytes,2,opt,name=time,proto3" json:"time,omitempty"`
+	Type    WatchModelEvent_Type `protobuf:"varint,3,opt,name=type,proto3,enum=api.WatchModelEvent_Type" json:"type,omitempty"`
+	// The gRPC method which made the change, e.g. /api.Repository/UpdateHyperparameters
+	Method       string `protobuf:"bytes,4,opt,name=method,proto3" json:"method,omitempty"`
+	ResourcePath string `protobuf:"bytes,5,opt,name=resourcePath,proto3" json:"resourcePath,omitempty"`
+	ModelId      string `protobuf:"bytes,6,opt,name=modelId,proto3" json:"modelId,omitempty"`
+	// Empty unless the changed resource is a set of hyperparameters or a checkpoint
+	HyperparametersId string `protobuf:"bytes,7,opt,name=hyperparametersId,proto3" json:"hyperparametersId,omitempty"`
+	// Empty unless the changed resource is a checkpoint
+	CheckpointId string `protobuf:"bytes,8,opt,name=checkpointId,proto3" json:"checkpointId,omitempty"`
+	// JSON encoding of the resource after the change, empty if it was deleted
+	After string `protobuf:"bytes,9,opt,name=after,proto3" json:"after,omitempty"`
+	// Empty unless the changed resource is a tag
+	Tag                  string   `protobuf:"bytes,10,opt,name=tag,proto3" json:"tag,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *WatchModelEvent) Reset()         { *m = WatchModelEvent{} }
+func (m *WatchModelEvent) String() string { return proto.CompactTextString(m) }
+func (*WatchModelEvent) ProtoMessage()    {}
+func (*WatchModelEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_10d86afa5a89ec9d, []int{70}
+}
+
+func (m *WatchModelEvent) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WatchModelEvent.Unmarshal(m, b)
+}
+func (m *WatchModelEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WatchModelEvent.Marshal(b, m, deterministic)
+}
+func (m *WatchModelEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WatchModelEvent.Merge(m, src)
+}
+func (m *WatchModelEvent) XXX_Size() int {
+	return xxx_messageInfo_WatchModelEvent.Size(m)
+}
+func (m *WatchModelEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_WatchModelEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WatchModelEvent proto.InternalMessageInfo
+
+func (m *WatchModelEvent) GetEventId() string {
+	if m != nil {
+		return m.EventId
+	}
+	return ""
+}
+
+func (m *WatchModelEvent) GetTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.Time
+	}
+	return nil
+}
+
+func (m *WatchModelEvent) GetType() WatchModelEvent_Type {
+	if m != nil {
+		return m.Type
+	}
+	return WatchModelEvent_UNKNOWN
+}
+
+func (m *WatchModelEvent) GetMethod() string {
+	if m != nil {
+		return m.Method
+	}
+	return ""
+}
+
+func (m *WatchModelEvent) GetResourcePath() string {
+	if m != nil {
+		return m.ResourcePath
+	}
+	return ""
+}
+
+func (m *WatchModelEvent) GetModelId() string {
+	if m != nil {
+		return m.ModelId
+	}
+	return ""
+}
+
+func (m *WatchModelEvent) GetHyperparametersId() string {
+	if m != nil {
+		return m.HyperparametersId
+	}
+	return ""
+}
+
+func (m *WatchModelEvent) GetCheckpointId() string {
+	if m != nil {
+		return m.CheckpointId
+	}
+	return ""
+}
+
+func (m *WatchModelEvent) GetAfter() string {
+	if m != nil {
+		return m.After
+	}
+	return ""
+}
+
+func (m *WatchModelEvent) GetTag() string {
+	if m != nil {
+		return m.Tag
+	}
+	return ""
+}
+
+// A named pointer, such as production or staging, to one of the hyperparameters of a model (for
+// tags on the model) or to one of the checkpoints of a set of hyperparameters (for tags on the
+// hyperparameters). Unlike canonicalHyperparameters and canonicalCheckpoint, a model or set of
+// hyperparameters can have any number of tags.
+type Tag struct {
+	ModelId string `protobuf:"bytes,1,opt,name=modelId,proto3" json:"modelId,omitempty"`
+	// Empty for tags on the model
+	HyperparametersId string `protobuf:"bytes,2,opt,name=hyperparametersId,proto3" json:"hyperparametersId,omitempty"`
+	Tag               string `protobuf:"bytes,3,opt,name=tag,proto3" json:"tag,omitempty"`
+	// The ID of the tagged hyperparameters or checkpoint
+	Target               string               `protobuf:"bytes,4,opt,name=target,proto3" json:"target,omitempty"`
+	UpdatedAt            *timestamp.Timestamp `protobuf:"bytes,5,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *Tag) Reset()         { *m = Tag{} }
+func (m *Tag) String() string { return proto.CompactTextString(m) }
+func (*Tag) ProtoMessage()    {}
+func (*Tag) Descriptor() ([]byte, []int) {
+	return fileDescriptor_10d86afa5a89ec9d, []int{71}
+}
+
+func (m *Tag) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Tag.Unmarshal(m, b)
+}
+func (m *Tag) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Tag.Marshal(b, m, deterministic)
+}
+func (m *Tag) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Tag.Merge(m, src)
+}
+func (m *Tag) XXX_Size() int {
+	return xxx_messageInfo_Tag.Size(m)
+}
+func (m *Tag) XXX_DiscardUnknown() {
+	xxx_messageInfo_Tag.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Tag proto.InternalMessageInfo
+
+func (m *Tag) GetModelId() string {
+	if m != nil {
+		return m.ModelId
+	}
+	return ""
+}
+
+func (m *Tag) GetHyperparametersId() string {
+	if m != nil {
+		return m.HyperparametersId
+	}
+	return ""
+}
+
+func (m *Tag) GetTag() string {
+	if m != nil {
+		return m.Tag
+	}
+	return ""
+}
+
+func (m *Tag) GetTarget() string {
+	if m != nil {
+		return m.Target
+	}
+	return ""
+}
+
+func (m *Tag) GetUpdatedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.UpdatedAt
+	}
+	return nil
+}
+
+// Adds the tag, or moves it to target if it already exists. Tags are set on the model unless
+// hyperparametersId is set.
+type SetTagRequest struct {
+	ModelId              string   `protobuf:"bytes,1,opt,name=modelId,proto3" json:"modelId,omitempty"`
+	HyperparametersId    string   `protobuf:"bytes,2,opt,name=hyperparametersId,proto3" json:"hyperparametersId,omitempty"`
+	Tag                  string   `protobuf:"bytes,3,opt,name=tag,proto3" json:"tag,omitempty"`
+	Target               string   `protobuf:"bytes,4,opt,name=target,proto3" json:"target,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SetTagRequest) Reset()         { *m = SetTagRequest{} }
+func (m *SetTagRequest) String() string { return proto.CompactTextString(m) }
+func (*SetTagRequest) ProtoMessage()    {}
+func (*SetTagRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_10d86afa5a89ec9d, []int{72}
+}
+
+func (m *SetTagRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetTagRequest.Unmarshal(m, b)
+}
+func (m *SetTagRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetTagRequest.Marshal(b, m, deterministic)
+}
+func (m *SetTagRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetTagRequest.Merge(m, src)
+}
+func (m *SetTagRequest) XXX_Size() int {
+	return xxx_messageInfo_SetTagRequest.Size(m)
+}
+func (m *SetTagRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetTagRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetTagRequest proto.InternalMessageInfo
+
+func (m *SetTagRequest) GetModelId() string {
+	if m != nil {
+		return m.ModelId
+	}
+	return ""
+}
+
+func (m *SetTagRequest) GetHyperparametersId() string {
+	if m != nil {
+		return m.HyperparametersId
+	}
+	return ""
+}
+
+func (m *SetTagRequest) GetTag() string {
+	if m != nil {
+		return m.Tag
+	}
+	return ""
+}
+
+func (m *SetTagRequest) GetTarget() string {
+	if m != nil {
+		return m.Target
+	}
+	return ""
+}
+
+type SetTagResponse struct {
+	Tag                  *Tag     `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SetTagResponse) Reset()         { *m = SetTagResponse{} }
+func (m *SetTagResponse) String() string { return proto.CompactTextString(m) }
+func (*SetTagResponse) ProtoMessage()    {}
+func (*SetTagResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_10d86afa5a89ec9d, []int{73}
+}
+
+func (m *SetTagResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetTagResponse.Unmarshal(m, b)
+}
+func (m *SetTagResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetTagResponse.Marshal(b, m, deterministic)
+}
+func (m *SetTagResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetTagResponse.Merge(m, src)
+}
+func (m *SetTagResponse) XXX_Size() int {
+	return xxx_messageInfo_SetTagResponse.Size(m)
+}
+func (m *SetTagResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetTagResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetTagResponse proto.InternalMessageInfo
+
+func (m *SetTagResponse) GetTag() *Tag {
+	if m != nil {
+		return m.Tag
+	}
+	return nil
+}
+
+type GetTagRequest struct {
+	ModelId              string   `protobuf:"bytes,1,opt,name=modelId,proto3" json:"modelId,omitempty"`
+	HyperparametersId    string   `protobuf:"bytes,2,opt,name=hyperparametersId,proto3" json:"hyperparametersId,omitempty"`
+	Tag                  string   `protobuf:"bytes,3,opt,name=tag,proto3" json:"tag,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetTagRequest) Reset()         { *m = GetTagRequest{} }
+func (m *GetTagRequest) String() string { return proto.CompactTextString(m) }
+func (*GetTagRequest) ProtoMessage()    {}
+func (*GetTagRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_10d86afa5a89ec9d, []int{74}
+}
+
+func (m *GetTagRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetTagRequest.Unmarshal(m, b)
+}
+func (m *GetTagRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetTagRequest.Marshal(b, m, deterministic)
+}
+func (m *GetTagRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetTagRequest.Merge(m, src)
+}
+func (m *GetTagRequest) XXX_Size() int {
+	return xxx_messageInfo_GetTagRequest.Size(m)
+}
+func (m *GetTagRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetTagRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetTagRequest proto.InternalMessageInfo
+
+func (m *GetTagRequest) GetModelId() string {
+	if m != nil {
+		return m.ModelId
+	}
+	return ""
+}
+
+func (m *GetTagRequest) GetHyperparametersId() string {
+	if m != nil {
+		return m.HyperparametersId
+	}
+	return ""
+}
+
+func (m *GetTagRequest) GetTag() string {
+	if m != nil {
+		return m.Tag
+	}
+	return ""
+}
+
+type GetTagResponse struct {
+	Tag                  *Tag     `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetTagResponse) Reset()         { *m = GetTagResponse{} }
+func (m *GetTagResponse) String() string { return proto.CompactTextString(m) }
+func (*GetTagResponse) ProtoMessage()    {}
+func (*GetTagResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_10d86afa5a89ec9d, []int{75}
+}
+
+func (m *GetTagResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetTagResponse.Unmarshal(m, b)
+}
+func (m *GetTagResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetTagResponse.Marshal(b, m, deterministic)
+}
+func (m *GetTagResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetTagResponse.Merge(m, src)
+}
+func (m *GetTagResponse) XXX_Size() int {
+	return xxx_messageInfo_GetTagResponse.Size(m)
+}
+func (m *GetTagResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetTagResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetTagResponse proto.InternalMessageInfo
+
+func (m *GetTagResponse) GetTag() *Tag {
+	if m != nil {
+		return m.Tag
+	}
+	return nil
+}
+
+type ListTagsRequest struct {
+	ModelId              string   `protobuf:"bytes,1,opt,name=modelId,proto3" json:"modelId,omitempty"`
+	HyperparametersId    string   `protobuf:"bytes,2,opt,name=hyperparametersId,proto3" json:"hyperparametersId,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListTagsRequest) Reset()         { *m = ListTagsRequest{} }
+func (m *ListTagsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListTagsRequest) ProtoMessage()    {}
+func (*ListTagsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_10d86afa5a89ec9d, []int{76}
+}
+
+func (m *ListTagsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListTagsRequest.Unmarshal(m, b)
+}
+func (m *ListTagsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListTagsRequest.Marshal(b, m, deterministic)
+}
+func (m *ListTagsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListTagsRequest.Merge(m, src)
+}
+func (m *ListTagsRequest) XXX_Size() int {
+	return xxx_messageInfo_ListTagsRequest.Size(m)
+}
+func (m *ListTagsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListTagsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListTagsRequest proto.InternalMessageInfo
+
+func (m *ListTagsRequest) GetModelId() string {
+	if m != nil {
+		return m.ModelId
+	}
+	return ""
+}
+
+func (m *ListTagsRequest) GetHyperparametersId() string {
+	if m != nil {
+		return m.HyperparametersId
+	}
+	return ""
+}
+
+// Tags are listed by name.
+type ListTagsResponse struct {
+	Tags                 []*Tag   `protobuf:"bytes,1,rep,name=tags,proto3" json:"tags,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListTagsResponse) Reset()         { *m = ListTagsResponse{} }
+func (m *ListTagsResponse) String() string { return proto.CompactTextString(m) }
+func (*ListTagsResponse) ProtoMessage()    {}
+func (*ListTagsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_10d86afa5a89ec9d, []int{77}
+}
+
+func (m *ListTagsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListTagsResponse.Unmarshal(m, b)
+}
+func (m *ListTagsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListTagsResponse.Marshal(b, m, deterministic)
+}
+func (m *ListTagsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListTagsResponse.Merge(m, src)
+}
+func (m *ListTagsResponse) XXX_Size() int {
+	return xxx_messageInfo_ListTagsResponse.Size(m)
+}
+func (m *ListTagsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListTagsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListTagsResponse proto.InternalMessageInfo
+
+func (m *ListTagsResponse) GetTags() []*Tag {
+	if m != nil {
+		return m.Tags
+	}
+	return nil
+}
+
+type DeleteTagRequest struct {
+	ModelId              string   `protobuf:"bytes,1,opt,name=modelId,proto3" json:"modelId,omitempty"`
+	HyperparametersId    string   `protobuf:"bytes,2,opt,name=hyperparametersId,proto3" json:"hyperparametersId,omitempty"`
+	Tag                  string   `protobuf:"bytes,3,opt,name=tag,proto3" json:"tag,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteTagRequest) Reset()         { *m = DeleteTagRequest{} }
+func (m *DeleteTagRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteTagRequest) ProtoMessage()    {}
+func (*DeleteTagRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_10d86afa5a89ec9d, []int{78}
+}
+
+func (m *DeleteTagRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteTagRequest.Unmarshal(m, b)
+}
+func (m *DeleteTagRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteTagRequest.Marshal(b, m, deterministic)
+}
+func (m *DeleteTagRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteTagRequest.Merge(m, src)
+}
+func (m *DeleteTagRequest) XXX_Size() int {
+	return xxx_messageInfo_DeleteTagRequest.Size(m)
+}
+func (m *DeleteTagRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteTagRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteTagRequest proto.InternalMessageInfo
+
+func (m *DeleteTagRequest) GetModelId() string {
+	if m != nil {
+		return m.ModelId
+	}
+	return ""
+}
+
+func (m *DeleteTagRequest) GetHyperparametersId() string {
+	if m != nil {
+		return m.HyperparametersId
+	}
+	return ""
+}
+
+func (m *DeleteTagRequest) GetTag() string {
+	if m != nil {
+		return m.Tag
+	}
+	return ""
+}
+
+// The deleted tag.
+type DeleteTagResponse struct {
+	Tag                  *Tag     `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteTagResponse) Reset()         { *m = DeleteTagResponse{} }
+func (m *DeleteTagResponse) String() string { return proto.CompactTextString(m) }
+func (*DeleteTagResponse) ProtoMessage()    {}
+func (*DeleteTagResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_10d86afa5a89ec9d, []int{79}
+}
+
+func (m *DeleteTagResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteTagResponse.Unmarshal(m, b)
+}
+func (m *DeleteTagResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteTagResponse.Marshal(b, m, deterministic)
+}
+func (m *DeleteTagResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteTagResponse.Merge(m, src)
+}
+func (m *DeleteTagResponse) XXX_Size() int {
+	return xxx_messageInfo_DeleteTagResponse.Size(m)
+}
+func (m *DeleteTagResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteTagResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteTagResponse proto.InternalMessageInfo
+
+func (m *DeleteTagResponse) GetTag() *Tag {
+	if m != nil {
+		return m.Tag
+	}
+	return nil
+}
+
+// A checkpoint which is being rolled out to weight percent of the clients of its hyperparameters.
+type RolloutTarget struct {
+	CheckpointId         string   `protobuf:"bytes,1,opt,name=checkpointId,proto3" json:"checkpointId,omitempty"`
+	Weight               int32    `protobuf:"varint,2,opt,name=weight,proto3" json:"weight,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RolloutTarget) Reset()         { *m = RolloutTarget{} }
+func (m *RolloutTarget) String() string { return proto.CompactTextString(m) }
+func (*RolloutTarget) ProtoMessage()    {}
+func (*RolloutTarget) Descriptor() ([]byte, []int) {
+	return fileDescriptor_10d86afa5a89ec9d, []int{80}
+}
+
+func (m *RolloutTarget) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RolloutTarget.Unmarshal(m, b)
+}
+func (m *RolloutTarget) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RolloutTarget.Marshal(b, m, deterministic)
+}
+func (m *RolloutTarget) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RolloutTarget.Merge(m, src)
+}
+func (m *RolloutTarget) XXX_Size() int {
+	return xxx_messageInfo_RolloutTarget.Size(m)
+}
+func (m *RolloutTarget) XXX_DiscardUnknown() {
+	xxx_messageInfo_RolloutTarget.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RolloutTarget proto.InternalMessageInfo
+
+func (m *RolloutTarget) GetCheckpointId() string {
+	if m != nil {
+		return m.CheckpointId
+	}
+	return ""
+}
+
+func (m *RolloutTarget) GetWeight() int32 {
+	if m != nil {
+		return m.Weight
+	}
+	return 0
+}
+
+type RampRolloutRequest struct {
+	ModelId           string `protobuf:"bytes,1,opt,name=modelId,proto3" json:"modelId,omitempty"`
+	HyperparametersId string `protobuf:"bytes,2,opt,name=hyperparametersId,proto3" json:"hyperparametersId,omitempty"`
+	// Replaces the current rollout, or starts one. Clients are hashed into 100 buckets, which are
+	// handed out to the targets in order; clients in the remaining buckets get the
+	// canonicalCheckpoint. Weights are percentages and must add up to at most 100.
+	Rollout []*RolloutTarget `protobuf:"bytes,3,rep,name=rollout,proto3" json:"rollout,omitempty"`
+	// As in UpdateHyperparametersRequest
+	ExpectedVersion      int64    `protobuf:"varint,4,opt,name=expectedVersion,proto3" json:"expectedVersion,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RampRolloutRequest) Reset()         { *m = RampRolloutRequest{} }
+func (m *RampRolloutRequest) String() string { return proto.CompactTextString(m) }
+func (*RampRolloutRequest) ProtoMessage()    {}
+func (*RampRolloutRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_10d86afa5a89ec9d, []int{81}
+}
+
+func (m *RampRolloutRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RampRolloutRequest.Unmarshal(m, b)
+}
+func (m *RampRolloutRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RampRolloutRequest.Marshal(b, m, deterministic)
+}
+func (m *RampRolloutRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RampRolloutRequest.Merge(m, src)
+}
+func (m *RampRolloutRequest) XXX_Size() int {
+	return xxx_messageInfo_RampRolloutRequest.Size(m)
+}
+func (m *RampRolloutRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RampRolloutRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RampRolloutRequest proto.InternalMessageInfo
+
+func (m *RampRolloutRequest) GetModelId() string {
+	if m != nil {
+		return m.ModelId
+	}
+	return ""
+}
+
+func (m *RampRolloutRequest) GetHyperparametersId() string {
+	if m != nil {
+		return m.HyperparametersId
+	}
+	return ""
+}
+
+func (m *RampRolloutRequest) GetRollout() []*RolloutTarget {
+	if m != nil {
+		return m.Rollout
+	}
+	return nil
+}
+
+func (m *RampRolloutRequest) GetExpectedVersion() int64 {
+	if m != nil {
+		return m.ExpectedVersion
+	}
+	return 0
+}
+
+type RampRolloutResponse struct {
+	Hyperparameters      *GetHyperparametersResponse `protobuf:"bytes,1,opt,name=hyperparameters,proto3" json:"hyperparameters,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                    `json:"-"`
+	XXX_unrecognized     []byte                      `json:"-"`
+	XXX_sizecache        int32                       `json:"-"`
+}
+
+func (m *RampRolloutResponse) Reset()         { *m = RampRolloutResponse{} }
+func (m *RampRolloutResponse) String() string { return proto.CompactTextString(m) }
+func (*RampRolloutResponse) ProtoMessage()    {}
+func (*RampRolloutResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_10d86afa5a89ec9d, []int{82}
+}
+
+func (m *RampRolloutResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RampRolloutResponse.Unmarshal(m, b)
+}
+func (m *RampRolloutResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RampRolloutResponse.Marshal(b, m, deterministic)
+}
+func (m *RampRolloutResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RampRolloutResponse.Merge(m, src)
+}
+func (m *RampRolloutResponse) XXX_Size() int {
+	return xxx_messageInfo_RampRolloutResponse.Size(m)
+}
+func (m *RampRolloutResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RampRolloutResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RampRolloutResponse proto.InternalMessageInfo
+
+func (m *RampRolloutResponse) GetHyperparameters() *GetHyperparametersResponse {
+	if m != nil {
+		return m.Hyperparameters
+	}
+	return nil
+}
+
+// Ends the rollout, sending every client back to the canonicalCheckpoint.
+type AbortRolloutRequest struct {
+	ModelId              string   `protobuf:"bytes,1,opt,name=modelId,proto3" json:"modelId,omitempty"`
+	HyperparametersId    string   `protobuf:"bytes,2,opt,name=hyperparametersId,proto3" json:"hyperparametersId,omitempty"`
+	ExpectedVersion      int64    `protobuf:"varint,3,opt,name=expectedVersion,proto3" json:"expectedVersion,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AbortRolloutRequest) Reset()         { *m = AbortRolloutRequest{} }
+func (m *AbortRolloutRequest) String() string { return proto.CompactTextString(m) }
+func (*AbortRolloutRequest) ProtoMessage()    {}
+func (*AbortRolloutRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_10d86afa5a89ec9d, []int{83}
+}
+
+func (m *AbortRolloutRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AbortRolloutRequest.Unmarshal(m, b)
+}
+func (m *AbortRolloutRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AbortRolloutRequest.Marshal(b, m, deterministic)
+}
+func (m *AbortRolloutRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AbortRolloutRequest.Merge(m, src)
+}
+func (m *AbortRolloutRequest) XXX_Size() int {
+	return xxx_messageInfo_AbortRolloutRequest.Size(m)
+}
+func (m *AbortRolloutRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AbortRolloutRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AbortRolloutRequest proto.InternalMessageInfo
+
+func (m *AbortRolloutRequest) GetModelId() string {
+	if m != nil {
+		return m.ModelId
+	}
+	return ""
+}
+
+func (m *AbortRolloutRequest) GetHyperparametersId() string {
+	if m != nil {
+		return m.HyperparametersId
+	}
+	return ""
+}
+
+func (m *AbortRolloutRequest) GetExpectedVersion() int64 {
+	if m != nil {
+		return m.ExpectedVersion
+	}
+	return 0
+}
+
+type AbortRolloutResponse struct {
+	Hyperparameters      *GetHyperparametersResponse `protobuf:"bytes,1,opt,name=hyperparameters,proto3" json:"hyperparameters,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                    `json:"-"`
+	XXX_unrecognized     []byte                      `json:"-"`
+	XXX_sizecache        int32                       `json:"-"`
+}
+
+func (m *AbortRolloutResponse) Reset()         { *m = AbortRolloutResponse{} }
+func (m *AbortRolloutResponse) String() string { return proto.CompactTextString(m) }
+func (*AbortRolloutResponse) ProtoMessage()    {}
+func (*AbortRolloutResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_10d86afa5a89ec9d, []int{84}
+}
+
+func (m *AbortRolloutResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AbortRolloutResponse.Unmarshal(m, b)
+}
+func (m *AbortRolloutResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AbortRolloutResponse.Marshal(b, m, deterministic)
+}
+func (m *AbortRolloutResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AbortRolloutResponse.Merge(m, src)
+}
+func (m *AbortRolloutResponse) XXX_Size() int {
+	return xxx_messageInfo_AbortRolloutResponse.Size(m)
+}
+func (m *AbortRolloutResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AbortRolloutResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AbortRolloutResponse proto.InternalMessageInfo
+
+func (m *AbortRolloutResponse) GetHyperparameters() *GetHyperparametersResponse {
+	if m != nil {
+		return m.Hyperparameters
+	}
+	return nil
+}
+
+// Resolves the model as ResolveModel does, except that the checkpoint is picked for the given
+// client from the rollout of the resolved hyperparameters.
+type ResolveModelForClientRequest struct {
+	ModelId string `protobuf:"bytes,1,opt,name=modelId,proto3" json:"modelId,omitempty"`
+	// A stable ID of the client, such as a device ID. The same client always gets the same
+	// checkpoint for as long as the rollout is unchanged.
+	ClientId             string   `protobuf:"bytes,2,opt,name=clientId,proto3" json:"clientId,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ResolveModelForClientRequest) Reset()         { *m = ResolveModelForClientRequest{} }
+func (m *ResolveModelForClientRequest) String() string { return proto.CompactTextString(m) }
+func (*ResolveModelForClientRequest) ProtoMessage()    {}
+func (*ResolveModelForClientRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_10d86afa5a89ec9d, []int{85}
+}
+
+func (m *ResolveModelForClientRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ResolveModelForClientRequest.Unmarshal(m, b)
+}
+func (m *ResolveModelForClientRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ResolveModelForClientRequest.Marshal(b, m, deterministic)
+}
+func (m *ResolveModelForClientRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResolveModelForClientRequest.Merge(m, src)
+}
+func (m *ResolveModelForClientRequest) XXX_Size() int {
+	return xxx_messageInfo_ResolveModelForClientRequest.Size(m)
+}
+func (m *ResolveModelForClientRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResolveModelForClientRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResolveModelForClientRequest proto.InternalMessageInfo
+
+func (m *ResolveModelForClientRequest) GetModelId() string {
+	if m != nil {
+		return m.ModelId
+	}
+	return ""
+}
+
+func (m *ResolveModelForClientRequest) GetClientId() string {
+	if m != nil {
+		return m.ClientId
+	}
+	return ""
+}
+
+type ResolveModelForClientResponse struct {
+	Model           *Model                      `protobuf:"bytes,1,opt,name=model,proto3" json:"model,omitempty"`
+	Hyperparameters *GetHyperparametersResponse `protobuf:"bytes,2,opt,name=hyperparameters,proto3" json:"hyperparameters,omitempty"`
+	Checkpoint      *GetCheckpointResponse      `protobuf:"bytes,3,opt,name=checkpoint,proto3" json:"checkpoint,omitempty"`
+	UpgradePath     []string                    `protobuf:"bytes,4,rep,name=upgradePath,proto3" json:"upgradePath,omitempty"`
+	// The bucket (0-99) which the client falls into
+	Bucket int32 `protobuf:"varint,5,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	// Whether the checkpoint was picked from the rollout rather than being the canonicalCheckpoint
+	InRollout            bool     `protobuf:"varint,6,opt,name=inRollout,proto3" json:"inRollout,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ResolveModelForClientResponse) Reset()         { *m = ResolveModelForClientResponse{} }
+func (m *ResolveModelForClientResponse) String() string { return proto.CompactTextString(m) }
+func (*ResolveModelForClientResponse) ProtoMessage()    {}
+func (*ResolveModelForClientResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_10d86afa5a89ec9d, []int{86}
+}
+
+func (m *ResolveModelForClientResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ResolveModelForClientResponse.Unmarshal(m, b)
+}
+func (m *ResolveModelForClientResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ResolveModelForClientResponse.Marshal(b, m, deterministic)
+}
+func (m *ResolveModelForClientResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResolveModelForClientResponse.Merge(m, src)
+}
+func (m *ResolveModelForClientResponse) XXX_Size() int {
+	return xxx_messageInfo_ResolveModelForClientResponse.Size(m)
+}
+func (m *ResolveModelForClientResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResolveModelForClientResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResolveModelForClientResponse proto.InternalMessageInfo
+
+func (m *ResolveModelForClientResponse) GetModel() *Model {
+	if m != nil {
+		return m.Model
+	}
+	return nil
+}
+
+func (m *ResolveModelForClientResponse) GetHyperparameters() *GetHyperparametersResponse {
+	if m != nil {
+		return m.Hyperparameters
+	}
+	return nil
+}
+
+func (m *ResolveModelForClientResponse) GetCheckpoint() *GetCheckpointResponse {
+	if m != nil {
+		return m.Checkpoint
+	}
+	return nil
+}
+
+func (m *ResolveModelForClientResponse) GetUpgradePath() []string {
+	if m != nil {
+		return m.UpgradePath
+	}
+	return nil
+}
+
+func (m *ResolveModelForClientResponse) GetBucket() int32 {
+	if m != nil {
+		return m.Bucket
+	}
+	return 0
+}
+
+func (m *ResolveModelForClientResponse) GetInRollout() bool {
+	if m != nil {
+		return m.InRollout
+	}
+	return false
+}
+
+// Compares the numeric info entries (such as evaluation metrics) of some of the checkpoints of a
+// set of hyperparameters. Either checkpointIds or last must be set.
+type CompareCheckpointsRequest struct {
+	ModelId           string `protobuf:"bytes,1,opt,name=modelId,proto3" json:"modelId,omitempty"`
+	HyperparametersId string `protobuf:"bytes,2,opt,name=hyperparametersId,proto3" json:"hyperparametersId,omitempty"`
+	// The checkpoints to compare, in order. Deltas are computed against the first of them.
+	CheckpointIds []string `protobuf:"bytes,3,rep,name=checkpointIds,proto3" json:"checkpointIds,omitempty"`
+	// Compare the last N checkpoints to be created instead, oldest first.
+	Last            int32 `protobuf:"varint,4,opt,name=last,proto3" json:"last,omitempty"`
+	IncludeArchived bool  `protobuf:"varint,5,opt,name=includeArchived,proto3" json:"includeArchived,omitempty"`
+	// The metrics to compare, along with bestBy. All numeric info entries are compared if this is
+	// empty.
+	Metrics []string `protobuf:"bytes,6,rep,name=metrics,proto3" json:"metrics,omitempty"`
+	// If set, bestCheckpointId is the checkpoint with the highest value of this metric (or the lowest
+	// if lowerIsBetter is set, e.g. for losses).
+	BestBy               string   `protobuf:"bytes,7,opt,name=bestBy,proto3" json:"bestBy,omitempty"`
+	LowerIsBetter        bool     `protobuf:"varint,8,opt,name=lowerIsBetter,proto3" json:"lowerIsBetter,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CompareCheckpointsRequest) Reset()         { *m = CompareCheckpointsRequest{} }
+func (m *CompareCheckpointsRequest) String() string { return proto.CompactTextString(m) }
+func (*CompareCheckpointsRequest) ProtoMessage()    {}
+func (*CompareCheckpointsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_10d86afa5a89ec9d, []int{87}
+}
+
+func (m *CompareCheckpointsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CompareCheckpointsRequest.Unmarshal(m, b)
+}
+func (m *CompareCheckpointsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CompareCheckpointsRequest.Marshal(b, m, deterministic)
+}
+func (m *CompareCheckpointsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CompareCheckpointsRequest.Merge(m, src)
+}
+func (m *CompareCheckpointsRequest) XXX_Size() int {
+	return xxx_messageInfo_CompareCheckpointsRequest.Size(m)
+}
+func (m *CompareCheckpointsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CompareCheckpointsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CompareCheckpointsRequest proto.InternalMessageInfo
+
+func (m *CompareCheckpointsRequest) GetModelId() string {
+	if m != nil {
+		return m.ModelId
+	}
+	return ""
+}
+
+func (m *CompareCheckpointsRequest) GetHyperparametersId() string {
+	if m != nil {
+		return m.HyperparametersId
+	}
+	return ""
+}
+
+func (m *CompareCheckpointsRequest) GetCheckpointIds() []string {
+	if m != nil {
+		return m.CheckpointIds
+	}
+	return nil
+}
+
+func (m *CompareCheckpointsRequest) GetLast() int32 {
+	if m != nil {
+		return m.Last
+	}
+	return 0
+}
+
+func (m *CompareCheckpointsRequest) GetIncludeArchived() bool {
+	if m != nil {
+		return m.IncludeArchived
+	}
+	return false
+}
+
+func (m *CompareCheckpointsRequest) GetMetrics() []string {
+	if m != nil {
+		return m.Metrics
+	}
+	return nil
+}
+
+func (m *CompareCheckpointsRequest) GetBestBy() string {
+	if m != nil {
+		return m.BestBy
+	}
+	return ""
+}
+
+func (m *CompareCheckpointsRequest) GetLowerIsBetter() bool {
+	if m != nil {
+		return m.LowerIsBetter
+	}
+	return false
+}
+
+type CheckpointMetrics struct {
+	CheckpointId string               `protobuf:"bytes,1,opt,name=checkpointId,proto3" json:"checkpointId,omitempty"`
+	CreatedAt    *timestamp.Timestamp `protobuf:"bytes,2,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	Values       map[string]float64   `protobuf:"bytes,3,rep,name=values,proto3" json:"values,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed64,2,opt,name=value,proto3"`
+	// Each value minus the value of the same metric for the first checkpoint, for the metrics which
+	// both checkpoints have
+	Deltas               map[string]float64 `protobuf:"bytes,4,rep,name=deltas,proto3" json:"deltas,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed64,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *CheckpointMetrics) Reset()         { *m = CheckpointMetrics{} }
+func (m *CheckpointMetrics) String() string { return proto.CompactTextString(m) }
+func (*CheckpointMetrics) ProtoMessage()    {}
+func (*CheckpointMetrics) Descriptor() ([]byte, []int) {
+	return fileDescriptor_10d86afa5a89ec9d, []int{88}
+}
+
+func (m *CheckpointMetrics) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CheckpointMetrics.Unmarshal(m, b)
+}
+func (m *CheckpointMetrics) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CheckpointMetrics.Marshal(b, m, deterministic)
+}
+func (m *CheckpointMetrics) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CheckpointMetrics.Merge(m, src)
+}
+func (m *CheckpointMetrics) XXX_Size() int {
+	return xxx_messageInfo_CheckpointMetrics.Size(m)
+}
+func (m *CheckpointMetrics) XXX_DiscardUnknown() {
+	xxx_messageInfo_CheckpointMetrics.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CheckpointMetrics proto.InternalMessageInfo
+
+func (m *CheckpointMetrics) GetCheckpointId() string {
+	if m != nil {
+		return m.CheckpointId
+	}
+	return ""
+}
+
+func (m *CheckpointMetrics) GetCreatedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return nil
+}
+
+func (m *CheckpointMetrics) GetValues() map[string]float64 {
+	if m != nil {
+		return m.Values
+	}
+	return nil
+}
+
+func (m *CheckpointMetrics) GetDeltas() map[string]float64 {
+	if m != nil {
+		return m.Deltas
+	}
+	return nil
+}
+
+type CompareCheckpointsResponse struct {
+	ModelId           string `protobuf:"bytes,1,opt,name=modelId,proto3" json:"modelId,omitempty"`
+	HyperparametersId string `protobuf:"bytes,2,opt,name=hyperparametersId,proto3" json:"hyperparametersId,omitempty"`
+	// The metrics which at least one of the checkpoints has, sorted
+	Metrics     []string             `protobuf:"bytes,3,rep,name=metrics,proto3" json:"metrics,omitempty"`
+	Checkpoints []*CheckpointMetrics `protobuf:"bytes,4,rep,name=checkpoints,proto3" json:"checkpoints,omitempty"`
+	// Empty unless bestBy was set and at least one of the checkpoints has that metric
+	BestCheckpointId     string   `protobuf:"bytes,5,opt,name=bestCheckpointId,proto3" json:"bestCheckpointId,omitempty"`
+	BestValue            float64  `protobuf:"fixed64,6,opt,name=bestValue,proto3" json:"bestValue,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CompareCheckpointsResponse) Reset()         { *m = CompareCheckpointsResponse{} }
+func (m *CompareCheckpointsResponse) String() string { return proto.CompactTextString(m) }
+func (*CompareCheckpointsResponse) ProtoMessage()    {}
+func (*CompareCheckpointsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_10d86afa5a89ec9d, []int{89}
+}
+
+func (m *CompareCheckpointsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CompareCheckpointsResponse.Unmarshal(m, b)
+}
+func (m *CompareCheckpointsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CompareCheckpointsResponse.Marshal(b, m, deterministic)
+}
+func (m *CompareCheckpointsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CompareCheckpointsResponse.Merge(m, src)
+}
+func (m *CompareCheckpointsResponse) XXX_Size() int {
+	return xxx_messageInfo_CompareCheckpointsResponse.Size(m)
+}
+func (m *CompareCheckpointsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CompareCheckpointsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CompareCheckpointsResponse proto.InternalMessageInfo
+
+func (m *CompareCheckpointsResponse) GetModelId() string {
+	if m != nil {
+		return m.ModelId
+	}
+	return ""
+}
+
+func (m *CompareCheckpointsResponse) GetHyperparametersId() string {
+	if m != nil {
+		return m.HyperparametersId
+	}
+	return ""
+}
+
+func (m *CompareCheckpointsResponse) GetMetrics() []string {
+	if m != nil {
+		return m.Metrics
+	}
+	return nil
+}
+
+func (m *CompareCheckpointsResponse) GetCheckpoints() []*CheckpointMetrics {
+	if m != nil {
+		return m.Checkpoints
+	}
+	return nil
+}
+
+func (m *CompareCheckpointsResponse) GetBestCheckpointId() string {
+	if m != nil {
+		return m.BestCheckpointId
+	}
+	return ""
+}
+
+func (m *CompareCheckpointsResponse) GetBestValue() float64 {
+	if m != nil {
+		return m.BestValue
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterEnum("api.ListView", ListView_name, ListView_value)
+	proto.RegisterEnum("api.CheckpointState", CheckpointState_name, CheckpointState_value)
+	proto.RegisterEnum("api.HealthCheckResponse_ServingStatus", HealthCheckResponse_ServingStatus_name, HealthCheckResponse_ServingStatus_value)
+	proto.RegisterEnum("api.ConfigResponse_BackendType", ConfigResponse_BackendType_name, ConfigResponse_BackendType_value)
+	proto.RegisterEnum("api.WatchModelEvent_Type", WatchModelEvent_Type_name, WatchModelEvent_Type_value)
+	proto.RegisterType((*HealthCheckRequest)(nil), "api.HealthCheckRequest")
+	proto.RegisterType((*HealthCheckResponse)(nil), "api.HealthCheckResponse")
+	proto.RegisterType((*ConfigRequest)(nil), "api.ConfigRequest")
+	proto.RegisterType((*ConfigResponse)(nil), "api.ConfigResponse")
+	proto.RegisterType((*Model)(nil), "api.Model")
+	proto.RegisterMapType((map[string]string)(nil), "api.Model.LabelsEntry")
+	proto.RegisterType((*ModelCard)(nil), "api.ModelCard")
+	proto.RegisterType((*TensorSpec)(nil), "api.TensorSpec")
+	proto.RegisterType((*ListModelsRequest)(nil), "api.ListModelsRequest")
+	proto.RegisterType((*ListModelsResponse)(nil), "api.ListModelsResponse")
+	proto.RegisterType((*CreateModelRequest)(nil), "api.CreateModelRequest")
+	proto.RegisterType((*CreateModelResponse)(nil), "api.CreateModelResponse")
+	proto.RegisterType((*GetModelRequest)(nil), "api.GetModelRequest")
+	proto.RegisterType((*GetModelResponse)(nil), "api.GetModelResponse")
+	proto.RegisterMapType((map[string]string)(nil), "api.GetModelResponse.LabelsEntry")
+	proto.RegisterType((*UpdateModelRequest)(nil), "api.UpdateModelRequest")
+	proto.RegisterType((*UpdateModelResponse)(nil), "api.UpdateModelResponse")
+	proto.RegisterType((*DeleteModelRequest)(nil), "api.DeleteModelRequest")
+	proto.RegisterType((*DeleteModelResponse)(nil), "api.DeleteModelResponse")
+	proto.RegisterType((*ListHyperparametersRequest)(nil), "api.ListHyperparametersRequest")
+	proto.RegisterType((*ListHyperparametersResponse)(nil), "api.ListHyperparametersResponse")
+	proto.RegisterType((*CreateHyperparametersRequest)(nil), "api.CreateHyperparametersRequest")
+	proto.RegisterMapType((map[string]string)(nil), "api.CreateHyperparametersRequest.HyperparametersEntry")
+	proto.RegisterMapType((map[string]string)(nil), "api.CreateHyperparametersRequest.LabelsEntry")
+	proto.RegisterType((*CreateHyperparametersResponse)(nil), "api.CreateHyperparametersResponse")
+	proto.RegisterType((*GetHyperparametersRequest)(nil), "api.GetHyperparametersRequest")
+	proto.RegisterType((*GetHyperparametersResponse)(nil), "api.GetHyperparametersResponse")
+	proto.RegisterMapType((map[string]string)(nil), "api.GetHyperparametersResponse.HyperparametersEntry")
+	proto.RegisterMapType((map[string]string)(nil), "api.GetHyperparametersResponse.LabelsEntry")
+	proto.RegisterType((*UpdateHyperparametersRequest)(nil), "api.UpdateHyperparametersRequest")
+	proto.RegisterMapType((map[string]string)(nil), "api.UpdateHyperparametersRequest.HyperparametersEntry")
+	proto.RegisterMapType((map[string]string)(nil), "api.UpdateHyperparametersRequest.LabelsEntry")
+	proto.RegisterType((*UpdateHyperparametersResponse)(nil), "api.UpdateHyperparametersResponse")
+	proto.RegisterMapType((map[string]string)(nil), "api.UpdateHyperparametersResponse.HyperparametersEntry")
+	proto.RegisterMapType((map[string]string)(nil), "api.UpdateHyperparametersResponse.LabelsEntry")
+	proto.RegisterType((*DeleteHyperparametersRequest)(nil), "api.DeleteHyperparametersRequest")
+	proto.RegisterType((*DeleteHyperparametersResponse)(nil), "api.DeleteHyperparametersResponse")
+	proto.RegisterType((*ListCheckpointsRequest)(nil), "api.ListCheckpointsRequest")
+	proto.RegisterType((*ListCheckpointsResponse)(nil), "api.ListCheckpointsResponse")
+	proto.RegisterType((*CreateCheckpointRequest)(nil), "api.CreateCheckpointRequest")
+	proto.RegisterMapType((map[string]string)(nil), "api.CreateCheckpointRequest.InfoEntry")
+	proto.RegisterMapType((map[string]string)(nil), "api.CreateCheckpointRequest.LabelsEntry")
+	proto.RegisterType((*CreateCheckpointResponse)(nil), "api.CreateCheckpointResponse")
+	proto.RegisterType((*FinalizeCheckpointRequest)(nil), "api.FinalizeCheckpointRequest")
+	proto.RegisterType((*FinalizeCheckpointResponse)(nil), "api.FinalizeCheckpointResponse")
+	proto.RegisterType((*GetCheckpointRequest)(nil), "api.GetCheckpointRequest")
+	proto.RegisterType((*GetCheckpointResponse)(nil), "api.GetCheckpointResponse")
+	proto.RegisterMapType((map[string]string)(nil), "api.GetCheckpointResponse.InfoEntry")
+	proto.RegisterMapType((map[string]string)(nil), "api.GetCheckpointResponse.LabelsEntry")
+	proto.RegisterType((*GetCheckpointManifestRequest)(nil), "api.GetCheckpointManifestRequest")
+	proto.RegisterType((*GetCheckpointManifestResponse)(nil), "api.GetCheckpointManifestResponse")
+	proto.RegisterType((*UpdateCheckpointStateRequest)(nil), "api.UpdateCheckpointStateRequest")
+	proto.RegisterType((*UpdateCheckpointStateResponse)(nil), "api.UpdateCheckpointStateResponse")
+	proto.RegisterType((*DeleteCheckpointRequest)(nil), "api.DeleteCheckpointRequest")
+	proto.RegisterType((*DeleteCheckpointResponse)(nil), "api.DeleteCheckpointResponse")
+	proto.RegisterType((*ResolveModelRequest)(nil), "api.ResolveModelRequest")
+	proto.RegisterType((*ResolveModelResponse)(nil), "api.ResolveModelResponse")
+	proto.RegisterType((*UpgradeCheckRequest)(nil), "api.UpgradeCheckRequest")
+	proto.RegisterType((*UpgradeHop)(nil), "api.UpgradeHop")
+	proto.RegisterType((*UpgradeCheckResponse)(nil), "api.UpgradeCheckResponse")
+	proto.RegisterType((*FsckRequest)(nil), "api.FsckRequest")
+	proto.RegisterType((*DanglingReference)(nil), "api.DanglingReference")
+	proto.RegisterType((*FsckResponse)(nil), "api.FsckResponse")
+	proto.RegisterType((*AuditEvent)(nil), "api.AuditEvent")
+	proto.RegisterType((*ListAuditEventsRequest)(nil), "api.ListAuditEventsRequest")
+	proto.RegisterType((*ListAuditEventsResponse)(nil), "api.ListAuditEventsResponse")
+	proto.RegisterType((*Webhook)(nil), "api.Webhook")
+	proto.RegisterType((*CreateWebhookRequest)(nil), "api.CreateWebhookRequest")
+	proto.RegisterType((*CreateWebhookResponse)(nil), "api.CreateWebhookResponse")
+	proto.RegisterType((*ListWebhooksRequest)(nil), "api.ListWebhooksRequest")
+	proto.RegisterType((*ListWebhooksResponse)(nil), "api.ListWebhooksResponse")
+	proto.RegisterType((*DeleteWebhookRequest)(nil), "api.DeleteWebhookRequest")
+	proto.RegisterType((*DeleteWebhookResponse)(nil), "api.DeleteWebhookResponse")
+	proto.RegisterType((*WebhookDeadLetter)(nil), "api.WebhookDeadLetter")
+	proto.RegisterType((*ListWebhookDeadLettersRequest)(nil), "api.ListWebhookDeadLettersRequest")
+	proto.RegisterType((*ListWebhookDeadLettersResponse)(nil), "api.ListWebhookDeadLettersResponse")
+	proto.RegisterType((*Revision)(nil), "api.Revision")
+	proto.RegisterType((*ListRevisionsRequest)(nil), "api.ListRevisionsRequest")
+	proto.RegisterType((*ListRevisionsResponse)(nil), "api.ListRevisionsResponse")
+	proto.RegisterType((*GetRevisionRequest)(nil), "api.GetRevisionRequest")
+	proto.RegisterType((*GetRevisionResponse)(nil), "api.GetRevisionResponse")
+	proto.RegisterType((*RollbackRequest)(nil), "api.RollbackRequest")
+	proto.RegisterType((*RollbackResponse)(nil), "api.RollbackResponse")
+	proto.RegisterType((*WatchModelRequest)(nil), "api.WatchModelRequest")
+	proto.RegisterType((*WatchModelEvent)(nil), "api.WatchModelEvent")
+	proto.RegisterType((*Tag)(nil), "api.Tag")
+	proto.RegisterType((*SetTagRequest)(nil), "api.SetTagRequest")
+	proto.RegisterType((*SetTagResponse)(nil), "api.SetTagResponse")
+	proto.RegisterType((*GetTagRequest)(nil), "api.GetTagRequest")
+	proto.RegisterType((*GetTagResponse)(nil), "api.GetTagResponse")
+	proto.RegisterType((*ListTagsRequest)(nil), "api.ListTagsRequest")
+	proto.RegisterType((*ListTagsResponse)(nil), "api.ListTagsResponse")
+	proto.RegisterType((*DeleteTagRequest)(nil), "api.DeleteTagRequest")
+	proto.RegisterType((*DeleteTagResponse)(nil), "api.DeleteTagResponse")
+	proto.RegisterType((*RolloutTarget)(nil), "api.RolloutTarget")
+	proto.RegisterType((*RampRolloutRequest)(nil), "api.RampRolloutRequest")
+	proto.RegisterType((*RampRolloutResponse)(nil), "api.RampRolloutResponse")
+	proto.RegisterType((*AbortRolloutRequest)(nil), "api.AbortRolloutRequest")
+	proto.RegisterType((*AbortRolloutResponse)(nil), "api.AbortRolloutResponse")
+	proto.RegisterType((*ResolveModelForClientRequest)(nil), "api.ResolveModelForClientRequest")
+	proto.RegisterType((*ResolveModelForClientResponse)(nil), "api.ResolveModelForClientResponse")
+	proto.RegisterType((*CompareCheckpointsRequest)(nil), "api.CompareCheckpointsRequest")
+	proto.RegisterType((*CheckpointMetrics)(nil), "api.CheckpointMetrics")
+	proto.RegisterMapType((map[string]float64)(nil), "api.CheckpointMetrics.DeltasEntry")
+	proto.RegisterMapType((map[string]float64)(nil), "api.CheckpointMetrics.ValuesEntry")
+	proto.RegisterType((*CompareCheckpointsResponse)(nil), "api.CompareCheckpointsResponse")
+}
+
+func init() { proto.RegisterFile("repository.proto", fileDescriptor_10d86afa5a89ec9d) }
+
+var fileDescriptor_10d86afa5a89ec9d = []byte{
+	// 4450 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x5b, 0xdd, 0x6f, 0x1c, 0xd7,
+	0x75, 0xf7, 0xdd, 0x5d, 0x2e, 0x97, 0x67, 0x49, 0x71, 0x75, 0x49, 0x4a, 0xcb, 0x31, 0x65, 0xc9,
+	0xd7, 0x8a, 0x45, 0xd3, 0xd6, 0xae, 0x23, 0xbb, 0xb6, 0x4c, 0xb8, 0xa9, 0x29, 0x72, 0x45, 0x32,
+	0xa6, 0x48, 0x75, 0x48, 0x51, 0x95, 0x10, 0xc4, 0x1a, 0xee, 0x5e, 0x2e, 0xc7, 0x5c, 0xce, 0x6c,
+	0x67, 0x86, 0xa4, 0x28, 0x55, 0x45, 0x6b, 0x04, 0x48, 0x91, 0x22, 0xfd, 0x40, 0x80, 0xc4, 0xfd,
+	0x42, 0x8d, 0x02, 0x05, 0xd2, 0x06, 0x48, 0x11, 0xb4, 0x48, 0xda, 0xa7, 0xb6, 0xc8, 0x53, 0x91,
+	0x87, 0x3c, 0xf4, 0xa5, 0xf0, 0x4b, 0x51, 0xa0, 0x2f, 0x45, 0x5f, 0xfa, 0x27, 0x14, 0xf7, 0x63,
+	0xbe, 0x67, 0xf6, 0x43, 0x59, 0x4a, 0x46, 0xde, 0xe6, 0x9e, 0xfb, 0xf5, 0x3b, 0xe7, 0x9e, 0x7b,
+	0xee, 0xb9, 0xf7, 0x9c, 0x81, 0x92, 0x45, 0xdb, 0xa6, 0xad, 0x3b, 0xa6, 0x75, 0x52, 0x69, 0x5b,
+	0xa6, 0x63, 0xe2, 0xac, 0xd6, 0xd6, 0x95, 0x99, 0xa6, 0x69, 0x36, 0x5b, 0xb4, 0xaa, 0xb5, 0xf5,
+	0xaa, 0x66, 0x18, 0xa6, 0xa3, 0x39, 0xba, 0x69, 0xd8, 0xa2, 0x89, 0x72, 0x51, 0xd6, 0xf2, 0xd2,
+	0xce, 0xe1, 0x6e, 0xd5, 0xd1, 0x0f, 0xa8, 0xed, 0x68, 0x07, 0x6d, 0xd1, 0x80, 0x54, 0x00, 0xaf,
+	0x50, 0xad, 0xe5, 0xec, 0x2d, 0xee, 0xd1, 0xfa, 0xbe, 0x4a, 0x7f, 0xf3, 0x90, 0xda, 0x0e, 0x2e,
+	0xc3, 0xb0, 0x4d, 0xad, 0x23, 0xbd, 0x4e, 0xcb, 0xe8, 0x12, 0x9a, 0x1d, 0x51, 0xdd, 0x22, 0xf9,
+	0x63, 0x04, 0x13, 0xa1, 0x0e, 0x76, 0xdb, 0x34, 0x6c, 0x8a, 0xbf, 0x02, 0x79, 0xdb, 0xd1, 0x9c,
+	0x43, 0x9b, 0x77, 0x38, 0x73, 0xed, 0xd5, 0x8a, 0xd6, 0xd6, 0x2b, 0x09, 0x2d, 0x2b, 0x9b, 0x6c,
+	0x24, 0xa3, 0xb9, 0xc9, 0x5b, 0xab, 0xb2, 0x17, 0x99, 0x87, 0xb1, 0x50, 0x05, 0x2e, 0xc2, 0xf0,
+	0x9d, 0xf5, 0x0f, 0xd7, 0x37, 0xee, 0xae, 0x97, 0x5e, 0x60, 0x85, 0xcd, 0x9a, 0xba, 0xbd, 0xba,
+	0xbe, 0x5c, 0x42, 0x78, 0x1c, 0x8a, 0xeb, 0x1b, 0x5b, 0x1f, 0xb9, 0x84, 0x0c, 0x19, 0x87, 0xb1,
+	0x45, 0xd3, 0xd8, 0xd5, 0x9b, 0x12, 0x3e, 0xf9, 0x27, 0x04, 0x67, 0x5c, 0x8a, 0xc4, 0xb7, 0x00,
+	0xc5, 0x1d, 0xad, 0xbe, 0x4f, 0x8d, 0xc6, 0xd6, 0x49, 0x9b, 0x4a, 0x90, 0x17, 0x39, 0xc8, 0x70,
+	0xcb, 0xca, 0x0d, 0xbf, 0x99, 0x1a, 0xec, 0x43, 0x1a, 0x50, 0x0c, 0xd4, 0x31, 0x4c, 0xab, 0xeb,
+	0xdb, 0x0b, 0x6b, 0xab, 0x4b, 0xa5, 0x17, 0x30, 0x40, 0xfe, 0x56, 0xed, 0xd6, 0x86, 0x7a, 0xaf,
+	0x84, 0x70, 0x19, 0x26, 0x97, 0x37, 0x36, 0x96, 0xd7, 0x6a, 0x1f, 0x2d, 0xae, 0x6d, 0xdc, 0x59,
+	0xfa, 0x68, 0x73, 0x6b, 0x43, 0x5d, 0x58, 0xae, 0x95, 0x32, 0xf8, 0x0c, 0xc0, 0xcd, 0xd5, 0xb5,
+	0xda, 0xe6, 0xbd, 0xcd, 0xad, 0xda, 0xad, 0x52, 0x16, 0xe7, 0x21, 0xb3, 0xf9, 0x56, 0x29, 0xc7,
+	0x7a, 0xdf, 0xd8, 0x58, 0xdb, 0x5a, 0xba, 0x51, 0x1a, 0x22, 0x9f, 0x65, 0x60, 0xe8, 0x96, 0xd9,
+	0xa0, 0x2d, 0xb6, 0x08, 0x07, 0xec, 0x63, 0xb5, 0xe1, 0x2e, 0x82, 0x2c, 0xb2, 0x9a, 0x06, 0x75,
+	0x34, 0xbd, 0x65, 0x97, 0x33, 0xa2, 0x46, 0x16, 0xf1, 0x3c, 0x94, 0xeb, 0x9a, 0x61, 0x1a, 0x7a,
+	0x5d, 0x6b, 0xad, 0x9c, 0xb4, 0xa9, 0xd5, 0xd6, 0x2c, 0xed, 0x80, 0x3a, 0xd4, 0xb2, 0xcb, 0x59,
+	0xde, 0x34, 0xb5, 0x1e, 0x57, 0x20, 0xdf, 0xd2, 0x76, 0x68, 0xcb, 0x2e, 0xe7, 0x2e, 0x65, 0x67,
+	0x8b, 0xd7, 0xce, 0x71, 0xe9, 0x70, 0x2c, 0x95, 0x35, 0x5e, 0x51, 0x33, 0x1c, 0xeb, 0x44, 0x95,
+	0xad, 0x30, 0x81, 0x5c, 0x5d, 0xb3, 0x1a, 0xe5, 0xa1, 0x4b, 0x68, 0xb6, 0x78, 0xed, 0x8c, 0xdf,
+	0x7a, 0x51, 0xb3, 0x1a, 0x2a, 0xaf, 0x63, 0x48, 0x8f, 0xa8, 0x65, 0xeb, 0xa6, 0x51, 0xce, 0x5f,
+	0x42, 0xb3, 0x59, 0xd5, 0x2d, 0x2a, 0xef, 0x41, 0x31, 0x30, 0x28, 0x2e, 0x41, 0x76, 0x9f, 0x9e,
+	0x48, 0x46, 0xd9, 0x27, 0x9e, 0x84, 0xa1, 0x23, 0xad, 0x75, 0x48, 0x25, 0x8b, 0xa2, 0x30, 0x9f,
+	0xb9, 0x8e, 0xc8, 0x9f, 0x64, 0x60, 0xc4, 0x9b, 0x08, 0x5f, 0x86, 0x31, 0xbb, 0xbe, 0x47, 0x0f,
+	0xb4, 0x6d, 0x39, 0x11, 0x1b, 0x63, 0x48, 0x0d, 0x13, 0xf1, 0x25, 0x28, 0x36, 0xa8, 0x5d, 0xb7,
+	0xf4, 0x36, 0xdb, 0x1e, 0x72, 0xcc, 0x20, 0x09, 0x9f, 0x83, 0xbc, 0x79, 0x6c, 0x08, 0x41, 0x65,
+	0x67, 0x47, 0x54, 0x59, 0xc2, 0x57, 0x20, 0xaf, 0x1b, 0xed, 0x43, 0xc7, 0x15, 0xcb, 0x38, 0x67,
+	0x74, 0x8b, 0x1a, 0xb6, 0x69, 0x6d, 0xb6, 0x69, 0x5d, 0x95, 0xd5, 0xf8, 0x35, 0x18, 0x36, 0x0f,
+	0x1d, 0xde, 0x72, 0x28, 0xb9, 0xa5, 0x5b, 0xcf, 0xc4, 0xd2, 0xd2, 0xeb, 0xd4, 0xb0, 0x29, 0x17,
+	0xcb, 0x88, 0xea, 0x16, 0x19, 0x4e, 0xdd, 0x70, 0xa8, 0xd1, 0xa0, 0x8d, 0x3b, 0x36, 0x2d, 0x0f,
+	0x0b, 0x9c, 0x01, 0x12, 0x9e, 0x81, 0x91, 0x5d, 0xb6, 0x66, 0xc7, 0xa6, 0xb5, 0x5f, 0x2e, 0xf0,
+	0x7a, 0x9f, 0x40, 0x0c, 0x00, 0x7f, 0x42, 0x8c, 0x21, 0x67, 0x68, 0x07, 0xee, 0x26, 0xe6, 0xdf,
+	0x4c, 0xae, 0x0d, 0x87, 0xed, 0x01, 0x29, 0x57, 0x5e, 0x60, 0x54, 0x7b, 0x4f, 0x6b, 0x53, 0xce,
+	0x7c, 0x56, 0x15, 0x85, 0xa8, 0xd4, 0x72, 0x31, 0xa9, 0x91, 0xcf, 0x10, 0x9c, 0x5d, 0xd3, 0x6d,
+	0x87, 0xaf, 0x87, 0xed, 0xda, 0x8f, 0x73, 0x90, 0x3f, 0xd0, 0xac, 0x7d, 0x6a, 0xc9, 0x99, 0x65,
+	0x09, 0x2b, 0x50, 0x38, 0xd0, 0x1e, 0xae, 0x3a, 0xf4, 0x40, 0x68, 0xee, 0x90, 0xea, 0x95, 0x19,
+	0x5f, 0x6d, 0xad, 0x49, 0xb7, 0xcc, 0x7d, 0x6a, 0x48, 0x5d, 0xf5, 0x09, 0xf8, 0x65, 0xc8, 0x1d,
+	0xe9, 0xf4, 0x98, 0x43, 0x38, 0x73, 0x6d, 0x8c, 0x4b, 0x96, 0xcd, 0xbb, 0xad, 0xd3, 0x63, 0x95,
+	0x57, 0xb1, 0x49, 0x77, 0xf5, 0x96, 0x43, 0x2d, 0xae, 0x91, 0x23, 0xaa, 0x2c, 0x91, 0x47, 0x80,
+	0x83, 0x08, 0xa5, 0x41, 0x60, 0x50, 0xc4, 0x76, 0x62, 0x26, 0x8b, 0x2d, 0xb8, 0x57, 0x66, 0x2a,
+	0x65, 0xd0, 0x87, 0xce, 0x6d, 0x0f, 0x8e, 0x10, 0x55, 0x98, 0x88, 0x09, 0xe4, 0x79, 0x0f, 0xa1,
+	0x30, 0xc5, 0x6b, 0xe0, 0xef, 0x00, 0x55, 0xd6, 0x90, 0x77, 0x00, 0x2f, 0x5a, 0x54, 0x73, 0xa8,
+	0x20, 0x4b, 0xf1, 0x5c, 0x82, 0x21, 0x5e, 0xcf, 0xa5, 0x13, 0xee, 0x28, 0x2a, 0xc8, 0x7b, 0x30,
+	0x11, 0xea, 0x27, 0x41, 0x13, 0x18, 0xb5, 0xa8, 0x6d, 0x1e, 0x5a, 0x75, 0x7a, 0x5b, 0x73, 0xf6,
+	0xa4, 0x74, 0x43, 0x34, 0xf2, 0x3a, 0x8c, 0x2f, 0x53, 0x27, 0x34, 0x5f, 0xaa, 0x25, 0x21, 0x3f,
+	0xce, 0x40, 0xc9, 0x6f, 0x2d, 0x67, 0x79, 0xd6, 0x86, 0xe7, 0xbd, 0x88, 0xe1, 0x79, 0x99, 0xcb,
+	0x23, 0x0a, 0xeb, 0x8b, 0x65, 0x83, 0x1e, 0x01, 0xbe, 0xd3, 0x6e, 0x44, 0x17, 0x36, 0x5d, 0x72,
+	0xde, 0x92, 0x67, 0x52, 0x96, 0x1c, 0xcf, 0xc2, 0x38, 0x7d, 0xd8, 0xa6, 0x75, 0x87, 0x36, 0x5c,
+	0x4b, 0x96, 0xe5, 0x70, 0xa3, 0x64, 0xf2, 0x2e, 0x4c, 0x84, 0xe6, 0x96, 0xcb, 0xd6, 0x5d, 0xab,
+	0x56, 0x00, 0x2f, 0xd1, 0x16, 0xed, 0x19, 0x74, 0x19, 0x86, 0xeb, 0x9a, 0x5d, 0xd7, 0x1a, 0x42,
+	0x00, 0x05, 0xd5, 0x2d, 0x32, 0xfd, 0x0c, 0x8d, 0xd4, 0x87, 0x7e, 0xfe, 0x14, 0x81, 0xc2, 0xf6,
+	0x63, 0x44, 0x0b, 0xba, 0xa3, 0xf1, 0x8d, 0x4a, 0x26, 0xd5, 0xa8, 0x64, 0x3b, 0x19, 0x95, 0x5c,
+	0x9a, 0x51, 0x19, 0xea, 0xc5, 0xa8, 0xe4, 0x43, 0x46, 0xe5, 0x3f, 0x10, 0xbc, 0x98, 0xc8, 0x45,
+	0xd7, 0x3d, 0x54, 0x01, 0xbc, 0x17, 0xee, 0xc4, 0x4c, 0x50, 0x86, 0x9b, 0xa0, 0x84, 0x9a, 0xb8,
+	0x31, 0xca, 0x26, 0x19, 0xa3, 0x55, 0x18, 0x8f, 0xf4, 0x95, 0x9b, 0xe9, 0xa2, 0xbb, 0x99, 0x52,
+	0x90, 0xaa, 0xd1, 0x7e, 0xe4, 0x5f, 0xb2, 0x30, 0x23, 0x8c, 0x4f, 0xdf, 0x4b, 0xf4, 0x06, 0x9c,
+	0x8d, 0x71, 0x20, 0x57, 0x2b, 0x5e, 0x81, 0xdf, 0x84, 0x09, 0xcf, 0x26, 0x70, 0x1f, 0xb1, 0x6d,
+	0xea, 0x86, 0x23, 0xf9, 0x4b, 0xaa, 0xc2, 0x0f, 0xd2, 0xb8, 0x7c, 0x47, 0x78, 0x72, 0x1d, 0x50,
+	0x57, 0x22, 0x64, 0x61, 0x47, 0xa2, 0xc3, 0xe1, 0x9a, 0x67, 0x8b, 0xc4, 0x19, 0x7e, 0xb5, 0xfb,
+	0xc0, 0x09, 0x76, 0x49, 0xb9, 0x01, 0x93, 0x49, 0xf3, 0xf5, 0x63, 0x62, 0x7e, 0x11, 0xeb, 0xb4,
+	0x08, 0x17, 0x52, 0x20, 0xf7, 0xb1, 0x51, 0xeb, 0x30, 0x9d, 0xa4, 0x36, 0x03, 0xd5, 0x01, 0xf2,
+	0x59, 0x0e, 0x94, 0x74, 0xe5, 0x1c, 0x98, 0xaa, 0xcd, 0xc0, 0xc8, 0x61, 0xbb, 0x69, 0x69, 0x0d,
+	0xba, 0x65, 0xba, 0xce, 0x85, 0x47, 0x48, 0x53, 0xc4, 0x5c, 0xba, 0x22, 0x7e, 0x3d, 0xae, 0x88,
+	0x42, 0x5f, 0xde, 0xee, 0xb2, 0xdd, 0x7a, 0x54, 0xc3, 0x45, 0x4f, 0x0d, 0xf3, 0x7c, 0xd8, 0xd7,
+	0xbb, 0x0d, 0x9b, 0x74, 0x38, 0x06, 0x0e, 0xbe, 0xe1, 0xd0, 0xc1, 0x87, 0xdf, 0x80, 0x61, 0xcb,
+	0x6c, 0xb5, 0xcc, 0x43, 0xa7, 0x5c, 0xe0, 0xe3, 0x63, 0x3e, 0xbe, 0x2a, 0x68, 0x5b, 0x9a, 0xd5,
+	0xa4, 0x8e, 0xea, 0x36, 0x79, 0xde, 0xca, 0xfc, 0x7b, 0x39, 0x98, 0x11, 0xe7, 0xdd, 0x29, 0xdb,
+	0xa3, 0x41, 0x2b, 0xc9, 0x83, 0x34, 0x25, 0x11, 0xd6, 0xaa, 0x13, 0x4f, 0x7d, 0x5b, 0xab, 0x7c,
+	0xc0, 0x5a, 0x75, 0x1c, 0x38, 0x49, 0x51, 0x12, 0x5c, 0x8f, 0xe1, 0x44, 0xd7, 0xe3, 0x79, 0xab,
+	0xc2, 0xdf, 0xe4, 0xe0, 0x42, 0x0a, 0x77, 0x5f, 0x70, 0x83, 0xa1, 0xa5, 0xe9, 0xc2, 0xbb, 0x9d,
+	0x96, 0xac, 0x2f, 0x9b, 0x71, 0x33, 0xa2, 0x0c, 0x95, 0x1e, 0x46, 0xfe, 0x25, 0x36, 0x1b, 0xdf,
+	0x45, 0x30, 0x23, 0x7c, 0xd4, 0x53, 0x36, 0x1b, 0x01, 0x2f, 0x39, 0x1b, 0xf2, 0x92, 0x19, 0xb8,
+	0x5d, 0xd3, 0xaa, 0x53, 0xae, 0x18, 0x05, 0x55, 0x14, 0xd8, 0xe1, 0x9c, 0x82, 0xab, 0x8f, 0xc3,
+	0xf9, 0x7b, 0x19, 0x38, 0xc7, 0xfc, 0x4f, 0x5f, 0xc5, 0x06, 0xce, 0x97, 0xef, 0x6f, 0x67, 0x53,
+	0xfd, 0xed, 0x5c, 0xc4, 0xdf, 0x9e, 0x85, 0x71, 0xdd, 0xa8, 0xb7, 0x0e, 0x1b, 0x74, 0xc1, 0xaa,
+	0xef, 0xe9, 0x47, 0x54, 0x5c, 0xcd, 0x0a, 0x6a, 0x94, 0x1c, 0xf6, 0xcc, 0xf3, 0x69, 0x9e, 0xf9,
+	0x70, 0x2f, 0x9e, 0x79, 0x21, 0xe4, 0x99, 0xff, 0x2f, 0x82, 0xf3, 0x31, 0xc9, 0xc4, 0xad, 0x43,
+	0xa6, 0x07, 0xd1, 0x64, 0xd3, 0x44, 0x73, 0x19, 0xc6, 0xea, 0xde, 0xf0, 0xfe, 0x0b, 0x42, 0x98,
+	0x18, 0xf7, 0xdc, 0x73, 0x49, 0x9e, 0xfb, 0xfb, 0x50, 0xf4, 0xbb, 0xb9, 0x56, 0x41, 0x71, 0xcf,
+	0x7b, 0x9f, 0x0b, 0xcf, 0x61, 0x0f, 0x36, 0x27, 0x7f, 0x98, 0x83, 0xf3, 0xc2, 0xd5, 0x0b, 0xb6,
+	0x1c, 0xac, 0x22, 0x10, 0x18, 0x0d, 0x32, 0x26, 0xc5, 0x12, 0xa2, 0xb1, 0x97, 0xa6, 0x96, 0x6e,
+	0xec, 0x4b, 0x16, 0xf9, 0x37, 0x9e, 0x87, 0x9c, 0x6e, 0xec, 0x9a, 0x92, 0xa5, 0x57, 0x03, 0x9e,
+	0x74, 0x0c, 0x6b, 0x65, 0xd5, 0xd8, 0x35, 0x85, 0x19, 0xe2, 0x7d, 0xf0, 0x07, 0x11, 0x63, 0x36,
+	0xdb, 0xb1, 0x77, 0x92, 0x19, 0x9b, 0x63, 0x2f, 0xe6, 0xbc, 0xfa, 0x4e, 0xbb, 0x65, 0x6a, 0x8d,
+	0x3b, 0x56, 0x8b, 0xab, 0x53, 0x41, 0x8d, 0xd1, 0x99, 0x2e, 0xd9, 0x7b, 0xda, 0xb5, 0x5f, 0x79,
+	0xc7, 0xd5, 0x25, 0x51, 0x62, 0x4a, 0x6a, 0xeb, 0x8f, 0xe8, 0x8d, 0x13, 0x87, 0xda, 0xe5, 0x11,
+	0x6e, 0x0c, 0x7d, 0x02, 0x7b, 0x1d, 0xab, 0x9b, 0x86, 0x43, 0x0d, 0x87, 0xbf, 0x29, 0x83, 0x78,
+	0x1d, 0x0b, 0x90, 0x94, 0x77, 0x61, 0xc4, 0x63, 0xec, 0x59, 0xd9, 0xbd, 0xef, 0x23, 0x28, 0xc7,
+	0xe5, 0xd4, 0xbb, 0x69, 0x11, 0x47, 0x9f, 0x2b, 0xb1, 0x8c, 0x7b, 0xf4, 0xb9, 0xa2, 0xfa, 0x2a,
+	0x60, 0xaf, 0x50, 0x7b, 0xd8, 0xd6, 0x2d, 0x6a, 0x2f, 0x88, 0x3b, 0x1b, 0xd3, 0x5a, 0x11, 0x6e,
+	0xa8, 0xb8, 0xe1, 0x86, 0xca, 0x96, 0x1b, 0x6e, 0x50, 0x13, 0x7a, 0x91, 0x6f, 0x22, 0x98, 0xbe,
+	0xa9, 0x1b, 0x5a, 0x4b, 0x7f, 0xf4, 0x7c, 0xd5, 0x97, 0xfc, 0x06, 0x28, 0x49, 0x40, 0xa4, 0xd4,
+	0xe6, 0x01, 0xfc, 0xd6, 0xf2, 0x79, 0xa5, 0xd3, 0x0e, 0x0d, 0xb4, 0x26, 0x7f, 0x8e, 0x60, 0x32,
+	0xd2, 0xea, 0xd9, 0xef, 0xce, 0x32, 0x0c, 0x5b, 0xda, 0xf1, 0x9a, 0xbb, 0x41, 0x0b, 0xaa, 0x5b,
+	0x24, 0x3f, 0xcd, 0xc1, 0x54, 0x22, 0x13, 0xcf, 0xdd, 0x7a, 0x5c, 0x87, 0x91, 0x3a, 0x57, 0xe3,
+	0xc6, 0x82, 0x53, 0x1e, 0xea, 0xaa, 0x5f, 0x7e, 0x63, 0x7c, 0x5d, 0xda, 0x1d, 0x61, 0x39, 0x2e,
+	0xa7, 0x2f, 0x54, 0xcc, 0xea, 0xcc, 0xc1, 0x90, 0xed, 0x68, 0x0e, 0x95, 0xe7, 0xce, 0xa4, 0x30,
+	0x3a, 0x5e, 0x3f, 0x16, 0x9a, 0xa2, 0xaa, 0x68, 0xc2, 0x22, 0x5e, 0xd2, 0x42, 0x15, 0x02, 0xf6,
+	0x2d, 0x79, 0x9e, 0x24, 0xfb, 0xe4, 0xdb, 0x9c, 0x91, 0x74, 0x9b, 0x03, 0x5d, 0x6c, 0x4e, 0xf1,
+	0x8b, 0x61, 0x73, 0xbe, 0x85, 0x60, 0x26, 0xc4, 0xf9, 0x2d, 0xcd, 0xd0, 0x77, 0xa9, 0xfd, 0x5c,
+	0xf6, 0xf2, 0xb7, 0x11, 0x5c, 0x48, 0x01, 0x13, 0x78, 0xfb, 0x97, 0x34, 0x09, 0xc7, 0x2b, 0x0b,
+	0xf1, 0x37, 0x0d, 0xcd, 0x39, 0xb4, 0x04, 0xa3, 0xa3, 0xaa, 0x4f, 0x60, 0x22, 0xd8, 0xa7, 0x27,
+	0xde, 0xc4, 0xa2, 0xc0, 0xfa, 0x68, 0xad, 0xa6, 0x69, 0xe9, 0xce, 0xde, 0x81, 0xfb, 0xca, 0xe8,
+	0x11, 0xc8, 0x8f, 0x91, 0x7b, 0x7f, 0x8d, 0x6a, 0xd2, 0x73, 0xb0, 0x04, 0x9e, 0x86, 0xe7, 0xba,
+	0x6a, 0x38, 0xf9, 0x09, 0x72, 0x6f, 0x5b, 0x31, 0xe0, 0xcf, 0xc1, 0x46, 0xf4, 0x83, 0xfc, 0xcf,
+	0x10, 0x9c, 0x17, 0x3e, 0xf6, 0xf3, 0xb5, 0xbb, 0xc9, 0x17, 0x80, 0xaf, 0x40, 0x39, 0x0e, 0xae,
+	0x0f, 0xdf, 0xbf, 0x0a, 0x13, 0x2a, 0xb5, 0xcd, 0xd6, 0x51, 0x8f, 0xef, 0xf8, 0xe4, 0xbf, 0x10,
+	0x4c, 0x86, 0x7b, 0xf4, 0x1a, 0x32, 0x48, 0x7a, 0x57, 0x16, 0x11, 0x8c, 0xbe, 0xdf, 0x95, 0x23,
+	0xa7, 0x68, 0xb6, 0x9f, 0x53, 0x94, 0x99, 0x3d, 0x79, 0xfb, 0xe6, 0x52, 0xc9, 0x71, 0x77, 0x3b,
+	0x48, 0x22, 0xbf, 0x8b, 0x58, 0x54, 0x84, 0x97, 0xa3, 0xa9, 0x0c, 0xcf, 0xcc, 0xf2, 0x7c, 0x0b,
+	0x01, 0x48, 0x0c, 0x2b, 0x66, 0x3b, 0x79, 0x02, 0xd4, 0xe7, 0x6b, 0x78, 0x26, 0xfd, 0x4d, 0xa1,
+	0xe3, 0x1b, 0x05, 0xdb, 0x03, 0x93, 0x61, 0x81, 0xc8, 0x45, 0x9f, 0x83, 0x92, 0x6c, 0xb5, 0x70,
+	0xa4, 0xe9, 0x2d, 0x6d, 0xa7, 0x25, 0x02, 0xc4, 0x05, 0x35, 0x46, 0xc7, 0xd7, 0x20, 0xef, 0xf0,
+	0x6b, 0x7d, 0x39, 0xd3, 0x75, 0xbd, 0x64, 0x4b, 0xfc, 0x0a, 0xe4, 0xf6, 0xcc, 0xb6, 0x1b, 0x15,
+	0x1d, 0x97, 0xaf, 0x10, 0xae, 0x54, 0x54, 0x5e, 0x49, 0xc6, 0xa0, 0x78, 0xd3, 0xf6, 0x56, 0x89,
+	0xec, 0xc3, 0xd9, 0x25, 0xcd, 0x68, 0xb6, 0x74, 0xa3, 0xa9, 0xd2, 0x5d, 0x6a, 0x51, 0xa3, 0xde,
+	0x9b, 0xb3, 0xca, 0x76, 0x98, 0x4e, 0x5b, 0xee, 0xc2, 0x89, 0x02, 0x93, 0x8c, 0xe5, 0x0e, 0xe3,
+	0x4a, 0xc6, 0x23, 0x90, 0x6d, 0x18, 0x15, 0x73, 0x4b, 0x81, 0xdc, 0x04, 0xdc, 0x88, 0x4e, 0x2e,
+	0xae, 0x74, 0x6e, 0x12, 0x44, 0x0c, 0x9b, 0x9a, 0xd0, 0x83, 0xfc, 0x1f, 0x02, 0x58, 0x38, 0x6c,
+	0xe8, 0x4e, 0xed, 0x88, 0x1a, 0x5c, 0xf3, 0x28, 0xfb, 0xf0, 0x35, 0x4f, 0x16, 0x71, 0x05, 0x72,
+	0x8e, 0x7e, 0x40, 0xcb, 0x99, 0xae, 0x5e, 0x0d, 0x6f, 0xc7, 0x98, 0xd4, 0xea, 0x8e, 0xe9, 0x5e,
+	0xc4, 0x45, 0x81, 0xdf, 0xcf, 0xa9, 0xb3, 0x67, 0x36, 0xe4, 0x91, 0x23, 0x4b, 0x31, 0xb1, 0x0d,
+	0x25, 0x88, 0x8d, 0x39, 0x84, 0x42, 0xf4, 0x6e, 0x02, 0x82, 0xe5, 0x87, 0xee, 0x77, 0xe8, 0xae,
+	0x69, 0xb9, 0xb9, 0x07, 0xb2, 0xc4, 0x31, 0xec, 0xfa, 0xb7, 0x6d, 0x51, 0x20, 0x9f, 0x23, 0xf1,
+	0x0c, 0xe1, 0xb3, 0xed, 0x3d, 0x43, 0xf4, 0xb2, 0x7a, 0x6f, 0xc2, 0x90, 0xad, 0x1b, 0xf5, 0x5e,
+	0x24, 0x21, 0x1a, 0xb2, 0x1e, 0x87, 0x86, 0xa3, 0xb7, 0x7a, 0xb8, 0x71, 0x88, 0x86, 0x1d, 0x9f,
+	0x2b, 0x42, 0x8f, 0x10, 0x43, 0x91, 0x47, 0x08, 0xb2, 0x07, 0xe7, 0x63, 0xbc, 0x49, 0x95, 0xb9,
+	0x02, 0x79, 0xbe, 0x98, 0xae, 0x9a, 0x08, 0x2d, 0xf7, 0x5b, 0xaa, 0xb2, 0xba, 0xb7, 0x54, 0x02,
+	0xf2, 0x1d, 0x04, 0xc3, 0x77, 0xe9, 0xce, 0x9e, 0x69, 0xee, 0x33, 0x4c, 0xc7, 0xe2, 0xd3, 0x53,
+	0x1c, 0x9f, 0xc0, 0xbc, 0xb2, 0x43, 0xef, 0x5a, 0xc6, 0x3e, 0xf1, 0x4b, 0x00, 0xf4, 0x48, 0x3a,
+	0x7f, 0x6e, 0xee, 0x4a, 0x80, 0x12, 0xf6, 0xa3, 0x73, 0x7d, 0xf8, 0xd1, 0xe4, 0x01, 0x4c, 0x8a,
+	0x8b, 0xa4, 0x84, 0xe6, 0xae, 0xac, 0xc4, 0x80, 0xd2, 0x30, 0x64, 0x62, 0x18, 0x98, 0xaf, 0x4b,
+	0xeb, 0x16, 0x75, 0x83, 0x7b, 0xb2, 0x44, 0x7e, 0x0d, 0xa6, 0x22, 0x33, 0x48, 0xf9, 0xbe, 0x0a,
+	0xc3, 0x92, 0x67, 0x79, 0x34, 0x8d, 0x72, 0x01, 0xbb, 0xcd, 0xdc, 0x4a, 0x32, 0x05, 0x13, 0x6c,
+	0x89, 0x24, 0xdd, 0xd5, 0x3d, 0xf2, 0x01, 0x4c, 0x86, 0xc9, 0x72, 0xd8, 0x59, 0x28, 0xc8, 0x9e,
+	0xee, 0xc2, 0x85, 0xc7, 0xf5, 0x6a, 0xc9, 0xdb, 0x30, 0x29, 0xce, 0xe8, 0x08, 0xef, 0x1d, 0x57,
+	0x87, 0xf1, 0x13, 0xe9, 0xd5, 0x27, 0x3f, 0xdf, 0xce, 0xc0, 0x59, 0x49, 0x5c, 0xa2, 0x5a, 0x63,
+	0x8d, 0x3a, 0x0e, 0xb5, 0x98, 0x78, 0x1b, 0xb4, 0xa5, 0x1f, 0x51, 0xeb, 0xc4, 0x9b, 0x35, 0x40,
+	0x09, 0x83, 0xca, 0xa4, 0xa8, 0x4c, 0xd6, 0x5f, 0xae, 0x19, 0x18, 0xf1, 0x16, 0xc7, 0xf5, 0x57,
+	0x3d, 0x02, 0xb3, 0x0d, 0x6d, 0xed, 0x84, 0x5d, 0xd6, 0xe5, 0x96, 0x70, 0x8b, 0x6c, 0x2b, 0x69,
+	0x8e, 0x43, 0x0f, 0xda, 0x8e, 0xcd, 0xcd, 0xc6, 0x90, 0xea, 0x95, 0xd9, 0x98, 0x2d, 0xcd, 0x76,
+	0x6a, 0x96, 0x65, 0x5a, 0xd2, 0x74, 0xf8, 0x04, 0xfc, 0x0e, 0x14, 0x76, 0x35, 0xbd, 0xc5, 0x75,
+	0xb0, 0xd0, 0x55, 0x07, 0xbd, 0xb6, 0xe4, 0x1e, 0x5c, 0x08, 0x2c, 0xa4, 0x2f, 0x12, 0xcf, 0xca,
+	0x04, 0x77, 0x37, 0xea, 0xb4, 0xbb, 0x33, 0xd1, 0xdd, 0xfd, 0x3b, 0x08, 0x5e, 0x4a, 0x1b, 0x5b,
+	0xae, 0xda, 0x75, 0x96, 0xfe, 0xe4, 0x91, 0x43, 0x27, 0x42, 0xac, 0x97, 0x1a, 0x6c, 0xda, 0xe3,
+	0xb6, 0xff, 0x19, 0x82, 0x82, 0x4a, 0x8f, 0x74, 0xfe, 0xb8, 0x1e, 0x78, 0x76, 0x47, 0xe1, 0x67,
+	0xf7, 0xd0, 0x0e, 0xce, 0xf4, 0x73, 0x13, 0xf6, 0xfc, 0xbb, 0x6c, 0x1f, 0xfe, 0x5d, 0xee, 0xe9,
+	0xfc, 0x3b, 0xf2, 0x29, 0x12, 0xbb, 0xce, 0xe5, 0x68, 0xe0, 0x0f, 0xd2, 0x4f, 0x9d, 0xe8, 0x41,
+	0x3e, 0x86, 0xa9, 0x08, 0x32, 0xb9, 0xc2, 0xaf, 0x33, 0x47, 0x41, 0x12, 0xe5, 0xfa, 0x8a, 0xc7,
+	0x66, 0xb7, 0xa9, 0xea, 0xd7, 0xf7, 0xb8, 0xa8, 0x47, 0x80, 0x97, 0xa9, 0x37, 0xd5, 0x29, 0x04,
+	0x1b, 0x8e, 0x42, 0xd9, 0x41, 0x6e, 0x91, 0x7c, 0x00, 0x13, 0xa1, 0x79, 0x25, 0x87, 0xaf, 0x41,
+	0xc1, 0xe5, 0x40, 0x9a, 0x9e, 0x08, 0x83, 0x5e, 0x35, 0xf9, 0x0b, 0x04, 0xe3, 0x2c, 0x96, 0xc3,
+	0x92, 0x5e, 0x9f, 0x19, 0xee, 0xa4, 0xe0, 0x63, 0x2e, 0x39, 0xef, 0xe9, 0x57, 0xa1, 0xe4, 0xc3,
+	0xeb, 0x9f, 0xbd, 0x0d, 0x38, 0x7b, 0x57, 0x73, 0xea, 0x7b, 0x3d, 0x67, 0x6c, 0x15, 0x2d, 0x6a,
+	0x1f, 0x1e, 0x84, 0xd6, 0x3a, 0x48, 0x22, 0xdf, 0xcd, 0xc2, 0xb8, 0x3f, 0xe2, 0xa0, 0x9d, 0xbe,
+	0xab, 0x90, 0xe3, 0x69, 0x9a, 0x59, 0x7e, 0xdd, 0x9d, 0x16, 0x56, 0x27, 0x3c, 0x5b, 0x85, 0x27,
+	0x29, 0xf3, 0x66, 0xbf, 0xa8, 0x37, 0xe8, 0x0a, 0x21, 0xdf, 0xc3, 0x22, 0x0f, 0xf7, 0x7a, 0x47,
+	0x2a, 0x24, 0x5f, 0x89, 0x85, 0x1f, 0x39, 0x12, 0xf0, 0x23, 0xd9, 0x19, 0xe5, 0x68, 0x4d, 0xf9,
+	0x84, 0xce, 0x3e, 0xc9, 0x3c, 0xe4, 0xdc, 0x34, 0xeb, 0x50, 0x1e, 0xf8, 0xa2, 0x5a, 0x5b, 0xd8,
+	0xaa, 0x2d, 0x95, 0x10, 0xaf, 0xb9, 0xbd, 0xc4, 0x0b, 0x19, 0x56, 0x58, 0xaa, 0xad, 0xd5, 0x58,
+	0x21, 0x4b, 0x7e, 0x84, 0x20, 0xbb, 0xa5, 0x35, 0x07, 0xa6, 0xbc, 0x12, 0x5d, 0xd6, 0x43, 0xc7,
+	0xa4, 0x2d, 0xef, 0x45, 0x52, 0xda, 0xa2, 0xc4, 0x4c, 0xf5, 0x61, 0xbb, 0x21, 0x4d, 0x75, 0x0f,
+	0x8f, 0x96, 0x5e, 0x63, 0x76, 0x7f, 0x1d, 0xdb, 0xa4, 0xce, 0x96, 0xd6, 0x1c, 0xf4, 0xd6, 0xeb,
+	0x19, 0x3d, 0x79, 0x03, 0xce, 0xb8, 0x10, 0xbc, 0x97, 0x32, 0xde, 0x57, 0xec, 0xac, 0x82, 0xc8,
+	0x67, 0xd6, 0x9a, 0x62, 0x85, 0x74, 0x18, 0x5b, 0x7e, 0x36, 0x80, 0x19, 0xb0, 0xe5, 0xde, 0x81,
+	0xdd, 0x83, 0x71, 0x66, 0xed, 0xb7, 0xb4, 0xe6, 0xc0, 0xd3, 0x95, 0xde, 0x84, 0x92, 0x3f, 0xb4,
+	0x84, 0x32, 0x03, 0x39, 0x47, 0x6b, 0xba, 0xc7, 0x87, 0x8f, 0x85, 0x53, 0x49, 0x0b, 0x4a, 0xc2,
+	0x25, 0x7c, 0x26, 0x82, 0xaa, 0xc2, 0xd9, 0xc0, 0x6c, 0x3d, 0xc8, 0xea, 0x43, 0x18, 0x0b, 0x85,
+	0xef, 0x63, 0x7b, 0x18, 0x25, 0xec, 0xe1, 0x73, 0x90, 0x3f, 0xa6, 0x7a, 0x73, 0xcf, 0x91, 0x49,
+	0xdc, 0xb2, 0x44, 0xfe, 0x01, 0x01, 0x56, 0x99, 0x5e, 0x8b, 0x11, 0x07, 0xcd, 0x6e, 0x20, 0xfd,
+	0x20, 0xdb, 0x35, 0xfd, 0xa0, 0x8f, 0x73, 0xe5, 0x01, 0x4c, 0x84, 0x50, 0x4b, 0xb1, 0x25, 0xb8,
+	0x46, 0xe8, 0x29, 0x5d, 0xa3, 0x6f, 0x22, 0x98, 0x58, 0xd8, 0x31, 0x2d, 0xe7, 0x94, 0x24, 0xd3,
+	0x7b, 0xee, 0xb0, 0x06, 0x93, 0x61, 0x20, 0x83, 0x67, 0x76, 0x0b, 0x66, 0x82, 0x8f, 0x8d, 0x37,
+	0x4d, 0x6b, 0xb1, 0xa5, 0xd3, 0x5e, 0x1e, 0x60, 0x15, 0x28, 0xd4, 0x79, 0x53, 0x8f, 0x57, 0xaf,
+	0xcc, 0xfe, 0x8b, 0xb9, 0x90, 0x32, 0xec, 0x2f, 0xdd, 0x63, 0x26, 0x7f, 0x84, 0x39, 0xac, 0xef,
+	0x53, 0x71, 0x86, 0x0c, 0xa9, 0xb2, 0xc4, 0xbc, 0x5c, 0xdd, 0x90, 0x4b, 0xc7, 0x8f, 0xea, 0x82,
+	0xea, 0x13, 0xc8, 0x5f, 0x66, 0x60, 0x7a, 0xd1, 0x3c, 0x68, 0x6b, 0x16, 0x3d, 0xc5, 0xb4, 0x90,
+	0x58, 0xee, 0x43, 0x36, 0x29, 0xf7, 0x81, 0x45, 0xf4, 0x34, 0xdb, 0x91, 0x2f, 0x2e, 0xfc, 0xbb,
+	0x8f, 0xe4, 0x10, 0x86, 0x95, 0x3a, 0x96, 0x5e, 0x17, 0xe1, 0xff, 0x11, 0xd5, 0x2d, 0x72, 0xc9,
+	0x50, 0xdb, 0xb9, 0x71, 0xe2, 0x3f, 0x4f, 0xb1, 0x12, 0x43, 0xd5, 0x32, 0x8f, 0xa9, 0xb5, 0x6a,
+	0xdf, 0xe0, 0x57, 0x30, 0xee, 0x7b, 0x14, 0xd4, 0x30, 0x91, 0xfc, 0x67, 0x06, 0xce, 0x06, 0xe2,
+	0x42, 0x72, 0xcc, 0x5e, 0x4c, 0xde, 0xd3, 0xdf, 0xc1, 0xe6, 0x21, 0xcf, 0x03, 0x65, 0xee, 0x83,
+	0x28, 0x89, 0x04, 0x2e, 0x24, 0x8a, 0xca, 0x36, 0x6f, 0x24, 0x63, 0x84, 0xa2, 0x07, 0xeb, 0xdb,
+	0xa0, 0x2d, 0x47, 0x73, 0xd3, 0x9c, 0xd3, 0xfa, 0x2e, 0xf1, 0x46, 0xb2, 0xaf, 0xe8, 0xc1, 0xc2,
+	0x79, 0x81, 0x21, 0xbb, 0x85, 0xf3, 0x50, 0x24, 0x12, 0x18, 0x18, 0xb1, 0x9f, 0xae, 0xe4, 0x93,
+	0x0c, 0x28, 0x49, 0x3a, 0x38, 0xe0, 0x80, 0x51, 0x40, 0x41, 0xb2, 0x61, 0x05, 0xb9, 0x1e, 0x4e,
+	0xa7, 0x09, 0xfe, 0xca, 0x16, 0x93, 0x5b, 0x28, 0x95, 0x86, 0xbd, 0x8b, 0x33, 0x65, 0x5a, 0x0c,
+	0xaa, 0x82, 0xf0, 0x96, 0x63, 0x74, 0xb6, 0x11, 0x19, 0x8d, 0x0b, 0x98, 0x6f, 0x44, 0xa4, 0xfa,
+	0x84, 0xb9, 0x0b, 0x50, 0x70, 0x93, 0x95, 0xf0, 0x30, 0x64, 0x57, 0x97, 0x36, 0x4b, 0x2f, 0xe0,
+	0x02, 0xe4, 0x6e, 0xde, 0x59, 0x5b, 0x2b, 0xa1, 0xb9, 0x15, 0x18, 0x8f, 0xc4, 0xad, 0xd8, 0x1f,
+	0x80, 0x0b, 0x8b, 0x5b, 0xab, 0xdb, 0xb5, 0xd2, 0x0b, 0xec, 0x2f, 0xc1, 0xa5, 0xda, 0x6d, 0xb5,
+	0xb6, 0x28, 0xfd, 0xdc, 0x51, 0x28, 0x2c, 0xa8, 0x8b, 0x2b, 0xab, 0xdb, 0xae, 0xa3, 0x7b, 0xbb,
+	0xb6, 0xbe, 0xc4, 0xfe, 0x7c, 0xcc, 0x5e, 0xfb, 0xbc, 0x02, 0xa0, 0x7a, 0xbf, 0x85, 0xe2, 0xaf,
+	0xc1, 0xb0, 0xf8, 0xe3, 0xf2, 0x11, 0x3e, 0x1f, 0xff, 0xff, 0x92, 0x9b, 0x01, 0xa5, 0x9c, 0xf6,
+	0x63, 0x26, 0x79, 0xe9, 0x93, 0x7f, 0xff, 0xef, 0xef, 0x64, 0xca, 0xf8, 0x5c, 0xf5, 0xe8, 0xcb,
+	0x55, 0xff, 0x67, 0xd3, 0xea, 0x9e, 0x1c, 0xf2, 0x36, 0xe4, 0xc5, 0xaf, 0x92, 0x18, 0x87, 0xfe,
+	0x9b, 0x14, 0xe3, 0x4e, 0x24, 0xfc, 0x4b, 0x49, 0x2e, 0xf0, 0x21, 0xcf, 0xe3, 0xa9, 0xc8, 0x90,
+	0x75, 0x31, 0xce, 0xd7, 0x00, 0xfc, 0x3f, 0xb3, 0xf0, 0x39, 0x2f, 0xcb, 0x2b, 0xf4, 0x33, 0x99,
+	0x72, 0x3e, 0x46, 0xef, 0x32, 0xba, 0xf8, 0xf7, 0x0a, 0xef, 0x40, 0x31, 0xf0, 0x0f, 0x95, 0x94,
+	0x48, 0xfc, 0x6f, 0x2c, 0xa5, 0x1c, 0xaf, 0x90, 0x13, 0x5c, 0xe2, 0x13, 0x28, 0x24, 0x79, 0x82,
+	0x79, 0x34, 0x87, 0x1f, 0x40, 0xc1, 0xfd, 0x4f, 0x09, 0x4f, 0x46, 0x7e, 0x5b, 0x12, 0xa3, 0x4f,
+	0x25, 0xfe, 0xcc, 0x44, 0xae, 0xf0, 0xa1, 0x5f, 0xc6, 0x17, 0x13, 0x87, 0xae, 0x3e, 0x96, 0xdb,
+	0xe2, 0x09, 0x76, 0x60, 0x34, 0x78, 0xec, 0xe1, 0xb2, 0xbc, 0xde, 0xc6, 0xe2, 0x7f, 0xca, 0x74,
+	0x42, 0x8d, 0x9c, 0xad, 0xca, 0x67, 0x7b, 0x0d, 0x5f, 0xe9, 0x32, 0x5b, 0xd5, 0x12, 0xbd, 0x71,
+	0x0b, 0x8a, 0x81, 0x5f, 0x8c, 0xa4, 0xec, 0xe2, 0x3f, 0x3c, 0x29, 0xe5, 0x78, 0x85, 0x9c, 0x72,
+	0x8e, 0x4f, 0x79, 0x59, 0xe9, 0xc6, 0x20, 0x93, 0xa2, 0x0e, 0xc5, 0xc0, 0xdf, 0x44, 0x72, 0xb6,
+	0xf8, 0x9f, 0x4a, 0x4a, 0x39, 0x5e, 0x11, 0x16, 0xe7, 0x5c, 0x57, 0x71, 0xb2, 0xff, 0x97, 0x13,
+	0xfe, 0xdb, 0xc1, 0x17, 0x3d, 0x25, 0x4b, 0xce, 0x16, 0x55, 0x2e, 0xa5, 0x37, 0x90, 0x18, 0xde,
+	0xe5, 0x18, 0xbe, 0x8c, 0xab, 0xdd, 0x84, 0x1c, 0xf5, 0x25, 0xfe, 0x14, 0xb9, 0xcf, 0xe0, 0x51,
+	0x54, 0x2f, 0x77, 0xfd, 0xfb, 0x44, 0x21, 0x9d, 0x9a, 0x48, 0x64, 0xf3, 0x1c, 0xd9, 0xdb, 0xa4,
+	0x5f, 0x64, 0x6c, 0x6d, 0xfe, 0x0a, 0xf1, 0xf7, 0xac, 0x28, 0xb2, 0x97, 0x52, 0x3d, 0x26, 0x01,
+	0xab, 0x9b, 0x47, 0x45, 0x3e, 0xe4, 0x98, 0x6a, 0x78, 0xb1, 0x4f, 0x4c, 0xd5, 0xc7, 0xb1, 0xd3,
+	0xe0, 0x09, 0xfe, 0x21, 0x82, 0xa9, 0xc4, 0x44, 0x67, 0x29, 0xc1, 0x4e, 0x19, 0xf1, 0x0a, 0xe9,
+	0xd4, 0x44, 0xa2, 0x5d, 0xe7, 0x68, 0x57, 0x94, 0x41, 0xa0, 0x65, 0x52, 0xfd, 0x01, 0x72, 0x23,
+	0x05, 0xc9, 0x80, 0x3b, 0x25, 0x2e, 0x2b, 0xa4, 0x53, 0x93, 0xb0, 0x78, 0xe7, 0x06, 0x22, 0xde,
+	0xbf, 0x46, 0xe2, 0x46, 0x1d, 0x38, 0xd1, 0xf1, 0x8b, 0xde, 0x7e, 0x88, 0xfb, 0x9a, 0xca, 0x4c,
+	0x72, 0xa5, 0xc4, 0x76, 0x97, 0x63, 0xfb, 0x75, 0xbc, 0x31, 0x00, 0x6c, 0xd5, 0xe0, 0x09, 0xfe,
+	0x03, 0x04, 0xa5, 0x68, 0xea, 0x23, 0x9e, 0xe9, 0x94, 0x39, 0xaa, 0x5c, 0x48, 0xa9, 0x95, 0x50,
+	0xef, 0x73, 0xa8, 0x5b, 0x64, 0xd0, 0x50, 0x99, 0x0e, 0xfc, 0x10, 0xf1, 0x07, 0x94, 0x00, 0xd4,
+	0xe9, 0xa4, 0x0b, 0x84, 0xc0, 0xd9, 0xe1, 0x6e, 0x41, 0x76, 0x39, 0xc8, 0x07, 0xf8, 0xeb, 0x03,
+	0x06, 0x59, 0x7d, 0x1c, 0x74, 0x81, 0x9f, 0xe0, 0x9f, 0x23, 0x98, 0x4a, 0x4c, 0xac, 0xc2, 0x2f,
+	0xc7, 0xd1, 0x45, 0x32, 0xc0, 0x14, 0xd2, 0xa9, 0x89, 0x64, 0xc4, 0xe4, 0x8c, 0xe8, 0xb8, 0x79,
+	0xba, 0x8c, 0x54, 0xbd, 0x64, 0xaf, 0xbf, 0x47, 0x30, 0x1a, 0xcc, 0x91, 0xc0, 0xe5, 0x60, 0xb6,
+	0x42, 0xc8, 0x6f, 0x9a, 0x4e, 0xa8, 0x91, 0xb0, 0x0d, 0x0e, 0x7b, 0x0f, 0xef, 0x9e, 0x32, 0x6c,
+	0x79, 0x43, 0xc4, 0xff, 0x86, 0x00, 0xc7, 0xb3, 0x55, 0xa5, 0x49, 0x4e, 0xcd, 0xa7, 0x55, 0x2e,
+	0xa6, 0xd6, 0x4b, 0x3e, 0x2c, 0xce, 0x47, 0x8b, 0x9c, 0xb6, 0xf8, 0x77, 0x25, 0x04, 0xb6, 0x09,
+	0x7e, 0xee, 0x59, 0xee, 0xa8, 0x4b, 0x1c, 0xb4, 0xdc, 0xc9, 0x89, 0x73, 0x0a, 0xe9, 0xd4, 0x24,
+	0xac, 0x53, 0x4a, 0xe3, 0x94, 0x99, 0xe2, 0x89, 0x67, 0x8c, 0xa3, 0x9f, 0x20, 0xf7, 0xc5, 0x2f,
+	0x66, 0x84, 0x52, 0x52, 0xd2, 0x94, 0x0b, 0x29, 0xb5, 0xe1, 0xfd, 0x3d, 0x77, 0xda, 0xfb, 0x7b,
+	0x05, 0x72, 0x2c, 0x2f, 0x06, 0x97, 0x84, 0xa2, 0xf8, 0xe9, 0x39, 0xca, 0xd9, 0x00, 0x45, 0x82,
+	0x7a, 0x91, 0x83, 0x9a, 0xc2, 0x13, 0x11, 0x50, 0xbb, 0x6c, 0x84, 0x8f, 0xc5, 0x79, 0x11, 0xc8,
+	0x9c, 0x08, 0x9c, 0x17, 0xf1, 0x5c, 0x11, 0x65, 0x26, 0xb9, 0x52, 0x4e, 0x35, 0xc3, 0xa7, 0x3a,
+	0x87, 0x27, 0x23, 0x53, 0x69, 0xac, 0x2d, 0xfe, 0x18, 0xc6, 0x42, 0x39, 0x04, 0xd2, 0x8a, 0x26,
+	0x65, 0x2e, 0x28, 0x4a, 0x52, 0x95, 0x9c, 0x85, 0xf0, 0x59, 0x66, 0xc8, 0xf9, 0xc8, 0x2c, 0x6e,
+	0x4a, 0x00, 0x5b, 0xdb, 0x06, 0x8c, 0x06, 0xf3, 0x0a, 0xa4, 0xb9, 0x48, 0xc8, 0x40, 0x50, 0xa6,
+	0x13, 0x6a, 0xe4, 0x44, 0x17, 0xf9, 0x44, 0xd3, 0x38, 0x6d, 0x22, 0x6c, 0xc3, 0x58, 0x28, 0x8b,
+	0x40, 0x72, 0x94, 0x94, 0x8f, 0xa0, 0x28, 0x49, 0x55, 0x72, 0xa2, 0xd7, 0xf9, 0x44, 0x5f, 0x9a,
+	0x7b, 0x25, 0x65, 0xa2, 0xea, 0x63, 0x2f, 0x49, 0xe0, 0x09, 0xfe, 0x03, 0x99, 0xc9, 0x13, 0x0f,
+	0x87, 0x63, 0x12, 0xe5, 0x25, 0x1e, 0x87, 0x57, 0x5e, 0xe9, 0xd8, 0x26, 0x0c, 0x08, 0xa7, 0x00,
+	0xba, 0xda, 0xa0, 0x5a, 0xe3, 0x6a, 0x4b, 0xce, 0xfa, 0x33, 0x04, 0x63, 0xa1, 0xa0, 0x2d, 0xf6,
+	0x65, 0x1a, 0x0d, 0x31, 0x2b, 0x4a, 0x52, 0x95, 0x9c, 0xf5, 0x13, 0xc4, 0xa7, 0xfd, 0x2d, 0xfc,
+	0x5a, 0xf7, 0xdb, 0x8f, 0xec, 0x7b, 0x7f, 0x03, 0xdf, 0x1a, 0xc4, 0x66, 0xf3, 0x06, 0xc4, 0x9f,
+	0x23, 0x28, 0x06, 0xc2, 0xb3, 0xf2, 0x8e, 0x13, 0x0f, 0x14, 0x2b, 0xe5, 0x78, 0x85, 0xe4, 0xe3,
+	0x7b, 0x82, 0x8f, 0x3f, 0x42, 0xf8, 0xad, 0x9e, 0x19, 0xa9, 0x3e, 0x96, 0x61, 0xd6, 0x27, 0xf7,
+	0xef, 0xe1, 0xbb, 0x03, 0x65, 0xc9, 0x1f, 0x1a, 0xff, 0x0f, 0xcb, 0x63, 0x90, 0x91, 0x59, 0x79,
+	0x0d, 0x8e, 0xc4, 0x91, 0x95, 0xa9, 0x08, 0x55, 0xf2, 0xf4, 0x77, 0x82, 0xa7, 0xef, 0x23, 0xf2,
+	0xfe, 0x53, 0xf0, 0x54, 0xb5, 0xe4, 0x78, 0xf3, 0x68, 0xee, 0x3e, 0x25, 0x0f, 0x4e, 0x89, 0xbf,
+	0xe0, 0x34, 0x78, 0x1f, 0xc0, 0x8f, 0xc2, 0xca, 0x37, 0x8b, 0x58, 0x58, 0x59, 0x99, 0x4c, 0x0a,
+	0xd7, 0x92, 0xab, 0x9c, 0xd9, 0x2b, 0xf8, 0x4b, 0xdd, 0x80, 0x1e, 0xb3, 0x8e, 0x6f, 0x22, 0xfc,
+	0xcf, 0x08, 0xf2, 0x22, 0x22, 0x27, 0xdf, 0x5c, 0x42, 0x11, 0x42, 0x65, 0x22, 0x44, 0x93, 0x22,
+	0xfd, 0x7d, 0x21, 0xd2, 0x6f, 0x20, 0x65, 0xae, 0xdb, 0x34, 0x2c, 0x40, 0x55, 0x7d, 0xec, 0x68,
+	0x4d, 0x76, 0x27, 0xb9, 0xbf, 0xa9, 0xac, 0x0f, 0x42, 0x80, 0xa1, 0x41, 0xf1, 0x3f, 0x22, 0xc8,
+	0x2f, 0x07, 0x39, 0x58, 0x4e, 0xe0, 0x20, 0x1c, 0xdb, 0x23, 0xdf, 0x10, 0x1c, 0xfc, 0x36, 0xee,
+	0x83, 0x81, 0xfb, 0xb7, 0xf1, 0x80, 0xd1, 0xe3, 0x1f, 0x21, 0x28, 0xb8, 0xc1, 0x3e, 0xa9, 0xd5,
+	0x91, 0xb0, 0xa2, 0x32, 0x15, 0xa1, 0x4a, 0x06, 0x1e, 0x72, 0xfc, 0x16, 0xbe, 0xdc, 0x0b, 0xfe,
+	0xfb, 0x5f, 0xc5, 0x2b, 0x83, 0x42, 0x8e, 0xff, 0x15, 0xc1, 0x88, 0x17, 0x00, 0xc4, 0x53, 0x81,
+	0xc3, 0x21, 0x20, 0xf4, 0x73, 0x51, 0x72, 0x44, 0xee, 0x73, 0x7d, 0xc9, 0x7d, 0x6e, 0xd0, 0x72,
+	0xff, 0x14, 0x41, 0x31, 0x10, 0x8f, 0x93, 0xa6, 0x32, 0x1e, 0x57, 0x54, 0xca, 0xf1, 0x0a, 0xc9,
+	0xc9, 0x36, 0x67, 0xe4, 0xb6, 0xf2, 0xe1, 0x40, 0x2c, 0x82, 0x18, 0x9c, 0x69, 0xf3, 0xa7, 0x08,
+	0x46, 0x83, 0xe1, 0x33, 0xe9, 0x00, 0x24, 0x84, 0xf6, 0x94, 0xe9, 0x84, 0x1a, 0x89, 0x6e, 0x93,
+	0xa3, 0xbb, 0x35, 0x37, 0x48, 0x74, 0xec, 0x9d, 0x66, 0x2a, 0x31, 0x3e, 0x26, 0x1d, 0xe9, 0x4e,
+	0x21, 0x39, 0x85, 0x74, 0x6a, 0x22, 0x51, 0xdf, 0xe0, 0xa8, 0xdf, 0xc7, 0xf3, 0xdd, 0x50, 0x8b,
+	0x90, 0x1d, 0xf3, 0x2e, 0x65, 0xec, 0xce, 0x7f, 0x56, 0xfc, 0x5b, 0x04, 0x38, 0x1e, 0x1d, 0x90,
+	0x37, 0x97, 0xd4, 0xd0, 0x95, 0x72, 0x31, 0xb5, 0x3e, 0x2c, 0x51, 0x3c, 0x10, 0x89, 0xd6, 0xc5,
+	0x3c, 0x3b, 0x79, 0x1e, 0xd7, 0x79, 0xeb, 0xff, 0x07, 0x00, 0xaf, 0x16, 0x38, 0x0b, 0x79, 0x51,
+	0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// RepositoryClient is the client API for Repository service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type RepositoryClient interface {
+	Healthz(ctx context.Context, in *HealthCheckRequest, opts ...grpc.CallOption) (*HealthCheckResponse, error)
+	Config(ctx context.Context, in *ConfigRequest, opts ...grpc.CallOption) (*ConfigResponse, error)
+	ListModels(ctx context.Context, in *ListModelsRequest, opts ...grpc.CallOption) (*ListModelsResponse, error)
+	CreateModel(ctx context.Context, in *CreateModelRequest, opts ...grpc.CallOption) (*CreateModelResponse, error)
+	GetModel(ctx context.Context, in *GetModelRequest, opts ...grpc.CallOption) (*GetModelResponse, error)
+	ResolveModel(ctx context.Context, in *ResolveModelRequest, opts ...grpc.CallOption) (*ResolveModelResponse, error)
+	UpdateModel(ctx context.Context, in *UpdateModelRequest, opts ...grpc.CallOption) (*UpdateModelResponse, error)
+	DeleteModel(ctx context.Context, in *DeleteModelRequest, opts ...grpc.CallOption) (*DeleteModelResponse, error)
+	ListHyperparameters(ctx context.Context, in *ListHyperparametersRequest, opts ...grpc.CallOption) (*ListHyperparametersResponse, error)
+	CreateHyperparameters(ctx context.Context, in *CreateHyperparametersRequest, opts ...grpc.CallOption) (*CreateHyperparametersResponse, error)
+	GetHyperparameters(ctx context.Context, in *GetHyperparametersRequest, opts ...grpc.CallOption) (*GetHyperparametersResponse, error)
+	UpdateHyperparameters(ctx context.Context, in *UpdateHyperparametersRequest, opts ...grpc.CallOption) (*UpdateHyperparametersResponse, error)
+	DeleteHyperparameters(ctx context.Context, in *DeleteHyperparametersRequest, opts ...grpc.CallOption) (*DeleteHyperparametersResponse, error)
+	ListCheckpoints(ctx context.Context, in *ListCheckpointsRequest, opts ...grpc.CallOption) (*ListCheckpointsResponse, error)
+	CreateCheckpoint(ctx context.Context, in *CreateCheckpointRequest, opts ...grpc.CallOption) (*CreateCheckpointResponse, error)
+	GetCheckpoint(ctx context.Context, in *GetCheckpointRequest, opts ...grpc.CallOption) (*GetCheckpointResponse, error)
+	GetCheckpointManifest(ctx context.Context, in *GetCheckpointManifestRequest, opts ...grpc.CallOption) (*GetCheckpointManifestResponse, error)
+	UpgradeCheck(ctx context.Context, in *UpgradeCheckRequest, opts ...grpc.CallOption) (*UpgradeCheckResponse, error)
+	FinalizeCheckpoint(ctx context.Context, in *FinalizeCheckpointRequest, opts ...grpc.CallOption) (*FinalizeCheckpointResponse, error)
+	UpdateCheckpointState(ctx context.Context, in *UpdateCheckpointStateRequest, opts ...grpc.CallOption) (*UpdateCheckpointStateResponse, error)
+	DeleteCheckpoint(ctx context.Context, in *DeleteCheckpointRequest, opts ...grpc.CallOption) (*DeleteCheckpointResponse, error)
+	Fsck(ctx context.Context, in *FsckRequest, opts ...grpc.CallOption) (*FsckResponse, error)
+	ListAuditEvents(ctx context.Context, in *ListAuditEventsRequest, opts ...grpc.CallOption) (*ListAuditEventsResponse, error)
+	CreateWebhook(ctx context.Context, in *CreateWebhookRequest, opts ...grpc.CallOption) (*CreateWebhookResponse, error)
+	ListWebhooks(ctx context.Context, in *ListWebhooksRequest, opts ...grpc.CallOption) (*ListWebhooksResponse, error)
+	DeleteWebhook(ctx context.Context, in *DeleteWebhookRequest, opts ...grpc.CallOption) (*DeleteWebhookResponse, error)
+	ListWebhookDeadLetters(ctx context.Context, in *ListWebhookDeadLettersRequest, opts ...grpc.CallOption) (*ListWebhookDeadLettersResponse, error)
+	ListRevisions(ctx context.Context, in *ListRevisionsRequest, opts ...grpc.CallOption) (*ListRevisionsResponse, error)
+	GetRevision(ctx context.Context, in *GetRevisionRequest, opts ...grpc.CallOption) (*GetRevisionResponse, error)
+	Rollback(ctx context.Context, in *RollbackRequest, opts ...grpc.CallOption) (*RollbackResponse, error)
+	WatchModel(ctx context.Context, in *WatchModelRequest, opts ...grpc.CallOption) (Repository_WatchModelClient, error)
+	SetTag(ctx context.Context, in *SetTagRequest, opts ...grpc.CallOption) (*SetTagResponse, error)
+	GetTag(ctx context.Context, in *GetTagRequest, opts ...grpc.CallOption) (*GetTagResponse, error)
+	ListTags(ctx context.Context, in *ListTagsRequest, opts ...grpc.CallOption) (*ListTagsResponse, error)
+	DeleteTag(ctx context.Context, in *DeleteTagRequest, opts ...grpc.CallOption) (*DeleteTagResponse, error)
+	RampRollout(ctx context.Context, in *RampRolloutRequest, opts ...grpc.CallOption) (*RampRolloutResponse, error)
+	AbortRollout(ctx context.Context, in *AbortRolloutRequest, opts ...grpc.CallOption) (*AbortRolloutResponse, error)
+	ResolveModelForClient(ctx context.Context, in *ResolveModelForClientRequest, opts ...grpc.CallOption) (*ResolveModelForClientResponse, error)
+	CompareCheckpoints(ctx context.Context, in *CompareCheckpointsRequest, opts ...grpc.CallOption) (*CompareCheckpointsResponse, error)
+}
+
+type repositoryClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewRepositoryClient(cc *grpc.ClientConn) RepositoryClient {
+	return &repositoryClient{cc}
+}
+
+func (c *repositoryClient) Healthz(ctx context.Context, in *HealthCheckRequest, opts ...grpc.CallOption) (*HealthCheckResponse, error) {
+	out := new(HealthCheckResponse)
+	err := c.cc.Invoke(ctx, "/api.Repository/Healthz", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *repositoryClient) Config(ctx context.Context, in *ConfigRequest, opts ...grpc.CallOption) (*ConfigResponse, error) {
+	out := new(ConfigResponse)
+	err := c.cc.Invoke(ctx, "/api.Repository/Config", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *repositoryClient) ListModels(ctx context.Context, in *ListModelsRequest, opts ...grpc.CallOption) (*ListModelsResponse, error) {
+	out := new(ListModelsResponse)
+	err := c.cc.Invoke(ctx, "/api.Repository/ListModels", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *repositoryClient) CreateModel(ctx context.Context, in *CreateModelRequest, opts ...grpc.CallOption) (*CreateModelResponse, error) {
 	out := new(CreateModelResponse)
 	err := c.cc.Invoke(ctx, "/api.Repository/CreateModel", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return out, nil
+	return out, nil
+}
+
+func (c *repositoryClient) GetModel(ctx context.Context, in *GetModelRequest, opts ...grpc.CallOption) (*GetModelResponse, error) {
+	out := new(GetModelResponse)
+	err := c.cc.Invoke(ctx, "/api.Repository/GetModel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *repositoryClient) ResolveModel(ctx context.Context, in *ResolveModelRequest, opts ...grpc.CallOption) (*ResolveModelResponse, error) {
+	out := new(ResolveModelResponse)
+	err := c.cc.Invoke(ctx, "/api.Repository/ResolveModel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *repositoryClient) UpdateModel(ctx context.Context, in *UpdateModelRequest, opts ...grpc.CallOption) (*UpdateModelResponse, error) {
+	out := new(UpdateModelResponse)
+	err := c.cc.Invoke(ctx, "/api.Repository/UpdateModel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *repositoryClient) DeleteModel(ctx context.Context, in *DeleteModelRequest, opts ...grpc.CallOption) (*DeleteModelResponse, error) {
+	out := new(DeleteModelResponse)
+	err := c.cc.Invoke(ctx, "/api.Repository/DeleteModel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *repositoryClient) ListHyperparameters(ctx context.Context, in *ListHyperparametersRequest, opts ...grpc.CallOption) (*ListHyperparametersResponse, error) {
+	out := new(ListHyperparametersResponse)
+	err := c.cc.Invoke(ctx, "/api.Repository/ListHyperparameters", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *repositoryClient) CreateHyperparameters(ctx context.Context, in *CreateHyperparametersRequest, opts ...grpc.CallOption) (*CreateHyperparametersResponse, error) {
+	out := new(CreateHyperparametersResponse)
+	err := c.cc.Invoke(ctx, "/api.Repository/CreateHyperparameters", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *repositoryClient) GetHyperparameters(ctx context.Context, in *GetHyperparametersRequest, opts ...grpc.CallOption) (*GetHyperparametersResponse, error) {
+	out := new(GetHyperparametersResponse)
+	err := c.cc.Invoke(ctx, "/api.Repository/GetHyperparameters", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *repositoryClient) UpdateHyperparameters(ctx context.Context, in *UpdateHyperparametersRequest, opts ...grpc.CallOption) (*UpdateHyperparametersResponse, error) {
+	out := new(UpdateHyperparametersResponse)
+	err := c.cc.Invoke(ctx, "/api.Repository/UpdateHyperparameters", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *repositoryClient) DeleteHyperparameters(ctx context.Context, in *DeleteHyperparametersRequest, opts ...grpc.CallOption) (*DeleteHyperparametersResponse, error) {
+	out := new(DeleteHyperparametersResponse)
+	err := c.cc.Invoke(ctx, "/api.Repository/DeleteHyperparameters", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *repositoryClient) ListCheckpoints(ctx context.Context, in *ListCheckpointsRequest, opts ...grpc.CallOption) (*ListCheckpointsResponse, error) {
+	out := new(ListCheckpointsResponse)
+	err := c.cc.Invoke(ctx, "/api.Repository/ListCheckpoints", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *repositoryClient) CreateCheckpoint(ctx context.Context, in *CreateCheckpointRequest, opts ...grpc.CallOption) (*CreateCheckpointResponse, error) {
+	out := new(CreateCheckpointResponse)
+	err := c.cc.Invoke(ctx, "/api.Repository/CreateCheckpoint", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *repositoryClient) GetCheckpoint(ctx context.Context, in *GetCheckpointRequest, opts ...grpc.CallOption) (*GetCheckpointResponse, error) {
+	out := new(GetCheckpointResponse)
+	err := c.cc.Invoke(ctx, "/api.Repository/GetCheckpoint", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *repositoryClient) GetCheckpointManifest(ctx context.Context, in *GetCheckpointManifestRequest, opts ...grpc.CallOption) (*GetCheckpointManifestResponse, error) {
+	out := new(GetCheckpointManifestResponse)
+	err := c.cc.Invoke(ctx, "/api.Repository/GetCheckpointManifest", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *repositoryClient) UpgradeCheck(ctx context.Context, in *UpgradeCheckRequest, opts ...grpc.CallOption) (*UpgradeCheckResponse, error) {
+	out := new(UpgradeCheckResponse)
+	err := c.cc.Invoke(ctx, "/api.Repository/UpgradeCheck", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *repositoryClient) FinalizeCheckpoint(ctx context.Context, in *FinalizeCheckpointRequest, opts ...grpc.CallOption) (*FinalizeCheckpointResponse, error) {
+	out := new(FinalizeCheckpointResponse)
+	err := c.cc.Invoke(ctx, "/api.Repository/FinalizeCheckpoint", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *repositoryClient) UpdateCheckpointState(ctx context.Context, in *UpdateCheckpointStateRequest, opts ...grpc.CallOption) (*UpdateCheckpointStateResponse, error) {
+	out := new(UpdateCheckpointStateResponse)
+	err := c.cc.Invoke(ctx, "/api.Repository/UpdateCheckpointState", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *repositoryClient) DeleteCheckpoint(ctx context.Context, in *DeleteCheckpointRequest, opts ...grpc.CallOption) (*DeleteCheckpointResponse, error) {
+	out := new(DeleteCheckpointResponse)
+	err := c.cc.Invoke(ctx, "/api.Repository/DeleteCheckpoint", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *repositoryClient) Fsck(ctx context.Context, in *FsckRequest, opts ...grpc.CallOption) (*FsckResponse, error) {
+	out := new(FsckResponse)
+	err := c.cc.Invoke(ctx, "/api.Repository/Fsck", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *repositoryClient) ListAuditEvents(ctx context.Context, in *ListAuditEventsRequest, opts ...grpc.CallOption) (*ListAuditEventsResponse, error) {
+	out := new(ListAuditEventsResponse)
+	err := c.cc.Invoke(ctx, "/api.Repository/ListAuditEvents", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *repositoryClient) CreateWebhook(ctx context.Context, in *CreateWebhookRequest, opts ...grpc.CallOption) (*CreateWebhookResponse, error) {
+	out := new(CreateWebhookResponse)
+	err := c.cc.Invoke(ctx, "/api.Repository/CreateWebhook", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *repositoryClient) ListWebhooks(ctx context.Context, in *ListWebhooksRequest, opts ...grpc.CallOption) (*ListWebhooksResponse, error) {
+	out := new(ListWebhooksResponse)
+	err := c.cc.Invoke(ctx, "/api.Repository/ListWebhooks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *repositoryClient) DeleteWebhook(ctx context.Context, in *DeleteWebhookRequest, opts ...grpc.CallOption) (*DeleteWebhookResponse, error) {
+	out := new(DeleteWebhookResponse)
+	err := c.cc.Invoke(ctx, "/api.Repository/DeleteWebhook", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *repositoryClient) ListWebhookDeadLetters(ctx context.Context, in *ListWebhookDeadLettersRequest, opts ...grpc.CallOption) (*ListWebhookDeadLettersResponse, error) {
+	out := new(ListWebhookDeadLettersResponse)
+	err := c.cc.Invoke(ctx, "/api.Repository/ListWebhookDeadLetters", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *repositoryClient) ListRevisions(ctx context.Context, in *ListRevisionsRequest, opts ...grpc.CallOption) (*ListRevisionsResponse, error) {
+	out := new(ListRevisionsResponse)
+	err := c.cc.Invoke(ctx, "/api.Repository/ListRevisions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *repositoryClient) GetRevision(ctx context.Context, in *GetRevisionRequest, opts ...grpc.CallOption) (*GetRevisionResponse, error) {
+	out := new(GetRevisionResponse)
+	err := c.cc.Invoke(ctx, "/api.Repository/GetRevision", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *repositoryClient) Rollback(ctx context.Context, in *RollbackRequest, opts ...grpc.CallOption) (*RollbackResponse, error) {
+	out := new(RollbackResponse)
+	err := c.cc.Invoke(ctx, "/api.Repository/Rollback", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *repositoryClient) WatchModel(ctx context.Context, in *WatchModelRequest, opts ...grpc.CallOption) (Repository_WatchModelClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Repository_serviceDesc.Streams[0], "/api.Repository/WatchModel", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &repositoryWatchModelClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Repository_WatchModelClient interface {
+	Recv() (*WatchModelEvent, error)
+	grpc.ClientStream
+}
+
+type repositoryWatchModelClient struct {
+	grpc.ClientStream
+}
+
+func (x *repositoryWatchModelClient) Recv() (*WatchModelEvent, error) {
+	m := new(WatchModelEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *repositoryClient) SetTag(ctx context.Context, in *SetTagRequest, opts ...grpc.CallOption) (*SetTagResponse, error) {
+	out := new(SetTagResponse)
+	err := c.cc.Invoke(ctx, "/api.Repository/SetTag", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *repositoryClient) GetTag(ctx context.Context, in *GetTagRequest, opts ...grpc.CallOption) (*GetTagResponse, error) {
+	out := new(GetTagResponse)
+	err := c.cc.Invoke(ctx, "/api.Repository/GetTag", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *repositoryClient) ListTags(ctx context.Context, in *ListTagsRequest, opts ...grpc.CallOption) (*ListTagsResponse, error) {
+	out := new(ListTagsResponse)
+	err := c.cc.Invoke(ctx, "/api.Repository/ListTags", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *repositoryClient) DeleteTag(ctx context.Context, in *DeleteTagRequest, opts ...grpc.CallOption) (*DeleteTagResponse, error) {
+	out := new(DeleteTagResponse)
+	err := c.cc.Invoke(ctx, "/api.Repository/DeleteTag", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *repositoryClient) RampRollout(ctx context.Context, in *RampRolloutRequest, opts ...grpc.CallOption) (*RampRolloutResponse, error) {
+	out := new(RampRolloutResponse)
+	err := c.cc.Invoke(ctx, "/api.Repository/RampRollout", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *repositoryClient) AbortRollout(ctx context.Context, in *AbortRolloutRequest, opts ...grpc.CallOption) (*AbortRolloutResponse, error) {
+	out := new(AbortRolloutResponse)
+	err := c.cc.Invoke(ctx, "/api.Repository/AbortRollout", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *repositoryClient) ResolveModelForClient(ctx context.Context, in *ResolveModelForClientRequest, opts ...grpc.CallOption) (*ResolveModelForClientResponse, error) {
+	out := new(ResolveModelForClientResponse)
+	err := c.cc.Invoke(ctx, "/api.Repository/ResolveModelForClient", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *repositoryClient) CompareCheckpoints(ctx context.Context, in *CompareCheckpointsRequest, opts ...grpc.CallOption) (*CompareCheckpointsResponse, error) {
+	out := new(CompareCheckpointsResponse)
+	err := c.cc.Invoke(ctx, "/api.Repository/CompareCheckpoints", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// RepositoryServer is the server API for Repository service.
+type RepositoryServer interface {
+	Healthz(context.Context, *HealthCheckRequest) (*HealthCheckResponse, error)
+	Config(context.Context, *ConfigRequest) (*ConfigResponse, error)
+	ListModels(context.Context, *ListModelsRequest) (*ListModelsResponse, error)
+	CreateModel(context.Context, *CreateModelRequest) (*CreateModelResponse, error)
+	GetModel(context.Context, *GetModelRequest) (*GetModelResponse, error)
+	ResolveModel(context.Context, *ResolveModelRequest) (*ResolveModelResponse, error)
+	UpdateModel(context.Context, *UpdateModelRequest) (*UpdateModelResponse, error)
+	DeleteModel(context.Context, *DeleteModelRequest) (*DeleteModelResponse, error)
+	ListHyperparameters(context.Context, *ListHyperparametersRequest) (*ListHyperparametersResponse, error)
+	CreateHyperparameters(context.Context, *CreateHyperparametersRequest) (*CreateHyperparametersResponse, error)
+	GetHyperparameters(context.Context, *GetHyperparametersRequest) (*GetHyperparametersResponse, error)
+	UpdateHyperparameters(context.Context, *UpdateHyperparametersRequest) (*UpdateHyperparametersResponse, error)
+	DeleteHyperparameters(context.Context, *DeleteHyperparametersRequest) (*DeleteHyperparametersResponse, error)
+	ListCheckpoints(context.Context, *ListCheckpointsRequest) (*ListCheckpointsResponse, error)
+	CreateCheckpoint(context.Context, *CreateCheckpointRequest) (*CreateCheckpointResponse, error)
+	GetCheckpoint(context.Context, *GetCheckpointRequest) (*GetCheckpointResponse, error)
+	GetCheckpointManifest(context.Context, *GetCheckpointManifestRequest) (*GetCheckpointManifestResponse, error)
+	UpgradeCheck(context.Context, *UpgradeCheckRequest) (*UpgradeCheckResponse, error)
+	FinalizeCheckpoint(context.Context, *FinalizeCheckpointRequest) (*FinalizeCheckpointResponse, error)
+	UpdateCheckpointState(context.Context, *UpdateCheckpointStateRequest) (*UpdateCheckpointStateResponse, error)
+	DeleteCheckpoint(context.Context, *DeleteCheckpointRequest) (*DeleteCheckpointResponse, error)
+	Fsck(context.Context, *FsckRequest) (*FsckResponse, error)
+	ListAuditEvents(context.Context, *ListAuditEventsRequest) (*ListAuditEventsResponse, error)
+	CreateWebhook(context.Context, *CreateWebhookRequest) (*CreateWebhookResponse, error)
+	ListWebhooks(context.Context, *ListWebhooksRequest) (*ListWebhooksResponse, error)
+	DeleteWebhook(context.Context, *DeleteWebhookRequest) (*DeleteWebhookResponse, error)
+	ListWebhookDeadLetters(context.Context, *ListWebhookDeadLettersRequest) (*ListWebhookDeadLettersResponse, error)
+	ListRevisions(context.Context, *ListRevisionsRequest) (*ListRevisionsResponse, error)
+	GetRevision(context.Context, *GetRevisionRequest) (*GetRevisionResponse, error)
+	Rollback(context.Context, *RollbackRequest) (*RollbackResponse, error)
+	WatchModel(*WatchModelRequest, Repository_WatchModelServer) error
+	SetTag(context.Context, *SetTagRequest) (*SetTagResponse, error)
+	GetTag(context.Context, *GetTagRequest) (*GetTagResponse, error)
+	ListTags(context.Context, *ListTagsRequest) (*ListTagsResponse, error)
+	DeleteTag(context.Context, *DeleteTagRequest) (*DeleteTagResponse, error)
+	RampRollout(context.Context, *RampRolloutRequest) (*RampRolloutResponse, error)
+	AbortRollout(context.Context, *AbortRolloutRequest) (*AbortRolloutResponse, error)
+	ResolveModelForClient(context.Context, *ResolveModelForClientRequest) (*ResolveModelForClientResponse, error)
+	CompareCheckpoints(context.Context, *CompareCheckpointsRequest) (*CompareCheckpointsResponse, error)
+}
+
+func RegisterRepositoryServer(s *grpc.Server, srv RepositoryServer) {
+	s.RegisterService(&_Repository_serviceDesc, srv)
+}
+
+func _Repository_Healthz_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HealthCheckRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RepositoryServer).Healthz(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Repository/Healthz",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RepositoryServer).Healthz(ctx, req.(*HealthCheckRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Repository_Config_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RepositoryServer).Config(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Repository/Config",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RepositoryServer).Config(ctx, req.(*ConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Repository_ListModels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListModelsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RepositoryServer).ListModels(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Repository/ListModels",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RepositoryServer).ListModels(ctx, req.(*ListModelsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *repositoryClient) GetModel(ctx context.Context, in *GetModelRequest, opts ...grpc.CallOption) (*GetModelResponse, error) {
-	out := new(GetModelResponse)
-	err := c.cc.Invoke(ctx, "/api.Repository/GetModel", in, out, opts...)
-	if err != nil {
+func _Repository_CreateModel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateModelRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(RepositoryServer).CreateModel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Repository/CreateModel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RepositoryServer).CreateModel(ctx, req.(*CreateModelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *repositoryClient) UpdateModel(ctx context.Context, in *UpdateModelRequest, opts ...grpc.CallOption) (*UpdateModelResponse, error) {
-	out := new(UpdateModelResponse)
-	err := c.cc.Invoke(ctx, "/api.Repository/UpdateModel", in, out, opts...)
-	if err != nil {
+func _Repository_GetModel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetModelRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(RepositoryServer).GetModel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Repository/GetModel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RepositoryServer).GetModel(ctx, req.(*GetModelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Repository_ResolveModel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResolveModelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RepositoryServer).ResolveModel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Repository/ResolveModel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RepositoryServer).ResolveModel(ctx, req.(*ResolveModelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Repository_UpdateModel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateModelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RepositoryServer).UpdateModel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Repository/UpdateModel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RepositoryServer).UpdateModel(ctx, req.(*UpdateModelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Repository_DeleteModel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteModelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RepositoryServer).DeleteModel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Repository/DeleteModel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RepositoryServer).DeleteModel(ctx, req.(*DeleteModelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Repository_ListHyperparameters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListHyperparametersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RepositoryServer).ListHyperparameters(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Repository/ListHyperparameters",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RepositoryServer).ListHyperparameters(ctx, req.(*ListHyperparametersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Repository_CreateHyperparameters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateHyperparametersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RepositoryServer).CreateHyperparameters(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Repository/CreateHyperparameters",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RepositoryServer).CreateHyperparameters(ctx, req.(*CreateHyperparametersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Repository_GetHyperparameters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetHyperparametersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RepositoryServer).GetHyperparameters(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Repository/GetHyperparameters",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RepositoryServer).GetHyperparameters(ctx, req.(*GetHyperparametersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Repository_UpdateHyperparameters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateHyperparametersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RepositoryServer).UpdateHyperparameters(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Repository/UpdateHyperparameters",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RepositoryServer).UpdateHyperparameters(ctx, req.(*UpdateHyperparametersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Repository_DeleteHyperparameters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteHyperparametersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RepositoryServer).DeleteHyperparameters(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Repository/DeleteHyperparameters",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RepositoryServer).DeleteHyperparameters(ctx, req.(*DeleteHyperparametersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Repository_ListCheckpoints_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCheckpointsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RepositoryServer).ListCheckpoints(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Repository/ListCheckpoints",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RepositoryServer).ListCheckpoints(ctx, req.(*ListCheckpointsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Repository_CreateCheckpoint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCheckpointRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RepositoryServer).CreateCheckpoint(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Repository/CreateCheckpoint",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RepositoryServer).CreateCheckpoint(ctx, req.(*CreateCheckpointRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Repository_GetCheckpoint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCheckpointRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RepositoryServer).GetCheckpoint(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Repository/GetCheckpoint",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RepositoryServer).GetCheckpoint(ctx, req.(*GetCheckpointRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Repository_GetCheckpointManifest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCheckpointManifestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RepositoryServer).GetCheckpointManifest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Repository/GetCheckpointManifest",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RepositoryServer).GetCheckpointManifest(ctx, req.(*GetCheckpointManifestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *repositoryClient) ListHyperparameters(ctx context.Context, in *ListHyperparametersRequest, opts ...grpc.CallOption) (*ListHyperparametersResponse, error) {
-	out := new(ListHyperparametersResponse)
-	err := c.cc.Invoke(ctx, "/api.Repository/ListHyperparameters", in, out, opts...)
-	if err != nil {
+func _Repository_UpgradeCheck_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpgradeCheckRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(RepositoryServer).UpgradeCheck(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Repository/UpgradeCheck",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RepositoryServer).UpgradeCheck(ctx, req.(*UpgradeCheckRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *repositoryClient) CreateHyperparameters(ctx context.Context, in *CreateHyperparametersRequest, opts ...grpc.CallOption) (*CreateHyperparametersResponse, error) {
-	out := new(CreateHyperparametersResponse)
-	err := c.cc.Invoke(ctx, "/api.Repository/CreateHyperparameters", in, out, opts...)
-	if err != nil {
+func _Repository_FinalizeCheckpoint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FinalizeCheckpointRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(RepositoryServer).FinalizeCheckpoint(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Repository/FinalizeCheckpoint",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RepositoryServer).FinalizeCheckpoint(ctx, req.(*FinalizeCheckpointRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *repositoryClient) GetHyperparameters(ctx context.Context, in *GetHyperparametersRequest, opts ...grpc.CallOption) (*GetHyperparametersResponse, error) {
-	out := new(GetHyperparametersResponse)
-	err := c.cc.Invoke(ctx, "/api.Repository/GetHyperparameters", in, out, opts...)
-	if err != nil {
+func _Repository_UpdateCheckpointState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCheckpointStateRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(RepositoryServer).UpdateCheckpointState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Repository/UpdateCheckpointState",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RepositoryServer).UpdateCheckpointState(ctx, req.(*UpdateCheckpointStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *repositoryClient) UpdateHyperparameters(ctx context.Context, in *UpdateHyperparametersRequest, opts ...grpc.CallOption) (*UpdateHyperparametersResponse, error) {
-	out := new(UpdateHyperparametersResponse)
-	err := c.cc.Invoke(ctx, "/api.Repository/UpdateHyperparameters", in, out, opts...)
-	if err != nil {
+func _Repository_DeleteCheckpoint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCheckpointRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(RepositoryServer).DeleteCheckpoint(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Repository/DeleteCheckpoint",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RepositoryServer).DeleteCheckpoint(ctx, req.(*DeleteCheckpointRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *repositoryClient) ListCheckpoints(ctx context.Context, in *ListCheckpointsRequest, opts ...grpc.CallOption) (*ListCheckpointsResponse, error) {
-	out := new(ListCheckpointsResponse)
-	err := c.cc.Invoke(ctx, "/api.Repository/ListCheckpoints", in, out, opts...)
-	if err != nil {
+func _Repository_Fsck_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FsckRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(RepositoryServer).Fsck(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Repository/Fsck",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RepositoryServer).Fsck(ctx, req.(*FsckRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *repositoryClient) CreateCheckpoint(ctx context.Context, in *CreateCheckpointRequest, opts ...grpc.CallOption) (*CreateCheckpointResponse, error) {
-	out := new(CreateCheckpointResponse)
-	err := c.cc.Invoke(ctx, "/api.Repository/CreateCheckpoint", in, out, opts...)
-	if err != nil {
+func _Repository_ListAuditEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAuditEventsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(RepositoryServer).ListAuditEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Repository/ListAuditEvents",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RepositoryServer).ListAuditEvents(ctx, req.(*ListAuditEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *repositoryClient) GetCheckpoint(ctx context.Context, in *GetCheckpointRequest, opts ...grpc.CallOption) (*GetCheckpointResponse, error) {
-	out := new(GetCheckpointResponse)
-	err := c.cc.Invoke(ctx, "/api.Repository/GetCheckpoint", in, out, opts...)
-	if err != nil {
+func _Repository_CreateWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateWebhookRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
-}
-
-// RepositoryServer is the server API for Repository service.
-type RepositoryServer interface {
-	Healthz(context.Context, *HealthCheckRequest) (*HealthCheckResponse, error)
-	Config(context.Context, *ConfigRequest) (*ConfigResponse, error)
-	ListModels(context.Context, *ListModelsRequest) (*ListModelsResponse, error)
-	CreateModel(context.Context, *CreateModelRequest) (*CreateModelResponse, error)
-	GetModel(context.Context, *GetModelRequest) (*GetModelResponse, error)
-	UpdateModel(context.Context, *UpdateModelRequest) (*UpdateModelResponse, error)
-	ListHyperparameters(context.Context, *ListHyperparametersRequest) (*ListHyperparametersResponse, error)
-	CreateHyperparameters(context.Context, *CreateHyperparametersRequest) (*CreateHyperparametersResponse, error)
-	GetHyperparameters(context.Context, *GetHyperparametersRequest) (*GetHyperparametersResponse, error)
-	UpdateHyperparameters(context.Context, *UpdateHyperparametersRequest) (*UpdateHyperparametersResponse, error)
-	ListCheckpoints(context.Context, *ListCheckpointsRequest) (*ListCheckpointsResponse, error)
-	CreateCheckpoint(context.Context, *CreateCheckpointRequest) (*CreateCheckpointResponse, error)
-	GetCheckpoint(context.Context, *GetCheckpointRequest) (*GetCheckpointResponse, error)
+	if interceptor == nil {
+		return srv.(RepositoryServer).CreateWebhook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Repository/CreateWebhook",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RepositoryServer).CreateWebhook(ctx, req.(*CreateWebhookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterRepositoryServer(s *grpc.Server, srv RepositoryServer) {
-	s.RegisterService(&_Repository_serviceDesc, srv)
+func _Repository_ListWebhooks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWebhooksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RepositoryServer).ListWebhooks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Repository/ListWebhooks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RepositoryServer).ListWebhooks(ctx, req.(*ListWebhooksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _Repository_Healthz_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(HealthCheckRequest)
+func _Repository_DeleteWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteWebhookRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RepositoryServer).Healthz(ctx, in)
+		return srv.(RepositoryServer).DeleteWebhook(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/api.Repository/Healthz",
+		FullMethod: "/api.Repository/DeleteWebhook",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RepositoryServer).Healthz(ctx, req.(*HealthCheckRequest))
+		return srv.(RepositoryServer).DeleteWebhook(ctx, req.(*DeleteWebhookRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Repository_Config_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ConfigRequest)
+func _Repository_ListWebhookDeadLetters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWebhookDeadLettersRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RepositoryServer).Config(ctx, in)
+		return srv.(RepositoryServer).ListWebhookDeadLetters(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/api.Repository/Config",
+		FullMethod: "/api.Repository/ListWebhookDeadLetters",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RepositoryServer).Config(ctx, req.(*ConfigRequest))
+		return srv.(RepositoryServer).ListWebhookDeadLetters(ctx, req.(*ListWebhookDeadLettersRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Repository_ListModels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListModelsRequest)
+func _Repository_ListRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRevisionsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RepositoryServer).ListModels(ctx, in)
+		return srv.(RepositoryServer).ListRevisions(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/api.Repository/ListModels",
+		FullMethod: "/api.Repository/ListRevisions",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RepositoryServer).ListModels(ctx, req.(*ListModelsRequest))
+		return srv.(RepositoryServer).ListRevisions(ctx, req.(*ListRevisionsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Repository_CreateModel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateModelRequest)
+func _Repository_GetRevision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRevisionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RepositoryServer).CreateModel(ctx, in)
+		return srv.(RepositoryServer).GetRevision(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/api.Repository/CreateModel",
+		FullMethod: "/api.Repository/GetRevision",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RepositoryServer).CreateModel(ctx, req.(*CreateModelRequest))
+		return srv.(RepositoryServer).GetRevision(ctx, req.(*GetRevisionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Repository_GetModel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetModelRequest)
+func _Repository_Rollback_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RollbackRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RepositoryServer).GetModel(ctx, in)
+		return srv.(RepositoryServer).Rollback(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/api.Repository/GetModel",
+		FullMethod: "/api.Repository/Rollback",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RepositoryServer).GetModel(ctx, req.(*GetModelRequest))
+		return srv.(RepositoryServer).Rollback(ctx, req.(*RollbackRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Repository_UpdateModel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateModelRequest)
+func _Repository_WatchModel_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchModelRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(RepositoryServer).WatchModel(m, &repositoryWatchModelServer{stream})
+}
+
+type Repository_WatchModelServer interface {
+	Send(*WatchModelEvent) error
+	grpc.ServerStream
+}
+
+type repositoryWatchModelServer struct {
+	grpc.ServerStream
+}
+
+func (x *repositoryWatchModelServer) Send(m *WatchModelEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Repository_SetTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetTagRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RepositoryServer).UpdateModel(ctx, in)
+		return srv.(RepositoryServer).SetTag(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/api.Repository/UpdateModel",
+		FullMethod: "/api.Repository/SetTag",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RepositoryServer).UpdateModel(ctx, req.(*UpdateModelRequest))
+		return srv.(RepositoryServer).SetTag(ctx, req.(*SetTagRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Repository_ListHyperparameters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListHyperparametersRequest)
+func _Repository_GetTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTagRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RepositoryServer).ListHyperparameters(ctx, in)
+		return srv.(RepositoryServer).GetTag(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/api.Repository/ListHyperparameters",
+		FullMethod: "/api.Repository/GetTag",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RepositoryServer).ListHyperparameters(ctx, req.(*ListHyperparametersRequest))
+		return srv.(RepositoryServer).GetTag(ctx, req.(*GetTagRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Repository_CreateHyperparameters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateHyperparametersRequest)
+func _Repository_ListTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTagsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RepositoryServer).CreateHyperparameters(ctx, in)
+		return srv.(RepositoryServer).ListTags(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/api.Repository/CreateHyperparameters",
+		FullMethod: "/api.Repository/ListTags",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RepositoryServer).CreateHyperparameters(ctx, req.(*CreateHyperparametersRequest))
+		return srv.(RepositoryServer).ListTags(ctx, req.(*ListTagsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Repository_GetHyperparameters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetHyperparametersRequest)
+func _Repository_DeleteTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTagRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RepositoryServer).GetHyperparameters(ctx, in)
+		return srv.(RepositoryServer).DeleteTag(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/api.Repository/GetHyperparameters",
+		FullMethod: "/api.Repository/DeleteTag",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RepositoryServer).GetHyperparameters(ctx, req.(*GetHyperparametersRequest))
+		return srv.(RepositoryServer).DeleteTag(ctx, req.(*DeleteTagRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Repository_UpdateHyperparameters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateHyperparametersRequest)
+func _Repository_RampRollout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RampRolloutRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RepositoryServer).UpdateHyperparameters(ctx, in)
+		return srv.(RepositoryServer).RampRollout(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/api.Repository/UpdateHyperparameters",
+		FullMethod: "/api.Repository/RampRollout",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RepositoryServer).UpdateHyperparameters(ctx, req.(*UpdateHyperparametersRequest))
+		return srv.(RepositoryServer).RampRollout(ctx, req.(*RampRolloutRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Repository_ListCheckpoints_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListCheckpointsRequest)
+func _Repository_AbortRollout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AbortRolloutRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RepositoryServer).ListCheckpoints(ctx, in)
+		return srv.(RepositoryServer).AbortRollout(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/api.Repository/ListCheckpoints",
+		FullMethod: "/api.Repository/AbortRollout",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RepositoryServer).ListCheckpoints(ctx, req.(*ListCheckpointsRequest))
+		return srv.(RepositoryServer).AbortRollout(ctx, req.(*AbortRolloutRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Repository_CreateCheckpoint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateCheckpointRequest)
+func _Repository_ResolveModelForClient_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResolveModelForClientRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RepositoryServer).CreateCheckpoint(ctx, in)
+		return srv.(RepositoryServer).ResolveModelForClient(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/api.Repository/CreateCheckpoint",
+		FullMethod: "/api.Repository/ResolveModelForClient",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RepositoryServer).CreateCheckpoint(ctx, req.(*CreateCheckpointRequest))
+		return srv.(RepositoryServer).ResolveModelForClient(ctx, req.(*ResolveModelForClientRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Repository_GetCheckpoint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetCheckpointRequest)
+func _Repository_CompareCheckpoints_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompareCheckpointsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RepositoryServer).GetCheckpoint(ctx, in)
+		return srv.(RepositoryServer).CompareCheckpoints(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/api.Repository/GetCheckpoint",
+		FullMethod: "/api.Repository/CompareCheckpoints",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RepositoryServer).GetCheckpoint(ctx, req.(*GetCheckpointRequest))
+		return srv.(RepositoryServer).CompareCheckpoints(ctx, req.(*CompareCheckpointsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -2018,10 +7089,18 @@ var _Repository_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetModel",
 			Handler:    _Repository_GetModel_Handler,
 		},
+		{
+			MethodName: "ResolveModel",
+			Handler:    _Repository_ResolveModel_Handler,
+		},
 		{
 			MethodName: "UpdateModel",
 			Handler:    _Repository_UpdateModel_Handler,
 		},
+		{
+			MethodName: "DeleteModel",
+			Handler:    _Repository_DeleteModel_Handler,
+		},
 		{
 			MethodName: "ListHyperparameters",
 			Handler:    _Repository_ListHyperparameters_Handler,
@@ -2038,6 +7117,10 @@ var _Repository_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateHyperparameters",
 			Handler:    _Repository_UpdateHyperparameters_Handler,
 		},
+		{
+			MethodName: "DeleteHyperparameters",
+			Handler:    _Repository_DeleteHyperparameters_Handler,
+		},
 		{
 			MethodName: "ListCheckpoints",
 			Handler:    _Repository_ListCheckpoints_Handler,
@@ -2050,7 +7133,101 @@ var _Repository_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetCheckpoint",
 			Handler:    _Repository_GetCheckpoint_Handler,
 		},
+		{
+			MethodName: "GetCheckpointManifest",
+			Handler:    _Repository_GetCheckpointManifest_Handler,
+		},
+		{
+			MethodName: "UpgradeCheck",
+			Handler:    _Repository_UpgradeCheck_Handler,
+		},
+		{
+			MethodName: "FinalizeCheckpoint",
+			Handler:    _Repository_FinalizeCheckpoint_Handler,
+		},
+		{
+			MethodName: "UpdateCheckpointState",
+			Handler:    _Repository_UpdateCheckpointState_Handler,
+		},
+		{
+			MethodName: "DeleteCheckpoint",
+			Handler:    _Repository_DeleteCheckpoint_Handler,
+		},
+		{
+			MethodName: "Fsck",
+			Handler:    _Repository_Fsck_Handler,
+		},
+		{
+			MethodName: "ListAuditEvents",
+			Handler:    _Repository_ListAuditEvents_Handler,
+		},
+		{
+			MethodName: "CreateWebhook",
+			Handler:    _Repository_CreateWebhook_Handler,
+		},
+		{
+			MethodName: "ListWebhooks",
+			Handler:    _Repository_ListWebhooks_Handler,
+		},
+		{
+			MethodName: "DeleteWebhook",
+			Handler:    _Repository_DeleteWebhook_Handler,
+		},
+		{
+			MethodName: "ListWebhookDeadLetters",
+			Handler:    _Repository_ListWebhookDeadLetters_Handler,
+		},
+		{
+			MethodName: "ListRevisions",
+			Handler:    _Repository_ListRevisions_Handler,
+		},
+		{
+			MethodName: "GetRevision",
+			Handler:    _Repository_GetRevision_Handler,
+		},
+		{
+			MethodName: "Rollback",
+			Handler:    _Repository_Rollback_Handler,
+		},
+		{
+			MethodName: "SetTag",
+			Handler:    _Repository_SetTag_Handler,
+		},
+		{
+			MethodName: "GetTag",
+			Handler:    _Repository_GetTag_Handler,
+		},
+		{
+			MethodName: "ListTags",
+			Handler:    _Repository_ListTags_Handler,
+		},
+		{
+			MethodName: "DeleteTag",
+			Handler:    _Repository_DeleteTag_Handler,
+		},
+		{
+			MethodName: "RampRollout",
+			Handler:    _Repository_RampRollout_Handler,
+		},
+		{
+			MethodName: "AbortRollout",
+			Handler:    _Repository_AbortRollout_Handler,
+		},
+		{
+			MethodName: "ResolveModelForClient",
+			Handler:    _Repository_ResolveModelForClient_Handler,
+		},
+		{
+			MethodName: "CompareCheckpoints",
+			Handler:    _Repository_CompareCheckpoints_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchModel",
+			Handler:       _Repository_WatchModel_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "repository.proto",
 }
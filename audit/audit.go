@@ -0,0 +1,130 @@
+// Package audit records changes made through the repository and FLEA APIs in the audit log of the
+// active storage backend, and serves the ListAuditEvents RPC of both APIs.
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"time"
+
+	"github.com/doc-ai/tensorio-models/api"
+	"github.com/doc-ai/tensorio-models/authentication"
+	"github.com/doc-ai/tensorio-models/common"
+	"github.com/doc-ai/tensorio-models/storage"
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Record - adds an event for a change which was made to the resource at resourcePath to the audit
+// log, and returns the event. before and after are the state of the resource before and after the
+// change, and are nil if it was created or deleted. The actor is taken from ctx. Failures are
+// logged rather than returned, since the change has already been made by the time it is recorded.
+func Record(ctx context.Context, store storage.AuditStorage, method, resourcePath string, req proto.Message, before, after interface{}) storage.AuditEvent {
+	now := time.Now()
+	event := storage.AuditEvent{
+		EventId:      storage.NewAuditEventId(now),
+		Time:         now,
+		Actor:        authentication.ActorFromContext(ctx),
+		Method:       method,
+		ResourcePath: resourcePath,
+		Request:      encode(req),
+		Before:       encode(before),
+		After:        encode(after),
+	}
+	err := store.AddAuditEvent(ctx, event)
+	if err != nil {
+		log.Printf("ERROR: Could not record audit event for %s on %s: %v", method, resourcePath, err)
+	}
+	return event
+}
+
+// encode - returns the JSON encoding of v, using the field names of the API for protobuf messages.
+func encode(v interface{}) string {
+	if v == nil {
+		return ""
+	}
+	if value := reflect.ValueOf(v); value.Kind() == reflect.Ptr && value.IsNil() {
+		return ""
+	}
+	if message, ok := v.(proto.Message); ok {
+		marshaler := jsonpb.Marshaler{}
+		encoded, err := marshaler.MarshalToString(message)
+		if err != nil {
+			log.Printf("ERROR: Could not encode %T for the audit log: %v", v, err)
+		}
+		return encoded
+	}
+	encoded, err := json.Marshal(v)
+	if err != nil {
+		log.Printf("ERROR: Could not encode %T for the audit log: %v", v, err)
+	}
+	return string(encoded)
+}
+
+// ListEvents - handles ListAuditEvents requests against the given audit log.
+func ListEvents(ctx context.Context, store storage.AuditStorage, req *api.ListAuditEventsRequest) (*api.ListAuditEventsResponse, error) {
+	query := storage.AuditQuery{ResourcePath: req.ResourcePath}
+	var err error
+	if req.Since != nil {
+		query.Since, err = ptypes.Timestamp(req.Since)
+		if err != nil {
+			return nil, api.InvalidFieldValueError("since", err.Error()).Err()
+		}
+	}
+	if req.Until != nil {
+		query.Until, err = ptypes.Timestamp(req.Until)
+		if err != nil {
+			return nil, api.InvalidFieldValueError("until", err.Error()).Err()
+		}
+	}
+	marker, err := common.DecodePageToken(req.PageToken)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	maxItems := int(req.MaxItems)
+	if maxItems <= 0 {
+		maxItems = 10
+	}
+	log.Printf("ListAuditEvents request - ResourcePath: %s, Since: %v, Until: %v, Marker: %s, MaxItems: %d", req.ResourcePath, req.Since, req.Until, marker, maxItems)
+
+	events, nextMarker, err := storage.ListMatchingAuditEvents(ctx, store, query, marker, maxItems)
+	if err != nil {
+		log.Printf("ERROR: %v", err)
+		return nil, status.Error(codes.Unavailable, "Could not retrieve audit events from storage")
+	}
+	res := &api.ListAuditEventsResponse{
+		Events:        make([]*api.AuditEvent, len(events)),
+		NextPageToken: common.EncodePageToken(nextMarker),
+	}
+	for i, event := range events {
+		res.Events[i], err = EventToAPI(event)
+		if err != nil {
+			log.Printf("ERROR: %v", err)
+			return nil, status.Error(codes.Internal, "Could not convert audit event time")
+		}
+	}
+	return res, nil
+}
+
+// EventToAPI - converts an audit event into its API representation.
+func EventToAPI(event storage.AuditEvent) (*api.AuditEvent, error) {
+	eventTime, err := ptypes.TimestampProto(event.Time)
+	if err != nil {
+		return nil, err
+	}
+	return &api.AuditEvent{
+		EventId:      event.EventId,
+		Time:         eventTime,
+		Actor:        event.Actor,
+		Method:       event.Method,
+		ResourcePath: event.ResourcePath,
+		Request:      event.Request,
+		Before:       event.Before,
+		After:        event.After,
+	}, nil
+}
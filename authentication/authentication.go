@@ -3,9 +3,12 @@ package authentication
 import (
 	"bufio"
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"errors"
 	"io"
 	"os"
+	"strings"
 	"sync/atomic"
 	"unsafe"
 
@@ -75,26 +78,105 @@ func CreateGRPCInterceptor(authenticator Authenticator,
 		info *grpc.UnaryServerInfo,
 		handler grpc.UnaryHandler) (interface{}, error) {
 
-		tokenType, exists := methodToTokenType[FullMethodName(info.FullMethod)]
-		if !exists {
-			// Returns error code 401.
-			log.Println(info.FullMethod, req)
-			return nil, status.Errorf(codes.Unauthenticated, "Unauthorized. No one is authorized")
-		}
-		if tokenType == NoAuthentication {
-			log.Println(info.FullMethod, req)
-			return handler(ctx, req)
-		}
-		err := authenticator.CheckAuthentication(ctx, tokenType)
+		ctx, err := authenticate(ctx, authenticator, methodToTokenType, info.FullMethod)
 		if err != nil {
-			// Error code 401
-			return nil, status.Errorf(codes.Unauthenticated, err.Error())
+			return nil, err
 		}
 		log.Println(info.FullMethod, req)
 		return handler(ctx, req)
 	}
 }
 
+// CreateGRPCStreamInterceptor - as CreateGRPCInterceptor, for streaming RPCs. Pass its output to
+// grpc.NewServer() using grpc.StreamInterceptor().
+func CreateGRPCStreamInterceptor(authenticator Authenticator,
+	methodToTokenType MethodToAuthenticationTokenType) grpc.StreamServerInterceptor {
+	return func(srv interface{},
+		stream grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler) error {
+
+		ctx, err := authenticate(stream.Context(), authenticator, methodToTokenType, info.FullMethod)
+		if err != nil {
+			return err
+		}
+		log.Println(info.FullMethod)
+		return handler(srv, &serverStreamWithContext{ServerStream: stream, ctx: ctx})
+	}
+}
+
+// authenticate - checks that the request in ctx may call the given method, and returns a copy of
+// ctx which carries its actor. Returns error code 401 if it may not.
+func authenticate(ctx context.Context, authenticator Authenticator,
+	methodToTokenType MethodToAuthenticationTokenType, fullMethod string) (context.Context, error) {
+	tokenType, exists := methodToTokenType[FullMethodName(fullMethod)]
+	if !exists {
+		return nil, status.Errorf(codes.Unauthenticated, "Unauthorized. No one is authorized")
+	}
+	ctx = ContextWithActor(ctx, TokenActor(tokenType, authorizationToken(ctx)))
+	if tokenType == NoAuthentication {
+		return ctx, nil
+	}
+	err := authenticator.CheckAuthentication(ctx, tokenType)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, err.Error())
+	}
+	return ctx, nil
+}
+
+// serverStreamWithContext - a grpc.ServerStream whose handler sees a different context.
+type serverStreamWithContext struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (stream *serverStreamWithContext) Context() context.Context {
+	return stream.ctx
+}
+
+type actorKey struct{}
+
+// AnonymousActor - the actor of requests which did not pass through an interceptor created by
+// CreateGRPCInterceptor.
+const AnonymousActor = "anonymous"
+
+// ContextWithActor - returns a copy of ctx which carries the given actor.
+func ContextWithActor(ctx context.Context, actor string) context.Context {
+	return context.WithValue(ctx, actorKey{}, actor)
+}
+
+// ActorFromContext - returns the actor set by ContextWithActor, or AnonymousActor if there is none.
+func ActorFromContext(ctx context.Context) string {
+	actor, ok := ctx.Value(actorKey{}).(string)
+	if !ok {
+		return AnonymousActor
+	}
+	return actor
+}
+
+// TokenActor - identifies the holder of an authorization token without revealing the token. The
+// actor is the token type followed by the first 12 hex digits of the SHA-256 digest of the token
+// (without its "Bearer " prefix), e.g. MODELS_ADMIN:9f86d081884c. Requests without a token are
+// identified by the token type alone.
+func TokenActor(tokenType AuthenticationTokenType, token string) string {
+	token = strings.TrimPrefix(token, "Bearer ")
+	if token == "" {
+		return string(tokenType)
+	}
+	digest := sha256.Sum256([]byte(token))
+	return string(tokenType) + ":" + hex.EncodeToString(digest[:])[:12]
+}
+
+// authorizationToken - returns the authorization header of an incoming request, or "" if it has
+// none.
+func authorizationToken(ctx context.Context) string {
+	headers, ok := metadata.FromIncomingContext(ctx)
+	if !ok || len(headers["authorization"]) < 1 {
+		return ""
+	}
+	return headers["authorization"][0]
+}
+
 // NewAuthenticator - returns an authenticator object. All valid keys in the TokenTypeToSet must be
 // specified in template. Panics on failure to load objects.
 func NewAuthenticator(template Authenticator) Authenticator {
@@ -5,7 +5,10 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
 )
 
 func Test_AtomicAssign(t *testing.T) {
@@ -73,3 +76,62 @@ func Test_TokenReload(t *testing.T) {
 	err = auth.CheckAuthentication(ctx, "ModelsAdmin")
 	assert.NoError(t, err)
 }
+
+func Test_InterceptorSetsActor(t *testing.T) {
+	auth := &FileSystemAuthentication{
+		TokenTypeToSet: &AuthenticationTokenTypeToSet{
+			"ADMIN": AuthenticationTokenSet{"Bearer test": {}},
+		},
+	}
+	interceptor := CreateGRPCInterceptor(auth, MethodToAuthenticationTokenType{"/api.Test/Admin": "ADMIN"})
+	info := &grpc.UnaryServerInfo{FullMethod: "/api.Test/Admin"}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return ActorFromContext(ctx), nil
+	}
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.MD{"authorization": {"Bearer test"}})
+	actor, err := interceptor(ctx, nil, info, handler)
+	assert.NoError(t, err)
+	// echo -n test | sha256sum
+	assert.Equal(t, "ADMIN:9f86d081884c", actor)
+
+	assert.Equal(t, AnonymousActor, ActorFromContext(context.Background()))
+	assert.Equal(t, "ADMIN", TokenActor("ADMIN", ""))
+}
+
+type fakeServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (stream fakeServerStream) Context() context.Context {
+	return stream.ctx
+}
+
+func Test_StreamInterceptor(t *testing.T) {
+	auth := &FileSystemAuthentication{
+		TokenTypeToSet: &AuthenticationTokenTypeToSet{
+			"ADMIN": AuthenticationTokenSet{"Bearer test": {}},
+		},
+	}
+	interceptor := CreateGRPCStreamInterceptor(auth, MethodToAuthenticationTokenType{"/api.Test/Watch": "ADMIN"})
+	info := &grpc.StreamServerInfo{FullMethod: "/api.Test/Watch", IsServerStream: true}
+	var actor string
+	handler := func(srv interface{}, stream grpc.ServerStream) error {
+		actor = ActorFromContext(stream.Context())
+		return nil
+	}
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.MD{"authorization": {"Bearer test"}})
+	err := interceptor(nil, fakeServerStream{ctx: ctx}, info, handler)
+	assert.NoError(t, err)
+	assert.Equal(t, "ADMIN:9f86d081884c", actor)
+
+	ctx = metadata.NewIncomingContext(context.Background(), metadata.MD{"authorization": {"Bearer wrong"}})
+	err = interceptor(nil, fakeServerStream{ctx: ctx}, info, handler)
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	info.FullMethod = "/api.Test/Unknown"
+	err = interceptor(nil, fakeServerStream{ctx: ctx}, info, handler)
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+}
@@ -23,7 +23,7 @@ DESCRIPTOR = _descriptor.FileDescriptor(
   package='api',
   syntax='proto3',
   serialized_options=None,
-  serialized_pb=_b('\n\nflea.proto\x12\x03\x61pi\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x10repository.proto\"a\n\x11ModifyTaskRequest\x12\x0e\n\x06taskId\x18\x01 \x01(\t\x12,\n\x08\x64\x65\x61\x64line\x18\x02 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12\x0e\n\x06\x61\x63tive\x18\x03 \x01(\x08\"\x94\x01\n\x10ListTasksRequest\x12\x0f\n\x07modelId\x18\x01 \x01(\t\x12\x19\n\x11hyperparametersId\x18\x02 \x01(\t\x12\x14\n\x0c\x63heckpointId\x18\x03 \x01(\t\x12\x13\n\x0bstartTaskId\x18\x04 \x01(\t\x12\x10\n\x08maxItems\x18\x05 \x01(\x05\x12\x17\n\x0fincludeInactive\x18\x06 \x01(\x08\"K\n\x11ListTasksResponse\x12\x13\n\x0bstartTaskId\x18\x01 \x01(\t\x12\x10\n\x08maxItems\x18\x02 \x01(\x05\x12\x0f\n\x07taskIds\x18\x03 \x03(\t\" \n\x0eGetTaskRequest\x12\x0e\n\x06taskId\x18\x01 \x01(\t\"\xc3\x01\n\x0bTaskDetails\x12\x0f\n\x07modelId\x18\x01 \x01(\t\x12\x19\n\x11hyperparametersId\x18\x02 \x01(\t\x12\x14\n\x0c\x63heckpointId\x18\x03 \x01(\t\x12,\n\x08\x64\x65\x61\x64line\x18\x04 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12\x0e\n\x06taskId\x18\x05 \x01(\t\x12\x0e\n\x06\x61\x63tive\x18\x06 \x01(\x08\x12\x0c\n\x04link\x18\x07 \x01(\t\x12\x16\n\x0e\x63heckpointLink\x18\x08 \x01(\t\"\"\n\x10StartTaskRequest\x12\x0e\n\x06taskId\x18\x01 \x01(\t\"\xa4\x01\n\x11StartTaskResponse\x12\x34\n\x06status\x18\x01 \x01(\x0e\x32$.api.StartTaskResponse.RequestStatus\x12\r\n\x05jobId\x18\x02 \x01(\t\x12\x10\n\x08uploadTo\x18\x03 \x01(\t\"8\n\rRequestStatus\x12\x0b\n\x07UNKNOWN\x10\x00\x12\x0c\n\x08REJECTED\x10\x01\x12\x0c\n\x08\x41PPROVED\x10\x02\"t\n\x0c\x41\x64minRequest\x12\x30\n\x04type\x18\x01 \x01(\x0e\x32\".api.AdminRequest.AdminRequestType\"2\n\x10\x41\x64minRequestType\x12\x0b\n\x07UNKNOWN\x10\x00\x12\x11\n\rRELOAD_TOKENS\x10\x01\"\"\n\x0fGenericResponse\x12\x0f\n\x07message\x18\x01 \x01(\t\"F\n\x0fJobErrorRequest\x12\x0e\n\x06taskId\x18\x01 \x01(\t\x12\r\n\x05jobId\x18\x02 \x01(\t\x12\x14\n\x0c\x65rrorMessage\x18\x03 \x01(\t2\x9d\x06\n\x04\x46lea\x12V\n\x07Healthz\x12\x17.api.HealthCheckRequest\x1a\x18.api.HealthCheckResponse\"\x18\x82\xd3\xe4\x93\x02\x12\x12\x10/v1/flea/healthz\x12J\n\x06\x43onfig\x12\x12.api.ConfigRequest\x1a\x13.api.ConfigResponse\"\x17\x82\xd3\xe4\x93\x02\x11\x12\x0f/v1/flea/config\x12Q\n\nCreateTask\x12\x10.api.TaskDetails\x1a\x10.api.TaskDetails\"\x1f\x82\xd3\xe4\x93\x02\x19\"\x14/v1/flea/create_task:\x01*\x12`\n\nModifyTask\x12\x16.api.ModifyTaskRequest\x1a\x10.api.TaskDetails\"(\x82\xd3\xe4\x93\x02\"\"\x1d/v1/flea/modify_task/{taskId}:\x01*\x12R\n\tListTasks\x12\x15.api.ListTasksRequest\x1a\x16.api.ListTasksResponse\"\x16\x82\xd3\xe4\x93\x02\x10\x12\x0e/v1/flea/tasks\x12Q\n\x07GetTask\x12\x13.api.GetTaskRequest\x1a\x10.api.TaskDetails\"\x1f\x82\xd3\xe4\x93\x02\x19\x12\x17/v1/flea/tasks/{taskId}\x12`\n\tStartTask\x12\x15.api.StartTaskRequest\x1a\x16.api.StartTaskResponse\"$\x82\xd3\xe4\x93\x02\x1e\x12\x1c/v1/flea/start_task/{taskId}\x12\x66\n\x08JobError\x12\x14.api.JobErrorRequest\x1a\x14.api.GenericResponse\".\x82\xd3\xe4\x93\x02(\"#/v1/flea/job_error/{taskId}/{jobId}:\x01*\x12K\n\x05\x41\x64min\x12\x11.api.AdminRequest\x1a\x14.api.GenericResponse\"\x19\x82\xd3\xe4\x93\x02\x13\"\x0e/v1/flea/admin:\x01*b\x06proto3')
+  serialized_pb=_b('\n\nflea.proto\x12\x03\x61pi\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x10repository.proto\"a\n\x11ModifyTaskRequest\x12\x0e\n\x06taskId\x18\x01 \x01(\t\x12,\n\x08\x64\x65\x61\x64line\x18\x02 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12\x0e\n\x06\x61\x63tive\x18\x03 \x01(\x08\"\xc4\x01\n\x10ListTasksRequest\x12\x0f\n\x07modelId\x18\x01 \x01(\t\x12\x19\n\x11hyperparametersId\x18\x02 \x01(\t\x12\x14\n\x0c\x63heckpointId\x18\x03 \x01(\t\x12\x13\n\x0bstartTaskId\x18\x04 \x01(\t\x12\x10\n\x08maxItems\x18\x05 \x01(\x05\x12\x17\n\x0fincludeInactive\x18\x06 \x01(\x08\x12\x11\n\tpageToken\x18\x07 \x01(\t\x12\x1b\n\x04view\x18\x08 \x01(\x0e\x32\r.api.ListView\"\x83\x01\n\x11ListTasksResponse\x12\x13\n\x0bstartTaskId\x18\x01 \x01(\t\x12\x10\n\x08maxItems\x18\x02 \x01(\x05\x12\x0f\n\x07taskIds\x18\x03 \x03(\t\x12\x15\n\rnextPageToken\x18\x04 \x01(\t\x12\x1f\n\x05tasks\x18\x05 \x03(\x0b\x32\x10.api.TaskDetails\"1\n\x0eGetTaskRequest\x12\x0e\n\x06taskId\x18\x01 \x01(\t\x12\x0f\n\x07rawLink\x18\x02 \x01(\x08\"\xc3\x01\n\x0bTaskDetails\x12\x0f\n\x07modelId\x18\x01 \x01(\t\x12\x19\n\x11hyperparametersId\x18\x02 \x01(\t\x12\x14\n\x0c\x63heckpointId\x18\x03 \x01(\t\x12,\n\x08\x64\x65\x61\x64line\x18\x04 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12\x0e\n\x06taskId\x18\x05 \x01(\t\x12\x0e\n\x06\x61\x63tive\x18\x06 \x01(\x08\x12\x0c\n\x04link\x18\x07 \x01(\t\x12\x16\n\x0e\x63heckpointLink\x18\x08 \x01(\t\"\"\n\x10StartTaskRequest\x12\x0e\n\x06taskId\x18\x01 \x01(\t\"\xa4\x01\n\x11StartTaskResponse\x12\x34\n\x06status\x18\x01 \x01(\x0e\x32$.api.StartTaskResponse.RequestStatus\x12\r\n\x05jobId\x18\x02 \x01(\t\x12\x10\n\x08uploadTo\x18\x03 \x01(\t\"8\n\rRequestStatus\x12\x0b\n\x07UNKNOWN\x10\x00\x12\x0c\n\x08REJECTED\x10\x01\x12\x0c\n\x08\x41PPROVED\x10\x02\"t\n\x0c\x41\x64minRequest\x12\x30\n\x04type\x18\x01 \x01(\x0e\x32\".api.AdminRequest.AdminRequestType\"2\n\x10\x41\x64minRequestType\x12\x0b\n\x07UNKNOWN\x10\x00\x12\x11\n\rRELOAD_TOKENS\x10\x01\"\"\n\x0fGenericResponse\x12\x0f\n\x07message\x18\x01 \x01(\t\"F\n\x0fJobErrorRequest\x12\x0e\n\x06taskId\x18\x01 \x01(\t\x12\r\n\x05jobId\x18\x02 \x01(\t\x12\x14\n\x0c\x65rrorMessage\x18\x03 \x01(\t\"/\n\nLogRequest\x12\x10\n\x08\x63lientId\x18\x01 \x01(\t\x12\x0f\n\x07message\x18\x02 \x01(\t2\x95\x0b\n\x04\x46lea\x12V\n\x07Healthz\x12\x17.api.HealthCheckRequest\x1a\x18.api.HealthCheckResponse\"\x18\x82\xd3\xe4\x93\x02\x12\x12\x10/v1/flea/healthz\x12J\n\x06\x43onfig\x12\x12.api.ConfigRequest\x1a\x13.api.ConfigResponse\"\x17\x82\xd3\xe4\x93\x02\x11\x12\x0f/v1/flea/config\x12Q\n\nCreateTask\x12\x10.api.TaskDetails\x1a\x10.api.TaskDetails\"\x1f\x82\xd3\xe4\x93\x02\x19\"\x14/v1/flea/create_task:\x01*\x12`\n\nModifyTask\x12\x16.api.ModifyTaskRequest\x1a\x10.api.TaskDetails\"(\x82\xd3\xe4\x93\x02\"\"\x1d/v1/flea/modify_task/{taskId}:\x01*\x12R\n\tListTasks\x12\x15.api.ListTasksRequest\x1a\x16.api.ListTasksResponse\"\x16\x82\xd3\xe4\x93\x02\x10\x12\x0e/v1/flea/tasks\x12Q\n\x07GetTask\x12\x13.api.GetTaskRequest\x1a\x10.api.TaskDetails\"\x1f\x82\xd3\xe4\x93\x02\x19\x12\x17/v1/flea/tasks/{taskId}\x12`\n\tStartTask\x12\x15.api.StartTaskRequest\x1a\x16.api.StartTaskResponse\"$\x82\xd3\xe4\x93\x02\x1e\x12\x1c/v1/flea/start_task/{taskId}\x12\x66\n\x08JobError\x12\x14.api.JobErrorRequest\x1a\x14.api.GenericResponse\".\x82\xd3\xe4\x93\x02(\"#/v1/flea/job_error/{taskId}/{jobId}:\x01*\x12P\n\x03Log\x12\x0f.api.LogRequest\x1a\x14.api.GenericResponse\"\"\x82\xd3\xe4\x93\x02\x1c\"\x17/v1/flea/log/{clientId}:\x01*\x12K\n\x05\x41\x64min\x12\x11.api.AdminRequest\x1a\x14.api.GenericResponse\"\x19\x82\xd3\xe4\x93\x02\x13\"\x0e/v1/flea/admin:\x01*\x12\x64\n\x0fListAuditEvents\x12\x1b.api.ListAuditEventsRequest\x1a\x1c.api.ListAuditEventsResponse\"\x16\x82\xd3\xe4\x93\x02\x10\x12\x0e/v1/flea/audit\x12\x64\n\rCreateWebhook\x12\x19.api.CreateWebhookRequest\x1a\x1a.api.CreateWebhookResponse\"\x1c\x82\xd3\xe4\x93\x02\x16\"\x11/v1/flea/webhooks:\x01*\x12^\n\x0cListWebhooks\x12\x18.api.ListWebhooksRequest\x1a\x19.api.ListWebhooksResponse\"\x19\x82\xd3\xe4\x93\x02\x13\x12\x11/v1/flea/webhooks\x12m\n\rDeleteWebhook\x12\x19.api.DeleteWebhookRequest\x1a\x1a.api.DeleteWebhookResponse\"%\x82\xd3\xe4\x93\x02\x1f*\x1d/v1/flea/webhooks/{webhookId}\x12\x88\x01\n\x16ListWebhookDeadLetters\x12\".api.ListWebhookDeadLettersRequest\x1a#.api.ListWebhookDeadLettersResponse\"%\x82\xd3\xe4\x93\x02\x1f\x12\x1d/v1/flea/webhook-dead-lettersb\x06proto3')
   ,
   dependencies=[google_dot_protobuf_dot_timestamp__pb2.DESCRIPTOR,google_dot_api_dot_annotations__pb2.DESCRIPTOR,repository__pb2.DESCRIPTOR,])
 
@@ -50,8 +50,8 @@ _STARTTASKRESPONSE_REQUESTSTATUS = _descriptor.EnumDescriptor(
   ],
   containing_type=None,
   serialized_options=None,
-  serialized_start=804,
-  serialized_end=860,
+  serialized_start=926,
+  serialized_end=982,
 )
 _sym_db.RegisterEnumDescriptor(_STARTTASKRESPONSE_REQUESTSTATUS)
 
@@ -72,8 +72,8 @@ _ADMINREQUEST_ADMINREQUESTTYPE = _descriptor.EnumDescriptor(
   ],
   containing_type=None,
   serialized_options=None,
-  serialized_start=928,
-  serialized_end=978,
+  serialized_start=1050,
+  serialized_end=1100,
 )
 _sym_db.RegisterEnumDescriptor(_ADMINREQUEST_ADMINREQUESTTYPE)
 
@@ -172,6 +172,20 @@ _LISTTASKSREQUEST = _descriptor.Descriptor(
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='pageToken', full_name='api.ListTasksRequest.pageToken', index=6,
+      number=7, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='view', full_name='api.ListTasksRequest.view', index=7,
+      number=8, type=14, cpp_type=8, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
   ],
   extensions=[
   ],
@@ -185,7 +199,7 @@ _LISTTASKSREQUEST = _descriptor.Descriptor(
   oneofs=[
   ],
   serialized_start=200,
-  serialized_end=348,
+  serialized_end=396,
 )
 
 
@@ -217,6 +231,20 @@ _LISTTASKSRESPONSE = _descriptor.Descriptor(
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='nextPageToken', full_name='api.ListTasksResponse.nextPageToken', index=3,
+      number=4, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='tasks', full_name='api.ListTasksResponse.tasks', index=4,
+      number=5, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
   ],
   extensions=[
   ],
@@ -229,8 +257,8 @@ _LISTTASKSRESPONSE = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=350,
-  serialized_end=425,
+  serialized_start=399,
+  serialized_end=530,
 )
 
 
@@ -248,6 +276,13 @@ _GETTASKREQUEST = _descriptor.Descriptor(
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='rawLink', full_name='api.GetTaskRequest.rawLink', index=1,
+      number=2, type=8, cpp_type=7, label=1,
+      has_default_value=False, default_value=False,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
   ],
   extensions=[
   ],
@@ -260,8 +295,8 @@ _GETTASKREQUEST = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=427,
-  serialized_end=459,
+  serialized_start=532,
+  serialized_end=581,
 )
 
 
@@ -340,8 +375,8 @@ _TASKDETAILS = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=462,
-  serialized_end=657,
+  serialized_start=584,
+  serialized_end=779,
 )
 
 
@@ -371,8 +406,8 @@ _STARTTASKREQUEST = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=659,
-  serialized_end=693,
+  serialized_start=781,
+  serialized_end=815,
 )
 
 
@@ -417,8 +452,8 @@ _STARTTASKRESPONSE = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=696,
-  serialized_end=860,
+  serialized_start=818,
+  serialized_end=982,
 )
 
 
@@ -449,8 +484,8 @@ _ADMINREQUEST = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=862,
-  serialized_end=978,
+  serialized_start=984,
+  serialized_end=1100,
 )
 
 
@@ -480,8 +515,8 @@ _GENERICRESPONSE = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=980,
-  serialized_end=1014,
+  serialized_start=1102,
+  serialized_end=1136,
 )
 
 
@@ -525,11 +560,51 @@ _JOBERRORREQUEST = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=1016,
-  serialized_end=1086,
+  serialized_start=1138,
+  serialized_end=1208,
+)
+
+
+_LOGREQUEST = _descriptor.Descriptor(
+  name='LogRequest',
+  full_name='api.LogRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='clientId', full_name='api.LogRequest.clientId', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='message', full_name='api.LogRequest.message', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=1210,
+  serialized_end=1257,
 )
 
 _MODIFYTASKREQUEST.fields_by_name['deadline'].message_type = google_dot_protobuf_dot_timestamp__pb2._TIMESTAMP
+_LISTTASKSREQUEST.fields_by_name['view'].enum_type = repository__pb2._LISTVIEW
+_LISTTASKSRESPONSE.fields_by_name['tasks'].message_type = _TASKDETAILS
 _TASKDETAILS.fields_by_name['deadline'].message_type = google_dot_protobuf_dot_timestamp__pb2._TIMESTAMP
 _STARTTASKRESPONSE.fields_by_name['status'].enum_type = _STARTTASKRESPONSE_REQUESTSTATUS
 _STARTTASKRESPONSE_REQUESTSTATUS.containing_type = _STARTTASKRESPONSE
@@ -545,6 +620,7 @@ DESCRIPTOR.message_types_by_name['StartTaskResponse'] = _STARTTASKRESPONSE
 DESCRIPTOR.message_types_by_name['AdminRequest'] = _ADMINREQUEST
 DESCRIPTOR.message_types_by_name['GenericResponse'] = _GENERICRESPONSE
 DESCRIPTOR.message_types_by_name['JobErrorRequest'] = _JOBERRORREQUEST
+DESCRIPTOR.message_types_by_name['LogRequest'] = _LOGREQUEST
 _sym_db.RegisterFileDescriptor(DESCRIPTOR)
 
 ModifyTaskRequest = _reflection.GeneratedProtocolMessageType('ModifyTaskRequest', (_message.Message,), {
@@ -617,6 +693,13 @@ JobErrorRequest = _reflection.GeneratedProtocolMessageType('JobErrorRequest', (_
   })
 _sym_db.RegisterMessage(JobErrorRequest)
 
+LogRequest = _reflection.GeneratedProtocolMessageType('LogRequest', (_message.Message,), {
+  'DESCRIPTOR' : _LOGREQUEST,
+  '__module__' : 'flea_pb2'
+  # @@protoc_insertion_point(class_scope:api.LogRequest)
+  })
+_sym_db.RegisterMessage(LogRequest)
+
 
 
 _FLEA = _descriptor.ServiceDescriptor(
@@ -625,8 +708,8 @@ _FLEA = _descriptor.ServiceDescriptor(
   file=DESCRIPTOR,
   index=0,
   serialized_options=None,
-  serialized_start=1089,
-  serialized_end=1886,
+  serialized_start=1260,
+  serialized_end=2689,
   methods=[
   _descriptor.MethodDescriptor(
     name='Healthz',
@@ -700,15 +783,69 @@ _FLEA = _descriptor.ServiceDescriptor(
     output_type=_GENERICRESPONSE,
     serialized_options=_b('\202\323\344\223\002(\"#/v1/flea/job_error/{taskId}/{jobId}:\001*'),
   ),
+  _descriptor.MethodDescriptor(
+    name='Log',
+    full_name='api.Flea.Log',
+    index=8,
+    containing_service=None,
+    input_type=_LOGREQUEST,
+    output_type=_GENERICRESPONSE,
+    serialized_options=_b('\202\323\344\223\002\034\"\027/v1/flea/log/{clientId}:\001*'),
+  ),
   _descriptor.MethodDescriptor(
     name='Admin',
     full_name='api.Flea.Admin',
-    index=8,
+    index=9,
     containing_service=None,
     input_type=_ADMINREQUEST,
     output_type=_GENERICRESPONSE,
     serialized_options=_b('\202\323\344\223\002\023\"\016/v1/flea/admin:\001*'),
   ),
+  _descriptor.MethodDescriptor(
+    name='ListAuditEvents',
+    full_name='api.Flea.ListAuditEvents',
+    index=10,
+    containing_service=None,
+    input_type=repository__pb2._LISTAUDITEVENTSREQUEST,
+    output_type=repository__pb2._LISTAUDITEVENTSRESPONSE,
+    serialized_options=_b('\202\323\344\223\002\020\022\016/v1/flea/audit'),
+  ),
+  _descriptor.MethodDescriptor(
+    name='CreateWebhook',
+    full_name='api.Flea.CreateWebhook',
+    index=11,
+    containing_service=None,
+    input_type=repository__pb2._CREATEWEBHOOKREQUEST,
+    output_type=repository__pb2._CREATEWEBHOOKRESPONSE,
+    serialized_options=_b('\202\323\344\223\002\026\"\021/v1/flea/webhooks:\001*'),
+  ),
+  _descriptor.MethodDescriptor(
+    name='ListWebhooks',
+    full_name='api.Flea.ListWebhooks',
+    index=12,
+    containing_service=None,
+    input_type=repository__pb2._LISTWEBHOOKSREQUEST,
+    output_type=repository__pb2._LISTWEBHOOKSRESPONSE,
+    serialized_options=_b('\202\323\344\223\002\023\022\021/v1/flea/webhooks'),
+  ),
+  _descriptor.MethodDescriptor(
+    name='DeleteWebhook',
+    full_name='api.Flea.DeleteWebhook',
+    index=13,
+    containing_service=None,
+    input_type=repository__pb2._DELETEWEBHOOKREQUEST,
+    output_type=repository__pb2._DELETEWEBHOOKRESPONSE,
+    serialized_options=_b('\202\323\344\223\002\037*\035/v1/flea/webhooks/{webhookId}'),
+  ),
+  _descriptor.MethodDescriptor(
+    name='ListWebhookDeadLetters',
+    full_name='api.Flea.ListWebhookDeadLetters',
+    index=14,
+    containing_service=None,
+    input_type=repository__pb2._LISTWEBHOOKDEADLETTERSREQUEST,
+    output_type=repository__pb2._LISTWEBHOOKDEADLETTERSRESPONSE,
+    serialized_options=_b('\202\323\344\223\002\037\022\035/v1/flea/webhook-dead-letters'),
+  ),
 ])
 _sym_db.RegisterServiceDescriptor(_FLEA)
 
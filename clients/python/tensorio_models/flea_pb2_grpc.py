@@ -55,11 +55,41 @@ class FleaStub(object):
         request_serializer=flea__pb2.JobErrorRequest.SerializeToString,
         response_deserializer=flea__pb2.GenericResponse.FromString,
         )
+    self.Log = channel.unary_unary(
+        '/api.Flea/Log',
+        request_serializer=flea__pb2.LogRequest.SerializeToString,
+        response_deserializer=flea__pb2.GenericResponse.FromString,
+        )
     self.Admin = channel.unary_unary(
         '/api.Flea/Admin',
         request_serializer=flea__pb2.AdminRequest.SerializeToString,
         response_deserializer=flea__pb2.GenericResponse.FromString,
         )
+    self.ListAuditEvents = channel.unary_unary(
+        '/api.Flea/ListAuditEvents',
+        request_serializer=repository__pb2.ListAuditEventsRequest.SerializeToString,
+        response_deserializer=repository__pb2.ListAuditEventsResponse.FromString,
+        )
+    self.CreateWebhook = channel.unary_unary(
+        '/api.Flea/CreateWebhook',
+        request_serializer=repository__pb2.CreateWebhookRequest.SerializeToString,
+        response_deserializer=repository__pb2.CreateWebhookResponse.FromString,
+        )
+    self.ListWebhooks = channel.unary_unary(
+        '/api.Flea/ListWebhooks',
+        request_serializer=repository__pb2.ListWebhooksRequest.SerializeToString,
+        response_deserializer=repository__pb2.ListWebhooksResponse.FromString,
+        )
+    self.DeleteWebhook = channel.unary_unary(
+        '/api.Flea/DeleteWebhook',
+        request_serializer=repository__pb2.DeleteWebhookRequest.SerializeToString,
+        response_deserializer=repository__pb2.DeleteWebhookResponse.FromString,
+        )
+    self.ListWebhookDeadLetters = channel.unary_unary(
+        '/api.Flea/ListWebhookDeadLetters',
+        request_serializer=repository__pb2.ListWebhookDeadLettersRequest.SerializeToString,
+        response_deserializer=repository__pb2.ListWebhookDeadLettersResponse.FromString,
+        )
 
 
 class FleaServicer(object):
@@ -124,6 +154,13 @@ class FleaServicer(object):
     context.set_details('Method not implemented!')
     raise NotImplementedError('Method not implemented!')
 
+  def Log(self, request, context):
+    # missing associated documentation comment in .proto file
+    pass
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
   def Admin(self, request, context):
     """Admin
 
@@ -132,6 +169,41 @@ class FleaServicer(object):
     context.set_details('Method not implemented!')
     raise NotImplementedError('Method not implemented!')
 
+  def ListAuditEvents(self, request, context):
+    # missing associated documentation comment in .proto file
+    pass
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
+  def CreateWebhook(self, request, context):
+    # missing associated documentation comment in .proto file
+    pass
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
+  def ListWebhooks(self, request, context):
+    # missing associated documentation comment in .proto file
+    pass
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
+  def DeleteWebhook(self, request, context):
+    # missing associated documentation comment in .proto file
+    pass
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
+  def ListWebhookDeadLetters(self, request, context):
+    # missing associated documentation comment in .proto file
+    pass
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
 
 def add_FleaServicer_to_server(servicer, server):
   rpc_method_handlers = {
@@ -175,11 +247,41 @@ def add_FleaServicer_to_server(servicer, server):
           request_deserializer=flea__pb2.JobErrorRequest.FromString,
           response_serializer=flea__pb2.GenericResponse.SerializeToString,
       ),
+      'Log': grpc.unary_unary_rpc_method_handler(
+          servicer.Log,
+          request_deserializer=flea__pb2.LogRequest.FromString,
+          response_serializer=flea__pb2.GenericResponse.SerializeToString,
+      ),
       'Admin': grpc.unary_unary_rpc_method_handler(
           servicer.Admin,
           request_deserializer=flea__pb2.AdminRequest.FromString,
           response_serializer=flea__pb2.GenericResponse.SerializeToString,
       ),
+      'ListAuditEvents': grpc.unary_unary_rpc_method_handler(
+          servicer.ListAuditEvents,
+          request_deserializer=repository__pb2.ListAuditEventsRequest.FromString,
+          response_serializer=repository__pb2.ListAuditEventsResponse.SerializeToString,
+      ),
+      'CreateWebhook': grpc.unary_unary_rpc_method_handler(
+          servicer.CreateWebhook,
+          request_deserializer=repository__pb2.CreateWebhookRequest.FromString,
+          response_serializer=repository__pb2.CreateWebhookResponse.SerializeToString,
+      ),
+      'ListWebhooks': grpc.unary_unary_rpc_method_handler(
+          servicer.ListWebhooks,
+          request_deserializer=repository__pb2.ListWebhooksRequest.FromString,
+          response_serializer=repository__pb2.ListWebhooksResponse.SerializeToString,
+      ),
+      'DeleteWebhook': grpc.unary_unary_rpc_method_handler(
+          servicer.DeleteWebhook,
+          request_deserializer=repository__pb2.DeleteWebhookRequest.FromString,
+          response_serializer=repository__pb2.DeleteWebhookResponse.SerializeToString,
+      ),
+      'ListWebhookDeadLetters': grpc.unary_unary_rpc_method_handler(
+          servicer.ListWebhookDeadLetters,
+          request_deserializer=repository__pb2.ListWebhookDeadLettersRequest.FromString,
+          response_serializer=repository__pb2.ListWebhookDeadLettersResponse.SerializeToString,
+      ),
   }
   generic_handler = grpc.method_handlers_generic_handler(
       'api.Flea', rpc_method_handlers)
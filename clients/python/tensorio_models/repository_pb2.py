@@ -4,6 +4,7 @@
 
 import sys
 _b=sys.version_info[0]<3 and (lambda x:x) or (lambda x:x.encode('latin1'))
+from google.protobuf.internal import enum_type_wrapper
 from google.protobuf import descriptor as _descriptor
 from google.protobuf import message as _message
 from google.protobuf import reflection as _reflection
@@ -22,10 +23,70 @@ DESCRIPTOR = _descriptor.FileDescriptor(
   package='api',
   syntax='proto3',
   serialized_options=None,
-  serialized_pb=_b('\n\x10repository.proto\x12\x03\x61pi\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"%\n\x12HealthCheckRequest\x12\x0f\n\x07service\x18\x01 \x01(\t\"\x89\x01\n\x13HealthCheckResponse\x12\x36\n\x06status\x18\x01 \x01(\x0e\x32&.api.HealthCheckResponse.ServingStatus\":\n\rServingStatus\x12\x0b\n\x07UNKNOWN\x10\x00\x12\x0b\n\x07SERVING\x10\x01\x12\x0f\n\x0bNOT_SERVING\x10\x02\"\x0f\n\rConfigRequest\"\x88\x01\n\x0e\x43onfigResponse\x12\x34\n\x0b\x62\x61\x63kendType\x18\x01 \x01(\x0e\x32\x1f.api.ConfigResponse.BackendType\"@\n\x0b\x42\x61\x63kendType\x12\x0b\n\x07INVALID\x10\x00\x12\n\n\x06MEMORY\x10\x01\x12\x18\n\x14GOOGLE_CLOUD_STORAGE\x10\x02\"K\n\x05Model\x12\x0f\n\x07modelId\x18\x01 \x01(\t\x12\x0f\n\x07\x64\x65tails\x18\x02 \x01(\t\x12 \n\x18\x63\x61nonicalHyperparameters\x18\x03 \x01(\t\"5\n\x11ListModelsRequest\x12\x0e\n\x06marker\x18\x01 \x01(\t\x12\x10\n\x08maxItems\x18\x02 \x01(\x05\"&\n\x12ListModelsResponse\x12\x10\n\x08modelIds\x18\x01 \x03(\t\"/\n\x12\x43reateModelRequest\x12\x19\n\x05model\x18\x01 \x01(\x0b\x32\n.api.Model\"+\n\x13\x43reateModelResponse\x12\x14\n\x0cresourcePath\x18\x01 \x01(\t\"\"\n\x0fGetModelRequest\x12\x0f\n\x07modelId\x18\x01 \x01(\t\"V\n\x10GetModelResponse\x12\x0f\n\x07modelId\x18\x01 \x01(\t\x12\x0f\n\x07\x64\x65tails\x18\x02 \x01(\t\x12 \n\x18\x63\x61nonicalHyperparameters\x18\x03 \x01(\t\"@\n\x12UpdateModelRequest\x12\x0f\n\x07modelId\x18\x01 \x01(\t\x12\x19\n\x05model\x18\x02 \x01(\x0b\x32\n.api.Model\"0\n\x13UpdateModelResponse\x12\x19\n\x05model\x18\x01 \x01(\x0b\x32\n.api.Model\"O\n\x1aListHyperparametersRequest\x12\x0f\n\x07modelId\x18\x01 \x01(\t\x12\x0e\n\x06marker\x18\x02 \x01(\t\x12\x10\n\x08maxItems\x18\x03 \x01(\x05\"J\n\x1bListHyperparametersResponse\x12\x0f\n\x07modelId\x18\x01 \x01(\t\x12\x1a\n\x12hyperparametersIds\x18\x02 \x03(\t\"\xf0\x01\n\x1c\x43reateHyperparametersRequest\x12\x0f\n\x07modelId\x18\x01 \x01(\t\x12\x19\n\x11hyperparametersId\x18\x02 \x01(\t\x12\x1b\n\x13\x63\x61nonicalCheckpoint\x18\x03 \x01(\t\x12O\n\x0fhyperparameters\x18\x04 \x03(\x0b\x32\x36.api.CreateHyperparametersRequest.HyperparametersEntry\x1a\x36\n\x14HyperparametersEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\"5\n\x1d\x43reateHyperparametersResponse\x12\x14\n\x0cresourcePath\x18\x01 \x01(\t\"G\n\x19GetHyperparametersRequest\x12\x0f\n\x07modelId\x18\x01 \x01(\t\x12\x19\n\x11hyperparametersId\x18\x02 \x01(\t\"\xff\x01\n\x1aGetHyperparametersResponse\x12\x0f\n\x07modelId\x18\x01 \x01(\t\x12\x19\n\x11hyperparametersId\x18\x02 \x01(\t\x12\x11\n\tupgradeTo\x18\x03 \x01(\t\x12\x1b\n\x13\x63\x61nonicalCheckpoint\x18\x04 \x01(\t\x12M\n\x0fhyperparameters\x18\x05 \x03(\x0b\x32\x34.api.GetHyperparametersResponse.HyperparametersEntry\x1a\x36\n\x14HyperparametersEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\"\x83\x02\n\x1cUpdateHyperparametersRequest\x12\x0f\n\x07modelId\x18\x01 \x01(\t\x12\x19\n\x11hyperparametersId\x18\x02 \x01(\t\x12\x11\n\tupgradeTo\x18\x03 \x01(\t\x12\x1b\n\x13\x63\x61nonicalCheckpoint\x18\x04 \x01(\t\x12O\n\x0fhyperparameters\x18\x05 \x03(\x0b\x32\x36.api.UpdateHyperparametersRequest.HyperparametersEntry\x1a\x36\n\x14HyperparametersEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\"\x85\x02\n\x1dUpdateHyperparametersResponse\x12\x0f\n\x07modelId\x18\x01 \x01(\t\x12\x19\n\x11hyperparametersId\x18\x02 \x01(\t\x12\x11\n\tupgradeTo\x18\x03 \x01(\t\x12\x1b\n\x13\x63\x61nonicalCheckpoint\x18\x04 \x01(\t\x12P\n\x0fhyperparameters\x18\x05 \x03(\x0b\x32\x37.api.UpdateHyperparametersResponse.HyperparametersEntry\x1a\x36\n\x14HyperparametersEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\"f\n\x16ListCheckpointsRequest\x12\x0f\n\x07modelId\x18\x01 \x01(\t\x12\x19\n\x11hyperparametersId\x18\x02 \x01(\t\x12\x0e\n\x06marker\x18\x03 \x01(\t\x12\x10\n\x08maxItems\x18\x04 \x01(\x05\"\\\n\x17ListCheckpointsResponse\x12\x0f\n\x07modelId\x18\x02 \x01(\t\x12\x19\n\x11hyperparametersId\x18\x03 \x01(\t\x12\x15\n\rcheckpointIds\x18\x01 \x03(\t\"\xcc\x01\n\x17\x43reateCheckpointRequest\x12\x0f\n\x07modelId\x18\x01 \x01(\t\x12\x19\n\x11hyperparametersId\x18\x02 \x01(\t\x12\x14\n\x0c\x63heckpointId\x18\x03 \x01(\t\x12\x0c\n\x04link\x18\x04 \x01(\t\x12\x34\n\x04info\x18\x05 \x03(\x0b\x32&.api.CreateCheckpointRequest.InfoEntry\x1a+\n\tInfoEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\"0\n\x18\x43reateCheckpointResponse\x12\x14\n\x0cresourcePath\x18\x01 \x01(\t\"X\n\x14GetCheckpointRequest\x12\x0f\n\x07modelId\x18\x01 \x01(\t\x12\x19\n\x11hyperparametersId\x18\x02 \x01(\t\x12\x14\n\x0c\x63heckpointId\x18\x03 \x01(\t\"\xf7\x01\n\x15GetCheckpointResponse\x12\x0f\n\x07modelId\x18\x01 \x01(\t\x12\x19\n\x11hyperparametersId\x18\x02 \x01(\t\x12\x14\n\x0c\x63heckpointId\x18\x03 \x01(\t\x12\x0c\n\x04link\x18\x04 \x01(\t\x12-\n\tcreatedAt\x18\x05 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12\x32\n\x04info\x18\x06 \x03(\x0b\x32$.api.GetCheckpointResponse.InfoEntry\x1a+\n\tInfoEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\x32\xdc\r\n\nRepository\x12\\\n\x07Healthz\x12\x17.api.HealthCheckRequest\x1a\x18.api.HealthCheckResponse\"\x1e\x82\xd3\xe4\x93\x02\x18\x12\x16/v1/repository/healthz\x12P\n\x06\x43onfig\x12\x12.api.ConfigRequest\x1a\x13.api.ConfigResponse\"\x1d\x82\xd3\xe4\x93\x02\x17\x12\x15/v1/repository/config\x12\\\n\nListModels\x12\x16.api.ListModelsRequest\x1a\x17.api.ListModelsResponse\"\x1d\x82\xd3\xe4\x93\x02\x17\x12\x15/v1/repository/models\x12\x62\n\x0b\x43reateModel\x12\x17.api.CreateModelRequest\x1a\x18.api.CreateModelResponse\" \x82\xd3\xe4\x93\x02\x1a\"\x15/v1/repository/models:\x01*\x12`\n\x08GetModel\x12\x14.api.GetModelRequest\x1a\x15.api.GetModelResponse\"\'\x82\xd3\xe4\x93\x02!\x12\x1f/v1/repository/models/{modelId}\x12l\n\x0bUpdateModel\x12\x17.api.UpdateModelRequest\x1a\x18.api.UpdateModelResponse\"*\x82\xd3\xe4\x93\x02$\x1a\x1f/v1/repository/models/{modelId}:\x01*\x12\x91\x01\n\x13ListHyperparameters\x12\x1f.api.ListHyperparametersRequest\x1a .api.ListHyperparametersResponse\"7\x82\xd3\xe4\x93\x02\x31\x12//v1/repository/models/{modelId}/hyperparameters\x12\x9a\x01\n\x15\x43reateHyperparameters\x12!.api.CreateHyperparametersRequest\x1a\".api.CreateHyperparametersResponse\":\x82\xd3\xe4\x93\x02\x34\"//v1/repository/models/{modelId}/hyperparameters:\x01*\x12\xa2\x01\n\x12GetHyperparameters\x12\x1e.api.GetHyperparametersRequest\x1a\x1f.api.GetHyperparametersResponse\"K\x82\xd3\xe4\x93\x02\x45\x12\x43/v1/repository/models/{modelId}/hyperparameters/{hyperparametersId}\x12\xae\x01\n\x15UpdateHyperparameters\x12!.api.UpdateHyperparametersRequest\x1a\".api.UpdateHyperparametersResponse\"N\x82\xd3\xe4\x93\x02H\x1a\x43/v1/repository/models/{modelId}/hyperparameters/{hyperparametersId}:\x01*\x12\xa5\x01\n\x0fListCheckpoints\x12\x1b.api.ListCheckpointsRequest\x1a\x1c.api.ListCheckpointsResponse\"W\x82\xd3\xe4\x93\x02Q\x12O/v1/repository/models/{modelId}/hyperparameters/{hyperparametersId}/checkpoints\x12\xab\x01\n\x10\x43reateCheckpoint\x12\x1c.api.CreateCheckpointRequest\x1a\x1d.api.CreateCheckpointResponse\"Z\x82\xd3\xe4\x93\x02T\"O/v1/repository/models/{modelId}/hyperparameters/{hyperparametersId}/checkpoints:\x01*\x12\xae\x01\n\rGetCheckpoint\x12\x19.api.GetCheckpointRequest\x1a\x1a.api.GetCheckpointResponse\"f\x82\xd3\xe4\x93\x02`\x12^/v1/repository/models/{modelId}/hyperparameters/{hyperparametersId}/checkpoints/{checkpointId}b\x06proto3')
+  serialized_pb=_b('\n\x10repository.proto\x12\x03\x61pi\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"%\n\x12HealthCheckRequest\x12\x0f\n\x07service\x18\x01 \x01(\t\"\x89\x01\n\x13HealthCheckResponse\x12\x36\n\x06status\x18\x01 \x01(\x0e\x32&.api.HealthCheckResponse.ServingStatus\":\n\rServingStatus\x12\x0b\n\x07UNKNOWN\x10\x00\x12\x0b\n\x07SERVING\x10\x01\x12\x0f\n\x0bNOT_SERVING\x10\x02\"\x0f\n\rConfigRequest\"\xac\x01\n\x0e\x43onfigResponse\x12\x34\n\x0b\x62\x61\x63kendType\x18\x01 \x01(\x0e\x32\x1f.api.ConfigResponse.BackendType\"d\n\x0b\x42\x61\x63kendType\x12\x0b\n\x07INVALID\x10\x00\x12\n\n\x06MEMORY\x10\x01\x12\x18\n\x14GOOGLE_CLOUD_STORAGE\x10\x02\x12\x0e\n\nFILESYSTEM\x10\x03\x12\x06\n\x02S3\x10\x04\x12\n\n\x06\x42OLTDB\x10\x05\"\xd1\x01\n\x05Model\x12\x0f\n\x07modelId\x18\x01 \x01(\t\x12\x0f\n\x07\x64\x65tails\x18\x02 \x01(\t\x12 \n\x18\x63\x61nonicalHyperparameters\x18\x03 \x01(\t\x12&\n\x06labels\x18\x04 \x03(\x0b\x32\x16.api.Model.LabelsEntry\x12\x1c\n\x04\x63\x61rd\x18\x05 \x01(\x0b\x32\x0e.api.ModelCard\x12\x0f\n\x07version\x18\x06 \x01(\x03\x1a-\n\x0bLabelsEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\"\xc3\x01\n\tModelCard\x12\x15\n\rschemaVersion\x18\x01 \x01(\x05\x12\x13\n\x0b\x64\x65scription\x18\x02 \x01(\t\x12\x0e\n\x06owners\x18\x03 \x03(\t\x12\x1f\n\x06inputs\x18\x04 \x03(\x0b\x32\x0f.api.TensorSpec\x12 \n\x07outputs\x18\x05 \x03(\x0b\x32\x0f.api.TensorSpec\x12\x0f\n\x07license\x18\x06 \x01(\t\x12\x13\n\x0bintendedUse\x18\x07 \x01(\t\x12\x11\n\tframework\x18\x08 \x01(\t\"M\n\nTensorSpec\x12\x0c\n\x04name\x18\x01 \x01(\t\x12\r\n\x05\x64type\x18\x02 \x01(\t\x12\r\n\x05shape\x18\x03 \x03(\x03\x12\x13\n\x0b\x64\x65scription\x18\x04 \x01(\t\"u\n\x11ListModelsRequest\x12\x0e\n\x06marker\x18\x01 \x01(\t\x12\x10\n\x08maxItems\x18\x02 \x01(\x05\x12\x11\n\tpageToken\x18\x03 \x01(\t\x12\x1b\n\x04view\x18\x04 \x01(\x0e\x32\r.api.ListView\x12\x0e\n\x06\x66ilter\x18\x05 \x01(\t\"Y\n\x12ListModelsResponse\x12\x10\n\x08modelIds\x18\x01 \x03(\t\x12\x15\n\rnextPageToken\x18\x02 \x01(\t\x12\x1a\n\x06models\x18\x03 \x03(\x0b\x32\n.api.Model\"/\n\x12\x43reateModelRequest\x12\x19\n\x05model\x18\x01 \x01(\x0b\x32\n.api.Model\"+\n\x13\x43reateModelResponse\x12\x14\n\x0cresourcePath\x18\x01 \x01(\t\"\"\n\x0fGetModelRequest\x12\x0f\n\x07modelId\x18\x01 \x01(\t\"\xe7\x01\n\x10GetModelResponse\x12\x0f\n\x07modelId\x18\x01 \x01(\t\x12\x0f\n\x07\x64\x65tails\x18\x02 \x01(\t\x12 \n\x18\x63\x61nonicalHyperparameters\x18\x03 \x01(\t\x12\x31\n\x06labels\x18\x04 \x03(\x0b\x32!.api.GetModelResponse.LabelsEntry\x12\x1c\n\x04\x63\x61rd\x18\x05 \x01(\x0b\x32\x0e.api.ModelCard\x12\x0f\n\x07version\x18\x06 \x01(\x03\x1a-\n\x0bLabelsEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\"Y\n\x12UpdateModelRequest\x12\x0f\n\x07modelId\x18\x01 \x01(\t\x12\x19\n\x05model\x18\x02 \x01(\x0b\x32\n.api.Model\x12\x17\n\x0f\x65xpectedVersion\x18\x03 \x01(\x03\"0\n\x13UpdateModelResponse\x12\x19\n\x05model\x18\x01 \x01(\x0b\x32\n.api.Model\"6\n\x12\x44\x65leteModelRequest\x12\x0f\n\x07modelId\x18\x01 \x01(\t\x12\x0f\n\x07\x63\x61scade\x18\x02 \x01(\x08\"+\n\x13\x44\x65leteModelResponse\x12\x14\n\x0cresourcePath\x18\x01 \x01(\t\"\x8f\x01\n\x1aListHyperparametersRequest\x12\x0f\n\x07modelId\x18\x01 \x01(\t\x12\x0e\n\x06marker\x18\x02 \x01(\t\x12\x10\n\x08maxItems\x18\x03 \x01(\x05\x12\x11\n\tpageToken\x18\x04 \x01(\t\x12\x1b\n\x04view\x18\x05 \x01(\x0e\x32\r.api.ListView\x12\x0e\n\x06\x66ilter\x18\x06 \x01(\t\"\x9b\x01\n\x1bListHyperparametersResponse\x12\x0f\n\x07modelId\x18\x01 \x01(\t\x12\x1a\n\x12hyperparametersIds\x18\x02 \x03(\t\x12\x15\n\rnextPageToken\x18\x03 \x01(\t\x12\x38\n\x0fhyperparameters\x18\x04 \x03(\x0b\x32\x1f.api.GetHyperparametersResponse\"\xde\x02\n\x1c\x43reateHyperparametersRequest\x12\x0f\n\x07modelId\x18\x01 \x01(\t\x12\x19\n\x11hyperparametersId\x18\x02 \x01(\t\x12\x1b\n\x13\x63\x61nonicalCheckpoint\x18\x03 \x01(\t\x12O\n\x0fhyperparameters\x18\x04 \x03(\x0b\x32\x36.api.CreateHyperparametersRequest.HyperparametersEntry\x12=\n\x06labels\x18\x05 \x03(\x0b\x32-.api.CreateHyperparametersRequest.LabelsEntry\x1a\x36\n\x14HyperparametersEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\x1a-\n\x0bLabelsEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\"5\n\x1d\x43reateHyperparametersResponse\x12\x14\n\x0cresourcePath\x18\x01 \x01(\t\"G\n\x19GetHyperparametersRequest\x12\x0f\n\x07modelId\x18\x01 \x01(\t\x12\x19\n\x11hyperparametersId\x18\x02 \x01(\t\"\xa1\x03\n\x1aGetHyperparametersResponse\x12\x0f\n\x07modelId\x18\x01 \x01(\t\x12\x19\n\x11hyperparametersId\x18\x02 \x01(\t\x12\x11\n\tupgradeTo\x18\x03 \x01(\t\x12\x1b\n\x13\x63\x61nonicalCheckpoint\x18\x04 \x01(\t\x12M\n\x0fhyperparameters\x18\x05 \x03(\x0b\x32\x34.api.GetHyperparametersResponse.HyperparametersEntry\x12;\n\x06labels\x18\x06 \x03(\x0b\x32+.api.GetHyperparametersResponse.LabelsEntry\x12\x0f\n\x07version\x18\x07 \x01(\x03\x12#\n\x07rollout\x18\x08 \x03(\x0b\x32\x12.api.RolloutTarget\x1a\x36\n\x14HyperparametersEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\x1a-\n\x0bLabelsEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\"\x8a\x03\n\x1cUpdateHyperparametersRequest\x12\x0f\n\x07modelId\x18\x01 \x01(\t\x12\x19\n\x11hyperparametersId\x18\x02 \x01(\t\x12\x11\n\tupgradeTo\x18\x03 \x01(\t\x12\x1b\n\x13\x63\x61nonicalCheckpoint\x18\x04 \x01(\t\x12O\n\x0fhyperparameters\x18\x05 \x03(\x0b\x32\x36.api.UpdateHyperparametersRequest.HyperparametersEntry\x12=\n\x06labels\x18\x06 \x03(\x0b\x32-.api.UpdateHyperparametersRequest.LabelsEntry\x12\x17\n\x0f\x65xpectedVersion\x18\x07 \x01(\x03\x1a\x36\n\x14HyperparametersEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\x1a-\n\x0bLabelsEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\"\xaa\x03\n\x1dUpdateHyperparametersResponse\x12\x0f\n\x07modelId\x18\x01 \x01(\t\x12\x19\n\x11hyperparametersId\x18\x02 \x01(\t\x12\x11\n\tupgradeTo\x18\x03 \x01(\t\x12\x1b\n\x13\x63\x61nonicalCheckpoint\x18\x04 \x01(\t\x12P\n\x0fhyperparameters\x18\x05 \x03(\x0b\x32\x37.api.UpdateHyperparametersResponse.HyperparametersEntry\x12>\n\x06labels\x18\x06 \x03(\x0b\x32..api.UpdateHyperparametersResponse.LabelsEntry\x12\x0f\n\x07version\x18\x07 \x01(\x03\x12#\n\x07rollout\x18\x08 \x03(\x0b\x32\x12.api.RolloutTarget\x1a\x36\n\x14HyperparametersEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\x1a-\n\x0bLabelsEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\"j\n\x1c\x44\x65leteHyperparametersRequest\x12\x0f\n\x07modelId\x18\x01 \x01(\t\x12\x19\n\x11hyperparametersId\x18\x02 \x01(\t\x12\x0f\n\x07\x63\x61scade\x18\x03 \x01(\x08\x12\r\n\x05\x66orce\x18\x04 \x01(\x08\"5\n\x1d\x44\x65leteHyperparametersResponse\x12\x14\n\x0cresourcePath\x18\x01 \x01(\t\"\xbf\x01\n\x16ListCheckpointsRequest\x12\x0f\n\x07modelId\x18\x01 \x01(\t\x12\x19\n\x11hyperparametersId\x18\x02 \x01(\t\x12\x0e\n\x06marker\x18\x03 \x01(\t\x12\x10\n\x08maxItems\x18\x04 \x01(\x05\x12\x17\n\x0fincludeArchived\x18\x05 \x01(\x08\x12\x11\n\tpageToken\x18\x06 \x01(\t\x12\x1b\n\x04view\x18\x07 \x01(\x0e\x32\r.api.ListView\x12\x0e\n\x06\x66ilter\x18\x08 \x01(\t\"\xa4\x01\n\x17ListCheckpointsResponse\x12\x0f\n\x07modelId\x18\x02 \x01(\t\x12\x19\n\x11hyperparametersId\x18\x03 \x01(\t\x12\x15\n\rcheckpointIds\x18\x01 \x03(\t\x12\x15\n\rnextPageToken\x18\x04 \x01(\t\x12/\n\x0b\x63heckpoints\x18\x05 \x03(\x0b\x32\x1a.api.GetCheckpointResponse\"\x87\x03\n\x17\x43reateCheckpointRequest\x12\x0f\n\x07modelId\x18\x01 \x01(\t\x12\x19\n\x11hyperparametersId\x18\x02 \x01(\t\x12\x14\n\x0c\x63heckpointId\x18\x03 \x01(\t\x12\x0c\n\x04link\x18\x04 \x01(\t\x12\x34\n\x04info\x18\x05 \x03(\x0b\x32&.api.CreateCheckpointRequest.InfoEntry\x12\x38\n\x06labels\x18\x06 \x03(\x0b\x32(.api.CreateCheckpointRequest.LabelsEntry\x12\x18\n\x10requestUploadUrl\x18\x07 \x01(\x08\x12\x0e\n\x06sha256\x18\x08 \x01(\t\x12\x11\n\tsizeBytes\x18\t \x01(\x03\x12\x13\n\x0b\x63ontentType\x18\n \x01(\t\x1a+\n\tInfoEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\x1a-\n\x0bLabelsEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\"{\n\x18\x43reateCheckpointResponse\x12\x14\n\x0cresourcePath\x18\x01 \x01(\t\x12\x11\n\tuploadUrl\x18\x02 \x01(\t\x12\x36\n\x12uploadUrlExpiresAt\x18\x03 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\"]\n\x19\x46inalizeCheckpointRequest\x12\x0f\n\x07modelId\x18\x01 \x01(\t\x12\x19\n\x11hyperparametersId\x18\x02 \x01(\t\x12\x14\n\x0c\x63heckpointId\x18\x03 \x01(\t\"L\n\x1a\x46inalizeCheckpointResponse\x12.\n\ncheckpoint\x18\x01 \x01(\x0b\x32\x1a.api.GetCheckpointResponse\"i\n\x14GetCheckpointRequest\x12\x0f\n\x07modelId\x18\x01 \x01(\t\x12\x19\n\x11hyperparametersId\x18\x02 \x01(\t\x12\x14\n\x0c\x63heckpointId\x18\x03 \x01(\t\x12\x0f\n\x07rawLink\x18\x04 \x01(\x08\"\xbb\x03\n\x15GetCheckpointResponse\x12\x0f\n\x07modelId\x18\x01 \x01(\t\x12\x19\n\x11hyperparametersId\x18\x02 \x01(\t\x12\x14\n\x0c\x63heckpointId\x18\x03 \x01(\t\x12\x0c\n\x04link\x18\x04 \x01(\t\x12-\n\tcreatedAt\x18\x05 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12\x32\n\x04info\x18\x06 \x03(\x0b\x32$.api.GetCheckpointResponse.InfoEntry\x12#\n\x05state\x18\x07 \x01(\x0e\x32\x14.api.CheckpointState\x12\x36\n\x06labels\x18\x08 \x03(\x0b\x32&.api.GetCheckpointResponse.LabelsEntry\x12\x0e\n\x06sha256\x18\t \x01(\t\x12\x11\n\tsizeBytes\x18\n \x01(\x03\x12\x13\n\x0b\x63ontentType\x18\x0b \x01(\t\x1a+\n\tInfoEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\x1a-\n\x0bLabelsEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\"`\n\x1cGetCheckpointManifestRequest\x12\x0f\n\x07modelId\x18\x01 \x01(\t\x12\x19\n\x11hyperparametersId\x18\x02 \x01(\t\x12\x14\n\x0c\x63heckpointId\x18\x03 \x01(\t\"f\n\x1dGetCheckpointManifestResponse\x12\x10\n\x08manifest\x18\x01 \x01(\t\x12\x11\n\tsignature\x18\x02 \x01(\x0c\x12\r\n\x05keyId\x18\x03 \x01(\t\x12\x11\n\talgorithm\x18\x04 \x01(\t\"\x85\x01\n\x1cUpdateCheckpointStateRequest\x12\x0f\n\x07modelId\x18\x01 \x01(\t\x12\x19\n\x11hyperparametersId\x18\x02 \x01(\t\x12\x14\n\x0c\x63heckpointId\x18\x03 \x01(\t\x12#\n\x05state\x18\x04 \x01(\x0e\x32\x14.api.CheckpointState\"\x86\x01\n\x1dUpdateCheckpointStateResponse\x12\x0f\n\x07modelId\x18\x01 \x01(\t\x12\x19\n\x11hyperparametersId\x18\x02 \x01(\t\x12\x14\n\x0c\x63heckpointId\x18\x03 \x01(\t\x12#\n\x05state\x18\x04 \x01(\x0e\x32\x14.api.CheckpointState\"j\n\x17\x44\x65leteCheckpointRequest\x12\x0f\n\x07modelId\x18\x01 \x01(\t\x12\x19\n\x11hyperparametersId\x18\x02 \x01(\t\x12\x14\n\x0c\x63heckpointId\x18\x03 \x01(\t\x12\r\n\x05\x66orce\x18\x04 \x01(\x08\"0\n\x18\x44\x65leteCheckpointResponse\x12\x14\n\x0cresourcePath\x18\x01 \x01(\t\"&\n\x13ResolveModelRequest\x12\x0f\n\x07modelId\x18\x01 \x01(\t\"\xb0\x01\n\x14ResolveModelResponse\x12\x19\n\x05model\x18\x01 \x01(\x0b\x32\n.api.Model\x12\x38\n\x0fhyperparameters\x18\x02 \x01(\x0b\x32\x1f.api.GetHyperparametersResponse\x12.\n\ncheckpoint\x18\x03 \x01(\x0b\x32\x1a.api.GetCheckpointResponse\x12\x13\n\x0bupgradePath\x18\x04 \x03(\t\"W\n\x13UpgradeCheckRequest\x12\x0f\n\x07modelId\x18\x01 \x01(\t\x12\x19\n\x11hyperparametersId\x18\x02 \x01(\t\x12\x14\n\x0c\x63heckpointId\x18\x03 \x01(\t\"W\n\nUpgradeHop\x12\x19\n\x11hyperparametersId\x18\x01 \x01(\t\x12\x1b\n\x13\x63\x61nonicalCheckpoint\x18\x02 \x01(\t\x12\x11\n\tupgradeTo\x18\x03 \x01(\t\"{\n\x14UpgradeCheckResponse\x12\x18\n\x10upgradeAvailable\x18\x01 \x01(\x08\x12*\n\x06target\x18\x02 \x01(\x0b\x32\x1a.api.GetCheckpointResponse\x12\x1d\n\x04hops\x18\x03 \x03(\x0b\x32\x0f.api.UpgradeHop\"\r\n\x0b\x46sckRequest\"K\n\x11\x44\x61nglingReference\x12\x14\n\x0cresourcePath\x18\x01 \x01(\t\x12\r\n\x05\x66ield\x18\x02 \x01(\t\x12\x11\n\treference\x18\x03 \x01(\t\"B\n\x0c\x46sckResponse\x12\x32\n\x12\x64\x61nglingReferences\x18\x01 \x03(\x0b\x32\x16.api.DanglingReference\"\xac\x01\n\nAuditEvent\x12\x0f\n\x07\x65ventId\x18\x01 \x01(\t\x12(\n\x04time\x18\x02 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12\r\n\x05\x61\x63tor\x18\x03 \x01(\t\x12\x0e\n\x06method\x18\x04 \x01(\t\x12\x14\n\x0cresourcePath\x18\x05 \x01(\t\x12\x0f\n\x07request\x18\x06 \x01(\t\x12\x0e\n\x06\x62\x65\x66ore\x18\x07 \x01(\t\x12\r\n\x05\x61\x66ter\x18\x08 \x01(\t\"\xa9\x01\n\x16ListAuditEventsRequest\x12\x14\n\x0cresourcePath\x18\x01 \x01(\t\x12)\n\x05since\x18\x02 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12)\n\x05until\x18\x03 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12\x10\n\x08maxItems\x18\x04 \x01(\x05\x12\x11\n\tpageToken\x18\x05 \x01(\t\"Q\n\x17ListAuditEventsResponse\x12\x1f\n\x06\x65vents\x18\x01 \x03(\x0b\x32\x0f.api.AuditEvent\x12\x15\n\rnextPageToken\x18\x02 \x01(\t\"l\n\x07Webhook\x12\x11\n\twebhookId\x18\x01 \x01(\t\x12\x0b\n\x03url\x18\x02 \x01(\t\x12\x12\n\neventTypes\x18\x03 \x03(\t\x12-\n\tcreatedAt\x18\x04 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\"G\n\x14\x43reateWebhookRequest\x12\x0b\n\x03url\x18\x01 \x01(\t\x12\x12\n\neventTypes\x18\x02 \x03(\t\x12\x0e\n\x06secret\x18\x03 \x01(\t\"6\n\x15\x43reateWebhookResponse\x12\x1d\n\x07webhook\x18\x01 \x01(\x0b\x32\x0c.api.Webhook\"\x15\n\x13ListWebhooksRequest\"6\n\x14ListWebhooksResponse\x12\x1e\n\x08webhooks\x18\x01 \x03(\x0b\x32\x0c.api.Webhook\")\n\x14\x44\x65leteWebhookRequest\x12\x11\n\twebhookId\x18\x01 \x01(\t\"6\n\x15\x44\x65leteWebhookResponse\x12\x1d\n\x07webhook\x18\x01 \x01(\x0b\x32\x0c.api.Webhook\"\xbe\x01\n\x11WebhookDeadLetter\x12\x12\n\ndeliveryId\x18\x01 \x01(\t\x12\x11\n\twebhookId\x18\x02 \x01(\t\x12\x0b\n\x03url\x18\x03 \x01(\t\x12\x11\n\teventType\x18\x04 \x01(\t\x12\x0f\n\x07payload\x18\x05 \x01(\t\x12\x10\n\x08\x61ttempts\x18\x06 \x01(\x05\x12\x11\n\tlastError\x18\x07 \x01(\t\x12,\n\x08\x66\x61iledAt\x18\x08 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\"D\n\x1dListWebhookDeadLettersRequest\x12\x10\n\x08maxItems\x18\x01 \x01(\x05\x12\x11\n\tpageToken\x18\x02 \x01(\t\"d\n\x1eListWebhookDeadLettersResponse\x12+\n\x0b\x64\x65\x61\x64Letters\x18\x01 \x03(\x0b\x32\x16.api.WebhookDeadLetter\x12\x15\n\rnextPageToken\x18\x02 \x01(\t\"\x9f\x01\n\x08Revision\x12\x0f\n\x07version\x18\x01 \x01(\x03\x12-\n\tcreatedAt\x18\x02 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12\x19\n\x05model\x18\x03 \x01(\x0b\x32\n.api.Model\x12\x38\n\x0fhyperparameters\x18\x04 \x01(\x0b\x32\x1f.api.GetHyperparametersResponse\"g\n\x14ListRevisionsRequest\x12\x0f\n\x07modelId\x18\x01 \x01(\t\x12\x19\n\x11hyperparametersId\x18\x02 \x01(\t\x12\x10\n\x08maxItems\x18\x03 \x01(\x05\x12\x11\n\tpageToken\x18\x04 \x01(\t\"P\n\x15ListRevisionsResponse\x12 \n\trevisions\x18\x01 \x03(\x0b\x32\r.api.Revision\x12\x15\n\rnextPageToken\x18\x02 \x01(\t\"Q\n\x12GetRevisionRequest\x12\x0f\n\x07modelId\x18\x01 \x01(\t\x12\x19\n\x11hyperparametersId\x18\x02 \x01(\t\x12\x0f\n\x07version\x18\x03 \x01(\x03\"6\n\x13GetRevisionResponse\x12\x1f\n\x08revision\x18\x01 \x01(\x0b\x32\r.api.Revision\"g\n\x0fRollbackRequest\x12\x0f\n\x07modelId\x18\x01 \x01(\t\x12\x19\n\x11hyperparametersId\x18\x02 \x01(\t\x12\x0f\n\x07version\x18\x03 \x01(\x03\x12\x17\n\x0f\x65xpectedVersion\x18\x04 \x01(\x03\"3\n\x10RollbackResponse\x12\x1f\n\x08revision\x18\x01 \x01(\x0b\x32\r.api.Revision\"9\n\x11WatchModelRequest\x12\x0f\n\x07modelId\x18\x01 \x01(\t\x12\x13\n\x0bresumeToken\x18\x02 \x01(\t\"\xb5\x02\n\x0fWatchModelEvent\x12\x0f\n\x07\x65ventId\x18\x01 \x01(\t\x12(\n\x04time\x18\x02 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12\'\n\x04type\x18\x03 \x01(\x0e\x32\x19.api.WatchModelEvent.Type\x12\x0e\n\x06method\x18\x04 \x01(\t\x12\x14\n\x0cresourcePath\x18\x05 \x01(\t\x12\x0f\n\x07modelId\x18\x06 \x01(\t\x12\x19\n\x11hyperparametersId\x18\x07 \x01(\t\x12\x14\n\x0c\x63heckpointId\x18\x08 \x01(\t\x12\r\n\x05\x61\x66ter\x18\t \x01(\t\x12\x0b\n\x03tag\x18\n \x01(\t\":\n\x04Type\x12\x0b\n\x07UNKNOWN\x10\x00\x12\x0b\n\x07\x43REATED\x10\x01\x12\x0b\n\x07UPDATED\x10\x02\x12\x0b\n\x07\x44\x45LETED\x10\x03\"}\n\x03Tag\x12\x0f\n\x07modelId\x18\x01 \x01(\t\x12\x19\n\x11hyperparametersId\x18\x02 \x01(\t\x12\x0b\n\x03tag\x18\x03 \x01(\t\x12\x0e\n\x06target\x18\x04 \x01(\t\x12-\n\tupdatedAt\x18\x05 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\"X\n\rSetTagRequest\x12\x0f\n\x07modelId\x18\x01 \x01(\t\x12\x19\n\x11hyperparametersId\x18\x02 \x01(\t\x12\x0b\n\x03tag\x18\x03 \x01(\t\x12\x0e\n\x06target\x18\x04 \x01(\t\"\'\n\x0eSetTagResponse\x12\x15\n\x03tag\x18\x01 \x01(\x0b\x32\x08.api.Tag\"H\n\rGetTagRequest\x12\x0f\n\x07modelId\x18\x01 \x01(\t\x12\x19\n\x11hyperparametersId\x18\x02 \x01(\t\x12\x0b\n\x03tag\x18\x03 \x01(\t\"\'\n\x0eGetTagResponse\x12\x15\n\x03tag\x18\x01 \x01(\x0b\x32\x08.api.Tag\"=\n\x0fListTagsRequest\x12\x0f\n\x07modelId\x18\x01 \x01(\t\x12\x19\n\x11hyperparametersId\x18\x02 \x01(\t\"*\n\x10ListTagsResponse\x12\x16\n\x04tags\x18\x01 \x03(\x0b\x32\x08.api.Tag\"K\n\x10\x44\x65leteTagRequest\x12\x0f\n\x07modelId\x18\x01 \x01(\t\x12\x19\n\x11hyperparametersId\x18\x02 \x01(\t\x12\x0b\n\x03tag\x18\x03 \x01(\t\"*\n\x11\x44\x65leteTagResponse\x12\x15\n\x03tag\x18\x01 \x01(\x0b\x32\x08.api.Tag\"5\n\rRolloutTarget\x12\x14\n\x0c\x63heckpointId\x18\x01 \x01(\t\x12\x0e\n\x06weight\x18\x02 \x01(\x05\"~\n\x12RampRolloutRequest\x12\x0f\n\x07modelId\x18\x01 \x01(\t\x12\x19\n\x11hyperparametersId\x18\x02 \x01(\t\x12#\n\x07rollout\x18\x03 \x03(\x0b\x32\x12.api.RolloutTarget\x12\x17\n\x0f\x65xpectedVersion\x18\x04 \x01(\x03\"O\n\x13RampRolloutResponse\x12\x38\n\x0fhyperparameters\x18\x01 \x01(\x0b\x32\x1f.api.GetHyperparametersResponse\"Z\n\x13\x41\x62ortRolloutRequest\x12\x0f\n\x07modelId\x18\x01 \x01(\t\x12\x19\n\x11hyperparametersId\x18\x02 \x01(\t\x12\x17\n\x0f\x65xpectedVersion\x18\x03 \x01(\x03\"P\n\x14\x41\x62ortRolloutResponse\x12\x38\n\x0fhyperparameters\x18\x01 \x01(\x0b\x32\x1f.api.GetHyperparametersResponse\"A\n\x1cResolveModelForClientRequest\x12\x0f\n\x07modelId\x18\x01 \x01(\t\x12\x10\n\x08\x63lientId\x18\x02 \x01(\t\"\xdc\x01\n\x1dResolveModelForClientResponse\x12\x19\n\x05model\x18\x01 \x01(\x0b\x32\n.api.Model\x12\x38\n\x0fhyperparameters\x18\x02 \x01(\x0b\x32\x1f.api.GetHyperparametersResponse\x12.\n\ncheckpoint\x18\x03 \x01(\x0b\x32\x1a.api.GetCheckpointResponse\x12\x13\n\x0bupgradePath\x18\x04 \x03(\t\x12\x0e\n\x06\x62ucket\x18\x05 \x01(\x05\x12\x11\n\tinRollout\x18\x06 \x01(\x08\"\xbd\x01\n\x19\x43ompareCheckpointsRequest\x12\x0f\n\x07modelId\x18\x01 \x01(\t\x12\x19\n\x11hyperparametersId\x18\x02 \x01(\t\x12\x15\n\rcheckpointIds\x18\x03 \x03(\t\x12\x0c\n\x04last\x18\x04 \x01(\x05\x12\x17\n\x0fincludeArchived\x18\x05 \x01(\x08\x12\x0f\n\x07metrics\x18\x06 \x03(\t\x12\x0e\n\x06\x62\x65stBy\x18\x07 \x01(\t\x12\x15\n\rlowerIsBetter\x18\x08 \x01(\x08\"\x9e\x02\n\x11\x43heckpointMetrics\x12\x14\n\x0c\x63heckpointId\x18\x01 \x01(\t\x12-\n\tcreatedAt\x18\x02 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12\x32\n\x06values\x18\x03 \x03(\x0b\x32\".api.CheckpointMetrics.ValuesEntry\x12\x32\n\x06\x64\x65ltas\x18\x04 \x03(\x0b\x32\".api.CheckpointMetrics.DeltasEntry\x1a-\n\x0bValuesEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\x01:\x02\x38\x01\x1a-\n\x0b\x44\x65ltasEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\x01:\x02\x38\x01\"\xb3\x01\n\x1a\x43ompareCheckpointsResponse\x12\x0f\n\x07modelId\x18\x01 \x01(\t\x12\x19\n\x11hyperparametersId\x18\x02 \x01(\t\x12\x0f\n\x07metrics\x18\x03 \x03(\t\x12+\n\x0b\x63heckpoints\x18\x04 \x03(\x0b\x32\x16.api.CheckpointMetrics\x12\x18\n\x10\x62\x65stCheckpointId\x18\x05 \x01(\t\x12\x11\n\tbestValue\x18\x06 \x01(\x01*\x1d\n\x08ListView\x12\x07\n\x03IDS\x10\x00\x12\x08\n\x04\x46ULL\x10\x01*H\n\x0f\x43heckpointState\x12\n\n\x06\x41\x43TIVE\x10\x00\x12\x0e\n\nDEPRECATED\x10\x01\x12\x0c\n\x08\x41RCHIVED\x10\x02\x12\x0b\n\x07PENDING\x10\x03\x32\xda.\n\nRepository\x12\\\n\x07Healthz\x12\x17.api.HealthCheckRequest\x1a\x18.api.HealthCheckResponse\"\x1e\x82\xd3\xe4\x93\x02\x18\x12\x16/v1/repository/healthz\x12P\n\x06\x43onfig\x12\x12.api.ConfigRequest\x1a\x13.api.ConfigResponse\"\x1d\x82\xd3\xe4\x93\x02\x17\x12\x15/v1/repository/config\x12\\\n\nListModels\x12\x16.api.ListModelsRequest\x1a\x17.api.ListModelsResponse\"\x1d\x82\xd3\xe4\x93\x02\x17\x12\x15/v1/repository/models\x12\x62\n\x0b\x43reateModel\x12\x17.api.CreateModelRequest\x1a\x18.api.CreateModelResponse\" \x82\xd3\xe4\x93\x02\x1a\"\x15/v1/repository/models:\x01*\x12`\n\x08GetModel\x12\x14.api.GetModelRequest\x1a\x15.api.GetModelResponse\"\'\x82\xd3\xe4\x93\x02!\x12\x1f/v1/repository/models/{modelId}\x12t\n\x0cResolveModel\x12\x18.api.ResolveModelRequest\x1a\x19.api.ResolveModelResponse\"/\x82\xd3\xe4\x93\x02)\x12\'/v1/repository/models/{modelId}/resolve\x12l\n\x0bUpdateModel\x12\x17.api.UpdateModelRequest\x1a\x18.api.UpdateModelResponse\"*\x82\xd3\xe4\x93\x02$\x1a\x1f/v1/repository/models/{modelId}:\x01*\x12i\n\x0b\x44\x65leteModel\x12\x17.api.DeleteModelRequest\x1a\x18.api.DeleteModelResponse\"\'\x82\xd3\xe4\x93\x02!*\x1f/v1/repository/models/{modelId}\x12\x91\x01\n\x13ListHyperparameters\x12\x1f.api.ListHyperparametersRequest\x1a .api.ListHyperparametersResponse\"7\x82\xd3\xe4\x93\x02\x31\x12//v1/repository/models/{modelId}/hyperparameters\x12\x9a\x01\n\x15\x43reateHyperparameters\x12!.api.CreateHyperparametersRequest\x1a\".api.CreateHyperparametersResponse\":\x82\xd3\xe4\x93\x02\x34\"//v1/repository/models/{modelId}/hyperparameters:\x01*\x12\xa2\x01\n\x12GetHyperparameters\x12\x1e.api.GetHyperparametersRequest\x1a\x1f.api.GetHyperparametersResponse\"K\x82\xd3\xe4\x93\x02\x45\x12\x43/v1/repository/models/{modelId}/hyperparameters/{hyperparametersId}\x12\xae\x01\n\x15UpdateHyperparameters\x12!.api.UpdateHyperparametersRequest\x1a\".api.UpdateHyperparametersResponse\"N\x82\xd3\xe4\x93\x02H\x1a\x43/v1/repository/models/{modelId}/hyperparameters/{hyperparametersId}:\x01*\x12\xab\x01\n\x15\x44\x65leteHyperparameters\x12!.api.DeleteHyperparametersRequest\x1a\".api.DeleteHyperparametersResponse\"K\x82\xd3\xe4\x93\x02\x45*C/v1/repository/models/{modelId}/hyperparameters/{hyperparametersId}\x12\xa5\x01\n\x0fListCheckpoints\x12\x1b.api.ListCheckpointsRequest\x1a\x1c.api.ListCheckpointsResponse\"W\x82\xd3\xe4\x93\x02Q\x12O/v1/repository/models/{modelId}/hyperparameters/{hyperparametersId}/checkpoints\x12\xab\x01\n\x10\x43reateCheckpoint\x12\x1c.api.CreateCheckpointRequest\x1a\x1d.api.CreateCheckpointResponse\"Z\x82\xd3\xe4\x93\x02T\"O/v1/repository/models/{modelId}/hyperparameters/{hyperparametersId}/checkpoints:\x01*\x12\xae\x01\n\rGetCheckpoint\x12\x19.api.GetCheckpointRequest\x1a\x1a.api.GetCheckpointResponse\"f\x82\xd3\xe4\x93\x02`\x12^/v1/repository/models/{modelId}/hyperparameters/{hyperparametersId}/checkpoints/{checkpointId}\x12\xcf\x01\n\x15GetCheckpointManifest\x12!.api.GetCheckpointManifestRequest\x1a\".api.GetCheckpointManifestResponse\"o\x82\xd3\xe4\x93\x02i\x12g/v1/repository/models/{modelId}/hyperparameters/{hyperparametersId}/checkpoints/{checkpointId}/manifest\x12\xb3\x01\n\x0cUpgradeCheck\x12\x18.api.UpgradeCheckRequest\x1a\x19.api.UpgradeCheckResponse\"n\x82\xd3\xe4\x93\x02h\x12\x66/v1/repository/models/{modelId}/hyperparameters/{hyperparametersId}/checkpoints/{checkpointId}/upgrade\x12\xc9\x01\n\x12\x46inalizeCheckpoint\x12\x1e.api.FinalizeCheckpointRequest\x1a\x1f.api.FinalizeCheckpointResponse\"r\x82\xd3\xe4\x93\x02l\"g/v1/repository/models/{modelId}/hyperparameters/{hyperparametersId}/checkpoints/{checkpointId}/finalize:\x01*\x12\xcf\x01\n\x15UpdateCheckpointState\x12!.api.UpdateCheckpointStateRequest\x1a\".api.UpdateCheckpointStateResponse\"o\x82\xd3\xe4\x93\x02i\x1a\x64/v1/repository/models/{modelId}/hyperparameters/{hyperparametersId}/checkpoints/{checkpointId}/state:\x01*\x12\xb7\x01\n\x10\x44\x65leteCheckpoint\x12\x1c.api.DeleteCheckpointRequest\x1a\x1d.api.DeleteCheckpointResponse\"f\x82\xd3\xe4\x93\x02`*^/v1/repository/models/{modelId}/hyperparameters/{hyperparametersId}/checkpoints/{checkpointId}\x12H\n\x04\x46sck\x12\x10.api.FsckRequest\x1a\x11.api.FsckResponse\"\x1b\x82\xd3\xe4\x93\x02\x15\x12\x13/v1/repository/fsck\x12j\n\x0fListAuditEvents\x12\x1b.api.ListAuditEventsRequest\x1a\x1c.api.ListAuditEventsResponse\"\x1c\x82\xd3\xe4\x93\x02\x16\x12\x14/v1/repository/audit\x12j\n\rCreateWebhook\x12\x19.api.CreateWebhookRequest\x1a\x1a.api.CreateWebhookResponse\"\"\x82\xd3\xe4\x93\x02\x1c\"\x17/v1/repository/webhooks:\x01*\x12\x64\n\x0cListWebhooks\x12\x18.api.ListWebhooksRequest\x1a\x19.api.ListWebhooksResponse\"\x1f\x82\xd3\xe4\x93\x02\x19\x12\x17/v1/repository/webhooks\x12s\n\rDeleteWebhook\x12\x19.api.DeleteWebhookRequest\x1a\x1a.api.DeleteWebhookResponse\"+\x82\xd3\xe4\x93\x02%*#/v1/repository/webhooks/{webhookId}\x12\x8e\x01\n\x16ListWebhookDeadLetters\x12\".api.ListWebhookDeadLettersRequest\x1a#.api.ListWebhookDeadLettersResponse\"+\x82\xd3\xe4\x93\x02%\x12#/v1/repository/webhook-dead-letters\x12\xcb\x01\n\rListRevisions\x12\x19.api.ListRevisionsRequest\x1a\x1a.api.ListRevisionsResponse\"\x82\x01\x82\xd3\xe4\x93\x02|\x12)/v1/repository/models/{modelId}/revisionsZO\x12M/v1/repository/models/{modelId}/hyperparameters/{hyperparametersId}/revisions\x12\xda\x01\n\x0bGetRevision\x12\x17.api.GetRevisionRequest\x1a\x18.api.GetRevisionResponse\"\x97\x01\x82\xd3\xe4\x93\x02\x90\x01\x12\x33/v1/repository/models/{modelId}/revisions/{version}ZY\x12W/v1/repository/models/{modelId}/hyperparameters/{hyperparametersId}/revisions/{version}\x12\xe9\x01\n\x08Rollback\x12\x14.api.RollbackRequest\x1a\x15.api.RollbackResponse\"\xaf\x01\x82\xd3\xe4\x93\x02\xa8\x01\"</v1/repository/models/{modelId}/revisions/{version}/rollback:\x01*Ze\"`/v1/repository/models/{modelId}/hyperparameters/{hyperparametersId}/revisions/{version}/rollback:\x01*\x12k\n\nWatchModel\x12\x16.api.WatchModelRequest\x1a\x14.api.WatchModelEvent\"-\x82\xd3\xe4\x93\x02\'\x12%/v1/repository/models/{modelId}/watch0\x01\x12\xbf\x01\n\x06SetTag\x12\x12.api.SetTagRequest\x1a\x13.api.SetTagResponse\"\x8b\x01\x82\xd3\xe4\x93\x02\x84\x01\x1a*/v1/repository/models/{modelId}/tags/{tag}:\x01*ZS\x1aN/v1/repository/models/{modelId}/hyperparameters/{hyperparametersId}/tags/{tag}:\x01*\x12\xb8\x01\n\x06GetTag\x12\x12.api.GetTagRequest\x1a\x13.api.GetTagResponse\"\x84\x01\x82\xd3\xe4\x93\x02~\x12*/v1/repository/models/{modelId}/tags/{tag}ZP\x12N/v1/repository/models/{modelId}/hyperparameters/{hyperparametersId}/tags/{tag}\x12\xb1\x01\n\x08ListTags\x12\x14.api.ListTagsRequest\x1a\x15.api.ListTagsResponse\"x\x82\xd3\xe4\x93\x02r\x12$/v1/repository/models/{modelId}/tagsZJ\x12H/v1/repository/models/{modelId}/hyperparameters/{hyperparametersId}/tags\x12\xc1\x01\n\tDeleteTag\x12\x15.api.DeleteTagRequest\x1a\x16.api.DeleteTagResponse\"\x84\x01\x82\xd3\xe4\x93\x02~**/v1/repository/models/{modelId}/tags/{tag}ZP*N/v1/repository/models/{modelId}/hyperparameters/{hyperparametersId}/tags/{tag}\x12\x98\x01\n\x0bRampRollout\x12\x17.api.RampRolloutRequest\x1a\x18.api.RampRolloutResponse\"V\x82\xd3\xe4\x93\x02P\x1aK/v1/repository/models/{modelId}/hyperparameters/{hyperparametersId}/rollout:\x01*\x12\x98\x01\n\x0c\x41\x62ortRollout\x12\x18.api.AbortRolloutRequest\x1a\x19.api.AbortRolloutResponse\"S\x82\xd3\xe4\x93\x02M*K/v1/repository/models/{modelId}/hyperparameters/{hyperparametersId}/rollout\x12\xa2\x01\n\x15ResolveModelForClient\x12!.api.ResolveModelForClientRequest\x1a\".api.ResolveModelForClientResponse\"B\x82\xd3\xe4\x93\x02<\x12:/v1/repository/models/{modelId}/clients/{clientId}/resolve\x12\xaa\x01\n\x12\x43ompareCheckpoints\x12\x1e.api.CompareCheckpointsRequest\x1a\x1f.api.CompareCheckpointsResponse\"S\x82\xd3\xe4\x93\x02M\x12K/v1/repository/models/{modelId}/hyperparameters/{hyperparametersId}/compareb\x06proto3')
   ,
   dependencies=[google_dot_api_dot_annotations__pb2.DESCRIPTOR,google_dot_protobuf_dot_timestamp__pb2.DESCRIPTOR,])
 
+_LISTVIEW = _descriptor.EnumDescriptor(
+  name='ListView',
+  full_name='api.ListView',
+  filename=None,
+  file=DESCRIPTOR,
+  values=[
+    _descriptor.EnumValueDescriptor(
+      name='IDS', index=0, number=0,
+      serialized_options=None,
+      type=None),
+    _descriptor.EnumValueDescriptor(
+      name='FULL', index=1, number=1,
+      serialized_options=None,
+      type=None),
+  ],
+  containing_type=None,
+  serialized_options=None,
+  serialized_start=11100,
+  serialized_end=11129,
+)
+_sym_db.RegisterEnumDescriptor(_LISTVIEW)
+
+ListView = enum_type_wrapper.EnumTypeWrapper(_LISTVIEW)
+_CHECKPOINTSTATE = _descriptor.EnumDescriptor(
+  name='CheckpointState',
+  full_name='api.CheckpointState',
+  filename=None,
+  file=DESCRIPTOR,
+  values=[
+    _descriptor.EnumValueDescriptor(
+      name='ACTIVE', index=0, number=0,
+      serialized_options=None,
+      type=None),
+    _descriptor.EnumValueDescriptor(
+      name='DEPRECATED', index=1, number=1,
+      serialized_options=None,
+      type=None),
+    _descriptor.EnumValueDescriptor(
+      name='ARCHIVED', index=2, number=2,
+      serialized_options=None,
+      type=None),
+    _descriptor.EnumValueDescriptor(
+      name='PENDING', index=3, number=3,
+      serialized_options=None,
+      type=None),
+  ],
+  containing_type=None,
+  serialized_options=None,
+  serialized_start=11131,
+  serialized_end=11203,
+)
+_sym_db.RegisterEnumDescriptor(_CHECKPOINTSTATE)
+
+CheckpointState = enum_type_wrapper.EnumTypeWrapper(_CHECKPOINTSTATE)
+IDS = 0
+FULL = 1
+ACTIVE = 0
+DEPRECATED = 1
+ARCHIVED = 2
+PENDING = 3
 
 
 _HEALTHCHECKRESPONSE_SERVINGSTATUS = _descriptor.EnumDescriptor(
@@ -72,14 +133,56 @@ _CONFIGRESPONSE_BACKENDTYPE = _descriptor.EnumDescriptor(
       name='GOOGLE_CLOUD_STORAGE', index=2, number=2,
       serialized_options=None,
       type=None),
+    _descriptor.EnumValueDescriptor(
+      name='FILESYSTEM', index=3, number=3,
+      serialized_options=None,
+      type=None),
+    _descriptor.EnumValueDescriptor(
+      name='S3', index=4, number=4,
+      serialized_options=None,
+      type=None),
+    _descriptor.EnumValueDescriptor(
+      name='BOLTDB', index=5, number=5,
+      serialized_options=None,
+      type=None),
   ],
   containing_type=None,
   serialized_options=None,
   serialized_start=357,
-  serialized_end=421,
+  serialized_end=457,
 )
 _sym_db.RegisterEnumDescriptor(_CONFIGRESPONSE_BACKENDTYPE)
 
+_WATCHMODELEVENT_TYPE = _descriptor.EnumDescriptor(
+  name='Type',
+  full_name='api.WatchModelEvent.Type',
+  filename=None,
+  file=DESCRIPTOR,
+  values=[
+    _descriptor.EnumValueDescriptor(
+      name='UNKNOWN', index=0, number=0,
+      serialized_options=None,
+      type=None),
+    _descriptor.EnumValueDescriptor(
+      name='CREATED', index=1, number=1,
+      serialized_options=None,
+      type=None),
+    _descriptor.EnumValueDescriptor(
+      name='UPDATED', index=2, number=2,
+      serialized_options=None,
+      type=None),
+    _descriptor.EnumValueDescriptor(
+      name='DELETED', index=3, number=3,
+      serialized_options=None,
+      type=None),
+  ],
+  containing_type=None,
+  serialized_options=None,
+  serialized_start=9048,
+  serialized_end=9106,
+)
+_sym_db.RegisterEnumDescriptor(_WATCHMODELEVENT_TYPE)
+
 
 _HEALTHCHECKREQUEST = _descriptor.Descriptor(
   name='HealthCheckRequest',
@@ -196,10 +299,47 @@ _CONFIGRESPONSE = _descriptor.Descriptor(
   oneofs=[
   ],
   serialized_start=285,
-  serialized_end=421,
+  serialized_end=457,
 )
 
 
+_MODEL_LABELSENTRY = _descriptor.Descriptor(
+  name='LabelsEntry',
+  full_name='api.Model.LabelsEntry',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='key', full_name='api.Model.LabelsEntry.key', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='value', full_name='api.Model.LabelsEntry.value', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=_b('8\001'),
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=624,
+  serialized_end=669,
+)
+
 _MODEL = _descriptor.Descriptor(
   name='Model',
   full_name='api.Model',
@@ -228,6 +368,159 @@ _MODEL = _descriptor.Descriptor(
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='labels', full_name='api.Model.labels', index=3,
+      number=4, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='card', full_name='api.Model.card', index=4,
+      number=5, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='version', full_name='api.Model.version', index=5,
+      number=6, type=3, cpp_type=2, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[_MODEL_LABELSENTRY, ],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=460,
+  serialized_end=669,
+)
+
+
+_MODELCARD = _descriptor.Descriptor(
+  name='ModelCard',
+  full_name='api.ModelCard',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='schemaVersion', full_name='api.ModelCard.schemaVersion', index=0,
+      number=1, type=5, cpp_type=1, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='description', full_name='api.ModelCard.description', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='owners', full_name='api.ModelCard.owners', index=2,
+      number=3, type=9, cpp_type=9, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='inputs', full_name='api.ModelCard.inputs', index=3,
+      number=4, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='outputs', full_name='api.ModelCard.outputs', index=4,
+      number=5, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='license', full_name='api.ModelCard.license', index=5,
+      number=6, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='intendedUse', full_name='api.ModelCard.intendedUse', index=6,
+      number=7, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='framework', full_name='api.ModelCard.framework', index=7,
+      number=8, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=672,
+  serialized_end=867,
+)
+
+
+_TENSORSPEC = _descriptor.Descriptor(
+  name='TensorSpec',
+  full_name='api.TensorSpec',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='name', full_name='api.TensorSpec.name', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='dtype', full_name='api.TensorSpec.dtype', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='shape', full_name='api.TensorSpec.shape', index=2,
+      number=3, type=3, cpp_type=2, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='description', full_name='api.TensorSpec.description', index=3,
+      number=4, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
   ],
   extensions=[
   ],
@@ -240,8 +533,8 @@ _MODEL = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=423,
-  serialized_end=498,
+  serialized_start=869,
+  serialized_end=946,
 )
 
 
@@ -266,6 +559,27 @@ _LISTMODELSREQUEST = _descriptor.Descriptor(
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='pageToken', full_name='api.ListModelsRequest.pageToken', index=2,
+      number=3, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='view', full_name='api.ListModelsRequest.view', index=3,
+      number=4, type=14, cpp_type=8, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='filter', full_name='api.ListModelsRequest.filter', index=4,
+      number=5, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
   ],
   extensions=[
   ],
@@ -278,8 +592,8 @@ _LISTMODELSREQUEST = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=500,
-  serialized_end=553,
+  serialized_start=948,
+  serialized_end=1065,
 )
 
 
@@ -297,6 +611,20 @@ _LISTMODELSRESPONSE = _descriptor.Descriptor(
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='nextPageToken', full_name='api.ListModelsResponse.nextPageToken', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='models', full_name='api.ListModelsResponse.models', index=2,
+      number=3, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
   ],
   extensions=[
   ],
@@ -309,8 +637,8 @@ _LISTMODELSRESPONSE = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=555,
-  serialized_end=593,
+  serialized_start=1067,
+  serialized_end=1156,
 )
 
 
@@ -340,8 +668,8 @@ _CREATEMODELREQUEST = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=595,
-  serialized_end=642,
+  serialized_start=1158,
+  serialized_end=1205,
 )
 
 
@@ -371,8 +699,8 @@ _CREATEMODELRESPONSE = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=644,
-  serialized_end=687,
+  serialized_start=1207,
+  serialized_end=1250,
 )
 
 
@@ -402,56 +730,114 @@ _GETMODELREQUEST = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=689,
-  serialized_end=723,
+  serialized_start=1252,
+  serialized_end=1286,
 )
 
 
-_GETMODELRESPONSE = _descriptor.Descriptor(
-  name='GetModelResponse',
-  full_name='api.GetModelResponse',
+_GETMODELRESPONSE_LABELSENTRY = _descriptor.Descriptor(
+  name='LabelsEntry',
+  full_name='api.GetModelResponse.LabelsEntry',
   filename=None,
   file=DESCRIPTOR,
   containing_type=None,
   fields=[
     _descriptor.FieldDescriptor(
-      name='modelId', full_name='api.GetModelResponse.modelId', index=0,
+      name='key', full_name='api.GetModelResponse.LabelsEntry.key', index=0,
       number=1, type=9, cpp_type=9, label=1,
       has_default_value=False, default_value=_b("").decode('utf-8'),
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR),
     _descriptor.FieldDescriptor(
-      name='details', full_name='api.GetModelResponse.details', index=1,
+      name='value', full_name='api.GetModelResponse.LabelsEntry.value', index=1,
       number=2, type=9, cpp_type=9, label=1,
       has_default_value=False, default_value=_b("").decode('utf-8'),
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR),
-    _descriptor.FieldDescriptor(
-      name='canonicalHyperparameters', full_name='api.GetModelResponse.canonicalHyperparameters', index=2,
-      number=3, type=9, cpp_type=9, label=1,
-      has_default_value=False, default_value=_b("").decode('utf-8'),
-      message_type=None, enum_type=None, containing_type=None,
-      is_extension=False, extension_scope=None,
-      serialized_options=None, file=DESCRIPTOR),
   ],
   extensions=[
   ],
   nested_types=[],
   enum_types=[
   ],
-  serialized_options=None,
+  serialized_options=_b('8\001'),
   is_extendable=False,
   syntax='proto3',
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=725,
-  serialized_end=811,
+  serialized_start=624,
+  serialized_end=669,
 )
 
-
+_GETMODELRESPONSE = _descriptor.Descriptor(
+  name='GetModelResponse',
+  full_name='api.GetModelResponse',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='modelId', full_name='api.GetModelResponse.modelId', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='details', full_name='api.GetModelResponse.details', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='canonicalHyperparameters', full_name='api.GetModelResponse.canonicalHyperparameters', index=2,
+      number=3, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='labels', full_name='api.GetModelResponse.labels', index=3,
+      number=4, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='card', full_name='api.GetModelResponse.card', index=4,
+      number=5, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='version', full_name='api.GetModelResponse.version', index=5,
+      number=6, type=3, cpp_type=2, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[_GETMODELRESPONSE_LABELSENTRY, ],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=1289,
+  serialized_end=1520,
+)
+
+
 _UPDATEMODELREQUEST = _descriptor.Descriptor(
   name='UpdateModelRequest',
   full_name='api.UpdateModelRequest',
@@ -473,6 +859,13 @@ _UPDATEMODELREQUEST = _descriptor.Descriptor(
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='expectedVersion', full_name='api.UpdateModelRequest.expectedVersion', index=2,
+      number=3, type=3, cpp_type=2, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
   ],
   extensions=[
   ],
@@ -485,8 +878,8 @@ _UPDATEMODELREQUEST = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=813,
-  serialized_end=877,
+  serialized_start=1522,
+  serialized_end=1611,
 )
 
 
@@ -516,8 +909,77 @@ _UPDATEMODELRESPONSE = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=879,
-  serialized_end=927,
+  serialized_start=1613,
+  serialized_end=1661,
+)
+
+
+_DELETEMODELREQUEST = _descriptor.Descriptor(
+  name='DeleteModelRequest',
+  full_name='api.DeleteModelRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='modelId', full_name='api.DeleteModelRequest.modelId', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='cascade', full_name='api.DeleteModelRequest.cascade', index=1,
+      number=2, type=8, cpp_type=7, label=1,
+      has_default_value=False, default_value=False,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=1663,
+  serialized_end=1717,
+)
+
+
+_DELETEMODELRESPONSE = _descriptor.Descriptor(
+  name='DeleteModelResponse',
+  full_name='api.DeleteModelResponse',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='resourcePath', full_name='api.DeleteModelResponse.resourcePath', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=1719,
+  serialized_end=1762,
 )
 
 
@@ -549,6 +1011,27 @@ _LISTHYPERPARAMETERSREQUEST = _descriptor.Descriptor(
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='pageToken', full_name='api.ListHyperparametersRequest.pageToken', index=3,
+      number=4, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='view', full_name='api.ListHyperparametersRequest.view', index=4,
+      number=5, type=14, cpp_type=8, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='filter', full_name='api.ListHyperparametersRequest.filter', index=5,
+      number=6, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
   ],
   extensions=[
   ],
@@ -561,8 +1044,8 @@ _LISTHYPERPARAMETERSREQUEST = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=929,
-  serialized_end=1008,
+  serialized_start=1765,
+  serialized_end=1908,
 )
 
 
@@ -587,6 +1070,20 @@ _LISTHYPERPARAMETERSRESPONSE = _descriptor.Descriptor(
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='nextPageToken', full_name='api.ListHyperparametersResponse.nextPageToken', index=2,
+      number=3, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='hyperparameters', full_name='api.ListHyperparametersResponse.hyperparameters', index=3,
+      number=4, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
   ],
   extensions=[
   ],
@@ -599,8 +1096,8 @@ _LISTHYPERPARAMETERSRESPONSE = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=1010,
-  serialized_end=1084,
+  serialized_start=1911,
+  serialized_end=2066,
 )
 
 
@@ -637,8 +1134,45 @@ _CREATEHYPERPARAMETERSREQUEST_HYPERPARAMETERSENTRY = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=1273,
-  serialized_end=1327,
+  serialized_start=2318,
+  serialized_end=2372,
+)
+
+_CREATEHYPERPARAMETERSREQUEST_LABELSENTRY = _descriptor.Descriptor(
+  name='LabelsEntry',
+  full_name='api.CreateHyperparametersRequest.LabelsEntry',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='key', full_name='api.CreateHyperparametersRequest.LabelsEntry.key', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='value', full_name='api.CreateHyperparametersRequest.LabelsEntry.value', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=_b('8\001'),
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=624,
+  serialized_end=669,
 )
 
 _CREATEHYPERPARAMETERSREQUEST = _descriptor.Descriptor(
@@ -676,10 +1210,17 @@ _CREATEHYPERPARAMETERSREQUEST = _descriptor.Descriptor(
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='labels', full_name='api.CreateHyperparametersRequest.labels', index=4,
+      number=5, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
   ],
   extensions=[
   ],
-  nested_types=[_CREATEHYPERPARAMETERSREQUEST_HYPERPARAMETERSENTRY, ],
+  nested_types=[_CREATEHYPERPARAMETERSREQUEST_HYPERPARAMETERSENTRY, _CREATEHYPERPARAMETERSREQUEST_LABELSENTRY, ],
   enum_types=[
   ],
   serialized_options=None,
@@ -688,8 +1229,8 @@ _CREATEHYPERPARAMETERSREQUEST = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=1087,
-  serialized_end=1327,
+  serialized_start=2069,
+  serialized_end=2419,
 )
 
 
@@ -719,8 +1260,8 @@ _CREATEHYPERPARAMETERSRESPONSE = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=1329,
-  serialized_end=1382,
+  serialized_start=2421,
+  serialized_end=2474,
 )
 
 
@@ -757,8 +1298,8 @@ _GETHYPERPARAMETERSREQUEST = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=1384,
-  serialized_end=1455,
+  serialized_start=2476,
+  serialized_end=2547,
 )
 
 
@@ -795,8 +1336,45 @@ _GETHYPERPARAMETERSRESPONSE_HYPERPARAMETERSENTRY = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=1273,
-  serialized_end=1327,
+  serialized_start=2318,
+  serialized_end=2372,
+)
+
+_GETHYPERPARAMETERSRESPONSE_LABELSENTRY = _descriptor.Descriptor(
+  name='LabelsEntry',
+  full_name='api.GetHyperparametersResponse.LabelsEntry',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='key', full_name='api.GetHyperparametersResponse.LabelsEntry.key', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='value', full_name='api.GetHyperparametersResponse.LabelsEntry.value', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=_b('8\001'),
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=624,
+  serialized_end=669,
 )
 
 _GETHYPERPARAMETERSRESPONSE = _descriptor.Descriptor(
@@ -841,10 +1419,31 @@ _GETHYPERPARAMETERSRESPONSE = _descriptor.Descriptor(
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='labels', full_name='api.GetHyperparametersResponse.labels', index=5,
+      number=6, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='version', full_name='api.GetHyperparametersResponse.version', index=6,
+      number=7, type=3, cpp_type=2, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='rollout', full_name='api.GetHyperparametersResponse.rollout', index=7,
+      number=8, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
   ],
   extensions=[
   ],
-  nested_types=[_GETHYPERPARAMETERSRESPONSE_HYPERPARAMETERSENTRY, ],
+  nested_types=[_GETHYPERPARAMETERSRESPONSE_HYPERPARAMETERSENTRY, _GETHYPERPARAMETERSRESPONSE_LABELSENTRY, ],
   enum_types=[
   ],
   serialized_options=None,
@@ -853,8 +1452,8 @@ _GETHYPERPARAMETERSRESPONSE = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=1458,
-  serialized_end=1713,
+  serialized_start=2550,
+  serialized_end=2967,
 )
 
 
@@ -891,66 +1490,117 @@ _UPDATEHYPERPARAMETERSREQUEST_HYPERPARAMETERSENTRY = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=1273,
-  serialized_end=1327,
+  serialized_start=2318,
+  serialized_end=2372,
 )
 
-_UPDATEHYPERPARAMETERSREQUEST = _descriptor.Descriptor(
-  name='UpdateHyperparametersRequest',
-  full_name='api.UpdateHyperparametersRequest',
+_UPDATEHYPERPARAMETERSREQUEST_LABELSENTRY = _descriptor.Descriptor(
+  name='LabelsEntry',
+  full_name='api.UpdateHyperparametersRequest.LabelsEntry',
   filename=None,
   file=DESCRIPTOR,
   containing_type=None,
   fields=[
     _descriptor.FieldDescriptor(
-      name='modelId', full_name='api.UpdateHyperparametersRequest.modelId', index=0,
+      name='key', full_name='api.UpdateHyperparametersRequest.LabelsEntry.key', index=0,
       number=1, type=9, cpp_type=9, label=1,
       has_default_value=False, default_value=_b("").decode('utf-8'),
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR),
     _descriptor.FieldDescriptor(
-      name='hyperparametersId', full_name='api.UpdateHyperparametersRequest.hyperparametersId', index=1,
+      name='value', full_name='api.UpdateHyperparametersRequest.LabelsEntry.value', index=1,
       number=2, type=9, cpp_type=9, label=1,
       has_default_value=False, default_value=_b("").decode('utf-8'),
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR),
-    _descriptor.FieldDescriptor(
-      name='upgradeTo', full_name='api.UpdateHyperparametersRequest.upgradeTo', index=2,
-      number=3, type=9, cpp_type=9, label=1,
-      has_default_value=False, default_value=_b("").decode('utf-8'),
-      message_type=None, enum_type=None, containing_type=None,
-      is_extension=False, extension_scope=None,
-      serialized_options=None, file=DESCRIPTOR),
-    _descriptor.FieldDescriptor(
-      name='canonicalCheckpoint', full_name='api.UpdateHyperparametersRequest.canonicalCheckpoint', index=3,
-      number=4, type=9, cpp_type=9, label=1,
-      has_default_value=False, default_value=_b("").decode('utf-8'),
-      message_type=None, enum_type=None, containing_type=None,
-      is_extension=False, extension_scope=None,
-      serialized_options=None, file=DESCRIPTOR),
-    _descriptor.FieldDescriptor(
-      name='hyperparameters', full_name='api.UpdateHyperparametersRequest.hyperparameters', index=4,
-      number=5, type=11, cpp_type=10, label=3,
-      has_default_value=False, default_value=[],
-      message_type=None, enum_type=None, containing_type=None,
-      is_extension=False, extension_scope=None,
-      serialized_options=None, file=DESCRIPTOR),
   ],
   extensions=[
   ],
-  nested_types=[_UPDATEHYPERPARAMETERSREQUEST_HYPERPARAMETERSENTRY, ],
+  nested_types=[],
   enum_types=[
   ],
-  serialized_options=None,
+  serialized_options=_b('8\001'),
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=624,
+  serialized_end=669,
+)
+
+_UPDATEHYPERPARAMETERSREQUEST = _descriptor.Descriptor(
+  name='UpdateHyperparametersRequest',
+  full_name='api.UpdateHyperparametersRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='modelId', full_name='api.UpdateHyperparametersRequest.modelId', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='hyperparametersId', full_name='api.UpdateHyperparametersRequest.hyperparametersId', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='upgradeTo', full_name='api.UpdateHyperparametersRequest.upgradeTo', index=2,
+      number=3, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='canonicalCheckpoint', full_name='api.UpdateHyperparametersRequest.canonicalCheckpoint', index=3,
+      number=4, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='hyperparameters', full_name='api.UpdateHyperparametersRequest.hyperparameters', index=4,
+      number=5, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='labels', full_name='api.UpdateHyperparametersRequest.labels', index=5,
+      number=6, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='expectedVersion', full_name='api.UpdateHyperparametersRequest.expectedVersion', index=6,
+      number=7, type=3, cpp_type=2, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[_UPDATEHYPERPARAMETERSREQUEST_HYPERPARAMETERSENTRY, _UPDATEHYPERPARAMETERSREQUEST_LABELSENTRY, ],
+  enum_types=[
+  ],
+  serialized_options=None,
   is_extendable=False,
   syntax='proto3',
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=1716,
-  serialized_end=1975,
+  serialized_start=2970,
+  serialized_end=3364,
 )
 
 
@@ -987,8 +1637,45 @@ _UPDATEHYPERPARAMETERSRESPONSE_HYPERPARAMETERSENTRY = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=1273,
-  serialized_end=1327,
+  serialized_start=2318,
+  serialized_end=2372,
+)
+
+_UPDATEHYPERPARAMETERSRESPONSE_LABELSENTRY = _descriptor.Descriptor(
+  name='LabelsEntry',
+  full_name='api.UpdateHyperparametersResponse.LabelsEntry',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='key', full_name='api.UpdateHyperparametersResponse.LabelsEntry.key', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='value', full_name='api.UpdateHyperparametersResponse.LabelsEntry.value', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=_b('8\001'),
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=624,
+  serialized_end=669,
 )
 
 _UPDATEHYPERPARAMETERSRESPONSE = _descriptor.Descriptor(
@@ -1033,10 +1720,31 @@ _UPDATEHYPERPARAMETERSRESPONSE = _descriptor.Descriptor(
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='labels', full_name='api.UpdateHyperparametersResponse.labels', index=5,
+      number=6, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='version', full_name='api.UpdateHyperparametersResponse.version', index=6,
+      number=7, type=3, cpp_type=2, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='rollout', full_name='api.UpdateHyperparametersResponse.rollout', index=7,
+      number=8, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
   ],
   extensions=[
   ],
-  nested_types=[_UPDATEHYPERPARAMETERSRESPONSE_HYPERPARAMETERSENTRY, ],
+  nested_types=[_UPDATEHYPERPARAMETERSRESPONSE_HYPERPARAMETERSENTRY, _UPDATEHYPERPARAMETERSRESPONSE_LABELSENTRY, ],
   enum_types=[
   ],
   serialized_options=None,
@@ -1045,43 +1753,43 @@ _UPDATEHYPERPARAMETERSRESPONSE = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=1978,
-  serialized_end=2239,
+  serialized_start=3367,
+  serialized_end=3793,
 )
 
 
-_LISTCHECKPOINTSREQUEST = _descriptor.Descriptor(
-  name='ListCheckpointsRequest',
-  full_name='api.ListCheckpointsRequest',
+_DELETEHYPERPARAMETERSREQUEST = _descriptor.Descriptor(
+  name='DeleteHyperparametersRequest',
+  full_name='api.DeleteHyperparametersRequest',
   filename=None,
   file=DESCRIPTOR,
   containing_type=None,
   fields=[
     _descriptor.FieldDescriptor(
-      name='modelId', full_name='api.ListCheckpointsRequest.modelId', index=0,
+      name='modelId', full_name='api.DeleteHyperparametersRequest.modelId', index=0,
       number=1, type=9, cpp_type=9, label=1,
       has_default_value=False, default_value=_b("").decode('utf-8'),
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR),
     _descriptor.FieldDescriptor(
-      name='hyperparametersId', full_name='api.ListCheckpointsRequest.hyperparametersId', index=1,
+      name='hyperparametersId', full_name='api.DeleteHyperparametersRequest.hyperparametersId', index=1,
       number=2, type=9, cpp_type=9, label=1,
       has_default_value=False, default_value=_b("").decode('utf-8'),
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR),
     _descriptor.FieldDescriptor(
-      name='marker', full_name='api.ListCheckpointsRequest.marker', index=2,
-      number=3, type=9, cpp_type=9, label=1,
-      has_default_value=False, default_value=_b("").decode('utf-8'),
+      name='cascade', full_name='api.DeleteHyperparametersRequest.cascade', index=2,
+      number=3, type=8, cpp_type=7, label=1,
+      has_default_value=False, default_value=False,
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR),
     _descriptor.FieldDescriptor(
-      name='maxItems', full_name='api.ListCheckpointsRequest.maxItems', index=3,
-      number=4, type=5, cpp_type=1, label=1,
-      has_default_value=False, default_value=0,
+      name='force', full_name='api.DeleteHyperparametersRequest.force', index=3,
+      number=4, type=8, cpp_type=7, label=1,
+      has_default_value=False, default_value=False,
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR),
@@ -1097,39 +1805,25 @@ _LISTCHECKPOINTSREQUEST = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=2241,
-  serialized_end=2343,
+  serialized_start=3795,
+  serialized_end=3901,
 )
 
 
-_LISTCHECKPOINTSRESPONSE = _descriptor.Descriptor(
-  name='ListCheckpointsResponse',
-  full_name='api.ListCheckpointsResponse',
+_DELETEHYPERPARAMETERSRESPONSE = _descriptor.Descriptor(
+  name='DeleteHyperparametersResponse',
+  full_name='api.DeleteHyperparametersResponse',
   filename=None,
   file=DESCRIPTOR,
   containing_type=None,
   fields=[
     _descriptor.FieldDescriptor(
-      name='modelId', full_name='api.ListCheckpointsResponse.modelId', index=0,
-      number=2, type=9, cpp_type=9, label=1,
-      has_default_value=False, default_value=_b("").decode('utf-8'),
-      message_type=None, enum_type=None, containing_type=None,
-      is_extension=False, extension_scope=None,
-      serialized_options=None, file=DESCRIPTOR),
-    _descriptor.FieldDescriptor(
-      name='hyperparametersId', full_name='api.ListCheckpointsResponse.hyperparametersId', index=1,
-      number=3, type=9, cpp_type=9, label=1,
+      name='resourcePath', full_name='api.DeleteHyperparametersResponse.resourcePath', index=0,
+      number=1, type=9, cpp_type=9, label=1,
       has_default_value=False, default_value=_b("").decode('utf-8'),
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR),
-    _descriptor.FieldDescriptor(
-      name='checkpointIds', full_name='api.ListCheckpointsResponse.checkpointIds', index=2,
-      number=1, type=9, cpp_type=9, label=3,
-      has_default_value=False, default_value=[],
-      message_type=None, enum_type=None, containing_type=None,
-      is_extension=False, extension_scope=None,
-      serialized_options=None, file=DESCRIPTOR),
   ],
   extensions=[
   ],
@@ -1142,85 +1836,128 @@ _LISTCHECKPOINTSRESPONSE = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=2345,
-  serialized_end=2437,
+  serialized_start=3903,
+  serialized_end=3956,
 )
 
 
-_CREATECHECKPOINTREQUEST_INFOENTRY = _descriptor.Descriptor(
-  name='InfoEntry',
-  full_name='api.CreateCheckpointRequest.InfoEntry',
+_LISTCHECKPOINTSREQUEST = _descriptor.Descriptor(
+  name='ListCheckpointsRequest',
+  full_name='api.ListCheckpointsRequest',
   filename=None,
   file=DESCRIPTOR,
   containing_type=None,
   fields=[
     _descriptor.FieldDescriptor(
-      name='key', full_name='api.CreateCheckpointRequest.InfoEntry.key', index=0,
+      name='modelId', full_name='api.ListCheckpointsRequest.modelId', index=0,
       number=1, type=9, cpp_type=9, label=1,
       has_default_value=False, default_value=_b("").decode('utf-8'),
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR),
     _descriptor.FieldDescriptor(
-      name='value', full_name='api.CreateCheckpointRequest.InfoEntry.value', index=1,
+      name='hyperparametersId', full_name='api.ListCheckpointsRequest.hyperparametersId', index=1,
       number=2, type=9, cpp_type=9, label=1,
       has_default_value=False, default_value=_b("").decode('utf-8'),
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='marker', full_name='api.ListCheckpointsRequest.marker', index=2,
+      number=3, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='maxItems', full_name='api.ListCheckpointsRequest.maxItems', index=3,
+      number=4, type=5, cpp_type=1, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='includeArchived', full_name='api.ListCheckpointsRequest.includeArchived', index=4,
+      number=5, type=8, cpp_type=7, label=1,
+      has_default_value=False, default_value=False,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='pageToken', full_name='api.ListCheckpointsRequest.pageToken', index=5,
+      number=6, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='view', full_name='api.ListCheckpointsRequest.view', index=6,
+      number=7, type=14, cpp_type=8, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='filter', full_name='api.ListCheckpointsRequest.filter', index=7,
+      number=8, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
   ],
   extensions=[
   ],
   nested_types=[],
   enum_types=[
   ],
-  serialized_options=_b('8\001'),
+  serialized_options=None,
   is_extendable=False,
   syntax='proto3',
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=2601,
-  serialized_end=2644,
+  serialized_start=3959,
+  serialized_end=4150,
 )
 
-_CREATECHECKPOINTREQUEST = _descriptor.Descriptor(
-  name='CreateCheckpointRequest',
-  full_name='api.CreateCheckpointRequest',
+
+_LISTCHECKPOINTSRESPONSE = _descriptor.Descriptor(
+  name='ListCheckpointsResponse',
+  full_name='api.ListCheckpointsResponse',
   filename=None,
   file=DESCRIPTOR,
   containing_type=None,
   fields=[
     _descriptor.FieldDescriptor(
-      name='modelId', full_name='api.CreateCheckpointRequest.modelId', index=0,
-      number=1, type=9, cpp_type=9, label=1,
+      name='modelId', full_name='api.ListCheckpointsResponse.modelId', index=0,
+      number=2, type=9, cpp_type=9, label=1,
       has_default_value=False, default_value=_b("").decode('utf-8'),
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR),
     _descriptor.FieldDescriptor(
-      name='hyperparametersId', full_name='api.CreateCheckpointRequest.hyperparametersId', index=1,
-      number=2, type=9, cpp_type=9, label=1,
+      name='hyperparametersId', full_name='api.ListCheckpointsResponse.hyperparametersId', index=1,
+      number=3, type=9, cpp_type=9, label=1,
       has_default_value=False, default_value=_b("").decode('utf-8'),
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR),
     _descriptor.FieldDescriptor(
-      name='checkpointId', full_name='api.CreateCheckpointRequest.checkpointId', index=2,
-      number=3, type=9, cpp_type=9, label=1,
-      has_default_value=False, default_value=_b("").decode('utf-8'),
+      name='checkpointIds', full_name='api.ListCheckpointsResponse.checkpointIds', index=2,
+      number=1, type=9, cpp_type=9, label=3,
+      has_default_value=False, default_value=[],
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR),
     _descriptor.FieldDescriptor(
-      name='link', full_name='api.CreateCheckpointRequest.link', index=3,
+      name='nextPageToken', full_name='api.ListCheckpointsResponse.nextPageToken', index=3,
       number=4, type=9, cpp_type=9, label=1,
       has_default_value=False, default_value=_b("").decode('utf-8'),
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR),
     _descriptor.FieldDescriptor(
-      name='info', full_name='api.CreateCheckpointRequest.info', index=4,
+      name='checkpoints', full_name='api.ListCheckpointsResponse.checkpoints', index=4,
       number=5, type=11, cpp_type=10, label=3,
       has_default_value=False, default_value=[],
       message_type=None, enum_type=None, containing_type=None,
@@ -1229,7 +1966,7 @@ _CREATECHECKPOINTREQUEST = _descriptor.Descriptor(
   ],
   extensions=[
   ],
-  nested_types=[_CREATECHECKPOINTREQUEST_INFOENTRY, ],
+  nested_types=[],
   enum_types=[
   ],
   serialized_options=None,
@@ -1238,177 +1975,339 @@ _CREATECHECKPOINTREQUEST = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=2440,
-  serialized_end=2644,
+  serialized_start=4153,
+  serialized_end=4317,
 )
 
 
-_CREATECHECKPOINTRESPONSE = _descriptor.Descriptor(
-  name='CreateCheckpointResponse',
-  full_name='api.CreateCheckpointResponse',
+_CREATECHECKPOINTREQUEST_INFOENTRY = _descriptor.Descriptor(
+  name='InfoEntry',
+  full_name='api.CreateCheckpointRequest.InfoEntry',
   filename=None,
   file=DESCRIPTOR,
   containing_type=None,
   fields=[
     _descriptor.FieldDescriptor(
-      name='resourcePath', full_name='api.CreateCheckpointResponse.resourcePath', index=0,
+      name='key', full_name='api.CreateCheckpointRequest.InfoEntry.key', index=0,
       number=1, type=9, cpp_type=9, label=1,
       has_default_value=False, default_value=_b("").decode('utf-8'),
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='value', full_name='api.CreateCheckpointRequest.InfoEntry.value', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
   ],
   extensions=[
   ],
   nested_types=[],
   enum_types=[
   ],
-  serialized_options=None,
+  serialized_options=_b('8\001'),
   is_extendable=False,
   syntax='proto3',
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=2646,
-  serialized_end=2694,
+  serialized_start=4621,
+  serialized_end=4664,
 )
 
-
-_GETCHECKPOINTREQUEST = _descriptor.Descriptor(
-  name='GetCheckpointRequest',
-  full_name='api.GetCheckpointRequest',
+_CREATECHECKPOINTREQUEST_LABELSENTRY = _descriptor.Descriptor(
+  name='LabelsEntry',
+  full_name='api.CreateCheckpointRequest.LabelsEntry',
   filename=None,
   file=DESCRIPTOR,
   containing_type=None,
   fields=[
     _descriptor.FieldDescriptor(
-      name='modelId', full_name='api.GetCheckpointRequest.modelId', index=0,
+      name='key', full_name='api.CreateCheckpointRequest.LabelsEntry.key', index=0,
       number=1, type=9, cpp_type=9, label=1,
       has_default_value=False, default_value=_b("").decode('utf-8'),
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR),
     _descriptor.FieldDescriptor(
-      name='hyperparametersId', full_name='api.GetCheckpointRequest.hyperparametersId', index=1,
+      name='value', full_name='api.CreateCheckpointRequest.LabelsEntry.value', index=1,
       number=2, type=9, cpp_type=9, label=1,
       has_default_value=False, default_value=_b("").decode('utf-8'),
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR),
-    _descriptor.FieldDescriptor(
-      name='checkpointId', full_name='api.GetCheckpointRequest.checkpointId', index=2,
-      number=3, type=9, cpp_type=9, label=1,
-      has_default_value=False, default_value=_b("").decode('utf-8'),
-      message_type=None, enum_type=None, containing_type=None,
-      is_extension=False, extension_scope=None,
-      serialized_options=None, file=DESCRIPTOR),
   ],
   extensions=[
   ],
   nested_types=[],
   enum_types=[
   ],
-  serialized_options=None,
+  serialized_options=_b('8\001'),
   is_extendable=False,
   syntax='proto3',
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=2696,
-  serialized_end=2784,
+  serialized_start=624,
+  serialized_end=669,
 )
 
-
-_GETCHECKPOINTRESPONSE_INFOENTRY = _descriptor.Descriptor(
-  name='InfoEntry',
-  full_name='api.GetCheckpointResponse.InfoEntry',
+_CREATECHECKPOINTREQUEST = _descriptor.Descriptor(
+  name='CreateCheckpointRequest',
+  full_name='api.CreateCheckpointRequest',
   filename=None,
   file=DESCRIPTOR,
   containing_type=None,
   fields=[
     _descriptor.FieldDescriptor(
-      name='key', full_name='api.GetCheckpointResponse.InfoEntry.key', index=0,
+      name='modelId', full_name='api.CreateCheckpointRequest.modelId', index=0,
       number=1, type=9, cpp_type=9, label=1,
       has_default_value=False, default_value=_b("").decode('utf-8'),
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR),
     _descriptor.FieldDescriptor(
-      name='value', full_name='api.GetCheckpointResponse.InfoEntry.value', index=1,
+      name='hyperparametersId', full_name='api.CreateCheckpointRequest.hyperparametersId', index=1,
       number=2, type=9, cpp_type=9, label=1,
       has_default_value=False, default_value=_b("").decode('utf-8'),
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='checkpointId', full_name='api.CreateCheckpointRequest.checkpointId', index=2,
+      number=3, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='link', full_name='api.CreateCheckpointRequest.link', index=3,
+      number=4, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='info', full_name='api.CreateCheckpointRequest.info', index=4,
+      number=5, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='labels', full_name='api.CreateCheckpointRequest.labels', index=5,
+      number=6, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='requestUploadUrl', full_name='api.CreateCheckpointRequest.requestUploadUrl', index=6,
+      number=7, type=8, cpp_type=7, label=1,
+      has_default_value=False, default_value=False,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='sha256', full_name='api.CreateCheckpointRequest.sha256', index=7,
+      number=8, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='sizeBytes', full_name='api.CreateCheckpointRequest.sizeBytes', index=8,
+      number=9, type=3, cpp_type=2, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='contentType', full_name='api.CreateCheckpointRequest.contentType', index=9,
+      number=10, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[_CREATECHECKPOINTREQUEST_INFOENTRY, _CREATECHECKPOINTREQUEST_LABELSENTRY, ],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=4320,
+  serialized_end=4711,
+)
+
+
+_CREATECHECKPOINTRESPONSE = _descriptor.Descriptor(
+  name='CreateCheckpointResponse',
+  full_name='api.CreateCheckpointResponse',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='resourcePath', full_name='api.CreateCheckpointResponse.resourcePath', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='uploadUrl', full_name='api.CreateCheckpointResponse.uploadUrl', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='uploadUrlExpiresAt', full_name='api.CreateCheckpointResponse.uploadUrlExpiresAt', index=2,
+      number=3, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
   ],
   extensions=[
   ],
   nested_types=[],
   enum_types=[
   ],
-  serialized_options=_b('8\001'),
+  serialized_options=None,
   is_extendable=False,
   syntax='proto3',
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=2601,
-  serialized_end=2644,
+  serialized_start=4713,
+  serialized_end=4836,
 )
 
-_GETCHECKPOINTRESPONSE = _descriptor.Descriptor(
-  name='GetCheckpointResponse',
-  full_name='api.GetCheckpointResponse',
+
+_FINALIZECHECKPOINTREQUEST = _descriptor.Descriptor(
+  name='FinalizeCheckpointRequest',
+  full_name='api.FinalizeCheckpointRequest',
   filename=None,
   file=DESCRIPTOR,
   containing_type=None,
   fields=[
     _descriptor.FieldDescriptor(
-      name='modelId', full_name='api.GetCheckpointResponse.modelId', index=0,
+      name='modelId', full_name='api.FinalizeCheckpointRequest.modelId', index=0,
       number=1, type=9, cpp_type=9, label=1,
       has_default_value=False, default_value=_b("").decode('utf-8'),
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR),
     _descriptor.FieldDescriptor(
-      name='hyperparametersId', full_name='api.GetCheckpointResponse.hyperparametersId', index=1,
+      name='hyperparametersId', full_name='api.FinalizeCheckpointRequest.hyperparametersId', index=1,
       number=2, type=9, cpp_type=9, label=1,
       has_default_value=False, default_value=_b("").decode('utf-8'),
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR),
     _descriptor.FieldDescriptor(
-      name='checkpointId', full_name='api.GetCheckpointResponse.checkpointId', index=2,
+      name='checkpointId', full_name='api.FinalizeCheckpointRequest.checkpointId', index=2,
       number=3, type=9, cpp_type=9, label=1,
       has_default_value=False, default_value=_b("").decode('utf-8'),
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=4838,
+  serialized_end=4931,
+)
+
+
+_FINALIZECHECKPOINTRESPONSE = _descriptor.Descriptor(
+  name='FinalizeCheckpointResponse',
+  full_name='api.FinalizeCheckpointResponse',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
     _descriptor.FieldDescriptor(
-      name='link', full_name='api.GetCheckpointResponse.link', index=3,
-      number=4, type=9, cpp_type=9, label=1,
+      name='checkpoint', full_name='api.FinalizeCheckpointResponse.checkpoint', index=0,
+      number=1, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=4933,
+  serialized_end=5009,
+)
+
+
+_GETCHECKPOINTREQUEST = _descriptor.Descriptor(
+  name='GetCheckpointRequest',
+  full_name='api.GetCheckpointRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='modelId', full_name='api.GetCheckpointRequest.modelId', index=0,
+      number=1, type=9, cpp_type=9, label=1,
       has_default_value=False, default_value=_b("").decode('utf-8'),
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR),
     _descriptor.FieldDescriptor(
-      name='createdAt', full_name='api.GetCheckpointResponse.createdAt', index=4,
-      number=5, type=11, cpp_type=10, label=1,
-      has_default_value=False, default_value=None,
+      name='hyperparametersId', full_name='api.GetCheckpointRequest.hyperparametersId', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR),
     _descriptor.FieldDescriptor(
-      name='info', full_name='api.GetCheckpointResponse.info', index=5,
-      number=6, type=11, cpp_type=10, label=3,
-      has_default_value=False, default_value=[],
+      name='checkpointId', full_name='api.GetCheckpointRequest.checkpointId', index=2,
+      number=3, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='rawLink', full_name='api.GetCheckpointRequest.rawLink', index=3,
+      number=4, type=8, cpp_type=7, label=1,
+      has_default_value=False, default_value=False,
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR),
   ],
   extensions=[
   ],
-  nested_types=[_GETCHECKPOINTRESPONSE_INFOENTRY, ],
+  nested_types=[],
   enum_types=[
   ],
   serialized_options=None,
@@ -1417,303 +2316,3699 @@ _GETCHECKPOINTRESPONSE = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=2787,
-  serialized_end=3034,
+  serialized_start=5011,
+  serialized_end=5116,
 )
 
-_HEALTHCHECKRESPONSE.fields_by_name['status'].enum_type = _HEALTHCHECKRESPONSE_SERVINGSTATUS
-_HEALTHCHECKRESPONSE_SERVINGSTATUS.containing_type = _HEALTHCHECKRESPONSE
-_CONFIGRESPONSE.fields_by_name['backendType'].enum_type = _CONFIGRESPONSE_BACKENDTYPE
-_CONFIGRESPONSE_BACKENDTYPE.containing_type = _CONFIGRESPONSE
-_CREATEMODELREQUEST.fields_by_name['model'].message_type = _MODEL
-_UPDATEMODELREQUEST.fields_by_name['model'].message_type = _MODEL
-_UPDATEMODELRESPONSE.fields_by_name['model'].message_type = _MODEL
-_CREATEHYPERPARAMETERSREQUEST_HYPERPARAMETERSENTRY.containing_type = _CREATEHYPERPARAMETERSREQUEST
-_CREATEHYPERPARAMETERSREQUEST.fields_by_name['hyperparameters'].message_type = _CREATEHYPERPARAMETERSREQUEST_HYPERPARAMETERSENTRY
-_GETHYPERPARAMETERSRESPONSE_HYPERPARAMETERSENTRY.containing_type = _GETHYPERPARAMETERSRESPONSE
-_GETHYPERPARAMETERSRESPONSE.fields_by_name['hyperparameters'].message_type = _GETHYPERPARAMETERSRESPONSE_HYPERPARAMETERSENTRY
-_UPDATEHYPERPARAMETERSREQUEST_HYPERPARAMETERSENTRY.containing_type = _UPDATEHYPERPARAMETERSREQUEST
-_UPDATEHYPERPARAMETERSREQUEST.fields_by_name['hyperparameters'].message_type = _UPDATEHYPERPARAMETERSREQUEST_HYPERPARAMETERSENTRY
-_UPDATEHYPERPARAMETERSRESPONSE_HYPERPARAMETERSENTRY.containing_type = _UPDATEHYPERPARAMETERSRESPONSE
-_UPDATEHYPERPARAMETERSRESPONSE.fields_by_name['hyperparameters'].message_type = _UPDATEHYPERPARAMETERSRESPONSE_HYPERPARAMETERSENTRY
-_CREATECHECKPOINTREQUEST_INFOENTRY.containing_type = _CREATECHECKPOINTREQUEST
-_CREATECHECKPOINTREQUEST.fields_by_name['info'].message_type = _CREATECHECKPOINTREQUEST_INFOENTRY
-_GETCHECKPOINTRESPONSE_INFOENTRY.containing_type = _GETCHECKPOINTRESPONSE
-_GETCHECKPOINTRESPONSE.fields_by_name['createdAt'].message_type = google_dot_protobuf_dot_timestamp__pb2._TIMESTAMP
-_GETCHECKPOINTRESPONSE.fields_by_name['info'].message_type = _GETCHECKPOINTRESPONSE_INFOENTRY
-DESCRIPTOR.message_types_by_name['HealthCheckRequest'] = _HEALTHCHECKREQUEST
-DESCRIPTOR.message_types_by_name['HealthCheckResponse'] = _HEALTHCHECKRESPONSE
-DESCRIPTOR.message_types_by_name['ConfigRequest'] = _CONFIGREQUEST
-DESCRIPTOR.message_types_by_name['ConfigResponse'] = _CONFIGRESPONSE
-DESCRIPTOR.message_types_by_name['Model'] = _MODEL
-DESCRIPTOR.message_types_by_name['ListModelsRequest'] = _LISTMODELSREQUEST
-DESCRIPTOR.message_types_by_name['ListModelsResponse'] = _LISTMODELSRESPONSE
-DESCRIPTOR.message_types_by_name['CreateModelRequest'] = _CREATEMODELREQUEST
-DESCRIPTOR.message_types_by_name['CreateModelResponse'] = _CREATEMODELRESPONSE
-DESCRIPTOR.message_types_by_name['GetModelRequest'] = _GETMODELREQUEST
-DESCRIPTOR.message_types_by_name['GetModelResponse'] = _GETMODELRESPONSE
-DESCRIPTOR.message_types_by_name['UpdateModelRequest'] = _UPDATEMODELREQUEST
-DESCRIPTOR.message_types_by_name['UpdateModelResponse'] = _UPDATEMODELRESPONSE
-DESCRIPTOR.message_types_by_name['ListHyperparametersRequest'] = _LISTHYPERPARAMETERSREQUEST
-DESCRIPTOR.message_types_by_name['ListHyperparametersResponse'] = _LISTHYPERPARAMETERSRESPONSE
-DESCRIPTOR.message_types_by_name['CreateHyperparametersRequest'] = _CREATEHYPERPARAMETERSREQUEST
-DESCRIPTOR.message_types_by_name['CreateHyperparametersResponse'] = _CREATEHYPERPARAMETERSRESPONSE
-DESCRIPTOR.message_types_by_name['GetHyperparametersRequest'] = _GETHYPERPARAMETERSREQUEST
-DESCRIPTOR.message_types_by_name['GetHyperparametersResponse'] = _GETHYPERPARAMETERSRESPONSE
-DESCRIPTOR.message_types_by_name['UpdateHyperparametersRequest'] = _UPDATEHYPERPARAMETERSREQUEST
-DESCRIPTOR.message_types_by_name['UpdateHyperparametersResponse'] = _UPDATEHYPERPARAMETERSRESPONSE
-DESCRIPTOR.message_types_by_name['ListCheckpointsRequest'] = _LISTCHECKPOINTSREQUEST
-DESCRIPTOR.message_types_by_name['ListCheckpointsResponse'] = _LISTCHECKPOINTSRESPONSE
-DESCRIPTOR.message_types_by_name['CreateCheckpointRequest'] = _CREATECHECKPOINTREQUEST
-DESCRIPTOR.message_types_by_name['CreateCheckpointResponse'] = _CREATECHECKPOINTRESPONSE
-DESCRIPTOR.message_types_by_name['GetCheckpointRequest'] = _GETCHECKPOINTREQUEST
-DESCRIPTOR.message_types_by_name['GetCheckpointResponse'] = _GETCHECKPOINTRESPONSE
-_sym_db.RegisterFileDescriptor(DESCRIPTOR)
 
-HealthCheckRequest = _reflection.GeneratedProtocolMessageType('HealthCheckRequest', (_message.Message,), {
-  'DESCRIPTOR' : _HEALTHCHECKREQUEST,
+_GETCHECKPOINTRESPONSE_INFOENTRY = _descriptor.Descriptor(
+  name='InfoEntry',
+  full_name='api.GetCheckpointResponse.InfoEntry',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='key', full_name='api.GetCheckpointResponse.InfoEntry.key', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='value', full_name='api.GetCheckpointResponse.InfoEntry.value', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=_b('8\001'),
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=4621,
+  serialized_end=4664,
+)
+
+_GETCHECKPOINTRESPONSE_LABELSENTRY = _descriptor.Descriptor(
+  name='LabelsEntry',
+  full_name='api.GetCheckpointResponse.LabelsEntry',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='key', full_name='api.GetCheckpointResponse.LabelsEntry.key', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='value', full_name='api.GetCheckpointResponse.LabelsEntry.value', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=_b('8\001'),
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=624,
+  serialized_end=669,
+)
+
+_GETCHECKPOINTRESPONSE = _descriptor.Descriptor(
+  name='GetCheckpointResponse',
+  full_name='api.GetCheckpointResponse',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='modelId', full_name='api.GetCheckpointResponse.modelId', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='hyperparametersId', full_name='api.GetCheckpointResponse.hyperparametersId', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='checkpointId', full_name='api.GetCheckpointResponse.checkpointId', index=2,
+      number=3, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='link', full_name='api.GetCheckpointResponse.link', index=3,
+      number=4, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='createdAt', full_name='api.GetCheckpointResponse.createdAt', index=4,
+      number=5, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='info', full_name='api.GetCheckpointResponse.info', index=5,
+      number=6, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='state', full_name='api.GetCheckpointResponse.state', index=6,
+      number=7, type=14, cpp_type=8, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='labels', full_name='api.GetCheckpointResponse.labels', index=7,
+      number=8, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='sha256', full_name='api.GetCheckpointResponse.sha256', index=8,
+      number=9, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='sizeBytes', full_name='api.GetCheckpointResponse.sizeBytes', index=9,
+      number=10, type=3, cpp_type=2, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='contentType', full_name='api.GetCheckpointResponse.contentType', index=10,
+      number=11, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[_GETCHECKPOINTRESPONSE_INFOENTRY, _GETCHECKPOINTRESPONSE_LABELSENTRY, ],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=5119,
+  serialized_end=5562,
+)
+
+
+_GETCHECKPOINTMANIFESTREQUEST = _descriptor.Descriptor(
+  name='GetCheckpointManifestRequest',
+  full_name='api.GetCheckpointManifestRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='modelId', full_name='api.GetCheckpointManifestRequest.modelId', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='hyperparametersId', full_name='api.GetCheckpointManifestRequest.hyperparametersId', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='checkpointId', full_name='api.GetCheckpointManifestRequest.checkpointId', index=2,
+      number=3, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=5564,
+  serialized_end=5660,
+)
+
+
+_GETCHECKPOINTMANIFESTRESPONSE = _descriptor.Descriptor(
+  name='GetCheckpointManifestResponse',
+  full_name='api.GetCheckpointManifestResponse',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='manifest', full_name='api.GetCheckpointManifestResponse.manifest', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='signature', full_name='api.GetCheckpointManifestResponse.signature', index=1,
+      number=2, type=12, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b(""),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='keyId', full_name='api.GetCheckpointManifestResponse.keyId', index=2,
+      number=3, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='algorithm', full_name='api.GetCheckpointManifestResponse.algorithm', index=3,
+      number=4, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=5662,
+  serialized_end=5764,
+)
+
+
+_UPDATECHECKPOINTSTATEREQUEST = _descriptor.Descriptor(
+  name='UpdateCheckpointStateRequest',
+  full_name='api.UpdateCheckpointStateRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='modelId', full_name='api.UpdateCheckpointStateRequest.modelId', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='hyperparametersId', full_name='api.UpdateCheckpointStateRequest.hyperparametersId', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='checkpointId', full_name='api.UpdateCheckpointStateRequest.checkpointId', index=2,
+      number=3, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='state', full_name='api.UpdateCheckpointStateRequest.state', index=3,
+      number=4, type=14, cpp_type=8, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=5767,
+  serialized_end=5900,
+)
+
+
+_UPDATECHECKPOINTSTATERESPONSE = _descriptor.Descriptor(
+  name='UpdateCheckpointStateResponse',
+  full_name='api.UpdateCheckpointStateResponse',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='modelId', full_name='api.UpdateCheckpointStateResponse.modelId', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='hyperparametersId', full_name='api.UpdateCheckpointStateResponse.hyperparametersId', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='checkpointId', full_name='api.UpdateCheckpointStateResponse.checkpointId', index=2,
+      number=3, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='state', full_name='api.UpdateCheckpointStateResponse.state', index=3,
+      number=4, type=14, cpp_type=8, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=5903,
+  serialized_end=6037,
+)
+
+
+_DELETECHECKPOINTREQUEST = _descriptor.Descriptor(
+  name='DeleteCheckpointRequest',
+  full_name='api.DeleteCheckpointRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='modelId', full_name='api.DeleteCheckpointRequest.modelId', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='hyperparametersId', full_name='api.DeleteCheckpointRequest.hyperparametersId', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='checkpointId', full_name='api.DeleteCheckpointRequest.checkpointId', index=2,
+      number=3, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='force', full_name='api.DeleteCheckpointRequest.force', index=3,
+      number=4, type=8, cpp_type=7, label=1,
+      has_default_value=False, default_value=False,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=6039,
+  serialized_end=6145,
+)
+
+
+_DELETECHECKPOINTRESPONSE = _descriptor.Descriptor(
+  name='DeleteCheckpointResponse',
+  full_name='api.DeleteCheckpointResponse',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='resourcePath', full_name='api.DeleteCheckpointResponse.resourcePath', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=6147,
+  serialized_end=6195,
+)
+
+
+_RESOLVEMODELREQUEST = _descriptor.Descriptor(
+  name='ResolveModelRequest',
+  full_name='api.ResolveModelRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='modelId', full_name='api.ResolveModelRequest.modelId', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=6197,
+  serialized_end=6235,
+)
+
+
+_RESOLVEMODELRESPONSE = _descriptor.Descriptor(
+  name='ResolveModelResponse',
+  full_name='api.ResolveModelResponse',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='model', full_name='api.ResolveModelResponse.model', index=0,
+      number=1, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='hyperparameters', full_name='api.ResolveModelResponse.hyperparameters', index=1,
+      number=2, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='checkpoint', full_name='api.ResolveModelResponse.checkpoint', index=2,
+      number=3, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='upgradePath', full_name='api.ResolveModelResponse.upgradePath', index=3,
+      number=4, type=9, cpp_type=9, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=6238,
+  serialized_end=6414,
+)
+
+
+_UPGRADECHECKREQUEST = _descriptor.Descriptor(
+  name='UpgradeCheckRequest',
+  full_name='api.UpgradeCheckRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='modelId', full_name='api.UpgradeCheckRequest.modelId', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='hyperparametersId', full_name='api.UpgradeCheckRequest.hyperparametersId', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='checkpointId', full_name='api.UpgradeCheckRequest.checkpointId', index=2,
+      number=3, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=6416,
+  serialized_end=6503,
+)
+
+
+_UPGRADEHOP = _descriptor.Descriptor(
+  name='UpgradeHop',
+  full_name='api.UpgradeHop',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='hyperparametersId', full_name='api.UpgradeHop.hyperparametersId', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='canonicalCheckpoint', full_name='api.UpgradeHop.canonicalCheckpoint', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='upgradeTo', full_name='api.UpgradeHop.upgradeTo', index=2,
+      number=3, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=6505,
+  serialized_end=6592,
+)
+
+
+_UPGRADECHECKRESPONSE = _descriptor.Descriptor(
+  name='UpgradeCheckResponse',
+  full_name='api.UpgradeCheckResponse',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='upgradeAvailable', full_name='api.UpgradeCheckResponse.upgradeAvailable', index=0,
+      number=1, type=8, cpp_type=7, label=1,
+      has_default_value=False, default_value=False,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='target', full_name='api.UpgradeCheckResponse.target', index=1,
+      number=2, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='hops', full_name='api.UpgradeCheckResponse.hops', index=2,
+      number=3, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=6594,
+  serialized_end=6717,
+)
+
+
+_FSCKREQUEST = _descriptor.Descriptor(
+  name='FsckRequest',
+  full_name='api.FsckRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=6719,
+  serialized_end=6732,
+)
+
+
+_DANGLINGREFERENCE = _descriptor.Descriptor(
+  name='DanglingReference',
+  full_name='api.DanglingReference',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='resourcePath', full_name='api.DanglingReference.resourcePath', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='field', full_name='api.DanglingReference.field', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='reference', full_name='api.DanglingReference.reference', index=2,
+      number=3, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=6734,
+  serialized_end=6809,
+)
+
+
+_FSCKRESPONSE = _descriptor.Descriptor(
+  name='FsckResponse',
+  full_name='api.FsckResponse',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='danglingReferences', full_name='api.FsckResponse.danglingReferences', index=0,
+      number=1, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=6811,
+  serialized_end=6877,
+)
+
+
+_AUDITEVENT = _descriptor.Descriptor(
+  name='AuditEvent',
+  full_name='api.AuditEvent',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='eventId', full_name='api.AuditEvent.eventId', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='time', full_name='api.AuditEvent.time', index=1,
+      number=2, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='actor', full_name='api.AuditEvent.actor', index=2,
+      number=3, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='method', full_name='api.AuditEvent.method', index=3,
+      number=4, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='resourcePath', full_name='api.AuditEvent.resourcePath', index=4,
+      number=5, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='request', full_name='api.AuditEvent.request', index=5,
+      number=6, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='before', full_name='api.AuditEvent.before', index=6,
+      number=7, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='after', full_name='api.AuditEvent.after', index=7,
+      number=8, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=6880,
+  serialized_end=7052,
+)
+
+
+_LISTAUDITEVENTSREQUEST = _descriptor.Descriptor(
+  name='ListAuditEventsRequest',
+  full_name='api.ListAuditEventsRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='resourcePath', full_name='api.ListAuditEventsRequest.resourcePath', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='since', full_name='api.ListAuditEventsRequest.since', index=1,
+      number=2, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='until', full_name='api.ListAuditEventsRequest.until', index=2,
+      number=3, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='maxItems', full_name='api.ListAuditEventsRequest.maxItems', index=3,
+      number=4, type=5, cpp_type=1, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='pageToken', full_name='api.ListAuditEventsRequest.pageToken', index=4,
+      number=5, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=7055,
+  serialized_end=7224,
+)
+
+
+_LISTAUDITEVENTSRESPONSE = _descriptor.Descriptor(
+  name='ListAuditEventsResponse',
+  full_name='api.ListAuditEventsResponse',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='events', full_name='api.ListAuditEventsResponse.events', index=0,
+      number=1, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='nextPageToken', full_name='api.ListAuditEventsResponse.nextPageToken', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=7226,
+  serialized_end=7307,
+)
+
+
+_WEBHOOK = _descriptor.Descriptor(
+  name='Webhook',
+  full_name='api.Webhook',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='webhookId', full_name='api.Webhook.webhookId', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='url', full_name='api.Webhook.url', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='eventTypes', full_name='api.Webhook.eventTypes', index=2,
+      number=3, type=9, cpp_type=9, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='createdAt', full_name='api.Webhook.createdAt', index=3,
+      number=4, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=7309,
+  serialized_end=7417,
+)
+
+
+_CREATEWEBHOOKREQUEST = _descriptor.Descriptor(
+  name='CreateWebhookRequest',
+  full_name='api.CreateWebhookRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='url', full_name='api.CreateWebhookRequest.url', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='eventTypes', full_name='api.CreateWebhookRequest.eventTypes', index=1,
+      number=2, type=9, cpp_type=9, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='secret', full_name='api.CreateWebhookRequest.secret', index=2,
+      number=3, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=7419,
+  serialized_end=7490,
+)
+
+
+_CREATEWEBHOOKRESPONSE = _descriptor.Descriptor(
+  name='CreateWebhookResponse',
+  full_name='api.CreateWebhookResponse',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='webhook', full_name='api.CreateWebhookResponse.webhook', index=0,
+      number=1, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=7492,
+  serialized_end=7546,
+)
+
+
+_LISTWEBHOOKSREQUEST = _descriptor.Descriptor(
+  name='ListWebhooksRequest',
+  full_name='api.ListWebhooksRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=7548,
+  serialized_end=7569,
+)
+
+
+_LISTWEBHOOKSRESPONSE = _descriptor.Descriptor(
+  name='ListWebhooksResponse',
+  full_name='api.ListWebhooksResponse',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='webhooks', full_name='api.ListWebhooksResponse.webhooks', index=0,
+      number=1, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=7571,
+  serialized_end=7625,
+)
+
+
+_DELETEWEBHOOKREQUEST = _descriptor.Descriptor(
+  name='DeleteWebhookRequest',
+  full_name='api.DeleteWebhookRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='webhookId', full_name='api.DeleteWebhookRequest.webhookId', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=7627,
+  serialized_end=7668,
+)
+
+
+_DELETEWEBHOOKRESPONSE = _descriptor.Descriptor(
+  name='DeleteWebhookResponse',
+  full_name='api.DeleteWebhookResponse',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='webhook', full_name='api.DeleteWebhookResponse.webhook', index=0,
+      number=1, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=7670,
+  serialized_end=7724,
+)
+
+
+_WEBHOOKDEADLETTER = _descriptor.Descriptor(
+  name='WebhookDeadLetter',
+  full_name='api.WebhookDeadLetter',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='deliveryId', full_name='api.WebhookDeadLetter.deliveryId', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='webhookId', full_name='api.WebhookDeadLetter.webhookId', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='url', full_name='api.WebhookDeadLetter.url', index=2,
+      number=3, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='eventType', full_name='api.WebhookDeadLetter.eventType', index=3,
+      number=4, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='payload', full_name='api.WebhookDeadLetter.payload', index=4,
+      number=5, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='attempts', full_name='api.WebhookDeadLetter.attempts', index=5,
+      number=6, type=5, cpp_type=1, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='lastError', full_name='api.WebhookDeadLetter.lastError', index=6,
+      number=7, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='failedAt', full_name='api.WebhookDeadLetter.failedAt', index=7,
+      number=8, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=7727,
+  serialized_end=7917,
+)
+
+
+_LISTWEBHOOKDEADLETTERSREQUEST = _descriptor.Descriptor(
+  name='ListWebhookDeadLettersRequest',
+  full_name='api.ListWebhookDeadLettersRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='maxItems', full_name='api.ListWebhookDeadLettersRequest.maxItems', index=0,
+      number=1, type=5, cpp_type=1, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='pageToken', full_name='api.ListWebhookDeadLettersRequest.pageToken', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=7919,
+  serialized_end=7987,
+)
+
+
+_LISTWEBHOOKDEADLETTERSRESPONSE = _descriptor.Descriptor(
+  name='ListWebhookDeadLettersResponse',
+  full_name='api.ListWebhookDeadLettersResponse',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='deadLetters', full_name='api.ListWebhookDeadLettersResponse.deadLetters', index=0,
+      number=1, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='nextPageToken', full_name='api.ListWebhookDeadLettersResponse.nextPageToken', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=7989,
+  serialized_end=8089,
+)
+
+
+_REVISION = _descriptor.Descriptor(
+  name='Revision',
+  full_name='api.Revision',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='version', full_name='api.Revision.version', index=0,
+      number=1, type=3, cpp_type=2, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='createdAt', full_name='api.Revision.createdAt', index=1,
+      number=2, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='model', full_name='api.Revision.model', index=2,
+      number=3, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='hyperparameters', full_name='api.Revision.hyperparameters', index=3,
+      number=4, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=8092,
+  serialized_end=8251,
+)
+
+
+_LISTREVISIONSREQUEST = _descriptor.Descriptor(
+  name='ListRevisionsRequest',
+  full_name='api.ListRevisionsRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='modelId', full_name='api.ListRevisionsRequest.modelId', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='hyperparametersId', full_name='api.ListRevisionsRequest.hyperparametersId', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='maxItems', full_name='api.ListRevisionsRequest.maxItems', index=2,
+      number=3, type=5, cpp_type=1, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='pageToken', full_name='api.ListRevisionsRequest.pageToken', index=3,
+      number=4, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=8253,
+  serialized_end=8356,
+)
+
+
+_LISTREVISIONSRESPONSE = _descriptor.Descriptor(
+  name='ListRevisionsResponse',
+  full_name='api.ListRevisionsResponse',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='revisions', full_name='api.ListRevisionsResponse.revisions', index=0,
+      number=1, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='nextPageToken', full_name='api.ListRevisionsResponse.nextPageToken', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=8358,
+  serialized_end=8438,
+)
+
+
+_GETREVISIONREQUEST = _descriptor.Descriptor(
+  name='GetRevisionRequest',
+  full_name='api.GetRevisionRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='modelId', full_name='api.GetRevisionRequest.modelId', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='hyperparametersId', full_name='api.GetRevisionRequest.hyperparametersId', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='version', full_name='api.GetRevisionRequest.version', index=2,
+      number=3, type=3, cpp_type=2, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=8440,
+  serialized_end=8521,
+)
+
+
+_GETREVISIONRESPONSE = _descriptor.Descriptor(
+  name='GetRevisionResponse',
+  full_name='api.GetRevisionResponse',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='revision', full_name='api.GetRevisionResponse.revision', index=0,
+      number=1, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=8523,
+  serialized_end=8577,
+)
+
+
+_ROLLBACKREQUEST = _descriptor.Descriptor(
+  name='RollbackRequest',
+  full_name='api.RollbackRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='modelId', full_name='api.RollbackRequest.modelId', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='hyperparametersId', full_name='api.RollbackRequest.hyperparametersId', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='version', full_name='api.RollbackRequest.version', index=2,
+      number=3, type=3, cpp_type=2, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='expectedVersion', full_name='api.RollbackRequest.expectedVersion', index=3,
+      number=4, type=3, cpp_type=2, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=8579,
+  serialized_end=8682,
+)
+
+
+_ROLLBACKRESPONSE = _descriptor.Descriptor(
+  name='RollbackResponse',
+  full_name='api.RollbackResponse',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='revision', full_name='api.RollbackResponse.revision', index=0,
+      number=1, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=8684,
+  serialized_end=8735,
+)
+
+
+_WATCHMODELREQUEST = _descriptor.Descriptor(
+  name='WatchModelRequest',
+  full_name='api.WatchModelRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='modelId', full_name='api.WatchModelRequest.modelId', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='resumeToken', full_name='api.WatchModelRequest.resumeToken', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=8737,
+  serialized_end=8794,
+)
+
+
+_WATCHMODELEVENT = _descriptor.Descriptor(
+  name='WatchModelEvent',
+  full_name='api.WatchModelEvent',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='eventId', full_name='api.WatchModelEvent.eventId', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='time', full_name='api.WatchModelEvent.time', index=1,
+      number=2, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='type', full_name='api.WatchModelEvent.type', index=2,
+      number=3, type=14, cpp_type=8, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='method', full_name='api.WatchModelEvent.method', index=3,
+      number=4, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='resourcePath', full_name='api.WatchModelEvent.resourcePath', index=4,
+      number=5, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='modelId', full_name='api.WatchModelEvent.modelId', index=5,
+      number=6, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='hyperparametersId', full_name='api.WatchModelEvent.hyperparametersId', index=6,
+      number=7, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='checkpointId', full_name='api.WatchModelEvent.checkpointId', index=7,
+      number=8, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='after', full_name='api.WatchModelEvent.after', index=8,
+      number=9, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='tag', full_name='api.WatchModelEvent.tag', index=9,
+      number=10, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+    _WATCHMODELEVENT_TYPE,
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=8797,
+  serialized_end=9106,
+)
+
+
+_TAG = _descriptor.Descriptor(
+  name='Tag',
+  full_name='api.Tag',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='modelId', full_name='api.Tag.modelId', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='hyperparametersId', full_name='api.Tag.hyperparametersId', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='tag', full_name='api.Tag.tag', index=2,
+      number=3, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='target', full_name='api.Tag.target', index=3,
+      number=4, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='updatedAt', full_name='api.Tag.updatedAt', index=4,
+      number=5, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=9108,
+  serialized_end=9233,
+)
+
+
+_SETTAGREQUEST = _descriptor.Descriptor(
+  name='SetTagRequest',
+  full_name='api.SetTagRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='modelId', full_name='api.SetTagRequest.modelId', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='hyperparametersId', full_name='api.SetTagRequest.hyperparametersId', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='tag', full_name='api.SetTagRequest.tag', index=2,
+      number=3, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='target', full_name='api.SetTagRequest.target', index=3,
+      number=4, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=9235,
+  serialized_end=9323,
+)
+
+
+_SETTAGRESPONSE = _descriptor.Descriptor(
+  name='SetTagResponse',
+  full_name='api.SetTagResponse',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='tag', full_name='api.SetTagResponse.tag', index=0,
+      number=1, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=9325,
+  serialized_end=9364,
+)
+
+
+_GETTAGREQUEST = _descriptor.Descriptor(
+  name='GetTagRequest',
+  full_name='api.GetTagRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='modelId', full_name='api.GetTagRequest.modelId', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='hyperparametersId', full_name='api.GetTagRequest.hyperparametersId', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='tag', full_name='api.GetTagRequest.tag', index=2,
+      number=3, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=9366,
+  serialized_end=9438,
+)
+
+
+_GETTAGRESPONSE = _descriptor.Descriptor(
+  name='GetTagResponse',
+  full_name='api.GetTagResponse',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='tag', full_name='api.GetTagResponse.tag', index=0,
+      number=1, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=9440,
+  serialized_end=9479,
+)
+
+
+_LISTTAGSREQUEST = _descriptor.Descriptor(
+  name='ListTagsRequest',
+  full_name='api.ListTagsRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='modelId', full_name='api.ListTagsRequest.modelId', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='hyperparametersId', full_name='api.ListTagsRequest.hyperparametersId', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=9481,
+  serialized_end=9542,
+)
+
+
+_LISTTAGSRESPONSE = _descriptor.Descriptor(
+  name='ListTagsResponse',
+  full_name='api.ListTagsResponse',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='tags', full_name='api.ListTagsResponse.tags', index=0,
+      number=1, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=9544,
+  serialized_end=9586,
+)
+
+
+_DELETETAGREQUEST = _descriptor.Descriptor(
+  name='DeleteTagRequest',
+  full_name='api.DeleteTagRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='modelId', full_name='api.DeleteTagRequest.modelId', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='hyperparametersId', full_name='api.DeleteTagRequest.hyperparametersId', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='tag', full_name='api.DeleteTagRequest.tag', index=2,
+      number=3, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=9588,
+  serialized_end=9663,
+)
+
+
+_DELETETAGRESPONSE = _descriptor.Descriptor(
+  name='DeleteTagResponse',
+  full_name='api.DeleteTagResponse',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='tag', full_name='api.DeleteTagResponse.tag', index=0,
+      number=1, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=9665,
+  serialized_end=9707,
+)
+
+
+_ROLLOUTTARGET = _descriptor.Descriptor(
+  name='RolloutTarget',
+  full_name='api.RolloutTarget',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='checkpointId', full_name='api.RolloutTarget.checkpointId', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='weight', full_name='api.RolloutTarget.weight', index=1,
+      number=2, type=5, cpp_type=1, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=9709,
+  serialized_end=9762,
+)
+
+
+_RAMPROLLOUTREQUEST = _descriptor.Descriptor(
+  name='RampRolloutRequest',
+  full_name='api.RampRolloutRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='modelId', full_name='api.RampRolloutRequest.modelId', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='hyperparametersId', full_name='api.RampRolloutRequest.hyperparametersId', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='rollout', full_name='api.RampRolloutRequest.rollout', index=2,
+      number=3, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='expectedVersion', full_name='api.RampRolloutRequest.expectedVersion', index=3,
+      number=4, type=3, cpp_type=2, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=9764,
+  serialized_end=9890,
+)
+
+
+_RAMPROLLOUTRESPONSE = _descriptor.Descriptor(
+  name='RampRolloutResponse',
+  full_name='api.RampRolloutResponse',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='hyperparameters', full_name='api.RampRolloutResponse.hyperparameters', index=0,
+      number=1, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=9892,
+  serialized_end=9971,
+)
+
+
+_ABORTROLLOUTREQUEST = _descriptor.Descriptor(
+  name='AbortRolloutRequest',
+  full_name='api.AbortRolloutRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='modelId', full_name='api.AbortRolloutRequest.modelId', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='hyperparametersId', full_name='api.AbortRolloutRequest.hyperparametersId', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='expectedVersion', full_name='api.AbortRolloutRequest.expectedVersion', index=2,
+      number=3, type=3, cpp_type=2, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=9973,
+  serialized_end=10063,
+)
+
+
+_ABORTROLLOUTRESPONSE = _descriptor.Descriptor(
+  name='AbortRolloutResponse',
+  full_name='api.AbortRolloutResponse',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='hyperparameters', full_name='api.AbortRolloutResponse.hyperparameters', index=0,
+      number=1, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=10065,
+  serialized_end=10145,
+)
+
+
+_RESOLVEMODELFORCLIENTREQUEST = _descriptor.Descriptor(
+  name='ResolveModelForClientRequest',
+  full_name='api.ResolveModelForClientRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='modelId', full_name='api.ResolveModelForClientRequest.modelId', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='clientId', full_name='api.ResolveModelForClientRequest.clientId', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=10147,
+  serialized_end=10212,
+)
+
+
+_RESOLVEMODELFORCLIENTRESPONSE = _descriptor.Descriptor(
+  name='ResolveModelForClientResponse',
+  full_name='api.ResolveModelForClientResponse',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='model', full_name='api.ResolveModelForClientResponse.model', index=0,
+      number=1, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='hyperparameters', full_name='api.ResolveModelForClientResponse.hyperparameters', index=1,
+      number=2, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='checkpoint', full_name='api.ResolveModelForClientResponse.checkpoint', index=2,
+      number=3, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='upgradePath', full_name='api.ResolveModelForClientResponse.upgradePath', index=3,
+      number=4, type=9, cpp_type=9, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='bucket', full_name='api.ResolveModelForClientResponse.bucket', index=4,
+      number=5, type=5, cpp_type=1, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='inRollout', full_name='api.ResolveModelForClientResponse.inRollout', index=5,
+      number=6, type=8, cpp_type=7, label=1,
+      has_default_value=False, default_value=False,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=10215,
+  serialized_end=10435,
+)
+
+
+_COMPARECHECKPOINTSREQUEST = _descriptor.Descriptor(
+  name='CompareCheckpointsRequest',
+  full_name='api.CompareCheckpointsRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='modelId', full_name='api.CompareCheckpointsRequest.modelId', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='hyperparametersId', full_name='api.CompareCheckpointsRequest.hyperparametersId', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='checkpointIds', full_name='api.CompareCheckpointsRequest.checkpointIds', index=2,
+      number=3, type=9, cpp_type=9, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='last', full_name='api.CompareCheckpointsRequest.last', index=3,
+      number=4, type=5, cpp_type=1, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='includeArchived', full_name='api.CompareCheckpointsRequest.includeArchived', index=4,
+      number=5, type=8, cpp_type=7, label=1,
+      has_default_value=False, default_value=False,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='metrics', full_name='api.CompareCheckpointsRequest.metrics', index=5,
+      number=6, type=9, cpp_type=9, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='bestBy', full_name='api.CompareCheckpointsRequest.bestBy', index=6,
+      number=7, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='lowerIsBetter', full_name='api.CompareCheckpointsRequest.lowerIsBetter', index=7,
+      number=8, type=8, cpp_type=7, label=1,
+      has_default_value=False, default_value=False,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=10438,
+  serialized_end=10627,
+)
+
+
+_CHECKPOINTMETRICS_VALUESENTRY = _descriptor.Descriptor(
+  name='ValuesEntry',
+  full_name='api.CheckpointMetrics.ValuesEntry',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='key', full_name='api.CheckpointMetrics.ValuesEntry.key', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='value', full_name='api.CheckpointMetrics.ValuesEntry.value', index=1,
+      number=2, type=1, cpp_type=5, label=1,
+      has_default_value=False, default_value=float(0),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=_b('8\001'),
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=10824,
+  serialized_end=10869,
+)
+
+_CHECKPOINTMETRICS_DELTASENTRY = _descriptor.Descriptor(
+  name='DeltasEntry',
+  full_name='api.CheckpointMetrics.DeltasEntry',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='key', full_name='api.CheckpointMetrics.DeltasEntry.key', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='value', full_name='api.CheckpointMetrics.DeltasEntry.value', index=1,
+      number=2, type=1, cpp_type=5, label=1,
+      has_default_value=False, default_value=float(0),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=_b('8\001'),
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=10871,
+  serialized_end=10916,
+)
+
+_CHECKPOINTMETRICS = _descriptor.Descriptor(
+  name='CheckpointMetrics',
+  full_name='api.CheckpointMetrics',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='checkpointId', full_name='api.CheckpointMetrics.checkpointId', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='createdAt', full_name='api.CheckpointMetrics.createdAt', index=1,
+      number=2, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='values', full_name='api.CheckpointMetrics.values', index=2,
+      number=3, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='deltas', full_name='api.CheckpointMetrics.deltas', index=3,
+      number=4, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[_CHECKPOINTMETRICS_VALUESENTRY, _CHECKPOINTMETRICS_DELTASENTRY, ],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=10630,
+  serialized_end=10916,
+)
+
+
+_COMPARECHECKPOINTSRESPONSE = _descriptor.Descriptor(
+  name='CompareCheckpointsResponse',
+  full_name='api.CompareCheckpointsResponse',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='modelId', full_name='api.CompareCheckpointsResponse.modelId', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='hyperparametersId', full_name='api.CompareCheckpointsResponse.hyperparametersId', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='metrics', full_name='api.CompareCheckpointsResponse.metrics', index=2,
+      number=3, type=9, cpp_type=9, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='checkpoints', full_name='api.CompareCheckpointsResponse.checkpoints', index=3,
+      number=4, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='bestCheckpointId', full_name='api.CompareCheckpointsResponse.bestCheckpointId', index=4,
+      number=5, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='bestValue', full_name='api.CompareCheckpointsResponse.bestValue', index=5,
+      number=6, type=1, cpp_type=5, label=1,
+      has_default_value=False, default_value=float(0),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=10919,
+  serialized_end=11098,
+)
+
+_HEALTHCHECKRESPONSE.fields_by_name['status'].enum_type = _HEALTHCHECKRESPONSE_SERVINGSTATUS
+_HEALTHCHECKRESPONSE_SERVINGSTATUS.containing_type = _HEALTHCHECKRESPONSE
+_CONFIGRESPONSE.fields_by_name['backendType'].enum_type = _CONFIGRESPONSE_BACKENDTYPE
+_CONFIGRESPONSE_BACKENDTYPE.containing_type = _CONFIGRESPONSE
+_MODEL_LABELSENTRY.containing_type = _MODEL
+_MODEL.fields_by_name['labels'].message_type = _MODEL_LABELSENTRY
+_MODEL.fields_by_name['card'].message_type = _MODELCARD
+_MODELCARD.fields_by_name['inputs'].message_type = _TENSORSPEC
+_MODELCARD.fields_by_name['outputs'].message_type = _TENSORSPEC
+_LISTMODELSREQUEST.fields_by_name['view'].enum_type = _LISTVIEW
+_LISTMODELSRESPONSE.fields_by_name['models'].message_type = _MODEL
+_CREATEMODELREQUEST.fields_by_name['model'].message_type = _MODEL
+_GETMODELRESPONSE_LABELSENTRY.containing_type = _GETMODELRESPONSE
+_GETMODELRESPONSE.fields_by_name['labels'].message_type = _GETMODELRESPONSE_LABELSENTRY
+_GETMODELRESPONSE.fields_by_name['card'].message_type = _MODELCARD
+_UPDATEMODELREQUEST.fields_by_name['model'].message_type = _MODEL
+_UPDATEMODELRESPONSE.fields_by_name['model'].message_type = _MODEL
+_LISTHYPERPARAMETERSREQUEST.fields_by_name['view'].enum_type = _LISTVIEW
+_LISTHYPERPARAMETERSRESPONSE.fields_by_name['hyperparameters'].message_type = _GETHYPERPARAMETERSRESPONSE
+_CREATEHYPERPARAMETERSREQUEST_HYPERPARAMETERSENTRY.containing_type = _CREATEHYPERPARAMETERSREQUEST
+_CREATEHYPERPARAMETERSREQUEST_LABELSENTRY.containing_type = _CREATEHYPERPARAMETERSREQUEST
+_CREATEHYPERPARAMETERSREQUEST.fields_by_name['hyperparameters'].message_type = _CREATEHYPERPARAMETERSREQUEST_HYPERPARAMETERSENTRY
+_CREATEHYPERPARAMETERSREQUEST.fields_by_name['labels'].message_type = _CREATEHYPERPARAMETERSREQUEST_LABELSENTRY
+_GETHYPERPARAMETERSRESPONSE_HYPERPARAMETERSENTRY.containing_type = _GETHYPERPARAMETERSRESPONSE
+_GETHYPERPARAMETERSRESPONSE_LABELSENTRY.containing_type = _GETHYPERPARAMETERSRESPONSE
+_GETHYPERPARAMETERSRESPONSE.fields_by_name['hyperparameters'].message_type = _GETHYPERPARAMETERSRESPONSE_HYPERPARAMETERSENTRY
+_GETHYPERPARAMETERSRESPONSE.fields_by_name['labels'].message_type = _GETHYPERPARAMETERSRESPONSE_LABELSENTRY
+_GETHYPERPARAMETERSRESPONSE.fields_by_name['rollout'].message_type = _ROLLOUTTARGET
+_UPDATEHYPERPARAMETERSREQUEST_HYPERPARAMETERSENTRY.containing_type = _UPDATEHYPERPARAMETERSREQUEST
+_UPDATEHYPERPARAMETERSREQUEST_LABELSENTRY.containing_type = _UPDATEHYPERPARAMETERSREQUEST
+_UPDATEHYPERPARAMETERSREQUEST.fields_by_name['hyperparameters'].message_type = _UPDATEHYPERPARAMETERSREQUEST_HYPERPARAMETERSENTRY
+_UPDATEHYPERPARAMETERSREQUEST.fields_by_name['labels'].message_type = _UPDATEHYPERPARAMETERSREQUEST_LABELSENTRY
+_UPDATEHYPERPARAMETERSRESPONSE_HYPERPARAMETERSENTRY.containing_type = _UPDATEHYPERPARAMETERSRESPONSE
+_UPDATEHYPERPARAMETERSRESPONSE_LABELSENTRY.containing_type = _UPDATEHYPERPARAMETERSRESPONSE
+_UPDATEHYPERPARAMETERSRESPONSE.fields_by_name['hyperparameters'].message_type = _UPDATEHYPERPARAMETERSRESPONSE_HYPERPARAMETERSENTRY
+_UPDATEHYPERPARAMETERSRESPONSE.fields_by_name['labels'].message_type = _UPDATEHYPERPARAMETERSRESPONSE_LABELSENTRY
+_UPDATEHYPERPARAMETERSRESPONSE.fields_by_name['rollout'].message_type = _ROLLOUTTARGET
+_LISTCHECKPOINTSREQUEST.fields_by_name['view'].enum_type = _LISTVIEW
+_LISTCHECKPOINTSRESPONSE.fields_by_name['checkpoints'].message_type = _GETCHECKPOINTRESPONSE
+_CREATECHECKPOINTREQUEST_INFOENTRY.containing_type = _CREATECHECKPOINTREQUEST
+_CREATECHECKPOINTREQUEST_LABELSENTRY.containing_type = _CREATECHECKPOINTREQUEST
+_CREATECHECKPOINTREQUEST.fields_by_name['info'].message_type = _CREATECHECKPOINTREQUEST_INFOENTRY
+_CREATECHECKPOINTREQUEST.fields_by_name['labels'].message_type = _CREATECHECKPOINTREQUEST_LABELSENTRY
+_CREATECHECKPOINTRESPONSE.fields_by_name['uploadUrlExpiresAt'].message_type = google_dot_protobuf_dot_timestamp__pb2._TIMESTAMP
+_FINALIZECHECKPOINTRESPONSE.fields_by_name['checkpoint'].message_type = _GETCHECKPOINTRESPONSE
+_GETCHECKPOINTRESPONSE_INFOENTRY.containing_type = _GETCHECKPOINTRESPONSE
+_GETCHECKPOINTRESPONSE_LABELSENTRY.containing_type = _GETCHECKPOINTRESPONSE
+_GETCHECKPOINTRESPONSE.fields_by_name['createdAt'].message_type = google_dot_protobuf_dot_timestamp__pb2._TIMESTAMP
+_GETCHECKPOINTRESPONSE.fields_by_name['info'].message_type = _GETCHECKPOINTRESPONSE_INFOENTRY
+_GETCHECKPOINTRESPONSE.fields_by_name['state'].enum_type = _CHECKPOINTSTATE
+_GETCHECKPOINTRESPONSE.fields_by_name['labels'].message_type = _GETCHECKPOINTRESPONSE_LABELSENTRY
+_UPDATECHECKPOINTSTATEREQUEST.fields_by_name['state'].enum_type = _CHECKPOINTSTATE
+_UPDATECHECKPOINTSTATERESPONSE.fields_by_name['state'].enum_type = _CHECKPOINTSTATE
+_RESOLVEMODELRESPONSE.fields_by_name['model'].message_type = _MODEL
+_RESOLVEMODELRESPONSE.fields_by_name['hyperparameters'].message_type = _GETHYPERPARAMETERSRESPONSE
+_RESOLVEMODELRESPONSE.fields_by_name['checkpoint'].message_type = _GETCHECKPOINTRESPONSE
+_UPGRADECHECKRESPONSE.fields_by_name['target'].message_type = _GETCHECKPOINTRESPONSE
+_UPGRADECHECKRESPONSE.fields_by_name['hops'].message_type = _UPGRADEHOP
+_FSCKRESPONSE.fields_by_name['danglingReferences'].message_type = _DANGLINGREFERENCE
+_AUDITEVENT.fields_by_name['time'].message_type = google_dot_protobuf_dot_timestamp__pb2._TIMESTAMP
+_LISTAUDITEVENTSREQUEST.fields_by_name['since'].message_type = google_dot_protobuf_dot_timestamp__pb2._TIMESTAMP
+_LISTAUDITEVENTSREQUEST.fields_by_name['until'].message_type = google_dot_protobuf_dot_timestamp__pb2._TIMESTAMP
+_LISTAUDITEVENTSRESPONSE.fields_by_name['events'].message_type = _AUDITEVENT
+_WEBHOOK.fields_by_name['createdAt'].message_type = google_dot_protobuf_dot_timestamp__pb2._TIMESTAMP
+_CREATEWEBHOOKRESPONSE.fields_by_name['webhook'].message_type = _WEBHOOK
+_LISTWEBHOOKSRESPONSE.fields_by_name['webhooks'].message_type = _WEBHOOK
+_DELETEWEBHOOKRESPONSE.fields_by_name['webhook'].message_type = _WEBHOOK
+_WEBHOOKDEADLETTER.fields_by_name['failedAt'].message_type = google_dot_protobuf_dot_timestamp__pb2._TIMESTAMP
+_LISTWEBHOOKDEADLETTERSRESPONSE.fields_by_name['deadLetters'].message_type = _WEBHOOKDEADLETTER
+_REVISION.fields_by_name['createdAt'].message_type = google_dot_protobuf_dot_timestamp__pb2._TIMESTAMP
+_REVISION.fields_by_name['model'].message_type = _MODEL
+_REVISION.fields_by_name['hyperparameters'].message_type = _GETHYPERPARAMETERSRESPONSE
+_LISTREVISIONSRESPONSE.fields_by_name['revisions'].message_type = _REVISION
+_GETREVISIONRESPONSE.fields_by_name['revision'].message_type = _REVISION
+_ROLLBACKRESPONSE.fields_by_name['revision'].message_type = _REVISION
+_WATCHMODELEVENT.fields_by_name['time'].message_type = google_dot_protobuf_dot_timestamp__pb2._TIMESTAMP
+_WATCHMODELEVENT.fields_by_name['type'].enum_type = _WATCHMODELEVENT_TYPE
+_WATCHMODELEVENT_TYPE.containing_type = _WATCHMODELEVENT
+_TAG.fields_by_name['updatedAt'].message_type = google_dot_protobuf_dot_timestamp__pb2._TIMESTAMP
+_SETTAGRESPONSE.fields_by_name['tag'].message_type = _TAG
+_GETTAGRESPONSE.fields_by_name['tag'].message_type = _TAG
+_LISTTAGSRESPONSE.fields_by_name['tags'].message_type = _TAG
+_DELETETAGRESPONSE.fields_by_name['tag'].message_type = _TAG
+_RAMPROLLOUTREQUEST.fields_by_name['rollout'].message_type = _ROLLOUTTARGET
+_RAMPROLLOUTRESPONSE.fields_by_name['hyperparameters'].message_type = _GETHYPERPARAMETERSRESPONSE
+_ABORTROLLOUTRESPONSE.fields_by_name['hyperparameters'].message_type = _GETHYPERPARAMETERSRESPONSE
+_RESOLVEMODELFORCLIENTRESPONSE.fields_by_name['model'].message_type = _MODEL
+_RESOLVEMODELFORCLIENTRESPONSE.fields_by_name['hyperparameters'].message_type = _GETHYPERPARAMETERSRESPONSE
+_RESOLVEMODELFORCLIENTRESPONSE.fields_by_name['checkpoint'].message_type = _GETCHECKPOINTRESPONSE
+_CHECKPOINTMETRICS_VALUESENTRY.containing_type = _CHECKPOINTMETRICS
+_CHECKPOINTMETRICS_DELTASENTRY.containing_type = _CHECKPOINTMETRICS
+_CHECKPOINTMETRICS.fields_by_name['createdAt'].message_type = google_dot_protobuf_dot_timestamp__pb2._TIMESTAMP
+_CHECKPOINTMETRICS.fields_by_name['values'].message_type = _CHECKPOINTMETRICS_VALUESENTRY
+_CHECKPOINTMETRICS.fields_by_name['deltas'].message_type = _CHECKPOINTMETRICS_DELTASENTRY
+_COMPARECHECKPOINTSRESPONSE.fields_by_name['checkpoints'].message_type = _CHECKPOINTMETRICS
+DESCRIPTOR.message_types_by_name['HealthCheckRequest'] = _HEALTHCHECKREQUEST
+DESCRIPTOR.message_types_by_name['HealthCheckResponse'] = _HEALTHCHECKRESPONSE
+DESCRIPTOR.message_types_by_name['ConfigRequest'] = _CONFIGREQUEST
+DESCRIPTOR.message_types_by_name['ConfigResponse'] = _CONFIGRESPONSE
+DESCRIPTOR.message_types_by_name['Model'] = _MODEL
+DESCRIPTOR.message_types_by_name['ModelCard'] = _MODELCARD
+DESCRIPTOR.message_types_by_name['TensorSpec'] = _TENSORSPEC
+DESCRIPTOR.message_types_by_name['ListModelsRequest'] = _LISTMODELSREQUEST
+DESCRIPTOR.message_types_by_name['ListModelsResponse'] = _LISTMODELSRESPONSE
+DESCRIPTOR.message_types_by_name['CreateModelRequest'] = _CREATEMODELREQUEST
+DESCRIPTOR.message_types_by_name['CreateModelResponse'] = _CREATEMODELRESPONSE
+DESCRIPTOR.message_types_by_name['GetModelRequest'] = _GETMODELREQUEST
+DESCRIPTOR.message_types_by_name['GetModelResponse'] = _GETMODELRESPONSE
+DESCRIPTOR.message_types_by_name['UpdateModelRequest'] = _UPDATEMODELREQUEST
+DESCRIPTOR.message_types_by_name['UpdateModelResponse'] = _UPDATEMODELRESPONSE
+DESCRIPTOR.message_types_by_name['DeleteModelRequest'] = _DELETEMODELREQUEST
+DESCRIPTOR.message_types_by_name['DeleteModelResponse'] = _DELETEMODELRESPONSE
+DESCRIPTOR.message_types_by_name['ListHyperparametersRequest'] = _LISTHYPERPARAMETERSREQUEST
+DESCRIPTOR.message_types_by_name['ListHyperparametersResponse'] = _LISTHYPERPARAMETERSRESPONSE
+DESCRIPTOR.message_types_by_name['CreateHyperparametersRequest'] = _CREATEHYPERPARAMETERSREQUEST
+DESCRIPTOR.message_types_by_name['CreateHyperparametersResponse'] = _CREATEHYPERPARAMETERSRESPONSE
+DESCRIPTOR.message_types_by_name['GetHyperparametersRequest'] = _GETHYPERPARAMETERSREQUEST
+DESCRIPTOR.message_types_by_name['GetHyperparametersResponse'] = _GETHYPERPARAMETERSRESPONSE
+DESCRIPTOR.message_types_by_name['UpdateHyperparametersRequest'] = _UPDATEHYPERPARAMETERSREQUEST
+DESCRIPTOR.message_types_by_name['UpdateHyperparametersResponse'] = _UPDATEHYPERPARAMETERSRESPONSE
+DESCRIPTOR.message_types_by_name['DeleteHyperparametersRequest'] = _DELETEHYPERPARAMETERSREQUEST
+DESCRIPTOR.message_types_by_name['DeleteHyperparametersResponse'] = _DELETEHYPERPARAMETERSRESPONSE
+DESCRIPTOR.message_types_by_name['ListCheckpointsRequest'] = _LISTCHECKPOINTSREQUEST
+DESCRIPTOR.message_types_by_name['ListCheckpointsResponse'] = _LISTCHECKPOINTSRESPONSE
+DESCRIPTOR.message_types_by_name['CreateCheckpointRequest'] = _CREATECHECKPOINTREQUEST
+DESCRIPTOR.message_types_by_name['CreateCheckpointResponse'] = _CREATECHECKPOINTRESPONSE
+DESCRIPTOR.message_types_by_name['FinalizeCheckpointRequest'] = _FINALIZECHECKPOINTREQUEST
+DESCRIPTOR.message_types_by_name['FinalizeCheckpointResponse'] = _FINALIZECHECKPOINTRESPONSE
+DESCRIPTOR.message_types_by_name['GetCheckpointRequest'] = _GETCHECKPOINTREQUEST
+DESCRIPTOR.message_types_by_name['GetCheckpointResponse'] = _GETCHECKPOINTRESPONSE
+DESCRIPTOR.message_types_by_name['GetCheckpointManifestRequest'] = _GETCHECKPOINTMANIFESTREQUEST
+DESCRIPTOR.message_types_by_name['GetCheckpointManifestResponse'] = _GETCHECKPOINTMANIFESTRESPONSE
+DESCRIPTOR.message_types_by_name['UpdateCheckpointStateRequest'] = _UPDATECHECKPOINTSTATEREQUEST
+DESCRIPTOR.message_types_by_name['UpdateCheckpointStateResponse'] = _UPDATECHECKPOINTSTATERESPONSE
+DESCRIPTOR.message_types_by_name['DeleteCheckpointRequest'] = _DELETECHECKPOINTREQUEST
+DESCRIPTOR.message_types_by_name['DeleteCheckpointResponse'] = _DELETECHECKPOINTRESPONSE
+DESCRIPTOR.message_types_by_name['ResolveModelRequest'] = _RESOLVEMODELREQUEST
+DESCRIPTOR.message_types_by_name['ResolveModelResponse'] = _RESOLVEMODELRESPONSE
+DESCRIPTOR.message_types_by_name['UpgradeCheckRequest'] = _UPGRADECHECKREQUEST
+DESCRIPTOR.message_types_by_name['UpgradeHop'] = _UPGRADEHOP
+DESCRIPTOR.message_types_by_name['UpgradeCheckResponse'] = _UPGRADECHECKRESPONSE
+DESCRIPTOR.message_types_by_name['FsckRequest'] = _FSCKREQUEST
+DESCRIPTOR.message_types_by_name['DanglingReference'] = _DANGLINGREFERENCE
+DESCRIPTOR.message_types_by_name['FsckResponse'] = _FSCKRESPONSE
+DESCRIPTOR.message_types_by_name['AuditEvent'] = _AUDITEVENT
+DESCRIPTOR.message_types_by_name['ListAuditEventsRequest'] = _LISTAUDITEVENTSREQUEST
+DESCRIPTOR.message_types_by_name['ListAuditEventsResponse'] = _LISTAUDITEVENTSRESPONSE
+DESCRIPTOR.message_types_by_name['Webhook'] = _WEBHOOK
+DESCRIPTOR.message_types_by_name['CreateWebhookRequest'] = _CREATEWEBHOOKREQUEST
+DESCRIPTOR.message_types_by_name['CreateWebhookResponse'] = _CREATEWEBHOOKRESPONSE
+DESCRIPTOR.message_types_by_name['ListWebhooksRequest'] = _LISTWEBHOOKSREQUEST
+DESCRIPTOR.message_types_by_name['ListWebhooksResponse'] = _LISTWEBHOOKSRESPONSE
+DESCRIPTOR.message_types_by_name['DeleteWebhookRequest'] = _DELETEWEBHOOKREQUEST
+DESCRIPTOR.message_types_by_name['DeleteWebhookResponse'] = _DELETEWEBHOOKRESPONSE
+DESCRIPTOR.message_types_by_name['WebhookDeadLetter'] = _WEBHOOKDEADLETTER
+DESCRIPTOR.message_types_by_name['ListWebhookDeadLettersRequest'] = _LISTWEBHOOKDEADLETTERSREQUEST
+DESCRIPTOR.message_types_by_name['ListWebhookDeadLettersResponse'] = _LISTWEBHOOKDEADLETTERSRESPONSE
+DESCRIPTOR.message_types_by_name['Revision'] = _REVISION
+DESCRIPTOR.message_types_by_name['ListRevisionsRequest'] = _LISTREVISIONSREQUEST
+DESCRIPTOR.message_types_by_name['ListRevisionsResponse'] = _LISTREVISIONSRESPONSE
+DESCRIPTOR.message_types_by_name['GetRevisionRequest'] = _GETREVISIONREQUEST
+DESCRIPTOR.message_types_by_name['GetRevisionResponse'] = _GETREVISIONRESPONSE
+DESCRIPTOR.message_types_by_name['RollbackRequest'] = _ROLLBACKREQUEST
+DESCRIPTOR.message_types_by_name['RollbackResponse'] = _ROLLBACKRESPONSE
+DESCRIPTOR.message_types_by_name['WatchModelRequest'] = _WATCHMODELREQUEST
+DESCRIPTOR.message_types_by_name['WatchModelEvent'] = _WATCHMODELEVENT
+DESCRIPTOR.message_types_by_name['Tag'] = _TAG
+DESCRIPTOR.message_types_by_name['SetTagRequest'] = _SETTAGREQUEST
+DESCRIPTOR.message_types_by_name['SetTagResponse'] = _SETTAGRESPONSE
+DESCRIPTOR.message_types_by_name['GetTagRequest'] = _GETTAGREQUEST
+DESCRIPTOR.message_types_by_name['GetTagResponse'] = _GETTAGRESPONSE
+DESCRIPTOR.message_types_by_name['ListTagsRequest'] = _LISTTAGSREQUEST
+DESCRIPTOR.message_types_by_name['ListTagsResponse'] = _LISTTAGSRESPONSE
+DESCRIPTOR.message_types_by_name['DeleteTagRequest'] = _DELETETAGREQUEST
+DESCRIPTOR.message_types_by_name['DeleteTagResponse'] = _DELETETAGRESPONSE
+DESCRIPTOR.message_types_by_name['RolloutTarget'] = _ROLLOUTTARGET
+DESCRIPTOR.message_types_by_name['RampRolloutRequest'] = _RAMPROLLOUTREQUEST
+DESCRIPTOR.message_types_by_name['RampRolloutResponse'] = _RAMPROLLOUTRESPONSE
+DESCRIPTOR.message_types_by_name['AbortRolloutRequest'] = _ABORTROLLOUTREQUEST
+DESCRIPTOR.message_types_by_name['AbortRolloutResponse'] = _ABORTROLLOUTRESPONSE
+DESCRIPTOR.message_types_by_name['ResolveModelForClientRequest'] = _RESOLVEMODELFORCLIENTREQUEST
+DESCRIPTOR.message_types_by_name['ResolveModelForClientResponse'] = _RESOLVEMODELFORCLIENTRESPONSE
+DESCRIPTOR.message_types_by_name['CompareCheckpointsRequest'] = _COMPARECHECKPOINTSREQUEST
+DESCRIPTOR.message_types_by_name['CheckpointMetrics'] = _CHECKPOINTMETRICS
+DESCRIPTOR.message_types_by_name['CompareCheckpointsResponse'] = _COMPARECHECKPOINTSRESPONSE
+DESCRIPTOR.enum_types_by_name['ListView'] = _LISTVIEW
+DESCRIPTOR.enum_types_by_name['CheckpointState'] = _CHECKPOINTSTATE
+_sym_db.RegisterFileDescriptor(DESCRIPTOR)
+
+HealthCheckRequest = _reflection.GeneratedProtocolMessageType('HealthCheckRequest', (_message.Message,), {
+  'DESCRIPTOR' : _HEALTHCHECKREQUEST,
+  '__module__' : 'repository_pb2'
+  # @@protoc_insertion_point(class_scope:api.HealthCheckRequest)
+  })
+_sym_db.RegisterMessage(HealthCheckRequest)
+
+HealthCheckResponse = _reflection.GeneratedProtocolMessageType('HealthCheckResponse', (_message.Message,), {
+  'DESCRIPTOR' : _HEALTHCHECKRESPONSE,
+  '__module__' : 'repository_pb2'
+  # @@protoc_insertion_point(class_scope:api.HealthCheckResponse)
+  })
+_sym_db.RegisterMessage(HealthCheckResponse)
+
+ConfigRequest = _reflection.GeneratedProtocolMessageType('ConfigRequest', (_message.Message,), {
+  'DESCRIPTOR' : _CONFIGREQUEST,
+  '__module__' : 'repository_pb2'
+  # @@protoc_insertion_point(class_scope:api.ConfigRequest)
+  })
+_sym_db.RegisterMessage(ConfigRequest)
+
+ConfigResponse = _reflection.GeneratedProtocolMessageType('ConfigResponse', (_message.Message,), {
+  'DESCRIPTOR' : _CONFIGRESPONSE,
+  '__module__' : 'repository_pb2'
+  # @@protoc_insertion_point(class_scope:api.ConfigResponse)
+  })
+_sym_db.RegisterMessage(ConfigResponse)
+
+Model = _reflection.GeneratedProtocolMessageType('Model', (_message.Message,), {
+
+  'LabelsEntry' : _reflection.GeneratedProtocolMessageType('LabelsEntry', (_message.Message,), {
+    'DESCRIPTOR' : _MODEL_LABELSENTRY,
+    '__module__' : 'repository_pb2'
+    # @@protoc_insertion_point(class_scope:api.Model.LabelsEntry)
+    })
+  ,
+  'DESCRIPTOR' : _MODEL,
+  '__module__' : 'repository_pb2'
+  # @@protoc_insertion_point(class_scope:api.Model)
+  })
+_sym_db.RegisterMessage(Model)
+_sym_db.RegisterMessage(Model.LabelsEntry)
+
+ModelCard = _reflection.GeneratedProtocolMessageType('ModelCard', (_message.Message,), {
+  'DESCRIPTOR' : _MODELCARD,
+  '__module__' : 'repository_pb2'
+  # @@protoc_insertion_point(class_scope:api.ModelCard)
+  })
+_sym_db.RegisterMessage(ModelCard)
+
+TensorSpec = _reflection.GeneratedProtocolMessageType('TensorSpec', (_message.Message,), {
+  'DESCRIPTOR' : _TENSORSPEC,
+  '__module__' : 'repository_pb2'
+  # @@protoc_insertion_point(class_scope:api.TensorSpec)
+  })
+_sym_db.RegisterMessage(TensorSpec)
+
+ListModelsRequest = _reflection.GeneratedProtocolMessageType('ListModelsRequest', (_message.Message,), {
+  'DESCRIPTOR' : _LISTMODELSREQUEST,
+  '__module__' : 'repository_pb2'
+  # @@protoc_insertion_point(class_scope:api.ListModelsRequest)
+  })
+_sym_db.RegisterMessage(ListModelsRequest)
+
+ListModelsResponse = _reflection.GeneratedProtocolMessageType('ListModelsResponse', (_message.Message,), {
+  'DESCRIPTOR' : _LISTMODELSRESPONSE,
+  '__module__' : 'repository_pb2'
+  # @@protoc_insertion_point(class_scope:api.ListModelsResponse)
+  })
+_sym_db.RegisterMessage(ListModelsResponse)
+
+CreateModelRequest = _reflection.GeneratedProtocolMessageType('CreateModelRequest', (_message.Message,), {
+  'DESCRIPTOR' : _CREATEMODELREQUEST,
+  '__module__' : 'repository_pb2'
+  # @@protoc_insertion_point(class_scope:api.CreateModelRequest)
+  })
+_sym_db.RegisterMessage(CreateModelRequest)
+
+CreateModelResponse = _reflection.GeneratedProtocolMessageType('CreateModelResponse', (_message.Message,), {
+  'DESCRIPTOR' : _CREATEMODELRESPONSE,
+  '__module__' : 'repository_pb2'
+  # @@protoc_insertion_point(class_scope:api.CreateModelResponse)
+  })
+_sym_db.RegisterMessage(CreateModelResponse)
+
+GetModelRequest = _reflection.GeneratedProtocolMessageType('GetModelRequest', (_message.Message,), {
+  'DESCRIPTOR' : _GETMODELREQUEST,
+  '__module__' : 'repository_pb2'
+  # @@protoc_insertion_point(class_scope:api.GetModelRequest)
+  })
+_sym_db.RegisterMessage(GetModelRequest)
+
+GetModelResponse = _reflection.GeneratedProtocolMessageType('GetModelResponse', (_message.Message,), {
+
+  'LabelsEntry' : _reflection.GeneratedProtocolMessageType('LabelsEntry', (_message.Message,), {
+    'DESCRIPTOR' : _GETMODELRESPONSE_LABELSENTRY,
+    '__module__' : 'repository_pb2'
+    # @@protoc_insertion_point(class_scope:api.GetModelResponse.LabelsEntry)
+    })
+  ,
+  'DESCRIPTOR' : _GETMODELRESPONSE,
+  '__module__' : 'repository_pb2'
+  # @@protoc_insertion_point(class_scope:api.GetModelResponse)
+  })
+_sym_db.RegisterMessage(GetModelResponse)
+_sym_db.RegisterMessage(GetModelResponse.LabelsEntry)
+
+UpdateModelRequest = _reflection.GeneratedProtocolMessageType('UpdateModelRequest', (_message.Message,), {
+  'DESCRIPTOR' : _UPDATEMODELREQUEST,
+  '__module__' : 'repository_pb2'
+  # @@protoc_insertion_point(class_scope:api.UpdateModelRequest)
+  })
+_sym_db.RegisterMessage(UpdateModelRequest)
+
+UpdateModelResponse = _reflection.GeneratedProtocolMessageType('UpdateModelResponse', (_message.Message,), {
+  'DESCRIPTOR' : _UPDATEMODELRESPONSE,
+  '__module__' : 'repository_pb2'
+  # @@protoc_insertion_point(class_scope:api.UpdateModelResponse)
+  })
+_sym_db.RegisterMessage(UpdateModelResponse)
+
+DeleteModelRequest = _reflection.GeneratedProtocolMessageType('DeleteModelRequest', (_message.Message,), {
+  'DESCRIPTOR' : _DELETEMODELREQUEST,
+  '__module__' : 'repository_pb2'
+  # @@protoc_insertion_point(class_scope:api.DeleteModelRequest)
+  })
+_sym_db.RegisterMessage(DeleteModelRequest)
+
+DeleteModelResponse = _reflection.GeneratedProtocolMessageType('DeleteModelResponse', (_message.Message,), {
+  'DESCRIPTOR' : _DELETEMODELRESPONSE,
+  '__module__' : 'repository_pb2'
+  # @@protoc_insertion_point(class_scope:api.DeleteModelResponse)
+  })
+_sym_db.RegisterMessage(DeleteModelResponse)
+
+ListHyperparametersRequest = _reflection.GeneratedProtocolMessageType('ListHyperparametersRequest', (_message.Message,), {
+  'DESCRIPTOR' : _LISTHYPERPARAMETERSREQUEST,
+  '__module__' : 'repository_pb2'
+  # @@protoc_insertion_point(class_scope:api.ListHyperparametersRequest)
+  })
+_sym_db.RegisterMessage(ListHyperparametersRequest)
+
+ListHyperparametersResponse = _reflection.GeneratedProtocolMessageType('ListHyperparametersResponse', (_message.Message,), {
+  'DESCRIPTOR' : _LISTHYPERPARAMETERSRESPONSE,
+  '__module__' : 'repository_pb2'
+  # @@protoc_insertion_point(class_scope:api.ListHyperparametersResponse)
+  })
+_sym_db.RegisterMessage(ListHyperparametersResponse)
+
+CreateHyperparametersRequest = _reflection.GeneratedProtocolMessageType('CreateHyperparametersRequest', (_message.Message,), {
+
+  'HyperparametersEntry' : _reflection.GeneratedProtocolMessageType('HyperparametersEntry', (_message.Message,), {
+    'DESCRIPTOR' : _CREATEHYPERPARAMETERSREQUEST_HYPERPARAMETERSENTRY,
+    '__module__' : 'repository_pb2'
+    # @@protoc_insertion_point(class_scope:api.CreateHyperparametersRequest.HyperparametersEntry)
+    })
+  ,
+
+  'LabelsEntry' : _reflection.GeneratedProtocolMessageType('LabelsEntry', (_message.Message,), {
+    'DESCRIPTOR' : _CREATEHYPERPARAMETERSREQUEST_LABELSENTRY,
+    '__module__' : 'repository_pb2'
+    # @@protoc_insertion_point(class_scope:api.CreateHyperparametersRequest.LabelsEntry)
+    })
+  ,
+  'DESCRIPTOR' : _CREATEHYPERPARAMETERSREQUEST,
+  '__module__' : 'repository_pb2'
+  # @@protoc_insertion_point(class_scope:api.CreateHyperparametersRequest)
+  })
+_sym_db.RegisterMessage(CreateHyperparametersRequest)
+_sym_db.RegisterMessage(CreateHyperparametersRequest.HyperparametersEntry)
+_sym_db.RegisterMessage(CreateHyperparametersRequest.LabelsEntry)
+
+CreateHyperparametersResponse = _reflection.GeneratedProtocolMessageType('CreateHyperparametersResponse', (_message.Message,), {
+  'DESCRIPTOR' : _CREATEHYPERPARAMETERSRESPONSE,
+  '__module__' : 'repository_pb2'
+  # @@protoc_insertion_point(class_scope:api.CreateHyperparametersResponse)
+  })
+_sym_db.RegisterMessage(CreateHyperparametersResponse)
+
+GetHyperparametersRequest = _reflection.GeneratedProtocolMessageType('GetHyperparametersRequest', (_message.Message,), {
+  'DESCRIPTOR' : _GETHYPERPARAMETERSREQUEST,
+  '__module__' : 'repository_pb2'
+  # @@protoc_insertion_point(class_scope:api.GetHyperparametersRequest)
+  })
+_sym_db.RegisterMessage(GetHyperparametersRequest)
+
+GetHyperparametersResponse = _reflection.GeneratedProtocolMessageType('GetHyperparametersResponse', (_message.Message,), {
+
+  'HyperparametersEntry' : _reflection.GeneratedProtocolMessageType('HyperparametersEntry', (_message.Message,), {
+    'DESCRIPTOR' : _GETHYPERPARAMETERSRESPONSE_HYPERPARAMETERSENTRY,
+    '__module__' : 'repository_pb2'
+    # @@protoc_insertion_point(class_scope:api.GetHyperparametersResponse.HyperparametersEntry)
+    })
+  ,
+
+  'LabelsEntry' : _reflection.GeneratedProtocolMessageType('LabelsEntry', (_message.Message,), {
+    'DESCRIPTOR' : _GETHYPERPARAMETERSRESPONSE_LABELSENTRY,
+    '__module__' : 'repository_pb2'
+    # @@protoc_insertion_point(class_scope:api.GetHyperparametersResponse.LabelsEntry)
+    })
+  ,
+  'DESCRIPTOR' : _GETHYPERPARAMETERSRESPONSE,
+  '__module__' : 'repository_pb2'
+  # @@protoc_insertion_point(class_scope:api.GetHyperparametersResponse)
+  })
+_sym_db.RegisterMessage(GetHyperparametersResponse)
+_sym_db.RegisterMessage(GetHyperparametersResponse.HyperparametersEntry)
+_sym_db.RegisterMessage(GetHyperparametersResponse.LabelsEntry)
+
+UpdateHyperparametersRequest = _reflection.GeneratedProtocolMessageType('UpdateHyperparametersRequest', (_message.Message,), {
+
+  'HyperparametersEntry' : _reflection.GeneratedProtocolMessageType('HyperparametersEntry', (_message.Message,), {
+    'DESCRIPTOR' : _UPDATEHYPERPARAMETERSREQUEST_HYPERPARAMETERSENTRY,
+    '__module__' : 'repository_pb2'
+    # @@protoc_insertion_point(class_scope:api.UpdateHyperparametersRequest.HyperparametersEntry)
+    })
+  ,
+
+  'LabelsEntry' : _reflection.GeneratedProtocolMessageType('LabelsEntry', (_message.Message,), {
+    'DESCRIPTOR' : _UPDATEHYPERPARAMETERSREQUEST_LABELSENTRY,
+    '__module__' : 'repository_pb2'
+    # @@protoc_insertion_point(class_scope:api.UpdateHyperparametersRequest.LabelsEntry)
+    })
+  ,
+  'DESCRIPTOR' : _UPDATEHYPERPARAMETERSREQUEST,
+  '__module__' : 'repository_pb2'
+  # @@protoc_insertion_point(class_scope:api.UpdateHyperparametersRequest)
+  })
+_sym_db.RegisterMessage(UpdateHyperparametersRequest)
+_sym_db.RegisterMessage(UpdateHyperparametersRequest.HyperparametersEntry)
+_sym_db.RegisterMessage(UpdateHyperparametersRequest.LabelsEntry)
+
+UpdateHyperparametersResponse = _reflection.GeneratedProtocolMessageType('UpdateHyperparametersResponse', (_message.Message,), {
+
+  'HyperparametersEntry' : _reflection.GeneratedProtocolMessageType('HyperparametersEntry', (_message.Message,), {
+    'DESCRIPTOR' : _UPDATEHYPERPARAMETERSRESPONSE_HYPERPARAMETERSENTRY,
+    '__module__' : 'repository_pb2'
+    # @@protoc_insertion_point(class_scope:api.UpdateHyperparametersResponse.HyperparametersEntry)
+    })
+  ,
+
+  'LabelsEntry' : _reflection.GeneratedProtocolMessageType('LabelsEntry', (_message.Message,), {
+    'DESCRIPTOR' : _UPDATEHYPERPARAMETERSRESPONSE_LABELSENTRY,
+    '__module__' : 'repository_pb2'
+    # @@protoc_insertion_point(class_scope:api.UpdateHyperparametersResponse.LabelsEntry)
+    })
+  ,
+  'DESCRIPTOR' : _UPDATEHYPERPARAMETERSRESPONSE,
+  '__module__' : 'repository_pb2'
+  # @@protoc_insertion_point(class_scope:api.UpdateHyperparametersResponse)
+  })
+_sym_db.RegisterMessage(UpdateHyperparametersResponse)
+_sym_db.RegisterMessage(UpdateHyperparametersResponse.HyperparametersEntry)
+_sym_db.RegisterMessage(UpdateHyperparametersResponse.LabelsEntry)
+
+DeleteHyperparametersRequest = _reflection.GeneratedProtocolMessageType('DeleteHyperparametersRequest', (_message.Message,), {
+  'DESCRIPTOR' : _DELETEHYPERPARAMETERSREQUEST,
+  '__module__' : 'repository_pb2'
+  # @@protoc_insertion_point(class_scope:api.DeleteHyperparametersRequest)
+  })
+_sym_db.RegisterMessage(DeleteHyperparametersRequest)
+
+DeleteHyperparametersResponse = _reflection.GeneratedProtocolMessageType('DeleteHyperparametersResponse', (_message.Message,), {
+  'DESCRIPTOR' : _DELETEHYPERPARAMETERSRESPONSE,
+  '__module__' : 'repository_pb2'
+  # @@protoc_insertion_point(class_scope:api.DeleteHyperparametersResponse)
+  })
+_sym_db.RegisterMessage(DeleteHyperparametersResponse)
+
+ListCheckpointsRequest = _reflection.GeneratedProtocolMessageType('ListCheckpointsRequest', (_message.Message,), {
+  'DESCRIPTOR' : _LISTCHECKPOINTSREQUEST,
+  '__module__' : 'repository_pb2'
+  # @@protoc_insertion_point(class_scope:api.ListCheckpointsRequest)
+  })
+_sym_db.RegisterMessage(ListCheckpointsRequest)
+
+ListCheckpointsResponse = _reflection.GeneratedProtocolMessageType('ListCheckpointsResponse', (_message.Message,), {
+  'DESCRIPTOR' : _LISTCHECKPOINTSRESPONSE,
+  '__module__' : 'repository_pb2'
+  # @@protoc_insertion_point(class_scope:api.ListCheckpointsResponse)
+  })
+_sym_db.RegisterMessage(ListCheckpointsResponse)
+
+CreateCheckpointRequest = _reflection.GeneratedProtocolMessageType('CreateCheckpointRequest', (_message.Message,), {
+
+  'InfoEntry' : _reflection.GeneratedProtocolMessageType('InfoEntry', (_message.Message,), {
+    'DESCRIPTOR' : _CREATECHECKPOINTREQUEST_INFOENTRY,
+    '__module__' : 'repository_pb2'
+    # @@protoc_insertion_point(class_scope:api.CreateCheckpointRequest.InfoEntry)
+    })
+  ,
+
+  'LabelsEntry' : _reflection.GeneratedProtocolMessageType('LabelsEntry', (_message.Message,), {
+    'DESCRIPTOR' : _CREATECHECKPOINTREQUEST_LABELSENTRY,
+    '__module__' : 'repository_pb2'
+    # @@protoc_insertion_point(class_scope:api.CreateCheckpointRequest.LabelsEntry)
+    })
+  ,
+  'DESCRIPTOR' : _CREATECHECKPOINTREQUEST,
+  '__module__' : 'repository_pb2'
+  # @@protoc_insertion_point(class_scope:api.CreateCheckpointRequest)
+  })
+_sym_db.RegisterMessage(CreateCheckpointRequest)
+_sym_db.RegisterMessage(CreateCheckpointRequest.InfoEntry)
+_sym_db.RegisterMessage(CreateCheckpointRequest.LabelsEntry)
+
+CreateCheckpointResponse = _reflection.GeneratedProtocolMessageType('CreateCheckpointResponse', (_message.Message,), {
+  'DESCRIPTOR' : _CREATECHECKPOINTRESPONSE,
+  '__module__' : 'repository_pb2'
+  # @@protoc_insertion_point(class_scope:api.CreateCheckpointResponse)
+  })
+_sym_db.RegisterMessage(CreateCheckpointResponse)
+
+FinalizeCheckpointRequest = _reflection.GeneratedProtocolMessageType('FinalizeCheckpointRequest', (_message.Message,), {
+  'DESCRIPTOR' : _FINALIZECHECKPOINTREQUEST,
+  '__module__' : 'repository_pb2'
+  # @@protoc_insertion_point(class_scope:api.FinalizeCheckpointRequest)
+  })
+_sym_db.RegisterMessage(FinalizeCheckpointRequest)
+
+FinalizeCheckpointResponse = _reflection.GeneratedProtocolMessageType('FinalizeCheckpointResponse', (_message.Message,), {
+  'DESCRIPTOR' : _FINALIZECHECKPOINTRESPONSE,
+  '__module__' : 'repository_pb2'
+  # @@protoc_insertion_point(class_scope:api.FinalizeCheckpointResponse)
+  })
+_sym_db.RegisterMessage(FinalizeCheckpointResponse)
+
+GetCheckpointRequest = _reflection.GeneratedProtocolMessageType('GetCheckpointRequest', (_message.Message,), {
+  'DESCRIPTOR' : _GETCHECKPOINTREQUEST,
+  '__module__' : 'repository_pb2'
+  # @@protoc_insertion_point(class_scope:api.GetCheckpointRequest)
+  })
+_sym_db.RegisterMessage(GetCheckpointRequest)
+
+GetCheckpointResponse = _reflection.GeneratedProtocolMessageType('GetCheckpointResponse', (_message.Message,), {
+
+  'InfoEntry' : _reflection.GeneratedProtocolMessageType('InfoEntry', (_message.Message,), {
+    'DESCRIPTOR' : _GETCHECKPOINTRESPONSE_INFOENTRY,
+    '__module__' : 'repository_pb2'
+    # @@protoc_insertion_point(class_scope:api.GetCheckpointResponse.InfoEntry)
+    })
+  ,
+
+  'LabelsEntry' : _reflection.GeneratedProtocolMessageType('LabelsEntry', (_message.Message,), {
+    'DESCRIPTOR' : _GETCHECKPOINTRESPONSE_LABELSENTRY,
+    '__module__' : 'repository_pb2'
+    # @@protoc_insertion_point(class_scope:api.GetCheckpointResponse.LabelsEntry)
+    })
+  ,
+  'DESCRIPTOR' : _GETCHECKPOINTRESPONSE,
+  '__module__' : 'repository_pb2'
+  # @@protoc_insertion_point(class_scope:api.GetCheckpointResponse)
+  })
+_sym_db.RegisterMessage(GetCheckpointResponse)
+_sym_db.RegisterMessage(GetCheckpointResponse.InfoEntry)
+_sym_db.RegisterMessage(GetCheckpointResponse.LabelsEntry)
+
+GetCheckpointManifestRequest = _reflection.GeneratedProtocolMessageType('GetCheckpointManifestRequest', (_message.Message,), {
+  'DESCRIPTOR' : _GETCHECKPOINTMANIFESTREQUEST,
+  '__module__' : 'repository_pb2'
+  # @@protoc_insertion_point(class_scope:api.GetCheckpointManifestRequest)
+  })
+_sym_db.RegisterMessage(GetCheckpointManifestRequest)
+
+GetCheckpointManifestResponse = _reflection.GeneratedProtocolMessageType('GetCheckpointManifestResponse', (_message.Message,), {
+  'DESCRIPTOR' : _GETCHECKPOINTMANIFESTRESPONSE,
+  '__module__' : 'repository_pb2'
+  # @@protoc_insertion_point(class_scope:api.GetCheckpointManifestResponse)
+  })
+_sym_db.RegisterMessage(GetCheckpointManifestResponse)
+
+UpdateCheckpointStateRequest = _reflection.GeneratedProtocolMessageType('UpdateCheckpointStateRequest', (_message.Message,), {
+  'DESCRIPTOR' : _UPDATECHECKPOINTSTATEREQUEST,
+  '__module__' : 'repository_pb2'
+  # @@protoc_insertion_point(class_scope:api.UpdateCheckpointStateRequest)
+  })
+_sym_db.RegisterMessage(UpdateCheckpointStateRequest)
+
+UpdateCheckpointStateResponse = _reflection.GeneratedProtocolMessageType('UpdateCheckpointStateResponse', (_message.Message,), {
+  'DESCRIPTOR' : _UPDATECHECKPOINTSTATERESPONSE,
+  '__module__' : 'repository_pb2'
+  # @@protoc_insertion_point(class_scope:api.UpdateCheckpointStateResponse)
+  })
+_sym_db.RegisterMessage(UpdateCheckpointStateResponse)
+
+DeleteCheckpointRequest = _reflection.GeneratedProtocolMessageType('DeleteCheckpointRequest', (_message.Message,), {
+  'DESCRIPTOR' : _DELETECHECKPOINTREQUEST,
+  '__module__' : 'repository_pb2'
+  # @@protoc_insertion_point(class_scope:api.DeleteCheckpointRequest)
+  })
+_sym_db.RegisterMessage(DeleteCheckpointRequest)
+
+DeleteCheckpointResponse = _reflection.GeneratedProtocolMessageType('DeleteCheckpointResponse', (_message.Message,), {
+  'DESCRIPTOR' : _DELETECHECKPOINTRESPONSE,
+  '__module__' : 'repository_pb2'
+  # @@protoc_insertion_point(class_scope:api.DeleteCheckpointResponse)
+  })
+_sym_db.RegisterMessage(DeleteCheckpointResponse)
+
+ResolveModelRequest = _reflection.GeneratedProtocolMessageType('ResolveModelRequest', (_message.Message,), {
+  'DESCRIPTOR' : _RESOLVEMODELREQUEST,
+  '__module__' : 'repository_pb2'
+  # @@protoc_insertion_point(class_scope:api.ResolveModelRequest)
+  })
+_sym_db.RegisterMessage(ResolveModelRequest)
+
+ResolveModelResponse = _reflection.GeneratedProtocolMessageType('ResolveModelResponse', (_message.Message,), {
+  'DESCRIPTOR' : _RESOLVEMODELRESPONSE,
+  '__module__' : 'repository_pb2'
+  # @@protoc_insertion_point(class_scope:api.ResolveModelResponse)
+  })
+_sym_db.RegisterMessage(ResolveModelResponse)
+
+UpgradeCheckRequest = _reflection.GeneratedProtocolMessageType('UpgradeCheckRequest', (_message.Message,), {
+  'DESCRIPTOR' : _UPGRADECHECKREQUEST,
+  '__module__' : 'repository_pb2'
+  # @@protoc_insertion_point(class_scope:api.UpgradeCheckRequest)
+  })
+_sym_db.RegisterMessage(UpgradeCheckRequest)
+
+UpgradeHop = _reflection.GeneratedProtocolMessageType('UpgradeHop', (_message.Message,), {
+  'DESCRIPTOR' : _UPGRADEHOP,
+  '__module__' : 'repository_pb2'
+  # @@protoc_insertion_point(class_scope:api.UpgradeHop)
+  })
+_sym_db.RegisterMessage(UpgradeHop)
+
+UpgradeCheckResponse = _reflection.GeneratedProtocolMessageType('UpgradeCheckResponse', (_message.Message,), {
+  'DESCRIPTOR' : _UPGRADECHECKRESPONSE,
+  '__module__' : 'repository_pb2'
+  # @@protoc_insertion_point(class_scope:api.UpgradeCheckResponse)
+  })
+_sym_db.RegisterMessage(UpgradeCheckResponse)
+
+FsckRequest = _reflection.GeneratedProtocolMessageType('FsckRequest', (_message.Message,), {
+  'DESCRIPTOR' : _FSCKREQUEST,
+  '__module__' : 'repository_pb2'
+  # @@protoc_insertion_point(class_scope:api.FsckRequest)
+  })
+_sym_db.RegisterMessage(FsckRequest)
+
+DanglingReference = _reflection.GeneratedProtocolMessageType('DanglingReference', (_message.Message,), {
+  'DESCRIPTOR' : _DANGLINGREFERENCE,
+  '__module__' : 'repository_pb2'
+  # @@protoc_insertion_point(class_scope:api.DanglingReference)
+  })
+_sym_db.RegisterMessage(DanglingReference)
+
+FsckResponse = _reflection.GeneratedProtocolMessageType('FsckResponse', (_message.Message,), {
+  'DESCRIPTOR' : _FSCKRESPONSE,
+  '__module__' : 'repository_pb2'
+  # @@protoc_insertion_point(class_scope:api.FsckResponse)
+  })
+_sym_db.RegisterMessage(FsckResponse)
+
+AuditEvent = _reflection.GeneratedProtocolMessageType('AuditEvent', (_message.Message,), {
+  'DESCRIPTOR' : _AUDITEVENT,
+  '__module__' : 'repository_pb2'
+  # @@protoc_insertion_point(class_scope:api.AuditEvent)
+  })
+_sym_db.RegisterMessage(AuditEvent)
+
+ListAuditEventsRequest = _reflection.GeneratedProtocolMessageType('ListAuditEventsRequest', (_message.Message,), {
+  'DESCRIPTOR' : _LISTAUDITEVENTSREQUEST,
+  '__module__' : 'repository_pb2'
+  # @@protoc_insertion_point(class_scope:api.ListAuditEventsRequest)
+  })
+_sym_db.RegisterMessage(ListAuditEventsRequest)
+
+ListAuditEventsResponse = _reflection.GeneratedProtocolMessageType('ListAuditEventsResponse', (_message.Message,), {
+  'DESCRIPTOR' : _LISTAUDITEVENTSRESPONSE,
+  '__module__' : 'repository_pb2'
+  # @@protoc_insertion_point(class_scope:api.ListAuditEventsResponse)
+  })
+_sym_db.RegisterMessage(ListAuditEventsResponse)
+
+Webhook = _reflection.GeneratedProtocolMessageType('Webhook', (_message.Message,), {
+  'DESCRIPTOR' : _WEBHOOK,
+  '__module__' : 'repository_pb2'
+  # @@protoc_insertion_point(class_scope:api.Webhook)
+  })
+_sym_db.RegisterMessage(Webhook)
+
+CreateWebhookRequest = _reflection.GeneratedProtocolMessageType('CreateWebhookRequest', (_message.Message,), {
+  'DESCRIPTOR' : _CREATEWEBHOOKREQUEST,
+  '__module__' : 'repository_pb2'
+  # @@protoc_insertion_point(class_scope:api.CreateWebhookRequest)
+  })
+_sym_db.RegisterMessage(CreateWebhookRequest)
+
+CreateWebhookResponse = _reflection.GeneratedProtocolMessageType('CreateWebhookResponse', (_message.Message,), {
+  'DESCRIPTOR' : _CREATEWEBHOOKRESPONSE,
+  '__module__' : 'repository_pb2'
+  # @@protoc_insertion_point(class_scope:api.CreateWebhookResponse)
+  })
+_sym_db.RegisterMessage(CreateWebhookResponse)
+
+ListWebhooksRequest = _reflection.GeneratedProtocolMessageType('ListWebhooksRequest', (_message.Message,), {
+  'DESCRIPTOR' : _LISTWEBHOOKSREQUEST,
+  '__module__' : 'repository_pb2'
+  # @@protoc_insertion_point(class_scope:api.ListWebhooksRequest)
+  })
+_sym_db.RegisterMessage(ListWebhooksRequest)
+
+ListWebhooksResponse = _reflection.GeneratedProtocolMessageType('ListWebhooksResponse', (_message.Message,), {
+  'DESCRIPTOR' : _LISTWEBHOOKSRESPONSE,
+  '__module__' : 'repository_pb2'
+  # @@protoc_insertion_point(class_scope:api.ListWebhooksResponse)
+  })
+_sym_db.RegisterMessage(ListWebhooksResponse)
+
+DeleteWebhookRequest = _reflection.GeneratedProtocolMessageType('DeleteWebhookRequest', (_message.Message,), {
+  'DESCRIPTOR' : _DELETEWEBHOOKREQUEST,
   '__module__' : 'repository_pb2'
-  # @@protoc_insertion_point(class_scope:api.HealthCheckRequest)
+  # @@protoc_insertion_point(class_scope:api.DeleteWebhookRequest)
   })
-_sym_db.RegisterMessage(HealthCheckRequest)
+_sym_db.RegisterMessage(DeleteWebhookRequest)
 
-HealthCheckResponse = _reflection.GeneratedProtocolMessageType('HealthCheckResponse', (_message.Message,), {
-  'DESCRIPTOR' : _HEALTHCHECKRESPONSE,
+DeleteWebhookResponse = _reflection.GeneratedProtocolMessageType('DeleteWebhookResponse', (_message.Message,), {
+  'DESCRIPTOR' : _DELETEWEBHOOKRESPONSE,
   '__module__' : 'repository_pb2'
-  # @@protoc_insertion_point(class_scope:api.HealthCheckResponse)
+  # @@protoc_insertion_point(class_scope:api.DeleteWebhookResponse)
   })
-_sym_db.RegisterMessage(HealthCheckResponse)
+_sym_db.RegisterMessage(DeleteWebhookResponse)
 
-ConfigRequest = _reflection.GeneratedProtocolMessageType('ConfigRequest', (_message.Message,), {
-  'DESCRIPTOR' : _CONFIGREQUEST,
+WebhookDeadLetter = _reflection.GeneratedProtocolMessageType('WebhookDeadLetter', (_message.Message,), {
+  'DESCRIPTOR' : _WEBHOOKDEADLETTER,
   '__module__' : 'repository_pb2'
-  # @@protoc_insertion_point(class_scope:api.ConfigRequest)
+  # @@protoc_insertion_point(class_scope:api.WebhookDeadLetter)
   })
-_sym_db.RegisterMessage(ConfigRequest)
+_sym_db.RegisterMessage(WebhookDeadLetter)
 
-ConfigResponse = _reflection.GeneratedProtocolMessageType('ConfigResponse', (_message.Message,), {
-  'DESCRIPTOR' : _CONFIGRESPONSE,
+ListWebhookDeadLettersRequest = _reflection.GeneratedProtocolMessageType('ListWebhookDeadLettersRequest', (_message.Message,), {
+  'DESCRIPTOR' : _LISTWEBHOOKDEADLETTERSREQUEST,
   '__module__' : 'repository_pb2'
-  # @@protoc_insertion_point(class_scope:api.ConfigResponse)
+  # @@protoc_insertion_point(class_scope:api.ListWebhookDeadLettersRequest)
   })
-_sym_db.RegisterMessage(ConfigResponse)
+_sym_db.RegisterMessage(ListWebhookDeadLettersRequest)
 
-Model = _reflection.GeneratedProtocolMessageType('Model', (_message.Message,), {
-  'DESCRIPTOR' : _MODEL,
+ListWebhookDeadLettersResponse = _reflection.GeneratedProtocolMessageType('ListWebhookDeadLettersResponse', (_message.Message,), {
+  'DESCRIPTOR' : _LISTWEBHOOKDEADLETTERSRESPONSE,
   '__module__' : 'repository_pb2'
-  # @@protoc_insertion_point(class_scope:api.Model)
+  # @@protoc_insertion_point(class_scope:api.ListWebhookDeadLettersResponse)
   })
-_sym_db.RegisterMessage(Model)
+_sym_db.RegisterMessage(ListWebhookDeadLettersResponse)
 
-ListModelsRequest = _reflection.GeneratedProtocolMessageType('ListModelsRequest', (_message.Message,), {
-  'DESCRIPTOR' : _LISTMODELSREQUEST,
+Revision = _reflection.GeneratedProtocolMessageType('Revision', (_message.Message,), {
+  'DESCRIPTOR' : _REVISION,
   '__module__' : 'repository_pb2'
-  # @@protoc_insertion_point(class_scope:api.ListModelsRequest)
+  # @@protoc_insertion_point(class_scope:api.Revision)
   })
-_sym_db.RegisterMessage(ListModelsRequest)
+_sym_db.RegisterMessage(Revision)
 
-ListModelsResponse = _reflection.GeneratedProtocolMessageType('ListModelsResponse', (_message.Message,), {
-  'DESCRIPTOR' : _LISTMODELSRESPONSE,
+ListRevisionsRequest = _reflection.GeneratedProtocolMessageType('ListRevisionsRequest', (_message.Message,), {
+  'DESCRIPTOR' : _LISTREVISIONSREQUEST,
   '__module__' : 'repository_pb2'
-  # @@protoc_insertion_point(class_scope:api.ListModelsResponse)
+  # @@protoc_insertion_point(class_scope:api.ListRevisionsRequest)
   })
-_sym_db.RegisterMessage(ListModelsResponse)
+_sym_db.RegisterMessage(ListRevisionsRequest)
 
-CreateModelRequest = _reflection.GeneratedProtocolMessageType('CreateModelRequest', (_message.Message,), {
-  'DESCRIPTOR' : _CREATEMODELREQUEST,
+ListRevisionsResponse = _reflection.GeneratedProtocolMessageType('ListRevisionsResponse', (_message.Message,), {
+  'DESCRIPTOR' : _LISTREVISIONSRESPONSE,
   '__module__' : 'repository_pb2'
-  # @@protoc_insertion_point(class_scope:api.CreateModelRequest)
+  # @@protoc_insertion_point(class_scope:api.ListRevisionsResponse)
   })
-_sym_db.RegisterMessage(CreateModelRequest)
+_sym_db.RegisterMessage(ListRevisionsResponse)
 
-CreateModelResponse = _reflection.GeneratedProtocolMessageType('CreateModelResponse', (_message.Message,), {
-  'DESCRIPTOR' : _CREATEMODELRESPONSE,
+GetRevisionRequest = _reflection.GeneratedProtocolMessageType('GetRevisionRequest', (_message.Message,), {
+  'DESCRIPTOR' : _GETREVISIONREQUEST,
   '__module__' : 'repository_pb2'
-  # @@protoc_insertion_point(class_scope:api.CreateModelResponse)
+  # @@protoc_insertion_point(class_scope:api.GetRevisionRequest)
   })
-_sym_db.RegisterMessage(CreateModelResponse)
+_sym_db.RegisterMessage(GetRevisionRequest)
 
-GetModelRequest = _reflection.GeneratedProtocolMessageType('GetModelRequest', (_message.Message,), {
-  'DESCRIPTOR' : _GETMODELREQUEST,
+GetRevisionResponse = _reflection.GeneratedProtocolMessageType('GetRevisionResponse', (_message.Message,), {
+  'DESCRIPTOR' : _GETREVISIONRESPONSE,
   '__module__' : 'repository_pb2'
-  # @@protoc_insertion_point(class_scope:api.GetModelRequest)
+  # @@protoc_insertion_point(class_scope:api.GetRevisionResponse)
   })
-_sym_db.RegisterMessage(GetModelRequest)
+_sym_db.RegisterMessage(GetRevisionResponse)
 
-GetModelResponse = _reflection.GeneratedProtocolMessageType('GetModelResponse', (_message.Message,), {
-  'DESCRIPTOR' : _GETMODELRESPONSE,
+RollbackRequest = _reflection.GeneratedProtocolMessageType('RollbackRequest', (_message.Message,), {
+  'DESCRIPTOR' : _ROLLBACKREQUEST,
   '__module__' : 'repository_pb2'
-  # @@protoc_insertion_point(class_scope:api.GetModelResponse)
+  # @@protoc_insertion_point(class_scope:api.RollbackRequest)
   })
-_sym_db.RegisterMessage(GetModelResponse)
+_sym_db.RegisterMessage(RollbackRequest)
 
-UpdateModelRequest = _reflection.GeneratedProtocolMessageType('UpdateModelRequest', (_message.Message,), {
-  'DESCRIPTOR' : _UPDATEMODELREQUEST,
+RollbackResponse = _reflection.GeneratedProtocolMessageType('RollbackResponse', (_message.Message,), {
+  'DESCRIPTOR' : _ROLLBACKRESPONSE,
   '__module__' : 'repository_pb2'
-  # @@protoc_insertion_point(class_scope:api.UpdateModelRequest)
+  # @@protoc_insertion_point(class_scope:api.RollbackResponse)
   })
-_sym_db.RegisterMessage(UpdateModelRequest)
+_sym_db.RegisterMessage(RollbackResponse)
 
-UpdateModelResponse = _reflection.GeneratedProtocolMessageType('UpdateModelResponse', (_message.Message,), {
-  'DESCRIPTOR' : _UPDATEMODELRESPONSE,
+WatchModelRequest = _reflection.GeneratedProtocolMessageType('WatchModelRequest', (_message.Message,), {
+  'DESCRIPTOR' : _WATCHMODELREQUEST,
   '__module__' : 'repository_pb2'
-  # @@protoc_insertion_point(class_scope:api.UpdateModelResponse)
+  # @@protoc_insertion_point(class_scope:api.WatchModelRequest)
   })
-_sym_db.RegisterMessage(UpdateModelResponse)
+_sym_db.RegisterMessage(WatchModelRequest)
 
-ListHyperparametersRequest = _reflection.GeneratedProtocolMessageType('ListHyperparametersRequest', (_message.Message,), {
-  'DESCRIPTOR' : _LISTHYPERPARAMETERSREQUEST,
+WatchModelEvent = _reflection.GeneratedProtocolMessageType('WatchModelEvent', (_message.Message,), {
+  'DESCRIPTOR' : _WATCHMODELEVENT,
   '__module__' : 'repository_pb2'
-  # @@protoc_insertion_point(class_scope:api.ListHyperparametersRequest)
+  # @@protoc_insertion_point(class_scope:api.WatchModelEvent)
   })
-_sym_db.RegisterMessage(ListHyperparametersRequest)
+_sym_db.RegisterMessage(WatchModelEvent)
 
-ListHyperparametersResponse = _reflection.GeneratedProtocolMessageType('ListHyperparametersResponse', (_message.Message,), {
-  'DESCRIPTOR' : _LISTHYPERPARAMETERSRESPONSE,
+Tag = _reflection.GeneratedProtocolMessageType('Tag', (_message.Message,), {
+  'DESCRIPTOR' : _TAG,
   '__module__' : 'repository_pb2'
-  # @@protoc_insertion_point(class_scope:api.ListHyperparametersResponse)
+  # @@protoc_insertion_point(class_scope:api.Tag)
   })
-_sym_db.RegisterMessage(ListHyperparametersResponse)
+_sym_db.RegisterMessage(Tag)
 
-CreateHyperparametersRequest = _reflection.GeneratedProtocolMessageType('CreateHyperparametersRequest', (_message.Message,), {
+SetTagRequest = _reflection.GeneratedProtocolMessageType('SetTagRequest', (_message.Message,), {
+  'DESCRIPTOR' : _SETTAGREQUEST,
+  '__module__' : 'repository_pb2'
+  # @@protoc_insertion_point(class_scope:api.SetTagRequest)
+  })
+_sym_db.RegisterMessage(SetTagRequest)
 
-  'HyperparametersEntry' : _reflection.GeneratedProtocolMessageType('HyperparametersEntry', (_message.Message,), {
-    'DESCRIPTOR' : _CREATEHYPERPARAMETERSREQUEST_HYPERPARAMETERSENTRY,
-    '__module__' : 'repository_pb2'
-    # @@protoc_insertion_point(class_scope:api.CreateHyperparametersRequest.HyperparametersEntry)
-    })
-  ,
-  'DESCRIPTOR' : _CREATEHYPERPARAMETERSREQUEST,
+SetTagResponse = _reflection.GeneratedProtocolMessageType('SetTagResponse', (_message.Message,), {
+  'DESCRIPTOR' : _SETTAGRESPONSE,
   '__module__' : 'repository_pb2'
-  # @@protoc_insertion_point(class_scope:api.CreateHyperparametersRequest)
+  # @@protoc_insertion_point(class_scope:api.SetTagResponse)
   })
-_sym_db.RegisterMessage(CreateHyperparametersRequest)
-_sym_db.RegisterMessage(CreateHyperparametersRequest.HyperparametersEntry)
+_sym_db.RegisterMessage(SetTagResponse)
 
-CreateHyperparametersResponse = _reflection.GeneratedProtocolMessageType('CreateHyperparametersResponse', (_message.Message,), {
-  'DESCRIPTOR' : _CREATEHYPERPARAMETERSRESPONSE,
+GetTagRequest = _reflection.GeneratedProtocolMessageType('GetTagRequest', (_message.Message,), {
+  'DESCRIPTOR' : _GETTAGREQUEST,
   '__module__' : 'repository_pb2'
-  # @@protoc_insertion_point(class_scope:api.CreateHyperparametersResponse)
+  # @@protoc_insertion_point(class_scope:api.GetTagRequest)
   })
-_sym_db.RegisterMessage(CreateHyperparametersResponse)
+_sym_db.RegisterMessage(GetTagRequest)
 
-GetHyperparametersRequest = _reflection.GeneratedProtocolMessageType('GetHyperparametersRequest', (_message.Message,), {
-  'DESCRIPTOR' : _GETHYPERPARAMETERSREQUEST,
+GetTagResponse = _reflection.GeneratedProtocolMessageType('GetTagResponse', (_message.Message,), {
+  'DESCRIPTOR' : _GETTAGRESPONSE,
   '__module__' : 'repository_pb2'
-  # @@protoc_insertion_point(class_scope:api.GetHyperparametersRequest)
+  # @@protoc_insertion_point(class_scope:api.GetTagResponse)
   })
-_sym_db.RegisterMessage(GetHyperparametersRequest)
+_sym_db.RegisterMessage(GetTagResponse)
 
-GetHyperparametersResponse = _reflection.GeneratedProtocolMessageType('GetHyperparametersResponse', (_message.Message,), {
+ListTagsRequest = _reflection.GeneratedProtocolMessageType('ListTagsRequest', (_message.Message,), {
+  'DESCRIPTOR' : _LISTTAGSREQUEST,
+  '__module__' : 'repository_pb2'
+  # @@protoc_insertion_point(class_scope:api.ListTagsRequest)
+  })
+_sym_db.RegisterMessage(ListTagsRequest)
 
-  'HyperparametersEntry' : _reflection.GeneratedProtocolMessageType('HyperparametersEntry', (_message.Message,), {
-    'DESCRIPTOR' : _GETHYPERPARAMETERSRESPONSE_HYPERPARAMETERSENTRY,
-    '__module__' : 'repository_pb2'
-    # @@protoc_insertion_point(class_scope:api.GetHyperparametersResponse.HyperparametersEntry)
-    })
-  ,
-  'DESCRIPTOR' : _GETHYPERPARAMETERSRESPONSE,
+ListTagsResponse = _reflection.GeneratedProtocolMessageType('ListTagsResponse', (_message.Message,), {
+  'DESCRIPTOR' : _LISTTAGSRESPONSE,
   '__module__' : 'repository_pb2'
-  # @@protoc_insertion_point(class_scope:api.GetHyperparametersResponse)
+  # @@protoc_insertion_point(class_scope:api.ListTagsResponse)
   })
-_sym_db.RegisterMessage(GetHyperparametersResponse)
-_sym_db.RegisterMessage(GetHyperparametersResponse.HyperparametersEntry)
+_sym_db.RegisterMessage(ListTagsResponse)
 
-UpdateHyperparametersRequest = _reflection.GeneratedProtocolMessageType('UpdateHyperparametersRequest', (_message.Message,), {
+DeleteTagRequest = _reflection.GeneratedProtocolMessageType('DeleteTagRequest', (_message.Message,), {
+  'DESCRIPTOR' : _DELETETAGREQUEST,
+  '__module__' : 'repository_pb2'
+  # @@protoc_insertion_point(class_scope:api.DeleteTagRequest)
+  })
+_sym_db.RegisterMessage(DeleteTagRequest)
 
-  'HyperparametersEntry' : _reflection.GeneratedProtocolMessageType('HyperparametersEntry', (_message.Message,), {
-    'DESCRIPTOR' : _UPDATEHYPERPARAMETERSREQUEST_HYPERPARAMETERSENTRY,
-    '__module__' : 'repository_pb2'
-    # @@protoc_insertion_point(class_scope:api.UpdateHyperparametersRequest.HyperparametersEntry)
-    })
-  ,
-  'DESCRIPTOR' : _UPDATEHYPERPARAMETERSREQUEST,
+DeleteTagResponse = _reflection.GeneratedProtocolMessageType('DeleteTagResponse', (_message.Message,), {
+  'DESCRIPTOR' : _DELETETAGRESPONSE,
   '__module__' : 'repository_pb2'
-  # @@protoc_insertion_point(class_scope:api.UpdateHyperparametersRequest)
+  # @@protoc_insertion_point(class_scope:api.DeleteTagResponse)
   })
-_sym_db.RegisterMessage(UpdateHyperparametersRequest)
-_sym_db.RegisterMessage(UpdateHyperparametersRequest.HyperparametersEntry)
+_sym_db.RegisterMessage(DeleteTagResponse)
 
-UpdateHyperparametersResponse = _reflection.GeneratedProtocolMessageType('UpdateHyperparametersResponse', (_message.Message,), {
+RolloutTarget = _reflection.GeneratedProtocolMessageType('RolloutTarget', (_message.Message,), {
+  'DESCRIPTOR' : _ROLLOUTTARGET,
+  '__module__' : 'repository_pb2'
+  # @@protoc_insertion_point(class_scope:api.RolloutTarget)
+  })
+_sym_db.RegisterMessage(RolloutTarget)
 
-  'HyperparametersEntry' : _reflection.GeneratedProtocolMessageType('HyperparametersEntry', (_message.Message,), {
-    'DESCRIPTOR' : _UPDATEHYPERPARAMETERSRESPONSE_HYPERPARAMETERSENTRY,
-    '__module__' : 'repository_pb2'
-    # @@protoc_insertion_point(class_scope:api.UpdateHyperparametersResponse.HyperparametersEntry)
-    })
-  ,
-  'DESCRIPTOR' : _UPDATEHYPERPARAMETERSRESPONSE,
+RampRolloutRequest = _reflection.GeneratedProtocolMessageType('RampRolloutRequest', (_message.Message,), {
+  'DESCRIPTOR' : _RAMPROLLOUTREQUEST,
   '__module__' : 'repository_pb2'
-  # @@protoc_insertion_point(class_scope:api.UpdateHyperparametersResponse)
+  # @@protoc_insertion_point(class_scope:api.RampRolloutRequest)
   })
-_sym_db.RegisterMessage(UpdateHyperparametersResponse)
-_sym_db.RegisterMessage(UpdateHyperparametersResponse.HyperparametersEntry)
+_sym_db.RegisterMessage(RampRolloutRequest)
 
-ListCheckpointsRequest = _reflection.GeneratedProtocolMessageType('ListCheckpointsRequest', (_message.Message,), {
-  'DESCRIPTOR' : _LISTCHECKPOINTSREQUEST,
+RampRolloutResponse = _reflection.GeneratedProtocolMessageType('RampRolloutResponse', (_message.Message,), {
+  'DESCRIPTOR' : _RAMPROLLOUTRESPONSE,
   '__module__' : 'repository_pb2'
-  # @@protoc_insertion_point(class_scope:api.ListCheckpointsRequest)
+  # @@protoc_insertion_point(class_scope:api.RampRolloutResponse)
   })
-_sym_db.RegisterMessage(ListCheckpointsRequest)
+_sym_db.RegisterMessage(RampRolloutResponse)
 
-ListCheckpointsResponse = _reflection.GeneratedProtocolMessageType('ListCheckpointsResponse', (_message.Message,), {
-  'DESCRIPTOR' : _LISTCHECKPOINTSRESPONSE,
+AbortRolloutRequest = _reflection.GeneratedProtocolMessageType('AbortRolloutRequest', (_message.Message,), {
+  'DESCRIPTOR' : _ABORTROLLOUTREQUEST,
   '__module__' : 'repository_pb2'
-  # @@protoc_insertion_point(class_scope:api.ListCheckpointsResponse)
+  # @@protoc_insertion_point(class_scope:api.AbortRolloutRequest)
   })
-_sym_db.RegisterMessage(ListCheckpointsResponse)
+_sym_db.RegisterMessage(AbortRolloutRequest)
 
-CreateCheckpointRequest = _reflection.GeneratedProtocolMessageType('CreateCheckpointRequest', (_message.Message,), {
+AbortRolloutResponse = _reflection.GeneratedProtocolMessageType('AbortRolloutResponse', (_message.Message,), {
+  'DESCRIPTOR' : _ABORTROLLOUTRESPONSE,
+  '__module__' : 'repository_pb2'
+  # @@protoc_insertion_point(class_scope:api.AbortRolloutResponse)
+  })
+_sym_db.RegisterMessage(AbortRolloutResponse)
 
-  'InfoEntry' : _reflection.GeneratedProtocolMessageType('InfoEntry', (_message.Message,), {
-    'DESCRIPTOR' : _CREATECHECKPOINTREQUEST_INFOENTRY,
-    '__module__' : 'repository_pb2'
-    # @@protoc_insertion_point(class_scope:api.CreateCheckpointRequest.InfoEntry)
-    })
-  ,
-  'DESCRIPTOR' : _CREATECHECKPOINTREQUEST,
+ResolveModelForClientRequest = _reflection.GeneratedProtocolMessageType('ResolveModelForClientRequest', (_message.Message,), {
+  'DESCRIPTOR' : _RESOLVEMODELFORCLIENTREQUEST,
   '__module__' : 'repository_pb2'
-  # @@protoc_insertion_point(class_scope:api.CreateCheckpointRequest)
+  # @@protoc_insertion_point(class_scope:api.ResolveModelForClientRequest)
   })
-_sym_db.RegisterMessage(CreateCheckpointRequest)
-_sym_db.RegisterMessage(CreateCheckpointRequest.InfoEntry)
+_sym_db.RegisterMessage(ResolveModelForClientRequest)
 
-CreateCheckpointResponse = _reflection.GeneratedProtocolMessageType('CreateCheckpointResponse', (_message.Message,), {
-  'DESCRIPTOR' : _CREATECHECKPOINTRESPONSE,
+ResolveModelForClientResponse = _reflection.GeneratedProtocolMessageType('ResolveModelForClientResponse', (_message.Message,), {
+  'DESCRIPTOR' : _RESOLVEMODELFORCLIENTRESPONSE,
   '__module__' : 'repository_pb2'
-  # @@protoc_insertion_point(class_scope:api.CreateCheckpointResponse)
+  # @@protoc_insertion_point(class_scope:api.ResolveModelForClientResponse)
   })
-_sym_db.RegisterMessage(CreateCheckpointResponse)
+_sym_db.RegisterMessage(ResolveModelForClientResponse)
 
-GetCheckpointRequest = _reflection.GeneratedProtocolMessageType('GetCheckpointRequest', (_message.Message,), {
-  'DESCRIPTOR' : _GETCHECKPOINTREQUEST,
+CompareCheckpointsRequest = _reflection.GeneratedProtocolMessageType('CompareCheckpointsRequest', (_message.Message,), {
+  'DESCRIPTOR' : _COMPARECHECKPOINTSREQUEST,
   '__module__' : 'repository_pb2'
-  # @@protoc_insertion_point(class_scope:api.GetCheckpointRequest)
+  # @@protoc_insertion_point(class_scope:api.CompareCheckpointsRequest)
   })
-_sym_db.RegisterMessage(GetCheckpointRequest)
+_sym_db.RegisterMessage(CompareCheckpointsRequest)
 
-GetCheckpointResponse = _reflection.GeneratedProtocolMessageType('GetCheckpointResponse', (_message.Message,), {
+CheckpointMetrics = _reflection.GeneratedProtocolMessageType('CheckpointMetrics', (_message.Message,), {
 
-  'InfoEntry' : _reflection.GeneratedProtocolMessageType('InfoEntry', (_message.Message,), {
-    'DESCRIPTOR' : _GETCHECKPOINTRESPONSE_INFOENTRY,
+  'ValuesEntry' : _reflection.GeneratedProtocolMessageType('ValuesEntry', (_message.Message,), {
+    'DESCRIPTOR' : _CHECKPOINTMETRICS_VALUESENTRY,
     '__module__' : 'repository_pb2'
-    # @@protoc_insertion_point(class_scope:api.GetCheckpointResponse.InfoEntry)
+    # @@protoc_insertion_point(class_scope:api.CheckpointMetrics.ValuesEntry)
     })
   ,
-  'DESCRIPTOR' : _GETCHECKPOINTRESPONSE,
+
+  'DeltasEntry' : _reflection.GeneratedProtocolMessageType('DeltasEntry', (_message.Message,), {
+    'DESCRIPTOR' : _CHECKPOINTMETRICS_DELTASENTRY,
+    '__module__' : 'repository_pb2'
+    # @@protoc_insertion_point(class_scope:api.CheckpointMetrics.DeltasEntry)
+    })
+  ,
+  'DESCRIPTOR' : _CHECKPOINTMETRICS,
   '__module__' : 'repository_pb2'
-  # @@protoc_insertion_point(class_scope:api.GetCheckpointResponse)
+  # @@protoc_insertion_point(class_scope:api.CheckpointMetrics)
   })
-_sym_db.RegisterMessage(GetCheckpointResponse)
-_sym_db.RegisterMessage(GetCheckpointResponse.InfoEntry)
+_sym_db.RegisterMessage(CheckpointMetrics)
+_sym_db.RegisterMessage(CheckpointMetrics.ValuesEntry)
+_sym_db.RegisterMessage(CheckpointMetrics.DeltasEntry)
+
+CompareCheckpointsResponse = _reflection.GeneratedProtocolMessageType('CompareCheckpointsResponse', (_message.Message,), {
+  'DESCRIPTOR' : _COMPARECHECKPOINTSRESPONSE,
+  '__module__' : 'repository_pb2'
+  # @@protoc_insertion_point(class_scope:api.CompareCheckpointsResponse)
+  })
+_sym_db.RegisterMessage(CompareCheckpointsResponse)
 
 
+_MODEL_LABELSENTRY._options = None
+_GETMODELRESPONSE_LABELSENTRY._options = None
 _CREATEHYPERPARAMETERSREQUEST_HYPERPARAMETERSENTRY._options = None
+_CREATEHYPERPARAMETERSREQUEST_LABELSENTRY._options = None
 _GETHYPERPARAMETERSRESPONSE_HYPERPARAMETERSENTRY._options = None
+_GETHYPERPARAMETERSRESPONSE_LABELSENTRY._options = None
 _UPDATEHYPERPARAMETERSREQUEST_HYPERPARAMETERSENTRY._options = None
+_UPDATEHYPERPARAMETERSREQUEST_LABELSENTRY._options = None
 _UPDATEHYPERPARAMETERSRESPONSE_HYPERPARAMETERSENTRY._options = None
+_UPDATEHYPERPARAMETERSRESPONSE_LABELSENTRY._options = None
 _CREATECHECKPOINTREQUEST_INFOENTRY._options = None
+_CREATECHECKPOINTREQUEST_LABELSENTRY._options = None
 _GETCHECKPOINTRESPONSE_INFOENTRY._options = None
+_GETCHECKPOINTRESPONSE_LABELSENTRY._options = None
+_CHECKPOINTMETRICS_VALUESENTRY._options = None
+_CHECKPOINTMETRICS_DELTASENTRY._options = None
 
 _REPOSITORY = _descriptor.ServiceDescriptor(
   name='Repository',
@@ -1721,8 +6016,8 @@ _REPOSITORY = _descriptor.ServiceDescriptor(
   file=DESCRIPTOR,
   index=0,
   serialized_options=None,
-  serialized_start=3037,
-  serialized_end=4793,
+  serialized_start=11206,
+  serialized_end=17184,
   methods=[
   _descriptor.MethodDescriptor(
     name='Healthz',
@@ -1769,19 +6064,37 @@ _REPOSITORY = _descriptor.ServiceDescriptor(
     output_type=_GETMODELRESPONSE,
     serialized_options=_b('\202\323\344\223\002!\022\037/v1/repository/models/{modelId}'),
   ),
+  _descriptor.MethodDescriptor(
+    name='ResolveModel',
+    full_name='api.Repository.ResolveModel',
+    index=5,
+    containing_service=None,
+    input_type=_RESOLVEMODELREQUEST,
+    output_type=_RESOLVEMODELRESPONSE,
+    serialized_options=_b('\202\323\344\223\002)\022\'/v1/repository/models/{modelId}/resolve'),
+  ),
   _descriptor.MethodDescriptor(
     name='UpdateModel',
     full_name='api.Repository.UpdateModel',
-    index=5,
+    index=6,
     containing_service=None,
     input_type=_UPDATEMODELREQUEST,
     output_type=_UPDATEMODELRESPONSE,
     serialized_options=_b('\202\323\344\223\002$\032\037/v1/repository/models/{modelId}:\001*'),
   ),
+  _descriptor.MethodDescriptor(
+    name='DeleteModel',
+    full_name='api.Repository.DeleteModel',
+    index=7,
+    containing_service=None,
+    input_type=_DELETEMODELREQUEST,
+    output_type=_DELETEMODELRESPONSE,
+    serialized_options=_b('\202\323\344\223\002!*\037/v1/repository/models/{modelId}'),
+  ),
   _descriptor.MethodDescriptor(
     name='ListHyperparameters',
     full_name='api.Repository.ListHyperparameters',
-    index=6,
+    index=8,
     containing_service=None,
     input_type=_LISTHYPERPARAMETERSREQUEST,
     output_type=_LISTHYPERPARAMETERSRESPONSE,
@@ -1790,7 +6103,7 @@ _REPOSITORY = _descriptor.ServiceDescriptor(
   _descriptor.MethodDescriptor(
     name='CreateHyperparameters',
     full_name='api.Repository.CreateHyperparameters',
-    index=7,
+    index=9,
     containing_service=None,
     input_type=_CREATEHYPERPARAMETERSREQUEST,
     output_type=_CREATEHYPERPARAMETERSRESPONSE,
@@ -1799,7 +6112,7 @@ _REPOSITORY = _descriptor.ServiceDescriptor(
   _descriptor.MethodDescriptor(
     name='GetHyperparameters',
     full_name='api.Repository.GetHyperparameters',
-    index=8,
+    index=10,
     containing_service=None,
     input_type=_GETHYPERPARAMETERSREQUEST,
     output_type=_GETHYPERPARAMETERSRESPONSE,
@@ -1808,16 +6121,25 @@ _REPOSITORY = _descriptor.ServiceDescriptor(
   _descriptor.MethodDescriptor(
     name='UpdateHyperparameters',
     full_name='api.Repository.UpdateHyperparameters',
-    index=9,
+    index=11,
     containing_service=None,
     input_type=_UPDATEHYPERPARAMETERSREQUEST,
     output_type=_UPDATEHYPERPARAMETERSRESPONSE,
     serialized_options=_b('\202\323\344\223\002H\032C/v1/repository/models/{modelId}/hyperparameters/{hyperparametersId}:\001*'),
   ),
+  _descriptor.MethodDescriptor(
+    name='DeleteHyperparameters',
+    full_name='api.Repository.DeleteHyperparameters',
+    index=12,
+    containing_service=None,
+    input_type=_DELETEHYPERPARAMETERSREQUEST,
+    output_type=_DELETEHYPERPARAMETERSRESPONSE,
+    serialized_options=_b('\202\323\344\223\002E*C/v1/repository/models/{modelId}/hyperparameters/{hyperparametersId}'),
+  ),
   _descriptor.MethodDescriptor(
     name='ListCheckpoints',
     full_name='api.Repository.ListCheckpoints',
-    index=10,
+    index=13,
     containing_service=None,
     input_type=_LISTCHECKPOINTSREQUEST,
     output_type=_LISTCHECKPOINTSRESPONSE,
@@ -1826,7 +6148,7 @@ _REPOSITORY = _descriptor.ServiceDescriptor(
   _descriptor.MethodDescriptor(
     name='CreateCheckpoint',
     full_name='api.Repository.CreateCheckpoint',
-    index=11,
+    index=14,
     containing_service=None,
     input_type=_CREATECHECKPOINTREQUEST,
     output_type=_CREATECHECKPOINTRESPONSE,
@@ -1835,12 +6157,219 @@ _REPOSITORY = _descriptor.ServiceDescriptor(
   _descriptor.MethodDescriptor(
     name='GetCheckpoint',
     full_name='api.Repository.GetCheckpoint',
-    index=12,
+    index=15,
     containing_service=None,
     input_type=_GETCHECKPOINTREQUEST,
     output_type=_GETCHECKPOINTRESPONSE,
     serialized_options=_b('\202\323\344\223\002`\022^/v1/repository/models/{modelId}/hyperparameters/{hyperparametersId}/checkpoints/{checkpointId}'),
   ),
+  _descriptor.MethodDescriptor(
+    name='GetCheckpointManifest',
+    full_name='api.Repository.GetCheckpointManifest',
+    index=16,
+    containing_service=None,
+    input_type=_GETCHECKPOINTMANIFESTREQUEST,
+    output_type=_GETCHECKPOINTMANIFESTRESPONSE,
+    serialized_options=_b('\202\323\344\223\002i\022g/v1/repository/models/{modelId}/hyperparameters/{hyperparametersId}/checkpoints/{checkpointId}/manifest'),
+  ),
+  _descriptor.MethodDescriptor(
+    name='UpgradeCheck',
+    full_name='api.Repository.UpgradeCheck',
+    index=17,
+    containing_service=None,
+    input_type=_UPGRADECHECKREQUEST,
+    output_type=_UPGRADECHECKRESPONSE,
+    serialized_options=_b('\202\323\344\223\002h\022f/v1/repository/models/{modelId}/hyperparameters/{hyperparametersId}/checkpoints/{checkpointId}/upgrade'),
+  ),
+  _descriptor.MethodDescriptor(
+    name='FinalizeCheckpoint',
+    full_name='api.Repository.FinalizeCheckpoint',
+    index=18,
+    containing_service=None,
+    input_type=_FINALIZECHECKPOINTREQUEST,
+    output_type=_FINALIZECHECKPOINTRESPONSE,
+    serialized_options=_b('\202\323\344\223\002l\"g/v1/repository/models/{modelId}/hyperparameters/{hyperparametersId}/checkpoints/{checkpointId}/finalize:\001*'),
+  ),
+  _descriptor.MethodDescriptor(
+    name='UpdateCheckpointState',
+    full_name='api.Repository.UpdateCheckpointState',
+    index=19,
+    containing_service=None,
+    input_type=_UPDATECHECKPOINTSTATEREQUEST,
+    output_type=_UPDATECHECKPOINTSTATERESPONSE,
+    serialized_options=_b('\202\323\344\223\002i\032d/v1/repository/models/{modelId}/hyperparameters/{hyperparametersId}/checkpoints/{checkpointId}/state:\001*'),
+  ),
+  _descriptor.MethodDescriptor(
+    name='DeleteCheckpoint',
+    full_name='api.Repository.DeleteCheckpoint',
+    index=20,
+    containing_service=None,
+    input_type=_DELETECHECKPOINTREQUEST,
+    output_type=_DELETECHECKPOINTRESPONSE,
+    serialized_options=_b('\202\323\344\223\002`*^/v1/repository/models/{modelId}/hyperparameters/{hyperparametersId}/checkpoints/{checkpointId}'),
+  ),
+  _descriptor.MethodDescriptor(
+    name='Fsck',
+    full_name='api.Repository.Fsck',
+    index=21,
+    containing_service=None,
+    input_type=_FSCKREQUEST,
+    output_type=_FSCKRESPONSE,
+    serialized_options=_b('\202\323\344\223\002\025\022\023/v1/repository/fsck'),
+  ),
+  _descriptor.MethodDescriptor(
+    name='ListAuditEvents',
+    full_name='api.Repository.ListAuditEvents',
+    index=22,
+    containing_service=None,
+    input_type=_LISTAUDITEVENTSREQUEST,
+    output_type=_LISTAUDITEVENTSRESPONSE,
+    serialized_options=_b('\202\323\344\223\002\026\022\024/v1/repository/audit'),
+  ),
+  _descriptor.MethodDescriptor(
+    name='CreateWebhook',
+    full_name='api.Repository.CreateWebhook',
+    index=23,
+    containing_service=None,
+    input_type=_CREATEWEBHOOKREQUEST,
+    output_type=_CREATEWEBHOOKRESPONSE,
+    serialized_options=_b('\202\323\344\223\002\034\"\027/v1/repository/webhooks:\001*'),
+  ),
+  _descriptor.MethodDescriptor(
+    name='ListWebhooks',
+    full_name='api.Repository.ListWebhooks',
+    index=24,
+    containing_service=None,
+    input_type=_LISTWEBHOOKSREQUEST,
+    output_type=_LISTWEBHOOKSRESPONSE,
+    serialized_options=_b('\202\323\344\223\002\031\022\027/v1/repository/webhooks'),
+  ),
+  _descriptor.MethodDescriptor(
+    name='DeleteWebhook',
+    full_name='api.Repository.DeleteWebhook',
+    index=25,
+    containing_service=None,
+    input_type=_DELETEWEBHOOKREQUEST,
+    output_type=_DELETEWEBHOOKRESPONSE,
+    serialized_options=_b('\202\323\344\223\002%*#/v1/repository/webhooks/{webhookId}'),
+  ),
+  _descriptor.MethodDescriptor(
+    name='ListWebhookDeadLetters',
+    full_name='api.Repository.ListWebhookDeadLetters',
+    index=26,
+    containing_service=None,
+    input_type=_LISTWEBHOOKDEADLETTERSREQUEST,
+    output_type=_LISTWEBHOOKDEADLETTERSRESPONSE,
+    serialized_options=_b('\202\323\344\223\002%\022#/v1/repository/webhook-dead-letters'),
+  ),
+  _descriptor.MethodDescriptor(
+    name='ListRevisions',
+    full_name='api.Repository.ListRevisions',
+    index=27,
+    containing_service=None,
+    input_type=_LISTREVISIONSREQUEST,
+    output_type=_LISTREVISIONSRESPONSE,
+    serialized_options=_b('\202\323\344\223\002|\022)/v1/repository/models/{modelId}/revisionsZO\022M/v1/repository/models/{modelId}/hyperparameters/{hyperparametersId}/revisions'),
+  ),
+  _descriptor.MethodDescriptor(
+    name='GetRevision',
+    full_name='api.Repository.GetRevision',
+    index=28,
+    containing_service=None,
+    input_type=_GETREVISIONREQUEST,
+    output_type=_GETREVISIONRESPONSE,
+    serialized_options=_b('\202\323\344\223\002\220\001\0223/v1/repository/models/{modelId}/revisions/{version}ZY\022W/v1/repository/models/{modelId}/hyperparameters/{hyperparametersId}/revisions/{version}'),
+  ),
+  _descriptor.MethodDescriptor(
+    name='Rollback',
+    full_name='api.Repository.Rollback',
+    index=29,
+    containing_service=None,
+    input_type=_ROLLBACKREQUEST,
+    output_type=_ROLLBACKRESPONSE,
+    serialized_options=_b('\202\323\344\223\002\250\001\"</v1/repository/models/{modelId}/revisions/{version}/rollback:\001*Ze\"`/v1/repository/models/{modelId}/hyperparameters/{hyperparametersId}/revisions/{version}/rollback:\001*'),
+  ),
+  _descriptor.MethodDescriptor(
+    name='WatchModel',
+    full_name='api.Repository.WatchModel',
+    index=30,
+    containing_service=None,
+    input_type=_WATCHMODELREQUEST,
+    output_type=_WATCHMODELEVENT,
+    serialized_options=_b('\202\323\344\223\002\'\022%/v1/repository/models/{modelId}/watch'),
+  ),
+  _descriptor.MethodDescriptor(
+    name='SetTag',
+    full_name='api.Repository.SetTag',
+    index=31,
+    containing_service=None,
+    input_type=_SETTAGREQUEST,
+    output_type=_SETTAGRESPONSE,
+    serialized_options=_b('\202\323\344\223\002\204\001\032*/v1/repository/models/{modelId}/tags/{tag}:\001*ZS\032N/v1/repository/models/{modelId}/hyperparameters/{hyperparametersId}/tags/{tag}:\001*'),
+  ),
+  _descriptor.MethodDescriptor(
+    name='GetTag',
+    full_name='api.Repository.GetTag',
+    index=32,
+    containing_service=None,
+    input_type=_GETTAGREQUEST,
+    output_type=_GETTAGRESPONSE,
+    serialized_options=_b('\202\323\344\223\002~\022*/v1/repository/models/{modelId}/tags/{tag}ZP\022N/v1/repository/models/{modelId}/hyperparameters/{hyperparametersId}/tags/{tag}'),
+  ),
+  _descriptor.MethodDescriptor(
+    name='ListTags',
+    full_name='api.Repository.ListTags',
+    index=33,
+    containing_service=None,
+    input_type=_LISTTAGSREQUEST,
+    output_type=_LISTTAGSRESPONSE,
+    serialized_options=_b('\202\323\344\223\002r\022$/v1/repository/models/{modelId}/tagsZJ\022H/v1/repository/models/{modelId}/hyperparameters/{hyperparametersId}/tags'),
+  ),
+  _descriptor.MethodDescriptor(
+    name='DeleteTag',
+    full_name='api.Repository.DeleteTag',
+    index=34,
+    containing_service=None,
+    input_type=_DELETETAGREQUEST,
+    output_type=_DELETETAGRESPONSE,
+    serialized_options=_b('\202\323\344\223\002~**/v1/repository/models/{modelId}/tags/{tag}ZP*N/v1/repository/models/{modelId}/hyperparameters/{hyperparametersId}/tags/{tag}'),
+  ),
+  _descriptor.MethodDescriptor(
+    name='RampRollout',
+    full_name='api.Repository.RampRollout',
+    index=35,
+    containing_service=None,
+    input_type=_RAMPROLLOUTREQUEST,
+    output_type=_RAMPROLLOUTRESPONSE,
+    serialized_options=_b('\202\323\344\223\002P\032K/v1/repository/models/{modelId}/hyperparameters/{hyperparametersId}/rollout:\001*'),
+  ),
+  _descriptor.MethodDescriptor(
+    name='AbortRollout',
+    full_name='api.Repository.AbortRollout',
+    index=36,
+    containing_service=None,
+    input_type=_ABORTROLLOUTREQUEST,
+    output_type=_ABORTROLLOUTRESPONSE,
+    serialized_options=_b('\202\323\344\223\002M*K/v1/repository/models/{modelId}/hyperparameters/{hyperparametersId}/rollout'),
+  ),
+  _descriptor.MethodDescriptor(
+    name='ResolveModelForClient',
+    full_name='api.Repository.ResolveModelForClient',
+    index=37,
+    containing_service=None,
+    input_type=_RESOLVEMODELFORCLIENTREQUEST,
+    output_type=_RESOLVEMODELFORCLIENTRESPONSE,
+    serialized_options=_b('\202\323\344\223\002<\022:/v1/repository/models/{modelId}/clients/{clientId}/resolve'),
+  ),
+  _descriptor.MethodDescriptor(
+    name='CompareCheckpoints',
+    full_name='api.Repository.CompareCheckpoints',
+    index=38,
+    containing_service=None,
+    input_type=_COMPARECHECKPOINTSREQUEST,
+    output_type=_COMPARECHECKPOINTSRESPONSE,
+    serialized_options=_b('\202\323\344\223\002M\022K/v1/repository/models/{modelId}/hyperparameters/{hyperparametersId}/compare'),
+  ),
 ])
 _sym_db.RegisterServiceDescriptor(_REPOSITORY)
 
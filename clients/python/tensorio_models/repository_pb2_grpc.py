@@ -39,11 +39,21 @@ class RepositoryStub(object):
         request_serializer=repository__pb2.GetModelRequest.SerializeToString,
         response_deserializer=repository__pb2.GetModelResponse.FromString,
         )
+    self.ResolveModel = channel.unary_unary(
+        '/api.Repository/ResolveModel',
+        request_serializer=repository__pb2.ResolveModelRequest.SerializeToString,
+        response_deserializer=repository__pb2.ResolveModelResponse.FromString,
+        )
     self.UpdateModel = channel.unary_unary(
         '/api.Repository/UpdateModel',
         request_serializer=repository__pb2.UpdateModelRequest.SerializeToString,
         response_deserializer=repository__pb2.UpdateModelResponse.FromString,
         )
+    self.DeleteModel = channel.unary_unary(
+        '/api.Repository/DeleteModel',
+        request_serializer=repository__pb2.DeleteModelRequest.SerializeToString,
+        response_deserializer=repository__pb2.DeleteModelResponse.FromString,
+        )
     self.ListHyperparameters = channel.unary_unary(
         '/api.Repository/ListHyperparameters',
         request_serializer=repository__pb2.ListHyperparametersRequest.SerializeToString,
@@ -64,6 +74,11 @@ class RepositoryStub(object):
         request_serializer=repository__pb2.UpdateHyperparametersRequest.SerializeToString,
         response_deserializer=repository__pb2.UpdateHyperparametersResponse.FromString,
         )
+    self.DeleteHyperparameters = channel.unary_unary(
+        '/api.Repository/DeleteHyperparameters',
+        request_serializer=repository__pb2.DeleteHyperparametersRequest.SerializeToString,
+        response_deserializer=repository__pb2.DeleteHyperparametersResponse.FromString,
+        )
     self.ListCheckpoints = channel.unary_unary(
         '/api.Repository/ListCheckpoints',
         request_serializer=repository__pb2.ListCheckpointsRequest.SerializeToString,
@@ -79,6 +94,121 @@ class RepositoryStub(object):
         request_serializer=repository__pb2.GetCheckpointRequest.SerializeToString,
         response_deserializer=repository__pb2.GetCheckpointResponse.FromString,
         )
+    self.GetCheckpointManifest = channel.unary_unary(
+        '/api.Repository/GetCheckpointManifest',
+        request_serializer=repository__pb2.GetCheckpointManifestRequest.SerializeToString,
+        response_deserializer=repository__pb2.GetCheckpointManifestResponse.FromString,
+        )
+    self.UpgradeCheck = channel.unary_unary(
+        '/api.Repository/UpgradeCheck',
+        request_serializer=repository__pb2.UpgradeCheckRequest.SerializeToString,
+        response_deserializer=repository__pb2.UpgradeCheckResponse.FromString,
+        )
+    self.FinalizeCheckpoint = channel.unary_unary(
+        '/api.Repository/FinalizeCheckpoint',
+        request_serializer=repository__pb2.FinalizeCheckpointRequest.SerializeToString,
+        response_deserializer=repository__pb2.FinalizeCheckpointResponse.FromString,
+        )
+    self.UpdateCheckpointState = channel.unary_unary(
+        '/api.Repository/UpdateCheckpointState',
+        request_serializer=repository__pb2.UpdateCheckpointStateRequest.SerializeToString,
+        response_deserializer=repository__pb2.UpdateCheckpointStateResponse.FromString,
+        )
+    self.DeleteCheckpoint = channel.unary_unary(
+        '/api.Repository/DeleteCheckpoint',
+        request_serializer=repository__pb2.DeleteCheckpointRequest.SerializeToString,
+        response_deserializer=repository__pb2.DeleteCheckpointResponse.FromString,
+        )
+    self.Fsck = channel.unary_unary(
+        '/api.Repository/Fsck',
+        request_serializer=repository__pb2.FsckRequest.SerializeToString,
+        response_deserializer=repository__pb2.FsckResponse.FromString,
+        )
+    self.ListAuditEvents = channel.unary_unary(
+        '/api.Repository/ListAuditEvents',
+        request_serializer=repository__pb2.ListAuditEventsRequest.SerializeToString,
+        response_deserializer=repository__pb2.ListAuditEventsResponse.FromString,
+        )
+    self.CreateWebhook = channel.unary_unary(
+        '/api.Repository/CreateWebhook',
+        request_serializer=repository__pb2.CreateWebhookRequest.SerializeToString,
+        response_deserializer=repository__pb2.CreateWebhookResponse.FromString,
+        )
+    self.ListWebhooks = channel.unary_unary(
+        '/api.Repository/ListWebhooks',
+        request_serializer=repository__pb2.ListWebhooksRequest.SerializeToString,
+        response_deserializer=repository__pb2.ListWebhooksResponse.FromString,
+        )
+    self.DeleteWebhook = channel.unary_unary(
+        '/api.Repository/DeleteWebhook',
+        request_serializer=repository__pb2.DeleteWebhookRequest.SerializeToString,
+        response_deserializer=repository__pb2.DeleteWebhookResponse.FromString,
+        )
+    self.ListWebhookDeadLetters = channel.unary_unary(
+        '/api.Repository/ListWebhookDeadLetters',
+        request_serializer=repository__pb2.ListWebhookDeadLettersRequest.SerializeToString,
+        response_deserializer=repository__pb2.ListWebhookDeadLettersResponse.FromString,
+        )
+    self.ListRevisions = channel.unary_unary(
+        '/api.Repository/ListRevisions',
+        request_serializer=repository__pb2.ListRevisionsRequest.SerializeToString,
+        response_deserializer=repository__pb2.ListRevisionsResponse.FromString,
+        )
+    self.GetRevision = channel.unary_unary(
+        '/api.Repository/GetRevision',
+        request_serializer=repository__pb2.GetRevisionRequest.SerializeToString,
+        response_deserializer=repository__pb2.GetRevisionResponse.FromString,
+        )
+    self.Rollback = channel.unary_unary(
+        '/api.Repository/Rollback',
+        request_serializer=repository__pb2.RollbackRequest.SerializeToString,
+        response_deserializer=repository__pb2.RollbackResponse.FromString,
+        )
+    self.WatchModel = channel.unary_stream(
+        '/api.Repository/WatchModel',
+        request_serializer=repository__pb2.WatchModelRequest.SerializeToString,
+        response_deserializer=repository__pb2.WatchModelEvent.FromString,
+        )
+    self.SetTag = channel.unary_unary(
+        '/api.Repository/SetTag',
+        request_serializer=repository__pb2.SetTagRequest.SerializeToString,
+        response_deserializer=repository__pb2.SetTagResponse.FromString,
+        )
+    self.GetTag = channel.unary_unary(
+        '/api.Repository/GetTag',
+        request_serializer=repository__pb2.GetTagRequest.SerializeToString,
+        response_deserializer=repository__pb2.GetTagResponse.FromString,
+        )
+    self.ListTags = channel.unary_unary(
+        '/api.Repository/ListTags',
+        request_serializer=repository__pb2.ListTagsRequest.SerializeToString,
+        response_deserializer=repository__pb2.ListTagsResponse.FromString,
+        )
+    self.DeleteTag = channel.unary_unary(
+        '/api.Repository/DeleteTag',
+        request_serializer=repository__pb2.DeleteTagRequest.SerializeToString,
+        response_deserializer=repository__pb2.DeleteTagResponse.FromString,
+        )
+    self.RampRollout = channel.unary_unary(
+        '/api.Repository/RampRollout',
+        request_serializer=repository__pb2.RampRolloutRequest.SerializeToString,
+        response_deserializer=repository__pb2.RampRolloutResponse.FromString,
+        )
+    self.AbortRollout = channel.unary_unary(
+        '/api.Repository/AbortRollout',
+        request_serializer=repository__pb2.AbortRolloutRequest.SerializeToString,
+        response_deserializer=repository__pb2.AbortRolloutResponse.FromString,
+        )
+    self.ResolveModelForClient = channel.unary_unary(
+        '/api.Repository/ResolveModelForClient',
+        request_serializer=repository__pb2.ResolveModelForClientRequest.SerializeToString,
+        response_deserializer=repository__pb2.ResolveModelForClientResponse.FromString,
+        )
+    self.CompareCheckpoints = channel.unary_unary(
+        '/api.Repository/CompareCheckpoints',
+        request_serializer=repository__pb2.CompareCheckpointsRequest.SerializeToString,
+        response_deserializer=repository__pb2.CompareCheckpointsResponse.FromString,
+        )
 
 
 class RepositoryServicer(object):
@@ -120,6 +250,13 @@ class RepositoryServicer(object):
     context.set_details('Method not implemented!')
     raise NotImplementedError('Method not implemented!')
 
+  def ResolveModel(self, request, context):
+    # missing associated documentation comment in .proto file
+    pass
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
   def UpdateModel(self, request, context):
     # missing associated documentation comment in .proto file
     pass
@@ -127,6 +264,13 @@ class RepositoryServicer(object):
     context.set_details('Method not implemented!')
     raise NotImplementedError('Method not implemented!')
 
+  def DeleteModel(self, request, context):
+    # missing associated documentation comment in .proto file
+    pass
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
   def ListHyperparameters(self, request, context):
     # missing associated documentation comment in .proto file
     pass
@@ -155,6 +299,13 @@ class RepositoryServicer(object):
     context.set_details('Method not implemented!')
     raise NotImplementedError('Method not implemented!')
 
+  def DeleteHyperparameters(self, request, context):
+    # missing associated documentation comment in .proto file
+    pass
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
   def ListCheckpoints(self, request, context):
     # missing associated documentation comment in .proto file
     pass
@@ -176,6 +327,167 @@ class RepositoryServicer(object):
     context.set_details('Method not implemented!')
     raise NotImplementedError('Method not implemented!')
 
+  def GetCheckpointManifest(self, request, context):
+    # missing associated documentation comment in .proto file
+    pass
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
+  def UpgradeCheck(self, request, context):
+    # missing associated documentation comment in .proto file
+    pass
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
+  def FinalizeCheckpoint(self, request, context):
+    # missing associated documentation comment in .proto file
+    pass
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
+  def UpdateCheckpointState(self, request, context):
+    # missing associated documentation comment in .proto file
+    pass
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
+  def DeleteCheckpoint(self, request, context):
+    # missing associated documentation comment in .proto file
+    pass
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
+  def Fsck(self, request, context):
+    # missing associated documentation comment in .proto file
+    pass
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
+  def ListAuditEvents(self, request, context):
+    # missing associated documentation comment in .proto file
+    pass
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
+  def CreateWebhook(self, request, context):
+    # missing associated documentation comment in .proto file
+    pass
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
+  def ListWebhooks(self, request, context):
+    # missing associated documentation comment in .proto file
+    pass
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
+  def DeleteWebhook(self, request, context):
+    # missing associated documentation comment in .proto file
+    pass
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
+  def ListWebhookDeadLetters(self, request, context):
+    # missing associated documentation comment in .proto file
+    pass
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
+  def ListRevisions(self, request, context):
+    # missing associated documentation comment in .proto file
+    pass
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
+  def GetRevision(self, request, context):
+    # missing associated documentation comment in .proto file
+    pass
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
+  def Rollback(self, request, context):
+    # missing associated documentation comment in .proto file
+    pass
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
+  def WatchModel(self, request, context):
+    # missing associated documentation comment in .proto file
+    pass
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
+  def SetTag(self, request, context):
+    # missing associated documentation comment in .proto file
+    pass
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
+  def GetTag(self, request, context):
+    # missing associated documentation comment in .proto file
+    pass
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
+  def ListTags(self, request, context):
+    # missing associated documentation comment in .proto file
+    pass
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
+  def DeleteTag(self, request, context):
+    # missing associated documentation comment in .proto file
+    pass
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
+  def RampRollout(self, request, context):
+    # missing associated documentation comment in .proto file
+    pass
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
+  def AbortRollout(self, request, context):
+    # missing associated documentation comment in .proto file
+    pass
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
+  def ResolveModelForClient(self, request, context):
+    # missing associated documentation comment in .proto file
+    pass
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
+  def CompareCheckpoints(self, request, context):
+    # missing associated documentation comment in .proto file
+    pass
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
 
 def add_RepositoryServicer_to_server(servicer, server):
   rpc_method_handlers = {
@@ -204,11 +516,21 @@ def add_RepositoryServicer_to_server(servicer, server):
           request_deserializer=repository__pb2.GetModelRequest.FromString,
           response_serializer=repository__pb2.GetModelResponse.SerializeToString,
       ),
+      'ResolveModel': grpc.unary_unary_rpc_method_handler(
+          servicer.ResolveModel,
+          request_deserializer=repository__pb2.ResolveModelRequest.FromString,
+          response_serializer=repository__pb2.ResolveModelResponse.SerializeToString,
+      ),
       'UpdateModel': grpc.unary_unary_rpc_method_handler(
           servicer.UpdateModel,
           request_deserializer=repository__pb2.UpdateModelRequest.FromString,
           response_serializer=repository__pb2.UpdateModelResponse.SerializeToString,
       ),
+      'DeleteModel': grpc.unary_unary_rpc_method_handler(
+          servicer.DeleteModel,
+          request_deserializer=repository__pb2.DeleteModelRequest.FromString,
+          response_serializer=repository__pb2.DeleteModelResponse.SerializeToString,
+      ),
       'ListHyperparameters': grpc.unary_unary_rpc_method_handler(
           servicer.ListHyperparameters,
           request_deserializer=repository__pb2.ListHyperparametersRequest.FromString,
@@ -229,6 +551,11 @@ def add_RepositoryServicer_to_server(servicer, server):
           request_deserializer=repository__pb2.UpdateHyperparametersRequest.FromString,
           response_serializer=repository__pb2.UpdateHyperparametersResponse.SerializeToString,
       ),
+      'DeleteHyperparameters': grpc.unary_unary_rpc_method_handler(
+          servicer.DeleteHyperparameters,
+          request_deserializer=repository__pb2.DeleteHyperparametersRequest.FromString,
+          response_serializer=repository__pb2.DeleteHyperparametersResponse.SerializeToString,
+      ),
       'ListCheckpoints': grpc.unary_unary_rpc_method_handler(
           servicer.ListCheckpoints,
           request_deserializer=repository__pb2.ListCheckpointsRequest.FromString,
@@ -244,6 +571,121 @@ def add_RepositoryServicer_to_server(servicer, server):
           request_deserializer=repository__pb2.GetCheckpointRequest.FromString,
           response_serializer=repository__pb2.GetCheckpointResponse.SerializeToString,
       ),
+      'GetCheckpointManifest': grpc.unary_unary_rpc_method_handler(
+          servicer.GetCheckpointManifest,
+          request_deserializer=repository__pb2.GetCheckpointManifestRequest.FromString,
+          response_serializer=repository__pb2.GetCheckpointManifestResponse.SerializeToString,
+      ),
+      'UpgradeCheck': grpc.unary_unary_rpc_method_handler(
+          servicer.UpgradeCheck,
+          request_deserializer=repository__pb2.UpgradeCheckRequest.FromString,
+          response_serializer=repository__pb2.UpgradeCheckResponse.SerializeToString,
+      ),
+      'FinalizeCheckpoint': grpc.unary_unary_rpc_method_handler(
+          servicer.FinalizeCheckpoint,
+          request_deserializer=repository__pb2.FinalizeCheckpointRequest.FromString,
+          response_serializer=repository__pb2.FinalizeCheckpointResponse.SerializeToString,
+      ),
+      'UpdateCheckpointState': grpc.unary_unary_rpc_method_handler(
+          servicer.UpdateCheckpointState,
+          request_deserializer=repository__pb2.UpdateCheckpointStateRequest.FromString,
+          response_serializer=repository__pb2.UpdateCheckpointStateResponse.SerializeToString,
+      ),
+      'DeleteCheckpoint': grpc.unary_unary_rpc_method_handler(
+          servicer.DeleteCheckpoint,
+          request_deserializer=repository__pb2.DeleteCheckpointRequest.FromString,
+          response_serializer=repository__pb2.DeleteCheckpointResponse.SerializeToString,
+      ),
+      'Fsck': grpc.unary_unary_rpc_method_handler(
+          servicer.Fsck,
+          request_deserializer=repository__pb2.FsckRequest.FromString,
+          response_serializer=repository__pb2.FsckResponse.SerializeToString,
+      ),
+      'ListAuditEvents': grpc.unary_unary_rpc_method_handler(
+          servicer.ListAuditEvents,
+          request_deserializer=repository__pb2.ListAuditEventsRequest.FromString,
+          response_serializer=repository__pb2.ListAuditEventsResponse.SerializeToString,
+      ),
+      'CreateWebhook': grpc.unary_unary_rpc_method_handler(
+          servicer.CreateWebhook,
+          request_deserializer=repository__pb2.CreateWebhookRequest.FromString,
+          response_serializer=repository__pb2.CreateWebhookResponse.SerializeToString,
+      ),
+      'ListWebhooks': grpc.unary_unary_rpc_method_handler(
+          servicer.ListWebhooks,
+          request_deserializer=repository__pb2.ListWebhooksRequest.FromString,
+          response_serializer=repository__pb2.ListWebhooksResponse.SerializeToString,
+      ),
+      'DeleteWebhook': grpc.unary_unary_rpc_method_handler(
+          servicer.DeleteWebhook,
+          request_deserializer=repository__pb2.DeleteWebhookRequest.FromString,
+          response_serializer=repository__pb2.DeleteWebhookResponse.SerializeToString,
+      ),
+      'ListWebhookDeadLetters': grpc.unary_unary_rpc_method_handler(
+          servicer.ListWebhookDeadLetters,
+          request_deserializer=repository__pb2.ListWebhookDeadLettersRequest.FromString,
+          response_serializer=repository__pb2.ListWebhookDeadLettersResponse.SerializeToString,
+      ),
+      'ListRevisions': grpc.unary_unary_rpc_method_handler(
+          servicer.ListRevisions,
+          request_deserializer=repository__pb2.ListRevisionsRequest.FromString,
+          response_serializer=repository__pb2.ListRevisionsResponse.SerializeToString,
+      ),
+      'GetRevision': grpc.unary_unary_rpc_method_handler(
+          servicer.GetRevision,
+          request_deserializer=repository__pb2.GetRevisionRequest.FromString,
+          response_serializer=repository__pb2.GetRevisionResponse.SerializeToString,
+      ),
+      'Rollback': grpc.unary_unary_rpc_method_handler(
+          servicer.Rollback,
+          request_deserializer=repository__pb2.RollbackRequest.FromString,
+          response_serializer=repository__pb2.RollbackResponse.SerializeToString,
+      ),
+      'WatchModel': grpc.unary_stream_rpc_method_handler(
+          servicer.WatchModel,
+          request_deserializer=repository__pb2.WatchModelRequest.FromString,
+          response_serializer=repository__pb2.WatchModelEvent.SerializeToString,
+      ),
+      'SetTag': grpc.unary_unary_rpc_method_handler(
+          servicer.SetTag,
+          request_deserializer=repository__pb2.SetTagRequest.FromString,
+          response_serializer=repository__pb2.SetTagResponse.SerializeToString,
+      ),
+      'GetTag': grpc.unary_unary_rpc_method_handler(
+          servicer.GetTag,
+          request_deserializer=repository__pb2.GetTagRequest.FromString,
+          response_serializer=repository__pb2.GetTagResponse.SerializeToString,
+      ),
+      'ListTags': grpc.unary_unary_rpc_method_handler(
+          servicer.ListTags,
+          request_deserializer=repository__pb2.ListTagsRequest.FromString,
+          response_serializer=repository__pb2.ListTagsResponse.SerializeToString,
+      ),
+      'DeleteTag': grpc.unary_unary_rpc_method_handler(
+          servicer.DeleteTag,
+          request_deserializer=repository__pb2.DeleteTagRequest.FromString,
+          response_serializer=repository__pb2.DeleteTagResponse.SerializeToString,
+      ),
+      'RampRollout': grpc.unary_unary_rpc_method_handler(
+          servicer.RampRollout,
+          request_deserializer=repository__pb2.RampRolloutRequest.FromString,
+          response_serializer=repository__pb2.RampRolloutResponse.SerializeToString,
+      ),
+      'AbortRollout': grpc.unary_unary_rpc_method_handler(
+          servicer.AbortRollout,
+          request_deserializer=repository__pb2.AbortRolloutRequest.FromString,
+          response_serializer=repository__pb2.AbortRolloutResponse.SerializeToString,
+      ),
+      'ResolveModelForClient': grpc.unary_unary_rpc_method_handler(
+          servicer.ResolveModelForClient,
+          request_deserializer=repository__pb2.ResolveModelForClientRequest.FromString,
+          response_serializer=repository__pb2.ResolveModelForClientResponse.SerializeToString,
+      ),
+      'CompareCheckpoints': grpc.unary_unary_rpc_method_handler(
+          servicer.CompareCheckpoints,
+          request_deserializer=repository__pb2.CompareCheckpointsRequest.FromString,
+          response_serializer=repository__pb2.CompareCheckpointsResponse.SerializeToString,
+      ),
   }
   generic_handler = grpc.method_handlers_generic_handler(
       'api.Repository', rpc_method_handlers)
@@ -10,8 +10,11 @@ import (
 	"github.com/doc-ai/tensorio-models/authentication"
 	"github.com/doc-ai/tensorio-models/flea_server"
 	"github.com/doc-ai/tensorio-models/storage"
+	"github.com/doc-ai/tensorio-models/storage/boltdb"
+	"github.com/doc-ai/tensorio-models/storage/filesystem"
 	"github.com/doc-ai/tensorio-models/storage/gcs"
 	"github.com/doc-ai/tensorio-models/storage/memory"
+	"github.com/doc-ai/tensorio-models/storage/s3"
 	log "github.com/sirupsen/logrus"
 )
 
@@ -19,8 +22,11 @@ func main() {
 	/* BEGIN cli */
 	// Backend specification
 	Backends := map[string]func(string) storage.FleaStorage{
-		"memory": memory.NewMemoryFleaStorage,
-		"gcs":    gcs.GenerateNewFleaGCSStorageFromEnv,
+		"memory":     memory.NewMemoryFleaStorage,
+		"gcs":        gcs.GenerateNewFleaGCSStorageFromEnv,
+		"filesystem": filesystem.GenerateNewFleaFilesystemStorageFromEnv,
+		"s3":         s3.GenerateNewFleaS3StorageFromEnv,
+		"boltdb":     boltdb.GenerateNewFleaBoltStorageFromEnv,
 	}
 	BackendKeys := make([]string, len(Backends))
 	i := 0
@@ -73,8 +79,21 @@ func main() {
 			TokenTypeToSet: tokenTypeToSet,
 		})
 	}
+	// Tasks are only checked against the lifecycle state of their checkpoints if FLEA is given a
+	// token with which to read from the repository.
+	// With FLEA_CHECKPOINT_DOWNLOAD_LINKS=true, GetTask also uses it to return the links of
+	// checkpoints (which the repository signs if it is configured to) rather than their URLs.
+	var checkpointStates flea_server.CheckpointStates
+	var checkpointLinks flea_server.CheckpointLinks
+	modelsReaderToken := os.Getenv("MODELS_READER_TOKEN")
+	if modelsReaderToken != "" {
+		checkpointStates = flea_server.NewRepositoryCheckpointStates(modelsURI, modelsReaderToken)
+		if os.Getenv("FLEA_CHECKPOINT_DOWNLOAD_LINKS") == "true" {
+			checkpointLinks = flea_server.NewRepositoryCheckpointLinks(modelsURI, modelsReaderToken)
+		}
+	}
 	const grpcAddress = ":8082"
 	const jsonRpcAddress = ":8083"
 	flea_server.StartGrpcAndProxyServer(fleaBackend,
-		grpcAddress, jsonRpcAddress, auth, make(chan string))
+		grpcAddress, jsonRpcAddress, auth, checkpointStates, checkpointLinks, make(chan string))
 }
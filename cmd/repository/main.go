@@ -5,21 +5,29 @@ import (
 	"flag"
 	"fmt"
 	"github.com/doc-ai/tensorio-models/authentication"
+	"github.com/doc-ai/tensorio-models/manifest"
 	"github.com/doc-ai/tensorio-models/server"
 	"github.com/doc-ai/tensorio-models/storage"
+	"github.com/doc-ai/tensorio-models/storage/boltdb"
+	"github.com/doc-ai/tensorio-models/storage/filesystem"
 	"github.com/doc-ai/tensorio-models/storage/gcs"
 	"github.com/doc-ai/tensorio-models/storage/memory"
+	"github.com/doc-ai/tensorio-models/storage/s3"
 	log "github.com/sirupsen/logrus"
 	"os"
 	"strings"
+	"time"
 )
 
 func main() {
 	/* BEGIN cli */
 	// Backend specification
 	Backends := map[string]func() storage.RepositoryStorage{
-		"memory": memory.NewMemoryRepositoryStorage,
-		"gcs":    gcs.GenerateNewGCSStorageFromEnv,
+		"memory":     memory.NewMemoryRepositoryStorage,
+		"gcs":        gcs.GenerateNewGCSStorageFromEnv,
+		"filesystem": filesystem.GenerateNewFilesystemStorageFromEnv,
+		"s3":         s3.GenerateNewS3StorageFromEnv,
+		"boltdb":     boltdb.GenerateNewBoltStorageFromEnv,
 	}
 	BackendKeys := make([]string, len(Backends))
 	i := 0
@@ -67,8 +75,24 @@ func main() {
 			TokenTypeToSet: tokenTypeToSet,
 		})
 	}
+	// Checkpoint links are only signed if CHECKPOINT_LINK_EXPIRY is set (e.g. to 15m).
+	var linkExpiry time.Duration
+	if expiry := os.Getenv("CHECKPOINT_LINK_EXPIRY"); expiry != "" {
+		var err error
+		linkExpiry, err = time.ParseDuration(expiry)
+		if err != nil {
+			log.Fatalf("Invalid CHECKPOINT_LINK_EXPIRY (%s): %v", expiry, err)
+		}
+	}
+	// Checkpoint manifests are only signed if MANIFEST_SIGNING_KEY_ID and MANIFEST_SIGNING_KEY are set.
+	manifestSigner, err := manifest.NewSignerFromEnv()
+	if err != nil {
+		log.Fatalf("Invalid manifest signing key: %v", err)
+	}
+	// Checkpoint bundles are only validated if CHECKPOINT_BUNDLE_VALIDATION is "true".
+	validateBundles := os.Getenv("CHECKPOINT_BUNDLE_VALIDATION") == "true"
 	const grpcAddress = ":8080"
 	const jsonRpcAddress = ":8081"
 	server.StartGrpcAndProxyServer(repositoryBackend,
-		grpcAddress, jsonRpcAddress, auth, make(chan string))
+		grpcAddress, jsonRpcAddress, auth, linkExpiry, manifestSigner, validateBundles, make(chan string))
 }
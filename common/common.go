@@ -1,8 +1,9 @@
 package common
 
 import (
+	"encoding/base64"
+	"errors"
 	"fmt"
-	"strings"
 )
 
 // IsValidIDChar - returns whether c is a valid character - alphanumeric, _ and -
@@ -33,20 +34,45 @@ func IsValidID(s string) bool {
 	return s != ""
 }
 
+// GetModelResourcePath - returns path of resource
+func GetModelResourcePath(modelID string) string {
+	return fmt.Sprintf("/models/%s", modelID)
+}
+
+// GetHyperparametersResourcePath - returns path of resource
+func GetHyperparametersResourcePath(modelID, hyperparametersID string) string {
+	return fmt.Sprintf("/models/%s/hyperparameters/%s", modelID, hyperparametersID)
+}
+
 // GetCheckpointResourcePath - returns path of resource
 func GetCheckpointResourcePath(modelID, hyperparametersID, checkpointID string) string {
 	resourcePath := fmt.Sprintf("/models/%s/hyperparameters/%s/checkpoints/%s", modelID, hyperparametersID, checkpointID)
 	return resourcePath
 }
 
-// GetTerminalResourceFromStoragePath -
-// RepositoryStorage implementations return resources in the form:
-// <modelId>, <modelId>:<hyperparametersId>, <modelId>:<hyperparametersId>:<checkpointId>
-// This function takes input in those formats and returns (respectively):
-// <modelId>, <hyperparametersId>, <checkpointId>
-func GetTerminalResourceFromStoragePath(storagePath string) string {
-	storageDelimiter := ":"
-	components := strings.Split(storagePath, storageDelimiter)
-	terminalComponent := components[len(components)-1]
-	return terminalComponent
+// GetTagResourcePath - returns path of a tag on a model, or on hyperparameters if hyperparametersID
+// is set
+func GetTagResourcePath(modelID, hyperparametersID, tag string) string {
+	if hyperparametersID == "" {
+		return fmt.Sprintf("/models/%s/tags/%s", modelID, tag)
+	}
+	return fmt.Sprintf("/models/%s/hyperparameters/%s/tags/%s", modelID, hyperparametersID, tag)
+}
+
+// EncodePageToken - turns the marker from which the next page of a listing starts into an opaque
+// page token for list responses.
+func EncodePageToken(marker string) string {
+	if marker == "" {
+		return ""
+	}
+	return base64.RawURLEncoding.EncodeToString([]byte(marker))
+}
+
+// DecodePageToken - recovers the marker from a page token created by EncodePageToken.
+func DecodePageToken(pageToken string) (string, error) {
+	marker, err := base64.RawURLEncoding.DecodeString(pageToken)
+	if err != nil {
+		return "", errors.New("Invalid page token")
+	}
+	return string(marker), nil
 }
@@ -52,21 +52,21 @@ curl -X POST \
 
 echo
 
-echo "Setting checkpoint-1 as canonicalCheckpoint for hyperparameters-1 and hyperparameters-2 as its upgrade..."
-curl -X PUT \
+echo "Creating hyperparameters-2"
+curl -X POST \
     -H "Authorization: Bearer $API_TOKEN" \
     -H "Content-Type: application/json" \
-    -d '{"canonicalCheckpoint": "checkpoint-1", "upgradeTo": "hyperparameters-2"}' \
-    $API_URL/v1/repository/models/$MODEL/hyperparameters/hyperparameters-1
+    -d '{"hyperparametersId": "hyperparameters-2", "hyperparameters": {"lol": "wtf"}}' \
+    $API_URL/v1/repository/models/$MODEL/hyperparameters
 
 echo
 
-echo "Creating hyperparameters-2"
-curl -X POST \
+echo "Setting checkpoint-1 as canonicalCheckpoint for hyperparameters-1 and hyperparameters-2 as its upgrade..."
+curl -X PUT \
     -H "Authorization: Bearer $API_TOKEN" \
     -H "Content-Type: application/json" \
-    -d '{"hyperparametersId": "hyperparameters-2", "hyperparameters": {"lol": "wtf"}}' \
-    $API_URL/v1/repository/models/$MODEL/hyperparameters
+    -d '{"canonicalCheckpoint": "checkpoint-1", "upgradeTo": "hyperparameters-2"}' \
+    $API_URL/v1/repository/models/$MODEL/hyperparameters/hyperparameters-1
 
 echo
 
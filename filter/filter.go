@@ -0,0 +1,198 @@
+package filter
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// ErrEmptyFilter - returned by Parse for filters which contain nothing but whitespace.
+var ErrEmptyFilter = errors.New("Filter is empty")
+
+// Filter - a parsed filter expression.
+//
+// Filters are comparisons of the form `field op value`, where op is one of =, !=, <, <=, > or >=,
+// joined by AND and OR (AND binds more tightly than OR). Values containing spaces or operator
+// characters have to be double quoted. If both sides of a comparison are numbers they are compared
+// numerically, otherwise they are compared as strings. Comparisons against fields which are not
+// set are false, whatever the operator.
+//
+// For example: info.accuracy>0.9 AND label.team=vision
+type Filter struct {
+	// Any of the conjunctions has to match.
+	disjunction [][]comparison
+}
+
+type comparison struct {
+	field string
+	op    string
+	value string
+}
+
+// Parse - parses a filter expression.
+func Parse(expression string) (*Filter, error) {
+	tokens, err := tokenize(expression)
+	if err != nil {
+		return nil, err
+	}
+	if len(tokens) == 0 {
+		return nil, ErrEmptyFilter
+	}
+
+	filter := &Filter{}
+	var conjunction []comparison
+	for len(tokens) > 0 {
+		if len(tokens) < 3 || tokens[0].kind != word || tokens[1].kind != operator || tokens[2].kind == operator ||
+			(tokens[2].kind == word && isKeyword(tokens[2].text)) {
+			return nil, fmt.Errorf("Expected a comparison at \"%s\"", remainder(tokens))
+		}
+		if isKeyword(tokens[0].text) {
+			return nil, fmt.Errorf("Expected a field name at \"%s\"", remainder(tokens))
+		}
+		conjunction = append(conjunction, comparison{field: tokens[0].text, op: tokens[1].text, value: tokens[2].text})
+		tokens = tokens[3:]
+
+		if len(tokens) == 0 {
+			break
+		}
+		if tokens[0].kind != word || !isKeyword(tokens[0].text) || len(tokens) == 1 {
+			return nil, fmt.Errorf("Expected AND or OR followed by a comparison at \"%s\"", remainder(tokens))
+		}
+		if tokens[0].text == "OR" {
+			filter.disjunction = append(filter.disjunction, conjunction)
+			conjunction = nil
+		}
+		tokens = tokens[1:]
+	}
+	filter.disjunction = append(filter.disjunction, conjunction)
+
+	return filter, nil
+}
+
+// Matches - evaluates the filter against the fields of a resource.
+func (f *Filter) Matches(fields map[string]string) bool {
+	for _, conjunction := range f.disjunction {
+		matches := true
+		for _, c := range conjunction {
+			if !c.matches(fields) {
+				matches = false
+				break
+			}
+		}
+		if matches {
+			return true
+		}
+	}
+	return false
+}
+
+func (c comparison) matches(fields map[string]string) bool {
+	actual, ok := fields[c.field]
+	if !ok {
+		return false
+	}
+
+	var cmp int
+	actualNumber, actualErr := strconv.ParseFloat(actual, 64)
+	expectedNumber, expectedErr := strconv.ParseFloat(c.value, 64)
+	if actualErr == nil && expectedErr == nil {
+		switch {
+		case actualNumber < expectedNumber:
+			cmp = -1
+		case actualNumber > expectedNumber:
+			cmp = 1
+		}
+	} else {
+		cmp = strings.Compare(actual, c.value)
+	}
+
+	switch c.op {
+	case "=":
+		return cmp == 0
+	case "!=":
+		return cmp != 0
+	case "<":
+		return cmp < 0
+	case "<=":
+		return cmp <= 0
+	case ">":
+		return cmp > 0
+	default:
+		return cmp >= 0
+	}
+}
+
+type tokenKind int
+
+const (
+	word tokenKind = iota
+	quoted
+	operator
+)
+
+type token struct {
+	kind tokenKind
+	text string
+	// The offset of the token in the expression, for error messages.
+	pos        int
+	expression string
+}
+
+func isKeyword(text string) bool {
+	return text == "AND" || text == "OR"
+}
+
+func isOperatorChar(c rune) bool {
+	return c == '=' || c == '!' || c == '<' || c == '>'
+}
+
+func remainder(tokens []token) string {
+	return tokens[0].expression[tokens[0].pos:]
+}
+
+func tokenize(expression string) ([]token, error) {
+	var tokens []token
+	runes := []rune(expression)
+	for i := 0; i < len(runes); {
+		c := runes[i]
+		start := i
+		switch {
+		case unicode.IsSpace(c):
+			i++
+			continue
+		case c == '"':
+			var text strings.Builder
+			i++
+			for ; i < len(runes) && runes[i] != '"'; i++ {
+				if runes[i] == '\\' && i+1 < len(runes) {
+					i++
+				}
+				text.WriteRune(runes[i])
+			}
+			if i == len(runes) {
+				return nil, fmt.Errorf("Unterminated string at \"%s\"", string(runes[start:]))
+			}
+			i++
+			tokens = append(tokens, token{kind: quoted, text: text.String(), pos: len(string(runes[:start])), expression: expression})
+		case isOperatorChar(c):
+			for i < len(runes) && isOperatorChar(runes[i]) {
+				i++
+			}
+			op := string(runes[start:i])
+			switch op {
+			case "=", "!=", "<", "<=", ">", ">=":
+			default:
+				return nil, fmt.Errorf("Unknown operator \"%s\"", op)
+			}
+			tokens = append(tokens, token{kind: operator, text: op, pos: len(string(runes[:start])), expression: expression})
+		default:
+			for i < len(runes) && !unicode.IsSpace(runes[i]) && !isOperatorChar(runes[i]) && runes[i] != '"' {
+				i++
+			}
+			tokens = append(tokens, token{kind: word, text: string(runes[start:i]), pos: len(string(runes[:start])), expression: expression})
+		}
+	}
+	return tokens, nil
+}
@@ -0,0 +1,74 @@
+package filter
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMatches(t *testing.T) {
+	fields := map[string]string{
+		"modelId":       "selfie",
+		"label.team":    "vision",
+		"label.owner":   "ada lovelace",
+		"info.accuracy": "0.93",
+		"info.epochs":   "12",
+	}
+
+	tests := []struct {
+		expression string
+		matches    bool
+	}{
+		{"label.team=vision", true},
+		{"label.team = vision", true},
+		{"label.team!=vision", false},
+		{"info.accuracy>0.9", true},
+		{"info.accuracy>=0.93", true},
+		{"info.accuracy<0.9", false},
+		{"info.accuracy<=0.930", true},
+		// numbers are compared as numbers, not as strings
+		{"info.epochs>9", true},
+		{"info.epochs=12.0", true},
+		// anything else is compared as strings
+		{"modelId<sleepy", true},
+		{"info.accuracy>0.9 AND label.team=vision", true},
+		{"info.accuracy>0.95 AND label.team=vision", false},
+		{"info.accuracy>0.95 OR label.team=vision", true},
+		{"label.team=audio OR info.accuracy>0.95", false},
+		{"label.team=audio AND info.epochs>1 OR label.team=vision AND info.epochs>1", true},
+		{`label.owner="ada lovelace"`, true},
+		{`label.owner="ada \"the countess\" lovelace"`, false},
+		// missing fields never match
+		{"label.missing=vision", false},
+		{"label.missing!=vision", false},
+	}
+
+	for _, test := range tests {
+		filter, err := Parse(test.expression)
+		if !assert.NoError(t, err, test.expression) {
+			continue
+		}
+		assert.Equal(t, test.matches, filter.Matches(fields), test.expression)
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	_, err := Parse("   ")
+	assert.Equal(t, ErrEmptyFilter, err)
+
+	for _, expression := range []string{
+		"label.team",
+		"label.team=",
+		"=vision",
+		"label.team==vision",
+		"label.team=vision AND",
+		"label.team=vision label.owner=ada",
+		"label.team=vision AND OR label.owner=ada",
+		"AND=vision",
+		`label.owner="ada`,
+		"label.team=>vision",
+	} {
+		_, err := Parse(expression)
+		assert.Error(t, err, expression)
+	}
+}
@@ -0,0 +1,98 @@
+package flea_server
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/doc-ai/tensorio-models/api"
+	"github.com/doc-ai/tensorio-models/common"
+	"github.com/golang/protobuf/jsonpb"
+)
+
+// CheckpointStates - looks up the lifecycle state of checkpoints in the model repository, so that
+// tasks are not created against archived checkpoints.
+type CheckpointStates interface {
+	GetCheckpointState(ctx context.Context, modelId, hyperparametersId, checkpointId string) (api.CheckpointState, error)
+}
+
+// CheckpointLinks - looks up the links of checkpoints in the model repository, so that devices can
+// download checkpoint bundles without going through the repository themselves.
+type CheckpointLinks interface {
+	GetCheckpointLink(ctx context.Context, modelId, hyperparametersId, checkpointId string) (string, error)
+}
+
+type repositoryCheckpointStates struct {
+	repositoryBaseURL string
+	authToken         string
+	client            *http.Client
+}
+
+// NewRepositoryCheckpointStates - returns CheckpointStates which fetch checkpoints from the REST
+// API of the repository at repositoryBaseURL (the MODELS_URI), authenticating with a ModelsReader
+// token. URLs without a scheme are assumed to be http.
+func NewRepositoryCheckpointStates(repositoryBaseURL, authToken string) CheckpointStates {
+	return newRepositoryCheckpointStates(repositoryBaseURL, authToken)
+}
+
+func newRepositoryCheckpointStates(repositoryBaseURL, authToken string) *repositoryCheckpointStates {
+	if !strings.Contains(repositoryBaseURL, "://") {
+		repositoryBaseURL = "http://" + repositoryBaseURL
+	}
+	return &repositoryCheckpointStates{
+		repositoryBaseURL: strings.TrimSuffix(repositoryBaseURL, "/"),
+		authToken:         authToken,
+		client:            &http.Client{},
+	}
+}
+
+// NewRepositoryCheckpointLinks - returns CheckpointLinks which fetch checkpoints from the REST API
+// of the repository at repositoryBaseURL, like NewRepositoryCheckpointStates. The links are
+// whatever the repository's GetCheckpoint returns, so they are signed download URLs if the
+// repository is configured to sign links.
+func NewRepositoryCheckpointLinks(repositoryBaseURL, authToken string) CheckpointLinks {
+	return newRepositoryCheckpointStates(repositoryBaseURL, authToken)
+}
+
+func (states repositoryCheckpointStates) GetCheckpointState(ctx context.Context, modelId, hyperparametersId, checkpointId string) (api.CheckpointState, error) {
+	checkpoint, err := states.getCheckpoint(ctx, modelId, hyperparametersId, checkpointId)
+	if err != nil {
+		return api.CheckpointState_ACTIVE, err
+	}
+	return checkpoint.State, nil
+}
+
+func (states repositoryCheckpointStates) GetCheckpointLink(ctx context.Context, modelId, hyperparametersId, checkpointId string) (string, error) {
+	checkpoint, err := states.getCheckpoint(ctx, modelId, hyperparametersId, checkpointId)
+	if err != nil {
+		return "", err
+	}
+	return checkpoint.Link, nil
+}
+
+func (states repositoryCheckpointStates) getCheckpoint(ctx context.Context, modelId, hyperparametersId, checkpointId string) (api.GetCheckpointResponse, error) {
+	checkpoint := api.GetCheckpointResponse{}
+	url := states.repositoryBaseURL + common.GetCheckpointResourcePath(modelId, hyperparametersId, checkpointId)
+	req, err := http.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return checkpoint, err
+	}
+	req = req.WithContext(ctx)
+	if states.authToken != "" {
+		req.Header.Set("Authorization", "Bearer "+states.authToken)
+	}
+
+	resp, err := states.client.Do(req)
+	if err != nil {
+		return checkpoint, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return checkpoint, fmt.Errorf("Could not get checkpoint from repository: %s returned %s", url, resp.Status)
+	}
+
+	unmarshaler := jsonpb.Unmarshaler{AllowUnknownFields: true}
+	err = unmarshaler.Unmarshal(resp.Body, &checkpoint)
+	return checkpoint, err
+}
@@ -3,31 +3,55 @@ package flea_server
 import (
 	"context"
 	"errors"
+	"fmt"
 	"net"
 	"net/http"
+	"time"
 
 	"github.com/doc-ai/tensorio-models/api"
+	"github.com/doc-ai/tensorio-models/audit"
 	"github.com/doc-ai/tensorio-models/authentication"
 	"github.com/doc-ai/tensorio-models/common"
 	"github.com/doc-ai/tensorio-models/storage"
+	"github.com/doc-ai/tensorio-models/storage/boltdb"
+	"github.com/doc-ai/tensorio-models/storage/filesystem"
 	"github.com/doc-ai/tensorio-models/storage/gcs"
 	"github.com/doc-ai/tensorio-models/storage/memory"
+	"github.com/doc-ai/tensorio-models/storage/s3"
+	"github.com/doc-ai/tensorio-models/webhook"
+	"github.com/golang/protobuf/proto"
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
 	log "github.com/sirupsen/logrus"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
+// webhookTimeout - how long each attempt to deliver a change to a webhook may take.
+const webhookTimeout = 10 * time.Second
+
+// webhookEventTypes - the methods whose changes can be delivered to webhooks.
+var webhookEventTypes = []string{"CreateTask", "ModifyTask", "StartTask", "JobError"}
+
 type flea_server struct {
-	storage       storage.FleaStorage
-	authenticator authentication.Authenticator
+	storage          storage.FleaStorage
+	authenticator    authentication.Authenticator
+	checkpointStates CheckpointStates
+	checkpointLinks  CheckpointLinks
+	webhooks         *webhook.Dispatcher
 }
 
 // NewServer - Creates an api.FleaServer which handles gRPC requests using a given
-// storage.FleaStorage backend
-func NewServer(storage storage.FleaStorage, authenticator authentication.Authenticator) api.FleaServer {
+// storage.FleaStorage backend. If checkpointStates is not nil, it is used to refuse tasks for
+// archived checkpoints. If checkpointLinks is not nil, GetTask returns the links of checkpoints in
+// the repository as checkpointLink, rather than the URLs of the checkpoints themselves.
+func NewServer(storage storage.FleaStorage, authenticator authentication.Authenticator, checkpointStates CheckpointStates, checkpointLinks CheckpointLinks) api.FleaServer {
 	return &flea_server{
-		storage:       storage,
-		authenticator: authenticator,
+		storage:          storage,
+		authenticator:    authenticator,
+		checkpointStates: checkpointStates,
+		checkpointLinks:  checkpointLinks,
+		webhooks:         webhook.NewDispatcher(storage, &http.Client{Timeout: webhookTimeout}, webhook.DefaultRetryPolicy),
 	}
 }
 
@@ -84,7 +108,12 @@ func CreateMethodToTokenTypeMap() authentication.MethodToAuthenticationTokenType
 		"/api.Flea/CreateTask": FleaTaskGen,
 		"/api.Flea/ModifyTask": FleaTaskGen,
 
-		"/api.Flea/Admin": FleaAdmin,
+		"/api.Flea/Admin":                  FleaAdmin,
+		"/api.Flea/ListAuditEvents":        FleaAdmin,
+		"/api.Flea/CreateWebhook":          FleaAdmin,
+		"/api.Flea/ListWebhooks":           FleaAdmin,
+		"/api.Flea/DeleteWebhook":          FleaAdmin,
+		"/api.Flea/ListWebhookDeadLetters": FleaAdmin,
 
 		"/api.Flea/GetTask":   FleaClient,
 		"/api.Flea/ListTasks": FleaClient,
@@ -99,8 +128,10 @@ func CreateMethodToTokenTypeMap() authentication.MethodToAuthenticationTokenType
 func StartGrpcAndProxyServer(storage storage.FleaStorage,
 	grpcServerAddress string, jsonServerAddress string,
 	authenticator authentication.Authenticator,
+	checkpointStates CheckpointStates,
+	checkpointLinks CheckpointLinks,
 	stopRequested <-chan string) {
-	apiServer := NewServer(storage, authenticator)
+	apiServer := NewServer(storage, authenticator, checkpointStates, checkpointLinks)
 	authInterceptor := authentication.CreateGRPCInterceptor(authenticator,
 		CreateMethodToTokenTypeMap(),
 	)
@@ -125,6 +156,12 @@ func (srv *flea_server) Config(ctx context.Context, req *api.ConfigRequest) (*ap
 		storageTypeEnum = api.ConfigResponse_MEMORY
 	case gcs.StorageType:
 		storageTypeEnum = api.ConfigResponse_GOOGLE_CLOUD_STORAGE
+	case filesystem.StorageType:
+		storageTypeEnum = api.ConfigResponse_FILESYSTEM
+	case s3.StorageType:
+		storageTypeEnum = api.ConfigResponse_S3
+	case boltdb.StorageType:
+		storageTypeEnum = api.ConfigResponse_BOLTDB
 	}
 	resp := &api.ConfigResponse{
 		BackendType: storageTypeEnum,
@@ -140,6 +177,8 @@ func (srv *flea_server) Admin(ctx context.Context, req *api.AdminRequest) (*api.
 	if err != nil {
 		return nil, err
 	}
+	// The tokens themselves are never recorded.
+	srv.recordChange(ctx, "Admin", "/tokens", req, nil, nil)
 	return &api.GenericResponse{Message: "Updated Authentication Tokens"}, nil
 }
 
@@ -152,6 +191,7 @@ func (srv *flea_server) JobError(ctx context.Context, req *api.JobErrorRequest)
 	if err != nil {
 		return nil, err
 	}
+	srv.recordChange(ctx, "JobError", jobResourcePath(req.TaskId, req.JobId), req, nil, nil)
 	return &api.GenericResponse{Message: "Thank you for the error report."}, nil
 }
 
@@ -180,22 +220,47 @@ func (srv *flea_server) CreateTask(ctx context.Context, req *api.TaskDetails) (*
 	if !common.IsValidID(req.TaskId) {
 		return nil, storage.ErrInvalidTaskId
 	}
+	if srv.checkpointStates != nil {
+		state, err := srv.checkpointStates.GetCheckpointState(ctx, req.ModelId, req.HyperparametersId, req.CheckpointId)
+		if err != nil {
+			log.Printf("ERROR: %v", err)
+			return nil, status.Error(codes.Unavailable, "Could not look up checkpoint in repository")
+		}
+		switch state {
+		case api.CheckpointState_ARCHIVED:
+			return nil, status.Error(codes.FailedPrecondition, storage.ErrArchivedCheckpoint.Error())
+		case api.CheckpointState_PENDING:
+			return nil, status.Error(codes.FailedPrecondition, storage.ErrPendingCheckpoint.Error())
+		}
+	}
 	err := srv.storage.AddTask(ctx, *req)
 	if err != nil {
 		return nil, err
 	}
 	resp, err := srv.storage.GetTask(ctx, req.TaskId)
-	return &resp, err
+	if err != nil {
+		return nil, err
+	}
+	srv.recordChange(ctx, "CreateTask", taskResourcePath(req.TaskId), req, nil, &resp)
+	return &resp, nil
 }
 
 func (srv *flea_server) ModifyTask(ctx context.Context, req *api.ModifyTaskRequest) (*api.TaskDetails, error) {
+	var storedTask *api.TaskDetails
+	if task, err := srv.storage.GetTask(ctx, req.TaskId); err == nil {
+		storedTask = &task
+	}
 	err := srv.storage.ModifyTask(ctx, *req)
 	if err != nil {
 		return nil, err
 	}
 	// Can't call srv.GetTask because it authenticates against a diff token.
 	resp, err := srv.storage.GetTask(ctx, req.TaskId)
-	return &resp, err
+	if err != nil {
+		return nil, err
+	}
+	srv.recordChange(ctx, "ModifyTask", taskResourcePath(req.TaskId), req, storedTask, &resp)
+	return &resp, nil
 }
 
 func (srv *flea_server) ListTasks(ctx context.Context, req *api.ListTasksRequest) (*api.ListTasksResponse, error) {
@@ -205,21 +270,107 @@ func (srv *flea_server) ListTasks(ctx context.Context, req *api.ListTasksRequest
 	if req.HyperparametersId != "" && req.ModelId == "" {
 		return nil, storage.ErrInvalidModelHyperparamsCheckpointCombo
 	}
-	resp, err := srv.storage.ListTasks(ctx, *req)
-	return &resp, err
+	listReq := *req
+	if req.PageToken != "" {
+		startTaskId, err := common.DecodePageToken(req.PageToken)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		listReq.StartTaskId = startTaskId
+	}
+	resp, err := srv.storage.ListTasks(ctx, listReq)
+	if err != nil || req.View != api.ListView_FULL {
+		return &resp, err
+	}
+	tasks, err := srv.storage.BatchGetTasks(ctx, resp.TaskIds)
+	if err != nil {
+		return nil, err
+	}
+	resp.Tasks = make([]*api.TaskDetails, len(tasks))
+	for i := range tasks {
+		resp.Tasks[i] = &tasks[i]
+	}
+	return &resp, nil
 }
 
 func (srv *flea_server) GetTask(ctx context.Context, req *api.GetTaskRequest) (*api.TaskDetails, error) {
 	resp, err := srv.storage.GetTask(ctx, req.TaskId)
-	return &resp, err
+	if err != nil || srv.checkpointLinks == nil || req.RawLink {
+		return &resp, err
+	}
+	link, err := srv.checkpointLinks.GetCheckpointLink(ctx, resp.ModelId, resp.HyperparametersId, resp.CheckpointId)
+	if err != nil {
+		log.Printf("ERROR: %v", err)
+		return nil, status.Error(codes.Unavailable, "Could not look up checkpoint link in repository")
+	}
+	resp.CheckpointLink = link
+	return &resp, nil
 }
 
 func (srv *flea_server) StartTask(ctx context.Context, req *api.StartTaskRequest) (*api.StartTaskResponse, error) {
 	resp, err := srv.storage.StartTask(ctx, req.TaskId)
-	return &resp, err
+	if err != nil {
+		return nil, err
+	}
+	if resp.Status == api.StartTaskResponse_APPROVED {
+		// The upload URL grants write access, so it is left out of the audit log.
+		job := &api.StartTaskResponse{Status: resp.Status, JobId: resp.JobId}
+		srv.recordChange(ctx, "StartTask", jobResourcePath(req.TaskId, resp.JobId), req, nil, job)
+	}
+	return &resp, nil
 }
 
 // This does nothing, except that if authenticated, dump the message in the server log.
 func (srv *flea_server) Log(ctx context.Context, req *api.LogRequest) (*api.GenericResponse, error) {
 	return &api.GenericResponse{Message: "Ok"}, nil
 }
+
+// ListAuditEvents - lists the changes made through the API, optionally only those to a given
+// resource (and the resources below it) within a given time range.
+func (srv *flea_server) ListAuditEvents(ctx context.Context, req *api.ListAuditEventsRequest) (*api.ListAuditEventsResponse, error) {
+	return audit.ListEvents(ctx, srv.storage, req)
+}
+
+// CreateWebhook - registers a URL which changes of the given event types are POSTed to.
+func (srv *flea_server) CreateWebhook(ctx context.Context, req *api.CreateWebhookRequest) (*api.CreateWebhookResponse, error) {
+	resp, err := webhook.CreateWebhook(ctx, srv.storage, webhookEventTypes, req)
+	if err != nil {
+		return nil, err
+	}
+	srv.recordChange(ctx, "CreateWebhook", webhook.ResourcePath(resp.Webhook.WebhookId), webhook.Redact(req), nil, resp.Webhook)
+	return resp, nil
+}
+
+func (srv *flea_server) ListWebhooks(ctx context.Context, req *api.ListWebhooksRequest) (*api.ListWebhooksResponse, error) {
+	return webhook.ListWebhooks(ctx, srv.storage, req)
+}
+
+func (srv *flea_server) DeleteWebhook(ctx context.Context, req *api.DeleteWebhookRequest) (*api.DeleteWebhookResponse, error) {
+	resp, err := webhook.DeleteWebhook(ctx, srv.storage, req)
+	if err != nil {
+		return nil, err
+	}
+	srv.recordChange(ctx, "DeleteWebhook", webhook.ResourcePath(req.WebhookId), req, resp.Webhook, nil)
+	return resp, nil
+}
+
+// ListWebhookDeadLetters - lists the deliveries to webhooks which failed on every attempt, in the
+// order in which the changes were made.
+func (srv *flea_server) ListWebhookDeadLetters(ctx context.Context, req *api.ListWebhookDeadLettersRequest) (*api.ListWebhookDeadLettersResponse, error) {
+	return webhook.ListDeadLetters(ctx, srv.storage, req)
+}
+
+// recordChange - adds a change made by the given Flea method to the audit log, and delivers it to
+// the webhooks which subscribe to it.
+func (srv *flea_server) recordChange(ctx context.Context, method, resourcePath string, req proto.Message, before, after interface{}) {
+	event := audit.Record(ctx, srv.storage, "/api.Flea/"+method, resourcePath, req, before, after)
+	srv.webhooks.Dispatch(event)
+}
+
+func taskResourcePath(taskId string) string {
+	return fmt.Sprintf("/tasks/%s", taskId)
+}
+
+func jobResourcePath(taskId, jobId string) string {
+	return fmt.Sprintf("/tasks/%s/jobs/%s", taskId, jobId)
+}
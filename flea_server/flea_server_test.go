@@ -0,0 +1,263 @@
+package flea_server
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/doc-ai/tensorio-models/api"
+	"github.com/doc-ai/tensorio-models/authentication"
+	"github.com/doc-ai/tensorio-models/storage/memory"
+	"github.com/doc-ai/tensorio-models/webhook"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Serves GetCheckpoint responses the way the repository's JSON gateway does.
+func testingRepository(t *testing.T) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Bearer ReaderToken", r.Header.Get("Authorization"))
+		switch r.URL.Path {
+		case "/v1/repository/models/model/hyperparameters/hp/checkpoints/active":
+			w.Write([]byte(`{"modelId":"model","hyperparametersId":"hp","checkpointId":"active","state":"ACTIVE","link":"https://signed.example.com/active.zip?signature=abc"}`))
+		case "/v1/repository/models/model/hyperparameters/hp/checkpoints/archived":
+			w.Write([]byte(`{"modelId":"model","hyperparametersId":"hp","checkpointId":"archived","state":"ARCHIVED"}`))
+		case "/v1/repository/models/model/hyperparameters/hp/checkpoints/pending":
+			w.Write([]byte(`{"modelId":"model","hyperparametersId":"hp","checkpointId":"pending","state":"PENDING"}`))
+		default:
+			http.Error(w, "Not Found", http.StatusNotFound)
+		}
+	}))
+}
+
+func TestCreateTaskChecksCheckpointState(t *testing.T) {
+	repository := testingRepository(t)
+	defer repository.Close()
+
+	repositoryBaseURL := repository.URL + "/v1/repository"
+	srv := NewServer(memory.NewMemoryFleaStorage(repositoryBaseURL), nil,
+		NewRepositoryCheckpointStates(repositoryBaseURL, "ReaderToken"), nil)
+	ctx := context.Background()
+
+	task := api.TaskDetails{
+		ModelId:           "model",
+		HyperparametersId: "hp",
+		CheckpointId:      "active",
+		TaskId:            "task-1",
+		Active:            true,
+	}
+	resp, err := srv.CreateTask(ctx, &task)
+	assert.NoError(t, err)
+	assert.Equal(t, "task-1", resp.TaskId)
+
+	task.CheckpointId = "archived"
+	task.TaskId = "task-2"
+	_, err = srv.CreateTask(ctx, &task)
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	task.CheckpointId = "pending"
+	task.TaskId = "task-3"
+	_, err = srv.CreateTask(ctx, &task)
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	task.CheckpointId = "missing"
+	task.TaskId = "task-4"
+	_, err = srv.CreateTask(ctx, &task)
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+}
+
+func TestGetTaskCheckpointLinks(t *testing.T) {
+	repository := testingRepository(t)
+	defer repository.Close()
+
+	repositoryBaseURL := repository.URL + "/v1/repository"
+	srv := NewServer(memory.NewMemoryFleaStorage(repositoryBaseURL), nil, nil,
+		NewRepositoryCheckpointLinks(repositoryBaseURL, "ReaderToken"))
+	ctx := context.Background()
+
+	for _, checkpointId := range []string{"active", "missing"} {
+		_, err := srv.CreateTask(ctx, &api.TaskDetails{
+			ModelId:           "model",
+			HyperparametersId: "hp",
+			CheckpointId:      checkpointId,
+			TaskId:            "task-" + checkpointId,
+			Active:            true,
+		})
+		assert.NoError(t, err)
+	}
+
+	task, err := srv.GetTask(ctx, &api.GetTaskRequest{TaskId: "task-active"})
+	assert.NoError(t, err)
+	assert.Equal(t, "https://signed.example.com/active.zip?signature=abc", task.CheckpointLink)
+
+	task, err = srv.GetTask(ctx, &api.GetTaskRequest{TaskId: "task-active", RawLink: true})
+	assert.NoError(t, err)
+	assert.Equal(t, repositoryBaseURL+"/models/model/hyperparameters/hp/checkpoints/active", task.CheckpointLink)
+
+	_, err = srv.GetTask(ctx, &api.GetTaskRequest{TaskId: "task-missing"})
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+}
+
+func TestCreateTaskWithoutCheckpointStates(t *testing.T) {
+	srv := NewServer(memory.NewMemoryFleaStorage("http://example.com/v1/repository"), nil, nil, nil)
+
+	_, err := srv.CreateTask(context.Background(), &api.TaskDetails{
+		ModelId:           "model",
+		HyperparametersId: "hp",
+		CheckpointId:      "archived",
+		TaskId:            "task-1",
+	})
+	assert.NoError(t, err)
+}
+
+func TestListTasksPageTokens(t *testing.T) {
+	srv := NewServer(memory.NewMemoryFleaStorage("http://example.com/v1/repository"), nil, nil, nil)
+	ctx := context.Background()
+
+	taskIds := []string{"task-1", "task-2", "task-3", "task-4", "task-5"}
+	for _, taskId := range taskIds {
+		_, err := srv.CreateTask(ctx, &api.TaskDetails{
+			ModelId:           "model",
+			HyperparametersId: "hp",
+			CheckpointId:      "checkpoint",
+			TaskId:            taskId,
+			Active:            true,
+		})
+		assert.NoError(t, err)
+	}
+
+	var listed []string
+	pageToken := ""
+	for pages := 0; pages < len(taskIds); pages++ {
+		resp, err := srv.ListTasks(ctx, &api.ListTasksRequest{MaxItems: 2, PageToken: pageToken})
+		assert.NoError(t, err)
+		listed = append(listed, resp.TaskIds...)
+		pageToken = resp.NextPageToken
+		if pageToken == "" {
+			break
+		}
+	}
+	assert.Equal(t, taskIds, listed)
+
+	resp, err := srv.ListTasks(ctx, &api.ListTasksRequest{StartTaskId: "task-4", MaxItems: 2})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"task-4", "task-5"}, resp.TaskIds)
+	assert.Equal(t, "", resp.NextPageToken)
+
+	_, err = srv.ListTasks(ctx, &api.ListTasksRequest{PageToken: "not a page token!"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestListTasksFullView(t *testing.T) {
+	srv := NewServer(memory.NewMemoryFleaStorage("http://example.com/v1/repository"), nil, nil, nil)
+	ctx := context.Background()
+
+	for _, taskId := range []string{"task-1", "task-2"} {
+		_, err := srv.CreateTask(ctx, &api.TaskDetails{
+			ModelId:           "model",
+			HyperparametersId: "hp",
+			CheckpointId:      "checkpoint",
+			TaskId:            taskId,
+			Active:            true,
+			Link:              "http://example.com/" + taskId,
+		})
+		assert.NoError(t, err)
+	}
+
+	resp, err := srv.ListTasks(ctx, &api.ListTasksRequest{})
+	assert.NoError(t, err)
+	assert.Empty(t, resp.Tasks)
+
+	resp, err = srv.ListTasks(ctx, &api.ListTasksRequest{View: api.ListView_FULL})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"task-1", "task-2"}, resp.TaskIds)
+	assert.Len(t, resp.Tasks, 2)
+	for i, task := range resp.Tasks {
+		assert.Equal(t, resp.TaskIds[i], task.TaskId)
+		assert.Equal(t, "http://example.com/"+task.TaskId, task.Link)
+		assert.Equal(t, "http://example.com/v1/repository/models/model/hyperparameters/hp/checkpoints/checkpoint", task.CheckpointLink)
+	}
+}
+
+func TestAuditLog(t *testing.T) {
+	srv := NewServer(memory.NewMemoryFleaStorage("http://repository"), authentication.NewFakeAuthenticator(), nil, nil)
+	ctx := authentication.ContextWithActor(context.Background(), "FleaTaskGen:9f86d081884c")
+
+	_, err := srv.CreateTask(ctx, &api.TaskDetails{ModelId: "model", HyperparametersId: "hp", CheckpointId: "ckpt", TaskId: "task", Active: true})
+	assert.NoError(t, err)
+	_, err = srv.ModifyTask(ctx, &api.ModifyTaskRequest{TaskId: "task", Active: false})
+	assert.NoError(t, err)
+	resp, err := srv.StartTask(ctx, &api.StartTaskRequest{TaskId: "task"})
+	assert.NoError(t, err)
+	assert.Equal(t, api.StartTaskResponse_APPROVED, resp.Status)
+	_, err = srv.JobError(ctx, &api.JobErrorRequest{TaskId: "task", JobId: resp.JobId, ErrorMessage: "out of memory"})
+	assert.NoError(t, err)
+
+	events, err := srv.ListAuditEvents(ctx, &api.ListAuditEventsRequest{ResourcePath: "/tasks/task"})
+	assert.NoError(t, err)
+	methods := make([]string, len(events.Events))
+	for i, event := range events.Events {
+		methods[i] = event.Method
+		assert.Equal(t, "FleaTaskGen:9f86d081884c", event.Actor)
+	}
+	assert.Equal(t, []string{
+		"/api.Flea/CreateTask",
+		"/api.Flea/ModifyTask",
+		"/api.Flea/StartTask",
+		"/api.Flea/JobError",
+	}, methods)
+	assert.Contains(t, events.Events[1].Before, `"active":true`)
+	assert.NotContains(t, events.Events[1].After, `"active":true`)
+	jobPath := "/tasks/task/jobs/" + resp.JobId
+	assert.Equal(t, jobPath, events.Events[2].ResourcePath)
+	assert.Equal(t, jobPath, events.Events[3].ResourcePath)
+	// Upload URLs are not recorded
+	assert.NotContains(t, events.Events[2].After, "uploadTo")
+	assert.NotContains(t, events.Events[2].After, "tasksJobs")
+}
+
+func TestWebhooks(t *testing.T) {
+	srv := NewServer(memory.NewMemoryFleaStorage("http://repository"), authentication.NewFakeAuthenticator(), nil, nil)
+	ctx := context.Background()
+
+	eventTypes := make(chan string, 10)
+	receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		eventTypes <- r.Header.Get(webhook.EventTypeHeader)
+	}))
+	defer receiver.Close()
+
+	_, err := srv.CreateWebhook(ctx, &api.CreateWebhookRequest{Url: receiver.URL, EventTypes: []string{"CreateCheckpoint"}, Secret: "secret"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = srv.CreateWebhook(ctx, &api.CreateWebhookRequest{Url: receiver.URL, EventTypes: []string{"StartTask", "JobError"}, Secret: "secret"})
+	assert.NoError(t, err)
+
+	_, err = srv.CreateTask(ctx, &api.TaskDetails{ModelId: "model", HyperparametersId: "hp", CheckpointId: "ckpt", TaskId: "task", Active: true})
+	assert.NoError(t, err)
+	resp, err := srv.StartTask(ctx, &api.StartTaskRequest{TaskId: "task"})
+	assert.NoError(t, err)
+	_, err = srv.JobError(ctx, &api.JobErrorRequest{TaskId: "task", JobId: resp.JobId, ErrorMessage: "out of memory"})
+	assert.NoError(t, err)
+
+	// Deliveries are concurrent, so may arrive in any order
+	delivered := make([]string, 0)
+	for len(delivered) < 2 {
+		select {
+		case eventType := <-eventTypes:
+			delivered = append(delivered, eventType)
+		case <-time.After(5 * time.Second):
+			t.Fatalf("only %v were delivered", delivered)
+		}
+	}
+	assert.ElementsMatch(t, []string{"StartTask", "JobError"}, delivered)
+
+	webhooks, err := srv.ListWebhooks(ctx, &api.ListWebhooksRequest{})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(webhooks.Webhooks))
+	_, err = srv.DeleteWebhook(ctx, &api.DeleteWebhookRequest{WebhookId: webhooks.Webhooks[0].WebhookId})
+	assert.NoError(t, err)
+	_, err = srv.DeleteWebhook(ctx, &api.DeleteWebhookRequest{WebhookId: webhooks.Webhooks[0].WebhookId})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
@@ -4,17 +4,19 @@ go 1.12
 
 require (
 	cloud.google.com/go v0.39.0
+	github.com/aws/aws-sdk-go v1.20.6
 	github.com/fsouza/fake-gcs-server v1.8.0
 	github.com/golang/protobuf v1.3.1
 	github.com/google/uuid v1.1.1
 	github.com/googleapis/gax-go v2.0.2+incompatible // indirect
 	github.com/grpc-ecosystem/grpc-gateway v1.9.0
+	github.com/johannesboyne/gofakes3 v0.0.0-20191228161223-9aee1c78a252
 	github.com/konsorten/go-windows-terminal-sequences v1.0.2 // indirect
 	github.com/sirupsen/logrus v1.4.2
 	github.com/stretchr/testify v1.3.0
-	golang.org/x/net v0.0.0-20190420063019-afa5a82059c6 // indirect
+	go.etcd.io/bbolt v1.3.3
+	golang.org/x/crypto v0.0.0-20190308221718-c2843e01d9a2
 	google.golang.org/api v0.6.0
 	google.golang.org/genproto v0.0.0-20190508193815-b515fa19cec8
 	google.golang.org/grpc v1.21.1
-	gopkg.in/yaml.v2 v2.2.1 // indirect
 )
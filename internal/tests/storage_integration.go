@@ -2,8 +2,17 @@ package tests
 
 import (
 	"context"
+	"fmt"
+	"github.com/doc-ai/tensorio-models/api"
+	"github.com/doc-ai/tensorio-models/common"
+	"github.com/doc-ai/tensorio-models/filter"
 	"github.com/doc-ai/tensorio-models/storage"
+	"github.com/golang/protobuf/ptypes/timestamp"
 	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"sync"
 	"testing"
 	"time"
 )
@@ -25,7 +34,8 @@ func Test_AddModel(t *testing.T, store storage.RepositoryStorage) {
 	err = store.AddModel(ctx, model)
 	assert.NoError(t, err)
 
-	// get model and ensure it hasn't changed
+	// get model and ensure it hasn't changed, other than being stored at version 1
+	model.Version = 1
 	storedModel, err := store.GetModel(ctx, "add_model")
 	assert.NoError(t, err)
 	assert.Equal(t, model, storedModel)
@@ -38,6 +48,7 @@ func Test_AddModel(t *testing.T, store storage.RepositoryStorage) {
 
 	err = store.AddModel(ctx, model2)
 	assert.NoError(t, err)
+	model2.Version = 1
 
 	// see adding same models with conflicting name fails
 	err = store.AddModel(ctx, model)
@@ -59,7 +70,7 @@ func Test_ListModels(t *testing.T, store storage.RepositoryStorage) {
 
 	// test empty case
 	list, err := store.ListModels(ctx, "model1", 2)
-	assert.Equal(t, []string{}, list)
+	assert.Equal(t, []string{}, list.Ids)
 	assert.NoError(t, err)
 
 	model := storage.Model{
@@ -83,35 +94,38 @@ func Test_ListModels(t *testing.T, store storage.RepositoryStorage) {
 	assert.NoError(t, err)
 
 	list, err = store.ListModels(ctx, "nothing", 2)
-	assert.Equal(t, []string{}, list)
+	assert.Equal(t, []string{}, list.Ids)
 	assert.NoError(t, err)
 
 	list, err = store.ListModels(ctx, "model1", 2)
-	assert.Equal(t, []string{"model2", "model3"}, list)
+	assert.Equal(t, []string{"model2", "model3"}, list.Ids)
+	assert.Equal(t, "model3", list.NextMarker)
 	assert.NoError(t, err)
 
 	list, err = store.ListModels(ctx, "model0", 4)
-	assert.Equal(t, []string{"model1", "model2", "model3", "model4"}, list)
+	assert.Equal(t, []string{"model1", "model2", "model3", "model4"}, list.Ids)
+	assert.Equal(t, "", list.NextMarker)
 	assert.NoError(t, err)
 
 	list, err = store.ListModels(ctx, "model1", 4)
-	assert.Equal(t, []string{"model2", "model3", "model4"}, list)
+	assert.Equal(t, []string{"model2", "model3", "model4"}, list.Ids)
 	assert.NoError(t, err)
 
 	list, err = store.ListModels(ctx, "a", 2)
-	assert.Equal(t, []string{"model1", "model2"}, list)
+	assert.Equal(t, []string{"model1", "model2"}, list.Ids)
 	assert.NoError(t, err)
 
 	list, err = store.ListModels(ctx, "model2a", 2)
-	assert.Equal(t, []string{"model3", "model4"}, list)
+	assert.Equal(t, []string{"model3", "model4"}, list.Ids)
+	assert.Equal(t, "", list.NextMarker)
 	assert.NoError(t, err)
 
 	list, err = store.ListModels(ctx, "a", 0)
-	assert.Equal(t, []string{}, list)
+	assert.Equal(t, []string{}, list.Ids)
 	assert.NoError(t, err)
 
 	list, err = store.ListModels(ctx, "", 2)
-	assert.Equal(t, []string{"model1", "model2"}, list)
+	assert.Equal(t, []string{"model1", "model2"}, list.Ids)
 	assert.NoError(t, err)
 }
 
@@ -140,18 +154,54 @@ func Test_UpdateModels(t *testing.T, store storage.RepositoryStorage) {
 
 	// check if new model is updated, in this case empty
 	assert.NoError(t, err)
+	model.Version = 2
 	assert.Equal(t, model, updatedModel)
 
 	modelUpdate = storage.Model{
 		ModelId:                  "model1",
 		Details:                  "new detail",
-		CanonicalHyperparameters: "new canonical",
+		CanonicalHyperparameters: "new-canonical",
 	}
+	_, err = store.UpdateModel(ctx, modelUpdate)
+
+	// check that canonical hyperparameters must exist
+	assert.Equal(t, storage.ErrCanonicalHyperparametersDoesNotExist, err)
+
+	store.AddHyperparameters(ctx, storage.Hyperparameters{
+		ModelId:           "model1",
+		HyperparametersId: "new-canonical",
+		Hyperparameters:   map[string]string{"hp1": "1"},
+	})
 	updatedModel, err = store.UpdateModel(ctx, modelUpdate)
 
 	// check if new model is updated
 	assert.NoError(t, err)
-	assert.Equal(t, modelUpdate, updatedModel)
+	expectedModel := modelUpdate
+	expectedModel.Version = 3
+	assert.Equal(t, expectedModel, updatedModel)
+
+	// model cards are replaced as a whole, and kept if an update does not include one
+	card := &storage.ModelCard{
+		SchemaVersion: storage.ModelCardSchemaVersion,
+		Description:   "Finds faces",
+		Owners:        []string{"vision@example.com"},
+		Inputs:        []storage.TensorSpec{{Name: "image", Dtype: "uint8", Shape: []int64{-1, 224, 224, 3}}},
+		Outputs:       []storage.TensorSpec{{Name: "boxes", Dtype: "float32", Shape: []int64{-1, 4}}},
+		License:       "Apache-2.0",
+		Framework:     "tflite",
+	}
+	updatedModel, err = store.UpdateModel(ctx, storage.Model{ModelId: "model1", Card: card})
+	assert.NoError(t, err)
+	assert.Equal(t, card, updatedModel.Card)
+	assert.Equal(t, "new detail", updatedModel.Details)
+
+	updatedModel, err = store.UpdateModel(ctx, storage.Model{ModelId: "model1", Details: "newer detail"})
+	assert.NoError(t, err)
+	assert.Equal(t, card, updatedModel.Card)
+
+	storedModel, err := store.GetModel(ctx, "model1")
+	assert.NoError(t, err)
+	assert.Equal(t, updatedModel, storedModel)
 }
 
 func Test_AddHyperparameters(t *testing.T, store storage.RepositoryStorage) {
@@ -188,6 +238,7 @@ func Test_AddHyperparameters(t *testing.T, store storage.RepositoryStorage) {
 
 	storedParams, err := store.GetHyperparameters(ctx, params.ModelId, params.HyperparametersId)
 	assert.NoError(t, err)
+	params.Version = 1
 	assert.Equal(t, params, storedParams)
 
 	// expect error on conflict
@@ -212,7 +263,7 @@ func Test_ListHyperparams(t *testing.T, store storage.RepositoryStorage) {
 	model.ModelId = "model2"
 	store.AddModel(ctx, model)
 	params, err := store.ListHyperparameters(ctx, "model1", "marker", 2)
-	assert.Equal(t, []string{}, params)
+	assert.Equal(t, []string{}, params.Ids)
 	assert.NoError(t, err)
 
 	param := storage.Hyperparameters{
@@ -243,19 +294,21 @@ func Test_ListHyperparams(t *testing.T, store storage.RepositoryStorage) {
 	}
 
 	params, err = store.ListHyperparameters(ctx, "model1", "marker", 2)
-	assert.Equal(t, []string{"model1:param1", "model1:param2"}, params)
+	assert.Equal(t, []string{"param1", "param2"}, params.Ids)
+	assert.Equal(t, "param2", params.NextMarker)
 	assert.NoError(t, err)
 
 	params, err = store.ListHyperparameters(ctx, "model1", "marker", 5)
-	assert.Equal(t, []string{"model1:param1", "model1:param2", "model1:param3", "model1:param4"}, params)
+	assert.Equal(t, []string{"param1", "param2", "param3", "param4"}, params.Ids)
 	assert.NoError(t, err)
 
 	params, err = store.ListHyperparameters(ctx, "model1", "param22", 5)
-	assert.Equal(t, []string{"model1:param3", "model1:param4"}, params)
+	assert.Equal(t, []string{"param3", "param4"}, params.Ids)
+	assert.Equal(t, "", params.NextMarker)
 	assert.NoError(t, err)
 
 	params, err = store.ListHyperparameters(ctx, "model2", "", 5)
-	assert.Equal(t, []string{"model2:param1"}, params)
+	assert.Equal(t, []string{"param1"}, params.Ids)
 	assert.NoError(t, err)
 }
 
@@ -296,6 +349,7 @@ func Test_UpdateHyperparams(t *testing.T, store storage.RepositoryStorage) {
 
 	updatedHyperparameters, err := store.UpdateHyperparameters(ctx, hyperparametersUpdate)
 	assert.NoError(t, err)
+	hyperparameters.Version = 2
 	assert.Equal(t, hyperparameters, updatedHyperparameters)
 
 	hyperparametersUpdate.CanonicalCheckpoint = "checkpoint2"
@@ -303,17 +357,367 @@ func Test_UpdateHyperparams(t *testing.T, store storage.RepositoryStorage) {
 	hyperparametersUpdate.Hyperparameters["hp1"] = "1.1"
 	hyperparametersUpdate.Hyperparameters["hp2"] = "2"
 	hyperparametersUpdate.UpgradeTo = "upgradeTo1"
+
+	// the canonical checkpoint and the hyperparameters to upgrade to must exist
+	_, err = store.UpdateHyperparameters(ctx, hyperparametersUpdate)
+	assert.Equal(t, storage.ErrCanonicalCheckpointDoesNotExist, err)
+	err = store.AddCheckpoint(ctx, storage.Checkpoint{
+		ModelId:           "model1",
+		HyperparametersId: "param1",
+		CheckpointId:      "checkpoint2",
+		Link:              "link2",
+		CreatedAt:         time.Now(),
+	})
+	assert.NoError(t, err)
+
+	_, err = store.UpdateHyperparameters(ctx, hyperparametersUpdate)
+	assert.Equal(t, storage.ErrUpgradeToDoesNotExist, err)
+	err = store.AddHyperparameters(ctx, storage.Hyperparameters{
+		ModelId:           "model1",
+		HyperparametersId: "upgradeTo1",
+		Hyperparameters:   map[string]string{"hp1": "2"},
+	})
+	assert.NoError(t, err)
+
 	expectedHyperparameters := storage.Hyperparameters{
 		ModelId:             "model1",
 		HyperparametersId:   "param1",
 		CanonicalCheckpoint: "checkpoint2",
 		UpgradeTo:           "upgradeTo1",
 		Hyperparameters:     map[string]string{"hp1": "1.1", "hp2": "2"},
+		Version:             3,
 	}
 	updatedHyperparameters, err = store.UpdateHyperparameters(ctx, hyperparametersUpdate)
+	assert.NoError(t, err)
 	assert.Equal(t, expectedHyperparameters, updatedHyperparameters)
 }
 
+func Test_UpdateVersions(t *testing.T, store storage.RepositoryStorage) {
+	ctx := context.Background()
+
+	err := store.AddModel(ctx, storage.Model{ModelId: "model1", Details: "details"})
+	assert.NoError(t, err)
+	err = store.AddHyperparameters(ctx, storage.Hyperparameters{
+		ModelId:           "model1",
+		HyperparametersId: "params1",
+		Hyperparameters:   map[string]string{"hp1": "1"},
+	})
+	assert.NoError(t, err)
+
+	// Two clients read the model at version 1; the first to write wins.
+	model, err := store.GetModel(ctx, "model1")
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), model.Version)
+	updatedModel, err := store.UpdateModel(ctx, storage.Model{ModelId: "model1", Details: "first", Version: model.Version})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), updatedModel.Version)
+	_, err = store.UpdateModel(ctx, storage.Model{ModelId: "model1", Details: "second", Version: model.Version})
+	assert.Equal(t, storage.ErrVersionMismatch, err)
+	model, err = store.GetModel(ctx, "model1")
+	assert.NoError(t, err)
+	assert.Equal(t, "first", model.Details)
+	assert.Equal(t, int64(2), model.Version)
+
+	// Updates without a version always apply.
+	updatedModel, err = store.UpdateModel(ctx, storage.Model{ModelId: "model1", Details: "third"})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(3), updatedModel.Version)
+	_, err = store.UpdateModel(ctx, storage.Model{ModelId: "model1", Details: "fourth", Version: 4})
+	assert.Equal(t, storage.ErrVersionMismatch, err)
+
+	hyperparameters, err := store.GetHyperparameters(ctx, "model1", "params1")
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), hyperparameters.Version)
+	updatedHyperparameters, err := store.UpdateHyperparameters(ctx, storage.Hyperparameters{
+		ModelId:           "model1",
+		HyperparametersId: "params1",
+		Hyperparameters:   map[string]string{"hp1": "2"},
+		Version:           hyperparameters.Version,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), updatedHyperparameters.Version)
+	_, err = store.UpdateHyperparameters(ctx, storage.Hyperparameters{
+		ModelId:           "model1",
+		HyperparametersId: "params1",
+		Hyperparameters:   map[string]string{"hp1": "3"},
+		Version:           hyperparameters.Version,
+	})
+	assert.Equal(t, storage.ErrVersionMismatch, err)
+	hyperparameters, err = store.GetHyperparameters(ctx, "model1", "params1")
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{"hp1": "2"}, hyperparameters.Hyperparameters)
+	assert.Equal(t, int64(2), hyperparameters.Version)
+
+	updatedHyperparameters, err = store.UpdateHyperparameters(ctx, storage.Hyperparameters{
+		ModelId:           "model1",
+		HyperparametersId: "params1",
+		Hyperparameters:   map[string]string{"hp1": "3"},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(3), updatedHyperparameters.Version)
+}
+
+func Test_ConcurrentUpdates(t *testing.T, store storage.RepositoryStorage) {
+	ctx := context.Background()
+
+	err := store.AddModel(ctx, storage.Model{ModelId: "model1", Details: "details"})
+	assert.NoError(t, err)
+	err = store.AddHyperparameters(ctx, storage.Hyperparameters{
+		ModelId:           "model1",
+		HyperparametersId: "params1",
+		Hyperparameters:   map[string]string{"hp1": "1"},
+	})
+	assert.NoError(t, err)
+
+	// Every writer read version 1, so exactly one of them may apply its update.
+	const writers = 10
+	modelErrs := make([]error, writers)
+	hyperparametersErrs := make([]error, writers)
+	start := make(chan struct{})
+	var wg sync.WaitGroup
+	for i := 0; i < writers; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			<-start
+			_, modelErrs[i] = store.UpdateModel(ctx, storage.Model{
+				ModelId: "model1",
+				Details: fmt.Sprintf("writer-%d", i),
+				Version: 1,
+			})
+			_, hyperparametersErrs[i] = store.UpdateHyperparameters(ctx, storage.Hyperparameters{
+				ModelId:           "model1",
+				HyperparametersId: "params1",
+				Hyperparameters:   map[string]string{"hp1": fmt.Sprintf("writer-%d", i)},
+				Version:           1,
+			})
+		}(i)
+	}
+	close(start)
+	wg.Wait()
+
+	modelWinner, hyperparametersWinner := -1, -1
+	for i := 0; i < writers; i++ {
+		if modelErrs[i] == nil {
+			assert.Equal(t, -1, modelWinner, "more than one model update applied")
+			modelWinner = i
+		} else {
+			assert.Equal(t, storage.ErrVersionMismatch, modelErrs[i])
+		}
+		if hyperparametersErrs[i] == nil {
+			assert.Equal(t, -1, hyperparametersWinner, "more than one hyperparameters update applied")
+			hyperparametersWinner = i
+		} else {
+			assert.Equal(t, storage.ErrVersionMismatch, hyperparametersErrs[i])
+		}
+	}
+	assert.NotEqual(t, -1, modelWinner)
+	assert.NotEqual(t, -1, hyperparametersWinner)
+
+	model, err := store.GetModel(ctx, "model1")
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), model.Version)
+	assert.Equal(t, fmt.Sprintf("writer-%d", modelWinner), model.Details)
+
+	hyperparameters, err := store.GetHyperparameters(ctx, "model1", "params1")
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), hyperparameters.Version)
+	assert.Equal(t, map[string]string{"hp1": fmt.Sprintf("writer-%d", hyperparametersWinner)}, hyperparameters.Hyperparameters)
+}
+
+func Test_Revisions(t *testing.T, store storage.RepositoryStorage) {
+	ctx := context.Background()
+
+	_, err := store.ListModelRevisions(ctx, "model1", 0, 10)
+	assert.Equal(t, storage.ModelDoesNotExistError, err)
+
+	err = store.AddModel(ctx, storage.Model{ModelId: "model1", Details: "first"})
+	assert.NoError(t, err)
+	err = store.AddHyperparameters(ctx, storage.Hyperparameters{
+		ModelId:           "model1",
+		HyperparametersId: "params1",
+		Hyperparameters:   map[string]string{"hp1": "1"},
+	})
+	assert.NoError(t, err)
+	_, err = store.UpdateModel(ctx, storage.Model{ModelId: "model1", Details: "second"})
+	assert.NoError(t, err)
+	_, err = store.UpdateModel(ctx, storage.Model{ModelId: "model1", CanonicalHyperparameters: "params1"})
+	assert.NoError(t, err)
+
+	revisions, err := store.ListModelRevisions(ctx, "model1", 0, 10)
+	assert.NoError(t, err)
+	if assert.Len(t, revisions, 3) {
+		for i, revision := range revisions {
+			assert.Equal(t, int64(i+1), revision.Model.Version)
+			assert.False(t, revision.CreatedAt.IsZero())
+		}
+		assert.Equal(t, "first", revisions[0].Model.Details)
+		assert.Equal(t, "", revisions[0].Model.CanonicalHyperparameters)
+		assert.Equal(t, "second", revisions[2].Model.Details)
+		assert.Equal(t, "params1", revisions[2].Model.CanonicalHyperparameters)
+	}
+
+	revisions, err = store.ListModelRevisions(ctx, "model1", 1, 1)
+	assert.NoError(t, err)
+	if assert.Len(t, revisions, 1) {
+		assert.Equal(t, int64(2), revisions[0].Model.Version)
+	}
+	revisions, err = store.ListModelRevisions(ctx, "model1", 3, 10)
+	assert.NoError(t, err)
+	assert.Len(t, revisions, 0)
+
+	revision, err := store.GetModelRevision(ctx, "model1", 2)
+	assert.NoError(t, err)
+	assert.Equal(t, "second", revision.Model.Details)
+	assert.Equal(t, "", revision.Model.CanonicalHyperparameters)
+	_, err = store.GetModelRevision(ctx, "model1", 4)
+	assert.Equal(t, storage.RevisionDoesNotExistError, err)
+	_, err = store.GetModelRevision(ctx, "model2", 1)
+	assert.Equal(t, storage.ModelDoesNotExistError, err)
+
+	// Revisions keep the hyperparameters as they were, however the stored ones change
+	_, err = store.UpdateHyperparameters(ctx, storage.Hyperparameters{
+		ModelId:           "model1",
+		HyperparametersId: "params1",
+		Hyperparameters:   map[string]string{"hp1": "2", "hp2": "2"},
+	})
+	assert.NoError(t, err)
+	hpRevisions, err := store.ListHyperparametersRevisions(ctx, "model1", "params1", 0, 10)
+	assert.NoError(t, err)
+	if assert.Len(t, hpRevisions, 2) {
+		assert.Equal(t, int64(1), hpRevisions[0].Hyperparameters.Version)
+		assert.Equal(t, map[string]string{"hp1": "1"}, hpRevisions[0].Hyperparameters.Hyperparameters)
+		assert.Equal(t, int64(2), hpRevisions[1].Hyperparameters.Version)
+		assert.Equal(t, map[string]string{"hp1": "2", "hp2": "2"}, hpRevisions[1].Hyperparameters.Hyperparameters)
+	}
+	hpRevision, err := store.GetHyperparametersRevision(ctx, "model1", "params1", 1)
+	assert.NoError(t, err)
+	assert.Equal(t, "params1", hpRevision.Hyperparameters.HyperparametersId)
+	assert.Equal(t, map[string]string{"hp1": "1"}, hpRevision.Hyperparameters.Hyperparameters)
+	_, err = store.GetHyperparametersRevision(ctx, "model1", "params1", 3)
+	assert.Equal(t, storage.RevisionDoesNotExistError, err)
+	_, err = store.ListHyperparametersRevisions(ctx, "model1", "params2", 0, 10)
+	assert.Equal(t, storage.HyperparametersDoesNotExistError, err)
+
+	// Revisions are deleted along with their resources
+	err = store.DeleteModel(ctx, "model1", storage.DeleteOptions{Cascade: true, Force: true})
+	assert.NoError(t, err)
+	err = store.AddModel(ctx, storage.Model{ModelId: "model1", Details: "recreated"})
+	assert.NoError(t, err)
+	err = store.AddHyperparameters(ctx, storage.Hyperparameters{ModelId: "model1", HyperparametersId: "params1"})
+	assert.NoError(t, err)
+	revisions, err = store.ListModelRevisions(ctx, "model1", 0, 10)
+	assert.NoError(t, err)
+	if assert.Len(t, revisions, 1) {
+		assert.Equal(t, "recreated", revisions[0].Model.Details)
+	}
+	hpRevisions, err = store.ListHyperparametersRevisions(ctx, "model1", "params1", 0, 10)
+	assert.NoError(t, err)
+	assert.Len(t, hpRevisions, 1)
+}
+
+func Test_Replace(t *testing.T, store storage.RepositoryStorage) {
+	ctx := context.Background()
+
+	_, err := store.ReplaceModel(ctx, storage.Model{ModelId: "model1"})
+	assert.Equal(t, storage.ModelDoesNotExistError, err)
+
+	err = store.AddModel(ctx, storage.Model{ModelId: "model1", Details: "first"})
+	assert.NoError(t, err)
+	for _, hyperparametersId := range []string{"params1", "params2"} {
+		err = store.AddHyperparameters(ctx, storage.Hyperparameters{
+			ModelId:           "model1",
+			HyperparametersId: hyperparametersId,
+			Hyperparameters:   map[string]string{"hp1": "1"},
+		})
+		assert.NoError(t, err)
+	}
+	err = store.AddCheckpoint(ctx, storage.Checkpoint{
+		ModelId:           "model1",
+		HyperparametersId: "params1",
+		CheckpointId:      "cp1",
+		Link:              "link1",
+		CreatedAt:         time.Now(),
+	})
+	assert.NoError(t, err)
+
+	// Replacing clears everything which was set since the revision
+	_, err = store.UpdateModel(ctx, storage.Model{
+		ModelId:                  "model1",
+		CanonicalHyperparameters: "params1",
+		Labels:                   map[string]string{"stage": "production"},
+		Card:                     &storage.ModelCard{SchemaVersion: storage.ModelCardSchemaVersion, License: "MIT"},
+	})
+	assert.NoError(t, err)
+	revision, err := store.GetModelRevision(ctx, "model1", 1)
+	assert.NoError(t, err)
+	replacement := revision.Model
+	replacement.Version = 1
+	_, err = store.ReplaceModel(ctx, replacement)
+	assert.Equal(t, storage.ErrVersionMismatch, err)
+	replacement.Version = 2
+	replaced, err := store.ReplaceModel(ctx, replacement)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(3), replaced.Version)
+	model, err := store.GetModel(ctx, "model1")
+	assert.NoError(t, err)
+	assert.Equal(t, replaced, model)
+	assert.Equal(t, "first", model.Details)
+	assert.Equal(t, "", model.CanonicalHyperparameters)
+	assert.Empty(t, model.Labels)
+	assert.Nil(t, model.Card)
+	revision, err = store.GetModelRevision(ctx, "model1", 3)
+	assert.NoError(t, err)
+	assert.Equal(t, model, revision.Model)
+
+	// As with updates, references are checked
+	_, err = store.ReplaceModel(ctx, storage.Model{ModelId: "model1", CanonicalHyperparameters: "params3"})
+	assert.Equal(t, storage.ErrCanonicalHyperparametersDoesNotExist, err)
+
+	_, err = store.UpdateHyperparameters(ctx, storage.Hyperparameters{
+		ModelId:             "model1",
+		HyperparametersId:   "params1",
+		CanonicalCheckpoint: "cp1",
+		UpgradeTo:           "params2",
+		Labels:              map[string]string{"stage": "production"},
+		Rollout:             []storage.RolloutTarget{{CheckpointId: "cp1", Weight: 10}},
+		Hyperparameters:     map[string]string{"hp2": "2"},
+	})
+	assert.NoError(t, err)
+	hpRevision, err := store.GetHyperparametersRevision(ctx, "model1", "params1", 1)
+	assert.NoError(t, err)
+	hpReplacement := hpRevision.Hyperparameters
+	hpReplacement.Version = 2
+	hpReplaced, err := store.ReplaceHyperparameters(ctx, hpReplacement)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(3), hpReplaced.Version)
+	hyperparameters, err := store.GetHyperparameters(ctx, "model1", "params1")
+	assert.NoError(t, err)
+	assert.Equal(t, "", hyperparameters.CanonicalCheckpoint)
+	assert.Equal(t, "", hyperparameters.UpgradeTo)
+	assert.Empty(t, hyperparameters.Labels)
+	assert.Empty(t, hyperparameters.Rollout)
+	assert.Equal(t, map[string]string{"hp1": "1"}, hyperparameters.Hyperparameters)
+	hpRevision, err = store.GetHyperparametersRevision(ctx, "model1", "params1", 3)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{"hp1": "1"}, hpRevision.Hyperparameters.Hyperparameters)
+
+	// The revision which was replaced is left as it was
+	hpRevision, err = store.GetHyperparametersRevision(ctx, "model1", "params1", 2)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{"hp1": "1", "hp2": "2"}, hpRevision.Hyperparameters.Hyperparameters)
+	assert.Equal(t, "cp1", hpRevision.Hyperparameters.CanonicalCheckpoint)
+
+	_, err = store.ReplaceHyperparameters(ctx, storage.Hyperparameters{
+		ModelId:             "model1",
+		HyperparametersId:   "params1",
+		CanonicalCheckpoint: "cp2",
+	})
+	assert.Equal(t, storage.ErrCanonicalCheckpointDoesNotExist, err)
+	_, err = store.ReplaceHyperparameters(ctx, storage.Hyperparameters{ModelId: "model1", HyperparametersId: "params3"})
+	assert.Equal(t, storage.HyperparametersDoesNotExistError, err)
+}
+
 func Test_AddCheckpoint(t *testing.T, store storage.RepositoryStorage) {
 	ctx := context.Background()
 
@@ -324,6 +728,9 @@ func Test_AddCheckpoint(t *testing.T, store storage.RepositoryStorage) {
 		Link:              "link1",
 		CreatedAt:         time.Now(),
 		Info:              map[string]string{"info1": "1"},
+		Sha256:            "9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08",
+		SizeBytes:         4,
+		ContentType:       "application/zip",
 	}
 	_, err := store.GetCheckpoint(ctx, "model1", "param1", "cp1")
 	assert.Error(t, err)
@@ -375,7 +782,7 @@ func Test_AddCheckpoint(t *testing.T, store storage.RepositoryStorage) {
 func Test_ListCheckpoints(t *testing.T, store storage.RepositoryStorage) {
 	ctx := context.Background()
 
-	_, err := store.ListCheckpoints(ctx, "model1", "params1", "", 4)
+	_, err := store.ListCheckpoints(ctx, "model1", "params1", "", 4, false)
 	assert.Error(t, err)
 
 	model1 := storage.Model{
@@ -385,7 +792,7 @@ func Test_ListCheckpoints(t *testing.T, store storage.RepositoryStorage) {
 	}
 	store.AddModel(ctx, model1)
 
-	_, err = store.ListCheckpoints(ctx, "model1", "params1", "", 4)
+	_, err = store.ListCheckpoints(ctx, "model1", "params1", "", 4, false)
 	assert.Error(t, err)
 
 	params1 := storage.Hyperparameters{
@@ -395,7 +802,7 @@ func Test_ListCheckpoints(t *testing.T, store storage.RepositoryStorage) {
 		Hyperparameters:     map[string]string{"hp1": "1"},
 	}
 	store.AddHyperparameters(ctx, params1)
-	_, err = store.ListCheckpoints(ctx, "model1", "params1", "", 4)
+	_, err = store.ListCheckpoints(ctx, "model1", "params1", "", 4, false)
 	assert.NoError(t, err)
 
 	checkpoint1 := storage.Checkpoint{
@@ -407,8 +814,8 @@ func Test_ListCheckpoints(t *testing.T, store storage.RepositoryStorage) {
 		Info:              map[string]string{"info1": "1"},
 	}
 	store.AddCheckpoint(ctx, checkpoint1)
-	checkpoints, err := store.ListCheckpoints(ctx, "model1", "params1", "", 4)
-	assert.Equal(t, []string{"model1:params1:cp1"}, checkpoints)
+	checkpoints, err := store.ListCheckpoints(ctx, "model1", "params1", "", 4, false)
+	assert.Equal(t, []string{"cp1"}, checkpoints.Ids)
 	assert.NoError(t, err)
 
 	checkpoint1.CheckpointId = "cp3"
@@ -420,27 +827,1202 @@ func Test_ListCheckpoints(t *testing.T, store storage.RepositoryStorage) {
 	checkpoint1.CheckpointId = "cp4"
 	store.AddCheckpoint(ctx, checkpoint1)
 
-	checkpoints, err = store.ListCheckpoints(ctx, "model1", "params1", "", 4)
+	checkpoints, err = store.ListCheckpoints(ctx, "model1", "params1", "", 4, false)
+	assert.Equal(t, []string{
+		"cp1",
+		"cp2",
+		"cp3",
+		"cp4",
+	}, checkpoints.Ids)
+	assert.NoError(t, err)
+
+	checkpoints, err = store.ListCheckpoints(ctx, "model1", "params1", "cp22", 4, false)
 	assert.Equal(t, []string{
-		"model1:params1:cp1",
-		"model1:params1:cp2",
-		"model1:params1:cp3",
-		"model1:params1:cp4",
-	}, checkpoints)
+		"cp3",
+		"cp4",
+	}, checkpoints.Ids)
 	assert.NoError(t, err)
 
-	checkpoints, err = store.ListCheckpoints(ctx, "model1", "params1", "cp22", 4)
+	checkpoints, err = store.ListCheckpoints(ctx, "model1", "params1", "cp1", 4, false)
 	assert.Equal(t, []string{
-		"model1:params1:cp3",
-		"model1:params1:cp4",
-	}, checkpoints)
+		"cp2",
+		"cp3",
+		"cp4",
+	}, checkpoints.Ids)
+	assert.Equal(t, "", checkpoints.NextMarker)
 	assert.NoError(t, err)
 
-	checkpoints, err = store.ListCheckpoints(ctx, "model1", "params1", "cp1", 4)
+	checkpoints, err = store.ListCheckpoints(ctx, "model1", "params1", "", 3, false)
+	assert.Equal(t, []string{"cp1", "cp2", "cp3"}, checkpoints.Ids)
+	assert.Equal(t, "cp3", checkpoints.NextMarker)
+	assert.NoError(t, err)
+
+	checkpoints, err = store.ListCheckpoints(ctx, "model1", "params1", checkpoints.NextMarker, 3, false)
+	assert.Equal(t, []string{"cp4"}, checkpoints.Ids)
+	assert.Equal(t, "", checkpoints.NextMarker)
+	assert.NoError(t, err)
+}
+
+func Test_UpdateCheckpointState(t *testing.T, store storage.RepositoryStorage) {
+	ctx := context.Background()
+
+	_, err := store.UpdateCheckpointState(ctx, "model1", "params1", "cp1", api.CheckpointState_ARCHIVED)
+	assert.Error(t, err)
+
+	model1 := storage.Model{
+		ModelId:                  "model1",
+		Details:                  "desc",
+		CanonicalHyperparameters: "params1",
+	}
+	store.AddModel(ctx, model1)
+	params1 := storage.Hyperparameters{
+		ModelId:             "model1",
+		HyperparametersId:   "params1",
+		CanonicalCheckpoint: "cp1",
+		Hyperparameters:     map[string]string{"hp1": "1"},
+	}
+	store.AddHyperparameters(ctx, params1)
+
+	_, err = store.UpdateCheckpointState(ctx, "model1", "params1", "cp1", api.CheckpointState_ARCHIVED)
+	assert.Equal(t, storage.CheckpointDoesNotExistError, err)
+
+	checkpoint1 := storage.Checkpoint{
+		ModelId:           "model1",
+		HyperparametersId: "params1",
+		CheckpointId:      "cp1",
+		Link:              "link1",
+		CreatedAt:         time.Now(),
+		Info:              map[string]string{"info1": "1"},
+		Sha256:            "9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08",
+		SizeBytes:         4,
+	}
+	for _, checkpointId := range []string{"cp1", "cp2", "cp3", "cp4"} {
+		checkpoint1.CheckpointId = checkpointId
+		store.AddCheckpoint(ctx, checkpoint1)
+	}
+
+	checkpoint, err := store.GetCheckpoint(ctx, "model1", "params1", "cp1")
+	assert.NoError(t, err)
+	assert.Equal(t, api.CheckpointState_ACTIVE, checkpoint.State)
+
+	updated, err := store.UpdateCheckpointState(ctx, "model1", "params1", "cp2", api.CheckpointState_DEPRECATED)
+	assert.NoError(t, err)
+	assert.Equal(t, api.CheckpointState_DEPRECATED, updated.State)
+	assert.Equal(t, "link1", updated.Link)
+	assert.Equal(t, checkpoint1.Sha256, updated.Sha256)
+	assert.Equal(t, checkpoint1.SizeBytes, updated.SizeBytes)
+
+	_, err = store.UpdateCheckpointState(ctx, "model1", "params1", "cp1", api.CheckpointState_ARCHIVED)
+	assert.NoError(t, err)
+	_, err = store.UpdateCheckpointState(ctx, "model1", "params1", "cp3", api.CheckpointState_ARCHIVED)
+	assert.NoError(t, err)
+
+	checkpoint, err = store.GetCheckpoint(ctx, "model1", "params1", "cp1")
+	assert.NoError(t, err)
+	assert.Equal(t, api.CheckpointState_ARCHIVED, checkpoint.State)
+	assert.Equal(t, map[string]string{"info1": "1"}, checkpoint.Info)
+
+	// Archived checkpoints are hidden by default, without shrinking the page.
+	checkpoints, err := store.ListCheckpoints(ctx, "model1", "params1", "", 2, false)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"cp2", "cp4"}, checkpoints.Ids)
+	assert.Equal(t, "", checkpoints.NextMarker)
+
+	checkpoints, err = store.ListCheckpoints(ctx, "model1", "params1", "cp2", 2, false)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"cp4"}, checkpoints.Ids)
+	assert.Equal(t, "", checkpoints.NextMarker)
+
+	checkpoints, err = store.ListCheckpoints(ctx, "model1", "params1", "", 10, true)
+	assert.NoError(t, err)
 	assert.Equal(t, []string{
-		"model1:params1:cp2",
-		"model1:params1:cp3",
-		"model1:params1:cp4",
-	}, checkpoints)
+		"cp1",
+		"cp2",
+		"cp3",
+		"cp4",
+	}, checkpoints.Ids)
+
+	// Restoring an archived checkpoint lists it again.
+	_, err = store.UpdateCheckpointState(ctx, "model1", "params1", "cp1", api.CheckpointState_ACTIVE)
+	assert.NoError(t, err)
+	checkpoints, err = store.ListCheckpoints(ctx, "model1", "params1", "", 10, false)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"cp1", "cp2", "cp4"}, checkpoints.Ids)
+}
+
+func Test_DeleteModel(t *testing.T, store storage.RepositoryStorage) {
+	ctx := context.Background()
+
+	err := store.DeleteModel(ctx, "model1", storage.DeleteOptions{})
+	assert.Equal(t, storage.ModelDoesNotExistError, err)
+
+	model1 := storage.Model{
+		ModelId:                  "model1",
+		Details:                  "desc",
+		CanonicalHyperparameters: "params1",
+	}
+	store.AddModel(ctx, model1)
+	model2 := model1
+	model2.ModelId = "model2"
+	store.AddModel(ctx, model2)
+
+	params1 := storage.Hyperparameters{
+		ModelId:             "model1",
+		HyperparametersId:   "params1",
+		CanonicalCheckpoint: "cp1",
+		Hyperparameters:     map[string]string{"hp1": "1"},
+	}
+	store.AddHyperparameters(ctx, params1)
+	checkpoint1 := storage.Checkpoint{
+		ModelId:           "model1",
+		HyperparametersId: "params1",
+		CheckpointId:      "cp1",
+		Link:              "link1",
+		CreatedAt:         time.Now(),
+	}
+	store.AddCheckpoint(ctx, checkpoint1)
+
+	// model1 has hyperparameters, so it may only be deleted along with them
+	err = store.DeleteModel(ctx, "model1", storage.DeleteOptions{})
+	assert.Equal(t, storage.ErrResourceHasChildren, err)
+	_, err = store.GetModel(ctx, "model1")
+	assert.NoError(t, err)
+
+	err = store.DeleteModel(ctx, "model1", storage.DeleteOptions{Cascade: true})
+	assert.NoError(t, err)
+	_, err = store.GetModel(ctx, "model1")
+	assert.Equal(t, storage.ModelDoesNotExistError, err)
+
+	err = store.DeleteModel(ctx, "model2", storage.DeleteOptions{})
+	assert.NoError(t, err)
+
+	models, err := store.ListModels(ctx, "", 10)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{}, models.Ids)
+
+	// nothing of the deleted model survives when it is recreated
+	store.AddModel(ctx, model1)
+	hyperparameters, err := store.ListHyperparameters(ctx, "model1", "", 10)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{}, hyperparameters.Ids)
+}
+
+func Test_DeleteHyperparameters(t *testing.T, store storage.RepositoryStorage) {
+	ctx := context.Background()
+
+	err := store.DeleteHyperparameters(ctx, "model1", "params1", storage.DeleteOptions{})
+	assert.Equal(t, storage.ModelDoesNotExistError, err)
+
+	model1 := storage.Model{
+		ModelId:                  "model1",
+		Details:                  "desc",
+		CanonicalHyperparameters: "params1",
+	}
+	store.AddModel(ctx, model1)
+
+	err = store.DeleteHyperparameters(ctx, "model1", "params1", storage.DeleteOptions{})
+	assert.Equal(t, storage.HyperparametersDoesNotExistError, err)
+
+	params := storage.Hyperparameters{
+		ModelId:           "model1",
+		HyperparametersId: "params1",
+		Hyperparameters:   map[string]string{"hp1": "1"},
+	}
+	store.AddHyperparameters(ctx, params)
+	params.HyperparametersId = "params2"
+	params.UpgradeTo = "params3"
+	store.AddHyperparameters(ctx, params)
+	params.HyperparametersId = "params3"
+	params.UpgradeTo = ""
+	store.AddHyperparameters(ctx, params)
+
+	checkpoint1 := storage.Checkpoint{
+		ModelId:           "model1",
+		HyperparametersId: "params2",
+		CheckpointId:      "cp1",
+		Link:              "link1",
+		CreatedAt:         time.Now(),
+	}
+	store.AddCheckpoint(ctx, checkpoint1)
+
+	// params1 is the canonical hyperparameters of model1
+	err = store.DeleteHyperparameters(ctx, "model1", "params1", storage.DeleteOptions{})
+	assert.Equal(t, storage.ErrResourceIsReferenced, err)
+
+	// params3 is what params2 upgrades to
+	err = store.DeleteHyperparameters(ctx, "model1", "params3", storage.DeleteOptions{Cascade: true})
+	assert.Equal(t, storage.ErrResourceIsReferenced, err)
+
+	// params2 has a checkpoint
+	err = store.DeleteHyperparameters(ctx, "model1", "params2", storage.DeleteOptions{Force: true})
+	assert.Equal(t, storage.ErrResourceHasChildren, err)
+
+	err = store.DeleteHyperparameters(ctx, "model1", "params2", storage.DeleteOptions{Cascade: true})
+	assert.NoError(t, err)
+	_, err = store.GetHyperparameters(ctx, "model1", "params2")
+	assert.Equal(t, storage.HyperparametersDoesNotExistError, err)
+
+	// with params2 gone, nothing references params3 any more
+	err = store.DeleteHyperparameters(ctx, "model1", "params3", storage.DeleteOptions{})
+	assert.NoError(t, err)
+
+	err = store.DeleteHyperparameters(ctx, "model1", "params1", storage.DeleteOptions{Force: true})
+	assert.NoError(t, err)
+
+	hyperparameters, err := store.ListHyperparameters(ctx, "model1", "", 10)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{}, hyperparameters.Ids)
+
+	// nothing of the deleted hyperparameters survives when they are recreated
+	params.HyperparametersId = "params2"
+	store.AddHyperparameters(ctx, params)
+	checkpoints, err := store.ListCheckpoints(ctx, "model1", "params2", "", 10, false)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{}, checkpoints.Ids)
+}
+
+func Test_DeleteCheckpoint(t *testing.T, store storage.RepositoryStorage) {
+	ctx := context.Background()
+
+	err := store.DeleteCheckpoint(ctx, "model1", "params1", "cp1", storage.DeleteOptions{})
+	assert.Equal(t, storage.ModelDoesNotExistError, err)
+
+	model1 := storage.Model{
+		ModelId:                  "model1",
+		Details:                  "desc",
+		CanonicalHyperparameters: "params1",
+	}
+	store.AddModel(ctx, model1)
+
+	err = store.DeleteCheckpoint(ctx, "model1", "params1", "cp1", storage.DeleteOptions{})
+	assert.Equal(t, storage.HyperparametersDoesNotExistError, err)
+
+	params1 := storage.Hyperparameters{
+		ModelId:             "model1",
+		HyperparametersId:   "params1",
+		CanonicalCheckpoint: "cp1",
+		Hyperparameters:     map[string]string{"hp1": "1"},
+	}
+	store.AddHyperparameters(ctx, params1)
+
+	err = store.DeleteCheckpoint(ctx, "model1", "params1", "cp1", storage.DeleteOptions{})
+	assert.Equal(t, storage.CheckpointDoesNotExistError, err)
+
+	checkpoint := storage.Checkpoint{
+		ModelId:           "model1",
+		HyperparametersId: "params1",
+		CheckpointId:      "cp1",
+		Link:              "link1",
+		CreatedAt:         time.Now(),
+	}
+	store.AddCheckpoint(ctx, checkpoint)
+	checkpoint.CheckpointId = "cp2"
+	store.AddCheckpoint(ctx, checkpoint)
+
+	// cp1 is the canonical checkpoint of params1
+	err = store.DeleteCheckpoint(ctx, "model1", "params1", "cp1", storage.DeleteOptions{})
+	assert.Equal(t, storage.ErrResourceIsReferenced, err)
+
+	err = store.DeleteCheckpoint(ctx, "model1", "params1", "cp2", storage.DeleteOptions{})
+	assert.NoError(t, err)
+	_, err = store.GetCheckpoint(ctx, "model1", "params1", "cp2")
+	assert.Equal(t, storage.CheckpointDoesNotExistError, err)
+
+	err = store.DeleteCheckpoint(ctx, "model1", "params1", "cp1", storage.DeleteOptions{Force: true})
+	assert.NoError(t, err)
+
+	checkpoints, err := store.ListCheckpoints(ctx, "model1", "params1", "", 10, false)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{}, checkpoints.Ids)
+}
+
+func Test_Tags(t *testing.T, store storage.RepositoryStorage) {
+	ctx := context.Background()
+
+	_, err := store.SetTag(ctx, storage.Tag{ModelId: "model1", Tag: "production", Target: "params1"})
+	assert.Equal(t, storage.ModelDoesNotExistError, err)
+
+	err = store.AddModel(ctx, storage.Model{ModelId: "model1", Details: "desc"})
+	assert.NoError(t, err)
+	for _, hyperparametersId := range []string{"params1", "params2"} {
+		err = store.AddHyperparameters(ctx, storage.Hyperparameters{
+			ModelId:           "model1",
+			HyperparametersId: hyperparametersId,
+			Hyperparameters:   map[string]string{"hp1": "1"},
+		})
+		assert.NoError(t, err)
+	}
+	for _, checkpointId := range []string{"cp1", "cp2"} {
+		err = store.AddCheckpoint(ctx, storage.Checkpoint{
+			ModelId:           "model1",
+			HyperparametersId: "params1",
+			CheckpointId:      checkpointId,
+			Link:              "link",
+			CreatedAt:         time.Now(),
+		})
+		assert.NoError(t, err)
+	}
+
+	_, err = store.SetTag(ctx, storage.Tag{ModelId: "model1", HyperparametersId: "params3", Tag: "production", Target: "cp1"})
+	assert.Equal(t, storage.HyperparametersDoesNotExistError, err)
+	_, err = store.GetTag(ctx, "model1", "params1", "production")
+	assert.Equal(t, storage.TagDoesNotExistError, err)
+	tags, err := store.ListTags(ctx, "model1", "params1")
+	assert.NoError(t, err)
+	assert.Empty(t, tags)
+
+	// Tags on the model and on its hyperparameters are separate
+	_, err = store.SetTag(ctx, storage.Tag{ModelId: "model1", Tag: "production", Target: "params1"})
+	assert.NoError(t, err)
+	_, err = store.SetTag(ctx, storage.Tag{ModelId: "model1", HyperparametersId: "params1", Tag: "production", Target: "cp1"})
+	assert.NoError(t, err)
+	_, err = store.SetTag(ctx, storage.Tag{ModelId: "model1", HyperparametersId: "params1", Tag: "production-canary", Target: "cp2"})
+	assert.NoError(t, err)
+	_, err = store.SetTag(ctx, storage.Tag{ModelId: "model1", HyperparametersId: "params1", Tag: "staging", Target: "cp1"})
+	assert.NoError(t, err)
+
+	tag, err := store.GetTag(ctx, "model1", "", "production")
+	assert.NoError(t, err)
+	assert.Equal(t, "params1", tag.Target)
+	assert.False(t, tag.UpdatedAt.IsZero())
+	tag, err = store.GetTag(ctx, "model1", "params1", "production")
+	assert.NoError(t, err)
+	assert.Equal(t, "cp1", tag.Target)
+
+	// Moving a tag
+	tag, err = store.SetTag(ctx, storage.Tag{ModelId: "model1", HyperparametersId: "params1", Tag: "staging", Target: "cp2"})
+	assert.NoError(t, err)
+	assert.Equal(t, "cp2", tag.Target)
+	assert.False(t, tag.UpdatedAt.IsZero())
+	tags, err = store.ListTags(ctx, "model1", "params1")
+	assert.NoError(t, err)
+	names := make([]string, len(tags))
+	for i, tag := range tags {
+		names[i] = tag.Tag
+		assert.Equal(t, "model1", tag.ModelId)
+		assert.Equal(t, "params1", tag.HyperparametersId)
+	}
+	assert.Equal(t, []string{"production", "production-canary", "staging"}, names)
+	assert.Equal(t, "cp2", tags[2].Target)
+	tags, err = store.ListTags(ctx, "model1", "")
+	assert.NoError(t, err)
+	assert.Len(t, tags, 1)
+
+	// Tagged resources are referenced
+	err = store.DeleteCheckpoint(ctx, "model1", "params1", "cp2", storage.DeleteOptions{})
+	assert.Equal(t, storage.ErrResourceIsReferenced, err)
+	err = store.DeleteHyperparameters(ctx, "model1", "params1", storage.DeleteOptions{Cascade: true})
+	assert.Equal(t, storage.ErrResourceIsReferenced, err)
+
+	err = store.DeleteTag(ctx, "model1", "params1", "staging")
+	assert.NoError(t, err)
+	err = store.DeleteTag(ctx, "model1", "params1", "staging")
+	assert.Equal(t, storage.TagDoesNotExistError, err)
+	err = store.DeleteTag(ctx, "model1", "params1", "production-canary")
+	assert.NoError(t, err)
+	err = store.DeleteCheckpoint(ctx, "model1", "params1", "cp2", storage.DeleteOptions{})
+	assert.NoError(t, err)
+
+	// Tags are deleted along with the hyperparameters they are on
+	_, err = store.SetTag(ctx, storage.Tag{ModelId: "model1", HyperparametersId: "params2", Tag: "staging", Target: "cp3"})
+	assert.NoError(t, err)
+	err = store.DeleteHyperparameters(ctx, "model1", "params2", storage.DeleteOptions{})
+	assert.NoError(t, err)
+	err = store.AddHyperparameters(ctx, storage.Hyperparameters{
+		ModelId:           "model1",
+		HyperparametersId: "params2",
+		Hyperparameters:   map[string]string{"hp1": "2"},
+	})
+	assert.NoError(t, err)
+	tags, err = store.ListTags(ctx, "model1", "params2")
+	assert.NoError(t, err)
+	assert.Empty(t, tags)
+
+	// and with the model
+	err = store.DeleteModel(ctx, "model1", storage.DeleteOptions{Cascade: true, Force: true})
+	assert.NoError(t, err)
+	err = store.AddModel(ctx, storage.Model{ModelId: "model1", Details: "desc"})
+	assert.NoError(t, err)
+	tags, err = store.ListTags(ctx, "model1", "")
+	assert.NoError(t, err)
+	assert.Empty(t, tags)
+}
+
+func Test_Rollouts(t *testing.T, store storage.RepositoryStorage) {
+	ctx := context.Background()
+
+	err := store.AddModel(ctx, storage.Model{ModelId: "model1", Details: "desc"})
+	assert.NoError(t, err)
+	err = store.AddHyperparameters(ctx, storage.Hyperparameters{
+		ModelId:           "model1",
+		HyperparametersId: "params1",
+		Hyperparameters:   map[string]string{"hp1": "1"},
+	})
+	assert.NoError(t, err)
+	for _, checkpointId := range []string{"cp1", "cp2"} {
+		err = store.AddCheckpoint(ctx, storage.Checkpoint{
+			ModelId:           "model1",
+			HyperparametersId: "params1",
+			CheckpointId:      checkpointId,
+			Link:              "link-" + checkpointId,
+			CreatedAt:         time.Now(),
+		})
+		assert.NoError(t, err)
+	}
+
+	_, err = storage.ResolveModelForClient(ctx, store, "model1", "client")
+	assert.Equal(t, storage.ErrNoCanonicalHyperparameters, err)
+	_, err = store.UpdateModel(ctx, storage.Model{ModelId: "model1", CanonicalHyperparameters: "params1"})
+	assert.NoError(t, err)
+	_, err = storage.ResolveModelForClient(ctx, store, "model1", "client")
+	assert.Equal(t, storage.ErrNoCanonicalCheckpoint, err)
+	_, err = store.UpdateHyperparameters(ctx, storage.Hyperparameters{
+		ModelId:             "model1",
+		HyperparametersId:   "params1",
+		CanonicalCheckpoint: "cp1",
+	})
+	assert.NoError(t, err)
+
+	hyperparameters, err := store.GetHyperparameters(ctx, "model1", "params1")
+	assert.NoError(t, err)
+	assert.Empty(t, hyperparameters.Rollout)
+
+	_, err = store.UpdateHyperparameters(ctx, storage.Hyperparameters{
+		ModelId:           "model1",
+		HyperparametersId: "params1",
+		Rollout:           []storage.RolloutTarget{{CheckpointId: "cp3", Weight: 5}},
+	})
+	assert.Equal(t, storage.ErrRolloutTargetDoesNotExist, err)
+
+	rollout := []storage.RolloutTarget{{CheckpointId: "cp2", Weight: 5}}
+	hyperparameters, err = store.UpdateHyperparameters(ctx, storage.Hyperparameters{
+		ModelId:           "model1",
+		HyperparametersId: "params1",
+		Rollout:           rollout,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, rollout, hyperparameters.Rollout)
+	assert.Equal(t, "cp1", hyperparameters.CanonicalCheckpoint)
+
+	// Updates which do not mention the rollout keep it
+	_, err = store.UpdateHyperparameters(ctx, storage.Hyperparameters{
+		ModelId:           "model1",
+		HyperparametersId: "params1",
+		Labels:            map[string]string{"stage": "rollout"},
+	})
+	assert.NoError(t, err)
+	hyperparameters, err = store.GetHyperparameters(ctx, "model1", "params1")
+	assert.NoError(t, err)
+	assert.Equal(t, rollout, hyperparameters.Rollout)
+
+	// Clients in the first 5 buckets get the rolled out checkpoint, the rest stay where they were
+	inRolloutClient, outOfRolloutClient := "", ""
+	for i := 0; inRolloutClient == "" || outOfRolloutClient == ""; i++ {
+		clientId := fmt.Sprintf("client%d", i)
+		if storage.RolloutBucket("model1", "params1", clientId) < 5 {
+			inRolloutClient = clientId
+		} else {
+			outOfRolloutClient = clientId
+		}
+	}
+	resolution, err := storage.ResolveModelForClient(ctx, store, "model1", inRolloutClient)
+	assert.NoError(t, err)
+	assert.Equal(t, "cp2", resolution.Checkpoint.CheckpointId)
+	assert.Equal(t, "link-cp2", resolution.Checkpoint.Link)
+	assert.True(t, resolution.InRollout)
+	assert.True(t, resolution.Bucket < 5)
+	assert.Equal(t, []string{"params1"}, resolution.UpgradePath)
+	resolution, err = storage.ResolveModelForClient(ctx, store, "model1", outOfRolloutClient)
+	assert.NoError(t, err)
+	assert.Equal(t, "cp1", resolution.Checkpoint.CheckpointId)
+	assert.False(t, resolution.InRollout)
+
+	// Checkpoints which are being rolled out cannot be deleted
+	err = store.DeleteCheckpoint(ctx, "model1", "params1", "cp2", storage.DeleteOptions{})
+	assert.Equal(t, storage.ErrResourceIsReferenced, err)
+
+	// An empty rollout ends it
+	hyperparameters, err = store.UpdateHyperparameters(ctx, storage.Hyperparameters{
+		ModelId:           "model1",
+		HyperparametersId: "params1",
+		Rollout:           []storage.RolloutTarget{},
+	})
+	assert.NoError(t, err)
+	assert.Empty(t, hyperparameters.Rollout)
+	resolution, err = storage.ResolveModelForClient(ctx, store, "model1", inRolloutClient)
+	assert.NoError(t, err)
+	assert.Equal(t, "cp1", resolution.Checkpoint.CheckpointId)
+	assert.False(t, resolution.InRollout)
+	err = store.DeleteCheckpoint(ctx, "model1", "params1", "cp2", storage.DeleteOptions{})
+	assert.NoError(t, err)
+}
+
+func Test_FindDanglingReferences(t *testing.T, store storage.RepositoryStorage) {
+	ctx := context.Background()
+
+	danglingReferences, err := storage.FindDanglingReferences(ctx, store)
+	assert.NoError(t, err)
+	assert.Empty(t, danglingReferences)
+
+	store.AddModel(ctx, storage.Model{
+		ModelId:                  "model1",
+		Details:                  "desc",
+		CanonicalHyperparameters: "params1",
+	})
+	store.AddModel(ctx, storage.Model{
+		ModelId:                  "model2",
+		Details:                  "desc",
+		CanonicalHyperparameters: "params3",
+	})
+	store.AddHyperparameters(ctx, storage.Hyperparameters{
+		ModelId:             "model1",
+		HyperparametersId:   "params1",
+		CanonicalCheckpoint: "cp1",
+		UpgradeTo:           "params2",
+		Hyperparameters:     map[string]string{"hp1": "1"},
+	})
+	store.AddHyperparameters(ctx, storage.Hyperparameters{
+		ModelId:             "model1",
+		HyperparametersId:   "params2",
+		CanonicalCheckpoint: "cp2",
+		UpgradeTo:           "params3",
+		Hyperparameters:     map[string]string{"hp1": "2"},
+	})
+	store.AddCheckpoint(ctx, storage.Checkpoint{
+		ModelId:           "model1",
+		HyperparametersId: "params1",
+		CheckpointId:      "cp1",
+		Link:              "link1",
+		CreatedAt:         time.Now(),
+	})
+
+	danglingReferences, err = storage.FindDanglingReferences(ctx, store)
+	assert.NoError(t, err)
+	assert.Equal(t, []storage.DanglingReference{
+		{
+			ResourcePath: "/models/model1/hyperparameters/params2",
+			Field:        "CanonicalCheckpoint",
+			Reference:    "/models/model1/hyperparameters/params2/checkpoints/cp2",
+		},
+		{
+			ResourcePath: "/models/model1/hyperparameters/params2",
+			Field:        "UpgradeTo",
+			Reference:    "/models/model1/hyperparameters/params3",
+		},
+		{
+			ResourcePath: "/models/model2",
+			Field:        "CanonicalHyperparameters",
+			Reference:    "/models/model2/hyperparameters/params3",
+		},
+	}, danglingReferences)
+
+	// Tags which point to missing resources are dangling too
+	_, err = store.SetTag(ctx, storage.Tag{ModelId: "model1", Tag: "production", Target: "params3"})
+	assert.NoError(t, err)
+	_, err = store.SetTag(ctx, storage.Tag{ModelId: "model1", HyperparametersId: "params1", Tag: "production", Target: "cp1"})
+	assert.NoError(t, err)
+	_, err = store.SetTag(ctx, storage.Tag{ModelId: "model1", HyperparametersId: "params1", Tag: "staging", Target: "cp3"})
+	assert.NoError(t, err)
+
+	danglingReferences, err = storage.FindDanglingReferences(ctx, store)
+	assert.NoError(t, err)
+	assert.Contains(t, danglingReferences, storage.DanglingReference{
+		ResourcePath: "/models/model1/tags/production",
+		Field:        "Target",
+		Reference:    "/models/model1/hyperparameters/params3",
+	})
+	assert.Contains(t, danglingReferences, storage.DanglingReference{
+		ResourcePath: "/models/model1/hyperparameters/params1/tags/staging",
+		Field:        "Target",
+		Reference:    "/models/model1/hyperparameters/params1/checkpoints/cp3",
+	})
+
+	// and so are rollouts
+	store.AddHyperparameters(ctx, storage.Hyperparameters{
+		ModelId:           "model2",
+		HyperparametersId: "params1",
+		Hyperparameters:   map[string]string{"hp1": "1"},
+		Rollout:           []storage.RolloutTarget{{CheckpointId: "cp1", Weight: 10}},
+	})
+
+	danglingReferences, err = storage.FindDanglingReferences(ctx, store)
+	assert.NoError(t, err)
+	assert.Contains(t, danglingReferences, storage.DanglingReference{
+		ResourcePath: "/models/model2/hyperparameters/params1",
+		Field:        "Rollout",
+		Reference:    "/models/model2/hyperparameters/params1/checkpoints/cp1",
+	})
+	assert.Len(t, danglingReferences, 6)
+}
+
+func Test_BatchGet(t *testing.T, store storage.RepositoryStorage) {
+	ctx := context.Background()
+
+	models, err := store.BatchGetModels(ctx, []string{})
+	assert.NoError(t, err)
+	assert.Empty(t, models)
+
+	for _, modelId := range []string{"model1", "model2", "model3"} {
+		err = store.AddModel(ctx, storage.Model{ModelId: modelId, Details: "details of " + modelId})
+		assert.NoError(t, err)
+	}
+
+	models, err = store.BatchGetModels(ctx, []string{"model3", "model1"})
+	assert.NoError(t, err)
+	assert.Equal(t, []storage.Model{
+		{ModelId: "model3", Details: "details of model3", Version: 1},
+		{ModelId: "model1", Details: "details of model1", Version: 1},
+	}, models)
+
+	_, err = store.BatchGetModels(ctx, []string{"model1", "nomodel"})
+	assert.Equal(t, storage.ModelDoesNotExistError, err)
+
+	for _, hyperparametersId := range []string{"params1", "params2"} {
+		err = store.AddHyperparameters(ctx, storage.Hyperparameters{
+			ModelId:           "model1",
+			HyperparametersId: hyperparametersId,
+			Hyperparameters:   map[string]string{"id": hyperparametersId},
+		})
+		assert.NoError(t, err)
+	}
+
+	hyperparameters, err := store.BatchGetHyperparameters(ctx, "model1", []string{"params2", "params1"})
+	assert.NoError(t, err)
+	assert.Len(t, hyperparameters, 2)
+	assert.Equal(t, "params2", hyperparameters[0].HyperparametersId)
+	assert.Equal(t, map[string]string{"id": "params1"}, hyperparameters[1].Hyperparameters)
+
+	_, err = store.BatchGetHyperparameters(ctx, "model1", []string{"params1", "noparams"})
+	assert.Equal(t, storage.HyperparametersDoesNotExistError, err)
+	_, err = store.BatchGetHyperparameters(ctx, "nomodel", []string{"params1"})
+	assert.Equal(t, storage.ModelDoesNotExistError, err)
+
+	createdAt := time.Now().UTC().Truncate(time.Second)
+	for _, checkpointId := range []string{"cp1", "cp2", "cp3"} {
+		err = store.AddCheckpoint(ctx, storage.Checkpoint{
+			ModelId:           "model1",
+			HyperparametersId: "params1",
+			CheckpointId:      checkpointId,
+			Link:              "link-" + checkpointId,
+			CreatedAt:         createdAt,
+			Info:              map[string]string{"id": checkpointId},
+		})
+		assert.NoError(t, err)
+	}
+	_, err = store.UpdateCheckpointState(ctx, "model1", "params1", "cp2", api.CheckpointState_ARCHIVED)
+	assert.NoError(t, err)
+
+	checkpoints, err := store.BatchGetCheckpoints(ctx, "model1", "params1", []string{"cp1", "cp2", "cp3"})
+	assert.NoError(t, err)
+	assert.Len(t, checkpoints, 3)
+	for i, checkpointId := range []string{"cp1", "cp2", "cp3"} {
+		assert.Equal(t, checkpointId, checkpoints[i].CheckpointId)
+		assert.Equal(t, "link-"+checkpointId, checkpoints[i].Link)
+		assert.Equal(t, map[string]string{"id": checkpointId}, checkpoints[i].Info)
+		assert.True(t, createdAt.Equal(checkpoints[i].CreatedAt))
+	}
+	assert.Equal(t, api.CheckpointState_ARCHIVED, checkpoints[1].State)
+
+	_, err = store.BatchGetCheckpoints(ctx, "model1", "params1", []string{"cp1", "nocp"})
+	assert.Equal(t, storage.CheckpointDoesNotExistError, err)
+	_, err = store.BatchGetCheckpoints(ctx, "model1", "noparams", []string{"cp1"})
+	assert.Equal(t, storage.HyperparametersDoesNotExistError, err)
+}
+
+func Test_Labels(t *testing.T, store storage.RepositoryStorage) {
+	ctx := context.Background()
+
+	err := store.AddModel(ctx, storage.Model{
+		ModelId: "model1",
+		Details: "details",
+		Labels:  map[string]string{"team": "vision"},
+	})
+	assert.NoError(t, err)
+	model, err := store.GetModel(ctx, "model1")
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{"team": "vision"}, model.Labels)
+
+	// Updates without labels leave the stored labels alone
+	model.Labels = nil
+	model.Details = "new details"
+	_, err = store.UpdateModel(ctx, model)
+	assert.NoError(t, err)
+	model, err = store.GetModel(ctx, "model1")
+	assert.NoError(t, err)
+	assert.Equal(t, "new details", model.Details)
+	assert.Equal(t, map[string]string{"team": "vision"}, model.Labels)
+
+	// and updates with labels replace them
+	model.Labels = map[string]string{"team": "audio", "stage": "beta"}
+	_, err = store.UpdateModel(ctx, model)
+	assert.NoError(t, err)
+	model, err = store.GetModel(ctx, "model1")
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{"team": "audio", "stage": "beta"}, model.Labels)
+
+	err = store.AddHyperparameters(ctx, storage.Hyperparameters{
+		ModelId:           "model1",
+		HyperparametersId: "params1",
+		Labels:            map[string]string{"size": "small"},
+	})
+	assert.NoError(t, err)
+	hyperparameters, err := store.GetHyperparameters(ctx, "model1", "params1")
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{"size": "small"}, hyperparameters.Labels)
+
+	hyperparameters.Labels = map[string]string{"size": "large"}
+	_, err = store.UpdateHyperparameters(ctx, hyperparameters)
+	assert.NoError(t, err)
+	hyperparameters, err = store.GetHyperparameters(ctx, "model1", "params1")
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{"size": "large"}, hyperparameters.Labels)
+
+	for i, accuracy := range []string{"0.91", "0.85", "0.95", "0.97"} {
+		err = store.AddCheckpoint(ctx, storage.Checkpoint{
+			ModelId:           "model1",
+			HyperparametersId: "params1",
+			CheckpointId:      fmt.Sprintf("cp%d", i+1),
+			Link:              "link",
+			CreatedAt:         time.Now().UTC(),
+			Info:              map[string]string{"accuracy": accuracy},
+			Labels:            map[string]string{"stage": "prod"},
+		})
+		assert.NoError(t, err)
+	}
+	checkpoint, err := store.GetCheckpoint(ctx, "model1", "params1", "cp1")
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{"stage": "prod"}, checkpoint.Labels)
+
+	_, err = store.UpdateCheckpointState(ctx, "model1", "params1", "cp4", api.CheckpointState_ARCHIVED)
+	assert.NoError(t, err)
+
+	f, err := filter.Parse("info.accuracy>0.9 AND label.stage=prod")
+	assert.NoError(t, err)
+	res, checkpoints, err := storage.FilterCheckpoints(ctx, store, "model1", "params1", "", 1, false, f)
+	assert.NoError(t, err)
+	assert.Equal(t, storage.ListResult{Ids: []string{"cp1"}, NextMarker: "cp1"}, res)
+	assert.Len(t, checkpoints, 1)
+	assert.Equal(t, "0.91", checkpoints[0].Info["accuracy"])
+	res, _, err = storage.FilterCheckpoints(ctx, store, "model1", "params1", res.NextMarker, 1, false, f)
+	assert.NoError(t, err)
+	assert.Equal(t, storage.ListResult{Ids: []string{"cp3"}}, res)
+	res, _, err = storage.FilterCheckpoints(ctx, store, "model1", "params1", "", 10, true, f)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"cp1", "cp3", "cp4"}, res.Ids)
+
+	f, err = filter.Parse("label.team=audio")
+	assert.NoError(t, err)
+	res, models, err := storage.FilterModels(ctx, store, "", 10, f)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"model1"}, res.Ids)
+	assert.Equal(t, "new details", models[0].Details)
+
+	f, err = filter.Parse("label.size=small")
+	assert.NoError(t, err)
+	res, _, err = storage.FilterHyperparameters(ctx, store, "model1", "", 10, f)
+	assert.NoError(t, err)
+	assert.Empty(t, res.Ids)
+}
+
+// UploadToFileURL - uploads contents to a file:// upload URL, the way clients of the backends which
+// use storage.LocalCheckpointUploadURL do.
+func UploadToFileURL(uploadURL string, contents []byte) error {
+	if !strings.HasPrefix(uploadURL, "file://") {
+		return fmt.Errorf("Not a file:// URL: %s", uploadURL)
+	}
+	return ioutil.WriteFile(filepath.FromSlash(strings.TrimPrefix(uploadURL, "file://")), contents, 0644)
+}
+
+func Test_CheckpointUpload(t *testing.T, store storage.RepositoryStorage, upload func(uploadURL string, contents []byte) error) {
+	ctx := context.Background()
+
+	err := store.AddModel(ctx, storage.Model{ModelId: "model1", Details: "details"})
+	assert.NoError(t, err)
+	err = store.AddHyperparameters(ctx, storage.Hyperparameters{ModelId: "model1", HyperparametersId: "params1"})
+	assert.NoError(t, err)
+	err = store.AddCheckpoint(ctx, storage.Checkpoint{
+		ModelId:           "model1",
+		HyperparametersId: "params1",
+		CheckpointId:      "cp1",
+		Link:              "link",
+		CreatedAt:         time.Now().UTC(),
+	})
+	assert.NoError(t, err)
+
+	uploadURL, link, err := store.CheckpointUploadURL(ctx, "model1", "params1", "cp2", time.Now().Add(time.Hour))
+	assert.NoError(t, err)
+	assert.NotEmpty(t, uploadURL)
+	assert.NotEmpty(t, link)
+	err = store.AddCheckpoint(ctx, storage.Checkpoint{
+		ModelId:           "model1",
+		HyperparametersId: "params1",
+		CheckpointId:      "cp2",
+		Link:              link,
+		CreatedAt:         time.Now().UTC(),
+		State:             api.CheckpointState_PENDING,
+	})
+	assert.NoError(t, err)
+
+	// Pending checkpoints are never listed, even with includeArchived
+	for _, includeArchived := range []bool{false, true} {
+		res, err := store.ListCheckpoints(ctx, "model1", "params1", "", 10, includeArchived)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"cp1"}, res.Ids)
+	}
+	checkpoint, err := store.GetCheckpoint(ctx, "model1", "params1", "cp2")
+	assert.NoError(t, err)
+	assert.Equal(t, api.CheckpointState_PENDING, checkpoint.State)
+	assert.Equal(t, link, checkpoint.Link)
+
+	uploaded, err := store.CheckpointBundleExists(ctx, "model1", "params1", "cp2")
+	assert.NoError(t, err)
+	assert.False(t, uploaded)
+
+	err = upload(uploadURL, []byte("bundle"))
+	assert.NoError(t, err)
+	uploaded, err = store.CheckpointBundleExists(ctx, "model1", "params1", "cp2")
+	assert.NoError(t, err)
+	assert.True(t, uploaded)
+	// Other checkpoints' bundles are unaffected
+	uploaded, err = store.CheckpointBundleExists(ctx, "model1", "params1", "cp1")
+	assert.NoError(t, err)
+	assert.False(t, uploaded)
+
+	// Uploaded bundles can be read back through their links
+	reader, err := store.OpenCheckpointLink(ctx, link)
+	assert.NoError(t, err)
+	if err == nil {
+		contents, err := ioutil.ReadAll(reader)
+		reader.Close()
+		assert.NoError(t, err)
+		assert.Equal(t, "bundle", string(contents))
+	}
+	for _, unreadable := range []string{"https://example.com/bundle.zip", "file:///etc/passwd"} {
+		_, err = store.OpenCheckpointLink(ctx, unreadable)
+		assert.Equal(t, storage.ErrLinkNotReadable, err, unreadable)
+	}
+	_, err = store.OpenCheckpointLink(ctx, strings.Replace(link, "cp2.tiobundle.zip", "missing.tiobundle.zip", 1))
+	assert.Equal(t, storage.ErrLinkDoesNotExist, err)
+
+	updated, err := store.UpdateCheckpointInfo(ctx, "model1", "params1", "cp2", map[string]string{"tiobundle.backend": "tflite"})
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{"tiobundle.backend": "tflite"}, updated.Info)
+	assert.Equal(t, api.CheckpointState_PENDING, updated.State)
+	_, err = store.UpdateCheckpointInfo(ctx, "model1", "params1", "cp3", nil)
+	assert.Equal(t, storage.CheckpointDoesNotExistError, err)
+
+	_, err = store.UpdateCheckpointState(ctx, "model1", "params1", "cp2", api.CheckpointState_ACTIVE)
+	assert.NoError(t, err)
+	checkpoint, err = store.GetCheckpoint(ctx, "model1", "params1", "cp2")
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{"tiobundle.backend": "tflite"}, checkpoint.Info)
+	res, err := store.ListCheckpoints(ctx, "model1", "params1", "", 10, false)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"cp1", "cp2"}, res.Ids)
+
+	// Deleting a checkpoint deletes its bundle
+	err = store.DeleteCheckpoint(ctx, "model1", "params1", "cp2", storage.DeleteOptions{})
+	assert.NoError(t, err)
+	uploaded, err = store.CheckpointBundleExists(ctx, "model1", "params1", "cp2")
+	assert.NoError(t, err)
+	assert.False(t, uploaded)
+	_, err = store.OpenCheckpointLink(ctx, link)
+	assert.Equal(t, storage.ErrLinkDoesNotExist, err)
+	// Checkpoints without a bundle can be deleted too
+	err = store.DeleteCheckpoint(ctx, "model1", "params1", "cp1", storage.DeleteOptions{})
+	assert.NoError(t, err)
+
+	// So do cascading deletes of hyperparameters and models
+	err = store.AddHyperparameters(ctx, storage.Hyperparameters{ModelId: "model1", HyperparametersId: "params2"})
+	assert.NoError(t, err)
+	for _, ids := range [][2]string{{"params1", "cp3"}, {"params2", "cp4"}} {
+		uploadURL, link, err := store.CheckpointUploadURL(ctx, "model1", ids[0], ids[1], time.Now().Add(time.Hour))
+		assert.NoError(t, err)
+		err = store.AddCheckpoint(ctx, storage.Checkpoint{
+			ModelId:           "model1",
+			HyperparametersId: ids[0],
+			CheckpointId:      ids[1],
+			Link:              link,
+			CreatedAt:         time.Now().UTC(),
+			State:             api.CheckpointState_PENDING,
+		})
+		assert.NoError(t, err)
+		err = upload(uploadURL, []byte("bundle"))
+		assert.NoError(t, err)
+	}
+	err = store.DeleteHyperparameters(ctx, "model1", "params1", storage.DeleteOptions{Cascade: true})
+	assert.NoError(t, err)
+	uploaded, err = store.CheckpointBundleExists(ctx, "model1", "params1", "cp3")
+	assert.NoError(t, err)
+	assert.False(t, uploaded)
+	uploaded, err = store.CheckpointBundleExists(ctx, "model1", "params2", "cp4")
+	assert.NoError(t, err)
+	assert.True(t, uploaded)
+	err = store.DeleteModel(ctx, "model1", storage.DeleteOptions{Cascade: true})
+	assert.NoError(t, err)
+	uploaded, err = store.CheckpointBundleExists(ctx, "model1", "params2", "cp4")
+	assert.NoError(t, err)
+	assert.False(t, uploaded)
+}
+
+func Test_FleaTasks(t *testing.T, store storage.FleaStorage) {
+	ctx := context.Background()
+
+	_, err := store.GetTask(ctx, "task1")
+	assert.Equal(t, storage.ErrMissingTaskId, err)
+
+	deadline := &timestamp.Timestamp{Seconds: time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC).Unix()}
+	tasks := []api.TaskDetails{
+		{TaskId: "task1", ModelId: "model1", HyperparametersId: "params1", CheckpointId: "cp1", Active: true, Deadline: deadline, Link: "link1"},
+		{TaskId: "task2", ModelId: "model1", HyperparametersId: "params1", CheckpointId: "cp2", Active: true},
+		{TaskId: "task3", ModelId: "model1", HyperparametersId: "params2", CheckpointId: "cp1"},
+		{TaskId: "task4", ModelId: "model2", HyperparametersId: "params1", CheckpointId: "cp1", Active: true},
+		{TaskId: "task5", ModelId: "model1", HyperparametersId: "params1", CheckpointId: "cp1", Active: true},
+	}
+	for _, task := range tasks {
+		err = store.AddTask(ctx, task)
+		assert.NoError(t, err)
+	}
+	err = store.AddTask(ctx, api.TaskDetails{TaskId: "task1", ModelId: "model2"})
+	assert.Equal(t, storage.ErrDuplicateTaskId, err)
+
+	task, err := store.GetTask(ctx, "task1")
+	assert.NoError(t, err)
+	assert.Equal(t, "task1", task.TaskId)
+	assert.Equal(t, "model1", task.ModelId)
+	assert.Equal(t, "params1", task.HyperparametersId)
+	assert.Equal(t, "cp1", task.CheckpointId)
+	assert.True(t, task.Active)
+	assert.Equal(t, deadline.Seconds, task.Deadline.GetSeconds())
+	assert.Equal(t, "link1", task.Link)
+	assert.True(t, strings.HasSuffix(task.CheckpointLink, common.GetCheckpointResourcePath("model1", "params1", "cp1")))
+
+	batch, err := store.BatchGetTasks(ctx, []string{"task2", "task1"})
+	assert.NoError(t, err)
+	if assert.Len(t, batch, 2) {
+		assert.Equal(t, "task2", batch[0].TaskId)
+		assert.Equal(t, "task1", batch[1].TaskId)
+	}
+	_, err = store.BatchGetTasks(ctx, []string{"task1", "task6"})
+	assert.Equal(t, storage.ErrMissingTaskId, err)
+
+	// Listing, with and without filters and paging
+	listings := []struct {
+		req           api.ListTasksRequest
+		taskIds       []string
+		nextPageToken string
+	}{
+		{api.ListTasksRequest{}, []string{"task1", "task2", "task4", "task5"}, ""},
+		{api.ListTasksRequest{IncludeInactive: true}, []string{"task1", "task2", "task3", "task4", "task5"}, ""},
+		{api.ListTasksRequest{ModelId: "model1"}, []string{"task1", "task2", "task5"}, ""},
+		{api.ListTasksRequest{ModelId: "model1", IncludeInactive: true, HyperparametersId: "params2"}, []string{"task3"}, ""},
+		{api.ListTasksRequest{ModelId: "model1", HyperparametersId: "params1", CheckpointId: "cp1"}, []string{"task1", "task5"}, ""},
+		{api.ListTasksRequest{MaxItems: 2}, []string{"task1", "task2"}, common.EncodePageToken("task4")},
+		{api.ListTasksRequest{MaxItems: 2, StartTaskId: "task4"}, []string{"task4", "task5"}, ""},
+		{api.ListTasksRequest{MaxItems: 1, ModelId: "model1", StartTaskId: "task2"}, []string{"task2"}, common.EncodePageToken("task5")},
+		{api.ListTasksRequest{MaxItems: 1, CheckpointId: "cp1", StartTaskId: "task2"}, []string{"task4"}, common.EncodePageToken("task5")},
+		{api.ListTasksRequest{ModelId: "model3"}, nil, ""},
+	}
+	for _, listing := range listings {
+		resp, err := store.ListTasks(ctx, listing.req)
+		assert.NoError(t, err)
+		assert.Equal(t, listing.taskIds, resp.TaskIds, "%v", listing.req)
+		assert.Equal(t, listing.nextPageToken, resp.NextPageToken, "%v", listing.req)
+		assert.Equal(t, listing.req.StartTaskId, resp.StartTaskId)
+		assert.Equal(t, listing.req.MaxItems, resp.MaxItems)
+	}
+
+	err = store.ModifyTask(ctx, api.ModifyTaskRequest{TaskId: "task3", Active: true, Deadline: deadline})
+	assert.NoError(t, err)
+	task, err = store.GetTask(ctx, "task3")
+	assert.NoError(t, err)
+	assert.True(t, task.Active)
+	assert.Equal(t, deadline.Seconds, task.Deadline.GetSeconds())
+	assert.Equal(t, "params2", task.HyperparametersId)
+	resp, err := store.ListTasks(ctx, api.ListTasksRequest{ModelId: "model1"})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"task1", "task2", "task3", "task5"}, resp.TaskIds)
+	err = store.ModifyTask(ctx, api.ModifyTaskRequest{TaskId: "task6", Active: true})
+	assert.Equal(t, storage.ErrMissingTaskId, err)
+
+	// Jobs
+	started, err := store.StartTask(ctx, "task1")
+	assert.NoError(t, err)
+	assert.Equal(t, api.StartTaskResponse_APPROVED, started.Status)
+	assert.NotEmpty(t, started.JobId)
+	assert.Contains(t, started.UploadTo, "task1")
+	assert.Contains(t, started.UploadTo, started.JobId)
+	_, err = store.StartTask(ctx, "task6")
+	assert.Equal(t, storage.ErrMissingTaskId, err)
+
+	err = store.AddJobError(ctx, api.JobErrorRequest{TaskId: "task1", JobId: started.JobId, ErrorMessage: "out of memory"})
+	assert.NoError(t, err)
+	err = store.AddJobError(ctx, api.JobErrorRequest{TaskId: "task1", JobId: started.JobId, ErrorMessage: "out of memory again"})
+	assert.NoError(t, err)
+	err = store.AddJobError(ctx, api.JobErrorRequest{TaskId: "task6", JobId: started.JobId, ErrorMessage: "out of memory"})
+	assert.Equal(t, storage.ErrMissingTaskId, err)
+}
+
+func Test_AuditEvents(t *testing.T, store storage.AuditStorage) {
+	ctx := context.Background()
+
+	events, err := store.ListAuditEvents(ctx, "", 10)
+	assert.NoError(t, err)
+	assert.Empty(t, events)
+
+	start := time.Date(2019, 10, 1, 12, 0, 0, 0, time.UTC)
+	resourcePaths := []string{"/models/a", "/models/a/hyperparameters/h", "/models/ab", "/tasks/t", "/models/a"}
+	expected := make([]storage.AuditEvent, len(resourcePaths))
+	for i, resourcePath := range resourcePaths {
+		eventTime := start.Add(time.Duration(i) * time.Minute)
+		expected[i] = storage.AuditEvent{
+			EventId:      storage.NewAuditEventId(eventTime),
+			Time:         eventTime,
+			Actor:        "ModelsWriter:9f86d081884c",
+			Method:       "/api.Repository/UpdateModel",
+			ResourcePath: resourcePath,
+			Request:      fmt.Sprintf(`{"modelId":"%d"}`, i),
+			Before:       `{"version":1}`,
+			After:        `{"version":2}`,
+		}
+	}
+	// Events are listed in chronological order, whatever order they are added in
+	for _, i := range []int{3, 0, 4, 1, 2} {
+		err = store.AddAuditEvent(ctx, expected[i])
+		assert.NoError(t, err)
+	}
+
+	events, err = store.ListAuditEvents(ctx, "", 10)
+	assert.NoError(t, err)
+	assert.Equal(t, expected, events)
+
+	events, err = store.ListAuditEvents(ctx, expected[1].EventId, 2)
+	assert.NoError(t, err)
+	assert.Equal(t, expected[2:4], events)
+
+	events, err = store.ListAuditEvents(ctx, expected[4].EventId, 2)
+	assert.NoError(t, err)
+	assert.Empty(t, events)
+
+	// Resources match themselves and the resources below them
+	query := storage.AuditQuery{ResourcePath: "/models/a"}
+	events, marker, err := storage.ListMatchingAuditEvents(ctx, store, query, "", 2)
+	assert.NoError(t, err)
+	assert.Equal(t, expected[0:2], events)
+	assert.Equal(t, expected[1].EventId, marker)
+	events, marker, err = storage.ListMatchingAuditEvents(ctx, store, query, marker, 2)
+	assert.NoError(t, err)
+	assert.Equal(t, []storage.AuditEvent{expected[4]}, events)
+	assert.Equal(t, "", marker)
+
+	// Since is inclusive and until is exclusive
+	query = storage.AuditQuery{Since: expected[1].Time, Until: expected[3].Time}
+	events, marker, err = storage.ListMatchingAuditEvents(ctx, store, query, "", 10)
+	assert.NoError(t, err)
+	assert.Equal(t, expected[1:3], events)
+	assert.Equal(t, "", marker)
+
+	// Recent events, spread over several hours, follow the older ones
+	now := time.Now().UTC()
+	recent := make([]storage.AuditEvent, 3)
+	for i := range recent {
+		eventTime := now.Add(time.Duration(i-2) * time.Hour)
+		recent[i] = storage.AuditEvent{
+			EventId:      storage.NewAuditEventId(eventTime),
+			Time:         eventTime,
+			Actor:        "ModelsWriter:9f86d081884c",
+			Method:       "/api.Repository/UpdateModel",
+			ResourcePath: "/models/a",
+			Request:      fmt.Sprintf(`{"modelId":"recent%d"}`, i),
+		}
+		err = store.AddAuditEvent(ctx, recent[i])
+		assert.NoError(t, err)
+	}
+
+	events, err = store.ListAuditEvents(ctx, expected[4].EventId, 10)
+	assert.NoError(t, err)
+	assert.Equal(t, recent, events)
+	events, err = store.ListAuditEvents(ctx, recent[0].EventId, 10)
+	assert.NoError(t, err)
+	assert.Equal(t, recent[1:], events)
+	events, err = store.ListAuditEvents(ctx, recent[1].EventId, 1)
+	assert.NoError(t, err)
+	assert.Equal(t, recent[2:], events)
+	events, err = store.ListAuditEvents(ctx, recent[2].EventId, 10)
+	assert.NoError(t, err)
+	assert.Empty(t, events)
+}
+
+func Test_Webhooks(t *testing.T, store storage.WebhookStorage) {
+	ctx := context.Background()
+
+	webhooks, err := store.ListWebhooks(ctx)
+	assert.NoError(t, err)
+	assert.Empty(t, webhooks)
+
+	createdAt := time.Date(2019, 10, 1, 12, 0, 0, 0, time.UTC)
+	expected := []storage.Webhook{
+		{
+			WebhookId:  "webhook-1",
+			Url:        "https://ci.example.com/hooks/checkpoints",
+			EventTypes: []string{"CreateCheckpoint", "UpdateHyperparameters"},
+			Secret:     "secret-1",
+			CreatedAt:  createdAt,
+		},
+		{
+			WebhookId:  "webhook-2",
+			Url:        "https://hooks.slack.example.com/flea",
+			EventTypes: []string{"JobError"},
+			Secret:     "secret-2",
+			CreatedAt:  createdAt.Add(time.Minute),
+		},
+	}
+	for _, i := range []int{1, 0} {
+		err = store.AddWebhook(ctx, expected[i])
+		assert.NoError(t, err)
+	}
+	webhooks, err = store.ListWebhooks(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, expected, webhooks)
+
+	err = store.DeleteWebhook(ctx, "webhook-1")
+	assert.NoError(t, err)
+	err = store.DeleteWebhook(ctx, "webhook-1")
+	assert.Equal(t, storage.WebhookDoesNotExistError, err)
+	webhooks, err = store.ListWebhooks(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, expected[1:], webhooks)
+
+	deadLetters, err := store.ListWebhookDeadLetters(ctx, "", 10)
+	assert.NoError(t, err)
+	assert.Empty(t, deadLetters)
+
+	expectedDeadLetters := make([]storage.WebhookDeadLetter, 3)
+	for i := range expectedDeadLetters {
+		failedAt := createdAt.Add(time.Duration(i) * time.Hour)
+		expectedDeadLetters[i] = storage.WebhookDeadLetter{
+			DeliveryId: storage.NewAuditEventId(failedAt) + "-webhook-2",
+			WebhookId:  "webhook-2",
+			Url:        "https://hooks.slack.example.com/flea",
+			EventType:  "JobError",
+			Payload:    fmt.Sprintf(`{"resourcePath":"/tasks/t/jobs/%d"}`, i),
+			Attempts:   5,
+			LastError:  "503 Service Unavailable",
+			FailedAt:   failedAt,
+		}
+	}
+	// Dead letters are listed in the order of their IDs, whatever order they are added in
+	for _, i := range []int{2, 0, 1} {
+		err = store.AddWebhookDeadLetter(ctx, expectedDeadLetters[i])
+		assert.NoError(t, err)
+	}
+	deadLetters, err = store.ListWebhookDeadLetters(ctx, "", 10)
+	assert.NoError(t, err)
+	assert.Equal(t, expectedDeadLetters, deadLetters)
+	deadLetters, err = store.ListWebhookDeadLetters(ctx, expectedDeadLetters[0].DeliveryId, 1)
+	assert.NoError(t, err)
+	assert.Equal(t, expectedDeadLetters[1:2], deadLetters)
+	deadLetters, err = store.ListWebhookDeadLetters(ctx, expectedDeadLetters[2].DeliveryId, 10)
 	assert.NoError(t, err)
+	assert.Empty(t, deadLetters)
 }
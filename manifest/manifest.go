@@ -0,0 +1,108 @@
+package manifest
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+
+	"golang.org/x/crypto/ed25519"
+)
+
+// Algorithm - the signature algorithm used for manifests.
+const Algorithm = "ed25519"
+
+// Manifest - the checkpoint metadata which the repository vouches for. Devices verify the
+// signature over the manifest's canonical encoding before trusting any of its fields, and then
+// verify the bundle they downloaded against Sha256 and SizeBytes.
+type Manifest struct {
+	// KeyId - identifies the key which signed the manifest. It is part of the signed data so that a
+	// signature cannot be passed off as having been made by a different key.
+	KeyId             string            `json:"keyId"`
+	ModelId           string            `json:"modelId"`
+	HyperparametersId string            `json:"hyperparametersId"`
+	Hyperparameters   map[string]string `json:"hyperparameters"`
+	CheckpointId      string            `json:"checkpointId"`
+	Link              string            `json:"link"`
+	Sha256            string            `json:"sha256"`
+	SizeBytes         int64             `json:"sizeBytes"`
+	ContentType       string            `json:"contentType"`
+	// CreatedAt - when the checkpoint was created, in RFC 3339 format (UTC).
+	CreatedAt string `json:"createdAt"`
+}
+
+// Encode - returns the canonical JSON encoding of a manifest, which is what gets signed: the fields
+// in the order in which they are declared, map keys in sorted order, no insignificant whitespace
+// and no HTML escaping.
+func Encode(m Manifest) ([]byte, error) {
+	if m.Hyperparameters == nil {
+		m.Hyperparameters = map[string]string{}
+	}
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(false)
+	err := encoder.Encode(m)
+	if err != nil {
+		return nil, err
+	}
+	return bytes.TrimSuffix(buf.Bytes(), []byte("\n")), nil
+}
+
+// Signer - signs manifests with an Ed25519 key. Keys are rotated by deploying a new key under a
+// new key ID; devices keep trusting the old key ID until they have picked up the new public key.
+type Signer struct {
+	keyId      string
+	privateKey ed25519.PrivateKey
+}
+
+// NewSigner - creates a Signer which signs manifests as keyId.
+func NewSigner(keyId string, privateKey ed25519.PrivateKey) (*Signer, error) {
+	if keyId == "" {
+		return nil, errors.New("Key ID must not be empty")
+	}
+	if len(privateKey) != ed25519.PrivateKeySize {
+		return nil, fmt.Errorf("Private key must be %d bytes long", ed25519.PrivateKeySize)
+	}
+	return &Signer{keyId: keyId, privateKey: privateKey}, nil
+}
+
+// NewSignerFromEnv - uses the MANIFEST_SIGNING_KEY_ID and MANIFEST_SIGNING_KEY (a base64 encoded
+// 32 byte Ed25519 seed) environment variables to create a Signer. Returns nil if neither is set.
+func NewSignerFromEnv() (*Signer, error) {
+	keyId := os.Getenv("MANIFEST_SIGNING_KEY_ID")
+	encodedSeed := os.Getenv("MANIFEST_SIGNING_KEY")
+	if keyId == "" && encodedSeed == "" {
+		return nil, nil
+	}
+	seed, err := base64.StdEncoding.DecodeString(encodedSeed)
+	if err != nil {
+		return nil, fmt.Errorf("MANIFEST_SIGNING_KEY is not valid base64: %v", err)
+	}
+	if len(seed) != ed25519.SeedSize {
+		return nil, fmt.Errorf("MANIFEST_SIGNING_KEY must be a %d byte seed", ed25519.SeedSize)
+	}
+	return NewSigner(keyId, ed25519.NewKeyFromSeed(seed))
+}
+
+// KeyId - the ID of the key the signer signs with.
+func (signer *Signer) KeyId() string {
+	return signer.keyId
+}
+
+// PublicKey - the public key which verifies the signer's signatures.
+func (signer *Signer) PublicKey() ed25519.PublicKey {
+	return signer.privateKey.Public().(ed25519.PublicKey)
+}
+
+// Sign - stamps the manifest with the signer's key ID and returns its canonical encoding along
+// with a detached signature over that encoding.
+func (signer *Signer) Sign(m Manifest) ([]byte, []byte, error) {
+	m.KeyId = signer.keyId
+	encoded, err := Encode(m)
+	if err != nil {
+		return nil, nil, err
+	}
+	return encoded, ed25519.Sign(signer.privateKey, encoded), nil
+}
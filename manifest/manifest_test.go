@@ -0,0 +1,56 @@
+package manifest
+
+import (
+	"encoding/base64"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEncodeIsCanonical(t *testing.T) {
+	encoded, err := Encode(Manifest{
+		ModelId:           "model",
+		HyperparametersId: "hp",
+		Hyperparameters:   map[string]string{"b": "2", "a": "<1>"},
+		CheckpointId:      "ckpt",
+		Link:              "https://example.com/ckpt.zip?a=1&b=2",
+		Sha256:            "9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08",
+		SizeBytes:         4,
+		ContentType:       "application/zip",
+		CreatedAt:         "2019-10-01T12:00:00Z",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, `{"keyId":"","modelId":"model","hyperparametersId":"hp","hyperparameters":{"a":"<1>","b":"2"},`+
+		`"checkpointId":"ckpt","link":"https://example.com/ckpt.zip?a=1&b=2",`+
+		`"sha256":"9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08","sizeBytes":4,`+
+		`"contentType":"application/zip","createdAt":"2019-10-01T12:00:00Z"}`, string(encoded))
+
+	encoded, err = Encode(Manifest{})
+	assert.NoError(t, err)
+	assert.Contains(t, string(encoded), `"hyperparameters":{}`)
+}
+
+func TestNewSignerFromEnv(t *testing.T) {
+	defer os.Unsetenv("MANIFEST_SIGNING_KEY_ID")
+	defer os.Unsetenv("MANIFEST_SIGNING_KEY")
+
+	signer, err := NewSignerFromEnv()
+	assert.NoError(t, err)
+	assert.Nil(t, signer)
+
+	os.Setenv("MANIFEST_SIGNING_KEY_ID", "key-1")
+	_, err = NewSignerFromEnv()
+	assert.Error(t, err)
+
+	os.Setenv("MANIFEST_SIGNING_KEY", base64.StdEncoding.EncodeToString([]byte(strings.Repeat("k", 32))))
+	signer, err = NewSignerFromEnv()
+	assert.NoError(t, err)
+	assert.Equal(t, "key-1", signer.KeyId())
+
+	encoded, signature, err := signer.Sign(Manifest{ModelId: "model"})
+	assert.NoError(t, err)
+	assert.Contains(t, string(encoded), `"keyId":"key-1"`)
+	assert.Len(t, signature, 64)
+}
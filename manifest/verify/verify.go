@@ -0,0 +1,97 @@
+// Package verify - checks signed checkpoint manifests, and the bundles they describe, on the
+// receiving end.
+//
+// A client holds a Keyring of the public keys it trusts. To verify a download it calls
+// Keyring.Verify on the manifest and signature returned by GetCheckpointManifest, and then
+// VerifyBundle on the downloaded bundle with the manifest Verify returned. During a key rotation,
+// clients trust both the old and the new key ID until the old key has been retired.
+package verify
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/doc-ai/tensorio-models/manifest"
+	"golang.org/x/crypto/ed25519"
+)
+
+// ErrUnknownKey - returned by Verify for manifests signed by a key which is not in the keyring.
+var ErrUnknownKey = errors.New("Manifest is signed by an unknown key")
+
+// ErrInvalidSignature - returned by Verify if the signature does not match the manifest.
+var ErrInvalidSignature = errors.New("Manifest signature is invalid")
+
+// ErrBundleMismatch - returned by VerifyBundle if the bundle does not match the manifest.
+var ErrBundleMismatch = errors.New("Bundle does not match manifest")
+
+// Keyring - the trusted public keys, by key ID.
+type Keyring map[string]ed25519.PublicKey
+
+// ParsePublicKey - decodes a base64 encoded Ed25519 public key.
+func ParsePublicKey(encoded string) (ed25519.PublicKey, error) {
+	key, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, err
+	}
+	if len(key) != ed25519.PublicKeySize {
+		return nil, fmt.Errorf("Public key must be %d bytes long", ed25519.PublicKeySize)
+	}
+	return ed25519.PublicKey(key), nil
+}
+
+// Verify - checks that encodedManifest was signed by the key keyId refers to, and returns the
+// manifest. The manifest has to name the same key, and has to be in canonical form.
+func (keys Keyring) Verify(encodedManifest []byte, keyId string, signature []byte) (manifest.Manifest, error) {
+	publicKey, ok := keys[keyId]
+	if !ok {
+		return manifest.Manifest{}, ErrUnknownKey
+	}
+	if !ed25519.Verify(publicKey, encodedManifest, signature) {
+		return manifest.Manifest{}, ErrInvalidSignature
+	}
+
+	var m manifest.Manifest
+	decoder := json.NewDecoder(bytes.NewReader(encodedManifest))
+	decoder.DisallowUnknownFields()
+	err := decoder.Decode(&m)
+	if err != nil {
+		return manifest.Manifest{}, err
+	}
+	if m.KeyId != keyId {
+		return manifest.Manifest{}, ErrInvalidSignature
+	}
+	// Anything the signer did not produce itself should not have verified, but make sure that the
+	// manifest we hand back is exactly what was signed.
+	canonical, err := manifest.Encode(m)
+	if err != nil {
+		return manifest.Manifest{}, err
+	}
+	if !bytes.Equal(canonical, encodedManifest) {
+		return manifest.Manifest{}, ErrInvalidSignature
+	}
+	return m, nil
+}
+
+// VerifyBundle - reads the bundle and checks it against the manifest's Sha256 and SizeBytes.
+// Fields which the manifest leaves empty are not checked.
+func VerifyBundle(m manifest.Manifest, bundle io.Reader) error {
+	hash := sha256.New()
+	size, err := io.Copy(hash, bundle)
+	if err != nil {
+		return err
+	}
+	if m.SizeBytes != 0 && m.SizeBytes != size {
+		return ErrBundleMismatch
+	}
+	if m.Sha256 != "" && !strings.EqualFold(m.Sha256, hex.EncodeToString(hash.Sum(nil))) {
+		return ErrBundleMismatch
+	}
+	return nil
+}
@@ -0,0 +1,113 @@
+package verify
+
+import (
+	"bytes"
+	"encoding/base64"
+	"strings"
+	"testing"
+
+	"github.com/doc-ai/tensorio-models/manifest"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/crypto/ed25519"
+)
+
+func newSigner(t *testing.T, keyId string) *manifest.Signer {
+	_, privateKey, err := ed25519.GenerateKey(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	signer, err := manifest.NewSigner(keyId, privateKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return signer
+}
+
+var testManifest = manifest.Manifest{
+	ModelId:           "model",
+	HyperparametersId: "hp",
+	Hyperparameters:   map[string]string{"b": "2", "a": "<1>"},
+	CheckpointId:      "ckpt",
+	Link:              "https://example.com/ckpt.zip?a=1&b=2",
+	Sha256:            "9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08",
+	SizeBytes:         4,
+	ContentType:       "application/zip",
+	CreatedAt:         "2019-10-01T12:00:00Z",
+}
+
+func TestVerify(t *testing.T) {
+	signer := newSigner(t, "key-1")
+	keyring := Keyring{"key-1": signer.PublicKey()}
+
+	encoded, signature, err := signer.Sign(testManifest)
+	assert.NoError(t, err)
+	verified, err := keyring.Verify(encoded, "key-1", signature)
+	assert.NoError(t, err)
+	expected := testManifest
+	expected.KeyId = "key-1"
+	assert.Equal(t, expected, verified)
+
+	_, err = keyring.Verify(encoded, "key-2", signature)
+	assert.Equal(t, ErrUnknownKey, err)
+
+	tampered := bytes.Replace(encoded, []byte(`"sizeBytes":4`), []byte(`"sizeBytes":5`), 1)
+	_, err = keyring.Verify(tampered, "key-1", signature)
+	assert.Equal(t, ErrInvalidSignature, err)
+
+	signature[0] ^= 0xff
+	_, err = keyring.Verify(encoded, "key-1", signature)
+	assert.Equal(t, ErrInvalidSignature, err)
+}
+
+func TestVerifyAcrossKeyRotation(t *testing.T) {
+	retiring := newSigner(t, "2019-01")
+	current := newSigner(t, "2019-07")
+	keyring := Keyring{"2019-01": retiring.PublicKey(), "2019-07": current.PublicKey()}
+
+	for _, signer := range []*manifest.Signer{retiring, current} {
+		encoded, signature, err := signer.Sign(testManifest)
+		assert.NoError(t, err)
+		_, err = keyring.Verify(encoded, signer.KeyId(), signature)
+		assert.NoError(t, err)
+
+		// A signature is only good for the key ID which is in the signed manifest
+		for keyId := range keyring {
+			if keyId != signer.KeyId() {
+				_, err = keyring.Verify(encoded, keyId, signature)
+				assert.Equal(t, ErrInvalidSignature, err)
+			}
+		}
+	}
+}
+
+func TestVerifyRequiresCanonicalManifest(t *testing.T) {
+	_, privateKey, err := ed25519.GenerateKey(nil)
+	assert.NoError(t, err)
+	keyring := Keyring{"key-1": privateKey.Public().(ed25519.PublicKey)}
+
+	// Validly signed, but not something a Signer produces
+	for _, encoded := range []string{
+		`{"keyId":"key-1", "modelId":"model"}`,
+		`{"keyId":"key-1","modelId":"model","unexpected":true}`,
+	} {
+		_, err = keyring.Verify([]byte(encoded), "key-1", ed25519.Sign(privateKey, []byte(encoded)))
+		assert.Error(t, err, encoded)
+	}
+}
+
+func TestVerifyBundle(t *testing.T) {
+	assert.NoError(t, VerifyBundle(testManifest, strings.NewReader("test")))
+	assert.Equal(t, ErrBundleMismatch, VerifyBundle(testManifest, strings.NewReader("tests")))
+	assert.Equal(t, ErrBundleMismatch, VerifyBundle(testManifest, strings.NewReader("tent")))
+	assert.NoError(t, VerifyBundle(manifest.Manifest{}, strings.NewReader("anything")))
+}
+
+func TestParsePublicKey(t *testing.T) {
+	signer := newSigner(t, "key-1")
+	key, err := ParsePublicKey(base64.StdEncoding.EncodeToString(signer.PublicKey()))
+	assert.NoError(t, err)
+	assert.Equal(t, signer.PublicKey(), key)
+
+	_, err = ParsePublicKey("dG9vIHNob3J0")
+	assert.Error(t, err)
+}
@@ -2,43 +2,93 @@ package server
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
 	"fmt"
+	"mime"
 	"net"
 	"net/http"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/doc-ai/tensorio-models/api"
+	"github.com/doc-ai/tensorio-models/audit"
 	"github.com/doc-ai/tensorio-models/authentication"
 	"github.com/doc-ai/tensorio-models/common"
+	"github.com/doc-ai/tensorio-models/filter"
+	"github.com/doc-ai/tensorio-models/manifest"
 	"github.com/doc-ai/tensorio-models/storage"
+	"github.com/doc-ai/tensorio-models/storage/boltdb"
+	"github.com/doc-ai/tensorio-models/storage/filesystem"
 	"github.com/doc-ai/tensorio-models/storage/gcs"
 	"github.com/doc-ai/tensorio-models/storage/memory"
+	"github.com/doc-ai/tensorio-models/storage/s3"
+	"github.com/doc-ai/tensorio-models/tiobundle"
+	"github.com/doc-ai/tensorio-models/webhook"
+	"github.com/golang/protobuf/proto"
 	"github.com/golang/protobuf/ptypes"
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
 	log "github.com/sirupsen/logrus"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
 
+// checkpointUploadURLExpiry - how long the upload URLs handed out by CreateCheckpoint are valid for.
+const checkpointUploadURLExpiry = time.Hour
+
+// maxComparedCheckpoints - how many checkpoints CompareCheckpoints compares at most.
+const maxComparedCheckpoints = 100
+
+// webhookTimeout - how long each attempt to deliver a change to a webhook may take.
+const webhookTimeout = 10 * time.Second
+
+// webhookEventTypes - the methods whose changes can be delivered to webhooks.
+var webhookEventTypes = []string{
+	"CreateModel", "UpdateModel", "DeleteModel",
+	"CreateHyperparameters", "UpdateHyperparameters", "DeleteHyperparameters",
+	"CreateCheckpoint", "FinalizeCheckpoint", "UpdateCheckpointState", "DeleteCheckpoint",
+	"Rollback", "SetTag", "DeleteTag", "RampRollout", "AbortRollout",
+}
+
 type server struct {
 	storage       storage.RepositoryStorage
 	authenticator authentication.Authenticator
+	// linkExpiry - how long the signed download URLs returned by GetCheckpoint are valid for. Links
+	// are returned as they are stored if it is 0.
+	linkExpiry time.Duration
+	// manifestSigner - signs the manifests returned by GetCheckpointManifest. It is nil if manifest
+	// signing is not configured.
+	manifestSigner *manifest.Signer
+	// validateBundles - whether checkpoints are checked against the model.json of their TensorIO
+	// bundle, where the backend can read it.
+	validateBundles bool
+	webhooks        *webhook.Dispatcher
 }
 
 // NewServer - Creates an api.RepositoryServer which handles gRPC requests using a given
-// storage.RepositoryStorage backend
-func NewServer(storage storage.RepositoryStorage, authenticator authentication.Authenticator) api.RepositoryServer {
+// storage.RepositoryStorage backend. If linkExpiry is positive, GetCheckpoint returns checkpoint
+// links as download URLs signed by the backend which expire after linkExpiry. GetCheckpointManifest
+// is only available if manifestSigner is not nil. If validateBundles is set, CreateCheckpoint and
+// FinalizeCheckpoint reject checkpoints whose TensorIO bundle does not have a valid model.json.
+func NewServer(storage storage.RepositoryStorage, authenticator authentication.Authenticator, linkExpiry time.Duration, manifestSigner *manifest.Signer, validateBundles bool) api.RepositoryServer {
 	// Thids will panic on failure to load tokens.
 	return &server{
-		storage:       storage,
-		authenticator: authenticator}
+		storage:         storage,
+		authenticator:   authenticator,
+		linkExpiry:      linkExpiry,
+		manifestSigner:  manifestSigner,
+		validateBundles: validateBundles,
+		webhooks:        webhook.NewDispatcher(storage, &http.Client{Timeout: webhookTimeout}, webhook.DefaultRetryPolicy)}
 }
 
-func startGrpcServer(apiServer api.RepositoryServer, serverAddress string, authInterceptor grpc.UnaryServerInterceptor) {
+func startGrpcServer(apiServer api.RepositoryServer, serverAddress string, authInterceptor grpc.UnaryServerInterceptor, streamAuthInterceptor grpc.StreamServerInterceptor) {
 	log.Println("Starting grpc on:", serverAddress)
 
-	grpcServer := grpc.NewServer(grpc.UnaryInterceptor(authInterceptor))
+	grpcServer := grpc.NewServer(grpc.UnaryInterceptor(authInterceptor), grpc.StreamInterceptor(streamAuthInterceptor))
 	lis, err := net.Listen("tcp", serverAddress)
 	if err != nil {
 		log.Fatalln(err)
@@ -60,7 +110,12 @@ func startProxyServer(grpcServerAddress string, jsonServerAddress string) {
 	ctx, cancel := context.WithCancel(ctx)
 	defer cancel()
 	// Print default values in output JSON
-	mux := runtime.NewServeMux(runtime.WithMarshalerOption(runtime.MIMEWildcard, &runtime.JSONPb{OrigName: true, EmitDefaults: true}))
+	jsonMarshaler := &runtime.JSONPb{OrigName: true, EmitDefaults: true}
+	mux := runtime.NewServeMux(
+		runtime.WithMarshalerOption(runtime.MIMEWildcard, jsonMarshaler),
+		// Streams (WatchModel) are sent as Server-Sent Events to clients which accept them
+		runtime.WithMarshalerOption(eventStreamContentType, &eventStreamMarshaler{JSONPb: jsonMarshaler}),
+	)
 	opts := []grpc.DialOption{grpc.WithInsecure()}
 	// Note the *Repository* handler
 	err := api.RegisterRepositoryHandlerFromEndpoint(ctx, mux, grpcServerAddress, opts)
@@ -74,6 +129,31 @@ func startProxyServer(grpcServerAddress string, jsonServerAddress string) {
 	}
 }
 
+// eventStreamContentType - the content type of Server-Sent Events.
+const eventStreamContentType = "text/event-stream"
+
+// eventStreamMarshaler - encodes each message of a stream sent through the REST gateway as a
+// Server-Sent Event whose data is the JSON encoding of the message.
+type eventStreamMarshaler struct {
+	*runtime.JSONPb
+}
+
+func (*eventStreamMarshaler) ContentType() string {
+	return eventStreamContentType
+}
+
+func (m *eventStreamMarshaler) Marshal(v interface{}) ([]byte, error) {
+	data, err := m.JSONPb.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte("data: "), data...), nil
+}
+
+func (*eventStreamMarshaler) Delimiter() []byte {
+	return []byte("\n\n")
+}
+
 const (
 	MODELS_ADMIN  authentication.AuthenticationTokenType = "ModelsAdmin"
 	MODELS_WRITER authentication.AuthenticationTokenType = "ModelsWriter"
@@ -91,13 +171,40 @@ func CreateMethodToTokenTypeMap() authentication.MethodToAuthenticationTokenType
 		"/api.Repository/CreateHyperparameters": MODELS_WRITER,
 		"/api.Repository/UpdateHyperparameters": MODELS_WRITER,
 		"/api.Repository/CreateCheckpoint":      MODELS_WRITER,
+		"/api.Repository/FinalizeCheckpoint":    MODELS_WRITER,
+		"/api.Repository/UpdateCheckpointState": MODELS_WRITER,
+		"/api.Repository/Rollback":              MODELS_WRITER,
+		"/api.Repository/SetTag":                MODELS_WRITER,
+		"/api.Repository/RampRollout":           MODELS_WRITER,
+		"/api.Repository/AbortRollout":          MODELS_WRITER,
 
-		"/api.Repository/ListModels":          MODELS_READER,
-		"/api.Repository/GetModel":            MODELS_READER,
-		"/api.Repository/ListCheckpoints":     MODELS_READER,
-		"/api.Repository/GetCheckpoint":       MODELS_READER,
-		"/api.Repository/ListHyperparameters": MODELS_READER,
-		"/api.Repository/GetHyperparameters":  MODELS_READER,
+		"/api.Repository/ListModels":            MODELS_READER,
+		"/api.Repository/GetModel":              MODELS_READER,
+		"/api.Repository/ResolveModel":          MODELS_READER,
+		"/api.Repository/ListCheckpoints":       MODELS_READER,
+		"/api.Repository/GetCheckpoint":         MODELS_READER,
+		"/api.Repository/GetCheckpointManifest": MODELS_READER,
+		"/api.Repository/UpgradeCheck":          MODELS_READER,
+		"/api.Repository/ListHyperparameters":   MODELS_READER,
+		"/api.Repository/GetHyperparameters":    MODELS_READER,
+		"/api.Repository/ListRevisions":         MODELS_READER,
+		"/api.Repository/GetRevision":           MODELS_READER,
+		"/api.Repository/WatchModel":            MODELS_READER,
+		"/api.Repository/GetTag":                MODELS_READER,
+		"/api.Repository/ListTags":              MODELS_READER,
+		"/api.Repository/ResolveModelForClient": MODELS_READER,
+		"/api.Repository/CompareCheckpoints":    MODELS_READER,
+
+		"/api.Repository/DeleteModel":            MODELS_ADMIN,
+		"/api.Repository/DeleteHyperparameters":  MODELS_ADMIN,
+		"/api.Repository/DeleteCheckpoint":       MODELS_ADMIN,
+		"/api.Repository/Fsck":                   MODELS_ADMIN,
+		"/api.Repository/ListAuditEvents":        MODELS_ADMIN,
+		"/api.Repository/CreateWebhook":          MODELS_ADMIN,
+		"/api.Repository/ListWebhooks":           MODELS_ADMIN,
+		"/api.Repository/DeleteWebhook":          MODELS_ADMIN,
+		"/api.Repository/ListWebhookDeadLetters": MODELS_ADMIN,
+		"/api.Repository/DeleteTag":              MODELS_ADMIN,
 	}
 }
 
@@ -106,12 +213,18 @@ func CreateMethodToTokenTypeMap() authentication.MethodToAuthenticationTokenType
 func StartGrpcAndProxyServer(storage storage.RepositoryStorage,
 	grpcServerAddress string, jsonServerAddress string,
 	authenticator authentication.Authenticator,
+	linkExpiry time.Duration,
+	manifestSigner *manifest.Signer,
+	validateBundles bool,
 	stopRequested <-chan string) {
-	apiServer := NewServer(storage, authenticator)
+	apiServer := NewServer(storage, authenticator, linkExpiry, manifestSigner, validateBundles)
 	authInterceptor := authentication.CreateGRPCInterceptor(authenticator,
 		CreateMethodToTokenTypeMap(),
 	)
-	go startGrpcServer(apiServer, grpcServerAddress, authInterceptor)
+	streamAuthInterceptor := authentication.CreateGRPCStreamInterceptor(authenticator,
+		CreateMethodToTokenTypeMap(),
+	)
+	go startGrpcServer(apiServer, grpcServerAddress, authInterceptor, streamAuthInterceptor)
 	go startProxyServer(grpcServerAddress, jsonServerAddress)
 	stopReason := <-stopRequested
 	log.Println("Stopping server due to:", stopReason)
@@ -134,6 +247,12 @@ func (srv *server) Config(ctx context.Context, req *api.ConfigRequest) (*api.Con
 		storageTypeEnum = api.ConfigResponse_MEMORY
 	case gcs.StorageType:
 		storageTypeEnum = api.ConfigResponse_GOOGLE_CLOUD_STORAGE
+	case filesystem.StorageType:
+		storageTypeEnum = api.ConfigResponse_FILESYSTEM
+	case s3.StorageType:
+		storageTypeEnum = api.ConfigResponse_S3
+	case boltdb.StorageType:
+		storageTypeEnum = api.ConfigResponse_BOLTDB
 	}
 	resp := &api.ConfigResponse{
 		BackendType: storageTypeEnum,
@@ -143,19 +262,60 @@ func (srv *server) Config(ctx context.Context, req *api.ConfigRequest) (*api.Con
 
 func (srv *server) ListModels(ctx context.Context, req *api.ListModelsRequest) (*api.ListModelsResponse, error) {
 	marker := req.Marker
+	if req.PageToken != "" {
+		var err error
+		marker, err = common.DecodePageToken(req.PageToken)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
 	maxItems := int(req.MaxItems)
 	if maxItems <= 0 {
 		maxItems = 10
 	}
-	log.Printf("ListModels request - Marker: %s, MaxItems: %d", marker, maxItems)
-	models, err := srv.storage.ListModels(ctx, marker, maxItems)
+	log.Printf("ListModels request - Marker: %s, MaxItems: %d, Filter: %s", marker, maxItems, req.Filter)
+	var models storage.ListResult
+	var storedModels []storage.Model
+	var err error
+	if req.Filter != "" {
+		f, parseErr := filter.Parse(req.Filter)
+		if parseErr != nil {
+			return nil, api.InvalidFieldValueError("filter", parseErr.Error()).Err()
+		}
+		models, storedModels, err = storage.FilterModels(ctx, srv.storage, marker, maxItems, f)
+	} else {
+		models, err = srv.storage.ListModels(ctx, marker, maxItems)
+	}
 	if err != nil {
 		log.Printf("ERROR: %v", err)
 		grpcErr := status.Error(codes.Unavailable, "Could not retrieve models from storage")
 		return nil, grpcErr
 	}
 	res := &api.ListModelsResponse{
-		ModelIds: models,
+		ModelIds:      models.Ids,
+		NextPageToken: common.EncodePageToken(models.NextMarker),
+	}
+	if req.View == api.ListView_FULL {
+		// Filtered listings have already read the models.
+		if storedModels == nil {
+			storedModels, err = srv.storage.BatchGetModels(ctx, models.Ids)
+			if err != nil {
+				log.Printf("ERROR: %v", err)
+				grpcErr := status.Error(codes.Unavailable, "Could not retrieve models from storage")
+				return nil, grpcErr
+			}
+		}
+		res.Models = make([]*api.Model, len(storedModels))
+		for i, model := range storedModels {
+			res.Models[i] = &api.Model{
+				ModelId:                  model.ModelId,
+				Details:                  model.Details,
+				CanonicalHyperparameters: model.CanonicalHyperparameters,
+				Labels:                   model.Labels,
+				Card:                     modelCardToAPI(model.Card),
+				Version:                  model.Version,
+			}
+		}
 	}
 	return res, nil
 }
@@ -174,6 +334,57 @@ func (srv *server) GetModel(ctx context.Context, req *api.GetModelRequest) (*api
 		ModelId:                  model.ModelId,
 		Details:                  model.Details,
 		CanonicalHyperparameters: model.CanonicalHyperparameters,
+		Labels:                   model.Labels,
+		Card:                     modelCardToAPI(model.Card),
+		Version:                  model.Version,
+	}
+	return resp, nil
+}
+
+// ResolveModel - follows the canonical hyperparameters of a model through their UpgradeTo links to
+// the canonical checkpoint that devices should use, so that they can discover it in one request.
+func (srv *server) ResolveModel(ctx context.Context, req *api.ResolveModelRequest) (*api.ResolveModelResponse, error) {
+	modelID := req.ModelId
+	if modelID == "" {
+		return nil, api.MissingRequiredFieldError("modelId", "model id to resolve").Err()
+	}
+	log.Printf("ResolveModel request - ModelId: %s", modelID)
+	resolution, err := storage.ResolveModel(ctx, srv.storage, modelID)
+	if err != nil {
+		log.Printf("ERROR: %v", err)
+		return nil, resolveError(err, fmt.Sprintf("Could not resolve model (%s)", modelID))
+	}
+	return resolutionToAPI(resolution)
+}
+
+// ResolveModelForClient - as ResolveModel, but picks the checkpoint for the given client from the
+// rollout of the resolved hyperparameters, if there is one.
+func (srv *server) ResolveModelForClient(ctx context.Context, req *api.ResolveModelForClientRequest) (*api.ResolveModelForClientResponse, error) {
+	modelID := req.ModelId
+	clientID := req.ClientId
+	if modelID == "" {
+		return nil, api.MissingRequiredFieldError("modelId", "model id to resolve").Err()
+	}
+	if clientID == "" {
+		return nil, api.MissingRequiredFieldError("clientId", "stable id of the client to resolve the model for").Err()
+	}
+	log.Printf("ResolveModelForClient request - ModelId: %s, ClientId: %s", modelID, clientID)
+	resolution, err := storage.ResolveModelForClient(ctx, srv.storage, modelID, clientID)
+	if err != nil {
+		log.Printf("ERROR: %v", err)
+		return nil, resolveError(err, fmt.Sprintf("Could not resolve model (%s) for client (%s)", modelID, clientID))
+	}
+	resolved, err := resolutionToAPI(resolution.Resolution)
+	if err != nil {
+		return nil, err
+	}
+	resp := &api.ResolveModelForClientResponse{
+		Model:           resolved.Model,
+		Hyperparameters: resolved.Hyperparameters,
+		Checkpoint:      resolved.Checkpoint,
+		UpgradePath:     resolved.UpgradePath,
+		Bucket:          int32(resolution.Bucket),
+		InRollout:       resolution.InRollout,
 	}
 	return resp, nil
 }
@@ -186,15 +397,26 @@ func (srv *server) CreateModel(ctx context.Context, req *api.CreateModelRequest)
 		grpcErr := status.Error(codes.InvalidArgument, "ModelId was invalid")
 		return nil, grpcErr
 	}
-	// Check that Details field in request model is not nil
-	if model.Details == "" {
-		grpcErr := status.Error(codes.InvalidArgument, "Details should be specified in CreateModel request")
+	// Check that Details field in request model is not nil, unless the model card describes the model
+	if model.Details == "" && model.Card == nil {
+		grpcErr := status.Error(codes.InvalidArgument, "Details or a card should be specified in CreateModel request")
 		return nil, grpcErr
 	}
+	if err := validateLabels("model.labels", model.Labels); err != nil {
+		return nil, err
+	}
+	if err := validateModelCard("model.card", model.Card); err != nil {
+		return nil, err
+	}
 	storageModel := storage.Model{
 		ModelId:                  model.ModelId,
 		Details:                  model.Details,
 		CanonicalHyperparameters: model.CanonicalHyperparameters,
+		Labels:                   model.Labels,
+		Card:                     modelCardFromAPI(model.Card),
+	}
+	if storageModel.Details == "" {
+		storageModel.Details = model.Card.Description
 	}
 	err := srv.storage.AddModel(ctx, storageModel)
 	if err != nil {
@@ -203,6 +425,11 @@ func (srv *server) CreateModel(ctx context.Context, req *api.CreateModelRequest)
 		return nil, grpcErr
 	}
 	resourcePath := fmt.Sprintf("/models/%s", storageModel.ModelId)
+	if createdModel, err := srv.storage.GetModel(ctx, storageModel.ModelId); err == nil {
+		srv.recordChange(ctx, "CreateModel", resourcePath, req, nil, createdModel)
+	} else {
+		srv.recordChange(ctx, "CreateModel", resourcePath, req, nil, storageModel)
+	}
 	resp := &api.CreateModelResponse{ResourcePath: resourcePath}
 	return resp, nil
 }
@@ -221,7 +448,13 @@ func (srv *server) UpdateModel(ctx context.Context, req *api.UpdateModelRequest)
 			return nil, api.InvalidFieldValueError("request.model.modelId", "request.modelId != request.model.modelId").Err()
 		}
 	}
-	log.Printf("UpdateModel request - ModelId: %s, Model: %v", modelID, model)
+	if err := validateLabels("model.labels", model.Labels); err != nil {
+		return nil, err
+	}
+	if err := validateModelCard("model.card", model.Card); err != nil {
+		return nil, err
+	}
+	log.Printf("UpdateModel request - ModelId: %s, Model: %v, ExpectedVersion: %d", modelID, model, req.ExpectedVersion)
 	storedModel, err := srv.storage.GetModel(ctx, modelID)
 	if err != nil {
 		log.Printf("ERROR: %v", err)
@@ -229,6 +462,11 @@ func (srv *server) UpdateModel(ctx context.Context, req *api.UpdateModelRequest)
 		grpcErr := status.Error(codes.Unavailable, message)
 		return nil, grpcErr
 	}
+	if err := storage.CheckVersion(storedModel.Version, req.ExpectedVersion); err != nil {
+		return nil, referenceError(err, fmt.Sprintf("Could not update model (%s)", modelID))
+	}
+	// The update keeps storedModel's version, so that storage rejects it if the model changes
+	// before it is written.
 	updatedModel := storedModel
 	if model.Details != "" {
 		updatedModel.Details = model.Details
@@ -236,45 +474,116 @@ func (srv *server) UpdateModel(ctx context.Context, req *api.UpdateModelRequest)
 	if model.CanonicalHyperparameters != "" {
 		updatedModel.CanonicalHyperparameters = model.CanonicalHyperparameters
 	}
+	if len(model.Labels) > 0 {
+		updatedModel.Labels = mergeLabels(storedModel.Labels, model.Labels)
+	}
+	if model.Card != nil {
+		updatedModel.Card = modelCardFromAPI(model.Card)
+	}
 	newlyStoredModel, err := srv.storage.UpdateModel(ctx, updatedModel)
 	if err != nil {
 		log.Printf("ERROR: %v", err)
 		message := fmt.Sprintf("Could not update model (%s) in storage", modelID)
-		grpcErr := status.Error(codes.Unavailable, message)
-		return nil, grpcErr
+		return nil, referenceError(err, message)
 	}
+	srv.recordChange(ctx, "UpdateModel", common.GetModelResourcePath(modelID), req, storedModel, newlyStoredModel)
 	resp := &api.UpdateModelResponse{
 		Model: &api.Model{
 			ModelId:                  newlyStoredModel.ModelId,
 			Details:                  newlyStoredModel.Details,
 			CanonicalHyperparameters: newlyStoredModel.CanonicalHyperparameters,
+			Labels:                   newlyStoredModel.Labels,
+			Card:                     modelCardToAPI(newlyStoredModel.Card),
+			Version:                  newlyStoredModel.Version,
 		},
 	}
 	return resp, nil
 }
 
+func (srv *server) DeleteModel(ctx context.Context, req *api.DeleteModelRequest) (*api.DeleteModelResponse, error) {
+	modelID := req.ModelId
+	if modelID == "" {
+		return nil, api.MissingRequiredFieldError("modelId", "model id to delete").Err()
+	}
+	log.Printf("DeleteModel request - ModelId: %s, Cascade: %t", modelID, req.Cascade)
+	var storedModel interface{}
+	if model, err := srv.storage.GetModel(ctx, modelID); err == nil {
+		storedModel = model
+	}
+	err := srv.storage.DeleteModel(ctx, modelID, storage.DeleteOptions{Cascade: req.Cascade})
+	if err != nil {
+		log.Printf("ERROR: %v", err)
+		message := fmt.Sprintf("Could not delete model (%s) from storage", modelID)
+		return nil, deleteError(err, message)
+	}
+	srv.recordChange(ctx, "DeleteModel", common.GetModelResourcePath(modelID), req, storedModel, nil)
+	resp := &api.DeleteModelResponse{
+		ResourcePath: fmt.Sprintf("/models/%s", modelID),
+	}
+	return resp, nil
+}
+
 func (srv *server) ListHyperparameters(ctx context.Context, req *api.ListHyperparametersRequest) (*api.ListHyperparametersResponse, error) {
 	modelID := req.ModelId
 	marker := req.Marker
+	if req.PageToken != "" {
+		var err error
+		marker, err = common.DecodePageToken(req.PageToken)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
 	maxItems := int(req.MaxItems)
 	if maxItems <= 0 {
 		maxItems = 10
 	}
-	log.Printf("ListHyperparameters request - ModelId: %s, Marker: %s, MaxItems: %d", modelID, marker, maxItems)
-	hyperparametersStoragePaths, err := srv.storage.ListHyperparameters(ctx, modelID, marker, maxItems)
+	log.Printf("ListHyperparameters request - ModelId: %s, Marker: %s, MaxItems: %d, Filter: %s", modelID, marker, maxItems, req.Filter)
+	var hyperparameters storage.ListResult
+	var storedHyperparameters []storage.Hyperparameters
+	var err error
+	if req.Filter != "" {
+		f, parseErr := filter.Parse(req.Filter)
+		if parseErr != nil {
+			return nil, api.InvalidFieldValueError("filter", parseErr.Error()).Err()
+		}
+		hyperparameters, storedHyperparameters, err = storage.FilterHyperparameters(ctx, srv.storage, modelID, marker, maxItems, f)
+	} else {
+		hyperparameters, err = srv.storage.ListHyperparameters(ctx, modelID, marker, maxItems)
+	}
 	if err != nil {
 		log.Printf("ERROR: %v", err)
 		message := fmt.Sprintf("Could not list hyperparameters for model (%s) in storage", modelID)
 		grpcErr := status.Error(codes.Unavailable, message)
 		return nil, grpcErr
 	}
-	hyperparametersIDs := make([]string, len(hyperparametersStoragePaths))
-	for i, path := range hyperparametersStoragePaths {
-		hyperparametersIDs[i] = common.GetTerminalResourceFromStoragePath(path)
-	}
 	resp := &api.ListHyperparametersResponse{
 		ModelId:            modelID,
-		HyperparametersIds: hyperparametersIDs,
+		HyperparametersIds: hyperparameters.Ids,
+		NextPageToken:      common.EncodePageToken(hyperparameters.NextMarker),
+	}
+	if req.View == api.ListView_FULL {
+		if storedHyperparameters == nil {
+			storedHyperparameters, err = srv.storage.BatchGetHyperparameters(ctx, modelID, hyperparameters.Ids)
+			if err != nil {
+				log.Printf("ERROR: %v", err)
+				message := fmt.Sprintf("Could not get hyperparameters for model (%s) from storage", modelID)
+				grpcErr := status.Error(codes.Unavailable, message)
+				return nil, grpcErr
+			}
+		}
+		resp.Hyperparameters = make([]*api.GetHyperparametersResponse, len(storedHyperparameters))
+		for i, hyperparameters := range storedHyperparameters {
+			resp.Hyperparameters[i] = &api.GetHyperparametersResponse{
+				ModelId:             modelID,
+				HyperparametersId:   hyperparameters.HyperparametersId,
+				UpgradeTo:           hyperparameters.UpgradeTo,
+				CanonicalCheckpoint: hyperparameters.CanonicalCheckpoint,
+				Hyperparameters:     hyperparameters.Hyperparameters,
+				Labels:              hyperparameters.Labels,
+				Version:             hyperparameters.Version,
+				Rollout:             rolloutToAPI(hyperparameters.Rollout),
+			}
+		}
 	}
 	return resp, nil
 }
@@ -291,6 +600,9 @@ func (srv *server) CreateHyperparameters(ctx context.Context, req *api.CreateHyp
 		grpcErr := status.Error(codes.InvalidArgument, "hyperparametersID is invalid")
 		return nil, grpcErr
 	}
+	if err := validateLabels("labels", req.Labels); err != nil {
+		return nil, err
+	}
 	canonicalCheckpoint := req.CanonicalCheckpoint
 	hyperparameters := req.Hyperparameters
 	log.Printf("CreateHyperparameters request - ModelId: %s, HyperparametersId: %s, CanonicalCheckpoint: %s, Hyperparameters: %v", modelID, hyperparametersID, canonicalCheckpoint, hyperparameters)
@@ -299,6 +611,7 @@ func (srv *server) CreateHyperparameters(ctx context.Context, req *api.CreateHyp
 		HyperparametersId:   hyperparametersID,
 		CanonicalCheckpoint: canonicalCheckpoint,
 		Hyperparameters:     hyperparameters,
+		Labels:              req.Labels,
 	}
 	err := srv.storage.AddHyperparameters(ctx, storageHyperparameters)
 	if err != nil {
@@ -308,6 +621,11 @@ func (srv *server) CreateHyperparameters(ctx context.Context, req *api.CreateHyp
 		return nil, grpcErr
 	}
 	resourcePath := fmt.Sprintf("/models/%s/hyperparameters/%s", modelID, hyperparametersID)
+	if createdHyperparameters, err := srv.storage.GetHyperparameters(ctx, modelID, hyperparametersID); err == nil {
+		srv.recordChange(ctx, "CreateHyperparameters", resourcePath, req, nil, createdHyperparameters)
+	} else {
+		srv.recordChange(ctx, "CreateHyperparameters", resourcePath, req, nil, storageHyperparameters)
+	}
 	resp := &api.CreateHyperparametersResponse{
 		ResourcePath: resourcePath,
 	}
@@ -318,6 +636,10 @@ func (srv *server) GetHyperparameters(ctx context.Context, req *api.GetHyperpara
 	modelID := req.ModelId
 	hyperparametersID := req.HyperparametersId
 	log.Printf("GetHyperparameters request - ModelId: %s, HyperparametersId: %s", modelID, hyperparametersID)
+	hyperparametersID, err := srv.resolveTag(ctx, modelID, "", hyperparametersID)
+	if err != nil {
+		return nil, err
+	}
 	storedHyperparameters, err := srv.storage.GetHyperparameters(ctx, modelID, hyperparametersID)
 	if err != nil {
 		log.Printf("ERROR: %v", err)
@@ -331,6 +653,9 @@ func (srv *server) GetHyperparameters(ctx context.Context, req *api.GetHyperpara
 		UpgradeTo:           storedHyperparameters.UpgradeTo,
 		CanonicalCheckpoint: storedHyperparameters.CanonicalCheckpoint,
 		Hyperparameters:     storedHyperparameters.Hyperparameters,
+		Labels:              storedHyperparameters.Labels,
+		Version:             storedHyperparameters.Version,
+		Rollout:             rolloutToAPI(storedHyperparameters.Rollout),
 	}
 	return resp, nil
 }
@@ -341,7 +666,10 @@ func (srv *server) UpdateHyperparameters(ctx context.Context, req *api.UpdateHyp
 	upgradeTo := req.UpgradeTo
 	canonicalCheckpoint := req.CanonicalCheckpoint
 	hyperparameters := req.Hyperparameters
-	log.Printf("UpdateHyperparameters request - ModelId: %s, HyperparametersId: %s, CanonicalCheckpoint: %s, Hyperparameters: %v", modelID, hyperparametersID, canonicalCheckpoint, hyperparameters)
+	if err := validateLabels("labels", req.Labels); err != nil {
+		return nil, err
+	}
+	log.Printf("UpdateHyperparameters request - ModelId: %s, HyperparametersId: %s, CanonicalCheckpoint: %s, Hyperparameters: %v, ExpectedVersion: %d", modelID, hyperparametersID, canonicalCheckpoint, hyperparameters, req.ExpectedVersion)
 
 	existingHyperparameters, err := srv.storage.GetHyperparameters(ctx, modelID, hyperparametersID)
 	if err != nil {
@@ -350,7 +678,11 @@ func (srv *server) UpdateHyperparameters(ctx context.Context, req *api.UpdateHyp
 		grpcErr := status.Error(codes.Unavailable, message)
 		return nil, grpcErr
 	}
+	if err := storage.CheckVersion(existingHyperparameters.Version, req.ExpectedVersion); err != nil {
+		return nil, referenceError(err, fmt.Sprintf("Could not update hyperparameters (%s) for model (%s)", hyperparametersID, modelID))
+	}
 
+	// As in UpdateModel, the update keeps the version it was based on.
 	updatedHyperparameters := existingHyperparameters
 	if canonicalCheckpoint != "" {
 		updatedHyperparameters.CanonicalCheckpoint = canonicalCheckpoint
@@ -358,16 +690,24 @@ func (srv *server) UpdateHyperparameters(ctx context.Context, req *api.UpdateHyp
 	if upgradeTo != "" {
 		updatedHyperparameters.UpgradeTo = upgradeTo
 	}
+	// Copy the stored map so that a rejected update leaves it untouched.
+	updatedHyperparameters.Hyperparameters = make(map[string]string, len(existingHyperparameters.Hyperparameters)+len(hyperparameters))
+	for k, v := range existingHyperparameters.Hyperparameters {
+		updatedHyperparameters.Hyperparameters[k] = v
+	}
 	for k, v := range hyperparameters {
 		updatedHyperparameters.Hyperparameters[k] = v
 	}
+	if len(req.Labels) > 0 {
+		updatedHyperparameters.Labels = mergeLabels(existingHyperparameters.Labels, req.Labels)
+	}
 	storedHyperparameters, err := srv.storage.UpdateHyperparameters(ctx, updatedHyperparameters)
 	if err != nil {
 		log.Printf("ERROR: %v", err)
 		message := fmt.Sprintf("Could not store hyperparameters (%v) in storage", updatedHyperparameters)
-		grpcErr := status.Error(codes.Unavailable, message)
-		return nil, grpcErr
+		return nil, referenceError(err, message)
 	}
+	srv.recordChange(ctx, "UpdateHyperparameters", common.GetHyperparametersResourcePath(modelID, hyperparametersID), req, existingHyperparameters, storedHyperparameters)
 
 	resp := &api.UpdateHyperparametersResponse{
 		ModelId:             storedHyperparameters.ModelId,
@@ -375,6 +715,40 @@ func (srv *server) UpdateHyperparameters(ctx context.Context, req *api.UpdateHyp
 		UpgradeTo:           "",
 		CanonicalCheckpoint: storedHyperparameters.CanonicalCheckpoint,
 		Hyperparameters:     storedHyperparameters.Hyperparameters,
+		Labels:              storedHyperparameters.Labels,
+		Version:             storedHyperparameters.Version,
+		Rollout:             rolloutToAPI(storedHyperparameters.Rollout),
+	}
+	return resp, nil
+}
+
+func (srv *server) DeleteHyperparameters(ctx context.Context, req *api.DeleteHyperparametersRequest) (*api.DeleteHyperparametersResponse, error) {
+	modelID := req.ModelId
+	hyperparametersID := req.HyperparametersId
+	if modelID == "" {
+		return nil, api.MissingRequiredFieldError("modelId", "model id of hyperparameters to delete").Err()
+	}
+	if hyperparametersID == "" {
+		return nil, api.MissingRequiredFieldError("hyperparametersId", "hyperparameters id to delete").Err()
+	}
+	log.Printf("DeleteHyperparameters request - ModelId: %s, HyperparametersId: %s, Cascade: %t, Force: %t", modelID, hyperparametersID, req.Cascade, req.Force)
+	options := storage.DeleteOptions{
+		Cascade: req.Cascade,
+		Force:   req.Force,
+	}
+	var storedHyperparameters interface{}
+	if hyperparameters, err := srv.storage.GetHyperparameters(ctx, modelID, hyperparametersID); err == nil {
+		storedHyperparameters = hyperparameters
+	}
+	err := srv.storage.DeleteHyperparameters(ctx, modelID, hyperparametersID, options)
+	if err != nil {
+		log.Printf("ERROR: %v", err)
+		message := fmt.Sprintf("Could not delete hyperparameters (%s) for model (%s) from storage", hyperparametersID, modelID)
+		return nil, deleteError(err, message)
+	}
+	srv.recordChange(ctx, "DeleteHyperparameters", common.GetHyperparametersResourcePath(modelID, hyperparametersID), req, storedHyperparameters, nil)
+	resp := &api.DeleteHyperparametersResponse{
+		ResourcePath: fmt.Sprintf("/models/%s/hyperparameters/%s", modelID, hyperparametersID),
 	}
 	return resp, nil
 }
@@ -383,26 +757,73 @@ func (srv *server) ListCheckpoints(ctx context.Context, req *api.ListCheckpoints
 	modelID := req.ModelId
 	hyperparametersID := req.HyperparametersId
 	marker := req.Marker
+	if req.PageToken != "" {
+		var err error
+		marker, err = common.DecodePageToken(req.PageToken)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
 	maxItems := int(req.MaxItems)
 	if maxItems <= 0 {
 		maxItems = 10
 	}
-	log.Printf("ListCheckpoints request - ModelId: %s, HyperparametersId: %s, Marker: %s, MaxItems: %d", modelID, hyperparametersID, marker, maxItems)
-	checkpointStoragePaths, err := srv.storage.ListCheckpoints(ctx, modelID, hyperparametersID, marker, maxItems)
+	log.Printf("ListCheckpoints request - ModelId: %s, HyperparametersId: %s, Marker: %s, MaxItems: %d, IncludeArchived: %t, Filter: %s", modelID, hyperparametersID, marker, maxItems, req.IncludeArchived, req.Filter)
+	var checkpoints storage.ListResult
+	var storedCheckpoints []storage.Checkpoint
+	var err error
+	if req.Filter != "" {
+		f, parseErr := filter.Parse(req.Filter)
+		if parseErr != nil {
+			return nil, api.InvalidFieldValueError("filter", parseErr.Error()).Err()
+		}
+		checkpoints, storedCheckpoints, err = storage.FilterCheckpoints(ctx, srv.storage, modelID, hyperparametersID, marker, maxItems, req.IncludeArchived, f)
+	} else {
+		checkpoints, err = srv.storage.ListCheckpoints(ctx, modelID, hyperparametersID, marker, maxItems, req.IncludeArchived)
+	}
 	if err != nil {
 		log.Printf("ERROR: %v", err)
 		message := fmt.Sprintf("Could not list checkpoints for model (%s) and hyperparameters (%s) in storage", modelID, hyperparametersID)
 		grpcErr := status.Error(codes.Unavailable, message)
 		return nil, grpcErr
 	}
-	checkpointIDs := make([]string, len(checkpointStoragePaths))
-	for i, path := range checkpointStoragePaths {
-		checkpointIDs[i] = common.GetTerminalResourceFromStoragePath(path)
-	}
 	resp := &api.ListCheckpointsResponse{
 		ModelId:           modelID,
 		HyperparametersId: hyperparametersID,
-		CheckpointIds:     checkpointIDs,
+		CheckpointIds:     checkpoints.Ids,
+		NextPageToken:     common.EncodePageToken(checkpoints.NextMarker),
+	}
+	if req.View == api.ListView_FULL {
+		if storedCheckpoints == nil {
+			storedCheckpoints, err = srv.storage.BatchGetCheckpoints(ctx, modelID, hyperparametersID, checkpoints.Ids)
+			if err != nil {
+				log.Printf("ERROR: %v", err)
+				message := fmt.Sprintf("Could not get checkpoints for model (%s) and hyperparameters (%s) from storage", modelID, hyperparametersID)
+				grpcErr := status.Error(codes.Unavailable, message)
+				return nil, grpcErr
+			}
+		}
+		resp.Checkpoints = make([]*api.GetCheckpointResponse, len(storedCheckpoints))
+		for i, checkpoint := range storedCheckpoints {
+			createdAt, err := ptypes.TimestampProto(checkpoint.CreatedAt)
+			if err != nil {
+				log.Error("unable to serialize CreatedAt")
+				return nil, err
+			}
+			resp.Checkpoints[i] = &api.GetCheckpointResponse{
+				ModelId:           modelID,
+				HyperparametersId: hyperparametersID,
+				CheckpointId:      checkpoint.CheckpointId,
+				Link:              checkpoint.Link,
+				CreatedAt:         createdAt,
+				Info:              checkpoint.Info,
+				State:             checkpoint.State,
+				Labels:            checkpoint.Labels,
+				Sha256:            checkpoint.Sha256,
+				SizeBytes:         checkpoint.SizeBytes,
+				ContentType:       checkpoint.ContentType,
+			}
+		}
 	}
 	return resp, nil
 }
@@ -415,8 +836,20 @@ func (srv *server) CreateCheckpoint(ctx context.Context, req *api.CreateCheckpoi
 		grpcErr := status.Error(codes.InvalidArgument, "checkpointId is invalid")
 		return nil, grpcErr
 	}
+	if err := validateLabels("labels", req.Labels); err != nil {
+		return nil, err
+	}
+	if err := validateCheckpointContent(req); err != nil {
+		return nil, err
+	}
 	link := req.Link
-	log.Printf("CreateCheckpoint request - ModelId: %s, HyperparametersId: %s, CheckpointId: %s, Link: %s", modelID, hyperparametersID, checkpointID, link)
+	if req.RequestUploadUrl && link != "" {
+		return nil, api.InvalidFieldValueError("link", "link cannot be set when requesting an upload URL").Err()
+	}
+	if req.RequestUploadUrl && req.ContentType != "" && req.ContentType != storage.BundleContentType {
+		return nil, api.InvalidFieldValueError("contentType", fmt.Sprintf("uploaded bundles are %s", storage.BundleContentType)).Err()
+	}
+	log.Printf("CreateCheckpoint request - ModelId: %s, HyperparametersId: %s, CheckpointId: %s, Link: %s, RequestUploadUrl: %t", modelID, hyperparametersID, checkpointID, link, req.RequestUploadUrl)
 	utcNow := time.Now().UTC()
 	storageCheckpoint := storage.Checkpoint{
 		ModelId:           modelID,
@@ -425,18 +858,135 @@ func (srv *server) CreateCheckpoint(ctx context.Context, req *api.CreateCheckpoi
 		CreatedAt:         utcNow,
 		Link:              link,
 		Info:              req.Info,
+		Labels:            req.Labels,
+		Sha256:            strings.ToLower(req.Sha256),
+		SizeBytes:         req.SizeBytes,
+		ContentType:       req.ContentType,
+	}
+	var uploadURL string
+	var uploadURLExpiresAt time.Time
+	if req.RequestUploadUrl {
+		// The checkpoint links to the bundle in the repository's own storage, and stays pending
+		// until FinalizeCheckpoint has seen the bundle.
+		uploadURLExpiresAt = utcNow.Add(checkpointUploadURLExpiry)
+		var err error
+		uploadURL, storageCheckpoint.Link, err = srv.storage.CheckpointUploadURL(ctx, modelID, hyperparametersID, checkpointID, uploadURLExpiresAt)
+		if err != nil {
+			log.Printf("ERROR: %v", err)
+			message := fmt.Sprintf("Could not create upload URL for checkpoint (%s)", checkpointID)
+			if err == storage.ErrUploadsNotConfigured {
+				return nil, status.Error(codes.FailedPrecondition, fmt.Sprintf("%s: %v", message, err))
+			}
+			return nil, status.Error(codes.Unavailable, message)
+		}
+		storageCheckpoint.State = api.CheckpointState_PENDING
+		storageCheckpoint.ContentType = storage.BundleContentType
+	} else if srv.validateBundles {
+		// Uploaded bundles are validated when they are finalized.
+		var err error
+		storageCheckpoint.Info, err = srv.inspectBundle(ctx, link, req.Info)
+		if err != nil {
+			return nil, err
+		}
 	}
 	err := srv.storage.AddCheckpoint(ctx, storageCheckpoint)
 	if err != nil {
 		log.Printf("ERROR: %v", err)
+		if contentErr, ok := err.(storage.CheckpointContentError); ok {
+			return nil, api.InvalidFieldValueError(contentErr.Field, contentErr.Error()).Err()
+		}
 		message := fmt.Sprintf("Could not store checkpoint (%v) in storage", storageCheckpoint)
 		grpcErr := status.Error(codes.Unavailable, message)
 		return nil, grpcErr
 	}
 	resourcePath := common.GetCheckpointResourcePath(modelID, hyperparametersID, checkpointID)
+	if createdCheckpoint, err := srv.storage.GetCheckpoint(ctx, modelID, hyperparametersID, checkpointID); err == nil {
+		srv.recordChange(ctx, "CreateCheckpoint", resourcePath, req, nil, createdCheckpoint)
+	} else {
+		srv.recordChange(ctx, "CreateCheckpoint", resourcePath, req, nil, storageCheckpoint)
+	}
 	resp := &api.CreateCheckpointResponse{
 		ResourcePath: resourcePath,
 	}
+	if req.RequestUploadUrl {
+		resp.UploadUrl = uploadURL
+		resp.UploadUrlExpiresAt, err = ptypes.TimestampProto(uploadURLExpiresAt)
+		if err != nil {
+			log.Error("unable to serialize UploadUrlExpiresAt")
+			return nil, err
+		}
+	}
+	return resp, nil
+}
+
+// FinalizeCheckpoint - makes a checkpoint which was created with an upload URL visible, once its
+// bundle has been uploaded and found to match the checkpoint.
+func (srv *server) FinalizeCheckpoint(ctx context.Context, req *api.FinalizeCheckpointRequest) (*api.FinalizeCheckpointResponse, error) {
+	modelID := req.ModelId
+	hyperparametersID := req.HyperparametersId
+	checkpointID := req.CheckpointId
+	log.Printf("FinalizeCheckpoint request - ModelId: %s, HyperparametersId: %s, CheckpointId: %s", modelID, hyperparametersID, checkpointID)
+	message := fmt.Sprintf("Could not finalize checkpoint (%s) of hyperparameters (%s) for model (%s)", checkpointID, hyperparametersID, modelID)
+	checkpoint, err := srv.storage.GetCheckpoint(ctx, modelID, hyperparametersID, checkpointID)
+	if err != nil {
+		log.Printf("ERROR: %v", err)
+		return nil, notFoundError(err, message)
+	}
+	if checkpoint.State != api.CheckpointState_PENDING {
+		return nil, status.Error(codes.FailedPrecondition, fmt.Sprintf("%s: checkpoint is %s, not PENDING", message, checkpoint.State))
+	}
+	uploaded, err := srv.storage.CheckpointBundleExists(ctx, modelID, hyperparametersID, checkpointID)
+	if err != nil {
+		log.Printf("ERROR: %v", err)
+		return nil, status.Error(codes.Unavailable, message)
+	}
+	if !uploaded {
+		return nil, status.Error(codes.FailedPrecondition, fmt.Sprintf("%s: bundle has not been uploaded", message))
+	}
+	// Bundles which do not match the checkpoint, or are invalid, leave it pending, so that a fixed
+	// bundle can be uploaded.
+	err = srv.verifyUploadedBundle(ctx, checkpoint)
+	if err != nil {
+		return nil, err
+	}
+	pendingCheckpoint := checkpoint
+	if srv.validateBundles {
+		info, err := srv.inspectBundle(ctx, checkpoint.Link, checkpoint.Info)
+		if err != nil {
+			return nil, err
+		}
+		_, err = srv.storage.UpdateCheckpointInfo(ctx, modelID, hyperparametersID, checkpointID, info)
+		if err != nil {
+			log.Printf("ERROR: %v", err)
+			return nil, notFoundError(err, message)
+		}
+	}
+	checkpoint, err = srv.storage.UpdateCheckpointState(ctx, modelID, hyperparametersID, checkpointID, api.CheckpointState_ACTIVE)
+	if err != nil {
+		log.Printf("ERROR: %v", err)
+		return nil, notFoundError(err, message)
+	}
+	srv.recordChange(ctx, "FinalizeCheckpoint", common.GetCheckpointResourcePath(modelID, hyperparametersID, checkpointID), req, pendingCheckpoint, checkpoint)
+	createdAt, err := ptypes.TimestampProto(checkpoint.CreatedAt)
+	if err != nil {
+		log.Error("unable to serialize CreatedAt")
+		return nil, err
+	}
+	resp := &api.FinalizeCheckpointResponse{
+		Checkpoint: &api.GetCheckpointResponse{
+			ModelId:           modelID,
+			HyperparametersId: hyperparametersID,
+			CheckpointId:      checkpointID,
+			Link:              checkpoint.Link,
+			CreatedAt:         createdAt,
+			Info:              checkpoint.Info,
+			State:             checkpoint.State,
+			Labels:            checkpoint.Labels,
+			Sha256:            checkpoint.Sha256,
+			SizeBytes:         checkpoint.SizeBytes,
+			ContentType:       checkpoint.ContentType,
+		},
+	}
 	return resp, nil
 }
 
@@ -444,7 +994,11 @@ func (srv *server) GetCheckpoint(ctx context.Context, req *api.GetCheckpointRequ
 	modelID := req.ModelId
 	hyperparametersID := req.HyperparametersId
 	checkpointID := req.CheckpointId
-	log.Printf("GetCheckpoint request - ModelId: %s, HyperparametersId: %s, CheckpointId: %s", modelID, hyperparametersID, checkpointID)
+	log.Printf("GetCheckpoint request - ModelId: %s, HyperparametersId: %s, CheckpointId: %s, RawLink: %t", modelID, hyperparametersID, checkpointID, req.RawLink)
+	hyperparametersID, checkpointID, err := srv.resolveCheckpointTags(ctx, modelID, hyperparametersID, checkpointID)
+	if err != nil {
+		return nil, err
+	}
 	storedCheckpoint, err := srv.storage.GetCheckpoint(ctx, modelID, hyperparametersID, checkpointID)
 	if err != nil {
 		log.Printf("ERROR: %v", err)
@@ -457,13 +1011,1364 @@ func (srv *server) GetCheckpoint(ctx context.Context, req *api.GetCheckpointRequ
 		log.Error("unable to serialize CreatedAt")
 		return nil, err
 	}
+	link := storedCheckpoint.Link
+	if srv.linkExpiry > 0 && !req.RawLink {
+		link, err = srv.storage.SignCheckpointLink(ctx, link, time.Now().Add(srv.linkExpiry))
+		if err != nil {
+			log.Printf("ERROR: %v", err)
+			message := fmt.Sprintf("Could not sign link of checkpoint (%s) of hyperparameters (%s) for model (%s)", checkpointID, hyperparametersID, modelID)
+			grpcErr := status.Error(codes.Unavailable, message)
+			return nil, grpcErr
+		}
+	}
 	resp := &api.GetCheckpointResponse{
-		Link:              storedCheckpoint.Link,
+		Link:              link,
 		CreatedAt:         createdAt,
 		Info:              storedCheckpoint.Info,
 		ModelId:           modelID,
 		HyperparametersId: hyperparametersID,
 		CheckpointId:      checkpointID,
+		State:             storedCheckpoint.State,
+		Labels:            storedCheckpoint.Labels,
+		Sha256:            storedCheckpoint.Sha256,
+		SizeBytes:         storedCheckpoint.SizeBytes,
+		ContentType:       storedCheckpoint.ContentType,
+	}
+	return resp, nil
+}
+
+// GetCheckpointManifest - returns a manifest of the checkpoint, its hyperparameters and its bundle
+// along with a detached signature over it, so that devices can tell that a checkpoint came from this
+// repository. The manifest carries the stored link, never a signed download URL.
+func (srv *server) GetCheckpointManifest(ctx context.Context, req *api.GetCheckpointManifestRequest) (*api.GetCheckpointManifestResponse, error) {
+	modelID := req.ModelId
+	hyperparametersID := req.HyperparametersId
+	checkpointID := req.CheckpointId
+	log.Printf("GetCheckpointManifest request - ModelId: %s, HyperparametersId: %s, CheckpointId: %s", modelID, hyperparametersID, checkpointID)
+	hyperparametersID, checkpointID, err := srv.resolveCheckpointTags(ctx, modelID, hyperparametersID, checkpointID)
+	if err != nil {
+		return nil, err
+	}
+	message := fmt.Sprintf("Could not get manifest of checkpoint (%s) of hyperparameters (%s) for model (%s)", checkpointID, hyperparametersID, modelID)
+	if srv.manifestSigner == nil {
+		return nil, status.Error(codes.FailedPrecondition, fmt.Sprintf("%s: manifest signing is not configured", message))
+	}
+	checkpoint, err := srv.storage.GetCheckpoint(ctx, modelID, hyperparametersID, checkpointID)
+	if err != nil {
+		log.Printf("ERROR: %v", err)
+		return nil, notFoundError(err, message)
+	}
+	if checkpoint.State == api.CheckpointState_PENDING {
+		return nil, status.Error(codes.FailedPrecondition, fmt.Sprintf("%s: %v", message, storage.ErrPendingCheckpoint))
+	}
+	hyperparameters, err := srv.storage.GetHyperparameters(ctx, modelID, hyperparametersID)
+	if err != nil {
+		log.Printf("ERROR: %v", err)
+		return nil, notFoundError(err, message)
+	}
+	encoded, signature, err := srv.manifestSigner.Sign(manifest.Manifest{
+		ModelId:           modelID,
+		HyperparametersId: hyperparametersID,
+		Hyperparameters:   hyperparameters.Hyperparameters,
+		CheckpointId:      checkpointID,
+		Link:              checkpoint.Link,
+		Sha256:            checkpoint.Sha256,
+		SizeBytes:         checkpoint.SizeBytes,
+		ContentType:       checkpoint.ContentType,
+		CreatedAt:         checkpoint.CreatedAt.UTC().Format(time.RFC3339Nano),
+	})
+	if err != nil {
+		log.Printf("ERROR: %v", err)
+		return nil, status.Error(codes.Internal, message)
+	}
+	resp := &api.GetCheckpointManifestResponse{
+		Manifest:  string(encoded),
+		Signature: signature,
+		KeyId:     srv.manifestSigner.KeyId(),
+		Algorithm: manifest.Algorithm,
+	}
+	return resp, nil
+}
+
+// UpgradeCheck - tells a client pinned to a checkpoint which checkpoint it should be using, and
+// through which hyperparameters the recommendation was reached.
+func (srv *server) UpgradeCheck(ctx context.Context, req *api.UpgradeCheckRequest) (*api.UpgradeCheckResponse, error) {
+	modelID := req.ModelId
+	hyperparametersID := req.HyperparametersId
+	checkpointID := req.CheckpointId
+	if modelID == "" {
+		return nil, api.MissingRequiredFieldError("modelId", "model id of current checkpoint").Err()
+	}
+	if hyperparametersID == "" {
+		return nil, api.MissingRequiredFieldError("hyperparametersId", "hyperparameters id of current checkpoint").Err()
+	}
+	if checkpointID == "" {
+		return nil, api.MissingRequiredFieldError("checkpointId", "current checkpoint id").Err()
+	}
+	log.Printf("UpgradeCheck request - ModelId: %s, HyperparametersId: %s, CheckpointId: %s", modelID, hyperparametersID, checkpointID)
+	upgrade, err := storage.CheckUpgrade(ctx, srv.storage, modelID, hyperparametersID, checkpointID)
+	if err != nil {
+		log.Printf("ERROR: %v", err)
+		message := fmt.Sprintf("Could not check for upgrades to checkpoint (%s) of hyperparameters (%s) for model (%s)", checkpointID, hyperparametersID, modelID)
+		code := codes.Unavailable
+		switch err {
+		case storage.ModelDoesNotExistError, storage.HyperparametersDoesNotExistError, storage.CheckpointDoesNotExistError:
+			code = codes.NotFound
+			message = fmt.Sprintf("%s: %v", message, err)
+		case storage.ErrNoCanonicalCheckpoint, storage.ErrUpgradeCycle,
+			storage.ErrCanonicalCheckpointDoesNotExist, storage.ErrUpgradeToDoesNotExist:
+			code = codes.FailedPrecondition
+			message = fmt.Sprintf("%s: %v", message, err)
+		}
+		grpcErr := status.Error(code, message)
+		return nil, grpcErr
+	}
+	target := upgrade.Target
+	createdAt, err := ptypes.TimestampProto(target.CreatedAt)
+	if err != nil {
+		log.Error("unable to serialize CreatedAt")
+		return nil, err
+	}
+	hops := make([]*api.UpgradeHop, len(upgrade.Chain))
+	for i, hyperparameters := range upgrade.Chain {
+		hops[i] = &api.UpgradeHop{
+			HyperparametersId:   hyperparameters.HyperparametersId,
+			CanonicalCheckpoint: hyperparameters.CanonicalCheckpoint,
+			UpgradeTo:           hyperparameters.UpgradeTo,
+		}
+	}
+	resp := &api.UpgradeCheckResponse{
+		UpgradeAvailable: upgrade.UpgradeAvailable,
+		Target: &api.GetCheckpointResponse{
+			ModelId:           modelID,
+			HyperparametersId: target.HyperparametersId,
+			CheckpointId:      target.CheckpointId,
+			Link:              target.Link,
+			CreatedAt:         createdAt,
+			Info:              target.Info,
+			State:             target.State,
+			Labels:            target.Labels,
+			Sha256:            target.Sha256,
+			SizeBytes:         target.SizeBytes,
+			ContentType:       target.ContentType,
+		},
+		Hops: hops,
+	}
+	return resp, nil
+}
+
+func (srv *server) UpdateCheckpointState(ctx context.Context, req *api.UpdateCheckpointStateRequest) (*api.UpdateCheckpointStateResponse, error) {
+	modelID := req.ModelId
+	hyperparametersID := req.HyperparametersId
+	checkpointID := req.CheckpointId
+	if _, ok := api.CheckpointState_name[int32(req.State)]; !ok || req.State == api.CheckpointState_PENDING {
+		grpcErr := status.Error(codes.InvalidArgument, "state is invalid")
+		return nil, grpcErr
+	}
+	log.Printf("UpdateCheckpointState request - ModelId: %s, HyperparametersId: %s, CheckpointId: %s, State: %s", modelID, hyperparametersID, checkpointID, req.State)
+	message := fmt.Sprintf("Could not update state of checkpoint (%s) of hyperparameters (%s) for model (%s) in storage", checkpointID, hyperparametersID, modelID)
+	storedCheckpoint, err := srv.storage.GetCheckpoint(ctx, modelID, hyperparametersID, checkpointID)
+	if err != nil {
+		log.Printf("ERROR: %v", err)
+		return nil, notFoundError(err, message)
+	}
+	if storedCheckpoint.State == api.CheckpointState_PENDING {
+		return nil, status.Error(codes.FailedPrecondition, fmt.Sprintf("%s: checkpoint is PENDING (use FinalizeCheckpoint)", message))
+	}
+	updatedCheckpoint, err := srv.storage.UpdateCheckpointState(ctx, modelID, hyperparametersID, checkpointID, req.State)
+	if err != nil {
+		log.Printf("ERROR: %v", err)
+		return nil, notFoundError(err, message)
+	}
+	srv.recordChange(ctx, "UpdateCheckpointState", common.GetCheckpointResourcePath(modelID, hyperparametersID, checkpointID), req, storedCheckpoint, updatedCheckpoint)
+	resp := &api.UpdateCheckpointStateResponse{
+		ModelId:           modelID,
+		HyperparametersId: hyperparametersID,
+		CheckpointId:      checkpointID,
+		State:             updatedCheckpoint.State,
+	}
+	return resp, nil
+}
+
+func (srv *server) DeleteCheckpoint(ctx context.Context, req *api.DeleteCheckpointRequest) (*api.DeleteCheckpointResponse, error) {
+	modelID := req.ModelId
+	hyperparametersID := req.HyperparametersId
+	checkpointID := req.CheckpointId
+	if modelID == "" {
+		return nil, api.MissingRequiredFieldError("modelId", "model id of checkpoint to delete").Err()
+	}
+	if hyperparametersID == "" {
+		return nil, api.MissingRequiredFieldError("hyperparametersId", "hyperparameters id of checkpoint to delete").Err()
+	}
+	if checkpointID == "" {
+		return nil, api.MissingRequiredFieldError("checkpointId", "checkpoint id to delete").Err()
+	}
+	log.Printf("DeleteCheckpoint request - ModelId: %s, HyperparametersId: %s, CheckpointId: %s, Force: %t", modelID, hyperparametersID, checkpointID, req.Force)
+	var storedCheckpoint interface{}
+	if checkpoint, err := srv.storage.GetCheckpoint(ctx, modelID, hyperparametersID, checkpointID); err == nil {
+		storedCheckpoint = checkpoint
+	}
+	err := srv.storage.DeleteCheckpoint(ctx, modelID, hyperparametersID, checkpointID, storage.DeleteOptions{Force: req.Force})
+	if err != nil {
+		log.Printf("ERROR: %v", err)
+		message := fmt.Sprintf("Could not delete checkpoint (%s) of hyperparameters (%s) for model (%s) from storage", checkpointID, hyperparametersID, modelID)
+		return nil, deleteError(err, message)
+	}
+	srv.recordChange(ctx, "DeleteCheckpoint", common.GetCheckpointResourcePath(modelID, hyperparametersID, checkpointID), req, storedCheckpoint, nil)
+	resp := &api.DeleteCheckpointResponse{
+		ResourcePath: common.GetCheckpointResourcePath(modelID, hyperparametersID, checkpointID),
 	}
 	return resp, nil
 }
+
+// Fsck - scans the whole repository and reports references to resources which do not exist.
+func (srv *server) Fsck(ctx context.Context, req *api.FsckRequest) (*api.FsckResponse, error) {
+	log.Printf("Fsck request")
+	danglingReferences, err := storage.FindDanglingReferences(ctx, srv.storage)
+	if err != nil {
+		log.Printf("ERROR: %v", err)
+		grpcErr := status.Error(codes.Unavailable, "Could not scan repository in storage")
+		return nil, grpcErr
+	}
+	resp := &api.FsckResponse{
+		DanglingReferences: make([]*api.DanglingReference, len(danglingReferences)),
+	}
+	for i, danglingReference := range danglingReferences {
+		resp.DanglingReferences[i] = &api.DanglingReference{
+			ResourcePath: danglingReference.ResourcePath,
+			Field:        danglingReference.Field,
+			Reference:    danglingReference.Reference,
+		}
+	}
+	return resp, nil
+}
+
+// ListAuditEvents - lists the changes made through the API, optionally only those to a given
+// resource (and the resources below it) within a given time range.
+func (srv *server) ListAuditEvents(ctx context.Context, req *api.ListAuditEventsRequest) (*api.ListAuditEventsResponse, error) {
+	return audit.ListEvents(ctx, srv.storage, req)
+}
+
+// CreateWebhook - registers a URL which changes of the given event types are POSTed to.
+func (srv *server) CreateWebhook(ctx context.Context, req *api.CreateWebhookRequest) (*api.CreateWebhookResponse, error) {
+	resp, err := webhook.CreateWebhook(ctx, srv.storage, webhookEventTypes, req)
+	if err != nil {
+		return nil, err
+	}
+	srv.recordChange(ctx, "CreateWebhook", webhook.ResourcePath(resp.Webhook.WebhookId), webhook.Redact(req), nil, resp.Webhook)
+	return resp, nil
+}
+
+func (srv *server) ListWebhooks(ctx context.Context, req *api.ListWebhooksRequest) (*api.ListWebhooksResponse, error) {
+	return webhook.ListWebhooks(ctx, srv.storage, req)
+}
+
+func (srv *server) DeleteWebhook(ctx context.Context, req *api.DeleteWebhookRequest) (*api.DeleteWebhookResponse, error) {
+	resp, err := webhook.DeleteWebhook(ctx, srv.storage, req)
+	if err != nil {
+		return nil, err
+	}
+	srv.recordChange(ctx, "DeleteWebhook", webhook.ResourcePath(req.WebhookId), req, resp.Webhook, nil)
+	return resp, nil
+}
+
+// ListWebhookDeadLetters - lists the deliveries to webhooks which failed on every attempt, in the
+// order in which the changes were made.
+func (srv *server) ListWebhookDeadLetters(ctx context.Context, req *api.ListWebhookDeadLettersRequest) (*api.ListWebhookDeadLettersResponse, error) {
+	return webhook.ListDeadLetters(ctx, srv.storage, req)
+}
+
+// ListRevisions - lists the revisions of a model, or of one of its hyperparameters, oldest first.
+func (srv *server) ListRevisions(ctx context.Context, req *api.ListRevisionsRequest) (*api.ListRevisionsResponse, error) {
+	modelID := req.ModelId
+	hyperparametersID := req.HyperparametersId
+	if modelID == "" {
+		return nil, api.MissingRequiredFieldError("modelId", "model id to list revisions of").Err()
+	}
+	var afterVersion int64
+	if req.PageToken != "" {
+		marker, err := common.DecodePageToken(req.PageToken)
+		if err == nil {
+			afterVersion, err = strconv.ParseInt(marker, 10, 64)
+		}
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "Invalid page token")
+		}
+	}
+	maxItems := int(req.MaxItems)
+	if maxItems <= 0 {
+		maxItems = 10
+	}
+	log.Printf("ListRevisions request - ModelId: %s, HyperparametersId: %s, AfterVersion: %d, MaxItems: %d", modelID, hyperparametersID, afterVersion, maxItems)
+
+	// List one more revision than asked for to find out whether there is another page
+	var revisions []*api.Revision
+	var err error
+	if hyperparametersID == "" {
+		var storedRevisions []storage.ModelRevision
+		storedRevisions, err = srv.storage.ListModelRevisions(ctx, modelID, afterVersion, maxItems+1)
+		revisions = make([]*api.Revision, len(storedRevisions))
+		for i := 0; i < len(storedRevisions) && err == nil; i++ {
+			revisions[i], err = modelRevisionToAPI(storedRevisions[i])
+		}
+	} else {
+		var storedRevisions []storage.HyperparametersRevision
+		storedRevisions, err = srv.storage.ListHyperparametersRevisions(ctx, modelID, hyperparametersID, afterVersion, maxItems+1)
+		revisions = make([]*api.Revision, len(storedRevisions))
+		for i := 0; i < len(storedRevisions) && err == nil; i++ {
+			revisions[i], err = hyperparametersRevisionToAPI(storedRevisions[i])
+		}
+	}
+	if err != nil {
+		log.Printf("ERROR: %v", err)
+		return nil, notFoundError(err, "Could not retrieve revisions from storage")
+	}
+
+	res := &api.ListRevisionsResponse{Revisions: revisions}
+	if len(revisions) > maxItems {
+		res.Revisions = revisions[:maxItems]
+		res.NextPageToken = common.EncodePageToken(strconv.FormatInt(revisions[maxItems-1].Version, 10))
+	}
+	return res, nil
+}
+
+// GetRevision - returns a model, or one of its hyperparameters, as it was at the given version.
+func (srv *server) GetRevision(ctx context.Context, req *api.GetRevisionRequest) (*api.GetRevisionResponse, error) {
+	modelID := req.ModelId
+	hyperparametersID := req.HyperparametersId
+	if modelID == "" {
+		return nil, api.MissingRequiredFieldError("modelId", "model id of revision").Err()
+	}
+	if req.Version <= 0 {
+		return nil, api.InvalidFieldValueError("version", "Versions start at 1").Err()
+	}
+	log.Printf("GetRevision request - ModelId: %s, HyperparametersId: %s, Version: %d", modelID, hyperparametersID, req.Version)
+
+	var revision *api.Revision
+	var err error
+	if hyperparametersID == "" {
+		var storedRevision storage.ModelRevision
+		storedRevision, err = srv.storage.GetModelRevision(ctx, modelID, req.Version)
+		if err == nil {
+			revision, err = modelRevisionToAPI(storedRevision)
+		}
+	} else {
+		var storedRevision storage.HyperparametersRevision
+		storedRevision, err = srv.storage.GetHyperparametersRevision(ctx, modelID, hyperparametersID, req.Version)
+		if err == nil {
+			revision, err = hyperparametersRevisionToAPI(storedRevision)
+		}
+	}
+	if err != nil {
+		log.Printf("ERROR: %v", err)
+		message := fmt.Sprintf("Could not retrieve revision (%d) from storage", req.Version)
+		return nil, notFoundError(err, message)
+	}
+	return &api.GetRevisionResponse{Revision: revision}, nil
+}
+
+// Rollback - restores an earlier revision of a model, or of one of its hyperparameters, by replacing
+// the stored resource with it. Fields which were set and hyperparameters which were added since the
+// revision are cleared. The rollback is stored as a new revision, so that it can be rolled back in
+// turn.
+func (srv *server) Rollback(ctx context.Context, req *api.RollbackRequest) (*api.RollbackResponse, error) {
+	modelID := req.ModelId
+	hyperparametersID := req.HyperparametersId
+	if modelID == "" {
+		return nil, api.MissingRequiredFieldError("modelId", "model id to roll back").Err()
+	}
+	if req.Version <= 0 {
+		return nil, api.InvalidFieldValueError("version", "Versions start at 1").Err()
+	}
+	log.Printf("Rollback request - ModelId: %s, HyperparametersId: %s, Version: %d, ExpectedVersion: %d", modelID, hyperparametersID, req.Version, req.ExpectedVersion)
+
+	if hyperparametersID == "" {
+		revision, err := srv.storage.GetModelRevision(ctx, modelID, req.Version)
+		if err != nil {
+			log.Printf("ERROR: %v", err)
+			message := fmt.Sprintf("Could not retrieve revision (%d) of model (%s) from storage", req.Version, modelID)
+			return nil, notFoundError(err, message)
+		}
+		storedModel, err := srv.storage.GetModel(ctx, modelID)
+		if err != nil {
+			log.Printf("ERROR: %v", err)
+			message := fmt.Sprintf("Could not retrieve model (%s) from storage", modelID)
+			return nil, notFoundError(err, message)
+		}
+		if err := storage.CheckVersion(storedModel.Version, req.ExpectedVersion); err != nil {
+			return nil, referenceError(err, fmt.Sprintf("Could not roll back model (%s)", modelID))
+		}
+
+		// As in UpdateModel, the replacement keeps the version it was based on.
+		restoredModel := revision.Model
+		restoredModel.Version = storedModel.Version
+		newlyStoredModel, err := srv.storage.ReplaceModel(ctx, restoredModel)
+		if err != nil {
+			log.Printf("ERROR: %v", err)
+			message := fmt.Sprintf("Could not roll back model (%s) to revision (%d)", modelID, req.Version)
+			return nil, referenceError(err, message)
+		}
+		srv.recordChange(ctx, "Rollback", common.GetModelResourcePath(modelID), req, storedModel, newlyStoredModel)
+
+		newRevision, err := srv.storage.GetModelRevision(ctx, modelID, newlyStoredModel.Version)
+		if err != nil {
+			log.Printf("ERROR: %v", err)
+			newRevision = storage.ModelRevision{Model: newlyStoredModel, CreatedAt: time.Now()}
+		}
+		resp, err := modelRevisionToAPI(newRevision)
+		if err != nil {
+			return nil, err
+		}
+		return &api.RollbackResponse{Revision: resp}, nil
+	}
+
+	revision, err := srv.storage.GetHyperparametersRevision(ctx, modelID, hyperparametersID, req.Version)
+	if err != nil {
+		log.Printf("ERROR: %v", err)
+		message := fmt.Sprintf("Could not retrieve revision (%d) of hyperparameters (%s) for model (%s) from storage", req.Version, hyperparametersID, modelID)
+		return nil, notFoundError(err, message)
+	}
+	storedHyperparameters, err := srv.storage.GetHyperparameters(ctx, modelID, hyperparametersID)
+	if err != nil {
+		log.Printf("ERROR: %v", err)
+		message := fmt.Sprintf("Could not retrieve hyperparameters (%s) for model (%s) from storage", hyperparametersID, modelID)
+		return nil, notFoundError(err, message)
+	}
+	if err := storage.CheckVersion(storedHyperparameters.Version, req.ExpectedVersion); err != nil {
+		return nil, referenceError(err, fmt.Sprintf("Could not roll back hyperparameters (%s) for model (%s)", hyperparametersID, modelID))
+	}
+
+	restoredHyperparameters := revision.Hyperparameters
+	restoredHyperparameters.Version = storedHyperparameters.Version
+	newlyStoredHyperparameters, err := srv.storage.ReplaceHyperparameters(ctx, restoredHyperparameters)
+	if err != nil {
+		log.Printf("ERROR: %v", err)
+		message := fmt.Sprintf("Could not roll back hyperparameters (%s) for model (%s) to revision (%d)", hyperparametersID, modelID, req.Version)
+		return nil, referenceError(err, message)
+	}
+	srv.recordChange(ctx, "Rollback", common.GetHyperparametersResourcePath(modelID, hyperparametersID), req, storedHyperparameters, newlyStoredHyperparameters)
+
+	newRevision, err := srv.storage.GetHyperparametersRevision(ctx, modelID, hyperparametersID, newlyStoredHyperparameters.Version)
+	if err != nil {
+		log.Printf("ERROR: %v", err)
+		newRevision = storage.HyperparametersRevision{Hyperparameters: newlyStoredHyperparameters, CreatedAt: time.Now()}
+	}
+	resp, err := hyperparametersRevisionToAPI(newRevision)
+	if err != nil {
+		return nil, err
+	}
+	return &api.RollbackResponse{Revision: resp}, nil
+}
+
+// watchPollInterval - how often WatchModel checks the audit log for new changes.
+var watchPollInterval = time.Second
+
+// watchLookback - how far back WatchModel reads the audit log on each poll. Event IDs start with
+// the time at which a change was made, but events are only written after it (possibly by another
+// replica), so an event can turn up behind events which have already been sent.
+const watchLookback = 10 * time.Second
+
+// WatchModel - streams changes to a model, its hyperparameters and their checkpoints as they are
+// recorded in the audit log, which is kept by the backend and so is shared by every replica of the
+// server. The stream ends after the model is deleted.
+func (srv *server) WatchModel(req *api.WatchModelRequest, stream api.Repository_WatchModelServer) error {
+	ctx := stream.Context()
+	log.Println(req)
+	modelID := req.ModelId
+	if modelID == "" {
+		return api.MissingRequiredFieldError("modelId", "model id to watch").Err()
+	}
+
+	marker := req.ResumeToken
+	if marker == "" {
+		marker = storage.NewAuditEventId(time.Now())
+	}
+	pollStart, err := storage.AuditEventIdTime(marker)
+	if err != nil {
+		return api.InvalidFieldValueError("resumeToken", err.Error()).Err()
+	}
+
+	_, err = srv.storage.GetModel(ctx, modelID)
+	if err != nil {
+		log.Printf("ERROR: %v", err)
+		return notFoundError(err, fmt.Sprintf("Could not retrieve model (%s)", modelID))
+	}
+
+	// sent - the times of the events after marker which have been sent and which may be read again
+	sent := make(map[string]time.Time)
+	query := storage.AuditQuery{ResourcePath: common.GetModelResourcePath(modelID)}
+	for {
+		query.Since = pollStart.Add(-watchLookback)
+		pollStart = time.Now()
+		pageMarker := ""
+		for {
+			var events []storage.AuditEvent
+			events, pageMarker, err = storage.ListMatchingAuditEvents(ctx, srv.storage, query, pageMarker, 100)
+			if err != nil {
+				log.Printf("ERROR: %v", err)
+				return status.Error(codes.Unavailable, "Could not retrieve changes from the audit log")
+			}
+			for _, event := range events {
+				if _, ok := sent[event.EventId]; ok || event.EventId <= marker {
+					continue
+				}
+				watchEvent, err := auditEventToWatchEvent(event)
+				if err != nil {
+					log.Printf("ERROR: %v", err)
+					return status.Error(codes.Internal, "Could not convert audit event")
+				}
+				err = stream.Send(watchEvent)
+				if err != nil {
+					return err
+				}
+				sent[event.EventId] = event.Time
+				if watchEvent.Type == api.WatchModelEvent_DELETED && event.ResourcePath == common.GetModelResourcePath(modelID) {
+					return nil
+				}
+			}
+			if pageMarker == "" {
+				break
+			}
+		}
+
+		for eventID, eventTime := range sent {
+			if eventTime.Before(pollStart.Add(-watchLookback)) {
+				delete(sent, eventID)
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(watchPollInterval):
+		}
+	}
+}
+
+// auditEventToWatchEvent - describes the change recorded by an audit event for WatchModel.
+func auditEventToWatchEvent(event storage.AuditEvent) (*api.WatchModelEvent, error) {
+	eventTime, err := ptypes.TimestampProto(event.Time)
+	if err != nil {
+		return nil, err
+	}
+	res := &api.WatchModelEvent{
+		EventId:      event.EventId,
+		Time:         eventTime,
+		Type:         api.WatchModelEvent_UPDATED,
+		Method:       event.Method,
+		ResourcePath: event.ResourcePath,
+		After:        event.After,
+	}
+	if event.Before == "" {
+		res.Type = api.WatchModelEvent_CREATED
+	} else if event.After == "" {
+		res.Type = api.WatchModelEvent_DELETED
+	}
+	// /models/<modelId>[/hyperparameters/<hyperparametersId>[/checkpoints/<checkpointId>]], or
+	// /models/<modelId>[/hyperparameters/<hyperparametersId>]/tags/<tag> for tags
+	parts := strings.Split(strings.TrimPrefix(event.ResourcePath, "/"), "/")
+	for ; len(parts) > 1; parts = parts[2:] {
+		switch parts[0] {
+		case "models":
+			res.ModelId = parts[1]
+		case "hyperparameters":
+			res.HyperparametersId = parts[1]
+		case "checkpoints":
+			res.CheckpointId = parts[1]
+		case "tags":
+			res.Tag = parts[1]
+		}
+	}
+	return res, nil
+}
+
+// tagPrefix - marks the hyperparameters and checkpoint IDs in requests which name a tag instead,
+// e.g. @production.
+const tagPrefix = "@"
+
+// resolveTag - if id starts with tagPrefix, returns the target of the tag it names on the model (if
+// hyperparametersID is "") or hyperparameters. Other IDs are returned as they are.
+func (srv *server) resolveTag(ctx context.Context, modelID, hyperparametersID, id string) (string, error) {
+	if !strings.HasPrefix(id, tagPrefix) {
+		return id, nil
+	}
+	tag, err := srv.storage.GetTag(ctx, modelID, hyperparametersID, strings.TrimPrefix(id, tagPrefix))
+	if err != nil {
+		log.Printf("ERROR: %v", err)
+		message := fmt.Sprintf("Could not resolve tag (%s) for model (%s)", id, modelID)
+		return "", notFoundError(err, message)
+	}
+	return tag.Target, nil
+}
+
+// resolveCheckpointTags - resolves the hyperparameters ID and then the checkpoint ID of a request,
+// either of which may be a tag.
+func (srv *server) resolveCheckpointTags(ctx context.Context, modelID, hyperparametersID, checkpointID string) (string, string, error) {
+	hyperparametersID, err := srv.resolveTag(ctx, modelID, "", hyperparametersID)
+	if err != nil {
+		return "", "", err
+	}
+	checkpointID, err = srv.resolveTag(ctx, modelID, hyperparametersID, checkpointID)
+	if err != nil {
+		return "", "", err
+	}
+	return hyperparametersID, checkpointID, nil
+}
+
+// SetTag - points a tag on a model at one of its hyperparameters, or a tag on hyperparameters at one
+// of their checkpoints. Tags which already exist are moved.
+func (srv *server) SetTag(ctx context.Context, req *api.SetTagRequest) (*api.SetTagResponse, error) {
+	modelID := req.ModelId
+	hyperparametersID := req.HyperparametersId
+	if modelID == "" {
+		return nil, api.MissingRequiredFieldError("modelId", "model id of tag").Err()
+	}
+	if !common.IsValidID(req.Tag) {
+		return nil, api.InvalidFieldValueError("tag", "Tags may only contain letters, digits, - and _").Err()
+	}
+	if req.Target == "" {
+		return nil, api.MissingRequiredFieldError("target", "id of the tagged hyperparameters or checkpoint").Err()
+	}
+	log.Printf("SetTag request - ModelId: %s, HyperparametersId: %s, Tag: %s, Target: %s", modelID, hyperparametersID, req.Tag, req.Target)
+
+	message := fmt.Sprintf("Could not set tag (%s) for model (%s)", req.Tag, modelID)
+	var before interface{}
+	existingTag, err := srv.storage.GetTag(ctx, modelID, hyperparametersID, req.Tag)
+	if err == nil {
+		before = existingTag
+	} else if err != storage.TagDoesNotExistError {
+		log.Printf("ERROR: %v", err)
+		return nil, notFoundError(err, message)
+	}
+
+	tag := storage.Tag{
+		ModelId:           modelID,
+		HyperparametersId: hyperparametersID,
+		Tag:               req.Tag,
+		Target:            req.Target,
+	}
+	err = storage.CheckTagTarget(ctx, srv.storage, tag)
+	if err != nil {
+		log.Printf("ERROR: %v", err)
+		return nil, referenceError(err, message)
+	}
+	storedTag, err := srv.storage.SetTag(ctx, tag)
+	if err != nil {
+		log.Printf("ERROR: %v", err)
+		return nil, notFoundError(err, message)
+	}
+	srv.recordChange(ctx, "SetTag", common.GetTagResourcePath(modelID, hyperparametersID, req.Tag), req, before, storedTag)
+
+	resp, err := tagToAPI(storedTag)
+	if err != nil {
+		return nil, err
+	}
+	return &api.SetTagResponse{Tag: resp}, nil
+}
+
+func (srv *server) GetTag(ctx context.Context, req *api.GetTagRequest) (*api.GetTagResponse, error) {
+	modelID := req.ModelId
+	hyperparametersID := req.HyperparametersId
+	if modelID == "" {
+		return nil, api.MissingRequiredFieldError("modelId", "model id of tag").Err()
+	}
+	log.Printf("GetTag request - ModelId: %s, HyperparametersId: %s, Tag: %s", modelID, hyperparametersID, req.Tag)
+
+	storedTag, err := srv.storage.GetTag(ctx, modelID, hyperparametersID, req.Tag)
+	if err != nil {
+		log.Printf("ERROR: %v", err)
+		message := fmt.Sprintf("Could not retrieve tag (%s) for model (%s) from storage", req.Tag, modelID)
+		return nil, notFoundError(err, message)
+	}
+	resp, err := tagToAPI(storedTag)
+	if err != nil {
+		return nil, err
+	}
+	return &api.GetTagResponse{Tag: resp}, nil
+}
+
+func (srv *server) ListTags(ctx context.Context, req *api.ListTagsRequest) (*api.ListTagsResponse, error) {
+	modelID := req.ModelId
+	hyperparametersID := req.HyperparametersId
+	if modelID == "" {
+		return nil, api.MissingRequiredFieldError("modelId", "model id of tags").Err()
+	}
+	log.Printf("ListTags request - ModelId: %s, HyperparametersId: %s", modelID, hyperparametersID)
+
+	storedTags, err := srv.storage.ListTags(ctx, modelID, hyperparametersID)
+	if err != nil {
+		log.Printf("ERROR: %v", err)
+		message := fmt.Sprintf("Could not list tags for model (%s) from storage", modelID)
+		return nil, notFoundError(err, message)
+	}
+	tags := make([]*api.Tag, len(storedTags))
+	for i, storedTag := range storedTags {
+		tags[i], err = tagToAPI(storedTag)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return &api.ListTagsResponse{Tags: tags}, nil
+}
+
+func (srv *server) DeleteTag(ctx context.Context, req *api.DeleteTagRequest) (*api.DeleteTagResponse, error) {
+	modelID := req.ModelId
+	hyperparametersID := req.HyperparametersId
+	if modelID == "" {
+		return nil, api.MissingRequiredFieldError("modelId", "model id of tag").Err()
+	}
+	log.Printf("DeleteTag request - ModelId: %s, HyperparametersId: %s, Tag: %s", modelID, hyperparametersID, req.Tag)
+
+	message := fmt.Sprintf("Could not delete tag (%s) for model (%s)", req.Tag, modelID)
+	storedTag, err := srv.storage.GetTag(ctx, modelID, hyperparametersID, req.Tag)
+	if err != nil {
+		log.Printf("ERROR: %v", err)
+		return nil, notFoundError(err, message)
+	}
+	err = srv.storage.DeleteTag(ctx, modelID, hyperparametersID, req.Tag)
+	if err != nil {
+		log.Printf("ERROR: %v", err)
+		return nil, notFoundError(err, message)
+	}
+	srv.recordChange(ctx, "DeleteTag", common.GetTagResourcePath(modelID, hyperparametersID, req.Tag), req, storedTag, nil)
+
+	resp, err := tagToAPI(storedTag)
+	if err != nil {
+		return nil, err
+	}
+	return &api.DeleteTagResponse{Tag: resp}, nil
+}
+
+func tagToAPI(tag storage.Tag) (*api.Tag, error) {
+	updatedAt, err := ptypes.TimestampProto(tag.UpdatedAt)
+	if err != nil {
+		log.Printf("ERROR: %v", err)
+		return nil, status.Error(codes.Internal, "Could not convert tag time")
+	}
+	return &api.Tag{
+		ModelId:           tag.ModelId,
+		HyperparametersId: tag.HyperparametersId,
+		Tag:               tag.Tag,
+		Target:            tag.Target,
+		UpdatedAt:         updatedAt,
+	}, nil
+}
+
+// RampRollout - starts a rollout of some checkpoints to a share of the clients of a set of
+// hyperparameters, or changes the shares of a rollout in progress. Clients which are not part of
+// the rollout get the canonical checkpoint, so rollouts which leave any clients out need one.
+func (srv *server) RampRollout(ctx context.Context, req *api.RampRolloutRequest) (*api.RampRolloutResponse, error) {
+	modelID := req.ModelId
+	hyperparametersID := req.HyperparametersId
+	if modelID == "" {
+		return nil, api.MissingRequiredFieldError("modelId", "model id of hyperparameters to roll out checkpoints for").Err()
+	}
+	if hyperparametersID == "" {
+		return nil, api.MissingRequiredFieldError("hyperparametersId", "hyperparameters id to roll out checkpoints for").Err()
+	}
+	if len(req.Rollout) == 0 {
+		return nil, api.MissingRequiredFieldError("rollout", "checkpoints to roll out (use AbortRollout to end a rollout)").Err()
+	}
+	rollout := make([]storage.RolloutTarget, len(req.Rollout))
+	totalWeight := 0
+	for i, target := range req.Rollout {
+		if target.CheckpointId == "" {
+			return nil, api.InvalidFieldValueError("rollout", "Every target needs a checkpointId").Err()
+		}
+		if storage.IsInRollout(rollout[:i], target.CheckpointId) {
+			return nil, api.InvalidFieldValueError("rollout", fmt.Sprintf("Checkpoint (%s) is listed more than once", target.CheckpointId)).Err()
+		}
+		if target.Weight <= 0 || target.Weight > storage.RolloutBuckets {
+			return nil, api.InvalidFieldValueError("rollout", fmt.Sprintf("Weights are percentages between 1 and %d", storage.RolloutBuckets)).Err()
+		}
+		totalWeight += int(target.Weight)
+		rollout[i] = storage.RolloutTarget{CheckpointId: target.CheckpointId, Weight: target.Weight}
+	}
+	if totalWeight > storage.RolloutBuckets {
+		return nil, api.InvalidFieldValueError("rollout", fmt.Sprintf("Weights add up to %d%%", totalWeight)).Err()
+	}
+	log.Printf("RampRollout request - ModelId: %s, HyperparametersId: %s, Rollout: %v, ExpectedVersion: %d", modelID, hyperparametersID, rollout, req.ExpectedVersion)
+
+	existingHyperparameters, err := srv.storage.GetHyperparameters(ctx, modelID, hyperparametersID)
+	if err != nil {
+		log.Printf("ERROR: %v", err)
+		message := fmt.Sprintf("Could not get hyperparameters (%s) for model (%s) from storage", hyperparametersID, modelID)
+		return nil, notFoundError(err, message)
+	}
+	if err := storage.CheckVersion(existingHyperparameters.Version, req.ExpectedVersion); err != nil {
+		return nil, referenceError(err, fmt.Sprintf("Could not ramp rollout for hyperparameters (%s) for model (%s)", hyperparametersID, modelID))
+	}
+	if totalWeight < storage.RolloutBuckets && existingHyperparameters.CanonicalCheckpoint == "" {
+		message := fmt.Sprintf("Hyperparameters (%s) for model (%s) need a canonicalCheckpoint for the %d%% of clients left out of the rollout", hyperparametersID, modelID, storage.RolloutBuckets-totalWeight)
+		return nil, status.Error(codes.FailedPrecondition, message)
+	}
+
+	updatedHyperparameters := storage.Hyperparameters{
+		ModelId:           modelID,
+		HyperparametersId: hyperparametersID,
+		Rollout:           rollout,
+		Version:           existingHyperparameters.Version,
+	}
+	storedHyperparameters, err := srv.storage.UpdateHyperparameters(ctx, updatedHyperparameters)
+	if err != nil {
+		log.Printf("ERROR: %v", err)
+		message := fmt.Sprintf("Could not ramp rollout for hyperparameters (%s) for model (%s)", hyperparametersID, modelID)
+		return nil, referenceError(err, message)
+	}
+	srv.recordChange(ctx, "RampRollout", common.GetHyperparametersResourcePath(modelID, hyperparametersID), req, existingHyperparameters, storedHyperparameters)
+
+	return &api.RampRolloutResponse{Hyperparameters: hyperparametersToAPI(storedHyperparameters)}, nil
+}
+
+// AbortRollout - ends the rollout of a set of hyperparameters, so that all of their clients get
+// the canonical checkpoint again. Aborting when there is no rollout in progress does nothing.
+func (srv *server) AbortRollout(ctx context.Context, req *api.AbortRolloutRequest) (*api.AbortRolloutResponse, error) {
+	modelID := req.ModelId
+	hyperparametersID := req.HyperparametersId
+	if modelID == "" {
+		return nil, api.MissingRequiredFieldError("modelId", "model id of hyperparameters to abort the rollout for").Err()
+	}
+	if hyperparametersID == "" {
+		return nil, api.MissingRequiredFieldError("hyperparametersId", "hyperparameters id to abort the rollout for").Err()
+	}
+	log.Printf("AbortRollout request - ModelId: %s, HyperparametersId: %s, ExpectedVersion: %d", modelID, hyperparametersID, req.ExpectedVersion)
+
+	existingHyperparameters, err := srv.storage.GetHyperparameters(ctx, modelID, hyperparametersID)
+	if err != nil {
+		log.Printf("ERROR: %v", err)
+		message := fmt.Sprintf("Could not get hyperparameters (%s) for model (%s) from storage", hyperparametersID, modelID)
+		return nil, notFoundError(err, message)
+	}
+	if err := storage.CheckVersion(existingHyperparameters.Version, req.ExpectedVersion); err != nil {
+		return nil, referenceError(err, fmt.Sprintf("Could not abort rollout for hyperparameters (%s) for model (%s)", hyperparametersID, modelID))
+	}
+	if len(existingHyperparameters.Rollout) == 0 {
+		return &api.AbortRolloutResponse{Hyperparameters: hyperparametersToAPI(existingHyperparameters)}, nil
+	}
+
+	updatedHyperparameters := storage.Hyperparameters{
+		ModelId:           modelID,
+		HyperparametersId: hyperparametersID,
+		Rollout:           []storage.RolloutTarget{},
+		Version:           existingHyperparameters.Version,
+	}
+	storedHyperparameters, err := srv.storage.UpdateHyperparameters(ctx, updatedHyperparameters)
+	if err != nil {
+		log.Printf("ERROR: %v", err)
+		message := fmt.Sprintf("Could not abort rollout for hyperparameters (%s) for model (%s)", hyperparametersID, modelID)
+		return nil, referenceError(err, message)
+	}
+	srv.recordChange(ctx, "AbortRollout", common.GetHyperparametersResourcePath(modelID, hyperparametersID), req, existingHyperparameters, storedHyperparameters)
+
+	return &api.AbortRolloutResponse{Hyperparameters: hyperparametersToAPI(storedHyperparameters)}, nil
+}
+
+func hyperparametersToAPI(hyperparameters storage.Hyperparameters) *api.GetHyperparametersResponse {
+	return &api.GetHyperparametersResponse{
+		ModelId:             hyperparameters.ModelId,
+		HyperparametersId:   hyperparameters.HyperparametersId,
+		UpgradeTo:           hyperparameters.UpgradeTo,
+		CanonicalCheckpoint: hyperparameters.CanonicalCheckpoint,
+		Hyperparameters:     hyperparameters.Hyperparameters,
+		Labels:              hyperparameters.Labels,
+		Version:             hyperparameters.Version,
+		Rollout:             rolloutToAPI(hyperparameters.Rollout),
+	}
+}
+
+func rolloutToAPI(rollout []storage.RolloutTarget) []*api.RolloutTarget {
+	if len(rollout) == 0 {
+		return nil
+	}
+	res := make([]*api.RolloutTarget, len(rollout))
+	for i, target := range rollout {
+		res[i] = &api.RolloutTarget{
+			CheckpointId: target.CheckpointId,
+			Weight:       target.Weight,
+		}
+	}
+	return res
+}
+
+// CompareCheckpoints - tabulates the numeric info entries of some of the checkpoints of a set of
+// hyperparameters, with their deltas against the first of them, and optionally picks the best of
+// the checkpoints by one of those metrics.
+func (srv *server) CompareCheckpoints(ctx context.Context, req *api.CompareCheckpointsRequest) (*api.CompareCheckpointsResponse, error) {
+	modelID := req.ModelId
+	hyperparametersID := req.HyperparametersId
+	if modelID == "" {
+		return nil, api.MissingRequiredFieldError("modelId", "model id of checkpoints to compare").Err()
+	}
+	if hyperparametersID == "" {
+		return nil, api.MissingRequiredFieldError("hyperparametersId", "hyperparameters id of checkpoints to compare").Err()
+	}
+	if len(req.CheckpointIds) == 0 && req.Last <= 0 {
+		return nil, api.MissingRequiredFieldError("checkpointIds", "checkpoints to compare, or the number of latest checkpoints to compare in last").Err()
+	}
+	if len(req.CheckpointIds) > 0 && req.Last != 0 {
+		return nil, api.InvalidFieldValueError("last", "Set either checkpointIds or last").Err()
+	}
+	if len(req.CheckpointIds) > maxComparedCheckpoints || req.Last > maxComparedCheckpoints {
+		return nil, api.InvalidFieldValueError("checkpointIds", fmt.Sprintf("At most %d checkpoints can be compared", maxComparedCheckpoints)).Err()
+	}
+	log.Printf("CompareCheckpoints request - ModelId: %s, HyperparametersId: %s, CheckpointIds: %v, Last: %d, Metrics: %v, BestBy: %s", modelID, hyperparametersID, req.CheckpointIds, req.Last, req.Metrics, req.BestBy)
+	hyperparametersID, err := srv.resolveTag(ctx, modelID, "", hyperparametersID)
+	if err != nil {
+		return nil, err
+	}
+
+	var checkpoints []storage.Checkpoint
+	if len(req.CheckpointIds) > 0 {
+		checkpointIDs := make([]string, len(req.CheckpointIds))
+		for i, checkpointID := range req.CheckpointIds {
+			checkpointIDs[i], err = srv.resolveTag(ctx, modelID, hyperparametersID, checkpointID)
+			if err != nil {
+				return nil, err
+			}
+		}
+		checkpoints, err = srv.storage.BatchGetCheckpoints(ctx, modelID, hyperparametersID, checkpointIDs)
+	} else {
+		checkpoints, err = storage.GetLatestCheckpoints(ctx, srv.storage, modelID, hyperparametersID, int(req.Last), req.IncludeArchived)
+	}
+	if err == storage.ErrTooManyCheckpoints {
+		message := fmt.Sprintf("Hyperparameters (%s) for model (%s) have more than %d checkpoints, name the checkpoints to compare in checkpointIds instead", hyperparametersID, modelID, storage.MaxScannedCheckpoints)
+		return nil, status.Error(codes.FailedPrecondition, message)
+	}
+	if err != nil {
+		log.Printf("ERROR: %v", err)
+		message := fmt.Sprintf("Could not get checkpoints of hyperparameters (%s) for model (%s) from storage", hyperparametersID, modelID)
+		return nil, notFoundError(err, message)
+	}
+
+	metrics := req.Metrics
+	if len(metrics) > 0 && req.BestBy != "" {
+		metrics = append([]string{req.BestBy}, metrics...)
+	}
+	comparison := storage.CompareCheckpoints(checkpoints, metrics)
+
+	resp := &api.CompareCheckpointsResponse{
+		ModelId:           modelID,
+		HyperparametersId: hyperparametersID,
+		Metrics:           comparison.Metrics,
+		Checkpoints:       make([]*api.CheckpointMetrics, len(comparison.Rows)),
+	}
+	for i, row := range comparison.Rows {
+		createdAt, err := ptypes.TimestampProto(row.CreatedAt)
+		if err != nil {
+			log.Printf("ERROR: %v", err)
+			return nil, status.Error(codes.Internal, "Could not convert checkpoint time")
+		}
+		resp.Checkpoints[i] = &api.CheckpointMetrics{
+			CheckpointId: row.CheckpointId,
+			CreatedAt:    createdAt,
+			Values:       row.Values,
+			Deltas:       row.Deltas,
+		}
+	}
+	if req.BestBy != "" {
+		if best, ok := comparison.BestByMetric(req.BestBy, req.LowerIsBetter); ok {
+			resp.BestCheckpointId = best.CheckpointId
+			resp.BestValue = best.Values[req.BestBy]
+		}
+	}
+	return resp, nil
+}
+
+// recordChange - adds a change made by the given Repository method to the audit log, and delivers
+// it to the webhooks which subscribe to it.
+func (srv *server) recordChange(ctx context.Context, method, resourcePath string, req proto.Message, before, after interface{}) {
+	event := audit.Record(ctx, srv.storage, "/api.Repository/"+method, resourcePath, req, before, after)
+	srv.webhooks.Dispatch(event)
+}
+
+// resolveError - converts an error returned by storage.ResolveModel or
+// storage.ResolveModelForClient into a gRPC error. Broken chains are reported as
+// FailedPrecondition.
+func resolveError(err error, message string) error {
+	switch err {
+	case storage.ModelDoesNotExistError:
+		return status.Error(codes.NotFound, message)
+	case storage.ErrNoCanonicalHyperparameters, storage.ErrNoCanonicalCheckpoint, storage.ErrUpgradeCycle,
+		storage.ErrCanonicalHyperparametersDoesNotExist, storage.ErrCanonicalCheckpointDoesNotExist, storage.ErrUpgradeToDoesNotExist,
+		storage.ErrRolloutTargetDoesNotExist:
+		return status.Error(codes.FailedPrecondition, fmt.Sprintf("%s: %v", message, err))
+	}
+	return status.Error(codes.Unavailable, message)
+}
+
+func resolutionToAPI(resolution storage.Resolution) (*api.ResolveModelResponse, error) {
+	createdAt, err := ptypes.TimestampProto(resolution.Checkpoint.CreatedAt)
+	if err != nil {
+		log.Error("unable to serialize CreatedAt")
+		return nil, err
+	}
+	model := resolution.Model
+	hyperparameters := resolution.Hyperparameters
+	checkpoint := resolution.Checkpoint
+	resp := &api.ResolveModelResponse{
+		Model: &api.Model{
+			ModelId:                  model.ModelId,
+			Details:                  model.Details,
+			CanonicalHyperparameters: model.CanonicalHyperparameters,
+			Labels:                   model.Labels,
+			Card:                     modelCardToAPI(model.Card),
+			Version:                  model.Version,
+		},
+		Hyperparameters: &api.GetHyperparametersResponse{
+			ModelId:             model.ModelId,
+			HyperparametersId:   hyperparameters.HyperparametersId,
+			UpgradeTo:           hyperparameters.UpgradeTo,
+			CanonicalCheckpoint: hyperparameters.CanonicalCheckpoint,
+			Hyperparameters:     hyperparameters.Hyperparameters,
+			Labels:              hyperparameters.Labels,
+			Version:             hyperparameters.Version,
+			Rollout:             rolloutToAPI(hyperparameters.Rollout),
+		},
+		Checkpoint: &api.GetCheckpointResponse{
+			ModelId:           model.ModelId,
+			HyperparametersId: hyperparameters.HyperparametersId,
+			CheckpointId:      checkpoint.CheckpointId,
+			Link:              checkpoint.Link,
+			CreatedAt:         createdAt,
+			Info:              checkpoint.Info,
+			State:             checkpoint.State,
+			Labels:            checkpoint.Labels,
+			Sha256:            checkpoint.Sha256,
+			SizeBytes:         checkpoint.SizeBytes,
+			ContentType:       checkpoint.ContentType,
+		},
+		UpgradePath: resolution.UpgradePath,
+	}
+	return resp, nil
+}
+
+// referenceError - converts an error returned by one of the storage Update* methods into a gRPC
+// error, reporting references to missing resources as failed preconditions and concurrent updates
+// as aborted.
+func referenceError(err error, message string) error {
+	switch err {
+	case storage.ErrCanonicalHyperparametersDoesNotExist, storage.ErrCanonicalCheckpointDoesNotExist, storage.ErrUpgradeToDoesNotExist, storage.ErrTagTargetDoesNotExist,
+		storage.ErrRolloutTargetDoesNotExist:
+		return status.Error(codes.FailedPrecondition, fmt.Sprintf("%s: %v", message, err))
+	case storage.ErrVersionMismatch:
+		return status.Error(codes.Aborted, fmt.Sprintf("%s: %v", message, err))
+	}
+	return status.Error(codes.Unavailable, message)
+}
+
+// notFoundError - converts an error returned by storage into a gRPC error, distinguishing missing
+// resources from storage failures.
+func notFoundError(err error, message string) error {
+	switch err {
+	case storage.ModelDoesNotExistError, storage.HyperparametersDoesNotExistError, storage.CheckpointDoesNotExistError, storage.RevisionDoesNotExistError, storage.TagDoesNotExistError:
+		return status.Error(codes.NotFound, message)
+	}
+	return status.Error(codes.Unavailable, message)
+}
+
+// deleteError - converts an error returned by one of the storage Delete* methods into a gRPC error,
+// distinguishing missing resources and refused deletes from storage failures.
+func deleteError(err error, message string) error {
+	switch err {
+	case storage.ModelDoesNotExistError, storage.HyperparametersDoesNotExistError, storage.CheckpointDoesNotExistError:
+		return status.Error(codes.NotFound, fmt.Sprintf("%s: %v", message, err))
+	case storage.ErrResourceHasChildren:
+		return status.Error(codes.FailedPrecondition, fmt.Sprintf("%s: %v (use cascade to delete them too)", message, err))
+	case storage.ErrResourceIsReferenced:
+		return status.Error(codes.FailedPrecondition, fmt.Sprintf("%s: %v (use force to delete it anyway)", message, err))
+	}
+	return status.Error(codes.Unavailable, message)
+}
+
+// validateLabels - checks that every label key is a valid ID, so that it can be referred to in
+// filter expressions as label.<key>.
+func validateLabels(field string, labels map[string]string) error {
+	for k := range labels {
+		if !common.IsValidID(k) {
+			return api.InvalidFieldValueError(field, fmt.Sprintf("Label key (%s) is invalid", k)).Err()
+		}
+	}
+	return nil
+}
+
+// validateCheckpointContent - checks the optional description of a checkpoint's bundle.
+func validateCheckpointContent(req *api.CreateCheckpointRequest) error {
+	if req.Sha256 != "" {
+		digest, err := hex.DecodeString(req.Sha256)
+		if err != nil || len(digest) != sha256.Size {
+			return api.InvalidFieldValueError("sha256", "sha256 must be a hex encoded SHA-256 digest").Err()
+		}
+	}
+	if req.SizeBytes < 0 {
+		return api.InvalidFieldValueError("sizeBytes", "sizeBytes cannot be negative").Err()
+	}
+	if req.ContentType != "" {
+		if _, _, err := mime.ParseMediaType(req.ContentType); err != nil {
+			return api.InvalidFieldValueError("contentType", fmt.Sprintf("contentType is invalid: %v", err)).Err()
+		}
+	}
+	return nil
+}
+
+// verifyUploadedBundle - checks the uploaded bundle of a checkpoint against the Sha256, SizeBytes
+// and ContentType it was created with, see storage.VerifyCheckpointContent.
+func (srv *server) verifyUploadedBundle(ctx context.Context, checkpoint storage.Checkpoint) error {
+	reader, err := srv.storage.OpenCheckpointLink(ctx, checkpoint.Link)
+	if err != nil {
+		log.Printf("ERROR: %v", err)
+		return status.Error(codes.Unavailable, fmt.Sprintf("Could not read bundle at %s", checkpoint.Link))
+	}
+	defer reader.Close()
+
+	err = storage.VerifyCheckpointContent(checkpoint, reader)
+	if contentErr, ok := err.(storage.CheckpointContentError); ok {
+		return api.InvalidFieldValueError(contentErr.Field, contentErr.Error()).Err()
+	}
+	if err != nil {
+		log.Printf("ERROR: %v", err)
+		return status.Error(codes.Unavailable, fmt.Sprintf("Could not read bundle at %s", checkpoint.Link))
+	}
+	return nil
+}
+
+// inspectBundle - validates the model.json of the TensorIO bundle at link and returns info with the
+// bundle's backend, quantization and input and output specs added under tiobundle.* keys. Bundles
+// which the backend cannot read are not validated, and info is returned as it is. Links to a
+// .tiobundle directory are read as such, anything else as a zipped bundle.
+func (srv *server) inspectBundle(ctx context.Context, link string, info map[string]string) (map[string]string, error) {
+	isDirectory := strings.HasSuffix(strings.TrimSuffix(link, "/"), ".tiobundle")
+	objectLink := link
+	if isDirectory {
+		objectLink = strings.TrimSuffix(link, "/") + "/model.json"
+	}
+	reader, err := srv.storage.OpenCheckpointLink(ctx, objectLink)
+	if err == storage.ErrLinkNotReadable {
+		log.Printf("Not validating bundle at %s: %v", link, err)
+		return info, nil
+	}
+	if err == storage.ErrLinkDoesNotExist {
+		return nil, api.InvalidFieldValueError("link", fmt.Sprintf("There is no bundle at %s", objectLink)).Err()
+	}
+	if err != nil {
+		log.Printf("ERROR: %v", err)
+		return nil, status.Error(codes.Unavailable, fmt.Sprintf("Could not read bundle at %s", link))
+	}
+	defer reader.Close()
+
+	var modelJSON []byte
+	if isDirectory {
+		modelJSON, err = tiobundle.ReadModelJSON(reader)
+	} else {
+		modelJSON, err = tiobundle.ReadZippedModelJSON(reader)
+	}
+	if err != nil {
+		return nil, api.InvalidFieldValueError("link", fmt.Sprintf("Could not read model.json of bundle at %s: %v", link, err)).Err()
+	}
+	bundle, err := tiobundle.Parse(modelJSON)
+	if validationErr, ok := err.(*tiobundle.ValidationError); ok {
+		violations := make([]*errdetails.BadRequest_FieldViolation, len(validationErr.Violations))
+		for i, violation := range validationErr.Violations {
+			violations[i] = &errdetails.BadRequest_FieldViolation{
+				Field:       "bundle:model.json " + violation.Field,
+				Description: violation.Description,
+			}
+		}
+		return nil, api.InvalidFieldValuesError(validationErr.Error(), violations).Err()
+	}
+	if err != nil {
+		return nil, api.InvalidFieldValueError("link", err.Error()).Err()
+	}
+
+	inputs, err := json.Marshal(bundle.Inputs)
+	if err != nil {
+		return nil, err
+	}
+	outputs, err := json.Marshal(bundle.Outputs)
+	if err != nil {
+		return nil, err
+	}
+	res := make(map[string]string, len(info)+4)
+	for k, v := range info {
+		res[k] = v
+	}
+	res["tiobundle.backend"] = bundle.Backend
+	res["tiobundle.quantized"] = strconv.FormatBool(bundle.Quantized)
+	res["tiobundle.inputs"] = string(inputs)
+	res["tiobundle.outputs"] = string(outputs)
+	return res, nil
+}
+
+// tensorDtypes - the element types the tensors described in model cards can have.
+var tensorDtypes = map[string]bool{
+	"float16": true, "float32": true, "float64": true,
+	"int8": true, "int16": true, "int32": true, "int64": true, "uint8": true,
+	"bool": true, "string": true,
+}
+
+// validateModelCard - checks a model card against the model card schema. Cards without a
+// schemaVersion follow the current schema.
+func validateModelCard(field string, card *api.ModelCard) error {
+	if card == nil {
+		return nil
+	}
+	if card.SchemaVersion < 0 || card.SchemaVersion > storage.ModelCardSchemaVersion {
+		return api.InvalidFieldValueError(field+".schemaVersion", fmt.Sprintf("Model card schema version (%d) is not supported", card.SchemaVersion)).Err()
+	}
+	if strings.TrimSpace(card.Description) == "" {
+		return api.MissingRequiredFieldError(field+".description", "description of the model").Err()
+	}
+	if len(card.Owners) == 0 {
+		return api.MissingRequiredFieldError(field+".owners", "owners of the model").Err()
+	}
+	owners := make(map[string]bool, len(card.Owners))
+	for i, owner := range card.Owners {
+		if strings.TrimSpace(owner) == "" || owners[owner] {
+			return api.InvalidFieldValueError(fmt.Sprintf("%s.owners[%d]", field, i), "Owners must be distinct and not empty").Err()
+		}
+		owners[owner] = true
+	}
+	if err := validateTensorSpecs(field+".inputs", card.Inputs); err != nil {
+		return err
+	}
+	return validateTensorSpecs(field+".outputs", card.Outputs)
+}
+
+func validateTensorSpecs(field string, specs []*api.TensorSpec) error {
+	names := make(map[string]bool, len(specs))
+	for i, spec := range specs {
+		specField := fmt.Sprintf("%s[%d]", field, i)
+		if spec.Name == "" || names[spec.Name] {
+			return api.InvalidFieldValueError(specField+".name", "Tensor names must be distinct and not empty").Err()
+		}
+		names[spec.Name] = true
+		if !tensorDtypes[spec.Dtype] {
+			return api.InvalidFieldValueError(specField+".dtype", fmt.Sprintf("Tensor dtype (%s) is not supported", spec.Dtype)).Err()
+		}
+		for _, dimension := range spec.Shape {
+			if dimension == 0 || dimension < -1 {
+				return api.InvalidFieldValueError(specField+".shape", "Dimensions must be positive, or -1 for dimensions of any size").Err()
+			}
+		}
+	}
+	return nil
+}
+
+// modelCardFromAPI - converts a validated model card for storage, stamping it with the schema version.
+func modelCardFromAPI(card *api.ModelCard) *storage.ModelCard {
+	if card == nil {
+		return nil
+	}
+	return &storage.ModelCard{
+		SchemaVersion: storage.ModelCardSchemaVersion,
+		Description:   card.Description,
+		Owners:        card.Owners,
+		Inputs:        tensorSpecsFromAPI(card.Inputs),
+		Outputs:       tensorSpecsFromAPI(card.Outputs),
+		License:       card.License,
+		IntendedUse:   card.IntendedUse,
+		Framework:     card.Framework,
+	}
+}
+
+func tensorSpecsFromAPI(specs []*api.TensorSpec) []storage.TensorSpec {
+	res := make([]storage.TensorSpec, len(specs))
+	for i, spec := range specs {
+		res[i] = storage.TensorSpec{
+			Name:        spec.Name,
+			Dtype:       spec.Dtype,
+			Shape:       spec.Shape,
+			Description: spec.Description,
+		}
+	}
+	return res
+}
+
+func modelCardToAPI(card *storage.ModelCard) *api.ModelCard {
+	if card == nil {
+		return nil
+	}
+	return &api.ModelCard{
+		SchemaVersion: card.SchemaVersion,
+		Description:   card.Description,
+		Owners:        card.Owners,
+		Inputs:        tensorSpecsToAPI(card.Inputs),
+		Outputs:       tensorSpecsToAPI(card.Outputs),
+		License:       card.License,
+		IntendedUse:   card.IntendedUse,
+		Framework:     card.Framework,
+	}
+}
+
+func tensorSpecsToAPI(specs []storage.TensorSpec) []*api.TensorSpec {
+	res := make([]*api.TensorSpec, len(specs))
+	for i, spec := range specs {
+		res[i] = &api.TensorSpec{
+			Name:        spec.Name,
+			Dtype:       spec.Dtype,
+			Shape:       spec.Shape,
+			Description: spec.Description,
+		}
+	}
+	return res
+}
+
+// mergeLabels - applies the labels in an update to a copy of the stored labels. Labels which are
+// set to "" in the update are removed.
+func mergeLabels(stored, updates map[string]string) map[string]string {
+	merged := make(map[string]string, len(stored)+len(updates))
+	for k, v := range stored {
+		merged[k] = v
+	}
+	for k, v := range updates {
+		if v == "" {
+			delete(merged, k)
+		} else {
+			merged[k] = v
+		}
+	}
+	return merged
+}
+
+func modelRevisionToAPI(revision storage.ModelRevision) (*api.Revision, error) {
+	createdAt, err := ptypes.TimestampProto(revision.CreatedAt)
+	if err != nil {
+		log.Printf("ERROR: %v", err)
+		return nil, status.Error(codes.Internal, "Could not convert revision time")
+	}
+	model := revision.Model
+	return &api.Revision{
+		Version:   model.Version,
+		CreatedAt: createdAt,
+		Model: &api.Model{
+			ModelId:                  model.ModelId,
+			Details:                  model.Details,
+			CanonicalHyperparameters: model.CanonicalHyperparameters,
+			Labels:                   model.Labels,
+			Card:                     modelCardToAPI(model.Card),
+			Version:                  model.Version,
+		},
+	}, nil
+}
+
+func hyperparametersRevisionToAPI(revision storage.HyperparametersRevision) (*api.Revision, error) {
+	createdAt, err := ptypes.TimestampProto(revision.CreatedAt)
+	if err != nil {
+		log.Printf("ERROR: %v", err)
+		return nil, status.Error(codes.Internal, "Could not convert revision time")
+	}
+	hyperparameters := revision.Hyperparameters
+	return &api.Revision{
+		Version:   hyperparameters.Version,
+		CreatedAt: createdAt,
+		Hyperparameters: &api.GetHyperparametersResponse{
+			ModelId:             hyperparameters.ModelId,
+			HyperparametersId:   hyperparameters.HyperparametersId,
+			UpgradeTo:           hyperparameters.UpgradeTo,
+			CanonicalCheckpoint: hyperparameters.CanonicalCheckpoint,
+			Hyperparameters:     hyperparameters.Hyperparameters,
+			Labels:              hyperparameters.Labels,
+			Version:             hyperparameters.Version,
+			Rollout:             rolloutToAPI(hyperparameters.Rollout),
+		},
+	}, nil
+}
@@ -1,32 +1,49 @@
 package server_test
 
 import (
+	"archive/zip"
+	"bufio"
 	"bytes"
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
 	"net/http"
+	"net/http/httptest"
+	"net/url"
 	"os"
+	"path/filepath"
 	"sort"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/doc-ai/tensorio-models/api"
 	"github.com/doc-ai/tensorio-models/authentication"
 	"github.com/doc-ai/tensorio-models/common"
+	"github.com/doc-ai/tensorio-models/internal/tests"
+	"github.com/doc-ai/tensorio-models/manifest"
+	"github.com/doc-ai/tensorio-models/manifest/verify"
 	"github.com/doc-ai/tensorio-models/server"
 
+	"github.com/doc-ai/tensorio-models/storage"
 	"github.com/doc-ai/tensorio-models/storage/memory"
+	"github.com/doc-ai/tensorio-models/webhook"
 	"github.com/golang/protobuf/ptypes"
 	"github.com/stretchr/testify/assert"
+	"golang.org/x/crypto/ed25519"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 var CWD, _ = os.Getwd()
 
 func testingServer() api.RepositoryServer {
 	storage := memory.NewMemoryRepositoryStorage()
-	srv := server.NewServer(storage, authentication.NewFakeAuthenticator())
+	srv := server.NewServer(storage, authentication.NewFakeAuthenticator(), 0, nil, false)
 	return srv
 }
 
@@ -183,6 +200,266 @@ func TestListModels(t *testing.T) {
 	}
 }
 
+// Tests that following nextPageToken walks through all models exactly once
+func TestListModelsPageTokens(t *testing.T) {
+	srv := testingServer()
+	ctx := context.Background()
+
+	modelIDs := []string{"model-a", "model-b", "model-c", "model-d", "model-e"}
+	for _, modelID := range modelIDs {
+		_, err := srv.CreateModel(ctx, &api.CreateModelRequest{Model: &api.Model{ModelId: modelID, Details: modelID}})
+		assert.NoError(t, err)
+	}
+
+	var listed []string
+	pageToken := ""
+	for pages := 0; pages < len(modelIDs); pages++ {
+		resp, err := srv.ListModels(ctx, &api.ListModelsRequest{MaxItems: 2, PageToken: pageToken})
+		assert.NoError(t, err)
+		listed = append(listed, resp.ModelIds...)
+		pageToken = resp.NextPageToken
+		if pageToken == "" {
+			break
+		}
+	}
+	assert.Equal(t, modelIDs, listed)
+	assert.Equal(t, "", pageToken)
+
+	// a page which ends exactly at the last model has no next page
+	resp, err := srv.ListModels(ctx, &api.ListModelsRequest{Marker: "model-c", MaxItems: 2})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"model-d", "model-e"}, resp.ModelIds)
+	assert.Equal(t, "", resp.NextPageToken)
+
+	_, err = srv.ListModels(ctx, &api.ListModelsRequest{PageToken: "not a page token!"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+// Tests that view=FULL returns the listed resources along with their IDs
+func TestListFullView(t *testing.T) {
+	srv := testingServer()
+	ctx := context.Background()
+
+	_, err := srv.CreateModel(ctx, &api.CreateModelRequest{Model: &api.Model{ModelId: "model", Details: "details"}})
+	assert.NoError(t, err)
+	_, err = srv.CreateHyperparameters(ctx, &api.CreateHyperparametersRequest{
+		ModelId:           "model",
+		HyperparametersId: "hp",
+		Hyperparameters:   map[string]string{"key": "value"},
+	})
+	assert.NoError(t, err)
+	for _, checkpointID := range []string{"ckpt-1", "ckpt-2"} {
+		_, err = srv.CreateCheckpoint(ctx, &api.CreateCheckpointRequest{
+			ModelId:           "model",
+			HyperparametersId: "hp",
+			CheckpointId:      checkpointID,
+			Link:              "gs://bucket/" + checkpointID,
+			Info:              map[string]string{"id": checkpointID},
+		})
+		assert.NoError(t, err)
+	}
+
+	models, err := srv.ListModels(ctx, &api.ListModelsRequest{})
+	assert.NoError(t, err)
+	assert.Empty(t, models.Models)
+
+	models, err = srv.ListModels(ctx, &api.ListModelsRequest{View: api.ListView_FULL})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"model"}, models.ModelIds)
+	assert.Equal(t, []*api.Model{{ModelId: "model", Details: "details", Version: 1}}, models.Models)
+
+	hyperparameters, err := srv.ListHyperparameters(ctx, &api.ListHyperparametersRequest{ModelId: "model", View: api.ListView_FULL})
+	assert.NoError(t, err)
+	assert.Equal(t, []*api.GetHyperparametersResponse{{
+		ModelId:           "model",
+		HyperparametersId: "hp",
+		Hyperparameters:   map[string]string{"key": "value"},
+		Version:           1,
+	}}, hyperparameters.Hyperparameters)
+
+	checkpoints, err := srv.ListCheckpoints(ctx, &api.ListCheckpointsRequest{
+		ModelId:           "model",
+		HyperparametersId: "hp",
+		MaxItems:          1,
+		View:              api.ListView_FULL,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"ckpt-1"}, checkpoints.CheckpointIds)
+	assert.Len(t, checkpoints.Checkpoints, 1)
+	checkpoint := checkpoints.Checkpoints[0]
+	assert.Equal(t, "ckpt-1", checkpoint.CheckpointId)
+	assert.Equal(t, "gs://bucket/ckpt-1", checkpoint.Link)
+	assert.Equal(t, map[string]string{"id": "ckpt-1"}, checkpoint.Info)
+	assert.NotNil(t, checkpoint.CreatedAt)
+}
+
+func TestModelCards(t *testing.T) {
+	srv := server.NewServer(memory.NewMemoryRepositoryStorage(), authentication.NewFakeAuthenticator(), 0, nil, false)
+	ctx := context.Background()
+
+	newCard := func() *api.ModelCard {
+		return &api.ModelCard{
+			Description: "Finds faces",
+			Owners:      []string{"vision@example.com"},
+			Inputs:      []*api.TensorSpec{{Name: "image", Dtype: "uint8", Shape: []int64{-1, 224, 224, 3}}},
+			Outputs:     []*api.TensorSpec{{Name: "boxes", Dtype: "float32", Shape: []int64{-1, 4}}},
+			License:     "Apache-2.0",
+			IntendedUse: "Cropping selfies",
+			Framework:   "tflite",
+		}
+	}
+	invalid := map[string]func(*api.ModelCard){
+		"schemaVersion":    func(card *api.ModelCard) { card.SchemaVersion = 2 },
+		"description":      func(card *api.ModelCard) { card.Description = " " },
+		"owners":           func(card *api.ModelCard) { card.Owners = nil },
+		"owners[1]":        func(card *api.ModelCard) { card.Owners = append(card.Owners, card.Owners[0]) },
+		"inputs[0].name":   func(card *api.ModelCard) { card.Inputs[0].Name = "" },
+		"inputs[1].name":   func(card *api.ModelCard) { card.Inputs = append(card.Inputs, card.Inputs[0]) },
+		"outputs[0].dtype": func(card *api.ModelCard) { card.Outputs[0].Dtype = "float" },
+		"outputs[0].shape": func(card *api.ModelCard) { card.Outputs[0].Shape = []int64{-2, 4} },
+		"inputs[0].shape":  func(card *api.ModelCard) { card.Inputs[0].Shape = []int64{0} },
+	}
+	for field, invalidate := range invalid {
+		card := newCard()
+		invalidate(card)
+		_, err := srv.CreateModel(ctx, &api.CreateModelRequest{Model: &api.Model{ModelId: "model", Card: card}})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err), field)
+		assert.Contains(t, fmt.Sprint(status.Convert(err).Details()), "model.card."+field)
+	}
+
+	// Models still need a description of some sort
+	_, err := srv.CreateModel(ctx, &api.CreateModelRequest{Model: &api.Model{ModelId: "model"}})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = srv.CreateModel(ctx, &api.CreateModelRequest{Model: &api.Model{ModelId: "model", Card: newCard()}})
+	assert.NoError(t, err)
+	model, err := srv.GetModel(ctx, &api.GetModelRequest{ModelId: "model"})
+	assert.NoError(t, err)
+	// Legacy clients see the card's description as the model's details
+	assert.Equal(t, "Finds faces", model.Details)
+	expected := newCard()
+	expected.SchemaVersion = 1
+	assert.Equal(t, expected, model.Card)
+
+	// Models without a card work as they always have
+	_, err = srv.CreateModel(ctx, &api.CreateModelRequest{Model: &api.Model{ModelId: "legacy", Details: "details"}})
+	assert.NoError(t, err)
+	legacy, err := srv.GetModel(ctx, &api.GetModelRequest{ModelId: "legacy"})
+	assert.NoError(t, err)
+	assert.Nil(t, legacy.Card)
+
+	card := newCard()
+	card.Owners = []string{"audio@example.com"}
+	card.Inputs = nil
+	updated, err := srv.UpdateModel(ctx, &api.UpdateModelRequest{ModelId: "model", Model: &api.Model{Card: card}})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"audio@example.com"}, updated.Model.Card.Owners)
+	assert.Empty(t, updated.Model.Card.Inputs)
+	assert.Equal(t, "Finds faces", updated.Model.Details)
+
+	card.Framework = "pytorch"
+	_, err = srv.UpdateModel(ctx, &api.UpdateModelRequest{ModelId: "model", Model: &api.Model{Card: card}})
+	assert.NoError(t, err)
+	models, err := srv.ListModels(ctx, &api.ListModelsRequest{Filter: "card.framework=pytorch", View: api.ListView_FULL})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"model"}, models.ModelIds)
+	assert.Equal(t, "pytorch", models.Models[0].Card.Framework)
+
+	card.Description = ""
+	_, err = srv.UpdateModel(ctx, &api.UpdateModelRequest{ModelId: "model", Model: &api.Model{Card: card}})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestLabelsAndFilters(t *testing.T) {
+	srv := testingServer()
+	ctx := context.Background()
+
+	for _, model := range []*api.Model{
+		{ModelId: "selfie", Details: "details", Labels: map[string]string{"team": "vision", "stage": "beta"}},
+		{ModelId: "speech", Details: "details", Labels: map[string]string{"team": "audio"}},
+		{ModelId: "unlabelled", Details: "details"},
+	} {
+		_, err := srv.CreateModel(ctx, &api.CreateModelRequest{Model: model})
+		assert.NoError(t, err)
+	}
+
+	_, err := srv.CreateModel(ctx, &api.CreateModelRequest{
+		Model: &api.Model{ModelId: "badlabel", Details: "details", Labels: map[string]string{"bad key": "x"}},
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	models, err := srv.ListModels(ctx, &api.ListModelsRequest{Filter: "label.team=vision OR label.team=audio", MaxItems: 1})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"selfie"}, models.ModelIds)
+	assert.NotEmpty(t, models.NextPageToken)
+	models, err = srv.ListModels(ctx, &api.ListModelsRequest{
+		Filter:    "label.team=vision OR label.team=audio",
+		PageToken: models.NextPageToken,
+		View:      api.ListView_FULL,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"speech"}, models.ModelIds)
+	assert.Equal(t, "", models.NextPageToken)
+	assert.Equal(t, map[string]string{"team": "audio"}, models.Models[0].Labels)
+
+	_, err = srv.ListModels(ctx, &api.ListModelsRequest{Filter: "label.team=="})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// Labels set to "" are removed, other labels are kept
+	updated, err := srv.UpdateModel(ctx, &api.UpdateModelRequest{
+		ModelId: "selfie",
+		Model:   &api.Model{Labels: map[string]string{"stage": "", "owner": "ada"}},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{"team": "vision", "owner": "ada"}, updated.Model.Labels)
+	model, err := srv.GetModel(ctx, &api.GetModelRequest{ModelId: "selfie"})
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{"team": "vision", "owner": "ada"}, model.Labels)
+
+	_, err = srv.CreateHyperparameters(ctx, &api.CreateHyperparametersRequest{
+		ModelId:           "selfie",
+		HyperparametersId: "hp",
+		Hyperparameters:   map[string]string{"batchSize": "64"},
+	})
+	assert.NoError(t, err)
+	updatedHyperparameters, err := srv.UpdateHyperparameters(ctx, &api.UpdateHyperparametersRequest{
+		ModelId:           "selfie",
+		HyperparametersId: "hp",
+		Labels:            map[string]string{"size": "large"},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{"size": "large"}, updatedHyperparameters.Labels)
+	hyperparameters, err := srv.ListHyperparameters(ctx, &api.ListHyperparametersRequest{
+		ModelId: "selfie",
+		Filter:  "hyperparameters.batchSize>=32 AND label.size=large",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"hp"}, hyperparameters.HyperparametersIds)
+
+	for checkpointID, accuracy := range map[string]string{"ckpt-1": "0.85", "ckpt-2": "0.93", "ckpt-3": "0.97"} {
+		_, err = srv.CreateCheckpoint(ctx, &api.CreateCheckpointRequest{
+			ModelId:           "selfie",
+			HyperparametersId: "hp",
+			CheckpointId:      checkpointID,
+			Link:              "gs://bucket/" + checkpointID,
+			Info:              map[string]string{"accuracy": accuracy},
+			Labels:            map[string]string{"team": "vision"},
+		})
+		assert.NoError(t, err)
+	}
+	checkpoints, err := srv.ListCheckpoints(ctx, &api.ListCheckpointsRequest{
+		ModelId:           "selfie",
+		HyperparametersId: "hp",
+		Filter:            "info.accuracy>0.9 AND label.team=vision",
+		View:              api.ListView_FULL,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"ckpt-2", "ckpt-3"}, checkpoints.CheckpointIds)
+	assert.Len(t, checkpoints.Checkpoints, 2)
+	assert.Equal(t, "0.97", checkpoints.Checkpoints[1].Info["accuracy"])
+	assert.Equal(t, map[string]string{"team": "vision"}, checkpoints.Checkpoints[1].Labels)
+}
+
 // Tests that model update behaviour is correct
 func TestUpdateModel(t *testing.T) {
 	srv := testingServer()
@@ -212,7 +489,91 @@ func TestUpdateModel(t *testing.T) {
 	if err != nil {
 		t.Error(err)
 	}
-	assert.Equal(t, updateModelRequest.Model, updateModelResponse.Model, "UpdateModel models in request and response do not agree")
+	expectedModel := *updateModelRequest.Model
+	expectedModel.Version = 2
+	assert.Equal(t, &expectedModel, updateModelResponse.Model, "UpdateModel models in request and response do not agree")
+}
+
+// racingStorage - lets another update through just before every model update, as if a second
+// client had updated the model between the server reading and writing it.
+type racingStorage struct {
+	storage.RepositoryStorage
+}
+
+func (store racingStorage) UpdateModel(ctx context.Context, model storage.Model) (storage.Model, error) {
+	_, err := store.RepositoryStorage.UpdateModel(ctx, storage.Model{ModelId: model.ModelId, Details: "racing update"})
+	if err != nil {
+		return storage.Model{}, err
+	}
+	return store.RepositoryStorage.UpdateModel(ctx, model)
+}
+
+func TestExpectedVersion(t *testing.T) {
+	store := memory.NewMemoryRepositoryStorage()
+	srv := server.NewServer(store, authentication.NewFakeAuthenticator(), 0, nil, false)
+	ctx := context.Background()
+
+	_, err := srv.CreateModel(ctx, &api.CreateModelRequest{Model: &api.Model{ModelId: "model", Details: "details"}})
+	assert.NoError(t, err)
+	_, err = srv.CreateHyperparameters(ctx, &api.CreateHyperparametersRequest{
+		ModelId:           "model",
+		HyperparametersId: "hp",
+		Hyperparameters:   map[string]string{"key": "value"},
+	})
+	assert.NoError(t, err)
+
+	model, err := srv.GetModel(ctx, &api.GetModelRequest{ModelId: "model"})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), model.Version)
+	updated, err := srv.UpdateModel(ctx, &api.UpdateModelRequest{
+		ModelId:         "model",
+		Model:           &api.Model{Details: "first"},
+		ExpectedVersion: model.Version,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), updated.Model.Version)
+	_, err = srv.UpdateModel(ctx, &api.UpdateModelRequest{
+		ModelId:         "model",
+		Model:           &api.Model{Details: "second"},
+		ExpectedVersion: model.Version,
+	})
+	assert.Equal(t, codes.Aborted, status.Code(err))
+	model, err = srv.GetModel(ctx, &api.GetModelRequest{ModelId: "model"})
+	assert.NoError(t, err)
+	assert.Equal(t, "first", model.Details)
+	assert.Equal(t, int64(2), model.Version)
+
+	hyperparameters, err := srv.GetHyperparameters(ctx, &api.GetHyperparametersRequest{ModelId: "model", HyperparametersId: "hp"})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), hyperparameters.Version)
+	updatedHyperparameters, err := srv.UpdateHyperparameters(ctx, &api.UpdateHyperparametersRequest{
+		ModelId:           "model",
+		HyperparametersId: "hp",
+		Labels:            map[string]string{"stage": "first"},
+		ExpectedVersion:   hyperparameters.Version,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), updatedHyperparameters.Version)
+	_, err = srv.UpdateHyperparameters(ctx, &api.UpdateHyperparametersRequest{
+		ModelId:           "model",
+		HyperparametersId: "hp",
+		Labels:            map[string]string{"stage": "second"},
+		ExpectedVersion:   hyperparameters.Version,
+	})
+	assert.Equal(t, codes.Aborted, status.Code(err))
+	hyperparameters, err = srv.GetHyperparameters(ctx, &api.GetHyperparametersRequest{ModelId: "model", HyperparametersId: "hp"})
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{"stage": "first"}, hyperparameters.Labels)
+
+	// Updates which merge into what the server read are not applied over a concurrent update, even
+	// if the client did not ask for a version.
+	srv = server.NewServer(racingStorage{store}, authentication.NewFakeAuthenticator(), 0, nil, false)
+	_, err = srv.UpdateModel(ctx, &api.UpdateModelRequest{ModelId: "model", Model: &api.Model{Labels: map[string]string{"owner": "ada"}}})
+	assert.Equal(t, codes.Aborted, status.Code(err))
+	model, err = srv.GetModel(ctx, &api.GetModelRequest{ModelId: "model"})
+	assert.NoError(t, err)
+	assert.Equal(t, "racing update", model.Details)
+	assert.Empty(t, model.Labels)
 }
 
 func TestModelIdMatchesEmbeddedModelId(t *testing.T) {
@@ -240,6 +601,7 @@ func TestModelIdMatchesEmbeddedModelId(t *testing.T) {
 		Model: &api.Model{
 			ModelId: "test-model",
 			Details: "desc1",
+			Version: 2,
 		},
 	}
 
@@ -561,6 +923,22 @@ func TestUpdateHyperparameters(t *testing.T) {
 		Hyperparameters:     newHyperparameters,
 		CanonicalCheckpoint: canonicalCheckpoint,
 	}
+
+	// The canonical checkpoint has to exist.
+	_, err = srv.UpdateHyperparameters(ctx, &hpUpdateRequest)
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	hpGetResponse, err := srv.GetHyperparameters(ctx, &api.GetHyperparametersRequest{ModelId: modelID, HyperparametersId: hyperparametersID})
+	assert.NoError(t, err)
+	assert.Equal(t, oldHyperparameters, hpGetResponse.Hyperparameters, "Rejected update should not change hyperparameters")
+
+	_, err = srv.CreateCheckpoint(ctx, &api.CreateCheckpointRequest{
+		ModelId:           modelID,
+		HyperparametersId: hyperparametersID,
+		CheckpointId:      canonicalCheckpoint,
+		Link:              "http://example.com/checkpoints-for-test/ckpt.zip",
+	})
+	assert.NoError(t, err)
+
 	hpUpdateResponse, err := srv.UpdateHyperparameters(ctx, &hpUpdateRequest)
 	if err != nil {
 		t.Error(err)
@@ -928,119 +1306,1861 @@ func TestGetCheckpoint(t *testing.T) {
 	assert.Equal(t, checkpointID, getCheckpointResponse.CheckpointId, "Incorrect CheckpointId in GetCheckpointResponse")
 }
 
-// Send 0 for status to avoid status check.
-func sendGetRequest(t *testing.T, url string, status int) string {
-	resp, err := http.Get(url)
+// Tests that archived checkpoints are hidden from ListCheckpoints unless they are asked for.
+// mismatchedStorage - rejects every checkpoint the way verifying backends reject mismatched ones.
+type mismatchedStorage struct {
+	storage.RepositoryStorage
+}
+
+func (store mismatchedStorage) AddCheckpoint(ctx context.Context, checkpoint storage.Checkpoint) error {
+	return storage.CheckpointContentError{Field: "sizeBytes", Reason: "object is 5 bytes"}
+}
+
+func TestCheckpointContent(t *testing.T) {
+	uploadDir, err := ioutil.TempDir("", "tensorio-models-")
 	if err != nil {
-		t.Error(err)
+		t.Fatal(err)
 	}
-	defer resp.Body.Close()
+	defer os.RemoveAll(uploadDir)
+	store := memory.NewMemoryRepositoryStorageWithUploadDir(uploadDir)
+	srv := server.NewServer(store, authentication.NewFakeAuthenticator(), 0, nil, false)
+	ctx := context.Background()
 
-	if status != 0 && resp.StatusCode != status {
-		t.Errorf("Expected: %d Got: %d for URL: %s", status, resp.StatusCode, url)
-	}
+	_, err = srv.CreateModel(ctx, &api.CreateModelRequest{Model: &api.Model{ModelId: "model", Details: "details"}})
+	assert.NoError(t, err)
+	_, err = srv.CreateHyperparameters(ctx, &api.CreateHyperparametersRequest{ModelId: "model", HyperparametersId: "hp"})
+	assert.NoError(t, err)
 
-	bodyBytes, err := ioutil.ReadAll(resp.Body)
+	invalid := []*api.CreateCheckpointRequest{
+		{Sha256: "not hex"},
+		{Sha256: "9f86d081"},
+		{SizeBytes: -1},
+		{ContentType: "application/"},
+		{RequestUploadUrl: true, ContentType: "application/octet-stream"},
+	}
+	for _, req := range invalid {
+		req.ModelId = "model"
+		req.HyperparametersId = "hp"
+		req.CheckpointId = "ckpt"
+		if !req.RequestUploadUrl {
+			req.Link = "gs://bucket/ckpt.zip"
+		}
+		_, err = srv.CreateCheckpoint(ctx, req)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err), "%v", req)
+	}
+
+	_, err = srv.CreateCheckpoint(ctx, &api.CreateCheckpointRequest{
+		ModelId:           "model",
+		HyperparametersId: "hp",
+		CheckpointId:      "ckpt",
+		Link:              "gs://bucket/ckpt.zip",
+		Sha256:            "9F86D081884C7D659A2FEAA0C55AD015A3BF4F1B2B0B822CD15D6C15B0F00A08",
+		SizeBytes:         4,
+		ContentType:       "application/zip",
+	})
+	assert.NoError(t, err)
+	checkpoint, err := srv.GetCheckpoint(ctx, &api.GetCheckpointRequest{ModelId: "model", HyperparametersId: "hp", CheckpointId: "ckpt"})
+	assert.NoError(t, err)
+	assert.Equal(t, "9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08", checkpoint.Sha256)
+	assert.Equal(t, int64(4), checkpoint.SizeBytes)
+	assert.Equal(t, "application/zip", checkpoint.ContentType)
+
+	// Uploaded bundles are always zips
+	_, err = srv.CreateCheckpoint(ctx, &api.CreateCheckpointRequest{
+		ModelId:           "model",
+		HyperparametersId: "hp",
+		CheckpointId:      "uploaded",
+		RequestUploadUrl:  true,
+	})
+	assert.NoError(t, err)
+	checkpoint, err = srv.GetCheckpoint(ctx, &api.GetCheckpointRequest{ModelId: "model", HyperparametersId: "hp", CheckpointId: "uploaded"})
+	assert.NoError(t, err)
+	assert.Equal(t, storage.BundleContentType, checkpoint.ContentType)
+
+	srv = server.NewServer(mismatchedStorage{store}, authentication.NewFakeAuthenticator(), 0, nil, false)
+	_, err = srv.CreateCheckpoint(ctx, &api.CreateCheckpointRequest{
+		ModelId:           "model",
+		HyperparametersId: "hp",
+		CheckpointId:      "mismatched",
+		Link:              "gs://bucket/ckpt.zip",
+		SizeBytes:         4,
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	assert.Contains(t, status.Convert(err).Message(), "sizeBytes")
+}
+
+func TestGetCheckpointManifest(t *testing.T) {
+	store := memory.NewMemoryRepositoryStorage()
+	ctx := context.Background()
+
+	srv := server.NewServer(store, authentication.NewFakeAuthenticator(), 15*time.Minute, nil, false)
+	_, err := srv.GetCheckpointManifest(ctx, &api.GetCheckpointManifestRequest{ModelId: "model", HyperparametersId: "hp", CheckpointId: "ckpt"})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	_, privateKey, err := ed25519.GenerateKey(nil)
+	assert.NoError(t, err)
+	signer, err := manifest.NewSigner("2019-10", privateKey)
+	assert.NoError(t, err)
+	srv = server.NewServer(signingStorage{store}, authentication.NewFakeAuthenticator(), 15*time.Minute, signer, false)
+
+	_, err = srv.GetCheckpointManifest(ctx, &api.GetCheckpointManifestRequest{ModelId: "model", HyperparametersId: "hp", CheckpointId: "ckpt"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	_, err = srv.CreateModel(ctx, &api.CreateModelRequest{Model: &api.Model{ModelId: "model", Details: "details"}})
+	assert.NoError(t, err)
+	_, err = srv.CreateHyperparameters(ctx, &api.CreateHyperparametersRequest{
+		ModelId:           "model",
+		HyperparametersId: "hp",
+		Hyperparameters:   map[string]string{"learning_rate": "0.01"},
+	})
+	assert.NoError(t, err)
+	_, err = srv.CreateCheckpoint(ctx, &api.CreateCheckpointRequest{
+		ModelId:           "model",
+		HyperparametersId: "hp",
+		CheckpointId:      "ckpt",
+		Link:              "gs://bucket/ckpt.zip?a=1&b=2",
+		Sha256:            "9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08",
+		SizeBytes:         4,
+	})
+	assert.NoError(t, err)
+
+	resp, err := srv.GetCheckpointManifest(ctx, &api.GetCheckpointManifestRequest{ModelId: "model", HyperparametersId: "hp", CheckpointId: "ckpt"})
+	assert.NoError(t, err)
+	assert.Equal(t, "2019-10", resp.KeyId)
+	assert.Equal(t, manifest.Algorithm, resp.Algorithm)
+
+	keyring := verify.Keyring{"2019-10": signer.PublicKey()}
+	verified, err := keyring.Verify([]byte(resp.Manifest), resp.KeyId, resp.Signature)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{"learning_rate": "0.01"}, verified.Hyperparameters)
+	// The manifest vouches for the stored link, not for a signed download URL
+	assert.Equal(t, "gs://bucket/ckpt.zip?a=1&b=2", verified.Link)
+	assert.NoError(t, verify.VerifyBundle(verified, strings.NewReader("test")))
+
+	_, err = srv.CreateCheckpoint(ctx, &api.CreateCheckpointRequest{
+		ModelId:           "model",
+		HyperparametersId: "hp",
+		CheckpointId:      "pending",
+		RequestUploadUrl:  true,
+	})
+	assert.NoError(t, err)
+	_, err = srv.GetCheckpointManifest(ctx, &api.GetCheckpointManifestRequest{ModelId: "model", HyperparametersId: "hp", CheckpointId: "pending"})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+}
+
+func TestCheckpointUpload(t *testing.T) {
+	uploadDir, err := ioutil.TempDir("", "tensorio-models-")
 	if err != nil {
-		t.Error(err)
+		t.Fatal(err)
 	}
-	return string(bodyBytes)
+	defer os.RemoveAll(uploadDir)
+	srv := server.NewServer(memory.NewMemoryRepositoryStorageWithUploadDir(uploadDir), authentication.NewFakeAuthenticator(), 0, nil, false)
+	ctx := context.Background()
+
+	_, err = srv.CreateModel(ctx, &api.CreateModelRequest{Model: &api.Model{ModelId: "model", Details: "details"}})
+	assert.NoError(t, err)
+	_, err = srv.CreateHyperparameters(ctx, &api.CreateHyperparametersRequest{ModelId: "model", HyperparametersId: "hp"})
+	assert.NoError(t, err)
+
+	_, err = srv.CreateCheckpoint(ctx, &api.CreateCheckpointRequest{
+		ModelId:           "model",
+		HyperparametersId: "hp",
+		CheckpointId:      "ckpt",
+		Link:              "gs://elsewhere/ckpt.zip",
+		RequestUploadUrl:  true,
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	bundle := zippedBundle(t, validModelJSON)
+	digest := sha256.Sum256(bundle)
+	before := time.Now()
+	created, err := srv.CreateCheckpoint(ctx, &api.CreateCheckpointRequest{
+		ModelId:           "model",
+		HyperparametersId: "hp",
+		CheckpointId:      "ckpt",
+		RequestUploadUrl:  true,
+		Sha256:            hex.EncodeToString(digest[:]),
+		SizeBytes:         int64(len(bundle)),
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "/models/model/hyperparameters/hp/checkpoints/ckpt", created.ResourcePath)
+	assert.Contains(t, created.UploadUrl, "file://")
+	expiresAt, err := ptypes.Timestamp(created.UploadUrlExpiresAt)
+	assert.NoError(t, err)
+	assert.True(t, expiresAt.After(before))
+
+	checkpoint, err := srv.GetCheckpoint(ctx, &api.GetCheckpointRequest{ModelId: "model", HyperparametersId: "hp", CheckpointId: "ckpt"})
+	assert.NoError(t, err)
+	assert.Equal(t, api.CheckpointState_PENDING, checkpoint.State)
+	checkpoints, err := srv.ListCheckpoints(ctx, &api.ListCheckpointsRequest{ModelId: "model", HyperparametersId: "hp", IncludeArchived: true})
+	assert.NoError(t, err)
+	assert.Empty(t, checkpoints.CheckpointIds)
+
+	// Pending checkpoints can only be activated by finalizing them.
+	_, err = srv.UpdateCheckpointState(ctx, &api.UpdateCheckpointStateRequest{
+		ModelId: "model", HyperparametersId: "hp", CheckpointId: "ckpt", State: api.CheckpointState_ACTIVE,
+	})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	finalizeRequest := &api.FinalizeCheckpointRequest{ModelId: "model", HyperparametersId: "hp", CheckpointId: "ckpt"}
+	_, err = srv.FinalizeCheckpoint(ctx, finalizeRequest)
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	// Bundles which do not match the declared content leave the checkpoint pending
+	mismatches := map[string][]byte{
+		"contentType": append([]byte("bundle"), bytes.Repeat([]byte(" "), len(bundle)-len("bundle"))...),
+		"sizeBytes":   zippedBundle(t, `{}`),
+		"sha256":      zippedBundle(t, strings.Replace(validModelJSON, "RGB", "BGR", 1)),
+	}
+	for field, contents := range mismatches {
+		err = ioutil.WriteFile(strings.TrimPrefix(created.UploadUrl, "file://"), contents, 0644)
+		assert.NoError(t, err)
+		_, err = srv.FinalizeCheckpoint(ctx, finalizeRequest)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err), field)
+		assert.Contains(t, fmt.Sprint(err), "Checkpoint "+field, field)
+		checkpoint, err = srv.GetCheckpoint(ctx, &api.GetCheckpointRequest{ModelId: "model", HyperparametersId: "hp", CheckpointId: "ckpt"})
+		assert.NoError(t, err)
+		assert.Equal(t, api.CheckpointState_PENDING, checkpoint.State, field)
+	}
+
+	err = ioutil.WriteFile(strings.TrimPrefix(created.UploadUrl, "file://"), bundle, 0644)
+	assert.NoError(t, err)
+	finalized, err := srv.FinalizeCheckpoint(ctx, finalizeRequest)
+	assert.NoError(t, err)
+	assert.Equal(t, api.CheckpointState_ACTIVE, finalized.Checkpoint.State)
+	assert.Equal(t, created.UploadUrl, finalized.Checkpoint.Link)
+
+	checkpoints, err = srv.ListCheckpoints(ctx, &api.ListCheckpointsRequest{ModelId: "model", HyperparametersId: "hp"})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"ckpt"}, checkpoints.CheckpointIds)
+
+	_, err = srv.FinalizeCheckpoint(ctx, finalizeRequest)
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	_, err = srv.FinalizeCheckpoint(ctx, &api.FinalizeCheckpointRequest{ModelId: "model", HyperparametersId: "hp", CheckpointId: "missing"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	_, err = srv.UpdateCheckpointState(ctx, &api.UpdateCheckpointStateRequest{
+		ModelId: "model", HyperparametersId: "hp", CheckpointId: "ckpt", State: api.CheckpointState_PENDING,
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
 }
 
-func postRequest(t *testing.T, url string, jsonStruct map[string]interface{}, status int) string {
-	bytesRepresentation, err := json.Marshal(jsonStruct)
+const validModelJSON = `{
+	"model": {"file": "model.tflite", "quantized": false},
+	"inputs": [{"name": "image", "type": "image", "shape": [224, 224, 3], "format": "RGB"}],
+	"outputs": [{"name": "classification", "type": "array", "shape": [1, 1000]}]
+}`
+
+func zippedBundle(t *testing.T, modelJSON string) []byte {
+	var buf bytes.Buffer
+	writer := zip.NewWriter(&buf)
+	file, err := writer.Create("model.tiobundle/model.json")
 	if err != nil {
-		t.Error(err)
+		t.Fatal(err)
 	}
-
-	resp, err := http.Post(url, "application/json", bytes.NewBuffer(bytesRepresentation))
+	_, err = file.Write([]byte(modelJSON))
 	if err != nil {
-		t.Error(err)
+		t.Fatal(err)
 	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode != status {
-		t.Errorf("Expected: %d Got: %d for POST URL: %s", status, resp.StatusCode, url)
+	err = writer.Close()
+	if err != nil {
+		t.Fatal(err)
 	}
+	return buf.Bytes()
+}
 
-	bodyBytes, err := ioutil.ReadAll(resp.Body)
+func TestBundleValidation(t *testing.T) {
+	uploadDir, err := ioutil.TempDir("", "tensorio-models-")
 	if err != nil {
-		t.Error(err)
+		t.Fatal(err)
 	}
-	return string(bodyBytes)
+	defer os.RemoveAll(uploadDir)
+	srv := server.NewServer(memory.NewMemoryRepositoryStorageWithUploadDir(uploadDir), authentication.NewFakeAuthenticator(), 0, nil, true)
+	ctx := context.Background()
+
+	_, err = srv.CreateModel(ctx, &api.CreateModelRequest{Model: &api.Model{ModelId: "model", Details: "details"}})
+	assert.NoError(t, err)
+	_, err = srv.CreateHyperparameters(ctx, &api.CreateHyperparametersRequest{ModelId: "model", HyperparametersId: "hp"})
+	assert.NoError(t, err)
+
+	expectedInfo := map[string]string{
+		"owner":               "tests",
+		"tiobundle.backend":   "tflite",
+		"tiobundle.quantized": "false",
+		"tiobundle.inputs":    `[{"name":"image","type":"image","shape":[224,224,3],"format":"RGB"}]`,
+		"tiobundle.outputs":   `[{"name":"classification","type":"array","shape":[1,1000]}]`,
+	}
+
+	// Uploaded bundles are validated when they are finalized
+	created, err := srv.CreateCheckpoint(ctx, &api.CreateCheckpointRequest{
+		ModelId:           "model",
+		HyperparametersId: "hp",
+		CheckpointId:      "uploaded",
+		Info:              map[string]string{"owner": "tests"},
+		RequestUploadUrl:  true,
+	})
+	assert.NoError(t, err)
+	finalizeRequest := &api.FinalizeCheckpointRequest{ModelId: "model", HyperparametersId: "hp", CheckpointId: "uploaded"}
+
+	err = tests.UploadToFileURL(created.UploadUrl, zippedBundle(t, `{"model": {"file": "model.tflite"}, "inputs": []}`))
+	assert.NoError(t, err)
+	_, err = srv.FinalizeCheckpoint(ctx, finalizeRequest)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	assert.Contains(t, err.Error(), "model.quantized")
+	checkpoint, err := srv.GetCheckpoint(ctx, &api.GetCheckpointRequest{ModelId: "model", HyperparametersId: "hp", CheckpointId: "uploaded"})
+	assert.NoError(t, err)
+	assert.Equal(t, api.CheckpointState_PENDING, checkpoint.State)
+
+	err = tests.UploadToFileURL(created.UploadUrl, []byte("not a zip archive"))
+	assert.NoError(t, err)
+	_, err = srv.FinalizeCheckpoint(ctx, finalizeRequest)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	err = tests.UploadToFileURL(created.UploadUrl, zippedBundle(t, validModelJSON))
+	assert.NoError(t, err)
+	finalized, err := srv.FinalizeCheckpoint(ctx, finalizeRequest)
+	assert.NoError(t, err)
+	assert.Equal(t, api.CheckpointState_ACTIVE, finalized.Checkpoint.State)
+	assert.Equal(t, expectedInfo, finalized.Checkpoint.Info)
+
+	// Links to bundle directories are validated when the checkpoint is created
+	bundleDir := filepath.Join(uploadDir, "mobilenet.tiobundle")
+	err = os.Mkdir(bundleDir, 0755)
+	assert.NoError(t, err)
+	err = ioutil.WriteFile(filepath.Join(bundleDir, "model.json"), []byte(validModelJSON), 0644)
+	assert.NoError(t, err)
+	_, err = srv.CreateCheckpoint(ctx, &api.CreateCheckpointRequest{
+		ModelId:           "model",
+		HyperparametersId: "hp",
+		CheckpointId:      "directory",
+		Link:              "file://" + filepath.ToSlash(bundleDir),
+		Info:              map[string]string{"owner": "tests"},
+	})
+	assert.NoError(t, err)
+	checkpoint, err = srv.GetCheckpoint(ctx, &api.GetCheckpointRequest{ModelId: "model", HyperparametersId: "hp", CheckpointId: "directory"})
+	assert.NoError(t, err)
+	assert.Equal(t, expectedInfo, checkpoint.Info)
+
+	_, err = srv.CreateCheckpoint(ctx, &api.CreateCheckpointRequest{
+		ModelId:           "model",
+		HyperparametersId: "hp",
+		CheckpointId:      "missing",
+		Link:              "file://" + filepath.ToSlash(filepath.Join(uploadDir, "missing.tiobundle.zip")),
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// Links which the storage backend cannot read are accepted as they are
+	_, err = srv.CreateCheckpoint(ctx, &api.CreateCheckpointRequest{
+		ModelId:           "model",
+		HyperparametersId: "hp",
+		CheckpointId:      "elsewhere",
+		Link:              "https://example.com/bundle.zip",
+	})
+	assert.NoError(t, err)
+	checkpoint, err = srv.GetCheckpoint(ctx, &api.GetCheckpointRequest{ModelId: "model", HyperparametersId: "hp", CheckpointId: "elsewhere"})
+	assert.NoError(t, err)
+	assert.Empty(t, checkpoint.Info)
 }
 
-func TestIsValidID(t *testing.T) {
-	assert.True(t, common.IsValidID("dii-ZZ12_"))
-	assert.False(t, common.IsValidID(""))
-	assert.False(t, common.IsValidID(" X"))
-	assert.True(t, common.IsValidID("---"))
-	assert.False(t, common.IsValidID("1&2"))
-	assert.True(t, common.IsValidID("123"))
+// signingStorage - signs links by appending their expiry, so that tests can tell signed links apart.
+type signingStorage struct {
+	storage.RepositoryStorage
 }
 
-func TestURLEndpoints(t *testing.T) {
-	storage := memory.NewMemoryRepositoryStorage()
-	const grpcAddress = ":9300" // Use diff ports.
-	const jsonAddress = ":9301"
-	stopRequestChannel := make(chan string)
-	go server.StartGrpcAndProxyServer(storage, grpcAddress, jsonAddress, authentication.NewFakeAuthenticator(),
-		stopRequestChannel)
-	baseUrl := fmt.Sprintf("http://localhost%s/v1/repository/", jsonAddress)
-	healthzUrl := baseUrl + "healthz"
-	response := ""
-	for ; response != "{\"status\":\"SERVING\"}"; response = sendGetRequest(t, healthzUrl, 0) {
-		fmt.Println("Waiting for server to become healthy")
-		time.Sleep(100 * time.Millisecond)
-	}
-	assert.Equal(t, "{\"backendType\":\"MEMORY\"}", sendGetRequest(t, baseUrl+"config", http.StatusOK))
-	assert.Equal(t, "{\"modelIds\":[]}", sendGetRequest(t, baseUrl+"models", http.StatusOK))
-	const invModelErr = "\"Could not retrieve model (InvalidModelName) from storage\""
-	assert.Equal(t, "{\"error\":"+invModelErr+",\"message\":"+invModelErr+",\"code\":14,\"details\":[]}",
-		sendGetRequest(t, baseUrl+"models/InvalidModelName", http.StatusServiceUnavailable))
-	// Seems that these requests ignore canonicalHyperparameters or any other extra tags.
-	assert.Equal(t, "{\"resourcePath\":\"/models/MyModel\"}",
-		postRequest(t, baseUrl+"models",
-			map[string]interface{}{"model": map[string]string{
-				"modelId":                  "MyModel",
-				"details":                  "Selfie model",
-				"canonicalHyperparameters": "batch-666",
-				"randomTag":                "RandomValue",
-			}}, http.StatusOK))
-	assert.Equal(t, "{\"modelIds\":[\"MyModel\"]}", sendGetRequest(t, baseUrl+"models", http.StatusOK))
-	assert.Equal(t, "{\"resourcePath\":\"/models/BasicModel\"}",
-		postRequest(t, baseUrl+"models",
-			map[string]interface{}{"model": map[string]string{
-				"modelId":                  "BasicModel",
-				"details":                  "Basic model",
-				"canonicalHyperparameters": "batch-123",
-			}}, http.StatusOK))
+func (store signingStorage) SignCheckpointLink(ctx context.Context, link string, expires time.Time) (string, error) {
+	return fmt.Sprintf("%s?expires=%d", link, expires.Unix()), nil
+}
 
-	// Models are sorted lexicographically, not in order of recency.
-	assert.Equal(t, "{\"modelIds\":[\"BasicModel\",\"MyModel\"]}", sendGetRequest(t, baseUrl+"models", http.StatusOK))
+func TestGetCheckpointSignedLink(t *testing.T) {
+	store := signingStorage{memory.NewMemoryRepositoryStorage()}
+	ctx := context.Background()
 
-	// This is expected to fail. One needs to create model, hyperparameters and checkpoints in sequence.
-	assert.Equal(t, "Not Found\n",
-		postRequest(t, baseUrl+"models/GoodModel/hyperparameterId/batch-443",
-			map[string]interface{}{"model": map[string]string{
-				"modelId":                  "GoodModel",
-				"description":              "The best model",
-				"canonicalHyperparameters": "batch-443",
-			}}, http.StatusNotFound))
-	assert.Equal(t, "{\"modelIds\":[\"BasicModel\",\"MyModel\"]}", sendGetRequest(t, baseUrl+"models", http.StatusOK))
+	srv := server.NewServer(store, authentication.NewFakeAuthenticator(), 15*time.Minute, nil, false)
+	_, err := srv.CreateModel(ctx, &api.CreateModelRequest{Model: &api.Model{ModelId: "model", Details: "details"}})
+	assert.NoError(t, err)
+	_, err = srv.CreateHyperparameters(ctx, &api.CreateHyperparametersRequest{ModelId: "model", HyperparametersId: "hp"})
+	assert.NoError(t, err)
+	_, err = srv.CreateCheckpoint(ctx, &api.CreateCheckpointRequest{
+		ModelId:           "model",
+		HyperparametersId: "hp",
+		CheckpointId:      "ckpt",
+		Link:              "gs://bucket/ckpt.zip",
+	})
+	assert.NoError(t, err)
 
-	// Let's try emulating a real flow
-	assert.Equal(t, "{\"resourcePath\":\"/models/MyModel/hyperparameters/HPSet1\"}",
-		postRequest(t, baseUrl+"models/MyModel/hyperparameters",
-			map[string]interface{}{
-				"hyperparametersId": "HPSet1",
-				"hyperparameters": map[string]string{
-					"param1": "value1",
+	req := &api.GetCheckpointRequest{ModelId: "model", HyperparametersId: "hp", CheckpointId: "ckpt"}
+	before := time.Now()
+	checkpoint, err := srv.GetCheckpoint(ctx, req)
+	assert.NoError(t, err)
+	var expires int64
+	_, err = fmt.Sscanf(checkpoint.Link, "gs://bucket/ckpt.zip?expires=%d", &expires)
+	assert.NoError(t, err)
+	assert.True(t, expires >= before.Add(15*time.Minute).Unix())
+	assert.True(t, expires <= time.Now().Add(15*time.Minute).Unix())
+
+	// Internal callers can still ask for the stored link
+	req.RawLink = true
+	checkpoint, err = srv.GetCheckpoint(ctx, req)
+	assert.NoError(t, err)
+	assert.Equal(t, "gs://bucket/ckpt.zip", checkpoint.Link)
+
+	// and links are not signed at all unless an expiry is configured
+	srv = server.NewServer(store, authentication.NewFakeAuthenticator(), 0, nil, false)
+	req.RawLink = false
+	checkpoint, err = srv.GetCheckpoint(ctx, req)
+	assert.NoError(t, err)
+	assert.Equal(t, "gs://bucket/ckpt.zip", checkpoint.Link)
+}
+
+func TestUpdateCheckpointState(t *testing.T) {
+	srv := testingServer()
+	ctx := context.Background()
+
+	_, err := srv.CreateModel(ctx, &api.CreateModelRequest{
+		Model: &api.Model{
+			ModelId: "test-model",
+			Details: "This is a test",
+		},
+	})
+	assert.NoError(t, err)
+	_, err = srv.CreateHyperparameters(ctx, &api.CreateHyperparametersRequest{
+		ModelId:           "test-model",
+		HyperparametersId: "test-hyperparameters",
+		Hyperparameters:   map[string]string{"parameter": "parameter-value"},
+	})
+	assert.NoError(t, err)
+	for _, checkpointID := range []string{"ckpt-1", "ckpt-2"} {
+		_, err = srv.CreateCheckpoint(ctx, &api.CreateCheckpointRequest{
+			ModelId:           "test-model",
+			HyperparametersId: "test-hyperparameters",
+			CheckpointId:      checkpointID,
+			Link:              "http://example.com/checkpoints-for-test/ckpt.zip",
+		})
+		assert.NoError(t, err)
+	}
+
+	getCheckpointRequest := api.GetCheckpointRequest{
+		ModelId:           "test-model",
+		HyperparametersId: "test-hyperparameters",
+		CheckpointId:      "ckpt-1",
+	}
+	getCheckpointResponse, err := srv.GetCheckpoint(ctx, &getCheckpointRequest)
+	assert.NoError(t, err)
+	assert.Equal(t, api.CheckpointState_ACTIVE, getCheckpointResponse.State)
+
+	updateResponse, err := srv.UpdateCheckpointState(ctx, &api.UpdateCheckpointStateRequest{
+		ModelId:           "test-model",
+		HyperparametersId: "test-hyperparameters",
+		CheckpointId:      "ckpt-1",
+		State:             api.CheckpointState_ARCHIVED,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, api.CheckpointState_ARCHIVED, updateResponse.State)
+
+	getCheckpointResponse, err = srv.GetCheckpoint(ctx, &getCheckpointRequest)
+	assert.NoError(t, err)
+	assert.Equal(t, api.CheckpointState_ARCHIVED, getCheckpointResponse.State)
+
+	listCheckpointsRequest := api.ListCheckpointsRequest{
+		ModelId:           "test-model",
+		HyperparametersId: "test-hyperparameters",
+	}
+	listCheckpointsResponse, err := srv.ListCheckpoints(ctx, &listCheckpointsRequest)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"ckpt-2"}, listCheckpointsResponse.CheckpointIds)
+
+	listCheckpointsRequest.IncludeArchived = true
+	listCheckpointsResponse, err = srv.ListCheckpoints(ctx, &listCheckpointsRequest)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"ckpt-1", "ckpt-2"}, listCheckpointsResponse.CheckpointIds)
+
+	_, err = srv.UpdateCheckpointState(ctx, &api.UpdateCheckpointStateRequest{
+		ModelId:           "test-model",
+		HyperparametersId: "test-hyperparameters",
+		CheckpointId:      "ckpt-3",
+		State:             api.CheckpointState_DEPRECATED,
+	})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	_, err = srv.UpdateCheckpointState(ctx, &api.UpdateCheckpointStateRequest{
+		ModelId:           "test-model",
+		HyperparametersId: "test-hyperparameters",
+		CheckpointId:      "ckpt-2",
+		State:             api.CheckpointState(42),
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+// Tests that deletes refuse to remove resources with children or references unless asked to, and
+// report missing resources as NotFound.
+func TestDelete(t *testing.T) {
+	srv := testingServer()
+	ctx := context.Background()
+
+	_, err := srv.CreateModel(ctx, &api.CreateModelRequest{
+		Model: &api.Model{
+			ModelId:                  "test-model",
+			Details:                  "This is a test",
+			CanonicalHyperparameters: "test-hyperparameters",
+		},
+	})
+	assert.NoError(t, err)
+	_, err = srv.CreateHyperparameters(ctx, &api.CreateHyperparametersRequest{
+		ModelId:             "test-model",
+		HyperparametersId:   "test-hyperparameters",
+		CanonicalCheckpoint: "test-checkpoint",
+		Hyperparameters:     map[string]string{"parameter": "parameter-value"},
+	})
+	assert.NoError(t, err)
+	_, err = srv.CreateCheckpoint(ctx, &api.CreateCheckpointRequest{
+		ModelId:           "test-model",
+		HyperparametersId: "test-hyperparameters",
+		CheckpointId:      "test-checkpoint",
+		Link:              "http://example.com/checkpoints-for-test/ckpt.zip",
+	})
+	assert.NoError(t, err)
+
+	_, err = srv.DeleteCheckpoint(ctx, &api.DeleteCheckpointRequest{
+		ModelId:           "test-model",
+		HyperparametersId: "test-hyperparameters",
+		CheckpointId:      "test-checkpoint",
+	})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	_, err = srv.DeleteHyperparameters(ctx, &api.DeleteHyperparametersRequest{
+		ModelId:           "test-model",
+		HyperparametersId: "test-hyperparameters",
+		Force:             true,
+	})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	_, err = srv.DeleteModel(ctx, &api.DeleteModelRequest{ModelId: "test-model"})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	deleteCheckpointResponse, err := srv.DeleteCheckpoint(ctx, &api.DeleteCheckpointRequest{
+		ModelId:           "test-model",
+		HyperparametersId: "test-hyperparameters",
+		CheckpointId:      "test-checkpoint",
+		Force:             true,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "/models/test-model/hyperparameters/test-hyperparameters/checkpoints/test-checkpoint", deleteCheckpointResponse.ResourcePath)
+
+	_, err = srv.GetCheckpoint(ctx, &api.GetCheckpointRequest{
+		ModelId:           "test-model",
+		HyperparametersId: "test-hyperparameters",
+		CheckpointId:      "test-checkpoint",
+	})
+	assert.Error(t, err)
+
+	deleteModelResponse, err := srv.DeleteModel(ctx, &api.DeleteModelRequest{ModelId: "test-model", Cascade: true})
+	assert.NoError(t, err)
+	assert.Equal(t, "/models/test-model", deleteModelResponse.ResourcePath)
+
+	_, err = srv.DeleteModel(ctx, &api.DeleteModelRequest{ModelId: "test-model"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	_, err = srv.DeleteModel(ctx, &api.DeleteModelRequest{})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+// Tests that ResolveModel follows canonical pointers and UpgradeTo links, and refuses broken or
+// cyclic chains.
+func TestResolveModel(t *testing.T) {
+	srv := testingServer()
+	ctx := context.Background()
+
+	_, err := srv.ResolveModel(ctx, &api.ResolveModelRequest{ModelId: "test-model"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	_, err = srv.CreateModel(ctx, &api.CreateModelRequest{
+		Model: &api.Model{
+			ModelId: "test-model",
+			Details: "This is a test",
+		},
+	})
+	assert.NoError(t, err)
+
+	_, err = srv.ResolveModel(ctx, &api.ResolveModelRequest{ModelId: "test-model"})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	for _, hyperparametersID := range []string{"hp-1", "hp-2", "hp-3"} {
+		_, err = srv.CreateHyperparameters(ctx, &api.CreateHyperparametersRequest{
+			ModelId:           "test-model",
+			HyperparametersId: hyperparametersID,
+			Hyperparameters:   map[string]string{"parameter": hyperparametersID},
+		})
+		assert.NoError(t, err)
+		_, err = srv.CreateCheckpoint(ctx, &api.CreateCheckpointRequest{
+			ModelId:           "test-model",
+			HyperparametersId: hyperparametersID,
+			CheckpointId:      "ckpt",
+			Link:              "http://example.com/" + hyperparametersID + ".zip",
+			Info:              map[string]string{"accuracy": "0.9"},
+		})
+		assert.NoError(t, err)
+	}
+	_, err = srv.UpdateModel(ctx, &api.UpdateModelRequest{
+		ModelId: "test-model",
+		Model:   &api.Model{CanonicalHyperparameters: "hp-1"},
+	})
+	assert.NoError(t, err)
+
+	// hp-1 has no canonical checkpoint yet.
+	_, err = srv.ResolveModel(ctx, &api.ResolveModelRequest{ModelId: "test-model"})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	for _, hyperparametersID := range []string{"hp-1", "hp-2", "hp-3"} {
+		_, err = srv.UpdateHyperparameters(ctx, &api.UpdateHyperparametersRequest{
+			ModelId:             "test-model",
+			HyperparametersId:   hyperparametersID,
+			CanonicalCheckpoint: "ckpt",
+		})
+		assert.NoError(t, err)
+	}
+
+	resp, err := srv.ResolveModel(ctx, &api.ResolveModelRequest{ModelId: "test-model"})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"hp-1"}, resp.UpgradePath)
+	assert.Equal(t, "http://example.com/hp-1.zip", resp.Checkpoint.Link)
+
+	_, err = srv.UpdateHyperparameters(ctx, &api.UpdateHyperparametersRequest{
+		ModelId:           "test-model",
+		HyperparametersId: "hp-1",
+		UpgradeTo:         "hp-2",
+	})
+	assert.NoError(t, err)
+	_, err = srv.UpdateHyperparameters(ctx, &api.UpdateHyperparametersRequest{
+		ModelId:           "test-model",
+		HyperparametersId: "hp-2",
+		UpgradeTo:         "hp-3",
+	})
+	assert.NoError(t, err)
+
+	resp, err = srv.ResolveModel(ctx, &api.ResolveModelRequest{ModelId: "test-model"})
+	assert.NoError(t, err)
+	assert.Equal(t, "test-model", resp.Model.ModelId)
+	assert.Equal(t, "hp-1", resp.Model.CanonicalHyperparameters)
+	assert.Equal(t, []string{"hp-1", "hp-2", "hp-3"}, resp.UpgradePath)
+	assert.Equal(t, "hp-3", resp.Hyperparameters.HyperparametersId)
+	assert.Equal(t, map[string]string{"parameter": "hp-3"}, resp.Hyperparameters.Hyperparameters)
+	assert.Equal(t, "hp-3", resp.Checkpoint.HyperparametersId)
+	assert.Equal(t, "ckpt", resp.Checkpoint.CheckpointId)
+	assert.Equal(t, "http://example.com/hp-3.zip", resp.Checkpoint.Link)
+	assert.Equal(t, map[string]string{"accuracy": "0.9"}, resp.Checkpoint.Info)
+
+	_, err = srv.UpdateHyperparameters(ctx, &api.UpdateHyperparametersRequest{
+		ModelId:           "test-model",
+		HyperparametersId: "hp-3",
+		UpgradeTo:         "hp-2",
+	})
+	assert.NoError(t, err)
+
+	_, err = srv.ResolveModel(ctx, &api.ResolveModelRequest{ModelId: "test-model"})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	assert.Contains(t, status.Convert(err).Message(), storage.ErrUpgradeCycle.Error())
+}
+
+// Tests that UpgradeCheck recommends the canonical checkpoint at the end of the UpgradeTo chain of a
+// pinned checkpoint, and refuses cyclic chains.
+func TestUpgradeCheck(t *testing.T) {
+	srv := testingServer()
+	ctx := context.Background()
+
+	_, err := srv.CreateModel(ctx, &api.CreateModelRequest{
+		Model: &api.Model{
+			ModelId: "test-model",
+			Details: "This is a test",
+		},
+	})
+	assert.NoError(t, err)
+	for _, hyperparametersID := range []string{"hp-1", "hp-2"} {
+		_, err = srv.CreateHyperparameters(ctx, &api.CreateHyperparametersRequest{
+			ModelId:           "test-model",
+			HyperparametersId: hyperparametersID,
+			Hyperparameters:   map[string]string{"parameter": hyperparametersID},
+		})
+		assert.NoError(t, err)
+		for _, checkpointID := range []string{"ckpt-1", "ckpt-2"} {
+			_, err = srv.CreateCheckpoint(ctx, &api.CreateCheckpointRequest{
+				ModelId:           "test-model",
+				HyperparametersId: hyperparametersID,
+				CheckpointId:      checkpointID,
+				Link:              "http://example.com/" + hyperparametersID + "/" + checkpointID + ".zip",
+			})
+			assert.NoError(t, err)
+		}
+	}
+
+	upgradeCheckRequest := api.UpgradeCheckRequest{
+		ModelId:           "test-model",
+		HyperparametersId: "hp-1",
+		CheckpointId:      "ckpt-1",
+	}
+
+	// Without a canonical checkpoint or an upgrade, clients stay where they are.
+	resp, err := srv.UpgradeCheck(ctx, &upgradeCheckRequest)
+	assert.NoError(t, err)
+	assert.False(t, resp.UpgradeAvailable)
+	assert.Equal(t, "ckpt-1", resp.Target.CheckpointId)
+	assert.Equal(t, []*api.UpgradeHop{{HyperparametersId: "hp-1"}}, resp.Hops)
+
+	_, err = srv.UpdateHyperparameters(ctx, &api.UpdateHyperparametersRequest{
+		ModelId:             "test-model",
+		HyperparametersId:   "hp-1",
+		CanonicalCheckpoint: "ckpt-2",
+	})
+	assert.NoError(t, err)
+
+	resp, err = srv.UpgradeCheck(ctx, &upgradeCheckRequest)
+	assert.NoError(t, err)
+	assert.True(t, resp.UpgradeAvailable)
+	assert.Equal(t, "hp-1", resp.Target.HyperparametersId)
+	assert.Equal(t, "ckpt-2", resp.Target.CheckpointId)
+
+	_, err = srv.UpdateHyperparameters(ctx, &api.UpdateHyperparametersRequest{
+		ModelId:           "test-model",
+		HyperparametersId: "hp-1",
+		UpgradeTo:         "hp-2",
+	})
+	assert.NoError(t, err)
+
+	// hp-2 has no canonical checkpoint to upgrade to.
+	_, err = srv.UpgradeCheck(ctx, &upgradeCheckRequest)
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	_, err = srv.UpdateHyperparameters(ctx, &api.UpdateHyperparametersRequest{
+		ModelId:             "test-model",
+		HyperparametersId:   "hp-2",
+		CanonicalCheckpoint: "ckpt-1",
+	})
+	assert.NoError(t, err)
+
+	resp, err = srv.UpgradeCheck(ctx, &upgradeCheckRequest)
+	assert.NoError(t, err)
+	assert.True(t, resp.UpgradeAvailable)
+	assert.Equal(t, "hp-2", resp.Target.HyperparametersId)
+	assert.Equal(t, "ckpt-1", resp.Target.CheckpointId)
+	assert.Equal(t, "http://example.com/hp-2/ckpt-1.zip", resp.Target.Link)
+	assert.Equal(t, []*api.UpgradeHop{
+		{HyperparametersId: "hp-1", CanonicalCheckpoint: "ckpt-2", UpgradeTo: "hp-2"},
+		{HyperparametersId: "hp-2", CanonicalCheckpoint: "ckpt-1"},
+	}, resp.Hops)
+
+	resp, err = srv.UpgradeCheck(ctx, &api.UpgradeCheckRequest{
+		ModelId:           "test-model",
+		HyperparametersId: "hp-2",
+		CheckpointId:      "ckpt-1",
+	})
+	assert.NoError(t, err)
+	assert.False(t, resp.UpgradeAvailable)
+
+	_, err = srv.UpdateHyperparameters(ctx, &api.UpdateHyperparametersRequest{
+		ModelId:           "test-model",
+		HyperparametersId: "hp-2",
+		UpgradeTo:         "hp-1",
+	})
+	assert.NoError(t, err)
+
+	_, err = srv.UpgradeCheck(ctx, &upgradeCheckRequest)
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	assert.Contains(t, status.Convert(err).Message(), storage.ErrUpgradeCycle.Error())
+
+	_, err = srv.UpgradeCheck(ctx, &api.UpgradeCheckRequest{
+		ModelId:           "test-model",
+		HyperparametersId: "hp-3",
+		CheckpointId:      "ckpt-1",
+	})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+// Tests that updates refuse references to missing resources, and that Fsck reports the dangling
+// references left behind by creates and forced deletes.
+func TestFsck(t *testing.T) {
+	srv := testingServer()
+	ctx := context.Background()
+
+	fsckResponse, err := srv.Fsck(ctx, &api.FsckRequest{})
+	assert.NoError(t, err)
+	assert.Empty(t, fsckResponse.DanglingReferences)
+
+	// References can't be checked when a model is created, as its hyperparameters can't exist yet.
+	_, err = srv.CreateModel(ctx, &api.CreateModelRequest{
+		Model: &api.Model{
+			ModelId:                  "test-model",
+			Details:                  "This is a test",
+			CanonicalHyperparameters: "missing-hyperparameters",
+		},
+	})
+	assert.NoError(t, err)
+	_, err = srv.CreateHyperparameters(ctx, &api.CreateHyperparametersRequest{
+		ModelId:           "test-model",
+		HyperparametersId: "test-hyperparameters",
+		Hyperparameters:   map[string]string{"parameter": "parameter-value"},
+	})
+	assert.NoError(t, err)
+	_, err = srv.CreateCheckpoint(ctx, &api.CreateCheckpointRequest{
+		ModelId:           "test-model",
+		HyperparametersId: "test-hyperparameters",
+		CheckpointId:      "test-checkpoint",
+		Link:              "http://example.com/checkpoints-for-test/ckpt.zip",
+	})
+	assert.NoError(t, err)
+
+	_, err = srv.UpdateModel(ctx, &api.UpdateModelRequest{
+		ModelId: "test-model",
+		Model:   &api.Model{CanonicalHyperparameters: "other-hyperparameters"},
+	})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	// Unrelated updates are still allowed while a reference is dangling.
+	_, err = srv.UpdateModel(ctx, &api.UpdateModelRequest{
+		ModelId: "test-model",
+		Model:   &api.Model{Details: "This is only a test"},
+	})
+	assert.NoError(t, err)
+
+	_, err = srv.UpdateHyperparameters(ctx, &api.UpdateHyperparametersRequest{
+		ModelId:           "test-model",
+		HyperparametersId: "test-hyperparameters",
+		UpgradeTo:         "missing-hyperparameters",
+	})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	_, err = srv.UpdateHyperparameters(ctx, &api.UpdateHyperparametersRequest{
+		ModelId:             "test-model",
+		HyperparametersId:   "test-hyperparameters",
+		CanonicalCheckpoint: "test-checkpoint",
+	})
+	assert.NoError(t, err)
+
+	_, err = srv.DeleteCheckpoint(ctx, &api.DeleteCheckpointRequest{
+		ModelId:           "test-model",
+		HyperparametersId: "test-hyperparameters",
+		CheckpointId:      "test-checkpoint",
+		Force:             true,
+	})
+	assert.NoError(t, err)
+
+	fsckResponse, err = srv.Fsck(ctx, &api.FsckRequest{})
+	assert.NoError(t, err)
+	assert.Equal(t, []*api.DanglingReference{
+		{
+			ResourcePath: "/models/test-model",
+			Field:        "CanonicalHyperparameters",
+			Reference:    "/models/test-model/hyperparameters/missing-hyperparameters",
+		},
+		{
+			ResourcePath: "/models/test-model/hyperparameters/test-hyperparameters",
+			Field:        "CanonicalCheckpoint",
+			Reference:    "/models/test-model/hyperparameters/test-hyperparameters/checkpoints/test-checkpoint",
+		},
+	}, fsckResponse.DanglingReferences)
+}
+
+func TestAuditLog(t *testing.T) {
+	srv := testingServer()
+	ctx := authentication.ContextWithActor(context.Background(), "ModelsWriter:9f86d081884c")
+	start := time.Now()
+
+	_, err := srv.CreateModel(ctx, &api.CreateModelRequest{
+		Model: &api.Model{ModelId: "test-model", Details: "This is a test"},
+	})
+	assert.NoError(t, err)
+	_, err = srv.CreateHyperparameters(ctx, &api.CreateHyperparametersRequest{
+		ModelId:           "test-model",
+		HyperparametersId: "test-hyperparameters",
+	})
+	assert.NoError(t, err)
+	_, err = srv.UpdateModel(ctx, &api.UpdateModelRequest{
+		ModelId: "test-model",
+		Model:   &api.Model{Details: "This is only a test"},
+	})
+	assert.NoError(t, err)
+	// Failed changes are not recorded
+	_, err = srv.UpdateModel(ctx, &api.UpdateModelRequest{
+		ModelId:         "test-model",
+		Model:           &api.Model{Details: "This is a stale update"},
+		ExpectedVersion: 1,
+	})
+	assert.Equal(t, codes.Aborted, status.Code(err))
+	_, err = srv.DeleteModel(ctx, &api.DeleteModelRequest{ModelId: "test-model", Cascade: true})
+	assert.NoError(t, err)
+
+	events, err := srv.ListAuditEvents(ctx, &api.ListAuditEventsRequest{})
+	assert.NoError(t, err)
+	methods := make([]string, len(events.Events))
+	for i, event := range events.Events {
+		methods[i] = event.Method
+		assert.Equal(t, "ModelsWriter:9f86d081884c", event.Actor)
+	}
+	assert.Equal(t, []string{
+		"/api.Repository/CreateModel",
+		"/api.Repository/CreateHyperparameters",
+		"/api.Repository/UpdateModel",
+		"/api.Repository/DeleteModel",
+	}, methods)
+
+	update := events.Events[2]
+	assert.Equal(t, "/models/test-model", update.ResourcePath)
+	assert.Equal(t, `{"modelId":"test-model","model":{"details":"This is only a test"}}`, update.Request)
+	var before, after storage.Model
+	assert.NoError(t, json.Unmarshal([]byte(update.Before), &before))
+	assert.NoError(t, json.Unmarshal([]byte(update.After), &after))
+	assert.Equal(t, storage.Model{ModelId: "test-model", Details: "This is a test", Version: 1}, before)
+	assert.Equal(t, storage.Model{ModelId: "test-model", Details: "This is only a test", Version: 2}, after)
+
+	assert.Empty(t, events.Events[0].Before)
+	assert.NotEmpty(t, events.Events[0].After)
+	assert.NotEmpty(t, events.Events[3].Before)
+	assert.Empty(t, events.Events[3].After)
+
+	// Filtering by resource includes the resources below it
+	events, err = srv.ListAuditEvents(ctx, &api.ListAuditEventsRequest{
+		ResourcePath: "/models/test-model/hyperparameters",
+		MaxItems:     1,
+	})
+	assert.NoError(t, err)
+	assert.Len(t, events.Events, 1)
+	assert.Equal(t, "/models/test-model/hyperparameters/test-hyperparameters", events.Events[0].ResourcePath)
+
+	// Filtering by time range
+	until, err := ptypes.TimestampProto(start)
+	assert.NoError(t, err)
+	events, err = srv.ListAuditEvents(ctx, &api.ListAuditEventsRequest{Until: until})
+	assert.NoError(t, err)
+	assert.Empty(t, events.Events)
+
+	// Paging
+	events, err = srv.ListAuditEvents(ctx, &api.ListAuditEventsRequest{MaxItems: 3})
+	assert.NoError(t, err)
+	assert.Len(t, events.Events, 3)
+	events, err = srv.ListAuditEvents(ctx, &api.ListAuditEventsRequest{MaxItems: 3, PageToken: events.NextPageToken})
+	assert.NoError(t, err)
+	assert.Len(t, events.Events, 1)
+	assert.Equal(t, "/api.Repository/DeleteModel", events.Events[0].Method)
+	assert.Equal(t, "", events.NextPageToken)
+}
+
+func TestRevisions(t *testing.T) {
+	srv := testingServer()
+	ctx := context.Background()
+
+	_, err := srv.CreateModel(ctx, &api.CreateModelRequest{
+		Model: &api.Model{ModelId: "test-model", Details: "This is a test", Labels: map[string]string{"team": "vision"}},
+	})
+	assert.NoError(t, err)
+	for _, hyperparametersID := range []string{"hp-1", "hp-2"} {
+		_, err = srv.CreateHyperparameters(ctx, &api.CreateHyperparametersRequest{
+			ModelId:           "test-model",
+			HyperparametersId: hyperparametersID,
+			Hyperparameters:   map[string]string{"learningRate": "0.1"},
+		})
+		assert.NoError(t, err)
+	}
+	_, err = srv.UpdateModel(ctx, &api.UpdateModelRequest{
+		ModelId: "test-model",
+		Model:   &api.Model{CanonicalHyperparameters: "hp-1"},
+	})
+	assert.NoError(t, err)
+	_, err = srv.UpdateModel(ctx, &api.UpdateModelRequest{
+		ModelId: "test-model",
+		Model: &api.Model{
+			CanonicalHyperparameters: "hp-2",
+			Labels:                   map[string]string{"stage": "production"},
+			Card:                     &api.ModelCard{Description: "Faces", Owners: []string{"vision"}},
+		},
+	})
+	assert.NoError(t, err)
+
+	// Paging through the model's revisions
+	revisions, err := srv.ListRevisions(ctx, &api.ListRevisionsRequest{ModelId: "test-model", MaxItems: 2})
+	assert.NoError(t, err)
+	if assert.Len(t, revisions.Revisions, 2) {
+		assert.Equal(t, int64(1), revisions.Revisions[0].Version)
+		assert.Equal(t, "", revisions.Revisions[0].Model.CanonicalHyperparameters)
+		assert.Equal(t, int64(2), revisions.Revisions[1].Version)
+		assert.Equal(t, "hp-1", revisions.Revisions[1].Model.CanonicalHyperparameters)
+		assert.Nil(t, revisions.Revisions[1].Hyperparameters)
+	}
+	assert.NotEmpty(t, revisions.NextPageToken)
+	revisions, err = srv.ListRevisions(ctx, &api.ListRevisionsRequest{ModelId: "test-model", MaxItems: 2, PageToken: revisions.NextPageToken})
+	assert.NoError(t, err)
+	if assert.Len(t, revisions.Revisions, 1) {
+		assert.Equal(t, int64(3), revisions.Revisions[0].Version)
+	}
+	assert.Equal(t, "", revisions.NextPageToken)
+	_, err = srv.ListRevisions(ctx, &api.ListRevisionsRequest{ModelId: "test-model", PageToken: "not a token"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = srv.ListRevisions(ctx, &api.ListRevisionsRequest{ModelId: "missing-model"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	revision, err := srv.GetRevision(ctx, &api.GetRevisionRequest{ModelId: "test-model", Version: 2})
+	assert.NoError(t, err)
+	assert.Equal(t, "hp-1", revision.Revision.Model.CanonicalHyperparameters)
+	assert.NotNil(t, revision.Revision.CreatedAt)
+	_, err = srv.GetRevision(ctx, &api.GetRevisionRequest{ModelId: "test-model", Version: 9})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	_, err = srv.GetRevision(ctx, &api.GetRevisionRequest{ModelId: "test-model"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// Rolling back restores the revision as a new one
+	_, err = srv.Rollback(ctx, &api.RollbackRequest{ModelId: "test-model", Version: 2, ExpectedVersion: 2})
+	assert.Equal(t, codes.Aborted, status.Code(err))
+	rollback, err := srv.Rollback(ctx, &api.RollbackRequest{ModelId: "test-model", Version: 2, ExpectedVersion: 3})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(4), rollback.Revision.Version)
+	model, err := srv.GetModel(ctx, &api.GetModelRequest{ModelId: "test-model"})
+	assert.NoError(t, err)
+	assert.Equal(t, "hp-1", model.CanonicalHyperparameters)
+	assert.Equal(t, map[string]string{"team": "vision"}, model.Labels)
+	assert.Nil(t, model.Card)
+	assert.Equal(t, int64(4), model.Version)
+
+	// Revisions which refer to hyperparameters which have since been deleted cannot be restored
+	_, err = srv.DeleteHyperparameters(ctx, &api.DeleteHyperparametersRequest{ModelId: "test-model", HyperparametersId: "hp-2"})
+	assert.NoError(t, err)
+	_, err = srv.Rollback(ctx, &api.RollbackRequest{ModelId: "test-model", Version: 3})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	// Hyperparameters have revisions of their own. Rolling back clears the pointers and the
+	// hyperparameters which were set since.
+	_, err = srv.CreateHyperparameters(ctx, &api.CreateHyperparametersRequest{ModelId: "test-model", HyperparametersId: "hp-3"})
+	assert.NoError(t, err)
+	_, err = srv.CreateCheckpoint(ctx, &api.CreateCheckpointRequest{
+		ModelId:           "test-model",
+		HyperparametersId: "hp-1",
+		CheckpointId:      "ckpt-1",
+		Link:              "http://example.com/checkpoints-for-test/ckpt.zip",
+	})
+	assert.NoError(t, err)
+	_, err = srv.UpdateHyperparameters(ctx, &api.UpdateHyperparametersRequest{
+		ModelId:             "test-model",
+		HyperparametersId:   "hp-1",
+		CanonicalCheckpoint: "ckpt-1",
+		UpgradeTo:           "hp-3",
+		Hyperparameters:     map[string]string{"learningRate": "0.2", "batchSize": "64"},
+	})
+	assert.NoError(t, err)
+	rollback, err = srv.Rollback(ctx, &api.RollbackRequest{ModelId: "test-model", HyperparametersId: "hp-1", Version: 1})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(3), rollback.Revision.Version)
+	assert.Equal(t, map[string]string{"learningRate": "0.1"}, rollback.Revision.Hyperparameters.Hyperparameters)
+	hyperparameters, err := srv.GetHyperparameters(ctx, &api.GetHyperparametersRequest{ModelId: "test-model", HyperparametersId: "hp-1"})
+	assert.NoError(t, err)
+	assert.Equal(t, "", hyperparameters.CanonicalCheckpoint)
+	assert.Equal(t, "", hyperparameters.UpgradeTo)
+	assert.Equal(t, map[string]string{"learningRate": "0.1"}, hyperparameters.Hyperparameters)
+	revisions, err = srv.ListRevisions(ctx, &api.ListRevisionsRequest{ModelId: "test-model", HyperparametersId: "hp-1"})
+	assert.NoError(t, err)
+	versions := make([]string, len(revisions.Revisions))
+	for i, revision := range revisions.Revisions {
+		versions[i] = revision.Hyperparameters.Hyperparameters["learningRate"]
+	}
+	assert.Equal(t, []string{"0.1", "0.2", "0.1"}, versions)
+	_, err = srv.GetRevision(ctx, &api.GetRevisionRequest{ModelId: "test-model", HyperparametersId: "hp-2", Version: 1})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	// Rollbacks are in the audit log
+	events, err := srv.ListAuditEvents(ctx, &api.ListAuditEventsRequest{ResourcePath: "/models/test-model/hyperparameters/hp-1"})
+	assert.NoError(t, err)
+	if assert.NotEmpty(t, events.Events) {
+		assert.Equal(t, "/api.Repository/Rollback", events.Events[len(events.Events)-1].Method)
+	}
+}
+
+// watchStream - collects the events sent by WatchModel.
+type watchStream struct {
+	grpc.ServerStream
+	ctx    context.Context
+	events chan *api.WatchModelEvent
+}
+
+func (stream *watchStream) Context() context.Context {
+	return stream.ctx
+}
+
+func (stream *watchStream) Send(event *api.WatchModelEvent) error {
+	stream.events <- event
+	return nil
+}
+
+func TestWatchModel(t *testing.T) {
+	srv := testingServer()
+	ctx := context.Background()
+
+	err := srv.WatchModel(&api.WatchModelRequest{}, &watchStream{ctx: ctx})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	err = srv.WatchModel(&api.WatchModelRequest{ModelId: "test-model"}, &watchStream{ctx: ctx})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	_, err = srv.CreateModel(ctx, &api.CreateModelRequest{Model: &api.Model{ModelId: "test-model", Details: "This is a test"}})
+	assert.NoError(t, err)
+	_, err = srv.CreateModel(ctx, &api.CreateModelRequest{Model: &api.Model{ModelId: "test-model-2", Details: "Another test"}})
+	assert.NoError(t, err)
+	err = srv.WatchModel(&api.WatchModelRequest{ModelId: "test-model", ResumeToken: "not a token"}, &watchStream{ctx: ctx})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	watchCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	stream := &watchStream{ctx: watchCtx, events: make(chan *api.WatchModelEvent, 10)}
+	done := make(chan error)
+	// Resumes from now, so that the changes below are sent even if they are made before watching starts
+	resumeToken := storage.NewAuditEventId(time.Now())
+	go func() {
+		done <- srv.WatchModel(&api.WatchModelRequest{ModelId: "test-model", ResumeToken: resumeToken}, stream)
+	}()
+	nextEvent := func() *api.WatchModelEvent {
+		select {
+		case event := <-stream.events:
+			return event
+		case <-time.After(5 * time.Second):
+			t.Fatal("Timed out waiting for an event")
+			return nil
+		}
+	}
+
+	_, err = srv.CreateHyperparameters(ctx, &api.CreateHyperparametersRequest{ModelId: "test-model", HyperparametersId: "hp-1"})
+	assert.NoError(t, err)
+	_, err = srv.UpdateModel(ctx, &api.UpdateModelRequest{ModelId: "test-model-2", Model: &api.Model{Details: "Not watched"}})
+	assert.NoError(t, err)
+	_, err = srv.CreateCheckpoint(ctx, &api.CreateCheckpointRequest{
+		ModelId:           "test-model",
+		HyperparametersId: "hp-1",
+		CheckpointId:      "ckpt-1",
+		Link:              "http://example.com/ckpt-1.zip",
+	})
+	assert.NoError(t, err)
+	_, err = srv.UpdateHyperparameters(ctx, &api.UpdateHyperparametersRequest{
+		ModelId:             "test-model",
+		HyperparametersId:   "hp-1",
+		CanonicalCheckpoint: "ckpt-1",
+	})
+	assert.NoError(t, err)
+
+	event := nextEvent()
+	assert.Equal(t, api.WatchModelEvent_CREATED, event.Type)
+	assert.Equal(t, "/api.Repository/CreateHyperparameters", event.Method)
+	assert.Equal(t, "test-model", event.ModelId)
+	assert.Equal(t, "hp-1", event.HyperparametersId)
+	assert.Equal(t, "", event.CheckpointId)
+	firstEventID := event.EventId
+
+	event = nextEvent()
+	assert.Equal(t, api.WatchModelEvent_CREATED, event.Type)
+	assert.Equal(t, "/models/test-model/hyperparameters/hp-1/checkpoints/ckpt-1", event.ResourcePath)
+	assert.Equal(t, "ckpt-1", event.CheckpointId)
+
+	event = nextEvent()
+	assert.Equal(t, api.WatchModelEvent_UPDATED, event.Type)
+	assert.Equal(t, "/api.Repository/UpdateHyperparameters", event.Method)
+	assert.Contains(t, event.After, "ckpt-1")
+
+	// Deleting a tag on the model does not end the stream
+	_, err = srv.SetTag(ctx, &api.SetTagRequest{ModelId: "test-model", Tag: "production", Target: "hp-1"})
+	assert.NoError(t, err)
+	_, err = srv.DeleteTag(ctx, &api.DeleteTagRequest{ModelId: "test-model", Tag: "production"})
+	assert.NoError(t, err)
+	event = nextEvent()
+	assert.Equal(t, api.WatchModelEvent_CREATED, event.Type)
+	assert.Equal(t, "/models/test-model/tags/production", event.ResourcePath)
+	assert.Equal(t, "production", event.Tag)
+	assert.Equal(t, "", event.HyperparametersId)
+	event = nextEvent()
+	assert.Equal(t, api.WatchModelEvent_DELETED, event.Type)
+	assert.Equal(t, "/api.Repository/DeleteTag", event.Method)
+	assert.Equal(t, "test-model", event.ModelId)
+	assert.Equal(t, "production", event.Tag)
+	select {
+	case err = <-done:
+		t.Fatalf("WatchModel returned after a tag was deleted: %v", err)
+	case <-time.After(500 * time.Millisecond):
+	}
+
+	// Deleting the model ends the stream
+	_, err = srv.DeleteModel(ctx, &api.DeleteModelRequest{ModelId: "test-model", Cascade: true})
+	assert.NoError(t, err)
+	event = nextEvent()
+	assert.Equal(t, api.WatchModelEvent_DELETED, event.Type)
+	assert.Equal(t, "/models/test-model", event.ResourcePath)
+	assert.Equal(t, "", event.After)
+	select {
+	case err = <-done:
+		assert.NoError(t, err)
+	case <-time.After(5 * time.Second):
+		t.Fatal("WatchModel did not return after the model was deleted")
+	}
+
+	// Resuming replays the events after the resume token
+	_, err = srv.CreateModel(ctx, &api.CreateModelRequest{Model: &api.Model{ModelId: "test-model", Details: "This is a test"}})
+	assert.NoError(t, err)
+	stream = &watchStream{ctx: watchCtx, events: make(chan *api.WatchModelEvent, 10)}
+	err = srv.WatchModel(&api.WatchModelRequest{ModelId: "test-model", ResumeToken: firstEventID}, stream)
+	assert.NoError(t, err)
+	close(stream.events)
+	methods := make([]string, 0)
+	for event := range stream.events {
+		methods = append(methods, event.Method)
+	}
+	assert.Equal(t, []string{
+		"/api.Repository/CreateCheckpoint",
+		"/api.Repository/UpdateHyperparameters",
+		"/api.Repository/SetTag",
+		"/api.Repository/DeleteTag",
+		"/api.Repository/DeleteModel",
+	}, methods)
+
+	// Watching stops when the client goes away
+	stream = &watchStream{ctx: watchCtx, events: make(chan *api.WatchModelEvent, 10)}
+	go func() {
+		done <- srv.WatchModel(&api.WatchModelRequest{ModelId: "test-model"}, stream)
+	}()
+	cancel()
+	select {
+	case err = <-done:
+		assert.NoError(t, err)
+	case <-time.After(5 * time.Second):
+		t.Fatal("WatchModel did not return after its context was cancelled")
+	}
+	assert.Empty(t, stream.events)
+}
+
+// Send 0 for status to avoid status check.
+func sendGetRequest(t *testing.T, url string, status int) string {
+	resp, err := http.Get(url)
+	if err != nil {
+		t.Error(err)
+	}
+	defer resp.Body.Close()
+
+	if status != 0 && resp.StatusCode != status {
+		t.Errorf("Expected: %d Got: %d for URL: %s", status, resp.StatusCode, url)
+	}
+
+	bodyBytes, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		t.Error(err)
+	}
+	return string(bodyBytes)
+}
+
+func postRequest(t *testing.T, url string, jsonStruct map[string]interface{}, status int) string {
+	bytesRepresentation, err := json.Marshal(jsonStruct)
+	if err != nil {
+		t.Error(err)
+	}
+
+	resp, err := http.Post(url, "application/json", bytes.NewBuffer(bytesRepresentation))
+	if err != nil {
+		t.Error(err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != status {
+		t.Errorf("Expected: %d Got: %d for POST URL: %s", status, resp.StatusCode, url)
+	}
+
+	bodyBytes, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		t.Error(err)
+	}
+	return string(bodyBytes)
+}
+
+func putRequest(t *testing.T, url string, jsonStruct map[string]interface{}, status int) string {
+	bytesRepresentation, err := json.Marshal(jsonStruct)
+	if err != nil {
+		t.Error(err)
+	}
+
+	req, err := http.NewRequest(http.MethodPut, url, bytes.NewBuffer(bytesRepresentation))
+	if err != nil {
+		t.Error(err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Error(err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != status {
+		t.Errorf("Expected: %d Got: %d for PUT URL: %s", status, resp.StatusCode, url)
+	}
+
+	bodyBytes, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		t.Error(err)
+	}
+	return string(bodyBytes)
+}
+
+func deleteRequest(t *testing.T, url string, status int) string {
+	req, err := http.NewRequest(http.MethodDelete, url, nil)
+	if err != nil {
+		t.Error(err)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Error(err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != status {
+		t.Errorf("Expected: %d Got: %d for DELETE URL: %s", status, resp.StatusCode, url)
+	}
+
+	bodyBytes, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		t.Error(err)
+	}
+	return string(bodyBytes)
+}
+
+func TestTags(t *testing.T) {
+	srv := testingServer()
+	ctx := context.Background()
+
+	_, err := srv.SetTag(ctx, &api.SetTagRequest{ModelId: "model", Tag: "production", Target: "hp-1"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	_, err = srv.CreateModel(ctx, &api.CreateModelRequest{
+		Model: &api.Model{ModelId: "model", Details: "This is a test"},
+	})
+	assert.NoError(t, err)
+	for _, hyperparametersID := range []string{"hp-1", "hp-2"} {
+		_, err = srv.CreateHyperparameters(ctx, &api.CreateHyperparametersRequest{ModelId: "model", HyperparametersId: hyperparametersID})
+		assert.NoError(t, err)
+	}
+	for _, checkpointID := range []string{"ckpt-1", "ckpt-2"} {
+		_, err = srv.CreateCheckpoint(ctx, &api.CreateCheckpointRequest{
+			ModelId:           "model",
+			HyperparametersId: "hp-1",
+			CheckpointId:      checkpointID,
+			Link:              "gs://bucket/" + checkpointID,
+		})
+		assert.NoError(t, err)
+	}
+
+	_, err = srv.SetTag(ctx, &api.SetTagRequest{ModelId: "model", Tag: "@production", Target: "hp-1"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = srv.SetTag(ctx, &api.SetTagRequest{ModelId: "model", Tag: "production"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = srv.SetTag(ctx, &api.SetTagRequest{ModelId: "model", Tag: "production", Target: "hp-3"})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	_, err = srv.SetTag(ctx, &api.SetTagRequest{ModelId: "model", HyperparametersId: "hp-1", Tag: "production", Target: "ckpt-3"})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	modelTag, err := srv.SetTag(ctx, &api.SetTagRequest{ModelId: "model", Tag: "production", Target: "hp-1"})
+	assert.NoError(t, err)
+	assert.Equal(t, "hp-1", modelTag.Tag.Target)
+	assert.NotNil(t, modelTag.Tag.UpdatedAt)
+	_, err = srv.SetTag(ctx, &api.SetTagRequest{ModelId: "model", HyperparametersId: "hp-1", Tag: "production", Target: "ckpt-1"})
+	assert.NoError(t, err)
+	_, err = srv.SetTag(ctx, &api.SetTagRequest{ModelId: "model", HyperparametersId: "hp-1", Tag: "staging", Target: "ckpt-2"})
+	assert.NoError(t, err)
+
+	// Tags stand in for hyperparameters and checkpoint IDs
+	hyperparameters, err := srv.GetHyperparameters(ctx, &api.GetHyperparametersRequest{ModelId: "model", HyperparametersId: "@production"})
+	assert.NoError(t, err)
+	assert.Equal(t, "hp-1", hyperparameters.HyperparametersId)
+	checkpoint, err := srv.GetCheckpoint(ctx, &api.GetCheckpointRequest{ModelId: "model", HyperparametersId: "@production", CheckpointId: "@production"})
+	assert.NoError(t, err)
+	assert.Equal(t, "hp-1", checkpoint.HyperparametersId)
+	assert.Equal(t, "ckpt-1", checkpoint.CheckpointId)
+	assert.Equal(t, "gs://bucket/ckpt-1", checkpoint.Link)
+	checkpoint, err = srv.GetCheckpoint(ctx, &api.GetCheckpointRequest{ModelId: "model", HyperparametersId: "hp-1", CheckpointId: "@staging"})
+	assert.NoError(t, err)
+	assert.Equal(t, "ckpt-2", checkpoint.CheckpointId)
+	_, err = srv.GetCheckpoint(ctx, &api.GetCheckpointRequest{ModelId: "model", HyperparametersId: "hp-1", CheckpointId: "@canary"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	_, err = srv.GetCheckpoint(ctx, &api.GetCheckpointRequest{ModelId: "model", HyperparametersId: "hp-2", CheckpointId: "@production"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	// Moving a tag
+	_, err = srv.SetTag(ctx, &api.SetTagRequest{ModelId: "model", HyperparametersId: "hp-1", Tag: "staging", Target: "ckpt-1"})
+	assert.NoError(t, err)
+	checkpoint, err = srv.GetCheckpoint(ctx, &api.GetCheckpointRequest{ModelId: "model", HyperparametersId: "hp-1", CheckpointId: "@staging"})
+	assert.NoError(t, err)
+	assert.Equal(t, "ckpt-1", checkpoint.CheckpointId)
+
+	tag, err := srv.GetTag(ctx, &api.GetTagRequest{ModelId: "model", HyperparametersId: "hp-1", Tag: "staging"})
+	assert.NoError(t, err)
+	assert.Equal(t, "ckpt-1", tag.Tag.Target)
+	tags, err := srv.ListTags(ctx, &api.ListTagsRequest{ModelId: "model", HyperparametersId: "hp-1"})
+	assert.NoError(t, err)
+	if assert.Len(t, tags.Tags, 2) {
+		assert.Equal(t, "production", tags.Tags[0].Tag)
+		assert.Equal(t, "staging", tags.Tags[1].Tag)
+	}
+	tags, err = srv.ListTags(ctx, &api.ListTagsRequest{ModelId: "model"})
+	assert.NoError(t, err)
+	assert.Len(t, tags.Tags, 1)
+
+	// Tagged resources are only deleted if forced
+	_, err = srv.DeleteCheckpoint(ctx, &api.DeleteCheckpointRequest{ModelId: "model", HyperparametersId: "hp-1", CheckpointId: "ckpt-1"})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	_, err = srv.DeleteCheckpoint(ctx, &api.DeleteCheckpointRequest{ModelId: "model", HyperparametersId: "hp-1", CheckpointId: "ckpt-2"})
+	assert.NoError(t, err)
+
+	deleted, err := srv.DeleteTag(ctx, &api.DeleteTagRequest{ModelId: "model", HyperparametersId: "hp-1", Tag: "staging"})
+	assert.NoError(t, err)
+	assert.Equal(t, "ckpt-1", deleted.Tag.Target)
+	_, err = srv.DeleteTag(ctx, &api.DeleteTagRequest{ModelId: "model", HyperparametersId: "hp-1", Tag: "staging"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	events, err := srv.ListAuditEvents(ctx, &api.ListAuditEventsRequest{ResourcePath: "/models/model/hyperparameters/hp-1/tags/staging"})
+	assert.NoError(t, err)
+	methods := make([]string, len(events.Events))
+	for i, event := range events.Events {
+		methods[i] = event.Method
+	}
+	assert.Equal(t, []string{"/api.Repository/SetTag", "/api.Repository/SetTag", "/api.Repository/DeleteTag"}, methods)
+}
+
+func TestWebhooks(t *testing.T) {
+	srv := testingServer()
+	ctx := context.Background()
+
+	deliveries := make(chan *http.Request, 10)
+	receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		deliveries <- r
+	}))
+	defer receiver.Close()
+
+	// Invalid registrations
+	_, err := srv.CreateWebhook(ctx, &api.CreateWebhookRequest{Url: "ftp://example.com", EventTypes: []string{"CreateCheckpoint"}, Secret: "secret"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = srv.CreateWebhook(ctx, &api.CreateWebhookRequest{Url: receiver.URL, EventTypes: []string{"GetModel"}, Secret: "secret"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = srv.CreateWebhook(ctx, &api.CreateWebhookRequest{Url: receiver.URL, EventTypes: []string{"CreateCheckpoint"}})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	created, err := srv.CreateWebhook(ctx, &api.CreateWebhookRequest{
+		Url:        receiver.URL,
+		EventTypes: []string{"CreateCheckpoint", "UpdateHyperparameters"},
+		Secret:     "webhook-secret",
+	})
+	assert.NoError(t, err)
+
+	webhooks, err := srv.ListWebhooks(ctx, &api.ListWebhooksRequest{})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(webhooks.Webhooks))
+	assert.Equal(t, created.Webhook.WebhookId, webhooks.Webhooks[0].WebhookId)
+
+	_, err = srv.CreateModel(ctx, &api.CreateModelRequest{
+		Model: &api.Model{ModelId: "model", Details: "This is a test"},
+	})
+	assert.NoError(t, err)
+	_, err = srv.CreateHyperparameters(ctx, &api.CreateHyperparametersRequest{ModelId: "model", HyperparametersId: "hp"})
+	assert.NoError(t, err)
+	_, err = srv.CreateCheckpoint(ctx, &api.CreateCheckpointRequest{
+		ModelId:           "model",
+		HyperparametersId: "hp",
+		CheckpointId:      "ckpt",
+		Link:              "gs://bucket/ckpt",
+	})
+	assert.NoError(t, err)
+
+	// Only the checkpoint's creation is subscribed to
+	select {
+	case delivery := <-deliveries:
+		assert.Equal(t, "CreateCheckpoint", delivery.Header.Get(webhook.EventTypeHeader))
+	case <-time.After(5 * time.Second):
+		t.Fatal("checkpoint creation was not delivered")
+	}
+	select {
+	case delivery := <-deliveries:
+		t.Errorf("unexpected delivery of %s", delivery.Header.Get(webhook.EventTypeHeader))
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	deleted, err := srv.DeleteWebhook(ctx, &api.DeleteWebhookRequest{WebhookId: created.Webhook.WebhookId})
+	assert.NoError(t, err)
+	assert.Equal(t, receiver.URL, deleted.Webhook.Url)
+	_, err = srv.DeleteWebhook(ctx, &api.DeleteWebhookRequest{WebhookId: created.Webhook.WebhookId})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	deadLetters, err := srv.ListWebhookDeadLetters(ctx, &api.ListWebhookDeadLettersRequest{})
+	assert.NoError(t, err)
+	assert.Empty(t, deadLetters.DeadLetters)
+
+	// Registrations are audited without their secrets
+	events, err := srv.ListAuditEvents(ctx, &api.ListAuditEventsRequest{ResourcePath: webhook.ResourcePath(created.Webhook.WebhookId)})
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(events.Events))
+	assert.Equal(t, "/api.Repository/CreateWebhook", events.Events[0].Method)
+	assert.Equal(t, "/api.Repository/DeleteWebhook", events.Events[1].Method)
+	assert.NotContains(t, events.Events[0].Request, "webhook-secret")
+}
+
+func TestRollouts(t *testing.T) {
+	srv := testingServer()
+	ctx := context.Background()
+
+	_, err := srv.CreateModel(ctx, &api.CreateModelRequest{
+		Model: &api.Model{ModelId: "model", Details: "This is a test", CanonicalHyperparameters: "hp-1"},
+	})
+	assert.NoError(t, err)
+	_, err = srv.CreateHyperparameters(ctx, &api.CreateHyperparametersRequest{ModelId: "model", HyperparametersId: "hp-1"})
+	assert.NoError(t, err)
+	for _, checkpointID := range []string{"ckpt-1", "ckpt-2", "ckpt-3"} {
+		_, err = srv.CreateCheckpoint(ctx, &api.CreateCheckpointRequest{
+			ModelId:           "model",
+			HyperparametersId: "hp-1",
+			CheckpointId:      checkpointID,
+			Link:              "gs://bucket/" + checkpointID,
+		})
+		assert.NoError(t, err)
+	}
+
+	// Clients left out of the rollout need a canonical checkpoint
+	_, err = srv.RampRollout(ctx, &api.RampRolloutRequest{
+		ModelId:           "model",
+		HyperparametersId: "hp-1",
+		Rollout:           []*api.RolloutTarget{{CheckpointId: "ckpt-2", Weight: 5}},
+	})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	updated, err := srv.UpdateHyperparameters(ctx, &api.UpdateHyperparametersRequest{
+		ModelId:             "model",
+		HyperparametersId:   "hp-1",
+		CanonicalCheckpoint: "ckpt-1",
+	})
+	assert.NoError(t, err)
+
+	for _, rollout := range [][]*api.RolloutTarget{
+		nil,
+		{{Weight: 5}},
+		{{CheckpointId: "ckpt-2", Weight: 0}},
+		{{CheckpointId: "ckpt-2", Weight: 5}, {CheckpointId: "ckpt-2", Weight: 5}},
+		{{CheckpointId: "ckpt-2", Weight: 60}, {CheckpointId: "ckpt-3", Weight: 50}},
+	} {
+		_, err = srv.RampRollout(ctx, &api.RampRolloutRequest{ModelId: "model", HyperparametersId: "hp-1", Rollout: rollout})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err), "%v", rollout)
+	}
+	_, err = srv.RampRollout(ctx, &api.RampRolloutRequest{
+		ModelId:           "model",
+		HyperparametersId: "hp-1",
+		Rollout:           []*api.RolloutTarget{{CheckpointId: "ckpt-9", Weight: 5}},
+	})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	_, err = srv.RampRollout(ctx, &api.RampRolloutRequest{
+		ModelId:           "model",
+		HyperparametersId: "hp-1",
+		Rollout:           []*api.RolloutTarget{{CheckpointId: "ckpt-2", Weight: 5}},
+		ExpectedVersion:   updated.Version + 1,
+	})
+	assert.Equal(t, codes.Aborted, status.Code(err))
+
+	ramped, err := srv.RampRollout(ctx, &api.RampRolloutRequest{
+		ModelId:           "model",
+		HyperparametersId: "hp-1",
+		Rollout:           []*api.RolloutTarget{{CheckpointId: "ckpt-2", Weight: 5}},
+		ExpectedVersion:   updated.Version,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []*api.RolloutTarget{{CheckpointId: "ckpt-2", Weight: 5}}, ramped.Hyperparameters.Rollout)
+	assert.Equal(t, "ckpt-1", ramped.Hyperparameters.CanonicalCheckpoint)
+	hyperparameters, err := srv.GetHyperparameters(ctx, &api.GetHyperparametersRequest{ModelId: "model", HyperparametersId: "hp-1"})
+	assert.NoError(t, err)
+	assert.Equal(t, ramped.Hyperparameters.Rollout, hyperparameters.Rollout)
+
+	// Each client always gets the same checkpoint, and about 5% of them get the new one
+	_, err = srv.ResolveModelForClient(ctx, &api.ResolveModelForClientRequest{ModelId: "model"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	resolveAll := func() map[string]string {
+		res := make(map[string]string)
+		for i := 0; i < 1000; i++ {
+			clientID := fmt.Sprintf("device-%d", i)
+			resolved, err := srv.ResolveModelForClient(ctx, &api.ResolveModelForClientRequest{ModelId: "model", ClientId: clientID})
+			assert.NoError(t, err)
+			assert.Equal(t, resolved.Checkpoint.CheckpointId != "ckpt-1", resolved.InRollout)
+			assert.Equal(t, "gs://bucket/"+resolved.Checkpoint.CheckpointId, resolved.Checkpoint.Link)
+			res[clientID] = resolved.Checkpoint.CheckpointId
+		}
+		return res
+	}
+	countClients := func(checkpoints map[string]string, checkpointID string) int {
+		count := 0
+		for _, id := range checkpoints {
+			if id == checkpointID {
+				count++
+			}
+		}
+		return count
+	}
+	atFive := resolveAll()
+	assert.Equal(t, atFive, resolveAll())
+	assert.InDelta(t, 50, countClients(atFive, "ckpt-2"), 25)
+
+	// Ramping up keeps the clients which already have the new checkpoint
+	_, err = srv.RampRollout(ctx, &api.RampRolloutRequest{
+		ModelId:           "model",
+		HyperparametersId: "hp-1",
+		Rollout:           []*api.RolloutTarget{{CheckpointId: "ckpt-2", Weight: 50}, {CheckpointId: "ckpt-3", Weight: 50}},
+	})
+	assert.NoError(t, err)
+	atFifty := resolveAll()
+	for clientID, checkpointID := range atFive {
+		if checkpointID == "ckpt-2" {
+			assert.Equal(t, "ckpt-2", atFifty[clientID], clientID)
+		}
+	}
+	assert.Equal(t, 0, countClients(atFifty, "ckpt-1"))
+	assert.InDelta(t, 500, countClients(atFifty, "ckpt-2"), 75)
+
+	// Checkpoints which are being rolled out are only deleted if forced
+	_, err = srv.DeleteCheckpoint(ctx, &api.DeleteCheckpointRequest{ModelId: "model", HyperparametersId: "hp-1", CheckpointId: "ckpt-3"})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	aborted, err := srv.AbortRollout(ctx, &api.AbortRolloutRequest{ModelId: "model", HyperparametersId: "hp-1"})
+	assert.NoError(t, err)
+	assert.Empty(t, aborted.Hyperparameters.Rollout)
+	assert.Equal(t, "ckpt-1", aborted.Hyperparameters.CanonicalCheckpoint)
+	for _, checkpointID := range resolveAll() {
+		assert.Equal(t, "ckpt-1", checkpointID)
+	}
+	_, err = srv.AbortRollout(ctx, &api.AbortRolloutRequest{ModelId: "model", HyperparametersId: "hp-1"})
+	assert.NoError(t, err)
+	_, err = srv.AbortRollout(ctx, &api.AbortRolloutRequest{ModelId: "model", HyperparametersId: "hp-2"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	_, err = srv.DeleteCheckpoint(ctx, &api.DeleteCheckpointRequest{ModelId: "model", HyperparametersId: "hp-1", CheckpointId: "ckpt-3"})
+	assert.NoError(t, err)
+
+	// Ramps and aborts are recorded as hyperparameters updates
+	events, err := srv.ListAuditEvents(ctx, &api.ListAuditEventsRequest{ResourcePath: "/models/model/hyperparameters/hp-1"})
+	assert.NoError(t, err)
+	methods := make([]string, len(events.Events))
+	for i, event := range events.Events {
+		methods[i] = event.Method
+	}
+	assert.Contains(t, methods, "/api.Repository/RampRollout")
+	assert.Contains(t, methods, "/api.Repository/AbortRollout")
+}
+
+func TestCompareCheckpoints(t *testing.T) {
+	srv := testingServer()
+	ctx := context.Background()
+
+	_, err := srv.CreateModel(ctx, &api.CreateModelRequest{
+		Model: &api.Model{ModelId: "model", Details: "This is a test"},
+	})
+	assert.NoError(t, err)
+	_, err = srv.CreateHyperparameters(ctx, &api.CreateHyperparametersRequest{ModelId: "model", HyperparametersId: "hp-1"})
+	assert.NoError(t, err)
+	for i, info := range []map[string]string{
+		{"auc": "0.80", "loss": "0.5", "evaluator": "v1"},
+		{"auc": "0.85", "loss": "0.4"},
+		{"auc": "0.83", "loss": "0.45", "recall": "0.7"},
+		{"auc": "not evaluated", "loss": "NaN"},
+	} {
+		_, err = srv.CreateCheckpoint(ctx, &api.CreateCheckpointRequest{
+			ModelId:           "model",
+			HyperparametersId: "hp-1",
+			CheckpointId:      fmt.Sprintf("ckpt-%d", i+1),
+			Link:              "gs://bucket/checkpoint",
+			Info:              info,
+		})
+		assert.NoError(t, err)
+	}
+
+	for _, req := range []*api.CompareCheckpointsRequest{
+		{ModelId: "model"},
+		{ModelId: "model", HyperparametersId: "hp-1"},
+		{ModelId: "model", HyperparametersId: "hp-1", CheckpointIds: []string{"ckpt-1"}, Last: 2},
+		{ModelId: "model", HyperparametersId: "hp-1", Last: 101},
+	} {
+		_, err = srv.CompareCheckpoints(ctx, req)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err), "%v", req)
+	}
+	_, err = srv.CompareCheckpoints(ctx, &api.CompareCheckpointsRequest{ModelId: "model", HyperparametersId: "hp-1", CheckpointIds: []string{"ckpt-1", "ckpt-9"}})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	// Deltas are against the first checkpoint, and non-numeric entries are left out
+	comparison, err := srv.CompareCheckpoints(ctx, &api.CompareCheckpointsRequest{
+		ModelId:           "model",
+		HyperparametersId: "hp-1",
+		CheckpointIds:     []string{"ckpt-2", "ckpt-3", "ckpt-4"},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"auc", "loss", "recall"}, comparison.Metrics)
+	if assert.Len(t, comparison.Checkpoints, 3) {
+		assert.Equal(t, "ckpt-2", comparison.Checkpoints[0].CheckpointId)
+		assert.Equal(t, map[string]float64{"auc": 0, "loss": 0}, comparison.Checkpoints[0].Deltas)
+		assert.Equal(t, map[string]float64{"auc": 0.83, "loss": 0.45, "recall": 0.7}, comparison.Checkpoints[1].Values)
+		assert.InDelta(t, -0.02, comparison.Checkpoints[1].Deltas["auc"], 1e-9)
+		assert.InDelta(t, 0.05, comparison.Checkpoints[1].Deltas["loss"], 1e-9)
+		assert.NotContains(t, comparison.Checkpoints[1].Deltas, "recall")
+		assert.Empty(t, comparison.Checkpoints[2].Values)
+		assert.NotNil(t, comparison.Checkpoints[2].CreatedAt)
+	}
+	assert.Equal(t, "", comparison.BestCheckpointId)
+
+	// Best by metric among the latest checkpoints
+	comparison, err = srv.CompareCheckpoints(ctx, &api.CompareCheckpointsRequest{
+		ModelId:           "model",
+		HyperparametersId: "hp-1",
+		Last:              3,
+		BestBy:            "auc",
+	})
+	assert.NoError(t, err)
+	if assert.Len(t, comparison.Checkpoints, 3) {
+		assert.Equal(t, "ckpt-2", comparison.Checkpoints[0].CheckpointId)
+		assert.Equal(t, "ckpt-4", comparison.Checkpoints[2].CheckpointId)
+	}
+	assert.Equal(t, "ckpt-2", comparison.BestCheckpointId)
+	assert.Equal(t, 0.85, comparison.BestValue)
+
+	comparison, err = srv.CompareCheckpoints(ctx, &api.CompareCheckpointsRequest{
+		ModelId:           "model",
+		HyperparametersId: "hp-1",
+		Last:              10,
+		Metrics:           []string{"recall"},
+		BestBy:            "loss",
+		LowerIsBetter:     true,
+	})
+	assert.NoError(t, err)
+	assert.Len(t, comparison.Checkpoints, 4)
+	assert.Equal(t, []string{"loss", "recall"}, comparison.Metrics)
+	assert.NotContains(t, comparison.Checkpoints[0].Values, "auc")
+	assert.Equal(t, "ckpt-2", comparison.BestCheckpointId)
+	assert.Equal(t, 0.4, comparison.BestValue)
+
+	// Tags stand in for IDs
+	_, err = srv.SetTag(ctx, &api.SetTagRequest{ModelId: "model", Tag: "production", Target: "hp-1"})
+	assert.NoError(t, err)
+	_, err = srv.SetTag(ctx, &api.SetTagRequest{ModelId: "model", HyperparametersId: "hp-1", Tag: "production", Target: "ckpt-1"})
+	assert.NoError(t, err)
+	comparison, err = srv.CompareCheckpoints(ctx, &api.CompareCheckpointsRequest{
+		ModelId:           "model",
+		HyperparametersId: "@production",
+		CheckpointIds:     []string{"@production", "ckpt-2"},
+		Metrics:           []string{"auc"},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "hp-1", comparison.HyperparametersId)
+	if assert.Len(t, comparison.Checkpoints, 2) {
+		assert.Equal(t, "ckpt-1", comparison.Checkpoints[0].CheckpointId)
+		assert.InDelta(t, 0.05, comparison.Checkpoints[1].Deltas["auc"], 1e-9)
+	}
+}
+
+// Tests that the latest checkpoints are found across pages of listed checkpoints, and that
+// hyperparameters with too many checkpoints to read are refused.
+func TestCompareLatestCheckpointsAcrossPages(t *testing.T) {
+	store := memory.NewMemoryRepositoryStorage()
+	srv := server.NewServer(store, authentication.NewFakeAuthenticator(), 0, nil, false)
+	ctx := context.Background()
+
+	err := store.AddModel(ctx, storage.Model{ModelId: "model"})
+	assert.NoError(t, err)
+	err = store.AddHyperparameters(ctx, storage.Hyperparameters{ModelId: "model", HyperparametersId: "hp-1"})
+	assert.NoError(t, err)
+	createdAt := time.Now()
+	for i := 0; i < storage.MaxScannedCheckpoints; i++ {
+		err = store.AddCheckpoint(ctx, storage.Checkpoint{
+			ModelId:           "model",
+			HyperparametersId: "hp-1",
+			// Ids list in the opposite order to the one checkpoints were created in
+			CheckpointId: fmt.Sprintf("ckpt-%05d", storage.MaxScannedCheckpoints-i),
+			CreatedAt:    createdAt.Add(time.Duration(i) * time.Second),
+			Link:         "gs://bucket/checkpoint",
+		})
+		assert.NoError(t, err)
+	}
+
+	comparison, err := srv.CompareCheckpoints(ctx, &api.CompareCheckpointsRequest{ModelId: "model", HyperparametersId: "hp-1", Last: 3})
+	assert.NoError(t, err)
+	if assert.Len(t, comparison.Checkpoints, 3) {
+		assert.Equal(t, "ckpt-00003", comparison.Checkpoints[0].CheckpointId)
+		assert.Equal(t, "ckpt-00001", comparison.Checkpoints[2].CheckpointId)
+	}
+
+	err = store.AddCheckpoint(ctx, storage.Checkpoint{ModelId: "model", HyperparametersId: "hp-1", CheckpointId: "ckpt-extra", Link: "gs://bucket/checkpoint"})
+	assert.NoError(t, err)
+	_, err = srv.CompareCheckpoints(ctx, &api.CompareCheckpointsRequest{ModelId: "model", HyperparametersId: "hp-1", Last: 3})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	_, err = srv.CompareCheckpoints(ctx, &api.CompareCheckpointsRequest{ModelId: "model", HyperparametersId: "hp-1", CheckpointIds: []string{"ckpt-extra"}})
+	assert.NoError(t, err)
+}
+
+func TestIsValidID(t *testing.T) {
+	assert.True(t, common.IsValidID("dii-ZZ12_"))
+	assert.False(t, common.IsValidID(""))
+	assert.False(t, common.IsValidID(" X"))
+	assert.True(t, common.IsValidID("---"))
+	assert.False(t, common.IsValidID("1&2"))
+	assert.True(t, common.IsValidID("123"))
+}
+
+func TestURLEndpoints(t *testing.T) {
+	watchResumeToken := storage.NewAuditEventId(time.Now())
+	storage := memory.NewMemoryRepositoryStorage()
+	const grpcAddress = ":9300" // Use diff ports.
+	const jsonAddress = ":9301"
+	stopRequestChannel := make(chan string)
+	go server.StartGrpcAndProxyServer(storage, grpcAddress, jsonAddress, authentication.NewFakeAuthenticator(),
+		0, nil, false, stopRequestChannel)
+	baseUrl := fmt.Sprintf("http://localhost%s/v1/repository/", jsonAddress)
+	healthzUrl := baseUrl + "healthz"
+	response := ""
+	for ; response != "{\"status\":\"SERVING\"}"; response = sendGetRequest(t, healthzUrl, 0) {
+		fmt.Println("Waiting for server to become healthy")
+		time.Sleep(100 * time.Millisecond)
+	}
+	assert.Equal(t, "{\"backendType\":\"MEMORY\"}", sendGetRequest(t, baseUrl+"config", http.StatusOK))
+	assert.Equal(t, "{\"modelIds\":[],\"nextPageToken\":\"\",\"models\":[]}", sendGetRequest(t, baseUrl+"models", http.StatusOK))
+	const invModelErr = "\"Could not retrieve model (InvalidModelName) from storage\""
+	assert.Equal(t, "{\"error\":"+invModelErr+",\"message\":"+invModelErr+",\"code\":14,\"details\":[]}",
+		sendGetRequest(t, baseUrl+"models/InvalidModelName", http.StatusServiceUnavailable))
+	// Seems that these requests ignore canonicalHyperparameters or any other extra tags.
+	assert.Equal(t, "{\"resourcePath\":\"/models/MyModel\"}",
+		postRequest(t, baseUrl+"models",
+			map[string]interface{}{"model": map[string]string{
+				"modelId":                  "MyModel",
+				"details":                  "Selfie model",
+				"canonicalHyperparameters": "batch-666",
+				"randomTag":                "RandomValue",
+			}}, http.StatusOK))
+	assert.Equal(t, "{\"modelIds\":[\"MyModel\"],\"nextPageToken\":\"\",\"models\":[]}", sendGetRequest(t, baseUrl+"models", http.StatusOK))
+	assert.Equal(t, "{\"resourcePath\":\"/models/BasicModel\"}",
+		postRequest(t, baseUrl+"models",
+			map[string]interface{}{"model": map[string]string{
+				"modelId":                  "BasicModel",
+				"details":                  "Basic model",
+				"canonicalHyperparameters": "batch-123",
+			}}, http.StatusOK))
+
+	// Models are sorted lexicographically, not in order of recency.
+	assert.Equal(t, "{\"modelIds\":[\"BasicModel\",\"MyModel\"],\"nextPageToken\":\"\",\"models\":[]}", sendGetRequest(t, baseUrl+"models", http.StatusOK))
+	assert.Equal(t, "{\"modelIds\":[\"BasicModel\"],\"nextPageToken\":\"QmFzaWNNb2RlbA\",\"models\":[{\"modelId\":\"BasicModel\",\"details\":\"Basic model\",\"canonicalHyperparameters\":\"batch-123\",\"labels\":{},\"card\":null,\"version\":\"1\"}]}",
+		sendGetRequest(t, baseUrl+"models?maxItems=1&view=FULL", http.StatusOK))
+	assert.Equal(t, "{\"modelIds\":[\"BasicModel\"],\"nextPageToken\":\"\",\"models\":[]}",
+		sendGetRequest(t, baseUrl+"models?filter=canonicalHyperparameters%3Dbatch-123", http.StatusOK))
+
+	// This is expected to fail. One needs to create model, hyperparameters and checkpoints in sequence.
+	assert.Equal(t, "Not Found\n",
+		postRequest(t, baseUrl+"models/GoodModel/hyperparameterId/batch-443",
+			map[string]interface{}{"model": map[string]string{
+				"modelId":                  "GoodModel",
+				"description":              "The best model",
+				"canonicalHyperparameters": "batch-443",
+			}}, http.StatusNotFound))
+	assert.Equal(t, "{\"modelIds\":[\"BasicModel\",\"MyModel\"],\"nextPageToken\":\"\",\"models\":[]}", sendGetRequest(t, baseUrl+"models", http.StatusOK))
+
+	// Let's try emulating a real flow
+	assert.Equal(t, "{\"resourcePath\":\"/models/MyModel/hyperparameters/HPSet1\"}",
+		postRequest(t, baseUrl+"models/MyModel/hyperparameters",
+			map[string]interface{}{
+				"hyperparametersId": "HPSet1",
+				"hyperparameters": map[string]string{
+					"param1": "value1",
 					"param2": "v2",
 				},
 			}, http.StatusOK))
 
-	assert.Equal(t, "{\"resourcePath\":\"/models/MyModel/hyperparameters/HPSet1/checkpoints/chkpt-1\"}",
+	assert.Equal(t, "{\"resourcePath\":\"/models/MyModel/hyperparameters/HPSet1/checkpoints/chkpt-1\",\"uploadUrl\":\"\",\"uploadUrlExpiresAt\":null}",
 		postRequest(t, baseUrl+"models/MyModel/hyperparameters/HPSet1/checkpoints",
 			map[string]interface{}{
 				"checkpointId": "chkpt-1",
@@ -1060,7 +3180,7 @@ func TestURLEndpoints(t *testing.T) {
 				},
 			}, http.StatusOK))
 
-	assert.Equal(t, "{\"resourcePath\":\"/models/MyModel/hyperparameters/HPSet2/checkpoints/hp2-ckpt1\"}",
+	assert.Equal(t, "{\"resourcePath\":\"/models/MyModel/hyperparameters/HPSet2/checkpoints/hp2-ckpt1\",\"uploadUrl\":\"\",\"uploadUrlExpiresAt\":null}",
 		postRequest(t, baseUrl+"models/MyModel/hyperparameters/HPSet2/checkpoints",
 			map[string]interface{}{
 				"checkpointId": "hp2-ckpt1",
@@ -1071,5 +3191,100 @@ func TestURLEndpoints(t *testing.T) {
 				"link": "https://example.com/h2c1.tiobundle.zip",
 			}, http.StatusOK))
 
+	// MyModel's canonical hyperparameters (batch-666) were never created.
+	sendGetRequest(t, baseUrl+"models/MyModel/resolve", http.StatusPreconditionFailed)
+	sendGetRequest(t, baseUrl+"models/InvalidModelName/resolve", http.StatusNotFound)
+	assert.Contains(t, sendGetRequest(t, baseUrl+"models/MyModel/hyperparameters/HPSet1/checkpoints/chkpt-1/upgrade", http.StatusOK),
+		"\"upgradeAvailable\":false")
+
+	// Tags stand in for IDs
+	assert.Contains(t, putRequest(t, baseUrl+"models/MyModel/tags/production", map[string]interface{}{"target": "HPSet1"}, http.StatusOK),
+		"\"target\":\"HPSet1\"")
+	assert.Contains(t, putRequest(t, baseUrl+"models/MyModel/hyperparameters/HPSet1/tags/production", map[string]interface{}{"target": "chkpt-1"}, http.StatusOK),
+		"\"target\":\"chkpt-1\"")
+	putRequest(t, baseUrl+"models/MyModel/hyperparameters/HPSet1/tags/staging", map[string]interface{}{"target": "chkpt-9"}, http.StatusPreconditionFailed)
+	assert.Contains(t, sendGetRequest(t, baseUrl+"models/MyModel/hyperparameters/@production/checkpoints/@production", http.StatusOK),
+		"\"checkpointId\":\"chkpt-1\"")
+	sendGetRequest(t, baseUrl+"models/MyModel/hyperparameters/HPSet1/checkpoints/@staging", http.StatusNotFound)
+	assert.Contains(t, sendGetRequest(t, baseUrl+"models/MyModel/hyperparameters/HPSet1/tags", http.StatusOK),
+		"\"tag\":\"production\"")
+	deleteRequest(t, baseUrl+"models/MyModel/hyperparameters/HPSet1/checkpoints/chkpt-1", http.StatusPreconditionFailed)
+	deleteRequest(t, baseUrl+"models/MyModel/hyperparameters/HPSet1/tags/production", http.StatusOK)
+
+	// Staged rollouts
+	putRequest(t, baseUrl+"models/MyModel/hyperparameters/HPSet1/rollout", map[string]interface{}{
+		"rollout": []map[string]interface{}{{"checkpointId": "chkpt-1", "weight": 101}},
+	}, http.StatusBadRequest)
+	assert.Contains(t, putRequest(t, baseUrl+"models/MyModel/hyperparameters/HPSet1/rollout", map[string]interface{}{
+		"rollout": []map[string]interface{}{{"checkpointId": "chkpt-1", "weight": 100}},
+	}, http.StatusOK), "\"rollout\":[{\"checkpointId\":\"chkpt-1\",\"weight\":100}]")
+	sendGetRequest(t, baseUrl+"models/MyModel/clients/device-1/resolve", http.StatusPreconditionFailed)
+	deleteRequest(t, baseUrl+"models/MyModel/hyperparameters/HPSet1/checkpoints/chkpt-1", http.StatusPreconditionFailed)
+	assert.Contains(t, deleteRequest(t, baseUrl+"models/MyModel/hyperparameters/HPSet1/rollout", http.StatusOK),
+		"\"rollout\":[]")
+
+	assert.Contains(t, sendGetRequest(t, baseUrl+"models/MyModel/hyperparameters/HPSet1/compare?last=5&bestBy=accuracy", http.StatusOK),
+		"\"bestCheckpointId\":\"chkpt-1\"")
+	assert.Contains(t, sendGetRequest(t, baseUrl+"models/MyModel/hyperparameters/HPSet1/compare?checkpointIds=chkpt-1&checkpointIds=chkpt-1", http.StatusOK),
+		"\"values\":{\"accuracy\":0.93}")
+	sendGetRequest(t, baseUrl+"models/MyModel/hyperparameters/HPSet1/compare", http.StatusBadRequest)
+
+	// Deleting refuses to orphan children unless asked to cascade.
+	deleteRequest(t, baseUrl+"models/MyModel/hyperparameters/HPSet2", http.StatusPreconditionFailed)
+	assert.Equal(t, "{\"resourcePath\":\"/models/MyModel/hyperparameters/HPSet2\"}",
+		deleteRequest(t, baseUrl+"models/MyModel/hyperparameters/HPSet2?cascade=true", http.StatusOK))
+	assert.Equal(t, "{\"resourcePath\":\"/models/MyModel/hyperparameters/HPSet1/checkpoints/chkpt-1\"}",
+		deleteRequest(t, baseUrl+"models/MyModel/hyperparameters/HPSet1/checkpoints/chkpt-1", http.StatusOK))
+	deleteRequest(t, baseUrl+"models/MyModel/hyperparameters/HPSet1/checkpoints/chkpt-1", http.StatusNotFound)
+	assert.Equal(t, "{\"modelId\":\"MyModel\",\"hyperparametersIds\":[\"HPSet1\"],\"nextPageToken\":\"\",\"hyperparameters\":[]}",
+		sendGetRequest(t, baseUrl+"models/MyModel/hyperparameters", http.StatusOK))
+
+	// Changes made through the gateway are in the audit log.
+	auditLog := sendGetRequest(t, baseUrl+"audit?resourcePath=/models/MyModel/hyperparameters/HPSet2&since=2019-10-01T00:00:00Z", http.StatusOK)
+	assert.Contains(t, auditLog, "\"method\":\"/api.Repository/DeleteHyperparameters\"")
+	// The fake authenticator lets requests without a token through, so actors are bare token types.
+	assert.Contains(t, auditLog, "\"actor\":\"ModelsAdmin\"")
+	assert.NotContains(t, auditLog, "/models/MyModel/hyperparameters/HPSet1")
+	sendGetRequest(t, baseUrl+"audit?since=yesterday", http.StatusBadRequest)
+
+	assert.Contains(t, sendGetRequest(t, baseUrl+"models/MyModel/hyperparameters/HPSet1/revisions", http.StatusOK),
+		"\"hyperparametersId\":\"HPSet1\"")
+	assert.Contains(t, sendGetRequest(t, baseUrl+"models/MyModel/revisions/1", http.StatusOK),
+		"\"version\":\"1\"")
+	sendGetRequest(t, baseUrl+"models/MyModel/revisions/9", http.StatusNotFound)
+	assert.Contains(t, postRequest(t, baseUrl+"models/MyModel/revisions/1/rollback", map[string]interface{}{}, http.StatusOK),
+		"\"version\":\"2\"")
+
+	postRequest(t, baseUrl+"webhooks", map[string]interface{}{
+		"url":        "http://127.0.0.1:1/hook",
+		"eventTypes": []string{"Rollback"},
+	}, http.StatusBadRequest)
+	assert.Contains(t, postRequest(t, baseUrl+"webhooks", map[string]interface{}{
+		"url":        "http://127.0.0.1:1/hook",
+		"eventTypes": []string{"Rollback"},
+		"secret":     "webhook-secret",
+	}, http.StatusOK), "\"url\":\"http://127.0.0.1:1/hook\"")
+	webhooks := sendGetRequest(t, baseUrl+"webhooks", http.StatusOK)
+	assert.Contains(t, webhooks, "\"eventTypes\":[\"Rollback\"]")
+	assert.NotContains(t, webhooks, "webhook-secret")
+	deleteRequest(t, baseUrl+"webhooks/InvalidWebhookId", http.StatusNotFound)
+	sendGetRequest(t, baseUrl+"webhook-dead-letters", http.StatusOK)
+
+	sendGetRequest(t, baseUrl+"models/InvalidModelName/watch", http.StatusNotFound)
+	watchRequest, err := http.NewRequest(http.MethodGet, baseUrl+"models/MyModel/watch?resumeToken="+url.QueryEscape(watchResumeToken), nil)
+	assert.NoError(t, err)
+	watchRequest.Header.Set("Accept", "text/event-stream")
+	watchResponse, err := http.DefaultClient.Do(watchRequest)
+	if assert.NoError(t, err) {
+		assert.Equal(t, http.StatusOK, watchResponse.StatusCode)
+		assert.Equal(t, "text/event-stream", watchResponse.Header.Get("Content-Type"))
+		watchEvent, err := bufio.NewReader(watchResponse.Body).ReadString('\n')
+		assert.NoError(t, err)
+		assert.True(t, strings.HasPrefix(watchEvent, "data: {\"result\":{"), watchEvent)
+		assert.Contains(t, watchEvent, "\"method\":\"/api.Repository/CreateModel\"")
+		assert.Contains(t, watchEvent, "\"type\":\"CREATED\"")
+		watchResponse.Body.Close()
+	}
+
 	stopRequestChannel <- "Test Complete"
 }
@@ -0,0 +1,27 @@
+package signedURL
+
+import (
+	"path/filepath"
+	"time"
+)
+
+type localURLSigner struct {
+	dir string
+}
+
+// NewLocalURLSigner - returns a URLSigner which hands out file:// URLs for the files under dir. The
+// URLs are not actually signed, so this is only meant for backends which keep everything on the
+// local machine.
+func NewLocalURLSigner(dir string) URLSigner {
+	return localURLSigner{dir: dir}
+}
+
+// GetSignedURL - returns the file:// URL of filePath under the signer's directory. The method,
+// expiration and content type are ignored.
+func (signer localURLSigner) GetSignedURL(method string, filePath string, expires time.Time, contentType string) (string, error) {
+	dir, err := filepath.Abs(signer.dir)
+	if err != nil {
+		return "", err
+	}
+	return "file://" + filepath.ToSlash(filepath.Join(dir, filepath.FromSlash(filePath))), nil
+}
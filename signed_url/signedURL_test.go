@@ -40,3 +40,10 @@ func Test_URLSigning(t *testing.T) {
 	assert.NoError(t, err)
 	fmt.Println("curl \"" + url + "\"")
 }
+
+func Test_LocalURLSigning(t *testing.T) {
+	signer := NewLocalURLSigner("/tmp/uploads")
+	url, err := signer.GetSignedURL("PUT", "bundles/model.zip", time.Now().Add(time.Minute), "application/zip")
+	assert.NoError(t, err)
+	assert.Equal(t, "file:///tmp/uploads/bundles/model.zip", url)
+}
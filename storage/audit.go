@@ -0,0 +1,147 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// auditEventIdTimeFormat - the timestamp at the start of audit event IDs. It has a fixed width, so
+// that IDs sort chronologically.
+const auditEventIdTimeFormat = "20060102T150405.000000000Z"
+
+// AuditEvent - a record of a change made through the repository or FLEA API. Audit events are
+// append-only: once added, they are never changed or deleted.
+type AuditEvent struct {
+	// EventId - see NewAuditEventId.
+	EventId string
+	Time    time.Time
+	// Actor - who made the change, as identified by authentication.ActorFromContext.
+	Actor string
+	// Method - the full name of the gRPC method, e.g. /api.Repository/UpdateModel.
+	Method string
+	// ResourcePath - the resource which was changed, e.g. /models/faces/hyperparameters/hp-1.
+	ResourcePath string
+	// Request, Before and After - JSON encodings of the request, and of the resource before and
+	// after the change. Before is empty for resources which were created, After for resources
+	// which were deleted.
+	Request string
+	Before  string
+	After   string
+}
+
+// NewAuditEventId - returns a unique ID for an event which happened at t. IDs start with the time
+// of the event, so listing events in the order of their IDs lists them chronologically.
+func NewAuditEventId(t time.Time) string {
+	return t.UTC().Format(auditEventIdTimeFormat) + "-" + uuid.New().String()
+}
+
+// AuditEventIdTime - returns the time at the start of an ID returned by NewAuditEventId.
+func AuditEventIdTime(eventId string) (time.Time, error) {
+	if len(eventId) < len(auditEventIdTimeFormat) {
+		return time.Time{}, fmt.Errorf("Invalid audit event ID (%s)", eventId)
+	}
+	return time.Parse(auditEventIdTimeFormat, eventId[:len(auditEventIdTimeFormat)])
+}
+
+// auditPartitionFormat - the hour at the start of audit event IDs, see AuditPartition.
+const auditPartitionFormat = "20060102T15"
+
+// auditPartitionWalk - how old the partition of a marker may be for RecentAuditPartitions.
+const auditPartitionWalk = 24 * time.Hour
+
+// AuditPartition - backends which keep the audit log in a bucket or directory partition it by the
+// hour at the start of event IDs, so that listing the events after a marker does not have to list
+// the whole log. Returns the partition which the event with the given ID is in, or which listing
+// from the given marker starts at.
+func AuditPartition(eventId string) string {
+	if len(eventId) > len(auditPartitionFormat) {
+		return eventId[:len(auditPartitionFormat)]
+	}
+	return eventId
+}
+
+// RecentAuditPartitions - the partitions which may hold events after a marker up to a day old: the
+// hours from the partition of the marker to an hour from now, which leaves an hour for servers
+// whose clocks are ahead. This keeps the frequent listings of recent events, e.g. by WatchModel, to
+// a request per partition. ok is false for other markers, from which backends have to list the
+// partitions which exist instead.
+func RecentAuditPartitions(marker string, now time.Time) (partitions []string, ok bool) {
+	start, err := time.Parse(auditPartitionFormat, AuditPartition(marker))
+	now = now.UTC()
+	if err != nil || now.Sub(start) > auditPartitionWalk {
+		return nil, false
+	}
+	for hour := start; !hour.After(now.Add(time.Hour)); hour = hour.Add(time.Hour) {
+		partitions = append(partitions, hour.Format(auditPartitionFormat))
+	}
+	return partitions, true
+}
+
+// AuditStorage - the append-only audit log of a backend.
+type AuditStorage interface {
+	AddAuditEvent(ctx context.Context, event AuditEvent) error
+	// ListAuditEvents - returns up to maxItems events whose IDs come after marker, in the order of
+	// their IDs.
+	ListAuditEvents(ctx context.Context, marker string, maxItems int) ([]AuditEvent, error)
+}
+
+// AuditQuery - selects audit events in ListMatchingAuditEvents. Zero fields select every event.
+type AuditQuery struct {
+	// ResourcePath - only select events for this resource and the resources below it.
+	ResourcePath string
+	// Since and Until - only select events which happened at or after Since, and before Until.
+	Since time.Time
+	Until time.Time
+}
+
+// Matches - whether the query selects the given event.
+func (query AuditQuery) Matches(event AuditEvent) bool {
+	if !query.Since.IsZero() && event.Time.Before(query.Since) {
+		return false
+	}
+	if !query.Until.IsZero() && !event.Time.Before(query.Until) {
+		return false
+	}
+	resourcePath := strings.TrimSuffix(query.ResourcePath, "/")
+	return resourcePath == "" || event.ResourcePath == resourcePath || strings.HasPrefix(event.ResourcePath, resourcePath+"/")
+}
+
+// ListMatchingAuditEvents - pages through the audit log from marker and returns up to maxItems
+// events which the query selects, in chronological order, along with the marker to continue from
+// (or "" if there are no more events). Listing starts at query.Since and stops at query.Until.
+func ListMatchingAuditEvents(ctx context.Context, store AuditStorage, query AuditQuery, marker string, maxItems int) ([]AuditEvent, string, error) {
+	const pageSize = 100
+	if !query.Since.IsZero() {
+		// Every ID of an event at or after Since sorts after the bare timestamp.
+		if start := query.Since.UTC().Format(auditEventIdTimeFormat); start > marker {
+			marker = start
+		}
+	}
+	res := make([]AuditEvent, 0)
+	for {
+		page, err := store.ListAuditEvents(ctx, marker, pageSize)
+		if err != nil {
+			return nil, "", err
+		}
+		for _, event := range page {
+			if !query.Until.IsZero() && !event.Time.Before(query.Until) {
+				return res, "", nil
+			}
+			if !query.Matches(event) {
+				continue
+			}
+			res = append(res, event)
+			if len(res) == maxItems {
+				return res, event.EventId, nil
+			}
+		}
+		if len(page) < pageSize {
+			return res, "", nil
+		}
+		marker = page[len(page)-1].EventId
+	}
+}
@@ -0,0 +1,37 @@
+package storage
+
+import (
+	"sync"
+)
+
+// BatchGet - calls get(i) for every i in [0, n), running up to concurrency calls at a time, and
+// returns the first error that any of them returned. This is meant for backends which have to make
+// one request per object, so that listing resources in full does not take one round trip each.
+func BatchGet(n, concurrency int, get func(i int) error) error {
+	if concurrency < 1 {
+		concurrency = 1
+	}
+
+	var wg sync.WaitGroup
+	var once sync.Once
+	var firstErr error
+	slots := make(chan struct{}, concurrency)
+	for i := 0; i < n; i++ {
+		slots <- struct{}{}
+		wg.Add(1)
+		go func(i int) {
+			defer func() {
+				<-slots
+				wg.Done()
+			}()
+			if err := get(i); err != nil {
+				once.Do(func() {
+					firstErr = err
+				})
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	return firstErr
+}
@@ -0,0 +1,59 @@
+package boltdb
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/doc-ai/tensorio-models/storage"
+	bolt "go.etcd.io/bbolt"
+)
+
+// Audit events are kept in the audit bucket, keyed by event ID.
+var auditBucket = []byte("audit")
+
+// auditLog - the bolt implementation of storage.AuditStorage, shared by the repository and FLEA
+// storage.
+type auditLog struct {
+	auditDB *bolt.DB
+}
+
+// createAuditBucket - creates the audit bucket if the database does not have one yet.
+func createAuditBucket(tx *bolt.Tx) error {
+	_, err := tx.CreateBucketIfNotExists(auditBucket)
+	return err
+}
+
+func (store auditLog) AddAuditEvent(ctx context.Context, event storage.AuditEvent) error {
+	bytes, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+	return store.auditDB.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(auditBucket).Put([]byte(event.EventId), bytes)
+	})
+}
+
+func (store auditLog) ListAuditEvents(ctx context.Context, marker string, maxItems int) ([]storage.AuditEvent, error) {
+	res := make([]storage.AuditEvent, 0)
+	err := store.auditDB.View(func(tx *bolt.Tx) error {
+		cursor := tx.Bucket(auditBucket).Cursor()
+		k, v := cursor.Seek([]byte(marker))
+		if k != nil && string(k) == marker {
+			k, v = cursor.Next()
+		}
+		for ; k != nil && len(res) < maxItems; k, v = cursor.Next() {
+			event := storage.AuditEvent{}
+			err := json.Unmarshal(v, &event)
+			if err != nil {
+				return err
+			}
+			res = append(res, event)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
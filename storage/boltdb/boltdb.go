@@ -0,0 +1,799 @@
+package boltdb
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/doc-ai/tensorio-models/api"
+	"github.com/doc-ai/tensorio-models/common"
+	"github.com/doc-ai/tensorio-models/storage"
+	bolt "go.etcd.io/bbolt"
+)
+
+const (
+	StorageType string = "BOLTDB"
+)
+
+// The database mirrors the object layout used by the GCS backend using nested buckets:
+//
+//	models/<modelId>/model
+//	models/<modelId>/revisions/<version>
+//	models/<modelId>/hyperparameters/<hyperparametersId>/params
+//	models/<modelId>/hyperparameters/<hyperparametersId>/revisions/<version>
+//	models/<modelId>/hyperparameters/<hyperparametersId>/checkpoints/<checkpointId>
+//
+// Tags have buckets of their own within these, see tags.go.
+//
+// Since bolt keeps keys sorted, listing is a cursor seek to the marker.
+var (
+	modelsBucket          = []byte("models")
+	hyperparametersBucket = []byte("hyperparameters")
+	checkpointsBucket     = []byte("checkpoints")
+	revisionsBucket       = []byte("revisions")
+	modelKey              = []byte("model")
+	paramsKey             = []byte("params")
+)
+
+type boltStorage struct {
+	auditLog
+	webhookStore
+	db *bolt.DB
+}
+
+// OpenDB - opens (creating if necessary) the bolt database at the given path. Bolt holds an
+// exclusive lock on the file, so only one process may open it at a time.
+func OpenDB(path string) (*bolt.DB, error) {
+	return bolt.Open(path, 0600, &bolt.Options{Timeout: 5 * time.Second})
+}
+
+// GenerateNewBoltStorageFromEnv - Uses the REPOSITORY_BOLTDB_PATH environment variable to
+// instantiate an embedded database backend for tensorio-models repository
+func GenerateNewBoltStorageFromEnv() storage.RepositoryStorage {
+	path := os.Getenv("REPOSITORY_BOLTDB_PATH")
+	if path == "" {
+		err := errors.New("REPOSITORY_BOLTDB_PATH environment variable not defined")
+		panic(err)
+	}
+
+	db, err := OpenDB(path)
+	if err != nil {
+		panic(err)
+	}
+
+	store, err := NewBoltStorage(db)
+	if err != nil {
+		panic(err)
+	}
+	return store
+}
+
+// NewBoltStorage - Creates an instance of storage.RepositoryStorage interface backed by the given
+// bolt database. Every operation runs in a single transaction, so creates are free of races.
+func NewBoltStorage(db *bolt.DB) (storage.RepositoryStorage, error) {
+	err := db.Update(func(tx *bolt.Tx) error {
+		_, err := tx.CreateBucketIfNotExists(modelsBucket)
+		if err != nil {
+			return err
+		}
+		err = createAuditBucket(tx)
+		if err != nil {
+			return err
+		}
+		return createWebhookBuckets(tx)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &boltStorage{auditLog: auditLog{auditDB: db}, webhookStore: webhookStore{webhooksDB: db}, db: db}, nil
+}
+
+func (store boltStorage) GetStorageType() string {
+	return StorageType
+}
+
+func (store boltStorage) GetBucketName() string {
+	return ""
+}
+
+func (store boltStorage) ListModels(ctx context.Context, marker string, maxItems int) (storage.ListResult, error) {
+	var res []string
+	err := store.db.View(func(tx *bolt.Tx) error {
+		var err error
+		res, err = listKeys(tx.Bucket(modelsBucket), marker, maxItems+1, nil)
+		return err
+	})
+	if err != nil {
+		return storage.ListResult{}, err
+	}
+
+	return storage.NewListResult(res, maxItems), nil
+}
+
+func (store boltStorage) GetModel(ctx context.Context, modelId string) (storage.Model, error) {
+	model := storage.Model{}
+	err := store.db.View(func(tx *bolt.Tx) error {
+		modelBucket, err := getModelBucket(tx, modelId)
+		if err != nil {
+			return err
+		}
+		return json.Unmarshal(modelBucket.Get(modelKey), &model)
+	})
+	if err != nil {
+		return storage.Model{}, err
+	}
+
+	return model, nil
+}
+
+func (store boltStorage) BatchGetModels(ctx context.Context, modelIds []string) ([]storage.Model, error) {
+	models := make([]storage.Model, len(modelIds))
+	err := store.db.View(func(tx *bolt.Tx) error {
+		for i, modelId := range modelIds {
+			modelBucket, err := getModelBucket(tx, modelId)
+			if err != nil {
+				return err
+			}
+			err = json.Unmarshal(modelBucket.Get(modelKey), &models[i])
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return models, nil
+}
+
+func (store boltStorage) AddModel(ctx context.Context, model storage.Model) error {
+	if !common.IsValidID(model.ModelId) {
+		return storage.ErrInvalidModelId
+	}
+
+	model.Version = 1
+	bytes, err := json.Marshal(model)
+	if err != nil {
+		return err
+	}
+
+	return store.db.Update(func(tx *bolt.Tx) error {
+		modelBucket, err := tx.Bucket(modelsBucket).CreateBucket([]byte(model.ModelId))
+		if err == bolt.ErrBucketExists {
+			return storage.ModelExistsError
+		}
+		if err != nil {
+			return err
+		}
+
+		_, err = modelBucket.CreateBucket(hyperparametersBucket)
+		if err != nil {
+			return err
+		}
+		err = modelBucket.Put(modelKey, bytes)
+		if err != nil {
+			return err
+		}
+		return putModelRevision(modelBucket, model)
+	})
+}
+
+func (store boltStorage) UpdateModel(ctx context.Context, model storage.Model) (storage.Model, error) {
+	return store.updateModel(ctx, model, false)
+}
+
+func (store boltStorage) ReplaceModel(ctx context.Context, model storage.Model) (storage.Model, error) {
+	return store.updateModel(ctx, model, true)
+}
+
+// updateModel - merges model into the stored model as UpdateModel does or, if replace is
+// set, replaces it as ReplaceModel does.
+func (store boltStorage) updateModel(ctx context.Context, model storage.Model, replace bool) (storage.Model, error) {
+	storedModel := storage.Model{}
+	err := store.db.Update(func(tx *bolt.Tx) error {
+		modelBucket, err := getModelBucket(tx, model.ModelId)
+		if err != nil {
+			return err
+		}
+
+		err = json.Unmarshal(modelBucket.Get(modelKey), &storedModel)
+		if err != nil {
+			return err
+		}
+
+		err = storage.CheckVersion(storedModel.Version, model.Version)
+		if err != nil {
+			return err
+		}
+
+		canonicalHyperparameters := strings.TrimSpace(model.CanonicalHyperparameters)
+		if canonicalHyperparameters != "" && canonicalHyperparameters != storedModel.CanonicalHyperparameters {
+			if modelBucket.Bucket(hyperparametersBucket).Bucket([]byte(canonicalHyperparameters)) == nil {
+				return storage.ErrCanonicalHyperparametersDoesNotExist
+			}
+		}
+		if replace {
+			storedModel = storage.ReplacedModel(storedModel, model)
+		} else {
+			if strings.TrimSpace(model.CanonicalHyperparameters) != "" {
+				storedModel.CanonicalHyperparameters = model.CanonicalHyperparameters
+			}
+			if strings.TrimSpace(model.Details) != "" {
+				storedModel.Details = model.Details
+			}
+			if model.Labels != nil {
+				storedModel.Labels = model.Labels
+			}
+			if model.Card != nil {
+				storedModel.Card = model.Card
+			}
+		}
+
+		storedModel.Version++
+		bytes, err := json.Marshal(storedModel)
+		if err != nil {
+			return err
+		}
+		err = modelBucket.Put(modelKey, bytes)
+		if err != nil {
+			return err
+		}
+		return putModelRevision(modelBucket, storedModel)
+	})
+	if err != nil {
+		return storage.Model{}, err
+	}
+
+	return storedModel, nil
+}
+
+func (store boltStorage) ListHyperparameters(ctx context.Context, modelId, marker string, maxItems int) (storage.ListResult, error) {
+	var res []string
+	err := store.db.View(func(tx *bolt.Tx) error {
+		modelBucket, err := getModelBucket(tx, modelId)
+		if err != nil {
+			return err
+		}
+		res, err = listKeys(modelBucket.Bucket(hyperparametersBucket), marker, maxItems+1, nil)
+		return err
+	})
+	if err != nil {
+		return storage.ListResult{}, err
+	}
+
+	return storage.NewListResult(res, maxItems), nil
+}
+
+func (store boltStorage) GetHyperparameters(ctx context.Context, modelId string, hyperparametersId string) (storage.Hyperparameters, error) {
+	hyperparameters := storage.Hyperparameters{}
+	err := store.db.View(func(tx *bolt.Tx) error {
+		hpBucket, err := getHyperparametersBucket(tx, modelId, hyperparametersId)
+		if err != nil {
+			return err
+		}
+		return json.Unmarshal(hpBucket.Get(paramsKey), &hyperparameters)
+	})
+	if err != nil {
+		return storage.Hyperparameters{}, err
+	}
+
+	return hyperparameters, nil
+}
+
+func (store boltStorage) BatchGetHyperparameters(ctx context.Context, modelId string, hyperparametersIds []string) ([]storage.Hyperparameters, error) {
+	res := make([]storage.Hyperparameters, len(hyperparametersIds))
+	err := store.db.View(func(tx *bolt.Tx) error {
+		if _, err := getModelBucket(tx, modelId); err != nil {
+			return err
+		}
+		for i, hyperparametersId := range hyperparametersIds {
+			hpBucket, err := getHyperparametersBucket(tx, modelId, hyperparametersId)
+			if err != nil {
+				return err
+			}
+			err = json.Unmarshal(hpBucket.Get(paramsKey), &res[i])
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (store boltStorage) AddHyperparameters(ctx context.Context, hyperparameters storage.Hyperparameters) error {
+	hyperparameters.Version = 1
+	bytes, err := json.Marshal(hyperparameters)
+	if err != nil {
+		return err
+	}
+
+	return store.db.Update(func(tx *bolt.Tx) error {
+		modelBucket, err := getModelBucket(tx, hyperparameters.ModelId)
+		if err != nil {
+			return err
+		}
+
+		if !common.IsValidID(hyperparameters.HyperparametersId) {
+			return storage.ErrInvalidHyperparametersId
+		}
+
+		hpBucket, err := modelBucket.Bucket(hyperparametersBucket).CreateBucket([]byte(hyperparameters.HyperparametersId))
+		if err == bolt.ErrBucketExists {
+			return storage.HyperparametersExistsError
+		}
+		if err != nil {
+			return err
+		}
+
+		_, err = hpBucket.CreateBucket(checkpointsBucket)
+		if err != nil {
+			return err
+		}
+		err = hpBucket.Put(paramsKey, bytes)
+		if err != nil {
+			return err
+		}
+		return putHyperparametersRevision(hpBucket, hyperparameters)
+	})
+}
+
+func (store boltStorage) UpdateHyperparameters(ctx context.Context, hyperparameters storage.Hyperparameters) (storage.Hyperparameters, error) {
+	return store.updateHyperparameters(ctx, hyperparameters, false)
+}
+
+func (store boltStorage) ReplaceHyperparameters(ctx context.Context, hyperparameters storage.Hyperparameters) (storage.Hyperparameters, error) {
+	return store.updateHyperparameters(ctx, hyperparameters, true)
+}
+
+// updateHyperparameters - merges hyperparameters into the stored hyperparameters as
+// UpdateHyperparameters does or, if replace is set, replaces them as ReplaceHyperparameters does.
+func (store boltStorage) updateHyperparameters(ctx context.Context, hyperparameters storage.Hyperparameters, replace bool) (storage.Hyperparameters, error) {
+	storedHyperparameters := storage.Hyperparameters{}
+	err := store.db.Update(func(tx *bolt.Tx) error {
+		hpBucket, err := getHyperparametersBucket(tx, hyperparameters.ModelId, hyperparameters.HyperparametersId)
+		if err != nil {
+			return err
+		}
+
+		err = json.Unmarshal(hpBucket.Get(paramsKey), &storedHyperparameters)
+		if err != nil {
+			return err
+		}
+
+		err = storage.CheckVersion(storedHyperparameters.Version, hyperparameters.Version)
+		if err != nil {
+			return err
+		}
+
+		// The same checks as storage.CheckHyperparametersReferences, made within the transaction.
+		canonicalCheckpoint := strings.TrimSpace(hyperparameters.CanonicalCheckpoint)
+		if canonicalCheckpoint != "" && canonicalCheckpoint != storedHyperparameters.CanonicalCheckpoint {
+			if hpBucket.Bucket(checkpointsBucket).Get([]byte(canonicalCheckpoint)) == nil {
+				return storage.ErrCanonicalCheckpointDoesNotExist
+			}
+		}
+		upgradeTo := strings.TrimSpace(hyperparameters.UpgradeTo)
+		if upgradeTo != "" && upgradeTo != storedHyperparameters.UpgradeTo {
+			modelBucket, err := getModelBucket(tx, hyperparameters.ModelId)
+			if err != nil {
+				return err
+			}
+			if modelBucket.Bucket(hyperparametersBucket).Bucket([]byte(upgradeTo)) == nil {
+				return storage.ErrUpgradeToDoesNotExist
+			}
+		}
+		for _, target := range hyperparameters.Rollout {
+			if storage.IsInRollout(storedHyperparameters.Rollout, target.CheckpointId) {
+				continue
+			}
+			if hpBucket.Bucket(checkpointsBucket).Get([]byte(target.CheckpointId)) == nil {
+				return storage.ErrRolloutTargetDoesNotExist
+			}
+		}
+
+		if replace {
+			storedHyperparameters = storage.ReplacedHyperparameters(storedHyperparameters, hyperparameters)
+		} else {
+			if strings.TrimSpace(hyperparameters.CanonicalCheckpoint) != "" {
+				storedHyperparameters.CanonicalCheckpoint = hyperparameters.CanonicalCheckpoint
+			}
+			if strings.TrimSpace(hyperparameters.UpgradeTo) != "" {
+				storedHyperparameters.UpgradeTo = hyperparameters.UpgradeTo
+			}
+			if hyperparameters.Labels != nil {
+				storedHyperparameters.Labels = hyperparameters.Labels
+			}
+			if hyperparameters.Rollout != nil {
+				storedHyperparameters.Rollout = hyperparameters.Rollout
+			}
+
+			if hyperparameters.Hyperparameters != nil {
+				if storedHyperparameters.Hyperparameters == nil {
+					storedHyperparameters.Hyperparameters = make(map[string]string)
+				}
+				for k, v := range hyperparameters.Hyperparameters {
+					storedHyperparameters.Hyperparameters[k] = v
+				}
+			}
+		}
+
+		storedHyperparameters.Version++
+		bytes, err := json.Marshal(storedHyperparameters)
+		if err != nil {
+			return err
+		}
+		err = hpBucket.Put(paramsKey, bytes)
+		if err != nil {
+			return err
+		}
+		return putHyperparametersRevision(hpBucket, storedHyperparameters)
+	})
+	if err != nil {
+		return storage.Hyperparameters{}, err
+	}
+
+	return storedHyperparameters, nil
+}
+
+func (store boltStorage) ListCheckpoints(ctx context.Context, modelId, hyperparametersId, marker string, maxItems int, includeArchived bool) (storage.ListResult, error) {
+	keep := func(value []byte) (bool, error) {
+		checkpoint := storage.Checkpoint{}
+		err := json.Unmarshal(value, &checkpoint)
+		return storage.IsListedCheckpoint(checkpoint.State, includeArchived), err
+	}
+
+	var res []string
+	err := store.db.View(func(tx *bolt.Tx) error {
+		hpBucket, err := getHyperparametersBucket(tx, modelId, hyperparametersId)
+		if err != nil {
+			return err
+		}
+		res, err = listKeys(hpBucket.Bucket(checkpointsBucket), marker, maxItems+1, keep)
+		return err
+	})
+	if err != nil {
+		return storage.ListResult{}, err
+	}
+
+	return storage.NewListResult(res, maxItems), nil
+}
+
+func (store boltStorage) GetCheckpoint(ctx context.Context, modelId, hyperparametersId, checkpointId string) (storage.Checkpoint, error) {
+	checkpoint := storage.Checkpoint{}
+	err := store.db.View(func(tx *bolt.Tx) error {
+		hpBucket, err := getHyperparametersBucket(tx, modelId, hyperparametersId)
+		if err != nil {
+			return err
+		}
+
+		bytes := hpBucket.Bucket(checkpointsBucket).Get([]byte(checkpointId))
+		if bytes == nil {
+			return storage.CheckpointDoesNotExistError
+		}
+		return json.Unmarshal(bytes, &checkpoint)
+	})
+	if err != nil {
+		return storage.Checkpoint{}, err
+	}
+
+	return checkpoint, nil
+}
+
+func (store boltStorage) BatchGetCheckpoints(ctx context.Context, modelId, hyperparametersId string, checkpointIds []string) ([]storage.Checkpoint, error) {
+	res := make([]storage.Checkpoint, len(checkpointIds))
+	err := store.db.View(func(tx *bolt.Tx) error {
+		hpBucket, err := getHyperparametersBucket(tx, modelId, hyperparametersId)
+		if err != nil {
+			return err
+		}
+
+		checkpoints := hpBucket.Bucket(checkpointsBucket)
+		for i, checkpointId := range checkpointIds {
+			bytes := checkpoints.Get([]byte(checkpointId))
+			if bytes == nil {
+				return storage.CheckpointDoesNotExistError
+			}
+			err = json.Unmarshal(bytes, &res[i])
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (store boltStorage) AddCheckpoint(ctx context.Context, checkpoint storage.Checkpoint) error {
+	bytes, err := json.Marshal(checkpoint)
+	if err != nil {
+		return err
+	}
+
+	return store.db.Update(func(tx *bolt.Tx) error {
+		hpBucket, err := getHyperparametersBucket(tx, checkpoint.ModelId, checkpoint.HyperparametersId)
+		if err != nil {
+			return err
+		}
+
+		if !common.IsValidID(checkpoint.CheckpointId) {
+			return storage.ErrInvalidCheckpointId
+		}
+
+		checkpoints := hpBucket.Bucket(checkpointsBucket)
+		key := []byte(checkpoint.CheckpointId)
+		if checkpoints.Get(key) != nil {
+			return storage.CheckpointExistsError
+		}
+		return checkpoints.Put(key, bytes)
+	})
+}
+
+func (store boltStorage) UpdateCheckpointState(ctx context.Context, modelId, hyperparametersId, checkpointId string, state api.CheckpointState) (storage.Checkpoint, error) {
+	checkpoint := storage.Checkpoint{}
+	err := store.db.Update(func(tx *bolt.Tx) error {
+		hpBucket, err := getHyperparametersBucket(tx, modelId, hyperparametersId)
+		if err != nil {
+			return err
+		}
+
+		checkpoints := hpBucket.Bucket(checkpointsBucket)
+		key := []byte(checkpointId)
+		bytes := checkpoints.Get(key)
+		if bytes == nil {
+			return storage.CheckpointDoesNotExistError
+		}
+		err = json.Unmarshal(bytes, &checkpoint)
+		if err != nil {
+			return err
+		}
+		checkpoint.State = state
+
+		bytes, err = json.Marshal(checkpoint)
+		if err != nil {
+			return err
+		}
+		return checkpoints.Put(key, bytes)
+	})
+	if err != nil {
+		return storage.Checkpoint{}, err
+	}
+
+	return checkpoint, nil
+}
+
+func (store boltStorage) UpdateCheckpointInfo(ctx context.Context, modelId, hyperparametersId, checkpointId string, info map[string]string) (storage.Checkpoint, error) {
+	checkpoint := storage.Checkpoint{}
+	err := store.db.Update(func(tx *bolt.Tx) error {
+		hpBucket, err := getHyperparametersBucket(tx, modelId, hyperparametersId)
+		if err != nil {
+			return err
+		}
+
+		checkpoints := hpBucket.Bucket(checkpointsBucket)
+		key := []byte(checkpointId)
+		bytes := checkpoints.Get(key)
+		if bytes == nil {
+			return storage.CheckpointDoesNotExistError
+		}
+		err = json.Unmarshal(bytes, &checkpoint)
+		if err != nil {
+			return err
+		}
+		checkpoint.Info = info
+
+		bytes, err = json.Marshal(checkpoint)
+		if err != nil {
+			return err
+		}
+		return checkpoints.Put(key, bytes)
+	})
+	if err != nil {
+		return storage.Checkpoint{}, err
+	}
+
+	return checkpoint, nil
+}
+
+func (store boltStorage) DeleteModel(ctx context.Context, modelId string, options storage.DeleteOptions) error {
+	err := store.db.Update(func(tx *bolt.Tx) error {
+		modelBucket, err := getModelBucket(tx, modelId)
+		if err != nil {
+			return err
+		}
+
+		if !options.Cascade && !isEmpty(modelBucket.Bucket(hyperparametersBucket)) {
+			return storage.ErrResourceHasChildren
+		}
+
+		return tx.Bucket(modelsBucket).DeleteBucket([]byte(modelId))
+	})
+	if err != nil {
+		return err
+	}
+	return storage.RemoveLocalBundles(filepath.Dir(store.db.Path()), storage.ModelBundlesPrefix(modelId))
+}
+
+func (store boltStorage) DeleteHyperparameters(ctx context.Context, modelId, hyperparametersId string, options storage.DeleteOptions) error {
+	err := store.db.Update(func(tx *bolt.Tx) error {
+		hpBucket, err := getHyperparametersBucket(tx, modelId, hyperparametersId)
+		if err != nil {
+			return err
+		}
+
+		if !options.Cascade && !isEmpty(hpBucket.Bucket(checkpointsBucket)) {
+			return storage.ErrResourceHasChildren
+		}
+
+		hyperparametersBuckets := tx.Bucket(modelsBucket).Bucket([]byte(modelId)).Bucket(hyperparametersBucket)
+		if !options.Force {
+			model := storage.Model{}
+			err = json.Unmarshal(tx.Bucket(modelsBucket).Bucket([]byte(modelId)).Get(modelKey), &model)
+			if err != nil {
+				return err
+			}
+
+			var siblings []storage.Hyperparameters
+			err = hyperparametersBuckets.ForEach(func(k, v []byte) error {
+				sibling := storage.Hyperparameters{}
+				err := json.Unmarshal(hyperparametersBuckets.Bucket(k).Get(paramsKey), &sibling)
+				siblings = append(siblings, sibling)
+				return err
+			})
+			if err != nil {
+				return err
+			}
+
+			if storage.IsHyperparametersReferenced(model, siblings, hyperparametersId) {
+				return storage.ErrResourceIsReferenced
+			}
+
+			tags, err := listTags(tx.Bucket(modelsBucket).Bucket([]byte(modelId)))
+			if err != nil {
+				return err
+			}
+			if storage.IsTagged(tags, hyperparametersId) {
+				return storage.ErrResourceIsReferenced
+			}
+		}
+
+		return hyperparametersBuckets.DeleteBucket([]byte(hyperparametersId))
+	})
+	if err != nil {
+		return err
+	}
+	return storage.RemoveLocalBundles(filepath.Dir(store.db.Path()), storage.HyperparametersBundlesPrefix(modelId, hyperparametersId))
+}
+
+func (store boltStorage) DeleteCheckpoint(ctx context.Context, modelId, hyperparametersId, checkpointId string, options storage.DeleteOptions) error {
+	err := store.db.Update(func(tx *bolt.Tx) error {
+		hpBucket, err := getHyperparametersBucket(tx, modelId, hyperparametersId)
+		if err != nil {
+			return err
+		}
+
+		checkpoints := hpBucket.Bucket(checkpointsBucket)
+		key := []byte(checkpointId)
+		if checkpoints.Get(key) == nil {
+			return storage.CheckpointDoesNotExistError
+		}
+
+		if !options.Force {
+			hyperparameters := storage.Hyperparameters{}
+			err = json.Unmarshal(hpBucket.Get(paramsKey), &hyperparameters)
+			if err != nil {
+				return err
+			}
+			if hyperparameters.CanonicalCheckpoint == checkpointId || storage.IsInRollout(hyperparameters.Rollout, checkpointId) {
+				return storage.ErrResourceIsReferenced
+			}
+
+			tags, err := listTags(hpBucket)
+			if err != nil {
+				return err
+			}
+			if storage.IsTagged(tags, checkpointId) {
+				return storage.ErrResourceIsReferenced
+			}
+		}
+
+		return checkpoints.Delete(key)
+	})
+	if err != nil {
+		return err
+	}
+	return storage.RemoveLocalBundles(filepath.Dir(store.db.Path()), storage.CheckpointBundlePath(modelId, hyperparametersId, checkpointId))
+}
+
+// CheckpointUploadURL - bundles are kept out of the database, in the directory containing it, so
+// the upload URL is a file:// URL.
+func (store boltStorage) CheckpointUploadURL(ctx context.Context, modelId, hyperparametersId, checkpointId string, expires time.Time) (string, string, error) {
+	return storage.LocalCheckpointUploadURL(filepath.Dir(store.db.Path()), modelId, hyperparametersId, checkpointId, expires)
+}
+
+func (store boltStorage) CheckpointBundleExists(ctx context.Context, modelId, hyperparametersId, checkpointId string) (bool, error) {
+	return storage.LocalCheckpointBundleExists(filepath.Dir(store.db.Path()), modelId, hyperparametersId, checkpointId)
+}
+
+// SignCheckpointLink - uploaded bundles are local files, so there is nothing to sign.
+func (store boltStorage) SignCheckpointLink(ctx context.Context, link string, expires time.Time) (string, error) {
+	return link, nil
+}
+
+func (store boltStorage) OpenCheckpointLink(ctx context.Context, link string) (io.ReadCloser, error) {
+	return storage.OpenLocalLink(filepath.Dir(store.db.Path()), link)
+}
+
+func getModelBucket(tx *bolt.Tx, modelId string) (*bolt.Bucket, error) {
+	if modelId == "" {
+		return nil, storage.ModelDoesNotExistError
+	}
+	modelBucket := tx.Bucket(modelsBucket).Bucket([]byte(modelId))
+	if modelBucket == nil {
+		return nil, storage.ModelDoesNotExistError
+	}
+	return modelBucket, nil
+}
+
+func getHyperparametersBucket(tx *bolt.Tx, modelId, hyperparametersId string) (*bolt.Bucket, error) {
+	modelBucket, err := getModelBucket(tx, modelId)
+	if err != nil {
+		return nil, err
+	}
+	if hyperparametersId == "" {
+		return nil, storage.HyperparametersDoesNotExistError
+	}
+	hpBucket := modelBucket.Bucket(hyperparametersBucket).Bucket([]byte(hyperparametersId))
+	if hpBucket == nil {
+		return nil, storage.HyperparametersDoesNotExistError
+	}
+	return hpBucket, nil
+}
+
+func isEmpty(bucket *bolt.Bucket) bool {
+	k, _ := bucket.Cursor().First()
+	return k == nil
+}
+
+// listKeys returns up to maxItems keys of bucket which come after marker, in lexicographic order.
+// listKeys - lists up to maxItems keys following marker. If keep is not nil, only the keys for
+// whose values it returns true are listed.
+func listKeys(bucket *bolt.Bucket, marker string, maxItems int, keep func(value []byte) (bool, error)) ([]string, error) {
+	res := make([]string, 0)
+	cursor := bucket.Cursor()
+
+	k, v := cursor.Seek([]byte(marker))
+	if k != nil && string(k) == marker {
+		k, v = cursor.Next()
+	}
+	for ; k != nil && len(res) < maxItems; k, v = cursor.Next() {
+		if keep != nil {
+			ok, err := keep(v)
+			if err != nil {
+				return nil, err
+			}
+			if !ok {
+				continue
+			}
+		}
+		res = append(res, string(k))
+	}
+
+	return res, nil
+}
@@ -0,0 +1,266 @@
+package boltdb_test
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/doc-ai/tensorio-models/api"
+	"github.com/doc-ai/tensorio-models/internal/tests"
+	"github.com/doc-ai/tensorio-models/storage"
+	"github.com/doc-ai/tensorio-models/storage/boltdb"
+	"github.com/stretchr/testify/assert"
+	bolt "go.etcd.io/bbolt"
+)
+
+func newTestDB(t *testing.T) (*bolt.DB, func()) {
+	dir, err := ioutil.TempDir("", "tensorio-models-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	db, err := boltdb.OpenDB(filepath.Join(dir, "test.db"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return db, func() {
+		db.Close()
+		os.RemoveAll(dir)
+	}
+}
+
+func newTestStorage(t *testing.T) (storage.RepositoryStorage, func()) {
+	db, cleanup := newTestDB(t)
+	store, err := boltdb.NewBoltStorage(db)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return store, cleanup
+}
+
+func TestBoltDB_AddModel(t *testing.T) {
+	store, cleanup := newTestStorage(t)
+	defer cleanup()
+	tests.Test_AddModel(t, store)
+}
+
+func TestBoltDB_ListModels(t *testing.T) {
+	store, cleanup := newTestStorage(t)
+	defer cleanup()
+	tests.Test_ListModels(t, store)
+}
+
+func TestBoltDB_UpdateModel(t *testing.T) {
+	store, cleanup := newTestStorage(t)
+	defer cleanup()
+	tests.Test_UpdateModels(t, store)
+}
+
+func TestBoltDB_AddHyperparameters(t *testing.T) {
+	store, cleanup := newTestStorage(t)
+	defer cleanup()
+	tests.Test_AddHyperparameters(t, store)
+}
+
+func TestBoltDB_ListHyperparameters(t *testing.T) {
+	store, cleanup := newTestStorage(t)
+	defer cleanup()
+	tests.Test_ListHyperparams(t, store)
+}
+
+func TestBoltDB_UpdateHyperparameters(t *testing.T) {
+	store, cleanup := newTestStorage(t)
+	defer cleanup()
+	tests.Test_UpdateHyperparams(t, store)
+}
+
+func TestBoltDB_UpdateVersions(t *testing.T) {
+	store, cleanup := newTestStorage(t)
+	defer cleanup()
+	tests.Test_UpdateVersions(t, store)
+}
+
+func TestBoltDB_ConcurrentUpdates(t *testing.T) {
+	store, cleanup := newTestStorage(t)
+	defer cleanup()
+	tests.Test_ConcurrentUpdates(t, store)
+}
+
+func TestBoltDB_Revisions(t *testing.T) {
+	store, cleanup := newTestStorage(t)
+	defer cleanup()
+	tests.Test_Revisions(t, store)
+}
+
+func TestBoltDB_Replace(t *testing.T) {
+	store, cleanup := newTestStorage(t)
+	defer cleanup()
+	tests.Test_Replace(t, store)
+}
+
+func TestBoltDB_AddCheckpoint(t *testing.T) {
+	store, cleanup := newTestStorage(t)
+	defer cleanup()
+	tests.Test_AddCheckpoint(t, store)
+}
+
+func TestBoltDB_ListCheckpoints(t *testing.T) {
+	store, cleanup := newTestStorage(t)
+	defer cleanup()
+	tests.Test_ListCheckpoints(t, store)
+}
+
+func TestBoltDB_UpdateCheckpointState(t *testing.T) {
+	store, cleanup := newTestStorage(t)
+	defer cleanup()
+	tests.Test_UpdateCheckpointState(t, store)
+}
+
+func TestBoltDB_DeleteModel(t *testing.T) {
+	store, cleanup := newTestStorage(t)
+	defer cleanup()
+	tests.Test_DeleteModel(t, store)
+}
+
+func TestBoltDB_DeleteHyperparameters(t *testing.T) {
+	store, cleanup := newTestStorage(t)
+	defer cleanup()
+	tests.Test_DeleteHyperparameters(t, store)
+}
+
+func TestBoltDB_DeleteCheckpoint(t *testing.T) {
+	store, cleanup := newTestStorage(t)
+	defer cleanup()
+	tests.Test_DeleteCheckpoint(t, store)
+}
+
+func TestBoltDB_Tags(t *testing.T) {
+	store, cleanup := newTestStorage(t)
+	defer cleanup()
+	tests.Test_Tags(t, store)
+}
+
+func TestBoltDB_Rollouts(t *testing.T) {
+	store, cleanup := newTestStorage(t)
+	defer cleanup()
+	tests.Test_Rollouts(t, store)
+}
+
+func TestBoltDB_FindDanglingReferences(t *testing.T) {
+	store, cleanup := newTestStorage(t)
+	defer cleanup()
+	tests.Test_FindDanglingReferences(t, store)
+}
+
+func TestBoltDB_BatchGet(t *testing.T) {
+	store, cleanup := newTestStorage(t)
+	defer cleanup()
+	tests.Test_BatchGet(t, store)
+}
+
+func TestBoltDB_Labels(t *testing.T) {
+	store, cleanup := newTestStorage(t)
+	defer cleanup()
+	tests.Test_Labels(t, store)
+}
+
+func TestBoltDB_AuditEvents(t *testing.T) {
+	store, cleanup := newTestStorage(t)
+	defer cleanup()
+	tests.Test_AuditEvents(t, store)
+
+	db, fleaCleanup := newTestDB(t)
+	defer fleaCleanup()
+	fleaStore, err := boltdb.NewFleaBoltStorage(db, "http://repository", "file:///uploads")
+	assert.NoError(t, err)
+	tests.Test_AuditEvents(t, fleaStore)
+}
+
+func TestBoltDB_Webhooks(t *testing.T) {
+	store, cleanup := newTestStorage(t)
+	defer cleanup()
+	tests.Test_Webhooks(t, store)
+
+	db, fleaCleanup := newTestDB(t)
+	defer fleaCleanup()
+	fleaStore, err := boltdb.NewFleaBoltStorage(db, "http://repository", "file:///uploads")
+	assert.NoError(t, err)
+	tests.Test_Webhooks(t, fleaStore)
+}
+
+func TestBoltDB_FleaTasks(t *testing.T) {
+	db, cleanup := newTestDB(t)
+	defer cleanup()
+	store, err := boltdb.NewFleaBoltStorage(db, "http://repository", "file:///uploads")
+	assert.NoError(t, err)
+	tests.Test_FleaTasks(t, store)
+}
+
+func TestBoltDB_CheckpointUpload(t *testing.T) {
+	store, cleanup := newTestStorage(t)
+	defer cleanup()
+	tests.Test_CheckpointUpload(t, store, tests.UploadToFileURL)
+}
+
+// runConcurrently calls create from n goroutines at once and returns the errors they produced.
+func runConcurrently(n int, create func(i int) error) []error {
+	errs := make([]error, n)
+	start := make(chan struct{})
+	var wg sync.WaitGroup
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			<-start
+			errs[i] = create(i)
+		}(i)
+	}
+	close(start)
+	wg.Wait()
+	return errs
+}
+
+func TestBoltDB_ConcurrentAddModel(t *testing.T) {
+	store, cleanup := newTestStorage(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	errs := runConcurrently(20, func(i int) error {
+		return store.AddModel(ctx, storage.Model{ModelId: "model", Details: fmt.Sprintf("writer-%d", i)})
+	})
+
+	succeeded := 0
+	for _, err := range errs {
+		if err == nil {
+			succeeded++
+		} else {
+			assert.Equal(t, storage.ModelExistsError, err)
+		}
+	}
+	assert.Equal(t, 1, succeeded)
+}
+
+func TestBoltDB_ConcurrentAddTask(t *testing.T) {
+	db, cleanup := newTestDB(t)
+	defer cleanup()
+	store, err := boltdb.NewFleaBoltStorage(db, "http://repository", "file:///uploads")
+	assert.NoError(t, err)
+
+	ctx := context.Background()
+	errs := runConcurrently(20, func(i int) error {
+		return store.AddTask(ctx, api.TaskDetails{TaskId: "task", ModelId: fmt.Sprintf("model-%d", i)})
+	})
+
+	succeeded := 0
+	for _, err := range errs {
+		if err == nil {
+			succeeded++
+		} else {
+			assert.Equal(t, storage.ErrDuplicateTaskId, err)
+		}
+	}
+	assert.Equal(t, 1, succeeded)
+}
@@ -0,0 +1,290 @@
+package boltdb
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/doc-ai/tensorio-models/api"
+	"github.com/doc-ai/tensorio-models/common"
+	"github.com/doc-ai/tensorio-models/storage"
+	"github.com/google/uuid"
+	bolt "go.etcd.io/bbolt"
+)
+
+// Tasks are stored as tasks/<taskId>/task, with the jobs started for each task kept under
+// tasks/<taskId>/jobs/<jobId>.
+var (
+	tasksBucket = []byte("tasks")
+	jobsBucket  = []byte("jobs")
+	taskKey     = []byte("task")
+)
+
+type flea struct {
+	auditLog
+	webhookStore
+	db                *bolt.DB
+	repositoryBaseURL string
+	uploadReqURL      string
+}
+
+// GenerateNewFleaBoltStorageFromEnv - Uses the FLEA_BOLTDB_PATH environment variable to
+// instantiate an embedded database backend for FLEA. Job uploads are directed to
+// FLEA_BOLTDB_UPLOAD_URL if it is set, and to the directory containing the database otherwise.
+func GenerateNewFleaBoltStorageFromEnv(repositoryBaseURL string) storage.FleaStorage {
+	path := os.Getenv("FLEA_BOLTDB_PATH")
+	if path == "" {
+		err := errors.New("FLEA_BOLTDB_PATH environment variable not defined")
+		panic(err)
+	}
+
+	uploadReqURL := os.Getenv("FLEA_BOLTDB_UPLOAD_URL")
+	if uploadReqURL == "" {
+		absPath, err := filepath.Abs(path)
+		if err != nil {
+			panic(err)
+		}
+		uploadReqURL = "file://" + filepath.ToSlash(filepath.Dir(absPath))
+	}
+
+	db, err := OpenDB(path)
+	if err != nil {
+		panic(err)
+	}
+
+	store, err := NewFleaBoltStorage(db, repositoryBaseURL, uploadReqURL)
+	if err != nil {
+		panic(err)
+	}
+	return store
+}
+
+// NewFleaBoltStorage - returns an embedded database implementation of FleaStorage interface.
+func NewFleaBoltStorage(db *bolt.DB, repositoryBaseURL, uploadReqURL string) (storage.FleaStorage, error) {
+	err := db.Update(func(tx *bolt.Tx) error {
+		_, err := tx.CreateBucketIfNotExists(tasksBucket)
+		if err != nil {
+			return err
+		}
+		err = createAuditBucket(tx)
+		if err != nil {
+			return err
+		}
+		return createWebhookBuckets(tx)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &flea{
+		auditLog:          auditLog{auditDB: db},
+		webhookStore:      webhookStore{webhooksDB: db},
+		db:                db,
+		repositoryBaseURL: repositoryBaseURL,
+		uploadReqURL:      uploadReqURL,
+	}, nil
+}
+
+func (store flea) GetStorageType() string {
+	return StorageType
+}
+
+func (store flea) GetBucketName() string {
+	return ""
+}
+
+func (store flea) AddTask(ctx context.Context, req api.TaskDetails) error {
+	if !common.IsValidID(req.TaskId) {
+		return storage.ErrInvalidTaskId
+	}
+
+	bytes, err := json.Marshal(req)
+	if err != nil {
+		return err
+	}
+
+	return store.db.Update(func(tx *bolt.Tx) error {
+		taskBucket, err := tx.Bucket(tasksBucket).CreateBucket([]byte(req.TaskId))
+		if err == bolt.ErrBucketExists {
+			return storage.ErrDuplicateTaskId
+		}
+		if err != nil {
+			return err
+		}
+
+		_, err = taskBucket.CreateBucket(jobsBucket)
+		if err != nil {
+			return err
+		}
+		return taskBucket.Put(taskKey, bytes)
+	})
+}
+
+func (store flea) GetTask(ctx context.Context, taskId string) (api.TaskDetails, error) {
+	task := api.TaskDetails{}
+	err := store.db.View(func(tx *bolt.Tx) error {
+		taskBucket, err := getTaskBucket(tx, taskId)
+		if err != nil {
+			return err
+		}
+		return json.Unmarshal(taskBucket.Get(taskKey), &task)
+	})
+	if err != nil {
+		return api.TaskDetails{}, err
+	}
+
+	task.CheckpointLink = store.repositoryBaseURL + common.GetCheckpointResourcePath(
+		task.ModelId, task.HyperparametersId, task.CheckpointId)
+	return task, nil
+}
+
+func (store flea) BatchGetTasks(ctx context.Context, taskIds []string) ([]api.TaskDetails, error) {
+	tasks := make([]api.TaskDetails, len(taskIds))
+	err := store.db.View(func(tx *bolt.Tx) error {
+		for i, taskId := range taskIds {
+			taskBucket, err := getTaskBucket(tx, taskId)
+			if err != nil {
+				return err
+			}
+			err = json.Unmarshal(taskBucket.Get(taskKey), &tasks[i])
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for i, task := range tasks {
+		tasks[i].CheckpointLink = store.repositoryBaseURL + common.GetCheckpointResourcePath(
+			task.ModelId, task.HyperparametersId, task.CheckpointId)
+	}
+	return tasks, nil
+}
+
+func (store flea) ModifyTask(ctx context.Context, req api.ModifyTaskRequest) error {
+	return store.db.Update(func(tx *bolt.Tx) error {
+		taskBucket, err := getTaskBucket(tx, req.TaskId)
+		if err != nil {
+			return err
+		}
+
+		task := api.TaskDetails{}
+		err = json.Unmarshal(taskBucket.Get(taskKey), &task)
+		if err != nil {
+			return err
+		}
+		task.Deadline = req.Deadline
+		task.Active = req.Active
+
+		bytes, err := json.Marshal(task)
+		if err != nil {
+			return err
+		}
+		return taskBucket.Put(taskKey, bytes)
+	})
+}
+
+func (store flea) StartTask(ctx context.Context, taskId string) (api.StartTaskResponse, error) {
+	jobId := uuid.New().String()
+	uploadTo := fmt.Sprintf("%s/tasksJobs/%s/%s.zip", store.uploadReqURL, taskId, jobId)
+	job := storage.Job{
+		TaskId:       taskId,
+		JobId:        jobId,
+		UploadUrl:    uploadTo,
+		AcceptedTime: time.Now(),
+		Errors:       make([]string, 0),
+	}
+	bytes, err := json.Marshal(job)
+	if err != nil {
+		return api.StartTaskResponse{}, err
+	}
+
+	err = store.db.Update(func(tx *bolt.Tx) error {
+		taskBucket, err := getTaskBucket(tx, taskId)
+		if err != nil {
+			return err
+		}
+		return taskBucket.Bucket(jobsBucket).Put([]byte(jobId), bytes)
+	})
+	if err != nil {
+		return api.StartTaskResponse{}, err
+	}
+
+	resp := api.StartTaskResponse{
+		Status:   api.StartTaskResponse_APPROVED,
+		JobId:    jobId,
+		UploadTo: uploadTo,
+	}
+	return resp, nil
+}
+
+// Expects that the input sanity checks are done by the caller.
+func (store flea) ListTasks(ctx context.Context, req api.ListTasksRequest) (api.ListTasksResponse, error) {
+	var resp api.ListTasksResponse
+	err := store.db.View(func(tx *bolt.Tx) error {
+		var candidates []string
+		cursor := tx.Bucket(tasksBucket).Cursor()
+		for k, _ := cursor.Seek([]byte(req.StartTaskId)); k != nil; k, _ = cursor.Next() {
+			candidates = append(candidates, string(k))
+		}
+
+		var err error
+		resp, err = storage.ListTaskCandidates(req, candidates, func(taskId string) (api.TaskDetails, error) {
+			task := api.TaskDetails{}
+			err := json.Unmarshal(tx.Bucket(tasksBucket).Bucket([]byte(taskId)).Get(taskKey), &task)
+			return task, err
+		})
+		return err
+	})
+	if err != nil {
+		return api.ListTasksResponse{}, err
+	}
+
+	return resp, nil
+}
+
+func (store flea) AddJobError(ctx context.Context, req api.JobErrorRequest) error {
+	return store.db.Update(func(tx *bolt.Tx) error {
+		taskBucket, err := getTaskBucket(tx, req.TaskId)
+		if err != nil {
+			return err
+		}
+
+		jobs := taskBucket.Bucket(jobsBucket)
+		bytes := jobs.Get([]byte(req.JobId))
+		if bytes == nil {
+			return storage.ErrMissingJobId
+		}
+
+		job := storage.Job{}
+		err = json.Unmarshal(bytes, &job)
+		if err != nil {
+			return err
+		}
+		job.Errors = append(job.Errors, req.ErrorMessage)
+
+		bytes, err = json.Marshal(job)
+		if err != nil {
+			return err
+		}
+		return jobs.Put([]byte(req.JobId), bytes)
+	})
+}
+
+func getTaskBucket(tx *bolt.Tx, taskId string) (*bolt.Bucket, error) {
+	if taskId == "" {
+		return nil, storage.ErrMissingTaskId
+	}
+	taskBucket := tx.Bucket(tasksBucket).Bucket([]byte(taskId))
+	if taskBucket == nil {
+		return nil, storage.ErrMissingTaskId
+	}
+	return taskBucket, nil
+}
@@ -0,0 +1,142 @@
+package boltdb
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/doc-ai/tensorio-models/storage"
+	bolt "go.etcd.io/bbolt"
+)
+
+func (store boltStorage) ListModelRevisions(ctx context.Context, modelId string, afterVersion int64, maxItems int) ([]storage.ModelRevision, error) {
+	res := make([]storage.ModelRevision, 0)
+	err := store.db.View(func(tx *bolt.Tx) error {
+		modelBucket, err := getModelBucket(tx, modelId)
+		if err != nil {
+			return err
+		}
+		return listRevisions(modelBucket, afterVersion, maxItems, func(value []byte) error {
+			revision := storage.ModelRevision{}
+			err := json.Unmarshal(value, &revision)
+			res = append(res, revision)
+			return err
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (store boltStorage) GetModelRevision(ctx context.Context, modelId string, version int64) (storage.ModelRevision, error) {
+	revision := storage.ModelRevision{}
+	err := store.db.View(func(tx *bolt.Tx) error {
+		modelBucket, err := getModelBucket(tx, modelId)
+		if err != nil {
+			return err
+		}
+		return getRevision(modelBucket, version, &revision)
+	})
+	if err != nil {
+		return storage.ModelRevision{}, err
+	}
+
+	return revision, nil
+}
+
+func (store boltStorage) ListHyperparametersRevisions(ctx context.Context, modelId, hyperparametersId string, afterVersion int64, maxItems int) ([]storage.HyperparametersRevision, error) {
+	res := make([]storage.HyperparametersRevision, 0)
+	err := store.db.View(func(tx *bolt.Tx) error {
+		hpBucket, err := getHyperparametersBucket(tx, modelId, hyperparametersId)
+		if err != nil {
+			return err
+		}
+		return listRevisions(hpBucket, afterVersion, maxItems, func(value []byte) error {
+			revision := storage.HyperparametersRevision{}
+			err := json.Unmarshal(value, &revision)
+			res = append(res, revision)
+			return err
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (store boltStorage) GetHyperparametersRevision(ctx context.Context, modelId, hyperparametersId string, version int64) (storage.HyperparametersRevision, error) {
+	revision := storage.HyperparametersRevision{}
+	err := store.db.View(func(tx *bolt.Tx) error {
+		hpBucket, err := getHyperparametersBucket(tx, modelId, hyperparametersId)
+		if err != nil {
+			return err
+		}
+		return getRevision(hpBucket, version, &revision)
+	})
+	if err != nil {
+		return storage.HyperparametersRevision{}, err
+	}
+
+	return revision, nil
+}
+
+func putModelRevision(modelBucket *bolt.Bucket, model storage.Model) error {
+	return putRevision(modelBucket, model.Version, storage.ModelRevision{Model: model, CreatedAt: time.Now()})
+}
+
+func putHyperparametersRevision(hpBucket *bolt.Bucket, hyperparameters storage.Hyperparameters) error {
+	return putRevision(hpBucket, hyperparameters.Version, storage.HyperparametersRevision{Hyperparameters: hyperparameters, CreatedAt: time.Now()})
+}
+
+// putRevision - stores a revision in the revisions bucket of the given model or hyperparameters
+// bucket, creating it for resources which were added before revisions were kept.
+func putRevision(parent *bolt.Bucket, version int64, revision interface{}) error {
+	revisions, err := parent.CreateBucketIfNotExists(revisionsBucket)
+	if err != nil {
+		return err
+	}
+	bytes, err := json.Marshal(revision)
+	if err != nil {
+		return err
+	}
+	return revisions.Put([]byte(storage.RevisionKey(version)), bytes)
+}
+
+func getRevision(parent *bolt.Bucket, version int64, v interface{}) error {
+	revisions := parent.Bucket(revisionsBucket)
+	if revisions == nil {
+		return storage.RevisionDoesNotExistError
+	}
+	value := revisions.Get([]byte(storage.RevisionKey(version)))
+	if value == nil {
+		return storage.RevisionDoesNotExistError
+	}
+	return json.Unmarshal(value, v)
+}
+
+// listRevisions - calls add with up to maxItems revisions of the given model or hyperparameters
+// bucket whose versions come after afterVersion, in order of version.
+func listRevisions(parent *bolt.Bucket, afterVersion int64, maxItems int, add func(value []byte) error) error {
+	revisions := parent.Bucket(revisionsBucket)
+	if revisions == nil {
+		return nil
+	}
+
+	marker := []byte(storage.RevisionKey(afterVersion))
+	cursor := revisions.Cursor()
+	k, v := cursor.Seek(marker)
+	if k != nil && string(k) == string(marker) {
+		k, v = cursor.Next()
+	}
+	for count := 0; k != nil && count < maxItems; k, v = cursor.Next() {
+		err := add(v)
+		if err != nil {
+			return err
+		}
+		count++
+	}
+	return nil
+}
@@ -0,0 +1,125 @@
+package boltdb
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/doc-ai/tensorio-models/storage"
+	bolt "go.etcd.io/bbolt"
+)
+
+// Tags are kept in a tags bucket within the bucket of the model or hyperparameters they are on,
+// keyed by name, and so are deleted along with it:
+//
+//	models/<modelId>/tags/<tag>
+//	models/<modelId>/hyperparameters/<hyperparametersId>/tags/<tag>
+var tagsBucket = []byte("tags")
+
+func (store boltStorage) SetTag(ctx context.Context, tag storage.Tag) (storage.Tag, error) {
+	tag.UpdatedAt = time.Now()
+	bytes, err := json.Marshal(tag)
+	if err != nil {
+		return storage.Tag{}, err
+	}
+
+	err = store.db.Update(func(tx *bolt.Tx) error {
+		ownerBucket, err := getTagsOwnerBucket(tx, tag.ModelId, tag.HyperparametersId)
+		if err != nil {
+			return err
+		}
+		tags, err := ownerBucket.CreateBucketIfNotExists(tagsBucket)
+		if err != nil {
+			return err
+		}
+		return tags.Put([]byte(tag.Tag), bytes)
+	})
+	if err != nil {
+		return storage.Tag{}, err
+	}
+
+	return tag, nil
+}
+
+func (store boltStorage) GetTag(ctx context.Context, modelId, hyperparametersId, tag string) (storage.Tag, error) {
+	res := storage.Tag{}
+	err := store.db.View(func(tx *bolt.Tx) error {
+		ownerBucket, err := getTagsOwnerBucket(tx, modelId, hyperparametersId)
+		if err != nil {
+			return err
+		}
+		tags := ownerBucket.Bucket(tagsBucket)
+		if tags == nil {
+			return storage.TagDoesNotExistError
+		}
+		value := tags.Get([]byte(tag))
+		if value == nil {
+			return storage.TagDoesNotExistError
+		}
+		return json.Unmarshal(value, &res)
+	})
+	if err != nil {
+		return storage.Tag{}, err
+	}
+
+	return res, nil
+}
+
+func (store boltStorage) ListTags(ctx context.Context, modelId, hyperparametersId string) ([]storage.Tag, error) {
+	var res []storage.Tag
+	err := store.db.View(func(tx *bolt.Tx) error {
+		ownerBucket, err := getTagsOwnerBucket(tx, modelId, hyperparametersId)
+		if err != nil {
+			return err
+		}
+		res, err = listTags(ownerBucket)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (store boltStorage) DeleteTag(ctx context.Context, modelId, hyperparametersId, tag string) error {
+	return store.db.Update(func(tx *bolt.Tx) error {
+		ownerBucket, err := getTagsOwnerBucket(tx, modelId, hyperparametersId)
+		if err != nil {
+			return err
+		}
+		tags := ownerBucket.Bucket(tagsBucket)
+		if tags == nil || tags.Get([]byte(tag)) == nil {
+			return storage.TagDoesNotExistError
+		}
+		return tags.Delete([]byte(tag))
+	})
+}
+
+// getTagsOwnerBucket - returns the bucket of the model (if hyperparametersId is "") or
+// hyperparameters which tags are on.
+func getTagsOwnerBucket(tx *bolt.Tx, modelId, hyperparametersId string) (*bolt.Bucket, error) {
+	if hyperparametersId == "" {
+		return getModelBucket(tx, modelId)
+	}
+	return getHyperparametersBucket(tx, modelId, hyperparametersId)
+}
+
+// listTags - returns the tags in the tags bucket of ownerBucket, which are ordered by name.
+func listTags(ownerBucket *bolt.Bucket) ([]storage.Tag, error) {
+	res := make([]storage.Tag, 0)
+	tags := ownerBucket.Bucket(tagsBucket)
+	if tags == nil {
+		return res, nil
+	}
+	err := tags.ForEach(func(k, v []byte) error {
+		tag := storage.Tag{}
+		err := json.Unmarshal(v, &tag)
+		res = append(res, tag)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
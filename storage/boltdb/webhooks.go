@@ -0,0 +1,104 @@
+package boltdb
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/doc-ai/tensorio-models/storage"
+	bolt "go.etcd.io/bbolt"
+)
+
+// Webhooks are kept in the webhooks bucket, keyed by webhook ID, and dead letters in the
+// webhookDeadLetters bucket, keyed by delivery ID.
+var (
+	webhooksBucket           = []byte("webhooks")
+	webhookDeadLettersBucket = []byte("webhookDeadLetters")
+)
+
+// webhookStore - the bolt implementation of storage.WebhookStorage, shared by the repository and
+// FLEA storage.
+type webhookStore struct {
+	webhooksDB *bolt.DB
+}
+
+// createWebhookBuckets - creates the webhook buckets if the database does not have them yet.
+func createWebhookBuckets(tx *bolt.Tx) error {
+	_, err := tx.CreateBucketIfNotExists(webhooksBucket)
+	if err != nil {
+		return err
+	}
+	_, err = tx.CreateBucketIfNotExists(webhookDeadLettersBucket)
+	return err
+}
+
+func (store webhookStore) AddWebhook(ctx context.Context, webhook storage.Webhook) error {
+	bytes, err := json.Marshal(webhook)
+	if err != nil {
+		return err
+	}
+	return store.webhooksDB.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(webhooksBucket).Put([]byte(webhook.WebhookId), bytes)
+	})
+}
+
+func (store webhookStore) ListWebhooks(ctx context.Context) ([]storage.Webhook, error) {
+	res := make([]storage.Webhook, 0)
+	err := store.webhooksDB.View(func(tx *bolt.Tx) error {
+		return tx.Bucket(webhooksBucket).ForEach(func(k, v []byte) error {
+			webhook := storage.Webhook{}
+			err := json.Unmarshal(v, &webhook)
+			res = append(res, webhook)
+			return err
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (store webhookStore) DeleteWebhook(ctx context.Context, webhookId string) error {
+	return store.webhooksDB.Update(func(tx *bolt.Tx) error {
+		webhooks := tx.Bucket(webhooksBucket)
+		if webhooks.Get([]byte(webhookId)) == nil {
+			return storage.WebhookDoesNotExistError
+		}
+		return webhooks.Delete([]byte(webhookId))
+	})
+}
+
+func (store webhookStore) AddWebhookDeadLetter(ctx context.Context, deadLetter storage.WebhookDeadLetter) error {
+	bytes, err := json.Marshal(deadLetter)
+	if err != nil {
+		return err
+	}
+	return store.webhooksDB.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(webhookDeadLettersBucket).Put([]byte(deadLetter.DeliveryId), bytes)
+	})
+}
+
+func (store webhookStore) ListWebhookDeadLetters(ctx context.Context, marker string, maxItems int) ([]storage.WebhookDeadLetter, error) {
+	res := make([]storage.WebhookDeadLetter, 0)
+	err := store.webhooksDB.View(func(tx *bolt.Tx) error {
+		cursor := tx.Bucket(webhookDeadLettersBucket).Cursor()
+		k, v := cursor.Seek([]byte(marker))
+		if k != nil && string(k) == marker {
+			k, v = cursor.Next()
+		}
+		for ; k != nil && len(res) < maxItems; k, v = cursor.Next() {
+			deadLetter := storage.WebhookDeadLetter{}
+			err := json.Unmarshal(v, &deadLetter)
+			if err != nil {
+				return err
+			}
+			res = append(res, deadLetter)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
@@ -0,0 +1,139 @@
+package storage
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	signedURL "github.com/doc-ai/tensorio-models/signed_url"
+)
+
+// BundleContentType - the content type checkpoint bundles have to be uploaded with.
+const BundleContentType = "application/zip"
+
+// ErrUploadsNotConfigured - returned by CheckpointUploadURL if the backend has not been given the
+// credentials it needs to sign upload URLs.
+var ErrUploadsNotConfigured = errors.New("Checkpoint uploads are not configured for this backend")
+
+// ErrLinkNotReadable - returned by OpenCheckpointLink for links which the backend cannot read.
+var ErrLinkNotReadable = errors.New("Checkpoint link cannot be read by this backend")
+
+// ErrLinkDoesNotExist - returned by OpenCheckpointLink if there is nothing at the link.
+var ErrLinkDoesNotExist = errors.New("Checkpoint link does not point at anything")
+
+// CheckpointBundlePath - the path of the bundle of a checkpoint uploaded through the repository,
+// relative to the bucket (or directory) of the backend. Bundles are kept apart from the models/
+// tree so that they never show up in listings.
+func CheckpointBundlePath(modelId, hyperparametersId, checkpointId string) string {
+	return fmt.Sprintf("%scheckpoints/%s.tiobundle.zip", HyperparametersBundlesPrefix(modelId, hyperparametersId), checkpointId)
+}
+
+// ModelBundlesPrefix - the prefix of the CheckpointBundlePath of every checkpoint of a model, which
+// backends remove when the model is deleted.
+func ModelBundlesPrefix(modelId string) string {
+	return fmt.Sprintf("bundles/models/%s/", modelId)
+}
+
+// HyperparametersBundlesPrefix - the prefix of the CheckpointBundlePath of every checkpoint under
+// the given hyperparameters, which backends remove when the hyperparameters are deleted.
+func HyperparametersBundlesPrefix(modelId, hyperparametersId string) string {
+	return fmt.Sprintf("%shyperparameters/%s/", ModelBundlesPrefix(modelId), hyperparametersId)
+}
+
+// VerifyCheckpointContent - reads content to the end and checks it against the Sha256, SizeBytes
+// and ContentType of the checkpoint, returning a CheckpointContentError for the first which does
+// not match. The digest and size are computed from the content itself. The content type is sniffed
+// from it, and is only checked if the sniffer recognises the content.
+func VerifyCheckpointContent(checkpoint Checkpoint, content io.Reader) error {
+	head := make([]byte, 512)
+	n, err := io.ReadFull(content, head)
+	if err != nil && err != io.EOF && err != io.ErrUnexpectedEOF {
+		return err
+	}
+	head = head[:n]
+	hash := sha256.New()
+	hash.Write(head)
+	rest, err := io.Copy(hash, content)
+	if err != nil {
+		return err
+	}
+
+	size := int64(n) + rest
+	if checkpoint.SizeBytes != 0 && checkpoint.SizeBytes != size {
+		return CheckpointContentError{Field: "sizeBytes", Reason: fmt.Sprintf("object is %d bytes", size)}
+	}
+	sniffed, _, err := mime.ParseMediaType(http.DetectContentType(head))
+	if err == nil && sniffed != "application/octet-stream" && checkpoint.ContentType != "" && checkpoint.ContentType != sniffed {
+		return CheckpointContentError{Field: "contentType", Reason: fmt.Sprintf("object is %s", sniffed)}
+	}
+	digest := hex.EncodeToString(hash.Sum(nil))
+	if checkpoint.Sha256 != "" && !strings.EqualFold(checkpoint.Sha256, digest) {
+		return CheckpointContentError{Field: "sha256", Reason: fmt.Sprintf("object digest is %s", digest)}
+	}
+	return nil
+}
+
+// LocalCheckpointUploadURL - CheckpointUploadURL for backends which keep bundles in a directory on
+// the local filesystem. The upload URL and the link are the same file:// URL, and its parent
+// directory is created so that clients only have to write the file.
+func LocalCheckpointUploadURL(dir, modelId, hyperparametersId, checkpointId string, expires time.Time) (string, string, error) {
+	bundlePath := CheckpointBundlePath(modelId, hyperparametersId, checkpointId)
+	err := os.MkdirAll(filepath.Dir(filepath.Join(dir, filepath.FromSlash(bundlePath))), 0755)
+	if err != nil {
+		return "", "", err
+	}
+	url, err := signedURL.NewLocalURLSigner(dir).GetSignedURL("PUT", bundlePath, expires, BundleContentType)
+	if err != nil {
+		return "", "", err
+	}
+	return url, url, nil
+}
+
+// LocalCheckpointBundleExists - CheckpointBundleExists for backends using LocalCheckpointUploadURL.
+func LocalCheckpointBundleExists(dir, modelId, hyperparametersId, checkpointId string) (bool, error) {
+	bundlePath := CheckpointBundlePath(modelId, hyperparametersId, checkpointId)
+	_, err := os.Stat(filepath.Join(dir, filepath.FromSlash(bundlePath)))
+	if os.IsNotExist(err) {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+// RemoveLocalBundles - removes the bundle at, or every bundle under, the given CheckpointBundlePath
+// or bundles prefix for backends using LocalCheckpointUploadURL. Bundles which were never uploaded
+// are not an error.
+func RemoveLocalBundles(dir, bundlePath string) error {
+	return os.RemoveAll(filepath.Join(dir, filepath.FromSlash(bundlePath)))
+}
+
+// OpenLocalLink - OpenCheckpointLink for backends using LocalCheckpointUploadURL. Only file:// links
+// to files under dir can be read.
+func OpenLocalLink(dir, link string) (io.ReadCloser, error) {
+	if !strings.HasPrefix(link, "file://") {
+		return nil, ErrLinkNotReadable
+	}
+	root, err := filepath.Abs(dir)
+	if err != nil {
+		return nil, err
+	}
+	linkPath := filepath.Clean(filepath.FromSlash(strings.TrimPrefix(link, "file://")))
+	if !strings.HasPrefix(linkPath, root+string(filepath.Separator)) {
+		return nil, ErrLinkNotReadable
+	}
+	file, err := os.Open(linkPath)
+	if os.IsNotExist(err) {
+		return nil, ErrLinkDoesNotExist
+	}
+	if err != nil {
+		return nil, err
+	}
+	return file, nil
+}
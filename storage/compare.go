@@ -0,0 +1,164 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"math"
+	"sort"
+	"strconv"
+	"time"
+)
+
+// CheckpointMetrics - the entries of the Info of a checkpoint which parse as finite numbers, such as
+// the evaluation metrics which evaluators write there. Other entries are left out.
+func CheckpointMetrics(checkpoint Checkpoint) map[string]float64 {
+	metrics := make(map[string]float64)
+	for k, v := range checkpoint.Info {
+		value, err := strconv.ParseFloat(v, 64)
+		if err != nil || math.IsNaN(value) || math.IsInf(value, 0) {
+			continue
+		}
+		metrics[k] = value
+	}
+	return metrics
+}
+
+// MaxScannedCheckpoints - how many checkpoints GetLatestCheckpoints reads at most before giving up.
+const MaxScannedCheckpoints = 10000
+
+// ErrTooManyCheckpoints - returned by GetLatestCheckpoints for hyperparameters with more than
+// MaxScannedCheckpoints checkpoints.
+var ErrTooManyCheckpoints = errors.New("Too many checkpoints to find the latest ones")
+
+// GetLatestCheckpoints - fetches the n most recently created checkpoints of a set of
+// hyperparameters, oldest first. Checkpoints are listed as ListCheckpoints lists them, so archived
+// checkpoints are only included if includeArchived is set.
+//
+// Listings only return ids, so this reads every listed checkpoint to learn when it was created. Only
+// the n newest seen so far are kept between pages, and ErrTooManyCheckpoints is returned rather than
+// reading more than MaxScannedCheckpoints of them.
+func GetLatestCheckpoints(ctx context.Context, store RepositoryStorage, modelId, hyperparametersId string, n int, includeArchived bool) ([]Checkpoint, error) {
+	const pageSize = 100
+	var res []Checkpoint
+	scanned := 0
+	marker := ""
+	for {
+		page, err := store.ListCheckpoints(ctx, modelId, hyperparametersId, marker, pageSize, includeArchived)
+		if err != nil {
+			return nil, err
+		}
+		scanned += len(page.Ids)
+		if scanned > MaxScannedCheckpoints {
+			return nil, ErrTooManyCheckpoints
+		}
+		checkpoints, err := store.BatchGetCheckpoints(ctx, modelId, hyperparametersId, page.Ids)
+		if err != nil {
+			return nil, err
+		}
+		res = latestCheckpoints(append(res, checkpoints...), n)
+		if page.NextMarker == "" {
+			break
+		}
+		marker = page.NextMarker
+	}
+	return res, nil
+}
+
+// latestCheckpoints - sorts checkpoints by CreatedAt, breaking ties by CheckpointId, and keeps the
+// last n of them.
+func latestCheckpoints(checkpoints []Checkpoint, n int) []Checkpoint {
+	sort.SliceStable(checkpoints, func(i, j int) bool {
+		if checkpoints[i].CreatedAt.Equal(checkpoints[j].CreatedAt) {
+			return checkpoints[i].CheckpointId < checkpoints[j].CheckpointId
+		}
+		return checkpoints[i].CreatedAt.Before(checkpoints[j].CreatedAt)
+	})
+	if len(checkpoints) > n {
+		checkpoints = append([]Checkpoint(nil), checkpoints[len(checkpoints)-n:]...)
+	}
+	return checkpoints
+}
+
+// MetricsRow - the metrics of one of the checkpoints in a Comparison.
+type MetricsRow struct {
+	CheckpointId string
+	CreatedAt    time.Time
+	Values       map[string]float64
+	// Deltas - the difference between each value and the value of the same metric for the first
+	// checkpoint in the comparison, for the metrics which both checkpoints have.
+	Deltas map[string]float64
+}
+
+// Comparison - the result of CompareCheckpoints.
+type Comparison struct {
+	// Metrics - the names of the metrics which at least one of the checkpoints has, sorted.
+	Metrics []string
+	Rows    []MetricsRow
+}
+
+// CompareCheckpoints - tabulates the CheckpointMetrics of the given checkpoints, computing deltas
+// against the first of them. If metrics is not empty, only the metrics it names are compared.
+func CompareCheckpoints(checkpoints []Checkpoint, metrics []string) Comparison {
+	wanted := make(map[string]bool, len(metrics))
+	for _, metric := range metrics {
+		wanted[metric] = true
+	}
+
+	res := Comparison{
+		Metrics: make([]string, 0),
+		Rows:    make([]MetricsRow, len(checkpoints)),
+	}
+	seen := make(map[string]bool)
+	for i, checkpoint := range checkpoints {
+		values := CheckpointMetrics(checkpoint)
+		for metric := range values {
+			if len(wanted) > 0 && !wanted[metric] {
+				delete(values, metric)
+				continue
+			}
+			if !seen[metric] {
+				seen[metric] = true
+				res.Metrics = append(res.Metrics, metric)
+			}
+		}
+
+		deltas := make(map[string]float64)
+		if i > 0 {
+			baseline := res.Rows[0].Values
+			for metric, value := range values {
+				if baselineValue, ok := baseline[metric]; ok {
+					deltas[metric] = value - baselineValue
+				}
+			}
+		} else {
+			for metric := range values {
+				deltas[metric] = 0
+			}
+		}
+
+		res.Rows[i] = MetricsRow{
+			CheckpointId: checkpoint.CheckpointId,
+			CreatedAt:    checkpoint.CreatedAt,
+			Values:       values,
+			Deltas:       deltas,
+		}
+	}
+	sort.Strings(res.Metrics)
+	return res
+}
+
+// BestByMetric - the row with the highest value of the given metric, or the lowest if lowerIsBetter
+// is set. Ties go to the earlier row. ok is false if none of the rows has the metric.
+func (comparison Comparison) BestByMetric(metric string, lowerIsBetter bool) (best MetricsRow, ok bool) {
+	for _, row := range comparison.Rows {
+		value, has := row.Values[metric]
+		if !has {
+			continue
+		}
+		if !ok || (!lowerIsBetter && value > best.Values[metric]) || (lowerIsBetter && value < best.Values[metric]) {
+			best = row
+			ok = true
+		}
+	}
+	return best, ok
+}
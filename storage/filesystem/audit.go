@@ -0,0 +1,94 @@
+package filesystem
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/doc-ai/tensorio-models/storage"
+)
+
+// auditLog - stores each audit event in its own file under the directory of its partition of the
+// audit directory (see storage.AuditPartition), named after the event ID. Shared by the repository
+// and FLEA storage.
+type auditLog struct {
+	auditRoot string
+}
+
+func (store auditLog) AddAuditEvent(ctx context.Context, event storage.AuditEvent) error {
+	eventJSON, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+	return createObject(store.auditRoot, objAuditEventPath(event.EventId), eventJSON)
+}
+
+func (store auditLog) ListAuditEvents(ctx context.Context, marker string, maxItems int) ([]storage.AuditEvent, error) {
+	res := make([]storage.AuditEvent, 0)
+
+	partitions, err := readDirNames(filepath.Join(store.auditRoot, objAuditDir()))
+	if err != nil {
+		return nil, err
+	}
+
+	markerPartition := storage.AuditPartition(marker)
+	for _, partition := range partitions {
+		if len(res) >= maxItems {
+			break
+		}
+		if partition < markerPartition {
+			continue
+		}
+
+		names, err := readDirNames(filepath.Join(store.auditRoot, objAuditPartitionDir(partition)))
+		if err != nil {
+			return nil, err
+		}
+		for _, name := range names {
+			if len(res) >= maxItems {
+				break
+			}
+
+			eventId := strings.TrimSuffix(name, ".json")
+			// Skips the temporary files of events which are still being written
+			if strings.HasPrefix(name, ".") || eventId == name || eventId <= marker {
+				continue
+			}
+
+			eventJSON, err := readObject(store.auditRoot, objAuditEventPath(eventId))
+			if err != nil {
+				return nil, err
+			}
+			event := storage.AuditEvent{}
+			err = json.Unmarshal(eventJSON, &event)
+			if err != nil {
+				return nil, err
+			}
+			res = append(res, event)
+		}
+	}
+
+	return res, nil
+}
+
+// readDirNames - the sorted names of the entries of dir, which are none if it does not exist.
+func readDirNames(dir string) ([]string, error) {
+	file, err := os.Open(dir)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	names, err := file.Readdirnames(-1)
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(names)
+	return names, nil
+}
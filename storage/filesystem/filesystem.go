@@ -0,0 +1,752 @@
+package filesystem
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/doc-ai/tensorio-models/api"
+	"github.com/doc-ai/tensorio-models/common"
+	"github.com/doc-ai/tensorio-models/storage"
+)
+
+const (
+	StorageType string = "FILESYSTEM"
+)
+
+// Deleted directories are moved here before being removed.
+const trashDir = ".trash"
+
+var errObjectExists = errors.New("Object already exists")
+
+type filesystemStorage struct {
+	auditLog
+	webhookStore
+	root string
+	// Serializes read-modify-write cycles within this process. Individual writes are atomic on
+	// their own, so readers never need to take this lock.
+	lock *sync.Mutex
+}
+
+// GenerateNewFilesystemStorageFromEnv - Uses the REPOSITORY_FILESYSTEM_ROOT environment variable to
+// instantiate a local filesystem storage backend for tensorio-models repository
+func GenerateNewFilesystemStorageFromEnv() storage.RepositoryStorage {
+	root := os.Getenv("REPOSITORY_FILESYSTEM_ROOT")
+	if root == "" {
+		err := errors.New("REPOSITORY_FILESYSTEM_ROOT environment variable not defined")
+		panic(err)
+	}
+
+	err := os.MkdirAll(root, 0755)
+	if err != nil {
+		panic(err)
+	}
+
+	return NewFilesystemStorage(root)
+}
+
+// NewFilesystemStorage - Creates an instance of storage.RepositoryStorage interface which stores
+// objects under the given root directory using the same layout as the GCS backend
+func NewFilesystemStorage(root string) storage.RepositoryStorage {
+	return &filesystemStorage{
+		auditLog:     auditLog{auditRoot: root},
+		webhookStore: webhookStore{webhooksRoot: root},
+		root:         root,
+		lock:         &sync.Mutex{},
+	}
+}
+
+func (store filesystemStorage) GetStorageType() string {
+	return StorageType
+}
+
+// GetBucketName - the filesystem backend has no bucket, so authentication tokens are read from the
+// local filesystem.
+func (store filesystemStorage) GetBucketName() string {
+	return ""
+}
+
+func (store filesystemStorage) ListModels(ctx context.Context, marker string, maxItems int) (storage.ListResult, error) {
+	res, err := listObjects(store.root, objModelsDir(), "model.json", marker, maxItems+1)
+	if err != nil {
+		return storage.ListResult{}, err
+	}
+
+	return storage.NewListResult(res, maxItems), nil
+}
+
+func (store filesystemStorage) GetModel(ctx context.Context, modelId string) (storage.Model, error) {
+	if !common.IsValidID(modelId) {
+		return storage.Model{}, storage.ModelDoesNotExistError
+	}
+
+	bytes, err := readObject(store.root, objModelPath(modelId))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return storage.Model{}, storage.ModelDoesNotExistError
+		}
+		return storage.Model{}, err
+	}
+
+	model := storage.Model{}
+
+	err = json.Unmarshal(bytes, &model)
+	if err != nil {
+		return storage.Model{}, err
+	}
+
+	return model, nil
+}
+
+func (store filesystemStorage) BatchGetModels(ctx context.Context, modelIds []string) ([]storage.Model, error) {
+	models := make([]storage.Model, len(modelIds))
+	for i, modelId := range modelIds {
+		model, err := store.GetModel(ctx, modelId)
+		if err != nil {
+			return nil, err
+		}
+		models[i] = model
+	}
+
+	return models, nil
+}
+
+func (store filesystemStorage) AddModel(ctx context.Context, model storage.Model) error {
+	if !common.IsValidID(model.ModelId) {
+		return storage.ErrInvalidModelId
+	}
+
+	model.Version = 1
+	bytes, err := json.Marshal(model)
+	if err != nil {
+		return err
+	}
+
+	err = createObject(store.root, objModelPath(model.ModelId), bytes)
+	if err == errObjectExists {
+		return storage.ModelExistsError
+	}
+	if err != nil {
+		return err
+	}
+
+	return addModelRevision(store.root, model)
+}
+
+func (store filesystemStorage) UpdateModel(ctx context.Context, model storage.Model) (storage.Model, error) {
+	return store.updateModel(ctx, model, false)
+}
+
+func (store filesystemStorage) ReplaceModel(ctx context.Context, model storage.Model) (storage.Model, error) {
+	return store.updateModel(ctx, model, true)
+}
+
+// updateModel - merges model into the stored model as UpdateModel does or, if replace is
+// set, replaces it as ReplaceModel does.
+func (store filesystemStorage) updateModel(ctx context.Context, model storage.Model, replace bool) (storage.Model, error) {
+	store.lock.Lock()
+	defer store.lock.Unlock()
+
+	storedModel, err := store.GetModel(ctx, model.ModelId)
+	if err != nil {
+		return storage.Model{}, err
+	}
+
+	err = storage.CheckVersion(storedModel.Version, model.Version)
+	if err != nil {
+		return storage.Model{}, err
+	}
+
+	err = storage.CheckModelReferences(ctx, store, storedModel, model)
+	if err != nil {
+		return storage.Model{}, err
+	}
+
+	if replace {
+		storedModel = storage.ReplacedModel(storedModel, model)
+	} else {
+		if strings.TrimSpace(model.CanonicalHyperparameters) != "" {
+			storedModel.CanonicalHyperparameters = model.CanonicalHyperparameters
+		}
+		if strings.TrimSpace(model.Details) != "" {
+			storedModel.Details = model.Details
+		}
+		if model.Labels != nil {
+			storedModel.Labels = model.Labels
+		}
+		if model.Card != nil {
+			storedModel.Card = model.Card
+		}
+	}
+
+	storedModel.Version++
+	bytes, err := json.Marshal(storedModel)
+	if err != nil {
+		return storage.Model{}, err
+	}
+
+	err = writeObject(store.root, objModelPath(model.ModelId), bytes)
+	if err != nil {
+		return storage.Model{}, err
+	}
+
+	err = addModelRevision(store.root, storedModel)
+	if err != nil {
+		return storage.Model{}, err
+	}
+
+	return storedModel, nil
+}
+
+func (store filesystemStorage) ListHyperparameters(ctx context.Context, modelId, marker string, maxItems int) (storage.ListResult, error) {
+	_, err := store.GetModel(ctx, modelId)
+	if err != nil {
+		return storage.ListResult{}, err
+	}
+
+	res, err := listObjects(store.root, objHyperparametersDir(modelId), "params.json", marker, maxItems+1)
+	if err != nil {
+		return storage.ListResult{}, err
+	}
+
+	return storage.NewListResult(res, maxItems), nil
+}
+
+func (store filesystemStorage) GetHyperparameters(ctx context.Context, modelId string, hyperparametersId string) (storage.Hyperparameters, error) {
+	_, err := store.GetModel(ctx, modelId)
+	if err != nil {
+		return storage.Hyperparameters{}, err
+	}
+
+	if !common.IsValidID(hyperparametersId) {
+		return storage.Hyperparameters{}, storage.HyperparametersDoesNotExistError
+	}
+
+	bytes, err := readObject(store.root, objHyperparametersPath(modelId, hyperparametersId))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return storage.Hyperparameters{}, storage.HyperparametersDoesNotExistError
+		}
+		return storage.Hyperparameters{}, err
+	}
+
+	hyperparameters := storage.Hyperparameters{}
+
+	err = json.Unmarshal(bytes, &hyperparameters)
+	if err != nil {
+		return storage.Hyperparameters{}, err
+	}
+
+	return hyperparameters, nil
+}
+
+func (store filesystemStorage) BatchGetHyperparameters(ctx context.Context, modelId string, hyperparametersIds []string) ([]storage.Hyperparameters, error) {
+	_, err := store.GetModel(ctx, modelId)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]storage.Hyperparameters, len(hyperparametersIds))
+	for i, hyperparametersId := range hyperparametersIds {
+		res[i], err = store.GetHyperparameters(ctx, modelId, hyperparametersId)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return res, nil
+}
+
+func (store filesystemStorage) AddHyperparameters(ctx context.Context, hyperparameters storage.Hyperparameters) error {
+	_, err := store.GetModel(ctx, hyperparameters.ModelId)
+	if err != nil {
+		return err
+	}
+
+	if !common.IsValidID(hyperparameters.HyperparametersId) {
+		return storage.ErrInvalidHyperparametersId
+	}
+
+	hyperparameters.Version = 1
+	bytes, err := json.Marshal(hyperparameters)
+	if err != nil {
+		return err
+	}
+
+	err = createObject(store.root, objHyperparametersPath(hyperparameters.ModelId, hyperparameters.HyperparametersId), bytes)
+	if err == errObjectExists {
+		return storage.HyperparametersExistsError
+	}
+	if err != nil {
+		return err
+	}
+
+	return addHyperparametersRevision(store.root, hyperparameters)
+}
+
+func (store filesystemStorage) UpdateHyperparameters(ctx context.Context, hyperparameters storage.Hyperparameters) (storage.Hyperparameters, error) {
+	return store.updateHyperparameters(ctx, hyperparameters, false)
+}
+
+func (store filesystemStorage) ReplaceHyperparameters(ctx context.Context, hyperparameters storage.Hyperparameters) (storage.Hyperparameters, error) {
+	return store.updateHyperparameters(ctx, hyperparameters, true)
+}
+
+// updateHyperparameters - merges hyperparameters into the stored hyperparameters as
+// UpdateHyperparameters does or, if replace is set, replaces them as ReplaceHyperparameters does.
+func (store filesystemStorage) updateHyperparameters(ctx context.Context, hyperparameters storage.Hyperparameters, replace bool) (storage.Hyperparameters, error) {
+	store.lock.Lock()
+	defer store.lock.Unlock()
+
+	storedHyperparameters, err := store.GetHyperparameters(ctx, hyperparameters.ModelId, hyperparameters.HyperparametersId)
+	if err != nil {
+		return storage.Hyperparameters{}, err
+	}
+
+	err = storage.CheckVersion(storedHyperparameters.Version, hyperparameters.Version)
+	if err != nil {
+		return storage.Hyperparameters{}, err
+	}
+
+	err = storage.CheckHyperparametersReferences(ctx, store, storedHyperparameters, hyperparameters)
+	if err != nil {
+		return storage.Hyperparameters{}, err
+	}
+
+	if replace {
+		storedHyperparameters = storage.ReplacedHyperparameters(storedHyperparameters, hyperparameters)
+	} else {
+		if strings.TrimSpace(hyperparameters.CanonicalCheckpoint) != "" {
+			storedHyperparameters.CanonicalCheckpoint = hyperparameters.CanonicalCheckpoint
+		}
+		if strings.TrimSpace(hyperparameters.UpgradeTo) != "" {
+			storedHyperparameters.UpgradeTo = hyperparameters.UpgradeTo
+		}
+		if hyperparameters.Labels != nil {
+			storedHyperparameters.Labels = hyperparameters.Labels
+		}
+		if hyperparameters.Rollout != nil {
+			storedHyperparameters.Rollout = hyperparameters.Rollout
+		}
+
+		if hyperparameters.Hyperparameters != nil {
+			if storedHyperparameters.Hyperparameters == nil {
+				storedHyperparameters.Hyperparameters = make(map[string]string)
+			}
+			for k, v := range hyperparameters.Hyperparameters {
+				storedHyperparameters.Hyperparameters[k] = v
+			}
+		}
+	}
+
+	storedHyperparameters.Version++
+	bytes, err := json.Marshal(storedHyperparameters)
+	if err != nil {
+		return storage.Hyperparameters{}, err
+	}
+
+	err = writeObject(store.root, objHyperparametersPath(hyperparameters.ModelId, hyperparameters.HyperparametersId), bytes)
+	if err != nil {
+		return storage.Hyperparameters{}, err
+	}
+
+	err = addHyperparametersRevision(store.root, storedHyperparameters)
+	if err != nil {
+		return storage.Hyperparameters{}, err
+	}
+
+	return storedHyperparameters, nil
+}
+
+func (store filesystemStorage) ListCheckpoints(ctx context.Context, modelId, hyperparametersId, marker string, maxItems int, includeArchived bool) (storage.ListResult, error) {
+	_, err := store.GetHyperparameters(ctx, modelId, hyperparametersId)
+	if err != nil {
+		return storage.ListResult{}, err
+	}
+
+	listPage := func(marker string, maxItems int) ([]string, error) {
+		return listObjects(store.root, objCheckpointsDir(modelId, hyperparametersId), "checkpoint.json", marker, maxItems)
+	}
+
+	res, err := storage.ListVisibleCheckpoints(marker, maxItems+1, includeArchived, listPage, func(checkpointId string) (storage.Checkpoint, error) {
+		return store.GetCheckpoint(ctx, modelId, hyperparametersId, checkpointId)
+	})
+	if err != nil {
+		return storage.ListResult{}, err
+	}
+
+	return storage.NewListResult(res, maxItems), nil
+}
+
+func (store filesystemStorage) GetCheckpoint(ctx context.Context, modelId, hyperparametersId, checkpointId string) (storage.Checkpoint, error) {
+	_, err := store.GetHyperparameters(ctx, modelId, hyperparametersId)
+	if err != nil {
+		return storage.Checkpoint{}, err
+	}
+
+	if !common.IsValidID(checkpointId) {
+		return storage.Checkpoint{}, storage.CheckpointDoesNotExistError
+	}
+
+	bytes, err := readObject(store.root, objCheckpointPath(modelId, hyperparametersId, checkpointId))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return storage.Checkpoint{}, storage.CheckpointDoesNotExistError
+		}
+		return storage.Checkpoint{}, err
+	}
+
+	checkpoint := storage.Checkpoint{}
+
+	err = json.Unmarshal(bytes, &checkpoint)
+	if err != nil {
+		return storage.Checkpoint{}, err
+	}
+
+	return checkpoint, nil
+}
+
+func (store filesystemStorage) BatchGetCheckpoints(ctx context.Context, modelId, hyperparametersId string, checkpointIds []string) ([]storage.Checkpoint, error) {
+	_, err := store.GetHyperparameters(ctx, modelId, hyperparametersId)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]storage.Checkpoint, len(checkpointIds))
+	for i, checkpointId := range checkpointIds {
+		res[i], err = store.GetCheckpoint(ctx, modelId, hyperparametersId, checkpointId)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return res, nil
+}
+
+func (store filesystemStorage) AddCheckpoint(ctx context.Context, checkpoint storage.Checkpoint) error {
+	_, err := store.GetHyperparameters(ctx, checkpoint.ModelId, checkpoint.HyperparametersId)
+	if err != nil {
+		return err
+	}
+
+	if !common.IsValidID(checkpoint.CheckpointId) {
+		return storage.ErrInvalidCheckpointId
+	}
+
+	bytes, err := json.Marshal(checkpoint)
+	if err != nil {
+		return err
+	}
+
+	err = createObject(store.root, objCheckpointPath(checkpoint.ModelId, checkpoint.HyperparametersId, checkpoint.CheckpointId), bytes)
+	if err == errObjectExists {
+		return storage.CheckpointExistsError
+	}
+	return err
+}
+
+func (store filesystemStorage) UpdateCheckpointState(ctx context.Context, modelId, hyperparametersId, checkpointId string, state api.CheckpointState) (storage.Checkpoint, error) {
+	store.lock.Lock()
+	defer store.lock.Unlock()
+
+	checkpoint, err := store.GetCheckpoint(ctx, modelId, hyperparametersId, checkpointId)
+	if err != nil {
+		return storage.Checkpoint{}, err
+	}
+	checkpoint.State = state
+
+	bytes, err := json.Marshal(checkpoint)
+	if err != nil {
+		return storage.Checkpoint{}, err
+	}
+
+	err = writeObject(store.root, objCheckpointPath(modelId, hyperparametersId, checkpointId), bytes)
+	if err != nil {
+		return storage.Checkpoint{}, err
+	}
+
+	return checkpoint, nil
+}
+
+func (store filesystemStorage) UpdateCheckpointInfo(ctx context.Context, modelId, hyperparametersId, checkpointId string, info map[string]string) (storage.Checkpoint, error) {
+	store.lock.Lock()
+	defer store.lock.Unlock()
+
+	checkpoint, err := store.GetCheckpoint(ctx, modelId, hyperparametersId, checkpointId)
+	if err != nil {
+		return storage.Checkpoint{}, err
+	}
+	checkpoint.Info = info
+
+	bytes, err := json.Marshal(checkpoint)
+	if err != nil {
+		return storage.Checkpoint{}, err
+	}
+
+	err = writeObject(store.root, objCheckpointPath(modelId, hyperparametersId, checkpointId), bytes)
+	if err != nil {
+		return storage.Checkpoint{}, err
+	}
+
+	return checkpoint, nil
+}
+
+func (store filesystemStorage) DeleteModel(ctx context.Context, modelId string, options storage.DeleteOptions) error {
+	store.lock.Lock()
+	defer store.lock.Unlock()
+
+	_, err := store.GetModel(ctx, modelId)
+	if err != nil {
+		return err
+	}
+
+	if !options.Cascade {
+		children, err := listObjects(store.root, objHyperparametersDir(modelId), "params.json", "", 1)
+		if err != nil {
+			return err
+		}
+		if len(children) > 0 {
+			return storage.ErrResourceHasChildren
+		}
+	}
+
+	err = removeDir(store.root, filepath.Dir(objModelPath(modelId)))
+	if err != nil {
+		return err
+	}
+	return storage.RemoveLocalBundles(store.root, storage.ModelBundlesPrefix(modelId))
+}
+
+func (store filesystemStorage) DeleteHyperparameters(ctx context.Context, modelId, hyperparametersId string, options storage.DeleteOptions) error {
+	store.lock.Lock()
+	defer store.lock.Unlock()
+
+	model, err := store.GetModel(ctx, modelId)
+	if err != nil {
+		return err
+	}
+
+	_, err = store.GetHyperparameters(ctx, modelId, hyperparametersId)
+	if err != nil {
+		return err
+	}
+
+	if !options.Cascade {
+		children, err := listObjects(store.root, objCheckpointsDir(modelId, hyperparametersId), "checkpoint.json", "", 1)
+		if err != nil {
+			return err
+		}
+		if len(children) > 0 {
+			return storage.ErrResourceHasChildren
+		}
+	}
+
+	if !options.Force {
+		siblings, err := storage.GetAllHyperparameters(ctx, store, modelId)
+		if err != nil {
+			return err
+		}
+		if storage.IsHyperparametersReferenced(model, siblings, hyperparametersId) {
+			return storage.ErrResourceIsReferenced
+		}
+		tags, err := store.ListTags(ctx, modelId, "")
+		if err != nil {
+			return err
+		}
+		if storage.IsTagged(tags, hyperparametersId) {
+			return storage.ErrResourceIsReferenced
+		}
+	}
+
+	err = removeDir(store.root, filepath.Dir(objHyperparametersPath(modelId, hyperparametersId)))
+	if err != nil {
+		return err
+	}
+	return storage.RemoveLocalBundles(store.root, storage.HyperparametersBundlesPrefix(modelId, hyperparametersId))
+}
+
+func (store filesystemStorage) DeleteCheckpoint(ctx context.Context, modelId, hyperparametersId, checkpointId string, options storage.DeleteOptions) error {
+	store.lock.Lock()
+	defer store.lock.Unlock()
+
+	hyperparameters, err := store.GetHyperparameters(ctx, modelId, hyperparametersId)
+	if err != nil {
+		return err
+	}
+
+	_, err = store.GetCheckpoint(ctx, modelId, hyperparametersId, checkpointId)
+	if err != nil {
+		return err
+	}
+
+	if !options.Force {
+		if hyperparameters.CanonicalCheckpoint == checkpointId || storage.IsInRollout(hyperparameters.Rollout, checkpointId) {
+			return storage.ErrResourceIsReferenced
+		}
+		tags, err := store.ListTags(ctx, modelId, hyperparametersId)
+		if err != nil {
+			return err
+		}
+		if storage.IsTagged(tags, checkpointId) {
+			return storage.ErrResourceIsReferenced
+		}
+	}
+
+	err = removeDir(store.root, filepath.Dir(objCheckpointPath(modelId, hyperparametersId, checkpointId)))
+	if err != nil {
+		return err
+	}
+	return storage.RemoveLocalBundles(store.root, storage.CheckpointBundlePath(modelId, hyperparametersId, checkpointId))
+}
+
+// CheckpointUploadURL - bundles are stored under the root like everything else, so the upload URL
+// is a file:// URL.
+func (store filesystemStorage) CheckpointUploadURL(ctx context.Context, modelId, hyperparametersId, checkpointId string, expires time.Time) (string, string, error) {
+	return storage.LocalCheckpointUploadURL(store.root, modelId, hyperparametersId, checkpointId, expires)
+}
+
+func (store filesystemStorage) CheckpointBundleExists(ctx context.Context, modelId, hyperparametersId, checkpointId string) (bool, error) {
+	return storage.LocalCheckpointBundleExists(store.root, modelId, hyperparametersId, checkpointId)
+}
+
+// SignCheckpointLink - uploaded bundles are local files, so there is nothing to sign.
+func (store filesystemStorage) SignCheckpointLink(ctx context.Context, link string, expires time.Time) (string, error) {
+	return link, nil
+}
+
+func (store filesystemStorage) OpenCheckpointLink(ctx context.Context, link string) (io.ReadCloser, error) {
+	return storage.OpenLocalLink(store.root, link)
+}
+
+func readObject(root, objLoc string) ([]byte, error) {
+	return ioutil.ReadFile(filepath.Join(root, objLoc))
+}
+
+// writeTempObject writes bytes to a temporary file in the same directory as the object so that it
+// can later be moved into place atomically. The caller is responsible for removing the file.
+func writeTempObject(root, objLoc string, bytes []byte) (string, error) {
+	path := filepath.Join(root, objLoc)
+	dir := filepath.Dir(path)
+	err := os.MkdirAll(dir, 0755)
+	if err != nil {
+		return "", err
+	}
+
+	tmp, err := ioutil.TempFile(dir, "."+filepath.Base(path)+".tmp-")
+	if err != nil {
+		return "", err
+	}
+
+	_, err = tmp.Write(bytes)
+	if err == nil {
+		err = tmp.Sync()
+	}
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		os.Remove(tmp.Name())
+		return "", err
+	}
+
+	return tmp.Name(), nil
+}
+
+// writeObject atomically creates or replaces the object at objLoc.
+func writeObject(root, objLoc string, bytes []byte) error {
+	tmpPath, err := writeTempObject(root, objLoc, bytes)
+	if err != nil {
+		return err
+	}
+
+	err = os.Rename(tmpPath, filepath.Join(root, objLoc))
+	if err != nil {
+		os.Remove(tmpPath)
+		return err
+	}
+
+	return nil
+}
+
+// createObject atomically creates the object at objLoc, returning errObjectExists if it is already
+// present. Linking (unlike renaming) fails if the destination exists, which avoids a separate
+// existence check.
+func createObject(root, objLoc string, bytes []byte) error {
+	tmpPath, err := writeTempObject(root, objLoc, bytes)
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmpPath)
+
+	err = os.Link(tmpPath, filepath.Join(root, objLoc))
+	if err != nil {
+		if os.IsExist(err) {
+			return errObjectExists
+		}
+		return err
+	}
+
+	return nil
+}
+
+// removeDir moves dir (and everything below it) out of the tree in a single rename, so that it
+// disappears atomically, and only then deletes it.
+func removeDir(root, dir string) error {
+	trash := filepath.Join(root, trashDir)
+	err := os.MkdirAll(trash, 0755)
+	if err != nil {
+		return err
+	}
+
+	tmp, err := ioutil.TempDir(trash, "")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(tmp)
+
+	return os.Rename(filepath.Join(root, dir), filepath.Join(tmp, filepath.Base(dir)))
+}
+
+// listObjects returns, in lexicographic order, the names of the subdirectories of dir which come
+// after marker and contain a file called leaf.
+func listObjects(root, dir, leaf, marker string, maxItems int) ([]string, error) {
+	res := make([]string, 0)
+
+	entries, err := ioutil.ReadDir(filepath.Join(root, dir))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return res, nil
+		}
+		return nil, err
+	}
+
+	for _, entry := range entries {
+		if len(res) >= maxItems {
+			break
+		}
+
+		name := entry.Name()
+		if !entry.IsDir() || name <= marker {
+			continue
+		}
+
+		_, err := os.Stat(filepath.Join(root, dir, name, leaf))
+		if err != nil {
+			if os.IsNotExist(err) {
+				continue
+			}
+			return nil, err
+		}
+
+		res = append(res, name)
+	}
+
+	return res, nil
+}
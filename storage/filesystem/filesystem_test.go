@@ -0,0 +1,179 @@
+package filesystem_test
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/doc-ai/tensorio-models/internal/tests"
+	"github.com/doc-ai/tensorio-models/storage"
+	"github.com/doc-ai/tensorio-models/storage/filesystem"
+)
+
+func newTestStorage(t *testing.T) (storage.RepositoryStorage, string) {
+	root, err := ioutil.TempDir("", "tensorio-models-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return filesystem.NewFilesystemStorage(root), root
+}
+
+func TestFilesystem_AddModel(t *testing.T) {
+	store, root := newTestStorage(t)
+	defer os.RemoveAll(root)
+	tests.Test_AddModel(t, store)
+}
+
+func TestFilesystem_ListModels(t *testing.T) {
+	store, root := newTestStorage(t)
+	defer os.RemoveAll(root)
+	tests.Test_ListModels(t, store)
+}
+
+func TestFilesystem_UpdateModel(t *testing.T) {
+	store, root := newTestStorage(t)
+	defer os.RemoveAll(root)
+	tests.Test_UpdateModels(t, store)
+}
+
+func TestFilesystem_AddHyperparameters(t *testing.T) {
+	store, root := newTestStorage(t)
+	defer os.RemoveAll(root)
+	tests.Test_AddHyperparameters(t, store)
+}
+
+func TestFilesystem_ListHyperparameters(t *testing.T) {
+	store, root := newTestStorage(t)
+	defer os.RemoveAll(root)
+	tests.Test_ListHyperparams(t, store)
+}
+
+func TestFilesystem_UpdateHyperparameters(t *testing.T) {
+	store, root := newTestStorage(t)
+	defer os.RemoveAll(root)
+	tests.Test_UpdateHyperparams(t, store)
+}
+
+func TestFilesystem_UpdateVersions(t *testing.T) {
+	store, root := newTestStorage(t)
+	defer os.RemoveAll(root)
+	tests.Test_UpdateVersions(t, store)
+}
+
+func TestFilesystem_ConcurrentUpdates(t *testing.T) {
+	store, root := newTestStorage(t)
+	defer os.RemoveAll(root)
+	tests.Test_ConcurrentUpdates(t, store)
+}
+
+func TestFilesystem_Revisions(t *testing.T) {
+	store, root := newTestStorage(t)
+	defer os.RemoveAll(root)
+	tests.Test_Revisions(t, store)
+}
+
+func TestFilesystem_Replace(t *testing.T) {
+	store, root := newTestStorage(t)
+	defer os.RemoveAll(root)
+	tests.Test_Replace(t, store)
+}
+
+func TestFilesystem_AddCheckpoint(t *testing.T) {
+	store, root := newTestStorage(t)
+	defer os.RemoveAll(root)
+	tests.Test_AddCheckpoint(t, store)
+}
+
+func TestFilesystem_ListCheckpoints(t *testing.T) {
+	store, root := newTestStorage(t)
+	defer os.RemoveAll(root)
+	tests.Test_ListCheckpoints(t, store)
+}
+
+func TestFilesystem_UpdateCheckpointState(t *testing.T) {
+	store, root := newTestStorage(t)
+	defer os.RemoveAll(root)
+	tests.Test_UpdateCheckpointState(t, store)
+}
+
+func TestFilesystem_DeleteModel(t *testing.T) {
+	store, root := newTestStorage(t)
+	defer os.RemoveAll(root)
+	tests.Test_DeleteModel(t, store)
+}
+
+func TestFilesystem_DeleteHyperparameters(t *testing.T) {
+	store, root := newTestStorage(t)
+	defer os.RemoveAll(root)
+	tests.Test_DeleteHyperparameters(t, store)
+}
+
+func TestFilesystem_DeleteCheckpoint(t *testing.T) {
+	store, root := newTestStorage(t)
+	defer os.RemoveAll(root)
+	tests.Test_DeleteCheckpoint(t, store)
+}
+
+func TestFilesystem_Tags(t *testing.T) {
+	store, root := newTestStorage(t)
+	defer os.RemoveAll(root)
+	tests.Test_Tags(t, store)
+}
+
+func TestFilesystem_Rollouts(t *testing.T) {
+	store, root := newTestStorage(t)
+	defer os.RemoveAll(root)
+	tests.Test_Rollouts(t, store)
+}
+
+func TestFilesystem_FindDanglingReferences(t *testing.T) {
+	store, root := newTestStorage(t)
+	defer os.RemoveAll(root)
+	tests.Test_FindDanglingReferences(t, store)
+}
+
+func TestFilesystem_BatchGet(t *testing.T) {
+	store, root := newTestStorage(t)
+	defer os.RemoveAll(root)
+	tests.Test_BatchGet(t, store)
+}
+
+func TestFilesystem_Labels(t *testing.T) {
+	store, root := newTestStorage(t)
+	defer os.RemoveAll(root)
+	tests.Test_Labels(t, store)
+}
+
+func TestFilesystem_AuditEvents(t *testing.T) {
+	store, root := newTestStorage(t)
+	defer os.RemoveAll(root)
+	tests.Test_AuditEvents(t, store)
+
+	fleaRoot, err := ioutil.TempDir("", "tensorio-models-flea-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(fleaRoot)
+	tests.Test_AuditEvents(t, filesystem.NewFleaFilesystemStorage(fleaRoot, "http://repository", "file://"+fleaRoot))
+}
+
+func TestFilesystem_Webhooks(t *testing.T) {
+	store, root := newTestStorage(t)
+	defer os.RemoveAll(root)
+	tests.Test_Webhooks(t, store)
+}
+
+func TestFilesystem_FleaTasks(t *testing.T) {
+	root, err := ioutil.TempDir("", "tensorio-models-flea-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(root)
+	tests.Test_FleaTasks(t, filesystem.NewFleaFilesystemStorage(root, "http://repository", "file://"+root))
+}
+
+func TestFilesystem_CheckpointUpload(t *testing.T) {
+	store, root := newTestStorage(t)
+	defer os.RemoveAll(root)
+	tests.Test_CheckpointUpload(t, store, tests.UploadToFileURL)
+}
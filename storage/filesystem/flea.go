@@ -0,0 +1,185 @@
+package filesystem
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/doc-ai/tensorio-models/api"
+	"github.com/doc-ai/tensorio-models/common"
+	"github.com/doc-ai/tensorio-models/storage"
+	"github.com/google/uuid"
+)
+
+type flea struct {
+	auditLog
+	webhookStore
+	root              string
+	lock              *sync.Mutex
+	repositoryBaseURL string
+	uploadReqURL      string
+}
+
+// GenerateNewFleaFilesystemStorageFromEnv - Uses the FLEA_FILESYSTEM_ROOT environment variable to
+// instantiate a local filesystem storage backend for FLEA. Job uploads are directed to
+// FLEA_FILESYSTEM_UPLOAD_URL if it is set, and to the tasksJobs directory under the root otherwise.
+func GenerateNewFleaFilesystemStorageFromEnv(repositoryBaseURL string) storage.FleaStorage {
+	root := os.Getenv("FLEA_FILESYSTEM_ROOT")
+	if root == "" {
+		err := errors.New("FLEA_FILESYSTEM_ROOT environment variable not defined")
+		panic(err)
+	}
+
+	err := os.MkdirAll(root, 0755)
+	if err != nil {
+		panic(err)
+	}
+
+	uploadReqURL := os.Getenv("FLEA_FILESYSTEM_UPLOAD_URL")
+	if uploadReqURL == "" {
+		absRoot, err := filepath.Abs(root)
+		if err != nil {
+			panic(err)
+		}
+		uploadReqURL = "file://" + filepath.ToSlash(absRoot)
+	}
+
+	return NewFleaFilesystemStorage(root, repositoryBaseURL, uploadReqURL)
+}
+
+// NewFleaFilesystemStorage - returns a local filesystem implementation of FleaStorage interface.
+func NewFleaFilesystemStorage(root, repositoryBaseURL, uploadReqURL string) storage.FleaStorage {
+	return &flea{
+		auditLog:          auditLog{auditRoot: root},
+		webhookStore:      webhookStore{webhooksRoot: root},
+		root:              root,
+		lock:              &sync.Mutex{},
+		repositoryBaseURL: repositoryBaseURL,
+		uploadReqURL:      uploadReqURL,
+	}
+}
+
+func (store flea) GetStorageType() string {
+	return StorageType
+}
+
+func (store flea) GetBucketName() string {
+	return ""
+}
+
+func (store flea) AddTask(ctx context.Context, req api.TaskDetails) error {
+	if !common.IsValidID(req.TaskId) {
+		return storage.ErrInvalidTaskId
+	}
+
+	bytes, err := json.Marshal(req)
+	if err != nil {
+		return err
+	}
+
+	err = createObject(store.root, objTaskPath(req.TaskId), bytes)
+	if err == errObjectExists {
+		return storage.ErrDuplicateTaskId
+	}
+	return err
+}
+
+func (store flea) GetTask(ctx context.Context, taskId string) (api.TaskDetails, error) {
+	task := api.TaskDetails{}
+	if !common.IsValidID(taskId) {
+		return task, storage.ErrMissingTaskId
+	}
+
+	bytes, err := readObject(store.root, objTaskPath(taskId))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return task, storage.ErrMissingTaskId
+		}
+		return task, err
+	}
+
+	err = json.Unmarshal(bytes, &task)
+	task.CheckpointLink = store.repositoryBaseURL + common.GetCheckpointResourcePath(
+		task.ModelId, task.HyperparametersId, task.CheckpointId)
+	return task, err
+}
+
+func (store flea) BatchGetTasks(ctx context.Context, taskIds []string) ([]api.TaskDetails, error) {
+	tasks := make([]api.TaskDetails, len(taskIds))
+	for i, taskId := range taskIds {
+		task, err := store.GetTask(ctx, taskId)
+		if err != nil {
+			return nil, err
+		}
+		tasks[i] = task
+	}
+
+	return tasks, nil
+}
+
+func (store flea) ModifyTask(ctx context.Context, req api.ModifyTaskRequest) error {
+	store.lock.Lock()
+	defer store.lock.Unlock()
+
+	task, err := store.GetTask(ctx, req.TaskId)
+	if err != nil {
+		return err
+	}
+	task.Deadline = req.Deadline
+	task.Active = req.Active
+
+	bytes, err := json.Marshal(task)
+	if err != nil {
+		return err
+	}
+	return writeObject(store.root, objTaskPath(req.TaskId), bytes)
+}
+
+func (store flea) StartTask(ctx context.Context, taskId string) (api.StartTaskResponse, error) {
+	resp := api.StartTaskResponse{}
+	_, err := store.GetTask(ctx, taskId)
+	if err != nil {
+		return resp, err
+	}
+	jobId := uuid.New().String()
+	resp.JobId = jobId
+	resp.UploadTo = fmt.Sprintf("%s/tasksJobs/%s/%s.zip", store.uploadReqURL, taskId, jobId)
+	resp.Status = api.StartTaskResponse_APPROVED
+	return resp, nil
+}
+
+// Expects that the input sanity checks are done by the caller.
+func (store flea) ListTasks(ctx context.Context, req api.ListTasksRequest) (api.ListTasksResponse, error) {
+	candidates, err := listObjects(store.root, objTasksDir(), "task.json", "", math.MaxInt32)
+	if err != nil {
+		return api.ListTasksResponse{}, err
+	}
+
+	return storage.ListTaskCandidates(req, candidates, func(taskId string) (api.TaskDetails, error) {
+		return store.GetTask(ctx, taskId)
+	})
+}
+
+func (store flea) AddJobError(ctx context.Context, req api.JobErrorRequest) error {
+	// Sanity check that task exists.
+	_, err := store.GetTask(ctx, req.TaskId)
+	if err != nil {
+		return err
+	}
+
+	if !common.IsValidID(req.JobId) {
+		return storage.ErrInvalidJobId
+	}
+
+	// We only store the last error.
+	bytes, err := json.Marshal(req)
+	if err != nil {
+		return err
+	}
+	return writeObject(store.root, objJobErrorPath(req.TaskId, req.JobId), bytes)
+}
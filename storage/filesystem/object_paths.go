@@ -0,0 +1,110 @@
+package filesystem
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/doc-ai/tensorio-models/storage"
+)
+
+// Object paths mirror the layout used by the GCS backend so that a repository directory can be
+// synced to or from a bucket without any translation.
+
+func objModelsDir() string {
+	return "models"
+}
+
+func objModelPath(modelId string) string {
+	objLoc := fmt.Sprintf("models/%s/model.json", modelId)
+	return filepath.FromSlash(objLoc)
+}
+
+func objHyperparametersDir(modelId string) string {
+	objLoc := fmt.Sprintf("models/%s/hyperparameters", modelId)
+	return filepath.FromSlash(objLoc)
+}
+
+func objHyperparametersPath(modelId string, hyperparametersId string) string {
+	objLoc := fmt.Sprintf("models/%s/hyperparameters/%s/params.json", modelId, hyperparametersId)
+	return filepath.FromSlash(objLoc)
+}
+
+func objCheckpointsDir(modelId string, hyperparametersId string) string {
+	objLoc := fmt.Sprintf("models/%s/hyperparameters/%s/checkpoints", modelId, hyperparametersId)
+	return filepath.FromSlash(objLoc)
+}
+
+func objCheckpointPath(modelId string, hyperparametersId string, checkpointId string) string {
+	objLoc := fmt.Sprintf("models/%s/hyperparameters/%s/checkpoints/%s/checkpoint.json", modelId, hyperparametersId, checkpointId)
+	return filepath.FromSlash(objLoc)
+}
+
+func objTasksDir() string {
+	return "tasks"
+}
+
+func objTaskPath(taskId string) string {
+	return filepath.FromSlash("tasks/" + taskId + "/task.json")
+}
+
+func objJobErrorPath(taskId string, jobId string) string {
+	return filepath.FromSlash("tasks/" + taskId + "/errors/" + jobId + ".json")
+}
+
+func objAuditDir() string {
+	return "audit"
+}
+
+// objAuditPartitionDir - see storage.AuditPartition.
+func objAuditPartitionDir(partition string) string {
+	return filepath.Join(objAuditDir(), partition)
+}
+
+func objAuditEventPath(eventId string) string {
+	return filepath.Join(objAuditPartitionDir(storage.AuditPartition(eventId)), eventId+".json")
+}
+
+func objWebhooksDir() string {
+	return "webhooks"
+}
+
+func objWebhookPath(webhookId string) string {
+	return filepath.FromSlash("webhooks/" + webhookId + ".json")
+}
+
+func objWebhookDeadLettersDir() string {
+	return "webhook-dead-letters"
+}
+
+func objWebhookDeadLetterPath(deliveryId string) string {
+	return filepath.FromSlash("webhook-dead-letters/" + deliveryId + ".json")
+}
+
+func objModelRevisionsDir(modelId string) string {
+	return filepath.FromSlash(fmt.Sprintf("models/%s/revisions", modelId))
+}
+
+func objModelRevisionPath(modelId string, version int64) string {
+	return filepath.Join(objModelRevisionsDir(modelId), storage.RevisionKey(version)+".json")
+}
+
+func objHyperparametersRevisionsDir(modelId string, hyperparametersId string) string {
+	return filepath.FromSlash(fmt.Sprintf("models/%s/hyperparameters/%s/revisions", modelId, hyperparametersId))
+}
+
+func objHyperparametersRevisionPath(modelId string, hyperparametersId string, version int64) string {
+	return filepath.Join(objHyperparametersRevisionsDir(modelId, hyperparametersId), storage.RevisionKey(version)+".json")
+}
+
+// objTagsDir - the directory holding the tags on a model, or on hyperparameters if
+// hyperparametersId is set.
+func objTagsDir(modelId string, hyperparametersId string) string {
+	if hyperparametersId == "" {
+		return filepath.FromSlash(fmt.Sprintf("models/%s/tags", modelId))
+	}
+	return filepath.FromSlash(fmt.Sprintf("models/%s/hyperparameters/%s/tags", modelId, hyperparametersId))
+}
+
+func objTagPath(modelId string, hyperparametersId string, tag string) string {
+	return filepath.Join(objTagsDir(modelId, hyperparametersId), tag+".json")
+}
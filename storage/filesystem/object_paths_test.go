@@ -0,0 +1,37 @@
+package filesystem
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_objModelPath(t *testing.T) {
+	modelId := "model1"
+	modelPath := filepath.FromSlash("models/model1/model.json")
+
+	assert.Equal(t, modelPath, objModelPath(modelId))
+}
+
+func Test_objHyperparametersPath(t *testing.T) {
+	modelId := "model1"
+	paramId := "param2"
+	modelPath := filepath.FromSlash("models/model1/hyperparameters/param2/params.json")
+
+	assert.Equal(t, modelPath, objHyperparametersPath(modelId, paramId))
+}
+
+func Test_objCheckpointPath(t *testing.T) {
+	modelId := "model1"
+	paramId := "param2"
+	checkpointId := "checkpoint3"
+	modelPath := filepath.FromSlash("models/model1/hyperparameters/param2/checkpoints/checkpoint3/checkpoint.json")
+
+	assert.Equal(t, modelPath, objCheckpointPath(modelId, paramId, checkpointId))
+}
+
+func Test_objRevisionPaths(t *testing.T) {
+	assert.Equal(t, filepath.FromSlash("models/model1/revisions/00000000000000000012.json"), objModelRevisionPath("model1", 12))
+	assert.Equal(t, filepath.FromSlash("models/model1/hyperparameters/param2/revisions/00000000000000000003.json"), objHyperparametersRevisionPath("model1", "param2", 3))
+}
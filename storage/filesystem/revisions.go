@@ -0,0 +1,147 @@
+package filesystem
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/doc-ai/tensorio-models/storage"
+)
+
+func (store filesystemStorage) ListModelRevisions(ctx context.Context, modelId string, afterVersion int64, maxItems int) ([]storage.ModelRevision, error) {
+	_, err := store.GetModel(ctx, modelId)
+	if err != nil {
+		return nil, err
+	}
+
+	dir := objModelRevisionsDir(modelId)
+	names, err := listRevisions(store.root, dir, afterVersion, maxItems)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]storage.ModelRevision, len(names))
+	for i, name := range names {
+		err = readRevision(store.root, filepath.Join(dir, name), &res[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+func (store filesystemStorage) GetModelRevision(ctx context.Context, modelId string, version int64) (storage.ModelRevision, error) {
+	_, err := store.GetModel(ctx, modelId)
+	if err != nil {
+		return storage.ModelRevision{}, err
+	}
+
+	revision := storage.ModelRevision{}
+	err = readRevision(store.root, objModelRevisionPath(modelId, version), &revision)
+	if err != nil {
+		return storage.ModelRevision{}, err
+	}
+	return revision, nil
+}
+
+func (store filesystemStorage) ListHyperparametersRevisions(ctx context.Context, modelId, hyperparametersId string, afterVersion int64, maxItems int) ([]storage.HyperparametersRevision, error) {
+	_, err := store.GetHyperparameters(ctx, modelId, hyperparametersId)
+	if err != nil {
+		return nil, err
+	}
+
+	dir := objHyperparametersRevisionsDir(modelId, hyperparametersId)
+	names, err := listRevisions(store.root, dir, afterVersion, maxItems)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]storage.HyperparametersRevision, len(names))
+	for i, name := range names {
+		err = readRevision(store.root, filepath.Join(dir, name), &res[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+func (store filesystemStorage) GetHyperparametersRevision(ctx context.Context, modelId, hyperparametersId string, version int64) (storage.HyperparametersRevision, error) {
+	_, err := store.GetHyperparameters(ctx, modelId, hyperparametersId)
+	if err != nil {
+		return storage.HyperparametersRevision{}, err
+	}
+
+	revision := storage.HyperparametersRevision{}
+	err = readRevision(store.root, objHyperparametersRevisionPath(modelId, hyperparametersId, version), &revision)
+	if err != nil {
+		return storage.HyperparametersRevision{}, err
+	}
+	return revision, nil
+}
+
+func addModelRevision(root string, model storage.Model) error {
+	bytes, err := json.Marshal(storage.ModelRevision{Model: model, CreatedAt: time.Now()})
+	if err != nil {
+		return err
+	}
+	return createObject(root, objModelRevisionPath(model.ModelId, model.Version), bytes)
+}
+
+func addHyperparametersRevision(root string, hyperparameters storage.Hyperparameters) error {
+	bytes, err := json.Marshal(storage.HyperparametersRevision{Hyperparameters: hyperparameters, CreatedAt: time.Now()})
+	if err != nil {
+		return err
+	}
+	objLoc := objHyperparametersRevisionPath(hyperparameters.ModelId, hyperparameters.HyperparametersId, hyperparameters.Version)
+	return createObject(root, objLoc, bytes)
+}
+
+// readRevision - unmarshals the revision at objLoc into v, returning RevisionDoesNotExistError if
+// there is none.
+func readRevision(root, objLoc string, v interface{}) error {
+	bytes, err := readObject(root, objLoc)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return storage.RevisionDoesNotExistError
+		}
+		return err
+	}
+	return json.Unmarshal(bytes, v)
+}
+
+// listRevisions returns, in order of version, the names of up to maxItems revision files in dir
+// whose versions come after afterVersion.
+func listRevisions(root, dir string, afterVersion int64, maxItems int) ([]string, error) {
+	res := make([]string, 0)
+
+	entries, err := ioutil.ReadDir(filepath.Join(root, dir))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return res, nil
+		}
+		return nil, err
+	}
+
+	marker := storage.RevisionKey(afterVersion)
+	for _, entry := range entries {
+		if len(res) >= maxItems {
+			break
+		}
+
+		name := entry.Name()
+		key := strings.TrimSuffix(name, ".json")
+		// Skips the temporary files of revisions which are still being written
+		if entry.IsDir() || strings.HasPrefix(name, ".") || key == name || key <= marker {
+			continue
+		}
+
+		res = append(res, name)
+	}
+
+	return res, nil
+}
@@ -0,0 +1,109 @@
+package filesystem
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/doc-ai/tensorio-models/storage"
+)
+
+func (store filesystemStorage) SetTag(ctx context.Context, tag storage.Tag) (storage.Tag, error) {
+	store.lock.Lock()
+	defer store.lock.Unlock()
+
+	err := store.checkTagsOwner(ctx, tag.ModelId, tag.HyperparametersId)
+	if err != nil {
+		return storage.Tag{}, err
+	}
+
+	tag.UpdatedAt = time.Now()
+	tagJSON, err := json.Marshal(tag)
+	if err != nil {
+		return storage.Tag{}, err
+	}
+	err = writeObject(store.root, objTagPath(tag.ModelId, tag.HyperparametersId, tag.Tag), tagJSON)
+	if err != nil {
+		return storage.Tag{}, err
+	}
+	return tag, nil
+}
+
+func (store filesystemStorage) GetTag(ctx context.Context, modelId, hyperparametersId, tag string) (storage.Tag, error) {
+	err := store.checkTagsOwner(ctx, modelId, hyperparametersId)
+	if err != nil {
+		return storage.Tag{}, err
+	}
+
+	tagJSON, err := readObject(store.root, objTagPath(modelId, hyperparametersId, tag))
+	if os.IsNotExist(err) {
+		return storage.Tag{}, storage.TagDoesNotExistError
+	}
+	if err != nil {
+		return storage.Tag{}, err
+	}
+
+	res := storage.Tag{}
+	err = json.Unmarshal(tagJSON, &res)
+	if err != nil {
+		return storage.Tag{}, err
+	}
+	return res, nil
+}
+
+func (store filesystemStorage) ListTags(ctx context.Context, modelId, hyperparametersId string) ([]storage.Tag, error) {
+	err := store.checkTagsOwner(ctx, modelId, hyperparametersId)
+	if err != nil {
+		return nil, err
+	}
+
+	names, err := listFiles(store.root, objTagsDir(modelId, hyperparametersId), "", -1)
+	if err != nil {
+		return nil, err
+	}
+	// File names sort differently from tag names because of their .json extension
+	sort.Strings(names)
+
+	res := make([]storage.Tag, len(names))
+	for i, name := range names {
+		tagJSON, err := readObject(store.root, objTagPath(modelId, hyperparametersId, name))
+		if err != nil {
+			return nil, err
+		}
+		err = json.Unmarshal(tagJSON, &res[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+func (store filesystemStorage) DeleteTag(ctx context.Context, modelId, hyperparametersId, tag string) error {
+	store.lock.Lock()
+	defer store.lock.Unlock()
+
+	err := store.checkTagsOwner(ctx, modelId, hyperparametersId)
+	if err != nil {
+		return err
+	}
+
+	err = os.Remove(filepath.Join(store.root, objTagPath(modelId, hyperparametersId, tag)))
+	if os.IsNotExist(err) {
+		return storage.TagDoesNotExistError
+	}
+	return err
+}
+
+// checkTagsOwner - checks that the model (if hyperparametersId is "") or hyperparameters which tags
+// are on exist.
+func (store filesystemStorage) checkTagsOwner(ctx context.Context, modelId, hyperparametersId string) error {
+	if hyperparametersId == "" {
+		_, err := store.GetModel(ctx, modelId)
+		return err
+	}
+	_, err := store.GetHyperparameters(ctx, modelId, hyperparametersId)
+	return err
+}
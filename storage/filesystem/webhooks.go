@@ -0,0 +1,113 @@
+package filesystem
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/doc-ai/tensorio-models/storage"
+)
+
+// webhookStore - stores each webhook in its own file under the webhooks directory, and each dead
+// letter in its own file under the webhook-dead-letters directory. Shared by the repository and
+// FLEA storage.
+type webhookStore struct {
+	webhooksRoot string
+}
+
+func (store webhookStore) AddWebhook(ctx context.Context, webhook storage.Webhook) error {
+	webhookJSON, err := json.Marshal(webhook)
+	if err != nil {
+		return err
+	}
+	return writeObject(store.webhooksRoot, objWebhookPath(webhook.WebhookId), webhookJSON)
+}
+
+func (store webhookStore) ListWebhooks(ctx context.Context) ([]storage.Webhook, error) {
+	names, err := listFiles(store.webhooksRoot, objWebhooksDir(), "", -1)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]storage.Webhook, len(names))
+	for i, name := range names {
+		webhookJSON, err := readObject(store.webhooksRoot, objWebhookPath(name))
+		if err != nil {
+			return nil, err
+		}
+		err = json.Unmarshal(webhookJSON, &res[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+func (store webhookStore) DeleteWebhook(ctx context.Context, webhookId string) error {
+	err := os.Remove(filepath.Join(store.webhooksRoot, objWebhookPath(webhookId)))
+	if os.IsNotExist(err) {
+		return storage.WebhookDoesNotExistError
+	}
+	return err
+}
+
+func (store webhookStore) AddWebhookDeadLetter(ctx context.Context, deadLetter storage.WebhookDeadLetter) error {
+	deadLetterJSON, err := json.Marshal(deadLetter)
+	if err != nil {
+		return err
+	}
+	return createObject(store.webhooksRoot, objWebhookDeadLetterPath(deadLetter.DeliveryId), deadLetterJSON)
+}
+
+func (store webhookStore) ListWebhookDeadLetters(ctx context.Context, marker string, maxItems int) ([]storage.WebhookDeadLetter, error) {
+	deliveryIds, err := listFiles(store.webhooksRoot, objWebhookDeadLettersDir(), marker, maxItems)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]storage.WebhookDeadLetter, len(deliveryIds))
+	for i, deliveryId := range deliveryIds {
+		deadLetterJSON, err := readObject(store.webhooksRoot, objWebhookDeadLetterPath(deliveryId))
+		if err != nil {
+			return nil, err
+		}
+		err = json.Unmarshal(deadLetterJSON, &res[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+// listFiles - returns, in order, the names (without their .json extension) of up to maxItems
+// JSON files in dir which come after marker. A negative maxItems lists every file.
+func listFiles(root, dir, marker string, maxItems int) ([]string, error) {
+	res := make([]string, 0)
+
+	entries, err := ioutil.ReadDir(filepath.Join(root, dir))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return res, nil
+		}
+		return nil, err
+	}
+
+	for _, entry := range entries {
+		if maxItems >= 0 && len(res) >= maxItems {
+			break
+		}
+
+		name := strings.TrimSuffix(entry.Name(), ".json")
+		// Skips the temporary files of objects which are still being written
+		if entry.IsDir() || strings.HasPrefix(entry.Name(), ".") || name == entry.Name() || name <= marker {
+			continue
+		}
+
+		res = append(res, name)
+	}
+
+	return res, nil
+}
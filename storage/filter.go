@@ -0,0 +1,198 @@
+package storage
+
+import (
+	"context"
+	"time"
+
+	"github.com/doc-ai/tensorio-models/filter"
+)
+
+// filterPageSize - how many resources FilterModels, FilterHyperparameters and FilterCheckpoints
+// fetch from storage at a time.
+const filterPageSize = 100
+
+// ModelFields - the fields of a model which filter expressions can refer to: modelId, details,
+// canonicalHyperparameters, label.<key> for each of its labels and, for models with a card,
+// card.framework and card.license.
+func ModelFields(model Model) map[string]string {
+	fields := map[string]string{
+		"modelId":                  model.ModelId,
+		"details":                  model.Details,
+		"canonicalHyperparameters": model.CanonicalHyperparameters,
+	}
+	addPrefixed(fields, "label.", model.Labels)
+	if model.Card != nil {
+		fields["card.framework"] = model.Card.Framework
+		fields["card.license"] = model.Card.License
+	}
+	return fields
+}
+
+// HyperparametersFields - the fields of hyperparameters which filter expressions can refer to:
+// modelId, hyperparametersId, canonicalCheckpoint, upgradeTo, hyperparameters.<key> for each
+// hyperparameter and label.<key> for each label.
+func HyperparametersFields(hyperparameters Hyperparameters) map[string]string {
+	fields := map[string]string{
+		"modelId":             hyperparameters.ModelId,
+		"hyperparametersId":   hyperparameters.HyperparametersId,
+		"canonicalCheckpoint": hyperparameters.CanonicalCheckpoint,
+		"upgradeTo":           hyperparameters.UpgradeTo,
+	}
+	addPrefixed(fields, "hyperparameters.", hyperparameters.Hyperparameters)
+	addPrefixed(fields, "label.", hyperparameters.Labels)
+	return fields
+}
+
+// CheckpointFields - the fields of a checkpoint which filter expressions can refer to: modelId,
+// hyperparametersId, checkpointId, link, state (e.g. ACTIVE), createdAt (in RFC 3339 format, so
+// that it compares chronologically), info.<key> for each info entry and label.<key> for each label.
+func CheckpointFields(checkpoint Checkpoint) map[string]string {
+	fields := map[string]string{
+		"modelId":           checkpoint.ModelId,
+		"hyperparametersId": checkpoint.HyperparametersId,
+		"checkpointId":      checkpoint.CheckpointId,
+		"link":              checkpoint.Link,
+		"state":             checkpoint.State.String(),
+		"createdAt":         checkpoint.CreatedAt.UTC().Format(time.RFC3339),
+	}
+	addPrefixed(fields, "info.", checkpoint.Info)
+	addPrefixed(fields, "label.", checkpoint.Labels)
+	return fields
+}
+
+func addPrefixed(fields map[string]string, prefix string, values map[string]string) {
+	for k, v := range values {
+		fields[prefix+k] = v
+	}
+}
+
+// FilterModels - lists up to maxItems models following marker which match f, paging through the
+// models in storage as needed. The matching models are returned along with their IDs.
+func FilterModels(ctx context.Context, store RepositoryStorage, marker string, maxItems int, f *filter.Filter) (ListResult, []Model, error) {
+	matched := make(map[string]Model)
+	res, err := listMatching(marker, maxItems,
+		func(marker string) (ListResult, error) {
+			return store.ListModels(ctx, marker, filterPageSize)
+		},
+		func(modelIds []string) ([]bool, error) {
+			models, err := store.BatchGetModels(ctx, modelIds)
+			if err != nil {
+				return nil, err
+			}
+			matches := make([]bool, len(models))
+			for i, model := range models {
+				if matches[i] = f.Matches(ModelFields(model)); matches[i] {
+					matched[model.ModelId] = model
+				}
+			}
+			return matches, nil
+		})
+	if err != nil {
+		return ListResult{}, nil, err
+	}
+
+	models := make([]Model, len(res.Ids))
+	for i, modelId := range res.Ids {
+		models[i] = matched[modelId]
+	}
+	return res, models, nil
+}
+
+// FilterHyperparameters - lists up to maxItems hyperparameters of the given model following marker
+// which match f. The matching hyperparameters are returned along with their IDs.
+func FilterHyperparameters(ctx context.Context, store RepositoryStorage, modelId, marker string, maxItems int, f *filter.Filter) (ListResult, []Hyperparameters, error) {
+	matched := make(map[string]Hyperparameters)
+	res, err := listMatching(marker, maxItems,
+		func(marker string) (ListResult, error) {
+			return store.ListHyperparameters(ctx, modelId, marker, filterPageSize)
+		},
+		func(hyperparametersIds []string) ([]bool, error) {
+			hyperparameters, err := store.BatchGetHyperparameters(ctx, modelId, hyperparametersIds)
+			if err != nil {
+				return nil, err
+			}
+			matches := make([]bool, len(hyperparameters))
+			for i, h := range hyperparameters {
+				if matches[i] = f.Matches(HyperparametersFields(h)); matches[i] {
+					matched[h.HyperparametersId] = h
+				}
+			}
+			return matches, nil
+		})
+	if err != nil {
+		return ListResult{}, nil, err
+	}
+
+	hyperparameters := make([]Hyperparameters, len(res.Ids))
+	for i, hyperparametersId := range res.Ids {
+		hyperparameters[i] = matched[hyperparametersId]
+	}
+	return res, hyperparameters, nil
+}
+
+// FilterCheckpoints - lists up to maxItems checkpoints of the given hyperparameters following
+// marker which match f. As with ListCheckpoints, archived checkpoints are only considered if
+// includeArchived is set. The matching checkpoints are returned along with their IDs.
+func FilterCheckpoints(ctx context.Context, store RepositoryStorage, modelId, hyperparametersId, marker string, maxItems int, includeArchived bool, f *filter.Filter) (ListResult, []Checkpoint, error) {
+	matched := make(map[string]Checkpoint)
+	res, err := listMatching(marker, maxItems,
+		func(marker string) (ListResult, error) {
+			return store.ListCheckpoints(ctx, modelId, hyperparametersId, marker, filterPageSize, includeArchived)
+		},
+		func(checkpointIds []string) ([]bool, error) {
+			checkpoints, err := store.BatchGetCheckpoints(ctx, modelId, hyperparametersId, checkpointIds)
+			if err != nil {
+				return nil, err
+			}
+			matches := make([]bool, len(checkpoints))
+			for i, checkpoint := range checkpoints {
+				if matches[i] = f.Matches(CheckpointFields(checkpoint)); matches[i] {
+					matched[checkpoint.CheckpointId] = checkpoint
+				}
+			}
+			return matches, nil
+		})
+	if err != nil {
+		return ListResult{}, nil, err
+	}
+
+	checkpoints := make([]Checkpoint, len(res.Ids))
+	for i, checkpointId := range res.Ids {
+		checkpoints[i] = matched[checkpointId]
+	}
+	return res, checkpoints, nil
+}
+
+// listMatching - collects up to maxItems IDs following marker for which match returns true.
+// listPage should list the page of IDs following the given marker, and match should report which
+// of the IDs in a page are to be kept.
+func listMatching(marker string, maxItems int, listPage func(marker string) (ListResult, error), match func(ids []string) ([]bool, error)) (ListResult, error) {
+	ids := make([]string, 0)
+	if maxItems <= 0 {
+		return ListResult{Ids: ids}, nil
+	}
+	for {
+		page, err := listPage(marker)
+		if err != nil {
+			return ListResult{}, err
+		}
+		matches, err := match(page.Ids)
+		if err != nil {
+			return ListResult{}, err
+		}
+		for i, id := range page.Ids {
+			if !matches[i] {
+				continue
+			}
+			if len(ids) == maxItems {
+				// There is at least one more match, so there is another page.
+				return ListResult{Ids: ids, NextMarker: ids[len(ids)-1]}, nil
+			}
+			ids = append(ids, id)
+		}
+		if page.NextMarker == "" {
+			return ListResult{Ids: ids}, nil
+		}
+		marker = page.NextMarker
+	}
+}
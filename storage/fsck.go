@@ -0,0 +1,142 @@
+package storage
+
+import (
+	"context"
+
+	"github.com/doc-ai/tensorio-models/common"
+)
+
+// DanglingReference - a reference from a resource in the repository to a resource which does not
+// exist.
+type DanglingReference struct {
+	// ResourcePath - path of the resource holding the reference.
+	ResourcePath string
+	// Field - the field holding the reference (CanonicalHyperparameters, CanonicalCheckpoint,
+	// UpgradeTo, Rollout, or Target for tags).
+	Field string
+	// Reference - path of the missing resource.
+	Reference string
+}
+
+// GetAllModelIds - pages through the IDs of every model in the repository.
+func GetAllModelIds(ctx context.Context, store RepositoryStorage) ([]string, error) {
+	const pageSize = 100
+	var res []string
+	marker := ""
+	for {
+		page, err := store.ListModels(ctx, marker, pageSize)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, page.Ids...)
+		if page.NextMarker == "" {
+			return res, nil
+		}
+		marker = page.NextMarker
+	}
+}
+
+// FindDanglingReferences - scans the whole repository for CanonicalHyperparameters,
+// CanonicalCheckpoint, UpgradeTo, Rollout and tag references to resources which do not exist.
+func FindDanglingReferences(ctx context.Context, store RepositoryStorage) ([]DanglingReference, error) {
+	res := make([]DanglingReference, 0)
+
+	modelIds, err := GetAllModelIds(ctx, store)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, modelId := range modelIds {
+		model, err := store.GetModel(ctx, modelId)
+		if err != nil {
+			return nil, err
+		}
+		allHyperparameters, err := GetAllHyperparameters(ctx, store, modelId)
+		if err != nil {
+			return nil, err
+		}
+		hyperparametersIds := make(map[string]bool, len(allHyperparameters))
+		for _, hyperparameters := range allHyperparameters {
+			hyperparametersIds[hyperparameters.HyperparametersId] = true
+		}
+
+		if model.CanonicalHyperparameters != "" && !hyperparametersIds[model.CanonicalHyperparameters] {
+			res = append(res, DanglingReference{
+				ResourcePath: common.GetModelResourcePath(modelId),
+				Field:        "CanonicalHyperparameters",
+				Reference:    common.GetHyperparametersResourcePath(modelId, model.CanonicalHyperparameters),
+			})
+		}
+
+		modelTags, err := store.ListTags(ctx, modelId, "")
+		if err != nil {
+			return nil, err
+		}
+		for _, tag := range modelTags {
+			if !hyperparametersIds[tag.Target] {
+				res = append(res, DanglingReference{
+					ResourcePath: common.GetTagResourcePath(modelId, "", tag.Tag),
+					Field:        "Target",
+					Reference:    common.GetHyperparametersResourcePath(modelId, tag.Target),
+				})
+			}
+		}
+
+		for _, hyperparameters := range allHyperparameters {
+			resourcePath := common.GetHyperparametersResourcePath(modelId, hyperparameters.HyperparametersId)
+
+			if hyperparameters.CanonicalCheckpoint != "" {
+				_, err := store.GetCheckpoint(ctx, modelId, hyperparameters.HyperparametersId, hyperparameters.CanonicalCheckpoint)
+				if err == CheckpointDoesNotExistError {
+					res = append(res, DanglingReference{
+						ResourcePath: resourcePath,
+						Field:        "CanonicalCheckpoint",
+						Reference:    common.GetCheckpointResourcePath(modelId, hyperparameters.HyperparametersId, hyperparameters.CanonicalCheckpoint),
+					})
+				} else if err != nil {
+					return nil, err
+				}
+			}
+
+			if hyperparameters.UpgradeTo != "" && !hyperparametersIds[hyperparameters.UpgradeTo] {
+				res = append(res, DanglingReference{
+					ResourcePath: resourcePath,
+					Field:        "UpgradeTo",
+					Reference:    common.GetHyperparametersResourcePath(modelId, hyperparameters.UpgradeTo),
+				})
+			}
+
+			for _, target := range hyperparameters.Rollout {
+				_, err := store.GetCheckpoint(ctx, modelId, hyperparameters.HyperparametersId, target.CheckpointId)
+				if err == CheckpointDoesNotExistError {
+					res = append(res, DanglingReference{
+						ResourcePath: resourcePath,
+						Field:        "Rollout",
+						Reference:    common.GetCheckpointResourcePath(modelId, hyperparameters.HyperparametersId, target.CheckpointId),
+					})
+				} else if err != nil {
+					return nil, err
+				}
+			}
+
+			tags, err := store.ListTags(ctx, modelId, hyperparameters.HyperparametersId)
+			if err != nil {
+				return nil, err
+			}
+			for _, tag := range tags {
+				_, err := store.GetCheckpoint(ctx, modelId, hyperparameters.HyperparametersId, tag.Target)
+				if err == CheckpointDoesNotExistError {
+					res = append(res, DanglingReference{
+						ResourcePath: common.GetTagResourcePath(modelId, hyperparameters.HyperparametersId, tag.Tag),
+						Field:        "Target",
+						Reference:    common.GetCheckpointResourcePath(modelId, hyperparameters.HyperparametersId, tag.Target),
+					})
+				} else if err != nil {
+					return nil, err
+				}
+			}
+		}
+	}
+
+	return res, nil
+}
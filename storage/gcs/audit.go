@@ -0,0 +1,97 @@
+package gcs
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"time"
+
+	gcs "cloud.google.com/go/storage"
+	"github.com/doc-ai/tensorio-models/storage"
+	"google.golang.org/api/iterator"
+)
+
+// auditLog - stores each audit event in its own object under audit/<partition>/, named after the
+// event ID. Shared by the repository and FLEA storage.
+type auditLog struct {
+	auditBucket *gcs.BucketHandle
+}
+
+func (store auditLog) AddAuditEvent(ctx context.Context, event storage.AuditEvent) error {
+	bytes, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+	object := store.auditBucket.Object(objAuditEventPath(event.EventId))
+	// Audit events are never overwritten
+	writer := object.If(gcs.Conditions{DoesNotExist: true}).NewWriter(ctx)
+	return writeObject(ctx, writer, bytes)
+}
+
+func (store auditLog) ListAuditEvents(ctx context.Context, marker string, maxItems int) ([]storage.AuditEvent, error) {
+	partitions, err := store.listPartitions(ctx, marker)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]storage.AuditEvent, 0)
+	for _, partition := range partitions {
+		iter := store.auditBucket.Objects(ctx, &gcs.Query{Prefix: objAuditPartitionDir(partition)})
+		for len(res) < maxItems {
+			obj, err := iter.Next()
+			if err == iterator.Done {
+				break
+			}
+			if err != nil {
+				return nil, err
+			}
+
+			// Only the partition of the marker has events which are not after it
+			eventId := strings.TrimSuffix(strings.TrimPrefix(obj.Name, objAuditPartitionDir(partition)), ".json")
+			if eventId <= marker {
+				continue
+			}
+
+			event := storage.AuditEvent{}
+			err = readObject(ctx, store.auditBucket.Object(obj.Name), &event)
+			if err != nil {
+				return nil, err
+			}
+			res = append(res, event)
+		}
+		if len(res) == maxItems {
+			break
+		}
+	}
+	return res, nil
+}
+
+// listPartitions - the partitions of the audit log which may hold events after marker, in order.
+// See storage.RecentAuditPartitions.
+func (store auditLog) listPartitions(ctx context.Context, marker string) ([]string, error) {
+	if partitions, ok := storage.RecentAuditPartitions(marker, time.Now()); ok {
+		return partitions, nil
+	}
+
+	markerPartition := storage.AuditPartition(marker)
+	res := make([]string, 0)
+	iter := store.auditBucket.Objects(ctx, &gcs.Query{Prefix: objAuditDir(), Delimiter: "/"})
+	for {
+		obj, err := iter.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		if obj.Prefix == "" {
+			continue
+		}
+		partition := strings.TrimSuffix(strings.TrimPrefix(obj.Prefix, objAuditDir()), "/")
+		if partition < markerPartition {
+			continue
+		}
+		res = append(res, partition)
+	}
+	return res, nil
+}
@@ -19,6 +19,8 @@ import (
 )
 
 type flea struct {
+	auditLog
+	webhookStore
 	client             *gcs.Client
 	bucket             *gcs.BucketHandle
 	bucketName         string
@@ -49,11 +51,19 @@ func GenerateNewFleaGCSStorageFromEnv(repositoryBaseURL string) storage.FleaStor
 	if err != nil {
 		panic(err)
 	}
-	bucket := gcsClient.Bucket(bucketName)
 
 	urlSigner := signedURL.NewURLSignerFromEnvVar(uploadBucketName)
 
-	return &flea{client: gcsClient,
+	return NewFleaGCSStorage(gcsClient, bucketName, uploadBucketName, repositoryBaseURL, urlSigner)
+}
+
+// NewFleaGCSStorage - returns a GCS implementation of FleaStorage interface. Tasks are kept in
+// bucketName, and urlSigner signs the URLs which jobs are uploaded to in uploadBucketName.
+func NewFleaGCSStorage(client *gcs.Client, bucketName, uploadBucketName, repositoryBaseURL string, urlSigner signedURL.URLSigner) storage.FleaStorage {
+	bucket := client.Bucket(bucketName)
+	return &flea{client: client,
+		auditLog:           auditLog{auditBucket: bucket},
+		webhookStore:       webhookStore{webhooksBucket: bucket},
 		bucket:             bucket,
 		repositoryBaseURL:  repositoryBaseURL,
 		uploadToBucketName: uploadBucketName,
@@ -119,6 +129,20 @@ func (store flea) GetTask(ctx context.Context, taskId string) (api.TaskDetails,
 	return task, err
 }
 
+func (store flea) BatchGetTasks(ctx context.Context, taskIds []string) ([]api.TaskDetails, error) {
+	tasks := make([]api.TaskDetails, len(taskIds))
+	err := storage.BatchGet(len(taskIds), batchGetConcurrency, func(i int) error {
+		var err error
+		tasks[i], err = store.GetTask(ctx, taskIds[i])
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return tasks, nil
+}
+
 func (store flea) ModifyTask(ctx context.Context, req api.ModifyTaskRequest) error {
 	task, err := store.GetTask(ctx, req.TaskId)
 	if err != nil {
@@ -152,53 +176,27 @@ func (store flea) StartTask(ctx context.Context, taskId string) (api.StartTaskRe
 }
 
 func (store flea) ListTasks(ctx context.Context, req api.ListTasksRequest) (api.ListTasksResponse, error) {
-	resp := api.ListTasksResponse{}
 	query := &gcs.Query{
 		Delimiter: "/",
 		Prefix:    "tasks/",
 		Versions:  false,
 	}
 	iter := store.bucket.Objects(ctx, query)
-	var taskIds []string
+	var candidates []string
 	for {
-		if req.MaxItems > 0 && len(taskIds) == int(req.MaxItems) {
-			break
-		}
 		obj, err := iter.Next()
 		if err != nil {
 			if err == iterator.Done {
 				break
 			}
-			return resp, err
-		}
-
-		taskId := extractObjectName(obj.Prefix)
-
-		if req.StartTaskId != "" && taskId < req.StartTaskId {
-			continue
-		}
-		if req.ModelId != "" {
-			task, err := store.GetTask(ctx, taskId)
-			if err != nil {
-				return resp, err
-			}
-			if task.ModelId != req.ModelId {
-				continue
-			}
-			if task.HyperparametersId != req.HyperparametersId && req.HyperparametersId != "" {
-				continue
-			}
-			if task.CheckpointId != req.CheckpointId && req.CheckpointId != "" {
-				continue
-			}
+			return api.ListTasksResponse{}, err
 		}
-
-		taskIds = append(taskIds, taskId)
+		candidates = append(candidates, extractObjectName(obj.Prefix))
 	}
-	resp.TaskIds = taskIds
-	resp.StartTaskId = req.StartTaskId
-	resp.MaxItems = req.MaxItems
-	return resp, nil
+
+	return storage.ListTaskCandidates(req, candidates, func(taskId string) (api.TaskDetails, error) {
+		return store.GetTask(ctx, taskId)
+	})
 }
 
 func (store *flea) AddJobError(ctx context.Context, req api.JobErrorRequest) error {
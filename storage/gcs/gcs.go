@@ -7,26 +7,52 @@ import (
 	"fmt"
 	"io"
 	"io/ioutil"
+	"net/http"
 	"os"
 	"strings"
+	"time"
 
 	gcs "cloud.google.com/go/storage"
+	"github.com/doc-ai/tensorio-models/api"
+	signedURL "github.com/doc-ai/tensorio-models/signed_url"
 	"github.com/doc-ai/tensorio-models/storage"
+	"google.golang.org/api/googleapi"
 	"google.golang.org/api/iterator"
 )
 
 const (
 	StorageType string = "GCS"
+	// batchGetConcurrency - how many objects the BatchGet* methods read at a time.
+	batchGetConcurrency = 16
 )
 
 type gcsStorage struct {
+	auditLog
+	webhookStore
 	bucketName string
 	client     *gcs.Client
 	bucket     *gcs.BucketHandle
+	// urlSigner - signs checkpoint upload URLs. It is nil if uploads are not configured.
+	urlSigner signedURL.URLSigner
+	// verifyCheckpoints - whether AddCheckpoint checks gs:// checkpoint links against the
+	// attributes of the objects they point at.
+	verifyCheckpoints bool
+}
+
+// Options - optional features of the GCS backend.
+type Options struct {
+	// URLSigner - signs checkpoint upload and download URLs for the bucket. Uploads are refused and
+	// links are returned unsigned if it is nil.
+	URLSigner signedURL.URLSigner
+	// VerifyCheckpoints - if set, checkpoints with gs:// links are only added if the linked object
+	// exists and matches the checkpoint's sha256, sizeBytes and contentType (where they are given).
+	VerifyCheckpoints bool
 }
 
 // GenerateNewGCSStorageFromEnv - Uses the GOOGLE_APPLICATION_CREDENTIALS and REPOSITORY_GCS_BUCKET
-// environment variables to instantiate a GCS Storage backend for tensorio-models repository
+// environment variables to instantiate a GCS Storage backend for tensorio-models repository.
+// Checkpoint uploads are only enabled if GOOGLE_ACCESS_ID and PRIVATE_PEM_KEY are set as well, and
+// checkpoints are only verified if REPOSITORY_GCS_VERIFY_CHECKPOINTS is "true".
 func GenerateNewGCSStorageFromEnv() storage.RepositoryStorage {
 	bucketName := os.Getenv("REPOSITORY_GCS_BUCKET")
 	if bucketName == "" {
@@ -40,15 +66,39 @@ func GenerateNewGCSStorageFromEnv() storage.RepositoryStorage {
 		panic(err)
 	}
 
-	return NewGCSStorage(gcsClient, bucketName)
+	options := Options{
+		VerifyCheckpoints: os.Getenv("REPOSITORY_GCS_VERIFY_CHECKPOINTS") == "true",
+	}
+	if os.Getenv("GOOGLE_ACCESS_ID") != "" && os.Getenv("PRIVATE_PEM_KEY") != "" {
+		options.URLSigner = signedURL.NewURLSignerFromEnvVar(bucketName)
+	}
+
+	return NewGCSStorageWithOptions(gcsClient, bucketName, options)
 }
 
 // NewGCSStorage - Creates a GCS-backed instance of storage.RepositoryStorage interface
 func NewGCSStorage(client *gcs.Client, bucketName string) storage.RepositoryStorage {
+	return NewGCSStorageWithURLSigner(client, bucketName, nil)
+}
+
+// NewGCSStorageWithURLSigner - Creates a GCS-backed instance of storage.RepositoryStorage interface
+// which uses urlSigner to hand out checkpoint upload URLs for its bucket.
+func NewGCSStorageWithURLSigner(client *gcs.Client, bucketName string, urlSigner signedURL.URLSigner) storage.RepositoryStorage {
+	return NewGCSStorageWithOptions(client, bucketName, Options{URLSigner: urlSigner})
+}
+
+// NewGCSStorageWithOptions - Creates a GCS-backed instance of storage.RepositoryStorage interface
+// with the given optional features enabled.
+func NewGCSStorageWithOptions(client *gcs.Client, bucketName string, options Options) storage.RepositoryStorage {
+	bucket := client.Bucket(bucketName)
 	return &gcsStorage{
-		client:     client,
-		bucket:     client.Bucket(bucketName),
-		bucketName: bucketName,
+		auditLog:          auditLog{auditBucket: bucket},
+		webhookStore:      webhookStore{webhooksBucket: bucket},
+		client:            client,
+		bucket:            bucket,
+		bucketName:        bucketName,
+		urlSigner:         options.URLSigner,
+		verifyCheckpoints: options.VerifyCheckpoints,
 	}
 }
 
@@ -60,7 +110,7 @@ func (store gcsStorage) GetBucketName() string {
 	return store.bucketName
 }
 
-func (store gcsStorage) ListModels(ctx context.Context, marker string, maxItems int) ([]string, error) {
+func (store gcsStorage) ListModels(ctx context.Context, marker string, maxItems int) (storage.ListResult, error) {
 	query := &gcs.Query{
 		Delimiter: "/",
 		Prefix:    "models/",
@@ -68,12 +118,12 @@ func (store gcsStorage) ListModels(ctx context.Context, marker string, maxItems
 	}
 	iter := store.bucket.Objects(ctx, query)
 
-	res, err := listObjects(maxItems, iter, marker)
+	res, err := listObjects(maxItems+1, iter, marker)
 	if err != nil {
-		return nil, err
+		return storage.ListResult{}, err
 	}
 
-	return res, nil
+	return storage.NewListResult(res, maxItems), nil
 }
 
 func (store gcsStorage) GetModel(ctx context.Context, modelId string) (storage.Model, error) {
@@ -81,15 +131,15 @@ func (store gcsStorage) GetModel(ctx context.Context, modelId string) (storage.M
 	object := store.bucket.Object(objLoc)
 	reader, err := object.NewReader(ctx)
 	if err != nil {
+		if err == gcs.ErrObjectNotExist {
+			return storage.Model{}, storage.ModelDoesNotExistError
+		}
 		return storage.Model{}, err
 	}
 
 	// TODO this is dangerous, we should change this eventually to read a limited amount of data
 	bytes, err := ioutil.ReadAll(reader)
 	if err != nil {
-		if err == gcs.ErrObjectNotExist {
-			return storage.Model{}, storage.ModelDoesNotExistError
-		}
 		return storage.Model{}, err
 	}
 
@@ -103,6 +153,20 @@ func (store gcsStorage) GetModel(ctx context.Context, modelId string) (storage.M
 	return model, nil
 }
 
+func (store gcsStorage) BatchGetModels(ctx context.Context, modelIds []string) ([]storage.Model, error) {
+	models := make([]storage.Model, len(modelIds))
+	err := storage.BatchGet(len(modelIds), batchGetConcurrency, func(i int) error {
+		var err error
+		models[i], err = store.GetModel(ctx, modelIds[i])
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return models, nil
+}
+
 func (store gcsStorage) AddModel(ctx context.Context, model storage.Model) error {
 	objLoc := objModelPath(model.ModelId)
 	object := store.bucket.Object(objLoc)
@@ -116,6 +180,7 @@ func (store gcsStorage) AddModel(ctx context.Context, model storage.Model) error
 	}
 
 	writer := object.NewWriter(ctx)
+	model.Version = 1
 	bytes, err := json.Marshal(model)
 
 	err = writeObject(ctx, writer, bytes)
@@ -123,33 +188,76 @@ func (store gcsStorage) AddModel(ctx context.Context, model storage.Model) error
 		return err
 	}
 
-	return nil
+	return store.addModelRevision(ctx, model)
 }
 
 func (store gcsStorage) UpdateModel(ctx context.Context, model storage.Model) (storage.Model, error) {
+	return store.updateModel(ctx, model, false)
+}
+
+func (store gcsStorage) ReplaceModel(ctx context.Context, model storage.Model) (storage.Model, error) {
+	return store.updateModel(ctx, model, true)
+}
+
+// updateModel - merges model into the stored model as UpdateModel does or, if replace is
+// set, replaces it as ReplaceModel does.
+func (store gcsStorage) updateModel(ctx context.Context, model storage.Model, replace bool) (storage.Model, error) {
 	objLoc := objModelPath(model.ModelId)
 	object := store.bucket.Object(objLoc)
 
-	storedModel, err := store.GetModel(ctx, model.ModelId)
+	storedModel := storage.Model{}
+	generation, err := readObjectGeneration(ctx, object, &storedModel)
 	if err != nil {
+		if err == gcs.ErrObjectNotExist {
+			return storage.Model{}, storage.ModelDoesNotExistError
+		}
 		return storage.Model{}, err
 	}
 
-	if strings.TrimSpace(model.CanonicalHyperparameters) != "" {
-		storedModel.CanonicalHyperparameters = model.CanonicalHyperparameters
+	err = storage.CheckVersion(storedModel.Version, model.Version)
+	if err != nil {
+		return storage.Model{}, err
+	}
+
+	err = storage.CheckModelReferences(ctx, store, storedModel, model)
+	if err != nil {
+		return storage.Model{}, err
 	}
-	if strings.TrimSpace(model.Details) != "" {
-		storedModel.Details = model.Details
+
+	if replace {
+		storedModel = storage.ReplacedModel(storedModel, model)
+	} else {
+		if strings.TrimSpace(model.CanonicalHyperparameters) != "" {
+			storedModel.CanonicalHyperparameters = model.CanonicalHyperparameters
+		}
+		if strings.TrimSpace(model.Details) != "" {
+			storedModel.Details = model.Details
+		}
+		if model.Labels != nil {
+			storedModel.Labels = model.Labels
+		}
+		if model.Card != nil {
+			storedModel.Card = model.Card
+		}
 	}
 
+	storedModel.Version++
 	bytes, err := json.Marshal(storedModel)
 	if err != nil {
 		return storage.Model{}, err
 	}
 
-	writer := object.NewWriter(ctx)
+	writer := newGenerationMatchWriter(ctx, object, generation)
 
 	err = writeObject(ctx, writer, bytes)
+	if err != nil {
+		if isPreconditionFailed(err) {
+			return storage.Model{}, storage.ErrVersionMismatch
+		}
+		return storage.Model{}, err
+	}
+
+	err = store.addModelRevision(ctx, storedModel)
 	if err != nil {
 		return storage.Model{}, err
 	}
@@ -157,10 +265,10 @@ func (store gcsStorage) UpdateModel(ctx context.Context, model storage.Model) (s
 	return storedModel, nil
 }
 
-func (store gcsStorage) ListHyperparameters(ctx context.Context, modelId, marker string, maxItems int) ([]string, error) {
+func (store gcsStorage) ListHyperparameters(ctx context.Context, modelId, marker string, maxItems int) (storage.ListResult, error) {
 	_, err := store.GetModel(ctx, modelId)
 	if err != nil {
-		return nil, err
+		return storage.ListResult{}, err
 	}
 
 	query := &gcs.Query{
@@ -170,17 +278,12 @@ func (store gcsStorage) ListHyperparameters(ctx context.Context, modelId, marker
 	}
 	iter := store.bucket.Objects(ctx, query)
 
-	res, err := listObjects(maxItems, iter, marker)
-
-	for i, name := range res {
-		res[i] = fmt.Sprintf("%s:%s", modelId, name)
-	}
-
+	res, err := listObjects(maxItems+1, iter, marker)
 	if err != nil {
-		return nil, err
+		return storage.ListResult{}, err
 	}
 
-	return res, nil
+	return storage.NewListResult(res, maxItems), nil
 }
 
 func (store gcsStorage) GetHyperparameters(ctx context.Context, modelId string, hyperparametersId string) (storage.Hyperparameters, error) {
@@ -215,6 +318,28 @@ func (store gcsStorage) GetHyperparameters(ctx context.Context, modelId string,
 	return hyperparameters, nil
 }
 
+func (store gcsStorage) BatchGetHyperparameters(ctx context.Context, modelId string, hyperparametersIds []string) ([]storage.Hyperparameters, error) {
+	_, err := store.GetModel(ctx, modelId)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]storage.Hyperparameters, len(hyperparametersIds))
+	err = storage.BatchGet(len(hyperparametersIds), batchGetConcurrency, func(i int) error {
+		object := store.bucket.Object(objHyperparametersPath(modelId, hyperparametersIds[i]))
+		err := readObject(ctx, object, &res[i])
+		if err == gcs.ErrObjectNotExist {
+			return storage.HyperparametersDoesNotExistError
+		}
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
 func (store gcsStorage) AddHyperparameters(ctx context.Context, hyperparameters storage.Hyperparameters) error {
 	objLoc := objHyperparametersPath(hyperparameters.ModelId, hyperparameters.HyperparametersId)
 	object := store.bucket.Object(objLoc)
@@ -233,6 +358,7 @@ func (store gcsStorage) AddHyperparameters(ctx context.Context, hyperparameters
 	}
 
 	writer := object.NewWriter(ctx)
+	hyperparameters.Version = 1
 	bytes, err := json.Marshal(hyperparameters)
 
 	err = writeObject(ctx, writer, bytes)
@@ -240,39 +366,86 @@ func (store gcsStorage) AddHyperparameters(ctx context.Context, hyperparameters
 		return err
 	}
 
-	return nil
+	return store.addHyperparametersRevision(ctx, hyperparameters)
 }
 
 func (store gcsStorage) UpdateHyperparameters(ctx context.Context, hyperparameters storage.Hyperparameters) (storage.Hyperparameters, error) {
+	return store.updateHyperparameters(ctx, hyperparameters, false)
+}
+
+func (store gcsStorage) ReplaceHyperparameters(ctx context.Context, hyperparameters storage.Hyperparameters) (storage.Hyperparameters, error) {
+	return store.updateHyperparameters(ctx, hyperparameters, true)
+}
+
+// updateHyperparameters - merges hyperparameters into the stored hyperparameters as
+// UpdateHyperparameters does or, if replace is set, replaces them as ReplaceHyperparameters does.
+func (store gcsStorage) updateHyperparameters(ctx context.Context, hyperparameters storage.Hyperparameters, replace bool) (storage.Hyperparameters, error) {
 	objLoc := objHyperparametersPath(hyperparameters.ModelId, hyperparameters.HyperparametersId)
 	object := store.bucket.Object(objLoc)
 
-	storedHyperparameters, err := store.GetHyperparameters(ctx, hyperparameters.ModelId, hyperparameters.HyperparametersId)
+	_, err := store.GetModel(ctx, hyperparameters.ModelId)
 	if err != nil {
 		return storage.Hyperparameters{}, err
 	}
+	storedHyperparameters := storage.Hyperparameters{}
+	generation, err := readObjectGeneration(ctx, object, &storedHyperparameters)
+	if err != nil {
+		if err == gcs.ErrObjectNotExist {
+			return storage.Hyperparameters{}, storage.HyperparametersDoesNotExistError
+		}
+		return storage.Hyperparameters{}, err
+	}
 
-	if strings.TrimSpace(hyperparameters.CanonicalCheckpoint) != "" {
-		storedHyperparameters.CanonicalCheckpoint = hyperparameters.CanonicalCheckpoint
+	err = storage.CheckVersion(storedHyperparameters.Version, hyperparameters.Version)
+	if err != nil {
+		return storage.Hyperparameters{}, err
 	}
-	if strings.TrimSpace(hyperparameters.UpgradeTo) != "" {
-		storedHyperparameters.UpgradeTo = hyperparameters.UpgradeTo
+
+	err = storage.CheckHyperparametersReferences(ctx, store, storedHyperparameters, hyperparameters)
+	if err != nil {
+		return storage.Hyperparameters{}, err
 	}
 
-	if hyperparameters.Hyperparameters != nil {
-		for k, v := range hyperparameters.Hyperparameters {
-			storedHyperparameters.Hyperparameters[k] = v
+	if replace {
+		storedHyperparameters = storage.ReplacedHyperparameters(storedHyperparameters, hyperparameters)
+	} else {
+		if strings.TrimSpace(hyperparameters.CanonicalCheckpoint) != "" {
+			storedHyperparameters.CanonicalCheckpoint = hyperparameters.CanonicalCheckpoint
+		}
+		if strings.TrimSpace(hyperparameters.UpgradeTo) != "" {
+			storedHyperparameters.UpgradeTo = hyperparameters.UpgradeTo
+		}
+		if hyperparameters.Labels != nil {
+			storedHyperparameters.Labels = hyperparameters.Labels
+		}
+		if hyperparameters.Rollout != nil {
+			storedHyperparameters.Rollout = hyperparameters.Rollout
+		}
+
+		if hyperparameters.Hyperparameters != nil {
+			for k, v := range hyperparameters.Hyperparameters {
+				storedHyperparameters.Hyperparameters[k] = v
+			}
 		}
 	}
 
+	storedHyperparameters.Version++
 	bytes, err := json.Marshal(storedHyperparameters)
 	if err != nil {
 		return storage.Hyperparameters{}, err
 	}
 
-	writer := object.NewWriter(ctx)
+	writer := newGenerationMatchWriter(ctx, object, generation)
 
 	err = writeObject(ctx, writer, bytes)
+	if err != nil {
+		if isPreconditionFailed(err) {
+			return storage.Hyperparameters{}, storage.ErrVersionMismatch
+		}
+		return storage.Hyperparameters{}, err
+	}
+
+	err = store.addHyperparametersRevision(ctx, storedHyperparameters)
 	if err != nil {
 		return storage.Hyperparameters{}, err
 	}
@@ -280,35 +453,35 @@ func (store gcsStorage) UpdateHyperparameters(ctx context.Context, hyperparamete
 	return storedHyperparameters, nil
 }
 
-func (store gcsStorage) ListCheckpoints(ctx context.Context, modelId, hyperparametersId, marker string, maxItems int) ([]string, error) {
+func (store gcsStorage) ListCheckpoints(ctx context.Context, modelId, hyperparametersId, marker string, maxItems int, includeArchived bool) (storage.ListResult, error) {
 	_, err := store.GetModel(ctx, modelId)
 	if err != nil {
-		return nil, err
+		return storage.ListResult{}, err
 	}
 
 	_, err = store.GetHyperparameters(ctx, modelId, hyperparametersId)
 	if err != nil {
-		return nil, err
+		return storage.ListResult{}, err
 	}
 
-	query := &gcs.Query{
-		Delimiter: "/",
-		Prefix:    fmt.Sprintf("models/%s/hyperparameters/%s/checkpoints/", modelId, hyperparametersId),
-		Versions:  false,
-	}
-	iter := store.bucket.Objects(ctx, query)
-
-	res, err := listObjects(maxItems, iter, marker)
-
-	for i, name := range res {
-		res[i] = fmt.Sprintf("%s:%s:%s", modelId, hyperparametersId, name)
+	listPage := func(marker string, maxItems int) ([]string, error) {
+		query := &gcs.Query{
+			Delimiter: "/",
+			Prefix:    fmt.Sprintf("models/%s/hyperparameters/%s/checkpoints/", modelId, hyperparametersId),
+			Versions:  false,
+		}
+		iter := store.bucket.Objects(ctx, query)
+		return listObjects(maxItems, iter, marker)
 	}
 
+	res, err := storage.ListVisibleCheckpoints(marker, maxItems+1, includeArchived, listPage, func(checkpointId string) (storage.Checkpoint, error) {
+		return store.GetCheckpoint(ctx, modelId, hyperparametersId, checkpointId)
+	})
 	if err != nil {
-		return nil, err
+		return storage.ListResult{}, err
 	}
 
-	return res, nil
+	return storage.NewListResult(res, maxItems), nil
 }
 
 func (store gcsStorage) GetCheckpoint(ctx context.Context, modelId, hyperparametersId, checkpointId string) (storage.Checkpoint, error) {
@@ -344,6 +517,28 @@ func (store gcsStorage) GetCheckpoint(ctx context.Context, modelId, hyperparamet
 
 }
 
+func (store gcsStorage) BatchGetCheckpoints(ctx context.Context, modelId, hyperparametersId string, checkpointIds []string) ([]storage.Checkpoint, error) {
+	_, err := store.GetHyperparameters(ctx, modelId, hyperparametersId)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]storage.Checkpoint, len(checkpointIds))
+	err = storage.BatchGet(len(checkpointIds), batchGetConcurrency, func(i int) error {
+		object := store.bucket.Object(objCheckpointPath(modelId, hyperparametersId, checkpointIds[i]))
+		err := readObject(ctx, object, &res[i])
+		if err == gcs.ErrObjectNotExist {
+			return storage.CheckpointDoesNotExistError
+		}
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
 func (store gcsStorage) AddCheckpoint(ctx context.Context, checkpoint storage.Checkpoint) error {
 	objLoc := objCheckpointPath(checkpoint.ModelId, checkpoint.HyperparametersId, checkpoint.CheckpointId)
 	object := store.bucket.Object(objLoc)
@@ -366,6 +561,14 @@ func (store gcsStorage) AddCheckpoint(ctx context.Context, checkpoint storage.Ch
 		return err
 	}
 
+	// Uploaded bundles only arrive after the pending checkpoint has been added.
+	if store.verifyCheckpoints && checkpoint.State != api.CheckpointState_PENDING {
+		err = store.verifyCheckpoint(ctx, checkpoint)
+		if err != nil {
+			return err
+		}
+	}
+
 	writer := object.NewWriter(ctx)
 	bytes, err := json.Marshal(checkpoint)
 
@@ -377,6 +580,320 @@ func (store gcsStorage) AddCheckpoint(ctx context.Context, checkpoint storage.Ch
 	return nil
 }
 
+func (store gcsStorage) UpdateCheckpointState(ctx context.Context, modelId, hyperparametersId, checkpointId string, state api.CheckpointState) (storage.Checkpoint, error) {
+	checkpoint, err := store.GetCheckpoint(ctx, modelId, hyperparametersId, checkpointId)
+	if err != nil {
+		return storage.Checkpoint{}, err
+	}
+	checkpoint.State = state
+
+	bytes, err := json.Marshal(checkpoint)
+	if err != nil {
+		return storage.Checkpoint{}, err
+	}
+
+	object := store.bucket.Object(objCheckpointPath(modelId, hyperparametersId, checkpointId))
+	writer := object.NewWriter(ctx)
+
+	err = writeObject(ctx, writer, bytes)
+	if err != nil {
+		return storage.Checkpoint{}, err
+	}
+
+	return checkpoint, nil
+}
+
+func (store gcsStorage) UpdateCheckpointInfo(ctx context.Context, modelId, hyperparametersId, checkpointId string, info map[string]string) (storage.Checkpoint, error) {
+	checkpoint, err := store.GetCheckpoint(ctx, modelId, hyperparametersId, checkpointId)
+	if err != nil {
+		return storage.Checkpoint{}, err
+	}
+	checkpoint.Info = info
+
+	bytes, err := json.Marshal(checkpoint)
+	if err != nil {
+		return storage.Checkpoint{}, err
+	}
+
+	object := store.bucket.Object(objCheckpointPath(modelId, hyperparametersId, checkpointId))
+	writer := object.NewWriter(ctx)
+
+	err = writeObject(ctx, writer, bytes)
+	if err != nil {
+		return storage.Checkpoint{}, err
+	}
+
+	return checkpoint, nil
+}
+
+func (store gcsStorage) DeleteModel(ctx context.Context, modelId string, options storage.DeleteOptions) error {
+	_, err := store.GetModel(ctx, modelId)
+	if err != nil {
+		return err
+	}
+
+	if !options.Cascade {
+		hasChildren, err := hasObjects(ctx, store.bucket, fmt.Sprintf("models/%s/hyperparameters/", modelId))
+		if err != nil {
+			return err
+		}
+		if hasChildren {
+			return storage.ErrResourceHasChildren
+		}
+	}
+
+	// Remove the model itself first so that it disappears before its children are cleaned up.
+	err = store.bucket.Object(objModelPath(modelId)).Delete(ctx)
+	if err != nil {
+		return err
+	}
+	err = deleteObjects(ctx, store.bucket, fmt.Sprintf("models/%s/", modelId))
+	if err != nil {
+		return err
+	}
+	return deleteObjects(ctx, store.bucket, storage.ModelBundlesPrefix(modelId))
+}
+
+func (store gcsStorage) DeleteHyperparameters(ctx context.Context, modelId, hyperparametersId string, options storage.DeleteOptions) error {
+	model, err := store.GetModel(ctx, modelId)
+	if err != nil {
+		return err
+	}
+
+	_, err = store.GetHyperparameters(ctx, modelId, hyperparametersId)
+	if err != nil {
+		return err
+	}
+
+	if !options.Cascade {
+		hasChildren, err := hasObjects(ctx, store.bucket, fmt.Sprintf("models/%s/hyperparameters/%s/checkpoints/", modelId, hyperparametersId))
+		if err != nil {
+			return err
+		}
+		if hasChildren {
+			return storage.ErrResourceHasChildren
+		}
+	}
+
+	if !options.Force {
+		siblings, err := storage.GetAllHyperparameters(ctx, store, modelId)
+		if err != nil {
+			return err
+		}
+		if storage.IsHyperparametersReferenced(model, siblings, hyperparametersId) {
+			return storage.ErrResourceIsReferenced
+		}
+		tags, err := store.ListTags(ctx, modelId, "")
+		if err != nil {
+			return err
+		}
+		if storage.IsTagged(tags, hyperparametersId) {
+			return storage.ErrResourceIsReferenced
+		}
+	}
+
+	err = store.bucket.Object(objHyperparametersPath(modelId, hyperparametersId)).Delete(ctx)
+	if err != nil {
+		return err
+	}
+	err = deleteObjects(ctx, store.bucket, fmt.Sprintf("models/%s/hyperparameters/%s/", modelId, hyperparametersId))
+	if err != nil {
+		return err
+	}
+	return deleteObjects(ctx, store.bucket, storage.HyperparametersBundlesPrefix(modelId, hyperparametersId))
+}
+
+func (store gcsStorage) DeleteCheckpoint(ctx context.Context, modelId, hyperparametersId, checkpointId string, options storage.DeleteOptions) error {
+	hyperparameters, err := store.GetHyperparameters(ctx, modelId, hyperparametersId)
+	if err != nil {
+		return err
+	}
+
+	_, err = store.GetCheckpoint(ctx, modelId, hyperparametersId, checkpointId)
+	if err != nil {
+		return err
+	}
+
+	if !options.Force {
+		if hyperparameters.CanonicalCheckpoint == checkpointId || storage.IsInRollout(hyperparameters.Rollout, checkpointId) {
+			return storage.ErrResourceIsReferenced
+		}
+		tags, err := store.ListTags(ctx, modelId, hyperparametersId)
+		if err != nil {
+			return err
+		}
+		if storage.IsTagged(tags, checkpointId) {
+			return storage.ErrResourceIsReferenced
+		}
+	}
+
+	err = store.bucket.Object(objCheckpointPath(modelId, hyperparametersId, checkpointId)).Delete(ctx)
+	if err != nil {
+		return err
+	}
+	err = store.bucket.Object(storage.CheckpointBundlePath(modelId, hyperparametersId, checkpointId)).Delete(ctx)
+	if err == gcs.ErrObjectNotExist {
+		return nil
+	}
+	return err
+}
+
+func (store gcsStorage) CheckpointUploadURL(ctx context.Context, modelId, hyperparametersId, checkpointId string, expires time.Time) (string, string, error) {
+	if store.urlSigner == nil {
+		return "", "", storage.ErrUploadsNotConfigured
+	}
+	objLoc := storage.CheckpointBundlePath(modelId, hyperparametersId, checkpointId)
+	url, err := store.urlSigner.GetSignedURL("PUT", objLoc, expires, storage.BundleContentType)
+	if err != nil {
+		return "", "", err
+	}
+	return url, fmt.Sprintf("gs://%s/%s", store.bucketName, objLoc), nil
+}
+
+func (store gcsStorage) CheckpointBundleExists(ctx context.Context, modelId, hyperparametersId, checkpointId string) (bool, error) {
+	objLoc := storage.CheckpointBundlePath(modelId, hyperparametersId, checkpointId)
+	_, err := store.bucket.Object(objLoc).Attrs(ctx)
+	if err == gcs.ErrObjectNotExist {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+// SignCheckpointLink - signs gs:// links to objects in the repository's bucket, which includes every
+// uploaded bundle. Other links, and all links if uploads are not configured, are returned unchanged.
+func (store gcsStorage) SignCheckpointLink(ctx context.Context, link string, expires time.Time) (string, error) {
+	prefix := fmt.Sprintf("gs://%s/", store.bucketName)
+	if store.urlSigner == nil || !strings.HasPrefix(link, prefix) {
+		return link, nil
+	}
+	return store.urlSigner.GetSignedURL("GET", strings.TrimPrefix(link, prefix), expires, "")
+}
+
+// OpenCheckpointLink - opens gs:// links to objects in the repository's bucket.
+func (store gcsStorage) OpenCheckpointLink(ctx context.Context, link string) (io.ReadCloser, error) {
+	prefix := fmt.Sprintf("gs://%s/", store.bucketName)
+	if !strings.HasPrefix(link, prefix) {
+		return nil, storage.ErrLinkNotReadable
+	}
+	reader, err := store.bucket.Object(strings.TrimPrefix(link, prefix)).NewReader(ctx)
+	if err == gcs.ErrObjectNotExist {
+		return nil, storage.ErrLinkDoesNotExist
+	}
+	if err != nil {
+		return nil, err
+	}
+	return reader, nil
+}
+
+// verifyCheckpoint - checks that the object behind a gs:// checkpoint link exists and matches the
+// checkpoint's Sha256, SizeBytes and ContentType. The size and content type are compared to the
+// ones GCS recorded for the object, and the digest is computed by reading it. Other links are not
+// checked.
+func (store gcsStorage) verifyCheckpoint(ctx context.Context, checkpoint storage.Checkpoint) error {
+	if !strings.HasPrefix(checkpoint.Link, "gs://") {
+		return nil
+	}
+	path := strings.SplitN(strings.TrimPrefix(checkpoint.Link, "gs://"), "/", 2)
+	if len(path) != 2 || path[0] == "" || path[1] == "" {
+		return storage.CheckpointContentError{Field: "link", Reason: "not a gs://bucket/object link"}
+	}
+	object := store.client.Bucket(path[0]).Object(path[1])
+	attrs, err := object.Attrs(ctx)
+	if err == gcs.ErrObjectNotExist {
+		return storage.CheckpointContentError{Field: "link", Reason: "object does not exist"}
+	}
+	if err != nil {
+		return err
+	}
+
+	if checkpoint.SizeBytes != 0 && checkpoint.SizeBytes != attrs.Size {
+		return storage.CheckpointContentError{Field: "sizeBytes", Reason: fmt.Sprintf("object is %d bytes", attrs.Size)}
+	}
+	if checkpoint.ContentType != "" && checkpoint.ContentType != attrs.ContentType {
+		return storage.CheckpointContentError{Field: "contentType", Reason: fmt.Sprintf("object is %s", attrs.ContentType)}
+	}
+	if checkpoint.Sha256 == "" {
+		return nil
+	}
+	reader, err := object.NewReader(ctx)
+	if err != nil {
+		return err
+	}
+	defer reader.Close()
+	return storage.VerifyCheckpointContent(checkpoint, reader)
+}
+
+func hasObjects(ctx context.Context, bucket *gcs.BucketHandle, prefix string) (bool, error) {
+	iter := bucket.Objects(ctx, &gcs.Query{Prefix: prefix})
+	_, err := iter.Next()
+	if err == iterator.Done {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func deleteObjects(ctx context.Context, bucket *gcs.BucketHandle, prefix string) error {
+	iter := bucket.Objects(ctx, &gcs.Query{Prefix: prefix})
+	for {
+		obj, err := iter.Next()
+		if err == iterator.Done {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		err = bucket.Object(obj.Name).Delete(ctx)
+		if err != nil && err != gcs.ErrObjectNotExist {
+			return err
+		}
+	}
+}
+
+// readObject - unmarshals the JSON stored in the given object into v. Returns gcs.ErrObjectNotExist
+// if there is no such object.
+func readObject(ctx context.Context, object *gcs.ObjectHandle, v interface{}) error {
+	_, err := readObjectGeneration(ctx, object, v)
+	return err
+}
+
+// readObjectGeneration - like readObject, but also returns the generation of the object which was
+// read, or a negative number if the server did not report it.
+func readObjectGeneration(ctx context.Context, object *gcs.ObjectHandle, v interface{}) (int64, error) {
+	reader, err := object.NewReader(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer reader.Close()
+
+	// TODO this is dangerous, we should change this eventually to read a limited amount of data
+	bytes, err := ioutil.ReadAll(reader)
+	if err != nil {
+		return 0, err
+	}
+
+	return reader.Attrs.Generation, json.Unmarshal(bytes, v)
+}
+
+// newGenerationMatchWriter - returns a writer which only replaces the object if it is still at the
+// given generation, so that a read-modify-write cycle fails instead of overwriting a concurrent
+// update. Generations which the server did not report are not checked.
+func newGenerationMatchWriter(ctx context.Context, object *gcs.ObjectHandle, generation int64) *gcs.Writer {
+	if generation > 0 {
+		object = object.If(gcs.Conditions{GenerationMatch: generation})
+	}
+	return object.NewWriter(ctx)
+}
+
+// isPreconditionFailed - whether a request failed because one of its preconditions did not hold.
+func isPreconditionFailed(err error) bool {
+	apiErr, ok := err.(*googleapi.Error)
+	return ok && apiErr.Code == http.StatusPreconditionFailed
+}
+
 func writeObject(ctx context.Context, writer io.WriteCloser, bytes []byte) error {
 
 	written := 0
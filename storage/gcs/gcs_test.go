@@ -1,18 +1,122 @@
 package gcs_test
 
 import (
+	"bytes"
+	gcsclient "cloud.google.com/go/storage"
+	"context"
+	"encoding/json"
 	"github.com/doc-ai/tensorio-models/internal/tests"
 	"github.com/doc-ai/tensorio-models/storage"
 	"github.com/doc-ai/tensorio-models/storage/gcs"
 	"github.com/fsouza/fake-gcs-server/fakestorage"
+	"google.golang.org/api/option"
+	"io/ioutil"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
 	"testing"
+	"time"
 )
 
+// generationTransport - the fake server neither reports object generations nor checks
+// ifGenerationMatch preconditions, so this transport keeps a generation per object written through
+// it and enforces them, which lets the tests exercise conditional writes.
+type generationTransport struct {
+	base        http.RoundTripper
+	lock        sync.Mutex
+	generations map[string]int64
+}
+
+func (transport *generationTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.URL.Host == "storage.googleapis.com" && (req.Method == "GET" || req.Method == "HEAD") {
+		transport.lock.Lock()
+		defer transport.lock.Unlock()
+		res, err := transport.base.RoundTrip(req)
+		if err == nil && res.StatusCode == http.StatusOK {
+			generation := transport.generations[strings.TrimPrefix(req.URL.Path, "/")]
+			if generation > 0 {
+				res.Header.Set("X-Goog-Generation", strconv.FormatInt(generation, 10))
+				res.Header.Set("X-Goog-Metageneration", "1")
+			}
+		}
+		return res, err
+	}
+	if req.Method != "POST" || !strings.HasPrefix(req.URL.Path, "/upload/storage/v1/b/") {
+		return transport.base.RoundTrip(req)
+	}
+
+	body, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		return nil, err
+	}
+	req.Body.Close()
+	name, err := uploadObjectName(req.Header.Get("Content-Type"), body)
+	if err != nil {
+		return nil, err
+	}
+	bucketName := strings.TrimSuffix(strings.TrimPrefix(req.URL.Path, "/upload/storage/v1/b/"), "/o")
+	key := bucketName + "/" + name
+
+	transport.lock.Lock()
+	defer transport.lock.Unlock()
+	match, _ := strconv.ParseInt(req.URL.Query().Get("ifGenerationMatch"), 10, 64)
+	if match > 0 && match != transport.generations[key] {
+		return &http.Response{
+			StatusCode: http.StatusPreconditionFailed,
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+			Body:       ioutil.NopCloser(strings.NewReader(`{"error":{"code":412,"message":"Precondition Failed"}}`)),
+			Request:    req,
+		}, nil
+	}
+
+	forwarded := req.WithContext(req.Context())
+	forwarded.Body = ioutil.NopCloser(bytes.NewReader(body))
+	forwarded.ContentLength = int64(len(body))
+	res, err := transport.base.RoundTrip(forwarded)
+	if err == nil && res.StatusCode == http.StatusOK {
+		transport.generations[key]++
+	}
+	return res, err
+}
+
+// uploadObjectName - reads the object name from the metadata part of a multipart upload.
+func uploadObjectName(contentType string, body []byte) (string, error) {
+	_, params, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return "", err
+	}
+	part, err := multipart.NewReader(bytes.NewReader(body), params["boundary"]).NextPart()
+	if err != nil {
+		return "", err
+	}
+	metadata := struct {
+		Name string `json:"name"`
+	}{}
+	err = json.NewDecoder(part).Decode(&metadata)
+	return metadata.Name, err
+}
+
+// newTestClient - returns a client for the fake server which checks generation preconditions.
+func newTestClient(t *testing.T, server *fakestorage.Server) *gcsclient.Client {
+	transport := &generationTransport{
+		base:        server.HTTPClient().Transport,
+		generations: map[string]int64{},
+	}
+	client, err := gcsclient.NewClient(context.Background(), option.WithHTTPClient(&http.Client{Transport: transport}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return client
+}
+
 func newTestStorage(t *testing.T, bucketName string) (storage.RepositoryStorage, *fakestorage.Server) {
 	objects := make([]fakestorage.Object, 0)
 	server := fakestorage.NewServer(objects)
 	server.CreateBucket(bucketName)
-	client := server.Client()
+	client := newTestClient(t, server)
 
 	repository := gcs.NewGCSStorage(client, bucketName)
 	return repository, server
@@ -54,6 +158,30 @@ func TestGCS_UpdateHyperparameters(t *testing.T) {
 	tests.Test_UpdateHyperparams(t, store)
 }
 
+func TestGCS_UpdateVersions(t *testing.T) {
+	store, server := newTestStorage(t, "update_versions")
+	defer server.Stop()
+	tests.Test_UpdateVersions(t, store)
+}
+
+func TestGCS_ConcurrentUpdates(t *testing.T) {
+	store, server := newTestStorage(t, "concurrent-updates")
+	defer server.Stop()
+	tests.Test_ConcurrentUpdates(t, store)
+}
+
+func TestGCS_Revisions(t *testing.T) {
+	store, server := newTestStorage(t, "revisions")
+	defer server.Stop()
+	tests.Test_Revisions(t, store)
+}
+
+func TestGCS_Replace(t *testing.T) {
+	store, server := newTestStorage(t, "revisions")
+	defer server.Stop()
+	tests.Test_Replace(t, store)
+}
+
 func TestGCS_AddCheckpoint(t *testing.T) {
 	store, server := newTestStorage(t, "add_checkpoint")
 	defer server.Stop()
@@ -65,3 +193,207 @@ func TestGCS_ListCheckpoints(t *testing.T) {
 	defer server.Stop()
 	tests.Test_ListCheckpoints(t, store)
 }
+
+func TestGCS_UpdateCheckpointState(t *testing.T) {
+	store, server := newTestStorage(t, "update_checkpoint_state")
+	defer server.Stop()
+	tests.Test_UpdateCheckpointState(t, store)
+}
+
+func TestGCS_DeleteModel(t *testing.T) {
+	store, server := newTestStorage(t, "delete_model")
+	defer server.Stop()
+	tests.Test_DeleteModel(t, store)
+}
+
+func TestGCS_DeleteHyperparameters(t *testing.T) {
+	store, server := newTestStorage(t, "delete_hyperparameters")
+	defer server.Stop()
+	tests.Test_DeleteHyperparameters(t, store)
+}
+
+func TestGCS_DeleteCheckpoint(t *testing.T) {
+	store, server := newTestStorage(t, "delete_checkpoint")
+	defer server.Stop()
+	tests.Test_DeleteCheckpoint(t, store)
+}
+
+func TestGCS_Tags(t *testing.T) {
+	store, server := newTestStorage(t, "tags")
+	defer server.Stop()
+	tests.Test_Tags(t, store)
+}
+
+func TestGCS_Rollouts(t *testing.T) {
+	store, server := newTestStorage(t, "rollouts")
+	defer server.Stop()
+	tests.Test_Rollouts(t, store)
+}
+
+func TestGCS_FindDanglingReferences(t *testing.T) {
+	store, server := newTestStorage(t, "find_dangling_references")
+	defer server.Stop()
+	tests.Test_FindDanglingReferences(t, store)
+}
+
+func TestGCS_BatchGet(t *testing.T) {
+	store, server := newTestStorage(t, "batch_get")
+	defer server.Stop()
+	tests.Test_BatchGet(t, store)
+}
+
+func TestGCS_Labels(t *testing.T) {
+	store, server := newTestStorage(t, "labels")
+	defer server.Stop()
+	tests.Test_Labels(t, store)
+}
+
+func TestGCS_AuditEvents(t *testing.T) {
+	store, server := newTestStorage(t, "audit_events")
+	defer server.Stop()
+	tests.Test_AuditEvents(t, store)
+}
+
+func TestGCS_Webhooks(t *testing.T) {
+	store, server := newTestStorage(t, "webhooks")
+	defer server.Stop()
+	tests.Test_Webhooks(t, store)
+}
+
+func TestGCS_FleaTasks(t *testing.T) {
+	const bucketName = "flea_tasks"
+	server := fakestorage.NewServer(make([]fakestorage.Object, 0))
+	defer server.Stop()
+	server.CreateBucket(bucketName)
+	store := gcs.NewFleaGCSStorage(server.Client(), bucketName, "flea_uploads", "http://repository", fakeURLSigner{bucketName: "flea_uploads"})
+	tests.Test_FleaTasks(t, store)
+}
+
+// The fake GCS server cannot check signatures, so uploads write to it directly.
+type fakeURLSigner struct {
+	bucketName string
+}
+
+func (signer fakeURLSigner) GetSignedURL(method string, filePath string, expires time.Time, contentType string) (string, error) {
+	return "https://storage.example.com/" + signer.bucketName + "/" + filePath, nil
+}
+
+func TestGCS_SignCheckpointLink(t *testing.T) {
+	const bucketName = "sign_checkpoint_link"
+	server := fakestorage.NewServer(make([]fakestorage.Object, 0))
+	defer server.Stop()
+	server.CreateBucket(bucketName)
+	ctx := context.Background()
+	expires := time.Now().Add(time.Hour)
+
+	store := gcs.NewGCSStorageWithURLSigner(server.Client(), bucketName, fakeURLSigner{bucketName: bucketName})
+	link, err := store.SignCheckpointLink(ctx, "gs://sign_checkpoint_link/bundles/ckpt.zip", expires)
+	if err != nil || link != "https://storage.example.com/sign_checkpoint_link/bundles/ckpt.zip" {
+		t.Fatalf("Expected own bucket link to be signed, got %s (%v)", link, err)
+	}
+	for _, unsigned := range []string{"gs://elsewhere/ckpt.zip", "https://example.com/ckpt.zip"} {
+		link, err = store.SignCheckpointLink(ctx, unsigned, expires)
+		if err != nil || link != unsigned {
+			t.Fatalf("Expected %s to be returned unchanged, got %s (%v)", unsigned, link, err)
+		}
+	}
+
+	store = gcs.NewGCSStorage(server.Client(), bucketName)
+	link, err = store.SignCheckpointLink(ctx, "gs://sign_checkpoint_link/bundles/ckpt.zip", expires)
+	if err != nil || link != "gs://sign_checkpoint_link/bundles/ckpt.zip" {
+		t.Fatalf("Expected link to be returned unchanged without a signer, got %s (%v)", link, err)
+	}
+}
+
+func TestGCS_VerifyCheckpoints(t *testing.T) {
+	const bucketName = "verify_checkpoints"
+	server := fakestorage.NewServer([]fakestorage.Object{
+		{BucketName: "bundles", Name: "ckpt.zip", Content: []byte("test")},
+	})
+	defer server.Stop()
+	server.CreateBucket(bucketName)
+	ctx := context.Background()
+
+	store := gcs.NewGCSStorageWithOptions(server.Client(), bucketName, gcs.Options{VerifyCheckpoints: true})
+	err := store.AddModel(ctx, storage.Model{ModelId: "model"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = store.AddHyperparameters(ctx, storage.Hyperparameters{ModelId: "model", HyperparametersId: "hp"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	checkpoint := storage.Checkpoint{
+		ModelId:           "model",
+		HyperparametersId: "hp",
+		CheckpointId:      "ckpt",
+		Link:              "gs://bundles/ckpt.zip",
+		Sha256:            "9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08",
+		SizeBytes:         4,
+	}
+	mismatches := map[string]func(storage.Checkpoint) storage.Checkpoint{
+		"link": func(c storage.Checkpoint) storage.Checkpoint {
+			c.Link = "gs://bundles/missing.zip"
+			return c
+		},
+		"sha256": func(c storage.Checkpoint) storage.Checkpoint {
+			c.Sha256 = strings.Repeat("0", 64)
+			return c
+		},
+		"sizeBytes": func(c storage.Checkpoint) storage.Checkpoint {
+			c.SizeBytes = 5
+			return c
+		},
+		"contentType": func(c storage.Checkpoint) storage.Checkpoint {
+			c.ContentType = "application/zip"
+			return c
+		},
+	}
+	for field, mismatch := range mismatches {
+		err = store.AddCheckpoint(ctx, mismatch(checkpoint))
+		contentErr, ok := err.(storage.CheckpointContentError)
+		if !ok || contentErr.Field != field {
+			t.Fatalf("Expected %s mismatch, got %v", field, err)
+		}
+	}
+
+	err = store.AddCheckpoint(ctx, checkpoint)
+	if err != nil {
+		t.Fatalf("Expected matching checkpoint to be added, got %v", err)
+	}
+
+	// Links outside of GCS cannot be verified, so they are accepted as they are.
+	checkpoint.CheckpointId = "elsewhere"
+	checkpoint.Link = "https://example.com/ckpt.zip"
+	checkpoint.SizeBytes = 5
+	err = store.AddCheckpoint(ctx, checkpoint)
+	if err != nil {
+		t.Fatalf("Expected checkpoint with https link to be added, got %v", err)
+	}
+}
+
+func TestGCS_CheckpointUploadNotConfigured(t *testing.T) {
+	store, server := newTestStorage(t, "checkpoint_upload_not_configured")
+	defer server.Stop()
+	_, _, err := store.CheckpointUploadURL(context.Background(), "model", "hp", "ckpt", time.Now().Add(time.Hour))
+	if err != storage.ErrUploadsNotConfigured {
+		t.Fatalf("Expected %v, got %v", storage.ErrUploadsNotConfigured, err)
+	}
+}
+
+func TestGCS_CheckpointUpload(t *testing.T) {
+	const bucketName = "checkpoint_upload"
+	server := fakestorage.NewServer(make([]fakestorage.Object, 0))
+	defer server.Stop()
+	server.CreateBucket(bucketName)
+	store := gcs.NewGCSStorageWithURLSigner(server.Client(), bucketName, fakeURLSigner{bucketName: bucketName})
+	tests.Test_CheckpointUpload(t, store, func(uploadURL string, contents []byte) error {
+		server.CreateObject(fakestorage.Object{
+			BucketName: bucketName,
+			Name:       strings.TrimPrefix(uploadURL, "https://storage.example.com/"+bucketName+"/"),
+			Content:    contents,
+		})
+		return nil
+	})
+}
@@ -3,6 +3,8 @@ package gcs
 import (
 	"fmt"
 	"strings"
+
+	"github.com/doc-ai/tensorio-models/storage"
 )
 
 func objModelPath(modelId string) string {
@@ -25,3 +27,62 @@ func extractObjectName(name string) string {
 	name = splitNames[len(splitNames)-2]
 	return name
 }
+
+func objAuditDir() string {
+	return "audit/"
+}
+
+// objAuditPartitionDir - audit events are partitioned by the hour at the start of their IDs, so
+// that listing the events after a marker does not have to list the whole audit log.
+func objAuditPartitionDir(partition string) string {
+	return objAuditDir() + partition + "/"
+}
+
+func objAuditEventPath(eventId string) string {
+	return objAuditPartitionDir(storage.AuditPartition(eventId)) + eventId + ".json"
+}
+
+func objWebhooksDir() string {
+	return "webhooks/"
+}
+
+func objWebhookPath(webhookId string) string {
+	return objWebhooksDir() + webhookId + ".json"
+}
+
+func objWebhookDeadLettersDir() string {
+	return "webhook-dead-letters/"
+}
+
+func objWebhookDeadLetterPath(deliveryId string) string {
+	return objWebhookDeadLettersDir() + deliveryId + ".json"
+}
+
+func objModelRevisionsDir(modelId string) string {
+	return fmt.Sprintf("models/%s/revisions/", modelId)
+}
+
+func objModelRevisionPath(modelId string, version int64) string {
+	return objModelRevisionsDir(modelId) + storage.RevisionKey(version) + ".json"
+}
+
+func objHyperparametersRevisionsDir(modelId string, hyperparametersId string) string {
+	return fmt.Sprintf("models/%s/hyperparameters/%s/revisions/", modelId, hyperparametersId)
+}
+
+func objHyperparametersRevisionPath(modelId string, hyperparametersId string, version int64) string {
+	return objHyperparametersRevisionsDir(modelId, hyperparametersId) + storage.RevisionKey(version) + ".json"
+}
+
+// objTagsDir - the directory holding the tags on a model, or on hyperparameters if
+// hyperparametersId is set.
+func objTagsDir(modelId string, hyperparametersId string) string {
+	if hyperparametersId == "" {
+		return fmt.Sprintf("models/%s/tags/", modelId)
+	}
+	return fmt.Sprintf("models/%s/hyperparameters/%s/tags/", modelId, hyperparametersId)
+}
+
+func objTagPath(modelId string, hyperparametersId string, tag string) string {
+	return objTagsDir(modelId, hyperparametersId) + tag + ".json"
+}
@@ -47,3 +47,8 @@ func Test_extractCheckpointName(t *testing.T) {
 	name := extractObjectName(path)
 	assert.Equal(t, name, "checkpoint3")
 }
+
+func Test_objRevisionPaths(t *testing.T) {
+	assert.Equal(t, "models/model1/revisions/00000000000000000012.json", objModelRevisionPath("model1", 12))
+	assert.Equal(t, "models/model1/hyperparameters/param2/revisions/00000000000000000003.json", objHyperparametersRevisionPath("model1", "param2", 3))
+}
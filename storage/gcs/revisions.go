@@ -0,0 +1,139 @@
+package gcs
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"time"
+
+	gcs "cloud.google.com/go/storage"
+	"github.com/doc-ai/tensorio-models/storage"
+	"google.golang.org/api/iterator"
+)
+
+func (store gcsStorage) ListModelRevisions(ctx context.Context, modelId string, afterVersion int64, maxItems int) ([]storage.ModelRevision, error) {
+	_, err := store.GetModel(ctx, modelId)
+	if err != nil {
+		return nil, err
+	}
+
+	names, err := listRevisions(ctx, store.bucket, objModelRevisionsDir(modelId), afterVersion, maxItems)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]storage.ModelRevision, len(names))
+	for i, name := range names {
+		err = readRevision(ctx, store.bucket.Object(name), &res[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+func (store gcsStorage) GetModelRevision(ctx context.Context, modelId string, version int64) (storage.ModelRevision, error) {
+	_, err := store.GetModel(ctx, modelId)
+	if err != nil {
+		return storage.ModelRevision{}, err
+	}
+
+	revision := storage.ModelRevision{}
+	err = readRevision(ctx, store.bucket.Object(objModelRevisionPath(modelId, version)), &revision)
+	if err != nil {
+		return storage.ModelRevision{}, err
+	}
+	return revision, nil
+}
+
+func (store gcsStorage) ListHyperparametersRevisions(ctx context.Context, modelId, hyperparametersId string, afterVersion int64, maxItems int) ([]storage.HyperparametersRevision, error) {
+	_, err := store.GetHyperparameters(ctx, modelId, hyperparametersId)
+	if err != nil {
+		return nil, err
+	}
+
+	names, err := listRevisions(ctx, store.bucket, objHyperparametersRevisionsDir(modelId, hyperparametersId), afterVersion, maxItems)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]storage.HyperparametersRevision, len(names))
+	for i, name := range names {
+		err = readRevision(ctx, store.bucket.Object(name), &res[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+func (store gcsStorage) GetHyperparametersRevision(ctx context.Context, modelId, hyperparametersId string, version int64) (storage.HyperparametersRevision, error) {
+	_, err := store.GetHyperparameters(ctx, modelId, hyperparametersId)
+	if err != nil {
+		return storage.HyperparametersRevision{}, err
+	}
+
+	revision := storage.HyperparametersRevision{}
+	objLoc := objHyperparametersRevisionPath(modelId, hyperparametersId, version)
+	err = readRevision(ctx, store.bucket.Object(objLoc), &revision)
+	if err != nil {
+		return storage.HyperparametersRevision{}, err
+	}
+	return revision, nil
+}
+
+func (store gcsStorage) addModelRevision(ctx context.Context, model storage.Model) error {
+	revision := storage.ModelRevision{Model: model, CreatedAt: time.Now()}
+	return writeRevision(ctx, store.bucket.Object(objModelRevisionPath(model.ModelId, model.Version)), revision)
+}
+
+func (store gcsStorage) addHyperparametersRevision(ctx context.Context, hyperparameters storage.Hyperparameters) error {
+	revision := storage.HyperparametersRevision{Hyperparameters: hyperparameters, CreatedAt: time.Now()}
+	objLoc := objHyperparametersRevisionPath(hyperparameters.ModelId, hyperparameters.HyperparametersId, hyperparameters.Version)
+	return writeRevision(ctx, store.bucket.Object(objLoc), revision)
+}
+
+func writeRevision(ctx context.Context, object *gcs.ObjectHandle, revision interface{}) error {
+	bytes, err := json.Marshal(revision)
+	if err != nil {
+		return err
+	}
+	// Revisions are never overwritten
+	writer := object.If(gcs.Conditions{DoesNotExist: true}).NewWriter(ctx)
+	return writeObject(ctx, writer, bytes)
+}
+
+// readRevision - as readObject, but returns RevisionDoesNotExistError if there is no revision.
+func readRevision(ctx context.Context, object *gcs.ObjectHandle, v interface{}) error {
+	err := readObject(ctx, object, v)
+	if err == gcs.ErrObjectNotExist {
+		return storage.RevisionDoesNotExistError
+	}
+	return err
+}
+
+// listRevisions - returns the names of up to maxItems revision objects under prefix whose versions
+// come after afterVersion, in order of version. As with the audit log, the prefix is listed in full
+// and filtered here.
+func listRevisions(ctx context.Context, bucket *gcs.BucketHandle, prefix string, afterVersion int64, maxItems int) ([]string, error) {
+	res := make([]string, 0)
+	marker := storage.RevisionKey(afterVersion)
+	iter := bucket.Objects(ctx, &gcs.Query{Prefix: prefix})
+	for len(res) < maxItems {
+		obj, err := iter.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		key := strings.TrimSuffix(strings.TrimPrefix(obj.Name, prefix), ".json")
+		if key <= marker {
+			continue
+		}
+
+		res = append(res, obj.Name)
+	}
+	return res, nil
+}
@@ -0,0 +1,103 @@
+package gcs
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+	"time"
+
+	gcs "cloud.google.com/go/storage"
+	"github.com/doc-ai/tensorio-models/storage"
+	"google.golang.org/api/iterator"
+)
+
+func (store gcsStorage) SetTag(ctx context.Context, tag storage.Tag) (storage.Tag, error) {
+	err := store.checkTagsOwner(ctx, tag.ModelId, tag.HyperparametersId)
+	if err != nil {
+		return storage.Tag{}, err
+	}
+
+	tag.UpdatedAt = time.Now()
+	bytes, err := json.Marshal(tag)
+	if err != nil {
+		return storage.Tag{}, err
+	}
+	writer := store.bucket.Object(objTagPath(tag.ModelId, tag.HyperparametersId, tag.Tag)).NewWriter(ctx)
+	err = writeObject(ctx, writer, bytes)
+	if err != nil {
+		return storage.Tag{}, err
+	}
+	return tag, nil
+}
+
+func (store gcsStorage) GetTag(ctx context.Context, modelId, hyperparametersId, tag string) (storage.Tag, error) {
+	err := store.checkTagsOwner(ctx, modelId, hyperparametersId)
+	if err != nil {
+		return storage.Tag{}, err
+	}
+
+	res := storage.Tag{}
+	err = readObject(ctx, store.bucket.Object(objTagPath(modelId, hyperparametersId, tag)), &res)
+	if err == gcs.ErrObjectNotExist {
+		return storage.Tag{}, storage.TagDoesNotExistError
+	}
+	if err != nil {
+		return storage.Tag{}, err
+	}
+	return res, nil
+}
+
+func (store gcsStorage) ListTags(ctx context.Context, modelId, hyperparametersId string) ([]storage.Tag, error) {
+	err := store.checkTagsOwner(ctx, modelId, hyperparametersId)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]storage.Tag, 0)
+	iter := store.bucket.Objects(ctx, &gcs.Query{Prefix: objTagsDir(modelId, hyperparametersId)})
+	for {
+		obj, err := iter.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		tag := storage.Tag{}
+		err = readObject(ctx, store.bucket.Object(obj.Name), &tag)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, tag)
+	}
+	// Object names sort differently from tag names because of their .json extension
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].Tag < res[j].Tag
+	})
+	return res, nil
+}
+
+func (store gcsStorage) DeleteTag(ctx context.Context, modelId, hyperparametersId, tag string) error {
+	err := store.checkTagsOwner(ctx, modelId, hyperparametersId)
+	if err != nil {
+		return err
+	}
+
+	err = store.bucket.Object(objTagPath(modelId, hyperparametersId, tag)).Delete(ctx)
+	if err == gcs.ErrObjectNotExist {
+		return storage.TagDoesNotExistError
+	}
+	return err
+}
+
+// checkTagsOwner - checks that the model (if hyperparametersId is "") or hyperparameters which tags
+// are on exist.
+func (store gcsStorage) checkTagsOwner(ctx context.Context, modelId, hyperparametersId string) error {
+	if hyperparametersId == "" {
+		_, err := store.GetModel(ctx, modelId)
+		return err
+	}
+	_, err := store.GetHyperparameters(ctx, modelId, hyperparametersId)
+	return err
+}
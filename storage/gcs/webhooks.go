@@ -0,0 +1,95 @@
+package gcs
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+
+	gcs "cloud.google.com/go/storage"
+	"github.com/doc-ai/tensorio-models/storage"
+	"google.golang.org/api/iterator"
+)
+
+// webhookStore - stores each webhook in its own object under webhooks/, and each dead letter in
+// its own object under webhook-dead-letters/. Shared by the repository and FLEA storage.
+type webhookStore struct {
+	webhooksBucket *gcs.BucketHandle
+}
+
+func (store webhookStore) AddWebhook(ctx context.Context, webhook storage.Webhook) error {
+	bytes, err := json.Marshal(webhook)
+	if err != nil {
+		return err
+	}
+	writer := store.webhooksBucket.Object(objWebhookPath(webhook.WebhookId)).NewWriter(ctx)
+	return writeObject(ctx, writer, bytes)
+}
+
+func (store webhookStore) ListWebhooks(ctx context.Context) ([]storage.Webhook, error) {
+	res := make([]storage.Webhook, 0)
+	iter := store.webhooksBucket.Objects(ctx, &gcs.Query{Prefix: objWebhooksDir()})
+	for {
+		obj, err := iter.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		webhook := storage.Webhook{}
+		err = readObject(ctx, store.webhooksBucket.Object(obj.Name), &webhook)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, webhook)
+	}
+	return res, nil
+}
+
+func (store webhookStore) DeleteWebhook(ctx context.Context, webhookId string) error {
+	err := store.webhooksBucket.Object(objWebhookPath(webhookId)).Delete(ctx)
+	if err == gcs.ErrObjectNotExist {
+		return storage.WebhookDoesNotExistError
+	}
+	return err
+}
+
+func (store webhookStore) AddWebhookDeadLetter(ctx context.Context, deadLetter storage.WebhookDeadLetter) error {
+	bytes, err := json.Marshal(deadLetter)
+	if err != nil {
+		return err
+	}
+	object := store.webhooksBucket.Object(objWebhookDeadLetterPath(deadLetter.DeliveryId))
+	// Dead letters are never overwritten
+	writer := object.If(gcs.Conditions{DoesNotExist: true}).NewWriter(ctx)
+	return writeObject(ctx, writer, bytes)
+}
+
+func (store webhookStore) ListWebhookDeadLetters(ctx context.Context, marker string, maxItems int) ([]storage.WebhookDeadLetter, error) {
+	res := make([]storage.WebhookDeadLetter, 0)
+	// As with the audit log, the prefix is listed in full and filtered here.
+	iter := store.webhooksBucket.Objects(ctx, &gcs.Query{Prefix: objWebhookDeadLettersDir()})
+	for len(res) < maxItems {
+		obj, err := iter.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		deliveryId := strings.TrimSuffix(strings.TrimPrefix(obj.Name, objWebhookDeadLettersDir()), ".json")
+		if deliveryId <= marker {
+			continue
+		}
+
+		deadLetter := storage.WebhookDeadLetter{}
+		err = readObject(ctx, store.webhooksBucket.Object(obj.Name), &deadLetter)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, deadLetter)
+	}
+	return res, nil
+}
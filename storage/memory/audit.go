@@ -0,0 +1,50 @@
+package memory
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"github.com/doc-ai/tensorio-models/storage"
+)
+
+// auditLog - the in-memory implementation of storage.AuditStorage, shared by the repository and
+// FLEA storage.
+type auditLog struct {
+	auditLock   *sync.RWMutex
+	auditEvents []storage.AuditEvent
+}
+
+func newAuditLog() auditLog {
+	return auditLog{
+		auditLock:   &sync.RWMutex{},
+		auditEvents: make([]storage.AuditEvent, 0),
+	}
+}
+
+func (s *auditLog) AddAuditEvent(ctx context.Context, event storage.AuditEvent) error {
+	s.auditLock.Lock()
+	defer s.auditLock.Unlock()
+	index := sort.Search(len(s.auditEvents), func(i int) bool {
+		return s.auditEvents[i].EventId > event.EventId
+	})
+	s.auditEvents = append(s.auditEvents, storage.AuditEvent{})
+	copy(s.auditEvents[index+1:], s.auditEvents[index:])
+	s.auditEvents[index] = event
+	return nil
+}
+
+func (s *auditLog) ListAuditEvents(ctx context.Context, marker string, maxItems int) ([]storage.AuditEvent, error) {
+	s.auditLock.RLock()
+	defer s.auditLock.RUnlock()
+	firstIndex := sort.Search(len(s.auditEvents), func(i int) bool {
+		return s.auditEvents[i].EventId > marker
+	})
+	lastIndex := firstIndex + maxItems
+	if lastIndex > len(s.auditEvents) {
+		lastIndex = len(s.auditEvents)
+	}
+	res := make([]storage.AuditEvent, lastIndex-firstIndex)
+	copy(res, s.auditEvents[firstIndex:lastIndex])
+	return res, nil
+}
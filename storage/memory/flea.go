@@ -14,6 +14,8 @@ import (
 )
 
 type flea struct {
+	auditLog
+	webhookStore
 	lock              *sync.RWMutex
 	tasks             map[string]storage.Task
 	repositoryBaseURL string
@@ -23,6 +25,8 @@ type flea struct {
 // NewMemoryFleaStorage - returns in-memory implementation of FleaStorage interface.
 func NewMemoryFleaStorage(repositoryBaseURL string) storage.FleaStorage {
 	store := &flea{
+		auditLog:          newAuditLog(),
+		webhookStore:      newWebhookStore(),
 		lock:              &sync.RWMutex{},
 		repositoryBaseURL: repositoryBaseURL,
 		uploadReqURL:      "gs://example-repo", // Stub in this implementation.
@@ -71,40 +75,16 @@ func (s *flea) ModifyTask(ctx context.Context, req api.ModifyTaskRequest) error
 
 // Expects that the input sanity checks are done by the caller.
 func (s *flea) ListTasks(ctx context.Context, req api.ListTasksRequest) (api.ListTasksResponse, error) {
-	resp := api.ListTasksResponse{}
-	isLimited := false
-	if req.MaxItems > 0 {
-		isLimited = true
-		resp.MaxItems = req.MaxItems
-	}
-	resp.StartTaskId = req.StartTaskId
 	s.lock.RLock()
 	defer s.lock.RUnlock()
-	var taskIds []string
-	for taskId, task := range s.tasks {
-		if !req.IncludeInactive && !task.Active {
-			continue
-		}
-		if req.StartTaskId != "" && taskId < req.StartTaskId {
-			continue
-		}
-		if task.ModelId != req.ModelId && req.ModelId != "" {
-			continue
-		}
-		if task.HyperparametersId != req.HyperparametersId && req.HyperparametersId != "" {
-			continue
-		}
-		if task.CheckpointId != req.CheckpointId && req.CheckpointId != "" {
-			continue
-		}
-		taskIds = append(taskIds, taskId)
-	}
-	sort.Strings(taskIds)
-	if isLimited && (len(taskIds) >= int(req.MaxItems)) {
-		taskIds = taskIds[:req.MaxItems]
+	candidates := make([]string, 0, len(s.tasks))
+	for taskId := range s.tasks {
+		candidates = append(candidates, taskId)
 	}
-	resp.TaskIds = taskIds
-	return resp, nil
+	sort.Strings(candidates)
+	return storage.ListTaskCandidates(req, candidates, func(taskId string) (api.TaskDetails, error) {
+		return s.taskDetails(s.tasks[taskId]), nil
+	})
 }
 
 func (s *flea) GetTask(ctx context.Context, taskId string) (api.TaskDetails, error) {
@@ -114,7 +94,26 @@ func (s *flea) GetTask(ctx context.Context, taskId string) (api.TaskDetails, err
 	if !exists {
 		return api.TaskDetails{}, storage.ErrMissingTaskId
 	}
-	resp := api.TaskDetails{
+	return s.taskDetails(task), nil
+}
+
+func (s *flea) BatchGetTasks(ctx context.Context, taskIds []string) ([]api.TaskDetails, error) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	res := make([]api.TaskDetails, len(taskIds))
+	for i, taskId := range taskIds {
+		task, exists := s.tasks[taskId]
+		if !exists {
+			return nil, storage.ErrMissingTaskId
+		}
+		res[i] = s.taskDetails(task)
+	}
+	return res, nil
+}
+
+// Expects the caller to hold the lock.
+func (s *flea) taskDetails(task storage.Task) api.TaskDetails {
+	return api.TaskDetails{
 		ModelId:           task.ModelId,
 		HyperparametersId: task.HyperparametersId,
 		CheckpointId:      task.CheckpointId,
@@ -125,7 +124,6 @@ func (s *flea) GetTask(ctx context.Context, taskId string) (api.TaskDetails, err
 		CheckpointLink: s.repositoryBaseURL + common.GetCheckpointResourcePath(
 			task.ModelId, task.HyperparametersId, task.CheckpointId),
 	}
-	return resp, nil
 }
 
 func (s *flea) StartTask(ctx context.Context, taskId string) (api.StartTaskResponse, error) {
@@ -3,10 +3,15 @@ package memory
 import (
 	"context"
 	"fmt"
+	"io"
+	"os"
+	"path/filepath"
 	"sort"
 	"strings"
 	"sync"
+	"time"
 
+	"github.com/doc-ai/tensorio-models/api"
 	"github.com/doc-ai/tensorio-models/storage"
 )
 
@@ -15,30 +20,65 @@ const (
 )
 
 type memory struct {
+	auditLog
+	webhookStore
+
 	lock *sync.RWMutex
 
-	modelList []string
-	models    map[string]storage.Model
+	modelList      []string
+	models         map[string]storage.Model
+	modelRevisions map[string][]storage.ModelRevision
 
-	hyperparametersList []string
-	hyperparameters     map[string]storage.Hyperparameters
+	hyperparametersList      []string
+	hyperparameters          map[string]storage.Hyperparameters
+	hyperparametersRevisions map[string][]storage.HyperparametersRevision
 
 	checkpointsList []string
 	checkpoints     map[string]storage.Checkpoint
+
+	// tags - the tags on each model (keyed by model ID) and hyperparameters (keyed by
+	// "<model ID>:<hyperparameters ID>"), by name
+	tags map[string]map[string]storage.Tag
+
+	// Checkpoint bundles are the one thing which is not kept in memory, as clients have to be able
+	// to upload them.
+	uploadDir string
 }
 
+// NewMemoryRepositoryStorage - Creates an in-memory instance of storage.RepositoryStorage. Checkpoint
+// bundles are uploaded to REPOSITORY_MEMORY_UPLOAD_DIR, or to a directory under the system's
+// temporary directory if it is not set.
 func NewMemoryRepositoryStorage() storage.RepositoryStorage {
+	uploadDir := os.Getenv("REPOSITORY_MEMORY_UPLOAD_DIR")
+	if uploadDir == "" {
+		uploadDir = filepath.Join(os.TempDir(), "tensorio-models-uploads")
+	}
+	return NewMemoryRepositoryStorageWithUploadDir(uploadDir)
+}
+
+// NewMemoryRepositoryStorageWithUploadDir - Creates an in-memory instance of
+// storage.RepositoryStorage which hands out file:// URLs under uploadDir for checkpoint bundles.
+func NewMemoryRepositoryStorageWithUploadDir(uploadDir string) storage.RepositoryStorage {
 	store := &memory{
+		auditLog:     newAuditLog(),
+		webhookStore: newWebhookStore(),
+
 		lock: &sync.RWMutex{},
 
-		modelList: make([]string, 0),
-		models:    make(map[string]storage.Model),
+		modelList:      make([]string, 0),
+		models:         make(map[string]storage.Model),
+		modelRevisions: make(map[string][]storage.ModelRevision),
 
-		hyperparametersList: make([]string, 0),
-		hyperparameters:     make(map[string]storage.Hyperparameters),
+		hyperparametersList:      make([]string, 0),
+		hyperparameters:          make(map[string]storage.Hyperparameters),
+		hyperparametersRevisions: make(map[string][]storage.HyperparametersRevision),
 
 		checkpointsList: make([]string, 0),
 		checkpoints:     make(map[string]storage.Checkpoint),
+
+		tags: make(map[string]map[string]storage.Tag),
+
+		uploadDir: uploadDir,
 	}
 	return store
 }
@@ -49,14 +89,14 @@ func (s *memory) GetStorageType() string {
 
 func (s *memory) GetBucketName() string { return "" }
 
-func (s *memory) ListModels(ctx context.Context, marker string, maxItems int) ([]string, error) {
+func (s *memory) ListModels(ctx context.Context, marker string, maxItems int) (storage.ListResult, error) {
 	s.lock.RLock()
 	defer s.lock.RUnlock()
 	firstIndex := sort.SearchStrings(s.modelList, marker)
 
 	// check if marker index is past the end of the list
 	if firstIndex == len(s.modelList) {
-		return make([]string, 0), nil
+		return storage.ListResult{Ids: make([]string, 0)}, nil
 	}
 
 	if marker == s.modelList[firstIndex] {
@@ -65,10 +105,11 @@ func (s *memory) ListModels(ctx context.Context, marker string, maxItems int) ([
 
 	// check if the updated marker index is past the end of the listGert
 	if firstIndex == len(s.modelList) {
-		return make([]string, 0), nil
+		return storage.ListResult{Ids: make([]string, 0)}, nil
 	}
 
-	lastIndex := firstIndex + maxItems
+	// list one more model than asked for to find out whether there is another page
+	lastIndex := firstIndex + maxItems + 1
 	if lastIndex > len(s.modelList) {
 		lastIndex = len(s.modelList)
 	}
@@ -77,7 +118,7 @@ func (s *memory) ListModels(ctx context.Context, marker string, maxItems int) ([
 	safeSlice := make([]string, len(unsafeSlice))
 	copy(safeSlice, unsafeSlice)
 
-	return safeSlice, nil
+	return storage.NewListResult(safeSlice, maxItems), nil
 }
 
 func (s *memory) GetModel(ctx context.Context, modelId string) (storage.Model, error) {
@@ -90,6 +131,20 @@ func (s *memory) GetModel(ctx context.Context, modelId string) (storage.Model, e
 	return model, storage.ModelDoesNotExistError
 }
 
+func (s *memory) BatchGetModels(ctx context.Context, modelIds []string) ([]storage.Model, error) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	models := make([]storage.Model, len(modelIds))
+	for i, modelId := range modelIds {
+		model, ok := s.models[modelId]
+		if !ok {
+			return nil, storage.ModelDoesNotExistError
+		}
+		models[i] = model
+	}
+	return models, nil
+}
+
 func (s *memory) AddModel(ctx context.Context, model storage.Model) error {
 	if _, err := s.GetModel(ctx, model.ModelId); err == nil {
 		return storage.ModelExistsError
@@ -97,37 +152,75 @@ func (s *memory) AddModel(ctx context.Context, model storage.Model) error {
 
 	s.lock.Lock()
 	defer s.lock.Unlock()
+	model.Version = 1
 	s.modelList = insert(s.modelList, model.ModelId)
 	s.models[model.ModelId] = model
+	s.addModelRevision(model)
 
 	return nil
 }
 
 func (s *memory) UpdateModel(ctx context.Context, model storage.Model) (storage.Model, error) {
+	return s.updateModel(ctx, model, false)
+}
+
+func (s *memory) ReplaceModel(ctx context.Context, model storage.Model) (storage.Model, error) {
+	return s.updateModel(ctx, model, true)
+}
+
+// updateModel - merges model into the stored model as UpdateModel does or, if replace is
+// set, replaces it as ReplaceModel does.
+func (s *memory) updateModel(ctx context.Context, model storage.Model, replace bool) (storage.Model, error) {
 	var currentModel storage.Model
 	var err error
 	if currentModel, err = s.GetModel(ctx, model.ModelId); err != nil {
 		return storage.Model{}, err
 	}
 
+	if err = storage.CheckModelReferences(ctx, s, currentModel, model); err != nil {
+		return storage.Model{}, err
+	}
+
 	s.lock.Lock()
 	defer s.lock.Unlock()
 
-	if strings.TrimSpace(model.Details) != "" {
-		currentModel.Details = model.Details
+	// Another update may have gone through since the model was read.
+	currentModel, ok := s.models[model.ModelId]
+	if !ok {
+		return storage.Model{}, storage.ModelDoesNotExistError
+	}
+	if err = storage.CheckVersion(currentModel.Version, model.Version); err != nil {
+		return storage.Model{}, err
 	}
-	if strings.TrimSpace(model.CanonicalHyperparameters) != "" {
-		currentModel.CanonicalHyperparameters = model.CanonicalHyperparameters
+
+	if replace {
+		currentModel = storage.ReplacedModel(currentModel, model)
+		currentModel.Labels = copyMap(currentModel.Labels)
+	} else {
+		if strings.TrimSpace(model.Details) != "" {
+			currentModel.Details = model.Details
+		}
+		if model.Labels != nil {
+			currentModel.Labels = model.Labels
+		}
+		if model.Card != nil {
+			currentModel.Card = model.Card
+		}
+		if strings.TrimSpace(model.CanonicalHyperparameters) != "" {
+			currentModel.CanonicalHyperparameters = model.CanonicalHyperparameters
+		}
 	}
+	currentModel.Version++
 
 	s.models[currentModel.ModelId] = currentModel
+	s.addModelRevision(currentModel)
 
 	return s.models[model.ModelId], nil
 }
 
-func (s *memory) ListHyperparameters(ctx context.Context, modelId, marker string, maxItems int) ([]string, error) {
+func (s *memory) ListHyperparameters(ctx context.Context, modelId, marker string, maxItems int) (storage.ListResult, error) {
 	if _, err := s.GetModel(ctx, modelId); err != nil {
-		return nil, err
+		return storage.ListResult{}, err
 	}
 
 	s.lock.RLock()
@@ -139,7 +232,7 @@ func (s *memory) ListHyperparameters(ctx context.Context, modelId, marker string
 
 	// check if marker index is past the end of the list
 	if firstIndex == len(s.hyperparametersList) {
-		return make([]string, 0), nil
+		return storage.ListResult{Ids: make([]string, 0)}, nil
 	}
 
 	if qualifiedMarker == s.hyperparametersList[firstIndex] {
@@ -148,10 +241,11 @@ func (s *memory) ListHyperparameters(ctx context.Context, modelId, marker string
 
 	// check if the updated marker index is past the end of the list
 	if firstIndex == len(s.hyperparametersList) {
-		return make([]string, 0), nil
+		return storage.ListResult{Ids: make([]string, 0)}, nil
 	}
 
-	lastIndex := firstIndex + maxItems
+	// list one more set of hyperparameters than asked for to find out whether there is another page
+	lastIndex := firstIndex + maxItems + 1
 	if lastIndex > len(s.hyperparametersList) {
 		lastIndex = len(s.hyperparametersList)
 	}
@@ -161,11 +255,11 @@ func (s *memory) ListHyperparameters(ctx context.Context, modelId, marker string
 
 	for i := 0; i < len(unsafeSlice); i++ {
 		if strings.HasPrefix(unsafeSlice[i], modelId+":") {
-			safeSlice = append(safeSlice, unsafeSlice[i])
+			safeSlice = append(safeSlice, strings.TrimPrefix(unsafeSlice[i], modelId+":"))
 		}
 	}
 
-	return safeSlice, nil
+	return storage.NewListResult(safeSlice, maxItems), nil
 }
 
 func (s *memory) GetHyperparameters(ctx context.Context, modelId string, hyperparametersId string) (storage.Hyperparameters, error) {
@@ -180,6 +274,27 @@ func (s *memory) GetHyperparameters(ctx context.Context, modelId string, hyperpa
 	return storage.Hyperparameters{}, storage.HyperparametersDoesNotExistError
 }
 
+func (s *memory) BatchGetHyperparameters(ctx context.Context, modelId string, hyperparametersIds []string) ([]storage.Hyperparameters, error) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	if _, ok := s.models[modelId]; !ok {
+		return nil, storage.ModelDoesNotExistError
+	}
+
+	res := make([]storage.Hyperparameters, len(hyperparametersIds))
+	for i, hyperparametersId := range hyperparametersIds {
+		key := fmt.Sprintf("%s:%s", modelId, hyperparametersId)
+		hyperparameters, ok := s.hyperparameters[key]
+		if !ok {
+			return nil, storage.HyperparametersDoesNotExistError
+		}
+		res[i] = hyperparameters
+	}
+
+	return res, nil
+}
+
 func (s *memory) AddHyperparameters(ctx context.Context, hyperparameters storage.Hyperparameters) error {
 	if _, err := s.GetModel(ctx, hyperparameters.ModelId); err != nil {
 		return err
@@ -194,18 +309,35 @@ func (s *memory) AddHyperparameters(ctx context.Context, hyperparameters storage
 
 	key := fmt.Sprintf("%s:%s", hyperparameters.ModelId, hyperparameters.HyperparametersId)
 
+	hyperparameters.Version = 1
 	s.hyperparametersList = insert(s.hyperparametersList, key)
 	s.hyperparameters[key] = hyperparameters
+	s.addHyperparametersRevision(key, hyperparameters)
 
 	return nil
 }
 
 func (s *memory) UpdateHyperparameters(ctx context.Context, hyperparameters storage.Hyperparameters) (storage.Hyperparameters, error) {
+	return s.updateHyperparameters(ctx, hyperparameters, false)
+}
+
+func (s *memory) ReplaceHyperparameters(ctx context.Context, hyperparameters storage.Hyperparameters) (storage.Hyperparameters, error) {
+	return s.updateHyperparameters(ctx, hyperparameters, true)
+}
+
+// updateHyperparameters - merges hyperparameters into the stored hyperparameters as
+// UpdateHyperparameters does or, if replace is set, replaces them as ReplaceHyperparameters does.
+func (s *memory) updateHyperparameters(ctx context.Context, hyperparameters storage.Hyperparameters, replace bool) (storage.Hyperparameters, error) {
 	if _, err := s.GetModel(ctx, hyperparameters.ModelId); err != nil {
 		return storage.Hyperparameters{}, err
 	}
 
-	if _, err := s.GetHyperparameters(ctx, hyperparameters.ModelId, hyperparameters.HyperparametersId); err != nil {
+	storedHyperparameters, err := s.GetHyperparameters(ctx, hyperparameters.ModelId, hyperparameters.HyperparametersId)
+	if err != nil {
+		return storage.Hyperparameters{}, err
+	}
+
+	if err := storage.CheckHyperparametersReferences(ctx, s, storedHyperparameters, hyperparameters); err != nil {
 		return storage.Hyperparameters{}, err
 	}
 
@@ -215,35 +347,53 @@ func (s *memory) UpdateHyperparameters(ctx context.Context, hyperparameters stor
 	key := fmt.Sprintf("%s:%s", hyperparameters.ModelId, hyperparameters.HyperparametersId)
 
 	currentHyperparameters, _ := s.hyperparameters[key]
-
-	if strings.TrimSpace(hyperparameters.CanonicalCheckpoint) != "" {
-		currentHyperparameters.CanonicalCheckpoint = hyperparameters.CanonicalCheckpoint
+	if err := storage.CheckVersion(currentHyperparameters.Version, hyperparameters.Version); err != nil {
+		return storage.Hyperparameters{}, err
 	}
 
-	if strings.TrimSpace(hyperparameters.UpgradeTo) != "" {
-		currentHyperparameters.UpgradeTo = hyperparameters.UpgradeTo
-	}
+	if replace {
+		currentHyperparameters = storage.ReplacedHyperparameters(currentHyperparameters, hyperparameters)
+		currentHyperparameters.Hyperparameters = copyMap(currentHyperparameters.Hyperparameters)
+		currentHyperparameters.Labels = copyMap(currentHyperparameters.Labels)
+	} else {
+		if strings.TrimSpace(hyperparameters.CanonicalCheckpoint) != "" {
+			currentHyperparameters.CanonicalCheckpoint = hyperparameters.CanonicalCheckpoint
+		}
+
+		if strings.TrimSpace(hyperparameters.UpgradeTo) != "" {
+			currentHyperparameters.UpgradeTo = hyperparameters.UpgradeTo
+		}
+		if hyperparameters.Labels != nil {
+			currentHyperparameters.Labels = hyperparameters.Labels
+		}
+		if hyperparameters.Rollout != nil {
+			currentHyperparameters.Rollout = hyperparameters.Rollout
+		}
 
-	if hyperparameters.Hyperparameters != nil {
-		for k, v := range hyperparameters.Hyperparameters {
-			if strings.TrimSpace(v) != "" {
-				currentHyperparameters.Hyperparameters[k] = v
+		if hyperparameters.Hyperparameters != nil {
+			for k, v := range hyperparameters.Hyperparameters {
+				if strings.TrimSpace(v) != "" {
+					currentHyperparameters.Hyperparameters[k] = v
+				}
 			}
 		}
 	}
 
+	currentHyperparameters.Version++
+
 	s.hyperparameters[key] = currentHyperparameters
+	s.addHyperparametersRevision(key, currentHyperparameters)
 
 	return currentHyperparameters, nil
 }
 
-func (s *memory) ListCheckpoints(ctx context.Context, modelId, hyperparametersId, marker string, maxItems int) ([]string, error) {
+func (s *memory) ListCheckpoints(ctx context.Context, modelId, hyperparametersId, marker string, maxItems int, includeArchived bool) (storage.ListResult, error) {
 	if _, err := s.GetModel(ctx, modelId); err != nil {
-		return nil, err
+		return storage.ListResult{}, err
 	}
 
 	if _, err := s.GetHyperparameters(ctx, modelId, hyperparametersId); err != nil {
-		return nil, err
+		return storage.ListResult{}, err
 	}
 
 	s.lock.RLock()
@@ -255,32 +405,30 @@ func (s *memory) ListCheckpoints(ctx context.Context, modelId, hyperparametersId
 
 	// check if marker index is past the end of the list
 	if firstIndex == len(s.checkpointsList) {
-		return make([]string, 0), nil
+		return storage.ListResult{Ids: make([]string, 0)}, nil
 	}
 
 	if qualifiedMarker == s.checkpointsList[firstIndex] {
 		firstIndex = firstIndex + 1
 	}
 
-	// check if the updated marker index is past the end of the list
-	if firstIndex == len(s.checkpointsList) {
-		return make([]string, 0), nil
-	}
-	lastIndex := firstIndex + maxItems
-	if lastIndex > len(s.checkpointsList) {
-		lastIndex = len(s.checkpointsList)
-	}
+	safeSlice := make([]string, 0)
 
-	unsafeSlice := s.checkpointsList[firstIndex:lastIndex]
-	safeSlice := make([]string, 0, len(unsafeSlice))
+	prefix := modelId + ":" + hyperparametersId + ":"
 
-	for i := 0; i < len(unsafeSlice); i++ {
-		if strings.HasPrefix(unsafeSlice[i], modelId+":"+hyperparametersId+":") {
-			safeSlice = append(safeSlice, unsafeSlice[i])
+	// list one more checkpoint than asked for to find out whether there is another page
+	for i := firstIndex; i < len(s.checkpointsList) && len(safeSlice) <= maxItems; i++ {
+		key := s.checkpointsList[i]
+		if !strings.HasPrefix(key, prefix) {
+			break
 		}
+		if !storage.IsListedCheckpoint(s.checkpoints[key].State, includeArchived) {
+			continue
+		}
+		safeSlice = append(safeSlice, strings.TrimPrefix(key, prefix))
 	}
 
-	return safeSlice, nil
+	return storage.NewListResult(safeSlice, maxItems), nil
 }
 
 func (s *memory) GetCheckpoint(ctx context.Context, modelId, hyperparametersId, checkpointId string) (storage.Checkpoint, error) {
@@ -304,6 +452,30 @@ func (s *memory) GetCheckpoint(ctx context.Context, modelId, hyperparametersId,
 	return storage.Checkpoint{}, storage.CheckpointDoesNotExistError
 }
 
+func (s *memory) BatchGetCheckpoints(ctx context.Context, modelId, hyperparametersId string, checkpointIds []string) ([]storage.Checkpoint, error) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	if _, ok := s.models[modelId]; !ok {
+		return nil, storage.ModelDoesNotExistError
+	}
+	if _, ok := s.hyperparameters[fmt.Sprintf("%s:%s", modelId, hyperparametersId)]; !ok {
+		return nil, storage.HyperparametersDoesNotExistError
+	}
+
+	res := make([]storage.Checkpoint, len(checkpointIds))
+	for i, checkpointId := range checkpointIds {
+		key := fmt.Sprintf("%s:%s:%s", modelId, hyperparametersId, checkpointId)
+		checkpoint, ok := s.checkpoints[key]
+		if !ok {
+			return nil, storage.CheckpointDoesNotExistError
+		}
+		res[i] = checkpoint
+	}
+
+	return res, nil
+}
+
 func (s *memory) AddCheckpoint(ctx context.Context, checkpoint storage.Checkpoint) error {
 	if _, err := s.GetModel(ctx, checkpoint.ModelId); err != nil {
 		return err
@@ -328,6 +500,185 @@ func (s *memory) AddCheckpoint(ctx context.Context, checkpoint storage.Checkpoin
 	return nil
 }
 
+func (s *memory) UpdateCheckpointState(ctx context.Context, modelId, hyperparametersId, checkpointId string, state api.CheckpointState) (storage.Checkpoint, error) {
+	if _, err := s.GetCheckpoint(ctx, modelId, hyperparametersId, checkpointId); err != nil {
+		return storage.Checkpoint{}, err
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	key := fmt.Sprintf("%s:%s:%s", modelId, hyperparametersId, checkpointId)
+
+	checkpoint := s.checkpoints[key]
+	checkpoint.State = state
+	s.checkpoints[key] = checkpoint
+
+	return checkpoint, nil
+}
+
+func (s *memory) UpdateCheckpointInfo(ctx context.Context, modelId, hyperparametersId, checkpointId string, info map[string]string) (storage.Checkpoint, error) {
+	if _, err := s.GetCheckpoint(ctx, modelId, hyperparametersId, checkpointId); err != nil {
+		return storage.Checkpoint{}, err
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	key := fmt.Sprintf("%s:%s:%s", modelId, hyperparametersId, checkpointId)
+
+	checkpoint := s.checkpoints[key]
+	checkpoint.Info = info
+	s.checkpoints[key] = checkpoint
+
+	return checkpoint, nil
+}
+
+func (s *memory) DeleteModel(ctx context.Context, modelId string, options storage.DeleteOptions) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if _, ok := s.models[modelId]; !ok {
+		return storage.ModelDoesNotExistError
+	}
+
+	prefix := modelId + ":"
+	if !options.Cascade && hasPrefix(s.hyperparametersList, prefix) {
+		return storage.ErrResourceHasChildren
+	}
+
+	s.checkpointsList = s.removePrefixed(s.checkpointsList, prefix, s.deleteCheckpoint)
+	s.hyperparametersList = s.removePrefixed(s.hyperparametersList, prefix, s.deleteHyperparameters)
+	s.modelList = remove(s.modelList, modelId)
+	delete(s.models, modelId)
+	delete(s.modelRevisions, modelId)
+	delete(s.tags, modelId)
+
+	return storage.RemoveLocalBundles(s.uploadDir, storage.ModelBundlesPrefix(modelId))
+}
+
+func (s *memory) DeleteHyperparameters(ctx context.Context, modelId, hyperparametersId string, options storage.DeleteOptions) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	model, ok := s.models[modelId]
+	if !ok {
+		return storage.ModelDoesNotExistError
+	}
+
+	key := fmt.Sprintf("%s:%s", modelId, hyperparametersId)
+	if _, ok := s.hyperparameters[key]; !ok {
+		return storage.HyperparametersDoesNotExistError
+	}
+
+	prefix := key + ":"
+	if !options.Cascade && hasPrefix(s.checkpointsList, prefix) {
+		return storage.ErrResourceHasChildren
+	}
+
+	if !options.Force {
+		var siblings []storage.Hyperparameters
+		for _, siblingKey := range s.hyperparametersList {
+			if strings.HasPrefix(siblingKey, modelId+":") {
+				siblings = append(siblings, s.hyperparameters[siblingKey])
+			}
+		}
+		if storage.IsHyperparametersReferenced(model, siblings, hyperparametersId) ||
+			storage.IsTagged(s.listTags(modelId), hyperparametersId) {
+			return storage.ErrResourceIsReferenced
+		}
+	}
+
+	s.checkpointsList = s.removePrefixed(s.checkpointsList, prefix, s.deleteCheckpoint)
+	s.hyperparametersList = remove(s.hyperparametersList, key)
+	s.deleteHyperparameters(key)
+
+	return storage.RemoveLocalBundles(s.uploadDir, storage.HyperparametersBundlesPrefix(modelId, hyperparametersId))
+}
+
+func (s *memory) DeleteCheckpoint(ctx context.Context, modelId, hyperparametersId, checkpointId string, options storage.DeleteOptions) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if _, ok := s.models[modelId]; !ok {
+		return storage.ModelDoesNotExistError
+	}
+
+	hyperparametersKey := fmt.Sprintf("%s:%s", modelId, hyperparametersId)
+	hyperparameters, ok := s.hyperparameters[hyperparametersKey]
+	if !ok {
+		return storage.HyperparametersDoesNotExistError
+	}
+
+	key := fmt.Sprintf("%s:%s:%s", modelId, hyperparametersId, checkpointId)
+	if _, ok := s.checkpoints[key]; !ok {
+		return storage.CheckpointDoesNotExistError
+	}
+
+	if !options.Force && (hyperparameters.CanonicalCheckpoint == checkpointId ||
+		storage.IsInRollout(hyperparameters.Rollout, checkpointId) ||
+		storage.IsTagged(s.listTags(hyperparametersKey), checkpointId)) {
+		return storage.ErrResourceIsReferenced
+	}
+
+	s.checkpointsList = remove(s.checkpointsList, key)
+	delete(s.checkpoints, key)
+
+	return storage.RemoveLocalBundles(s.uploadDir, storage.CheckpointBundlePath(modelId, hyperparametersId, checkpointId))
+}
+
+func (s *memory) CheckpointUploadURL(ctx context.Context, modelId, hyperparametersId, checkpointId string, expires time.Time) (string, string, error) {
+	return storage.LocalCheckpointUploadURL(s.uploadDir, modelId, hyperparametersId, checkpointId, expires)
+}
+
+func (s *memory) CheckpointBundleExists(ctx context.Context, modelId, hyperparametersId, checkpointId string) (bool, error) {
+	return storage.LocalCheckpointBundleExists(s.uploadDir, modelId, hyperparametersId, checkpointId)
+}
+
+// SignCheckpointLink - uploaded bundles are local files, so there is nothing to sign.
+func (s *memory) SignCheckpointLink(ctx context.Context, link string, expires time.Time) (string, error) {
+	return link, nil
+}
+
+func (s *memory) OpenCheckpointLink(ctx context.Context, link string) (io.ReadCloser, error) {
+	return storage.OpenLocalLink(s.uploadDir, link)
+}
+
+func (s *memory) deleteHyperparameters(key string) {
+	delete(s.hyperparameters, key)
+	delete(s.hyperparametersRevisions, key)
+	delete(s.tags, key)
+}
+
+func (s *memory) deleteCheckpoint(key string) {
+	delete(s.checkpoints, key)
+}
+
+// removePrefixed removes every entry of the sorted list which starts with prefix, calling
+// deleteEntry for each of them.
+func (s *memory) removePrefixed(list []string, prefix string, deleteEntry func(string)) []string {
+	first := sort.SearchStrings(list, prefix)
+	last := first
+	for last < len(list) && strings.HasPrefix(list[last], prefix) {
+		deleteEntry(list[last])
+		last++
+	}
+	return append(list[:first], list[last:]...)
+}
+
+func hasPrefix(list []string, prefix string) bool {
+	index := sort.SearchStrings(list, prefix)
+	return index < len(list) && strings.HasPrefix(list[index], prefix)
+}
+
+func remove(list []string, id string) []string {
+	index := sort.SearchStrings(list, id)
+	if index < len(list) && list[index] == id {
+		list = append(list[:index], list[index+1:]...)
+	}
+	return list
+}
+
 func insert(list []string, id string) []string {
 	list = append(list, id)
 	sort.Strings(list)
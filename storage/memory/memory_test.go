@@ -3,6 +3,8 @@ package memory_test
 import (
 	"github.com/doc-ai/tensorio-models/internal/tests"
 	"github.com/doc-ai/tensorio-models/storage/memory"
+	"io/ioutil"
+	"os"
 	"testing"
 )
 
@@ -30,6 +32,22 @@ func TestMemory_UpdateHyperparameters(t *testing.T) {
 	tests.Test_UpdateHyperparams(t, memory.NewMemoryRepositoryStorage())
 }
 
+func TestMemory_UpdateVersions(t *testing.T) {
+	tests.Test_UpdateVersions(t, memory.NewMemoryRepositoryStorage())
+}
+
+func TestMemory_ConcurrentUpdates(t *testing.T) {
+	tests.Test_ConcurrentUpdates(t, memory.NewMemoryRepositoryStorage())
+}
+
+func TestMemory_Revisions(t *testing.T) {
+	tests.Test_Revisions(t, memory.NewMemoryRepositoryStorage())
+}
+
+func TestMemory_Replace(t *testing.T) {
+	tests.Test_Replace(t, memory.NewMemoryRepositoryStorage())
+}
+
 func TestMemory_AddCheckpoint(t *testing.T) {
 	tests.Test_AddCheckpoint(t, memory.NewMemoryRepositoryStorage())
 }
@@ -37,3 +55,62 @@ func TestMemory_AddCheckpoint(t *testing.T) {
 func TestMemory_ListCheckpoints(t *testing.T) {
 	tests.Test_ListCheckpoints(t, memory.NewMemoryRepositoryStorage())
 }
+
+func TestMemory_UpdateCheckpointState(t *testing.T) {
+	tests.Test_UpdateCheckpointState(t, memory.NewMemoryRepositoryStorage())
+}
+
+func TestMemory_DeleteModel(t *testing.T) {
+	tests.Test_DeleteModel(t, memory.NewMemoryRepositoryStorage())
+}
+
+func TestMemory_DeleteHyperparameters(t *testing.T) {
+	tests.Test_DeleteHyperparameters(t, memory.NewMemoryRepositoryStorage())
+}
+
+func TestMemory_DeleteCheckpoint(t *testing.T) {
+	tests.Test_DeleteCheckpoint(t, memory.NewMemoryRepositoryStorage())
+}
+
+func TestMemory_Tags(t *testing.T) {
+	tests.Test_Tags(t, memory.NewMemoryRepositoryStorage())
+}
+
+func TestMemory_Rollouts(t *testing.T) {
+	tests.Test_Rollouts(t, memory.NewMemoryRepositoryStorage())
+}
+
+func TestMemory_FindDanglingReferences(t *testing.T) {
+	tests.Test_FindDanglingReferences(t, memory.NewMemoryRepositoryStorage())
+}
+
+func TestMemory_BatchGet(t *testing.T) {
+	tests.Test_BatchGet(t, memory.NewMemoryRepositoryStorage())
+}
+
+func TestMemory_Labels(t *testing.T) {
+	tests.Test_Labels(t, memory.NewMemoryRepositoryStorage())
+}
+
+func TestMemory_AuditEvents(t *testing.T) {
+	tests.Test_AuditEvents(t, memory.NewMemoryRepositoryStorage())
+	tests.Test_AuditEvents(t, memory.NewMemoryFleaStorage("http://repository"))
+}
+
+func TestMemory_Webhooks(t *testing.T) {
+	tests.Test_Webhooks(t, memory.NewMemoryRepositoryStorage())
+	tests.Test_Webhooks(t, memory.NewMemoryFleaStorage("http://repository"))
+}
+
+func TestMemory_FleaTasks(t *testing.T) {
+	tests.Test_FleaTasks(t, memory.NewMemoryFleaStorage("http://repository"))
+}
+
+func TestMemory_CheckpointUpload(t *testing.T) {
+	uploadDir, err := ioutil.TempDir("", "tensorio-models-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(uploadDir)
+	tests.Test_CheckpointUpload(t, memory.NewMemoryRepositoryStorageWithUploadDir(uploadDir), tests.UploadToFileURL)
+}
@@ -0,0 +1,109 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/doc-ai/tensorio-models/storage"
+)
+
+func (s *memory) ListModelRevisions(ctx context.Context, modelId string, afterVersion int64, maxItems int) ([]storage.ModelRevision, error) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	if _, ok := s.models[modelId]; !ok {
+		return nil, storage.ModelDoesNotExistError
+	}
+
+	revisions := s.modelRevisions[modelId]
+	firstIndex := sort.Search(len(revisions), func(i int) bool {
+		return revisions[i].Model.Version > afterVersion
+	})
+	lastIndex := firstIndex + maxItems
+	if lastIndex > len(revisions) {
+		lastIndex = len(revisions)
+	}
+	res := make([]storage.ModelRevision, lastIndex-firstIndex)
+	copy(res, revisions[firstIndex:lastIndex])
+	return res, nil
+}
+
+func (s *memory) GetModelRevision(ctx context.Context, modelId string, version int64) (storage.ModelRevision, error) {
+	revisions, err := s.ListModelRevisions(ctx, modelId, version-1, 1)
+	if err != nil {
+		return storage.ModelRevision{}, err
+	}
+	if len(revisions) == 0 || revisions[0].Model.Version != version {
+		return storage.ModelRevision{}, storage.RevisionDoesNotExistError
+	}
+	return revisions[0], nil
+}
+
+func (s *memory) ListHyperparametersRevisions(ctx context.Context, modelId, hyperparametersId string, afterVersion int64, maxItems int) ([]storage.HyperparametersRevision, error) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	if _, ok := s.models[modelId]; !ok {
+		return nil, storage.ModelDoesNotExistError
+	}
+	key := fmt.Sprintf("%s:%s", modelId, hyperparametersId)
+	if _, ok := s.hyperparameters[key]; !ok {
+		return nil, storage.HyperparametersDoesNotExistError
+	}
+
+	revisions := s.hyperparametersRevisions[key]
+	firstIndex := sort.Search(len(revisions), func(i int) bool {
+		return revisions[i].Hyperparameters.Version > afterVersion
+	})
+	lastIndex := firstIndex + maxItems
+	if lastIndex > len(revisions) {
+		lastIndex = len(revisions)
+	}
+	res := make([]storage.HyperparametersRevision, lastIndex-firstIndex)
+	copy(res, revisions[firstIndex:lastIndex])
+	return res, nil
+}
+
+func (s *memory) GetHyperparametersRevision(ctx context.Context, modelId, hyperparametersId string, version int64) (storage.HyperparametersRevision, error) {
+	revisions, err := s.ListHyperparametersRevisions(ctx, modelId, hyperparametersId, version-1, 1)
+	if err != nil {
+		return storage.HyperparametersRevision{}, err
+	}
+	if len(revisions) == 0 || revisions[0].Hyperparameters.Version != version {
+		return storage.HyperparametersRevision{}, storage.RevisionDoesNotExistError
+	}
+	return revisions[0], nil
+}
+
+// addModelRevision - records the given model as stored. The caller must hold the write lock.
+// Revisions get their own copies of the model's maps, since updates modify the stored maps.
+func (s *memory) addModelRevision(model storage.Model) {
+	model.Labels = copyMap(model.Labels)
+	s.modelRevisions[model.ModelId] = append(s.modelRevisions[model.ModelId], storage.ModelRevision{
+		Model:     model,
+		CreatedAt: time.Now(),
+	})
+}
+
+// addHyperparametersRevision - as addModelRevision, for the hyperparameters stored under key.
+func (s *memory) addHyperparametersRevision(key string, hyperparameters storage.Hyperparameters) {
+	hyperparameters.Hyperparameters = copyMap(hyperparameters.Hyperparameters)
+	hyperparameters.Labels = copyMap(hyperparameters.Labels)
+	s.hyperparametersRevisions[key] = append(s.hyperparametersRevisions[key], storage.HyperparametersRevision{
+		Hyperparameters: hyperparameters,
+		CreatedAt:       time.Now(),
+	})
+}
+
+func copyMap(m map[string]string) map[string]string {
+	if m == nil {
+		return nil
+	}
+	res := make(map[string]string, len(m))
+	for k, v := range m {
+		res[k] = v
+	}
+	return res
+}
@@ -0,0 +1,99 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/doc-ai/tensorio-models/storage"
+)
+
+func (s *memory) SetTag(ctx context.Context, tag storage.Tag) (storage.Tag, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	key, err := s.tagsKey(tag.ModelId, tag.HyperparametersId)
+	if err != nil {
+		return storage.Tag{}, err
+	}
+
+	tag.UpdatedAt = time.Now()
+	if s.tags[key] == nil {
+		s.tags[key] = make(map[string]storage.Tag)
+	}
+	s.tags[key][tag.Tag] = tag
+	return tag, nil
+}
+
+func (s *memory) GetTag(ctx context.Context, modelId, hyperparametersId, tag string) (storage.Tag, error) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	key, err := s.tagsKey(modelId, hyperparametersId)
+	if err != nil {
+		return storage.Tag{}, err
+	}
+
+	stored, ok := s.tags[key][tag]
+	if !ok {
+		return storage.Tag{}, storage.TagDoesNotExistError
+	}
+	return stored, nil
+}
+
+func (s *memory) ListTags(ctx context.Context, modelId, hyperparametersId string) ([]storage.Tag, error) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	key, err := s.tagsKey(modelId, hyperparametersId)
+	if err != nil {
+		return nil, err
+	}
+	return s.listTags(key), nil
+}
+
+func (s *memory) DeleteTag(ctx context.Context, modelId, hyperparametersId, tag string) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	key, err := s.tagsKey(modelId, hyperparametersId)
+	if err != nil {
+		return err
+	}
+
+	if _, ok := s.tags[key][tag]; !ok {
+		return storage.TagDoesNotExistError
+	}
+	delete(s.tags[key], tag)
+	return nil
+}
+
+// tagsKey - returns the key under which the tags on the given model (if hyperparametersId is "")
+// or hyperparameters are stored, checking that they exist. The caller must hold the lock.
+func (s *memory) tagsKey(modelId, hyperparametersId string) (string, error) {
+	if _, ok := s.models[modelId]; !ok {
+		return "", storage.ModelDoesNotExistError
+	}
+	if hyperparametersId == "" {
+		return modelId, nil
+	}
+
+	key := fmt.Sprintf("%s:%s", modelId, hyperparametersId)
+	if _, ok := s.hyperparameters[key]; !ok {
+		return "", storage.HyperparametersDoesNotExistError
+	}
+	return key, nil
+}
+
+// listTags - returns the tags stored under key, ordered by name. The caller must hold the lock.
+func (s *memory) listTags(key string) []storage.Tag {
+	res := make([]storage.Tag, 0, len(s.tags[key]))
+	for _, tag := range s.tags[key] {
+		res = append(res, tag)
+	}
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].Tag < res[j].Tag
+	})
+	return res
+}
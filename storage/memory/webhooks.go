@@ -0,0 +1,84 @@
+package memory
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"github.com/doc-ai/tensorio-models/storage"
+)
+
+// webhookStore - the in-memory implementation of storage.WebhookStorage, shared by the repository
+// and FLEA storage.
+type webhookStore struct {
+	webhooksLock *sync.RWMutex
+	webhooks     map[string]storage.Webhook
+	deadLetters  []storage.WebhookDeadLetter
+}
+
+func newWebhookStore() webhookStore {
+	return webhookStore{
+		webhooksLock: &sync.RWMutex{},
+		webhooks:     make(map[string]storage.Webhook),
+		deadLetters:  make([]storage.WebhookDeadLetter, 0),
+	}
+}
+
+func (s *webhookStore) AddWebhook(ctx context.Context, webhook storage.Webhook) error {
+	s.webhooksLock.Lock()
+	defer s.webhooksLock.Unlock()
+	webhook.EventTypes = append([]string(nil), webhook.EventTypes...)
+	s.webhooks[webhook.WebhookId] = webhook
+	return nil
+}
+
+func (s *webhookStore) ListWebhooks(ctx context.Context) ([]storage.Webhook, error) {
+	s.webhooksLock.RLock()
+	defer s.webhooksLock.RUnlock()
+	res := make([]storage.Webhook, 0, len(s.webhooks))
+	for _, webhook := range s.webhooks {
+		webhook.EventTypes = append([]string(nil), webhook.EventTypes...)
+		res = append(res, webhook)
+	}
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].WebhookId < res[j].WebhookId
+	})
+	return res, nil
+}
+
+func (s *webhookStore) DeleteWebhook(ctx context.Context, webhookId string) error {
+	s.webhooksLock.Lock()
+	defer s.webhooksLock.Unlock()
+	if _, ok := s.webhooks[webhookId]; !ok {
+		return storage.WebhookDoesNotExistError
+	}
+	delete(s.webhooks, webhookId)
+	return nil
+}
+
+func (s *webhookStore) AddWebhookDeadLetter(ctx context.Context, deadLetter storage.WebhookDeadLetter) error {
+	s.webhooksLock.Lock()
+	defer s.webhooksLock.Unlock()
+	index := sort.Search(len(s.deadLetters), func(i int) bool {
+		return s.deadLetters[i].DeliveryId > deadLetter.DeliveryId
+	})
+	s.deadLetters = append(s.deadLetters, storage.WebhookDeadLetter{})
+	copy(s.deadLetters[index+1:], s.deadLetters[index:])
+	s.deadLetters[index] = deadLetter
+	return nil
+}
+
+func (s *webhookStore) ListWebhookDeadLetters(ctx context.Context, marker string, maxItems int) ([]storage.WebhookDeadLetter, error) {
+	s.webhooksLock.RLock()
+	defer s.webhooksLock.RUnlock()
+	firstIndex := sort.Search(len(s.deadLetters), func(i int) bool {
+		return s.deadLetters[i].DeliveryId > marker
+	})
+	lastIndex := firstIndex + maxItems
+	if lastIndex > len(s.deadLetters) {
+		lastIndex = len(s.deadLetters)
+	}
+	res := make([]storage.WebhookDeadLetter, lastIndex-firstIndex)
+	copy(res, s.deadLetters[firstIndex:lastIndex])
+	return res, nil
+}
@@ -0,0 +1,138 @@
+package storage
+
+import (
+	"context"
+	"errors"
+)
+
+var ErrNoCanonicalHyperparameters = errors.New("Model has no CanonicalHyperparameters")
+var ErrNoCanonicalCheckpoint = errors.New("Hyperparameters have no CanonicalCheckpoint")
+var ErrUpgradeCycle = errors.New("UpgradeTo links form a cycle")
+
+// Resolution - the result of ResolveModel.
+type Resolution struct {
+	Model           Model
+	Hyperparameters Hyperparameters
+	Checkpoint      Checkpoint
+	// UpgradePath - the IDs of the hyperparameters that were visited, starting with the canonical
+	// hyperparameters of the model and ending with Hyperparameters.HyperparametersId.
+	UpgradePath []string
+}
+
+// ResolveModel - follows the CanonicalHyperparameters of a model, then the UpgradeTo links from
+// those hyperparameters, and finally the CanonicalCheckpoint of the last hyperparameters in the
+// chain. A broken chain is reported as ErrNoCanonicalHyperparameters, ErrNoCanonicalCheckpoint,
+// ErrUpgradeCycle or one of the Err*DoesNotExist reference errors.
+func ResolveModel(ctx context.Context, store RepositoryStorage, modelId string) (Resolution, error) {
+	model, err := store.GetModel(ctx, modelId)
+	if err != nil {
+		return Resolution{}, err
+	}
+	if model.CanonicalHyperparameters == "" {
+		return Resolution{}, ErrNoCanonicalHyperparameters
+	}
+
+	chain, err := followUpgrades(ctx, store, modelId, model.CanonicalHyperparameters, ErrCanonicalHyperparametersDoesNotExist)
+	if err != nil {
+		return Resolution{}, err
+	}
+	hyperparameters := chain[len(chain)-1]
+	upgradePath := make([]string, len(chain))
+	for i, link := range chain {
+		upgradePath[i] = link.HyperparametersId
+	}
+
+	if hyperparameters.CanonicalCheckpoint == "" {
+		return Resolution{}, ErrNoCanonicalCheckpoint
+	}
+	checkpoint, err := store.GetCheckpoint(ctx, modelId, hyperparameters.HyperparametersId, hyperparameters.CanonicalCheckpoint)
+	if err == CheckpointDoesNotExistError {
+		return Resolution{}, ErrCanonicalCheckpointDoesNotExist
+	}
+	if err != nil {
+		return Resolution{}, err
+	}
+
+	return Resolution{
+		Model:           model,
+		Hyperparameters: hyperparameters,
+		Checkpoint:      checkpoint,
+		UpgradePath:     upgradePath,
+	}, nil
+}
+
+// Upgrade - the result of CheckUpgrade.
+type Upgrade struct {
+	// Chain - the hyperparameters that were visited, starting with the current hyperparameters of
+	// the client and following their UpgradeTo links.
+	Chain []Hyperparameters
+	// Target - the checkpoint which the client should be using.
+	Target Checkpoint
+	// UpgradeAvailable - whether Target is different from the current checkpoint of the client.
+	UpgradeAvailable bool
+}
+
+// CheckUpgrade - works out which checkpoint a client currently using the given checkpoint should
+// upgrade to, by following the UpgradeTo links from its hyperparameters and taking the
+// CanonicalCheckpoint of the last hyperparameters in the chain. Clients whose hyperparameters have
+// neither an UpgradeTo nor a CanonicalCheckpoint are told to stay where they are.
+func CheckUpgrade(ctx context.Context, store RepositoryStorage, modelId, hyperparametersId, checkpointId string) (Upgrade, error) {
+	chain, err := followUpgrades(ctx, store, modelId, hyperparametersId, HyperparametersDoesNotExistError)
+	if err != nil {
+		return Upgrade{}, err
+	}
+
+	last := chain[len(chain)-1]
+	targetCheckpointId := last.CanonicalCheckpoint
+	notFoundErr := ErrCanonicalCheckpointDoesNotExist
+	if targetCheckpointId == "" {
+		if len(chain) > 1 {
+			return Upgrade{}, ErrNoCanonicalCheckpoint
+		}
+		targetCheckpointId = checkpointId
+		notFoundErr = CheckpointDoesNotExistError
+	}
+
+	target, err := store.GetCheckpoint(ctx, modelId, last.HyperparametersId, targetCheckpointId)
+	if err == CheckpointDoesNotExistError {
+		return Upgrade{}, notFoundErr
+	}
+	if err != nil {
+		return Upgrade{}, err
+	}
+
+	return Upgrade{
+		Chain:            chain,
+		Target:           target,
+		UpgradeAvailable: last.HyperparametersId != hyperparametersId || targetCheckpointId != checkpointId,
+	}, nil
+}
+
+// followUpgrades - returns the hyperparameters with the given ID followed by those reached through
+// their UpgradeTo links, or ErrUpgradeCycle if the links loop back on themselves. notFoundErr is
+// returned if the first hyperparameters do not exist.
+func followUpgrades(ctx context.Context, store RepositoryStorage, modelId, hyperparametersId string, notFoundErr error) ([]Hyperparameters, error) {
+	var chain []Hyperparameters
+	visited := make(map[string]bool)
+	for {
+		if visited[hyperparametersId] {
+			return nil, ErrUpgradeCycle
+		}
+		visited[hyperparametersId] = true
+
+		hyperparameters, err := store.GetHyperparameters(ctx, modelId, hyperparametersId)
+		if err == HyperparametersDoesNotExistError {
+			return nil, notFoundErr
+		}
+		if err != nil {
+			return nil, err
+		}
+		chain = append(chain, hyperparameters)
+
+		if hyperparameters.UpgradeTo == "" {
+			return chain, nil
+		}
+		hyperparametersId = hyperparameters.UpgradeTo
+		notFoundErr = ErrUpgradeToDoesNotExist
+	}
+}
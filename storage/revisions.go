@@ -0,0 +1,46 @@
+package storage
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+var RevisionDoesNotExistError = errors.New("Revision does not exist")
+
+// ModelRevision - a model as it was stored at one of its versions. Backends store a revision every
+// time a model is added or updated, and never change it afterwards. Revisions are deleted along
+// with their model.
+type ModelRevision struct {
+	Model     Model
+	CreatedAt time.Time
+}
+
+// HyperparametersRevision - hyperparameters as they were stored at one of their versions. As with
+// ModelRevision, revisions are immutable and deleted along with their hyperparameters.
+type HyperparametersRevision struct {
+	Hyperparameters Hyperparameters
+	CreatedAt       time.Time
+}
+
+// RevisionKey - the key under which backends store the revision at the given version. Keys are
+// zero padded so that they sort by version.
+func RevisionKey(version int64) string {
+	return fmt.Sprintf("%020d", version)
+}
+
+// ReplacedModel - the model which ReplaceModel stores in place of stored: model, with the ID and
+// version of stored. Restoring a revision this way makes it the current state of the model again.
+func ReplacedModel(stored, model Model) Model {
+	model.ModelId = stored.ModelId
+	model.Version = stored.Version
+	return model
+}
+
+// ReplacedHyperparameters - as ReplacedModel, for ReplaceHyperparameters.
+func ReplacedHyperparameters(stored, hyperparameters Hyperparameters) Hyperparameters {
+	hyperparameters.ModelId = stored.ModelId
+	hyperparameters.HyperparametersId = stored.HyperparametersId
+	hyperparameters.Version = stored.Version
+	return hyperparameters
+}
@@ -0,0 +1,116 @@
+package storage
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+)
+
+var ErrRolloutTargetDoesNotExist = errors.New("Rollout refers to a checkpoint which does not exist")
+
+// RolloutBuckets - the number of buckets which clients are hashed into. RolloutTarget weights are
+// percentages, so each bucket holds 1% of the clients.
+const RolloutBuckets = 100
+
+// RolloutTarget - a checkpoint which is being rolled out to Weight percent of the clients of some
+// hyperparameters.
+type RolloutTarget struct {
+	CheckpointId string
+	Weight       int32
+}
+
+// RolloutBucket - the bucket (from 0 to RolloutBuckets-1) which a client falls into for the given
+// hyperparameters. The same client always falls into the same bucket, but into unrelated buckets
+// for different hyperparameters, so that the same clients are not always the first to get new
+// checkpoints.
+func RolloutBucket(modelId, hyperparametersId, clientId string) int {
+	sum := sha256.Sum256([]byte(modelId + "/" + hyperparametersId + "/" + clientId))
+	return int(binary.BigEndian.Uint64(sum[:8]) % RolloutBuckets)
+}
+
+// PickRolloutCheckpoint - the checkpoint which clients in the given bucket should be using. Buckets
+// are handed out to the targets of the rollout in order, and the clients in the remaining buckets
+// stay on the CanonicalCheckpoint. Ramping up the weight of the first target therefore only ever
+// moves clients onto it. inRollout reports whether the checkpoint was picked from the rollout.
+func PickRolloutCheckpoint(hyperparameters Hyperparameters, bucket int) (checkpointId string, inRollout bool) {
+	upper := 0
+	for _, target := range hyperparameters.Rollout {
+		upper += int(target.Weight)
+		if bucket < upper {
+			return target.CheckpointId, true
+		}
+	}
+	return hyperparameters.CanonicalCheckpoint, false
+}
+
+// IsInRollout - reports whether checkpointId is one of the targets of the given rollout. Deleting
+// a checkpoint which is being rolled out returns ErrResourceIsReferenced unless it is forced.
+func IsInRollout(rollout []RolloutTarget, checkpointId string) bool {
+	for _, target := range rollout {
+		if target.CheckpointId == checkpointId {
+			return true
+		}
+	}
+	return false
+}
+
+// ClientResolution - the result of ResolveModelForClient.
+type ClientResolution struct {
+	Resolution
+	// Bucket - the bucket which the client falls into, see RolloutBucket.
+	Bucket int
+	// InRollout - whether Checkpoint was picked from the Rollout of the hyperparameters rather than
+	// being their CanonicalCheckpoint.
+	InRollout bool
+}
+
+// ResolveModelForClient - resolves a model as ResolveModel does, except that the checkpoint is
+// picked for the given client from the Rollout of the hyperparameters at the end of the chain. As
+// with ResolveModel, a missing checkpoint is reported as one of the reference errors.
+func ResolveModelForClient(ctx context.Context, store RepositoryStorage, modelId, clientId string) (ClientResolution, error) {
+	model, err := store.GetModel(ctx, modelId)
+	if err != nil {
+		return ClientResolution{}, err
+	}
+	if model.CanonicalHyperparameters == "" {
+		return ClientResolution{}, ErrNoCanonicalHyperparameters
+	}
+
+	chain, err := followUpgrades(ctx, store, modelId, model.CanonicalHyperparameters, ErrCanonicalHyperparametersDoesNotExist)
+	if err != nil {
+		return ClientResolution{}, err
+	}
+	hyperparameters := chain[len(chain)-1]
+	upgradePath := make([]string, len(chain))
+	for i, link := range chain {
+		upgradePath[i] = link.HyperparametersId
+	}
+
+	bucket := RolloutBucket(modelId, hyperparameters.HyperparametersId, clientId)
+	checkpointId, inRollout := PickRolloutCheckpoint(hyperparameters, bucket)
+	if checkpointId == "" {
+		return ClientResolution{}, ErrNoCanonicalCheckpoint
+	}
+	checkpoint, err := store.GetCheckpoint(ctx, modelId, hyperparameters.HyperparametersId, checkpointId)
+	if err == CheckpointDoesNotExistError {
+		if inRollout {
+			return ClientResolution{}, ErrRolloutTargetDoesNotExist
+		}
+		return ClientResolution{}, ErrCanonicalCheckpointDoesNotExist
+	}
+	if err != nil {
+		return ClientResolution{}, err
+	}
+
+	return ClientResolution{
+		Resolution: Resolution{
+			Model:           model,
+			Hyperparameters: hyperparameters,
+			Checkpoint:      checkpoint,
+			UpgradePath:     upgradePath,
+		},
+		Bucket:    bucket,
+		InRollout: inRollout,
+	}, nil
+}
@@ -0,0 +1,112 @@
+package s3
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3iface"
+	"github.com/doc-ai/tensorio-models/storage"
+)
+
+// auditLog - stores each audit event in its own object under audit/<partition>/ (see
+// storage.AuditPartition), named after the event ID. Shared by the repository and FLEA storage.
+type auditLog struct {
+	auditClient     s3iface.S3API
+	auditBucketName string
+}
+
+func (store auditLog) AddAuditEvent(ctx context.Context, event storage.AuditEvent) error {
+	bytes, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+	// Event IDs are unique, so PUTs never replace earlier events
+	return writeObject(ctx, store.auditClient, store.auditBucketName, objAuditEventPath(event.EventId), bytes)
+}
+
+func (store auditLog) ListAuditEvents(ctx context.Context, marker string, maxItems int) ([]storage.AuditEvent, error) {
+	partitions, err := store.listPartitions(ctx, marker)
+	if err != nil {
+		return nil, err
+	}
+
+	var eventIds []string
+	for _, partition := range partitions {
+		// S3-compatible stores do not all handle markers which are not keys themselves (such as the
+		// start of a time range) the way S3 does, so as in the GCS backend the partition is listed
+		// and filtered here.
+		input := &s3.ListObjectsInput{
+			Bucket: aws.String(store.auditBucketName),
+			Prefix: aws.String(objAuditPartitionPrefix(partition)),
+		}
+		err := store.auditClient.ListObjectsPagesWithContext(ctx, input, func(page *s3.ListObjectsOutput, lastPage bool) bool {
+			for _, object := range page.Contents {
+				if len(eventIds) == maxItems {
+					return false
+				}
+
+				key := aws.StringValue(object.Key)
+				eventId := strings.TrimSuffix(strings.TrimPrefix(key, objAuditPartitionPrefix(partition)), ".json")
+				if eventId <= marker {
+					continue
+				}
+
+				eventIds = append(eventIds, eventId)
+			}
+			return len(eventIds) < maxItems
+		})
+		if err != nil {
+			return nil, err
+		}
+		if len(eventIds) == maxItems {
+			break
+		}
+	}
+
+	res := make([]storage.AuditEvent, len(eventIds))
+	for i, eventId := range eventIds {
+		bytes, err := readObject(ctx, store.auditClient, store.auditBucketName, objAuditEventPath(eventId))
+		if err != nil {
+			return nil, err
+		}
+		err = json.Unmarshal(bytes, &res[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+// listPartitions - the partitions of the audit log which may hold events after marker, in order.
+// See storage.RecentAuditPartitions.
+func (store auditLog) listPartitions(ctx context.Context, marker string) ([]string, error) {
+	if partitions, ok := storage.RecentAuditPartitions(marker, time.Now()); ok {
+		return partitions, nil
+	}
+
+	markerPartition := storage.AuditPartition(marker)
+	res := make([]string, 0)
+	input := &s3.ListObjectsInput{
+		Bucket:    aws.String(store.auditBucketName),
+		Prefix:    aws.String(objAuditPrefix()),
+		Delimiter: aws.String("/"),
+	}
+	err := store.auditClient.ListObjectsPagesWithContext(ctx, input, func(page *s3.ListObjectsOutput, lastPage bool) bool {
+		for _, commonPrefix := range page.CommonPrefixes {
+			partition := strings.TrimSuffix(strings.TrimPrefix(aws.StringValue(commonPrefix.Prefix), objAuditPrefix()), "/")
+			if partition < markerPartition {
+				continue
+			}
+			res = append(res, partition)
+		}
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
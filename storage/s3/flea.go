@@ -0,0 +1,205 @@
+package s3
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"math"
+	"os"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3iface"
+	"github.com/doc-ai/tensorio-models/api"
+	"github.com/doc-ai/tensorio-models/common"
+	"github.com/doc-ai/tensorio-models/storage"
+	"github.com/google/uuid"
+)
+
+// S3 refuses presigned URLs which are valid for longer than a week.
+const maxPresignExpiry = 7 * 24 * time.Hour
+
+var errTaskDeadlinePassed = errors.New("Task deadline has passed")
+
+type flea struct {
+	auditLog
+	webhookStore
+	client             s3iface.S3API
+	bucketName         string
+	repositoryBaseURL  string
+	uploadToBucketName string
+}
+
+// GenerateNewFleaS3StorageFromEnv - Uses the FLEA_S3_BUCKET and FLEA_UPLOAD_S3_BUCKET environment
+// variables (along with the variables described in NewS3ClientFromEnv) to instantiate an S3
+// Storage backend. Job uploads are authorized using presigned PUT URLs for the UPLOAD bucket, so
+// the credentials must allow writing to it.
+func GenerateNewFleaS3StorageFromEnv(repositoryBaseURL string) storage.FleaStorage {
+	bucketName := os.Getenv("FLEA_S3_BUCKET")
+	if bucketName == "" {
+		err := errors.New("FLEA_S3_BUCKET environment variable not defined")
+		panic(err)
+	}
+
+	uploadBucketName := os.Getenv("FLEA_UPLOAD_S3_BUCKET")
+	if uploadBucketName == "" {
+		err := errors.New("FLEA_UPLOAD_S3_BUCKET environment variable not set")
+		panic(err)
+	}
+
+	return NewFleaS3Storage(NewS3ClientFromEnv(), bucketName, uploadBucketName, repositoryBaseURL)
+}
+
+// NewFleaS3Storage - returns an S3-backed implementation of FleaStorage interface.
+func NewFleaS3Storage(client s3iface.S3API, bucketName, uploadBucketName, repositoryBaseURL string) storage.FleaStorage {
+	return &flea{
+		auditLog:           auditLog{auditClient: client, auditBucketName: bucketName},
+		webhookStore:       webhookStore{webhooksClient: client, webhooksBucketName: bucketName},
+		client:             client,
+		bucketName:         bucketName,
+		repositoryBaseURL:  repositoryBaseURL,
+		uploadToBucketName: uploadBucketName,
+	}
+}
+
+func (store flea) GetStorageType() string {
+	return StorageType
+}
+
+// GetBucketName - see s3Storage.GetBucketName.
+func (store flea) GetBucketName() string {
+	return ""
+}
+
+// GetUploadToURL - returns a presigned PUT URL for the job upload which expires at the task
+// deadline (or after maxPresignExpiry if that comes first).
+func (store flea) GetUploadToURL(taskId, jobId string, deadline_epoch_sec int64) (string, error) {
+	expires := maxPresignExpiry
+	if deadline_epoch_sec > 0 {
+		expires = time.Until(time.Unix(deadline_epoch_sec, 0))
+		if expires <= 0 {
+			return "", errTaskDeadlinePassed
+		}
+		if expires > maxPresignExpiry {
+			expires = maxPresignExpiry
+		}
+	}
+
+	req, _ := store.client.PutObjectRequest(&s3.PutObjectInput{
+		Bucket:      aws.String(store.uploadToBucketName),
+		Key:         aws.String(objJobUploadPath(taskId, jobId)),
+		ContentType: aws.String("application/zip"),
+	})
+	return req.Presign(expires)
+}
+
+func (store flea) AddTask(ctx context.Context, req api.TaskDetails) error {
+	objLoc := objTaskPath(req.TaskId)
+
+	exists, err := objectExists(ctx, store.client, store.bucketName, objLoc)
+	if err != nil {
+		return err
+	}
+	if exists {
+		return storage.ErrDuplicateTaskId
+	}
+
+	bytes, err := json.Marshal(req)
+	if err != nil {
+		return err
+	}
+	return writeObject(ctx, store.client, store.bucketName, objLoc, bytes)
+}
+
+func (store flea) GetTask(ctx context.Context, taskId string) (api.TaskDetails, error) {
+	task := api.TaskDetails{}
+	bytes, err := readObject(ctx, store.client, store.bucketName, objTaskPath(taskId))
+	if err != nil {
+		if err == errObjectNotExist {
+			return task, storage.ErrMissingTaskId
+		}
+		return task, err
+	}
+
+	err = json.Unmarshal(bytes, &task)
+	task.CheckpointLink = store.repositoryBaseURL + common.GetCheckpointResourcePath(
+		task.ModelId, task.HyperparametersId, task.CheckpointId)
+	return task, err
+}
+
+func (store flea) BatchGetTasks(ctx context.Context, taskIds []string) ([]api.TaskDetails, error) {
+	tasks := make([]api.TaskDetails, len(taskIds))
+	err := storage.BatchGet(len(taskIds), batchGetConcurrency, func(i int) error {
+		var err error
+		tasks[i], err = store.GetTask(ctx, taskIds[i])
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return tasks, nil
+}
+
+func (store flea) ModifyTask(ctx context.Context, req api.ModifyTaskRequest) error {
+	task, err := store.GetTask(ctx, req.TaskId)
+	if err != nil {
+		return err
+	}
+	task.Deadline = req.Deadline
+	task.Active = req.Active
+
+	bytes, err := json.Marshal(task)
+	if err != nil {
+		return err
+	}
+	return writeObject(ctx, store.client, store.bucketName, objTaskPath(req.TaskId), bytes)
+}
+
+func (store flea) StartTask(ctx context.Context, taskId string) (api.StartTaskResponse, error) {
+	resp := api.StartTaskResponse{}
+	task, err := store.GetTask(ctx, taskId)
+	if err != nil {
+		return resp, err
+	}
+	jobId := uuid.New().String()
+	signedURL, err := store.GetUploadToURL(taskId, jobId, task.Deadline.GetSeconds())
+	if err != nil {
+		return resp, err
+	}
+	resp.JobId = jobId
+	resp.UploadTo = signedURL
+	resp.Status = api.StartTaskResponse_APPROVED
+	return resp, nil
+}
+
+func (store flea) ListTasks(ctx context.Context, req api.ListTasksRequest) (api.ListTasksResponse, error) {
+	candidates, err := listObjects(ctx, store.client, store.bucketName, objTasksPrefix(), "", math.MaxInt32)
+	if err != nil {
+		return api.ListTasksResponse{}, err
+	}
+
+	return storage.ListTaskCandidates(req, candidates, func(taskId string) (api.TaskDetails, error) {
+		return store.GetTask(ctx, taskId)
+	})
+}
+
+func (store flea) AddJobError(ctx context.Context, req api.JobErrorRequest) error {
+	// Sanity check that task exists.
+	_, err := store.GetTask(ctx, req.TaskId)
+	if err != nil {
+		return err
+	}
+
+	if !common.IsValidID(req.JobId) {
+		return storage.ErrInvalidJobId
+	}
+
+	// We only store the last error.
+	bytes, err := json.Marshal(req)
+	if err != nil {
+		return err
+	}
+	return writeObject(ctx, store.client, store.bucketName, objJobErrorPath(req.TaskId, req.JobId), bytes)
+}
@@ -0,0 +1,117 @@
+package s3
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/doc-ai/tensorio-models/storage"
+)
+
+func objModelsPrefix() string {
+	return "models/"
+}
+
+func objModelPath(modelId string) string {
+	objLoc := fmt.Sprintf("models/%s/model.json", modelId)
+	return objLoc
+}
+
+func objHyperparametersPrefix(modelId string) string {
+	objLoc := fmt.Sprintf("models/%s/hyperparameters/", modelId)
+	return objLoc
+}
+
+func objHyperparametersPath(modelId string, hyperparametersId string) string {
+	objLoc := fmt.Sprintf("models/%s/hyperparameters/%s/params.json", modelId, hyperparametersId)
+	return objLoc
+}
+
+func objModelRevisionsPrefix(modelId string) string {
+	return fmt.Sprintf("models/%s/revisions/", modelId)
+}
+
+func objModelRevisionPath(modelId string, version int64) string {
+	return objModelRevisionsPrefix(modelId) + storage.RevisionKey(version) + ".json"
+}
+
+func objHyperparametersRevisionsPrefix(modelId string, hyperparametersId string) string {
+	return fmt.Sprintf("models/%s/hyperparameters/%s/revisions/", modelId, hyperparametersId)
+}
+
+func objHyperparametersRevisionPath(modelId string, hyperparametersId string, version int64) string {
+	return objHyperparametersRevisionsPrefix(modelId, hyperparametersId) + storage.RevisionKey(version) + ".json"
+}
+
+func objCheckpointsPrefix(modelId string, hyperparametersId string) string {
+	objLoc := fmt.Sprintf("models/%s/hyperparameters/%s/checkpoints/", modelId, hyperparametersId)
+	return objLoc
+}
+
+func objCheckpointPath(modelId string, hyperparametersId string, checkpointId string) string {
+	objLoc := fmt.Sprintf("models/%s/hyperparameters/%s/checkpoints/%s/checkpoint.json", modelId, hyperparametersId, checkpointId)
+	return objLoc
+}
+
+func objTasksPrefix() string {
+	return "tasks/"
+}
+
+func objTaskPath(taskId string) string {
+	return "tasks/" + taskId + "/task.json"
+}
+
+func objJobErrorPath(taskId string, jobId string) string {
+	return "tasks/" + taskId + "/errors/" + jobId + ".json"
+}
+
+func objAuditPrefix() string {
+	return "audit/"
+}
+
+// objAuditPartitionPrefix - see storage.AuditPartition.
+func objAuditPartitionPrefix(partition string) string {
+	return objAuditPrefix() + partition + "/"
+}
+
+func objAuditEventPath(eventId string) string {
+	return objAuditPartitionPrefix(storage.AuditPartition(eventId)) + eventId + ".json"
+}
+
+func objWebhooksPrefix() string {
+	return "webhooks/"
+}
+
+func objWebhookPath(webhookId string) string {
+	return objWebhooksPrefix() + webhookId + ".json"
+}
+
+func objWebhookDeadLettersPrefix() string {
+	return "webhook-dead-letters/"
+}
+
+func objWebhookDeadLetterPath(deliveryId string) string {
+	return objWebhookDeadLettersPrefix() + deliveryId + ".json"
+}
+
+func objJobUploadPath(taskId string, jobId string) string {
+	return fmt.Sprintf("tasksJobs/%s/%s.zip", taskId, jobId)
+}
+
+func extractObjectName(name string) string {
+	splitNames := strings.Split(name, "/")
+	name = splitNames[len(splitNames)-2]
+	return name
+}
+
+// objTagsPrefix - the prefix of the tags on a model, or on hyperparameters if hyperparametersId is
+// set.
+func objTagsPrefix(modelId string, hyperparametersId string) string {
+	if hyperparametersId == "" {
+		return fmt.Sprintf("models/%s/tags/", modelId)
+	}
+	return fmt.Sprintf("models/%s/hyperparameters/%s/tags/", modelId, hyperparametersId)
+}
+
+func objTagPath(modelId string, hyperparametersId string, tag string) string {
+	return objTagsPrefix(modelId, hyperparametersId) + tag + ".json"
+}
@@ -0,0 +1,42 @@
+package s3
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_objModelPath(t *testing.T) {
+	modelId := "model1"
+	modelPath := "models/model1/model.json"
+
+	assert.Equal(t, modelPath, objModelPath(modelId))
+}
+
+func Test_objHyperparametersPath(t *testing.T) {
+	modelId := "model1"
+	paramId := "param2"
+	modelPath := "models/model1/hyperparameters/param2/params.json"
+
+	assert.Equal(t, modelPath, objHyperparametersPath(modelId, paramId))
+}
+
+func Test_objCheckpointPath(t *testing.T) {
+	modelId := "model1"
+	paramId := "param2"
+	checkpointId := "checkpoint3"
+	modelPath := "models/model1/hyperparameters/param2/checkpoints/checkpoint3/checkpoint.json"
+
+	assert.Equal(t, modelPath, objCheckpointPath(modelId, paramId, checkpointId))
+}
+
+func Test_extractObjectName(t *testing.T) {
+	path := "models/model1/hyperparameters/param2/"
+	name := extractObjectName(path)
+	assert.Equal(t, name, "param2")
+}
+
+func Test_objRevisionPaths(t *testing.T) {
+	assert.Equal(t, "models/model1/revisions/00000000000000000012.json", objModelRevisionPath("model1", 12))
+	assert.Equal(t, "models/model1/hyperparameters/param2/revisions/00000000000000000003.json", objHyperparametersRevisionPath("model1", "param2", 3))
+}
@@ -0,0 +1,144 @@
+package s3
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/doc-ai/tensorio-models/storage"
+)
+
+func (store s3Storage) ListModelRevisions(ctx context.Context, modelId string, afterVersion int64, maxItems int) ([]storage.ModelRevision, error) {
+	_, err := store.GetModel(ctx, modelId)
+	if err != nil {
+		return nil, err
+	}
+
+	keys, err := store.listRevisions(ctx, objModelRevisionsPrefix(modelId), afterVersion, maxItems)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]storage.ModelRevision, len(keys))
+	for i, key := range keys {
+		err = store.readRevision(ctx, key, &res[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+func (store s3Storage) GetModelRevision(ctx context.Context, modelId string, version int64) (storage.ModelRevision, error) {
+	_, err := store.GetModel(ctx, modelId)
+	if err != nil {
+		return storage.ModelRevision{}, err
+	}
+
+	revision := storage.ModelRevision{}
+	err = store.readRevision(ctx, objModelRevisionPath(modelId, version), &revision)
+	if err != nil {
+		return storage.ModelRevision{}, err
+	}
+	return revision, nil
+}
+
+func (store s3Storage) ListHyperparametersRevisions(ctx context.Context, modelId, hyperparametersId string, afterVersion int64, maxItems int) ([]storage.HyperparametersRevision, error) {
+	_, err := store.GetHyperparameters(ctx, modelId, hyperparametersId)
+	if err != nil {
+		return nil, err
+	}
+
+	keys, err := store.listRevisions(ctx, objHyperparametersRevisionsPrefix(modelId, hyperparametersId), afterVersion, maxItems)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]storage.HyperparametersRevision, len(keys))
+	for i, key := range keys {
+		err = store.readRevision(ctx, key, &res[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+func (store s3Storage) GetHyperparametersRevision(ctx context.Context, modelId, hyperparametersId string, version int64) (storage.HyperparametersRevision, error) {
+	_, err := store.GetHyperparameters(ctx, modelId, hyperparametersId)
+	if err != nil {
+		return storage.HyperparametersRevision{}, err
+	}
+
+	revision := storage.HyperparametersRevision{}
+	err = store.readRevision(ctx, objHyperparametersRevisionPath(modelId, hyperparametersId, version), &revision)
+	if err != nil {
+		return storage.HyperparametersRevision{}, err
+	}
+	return revision, nil
+}
+
+func (store s3Storage) addModelRevision(ctx context.Context, model storage.Model) error {
+	bytes, err := json.Marshal(storage.ModelRevision{Model: model, CreatedAt: time.Now()})
+	if err != nil {
+		return err
+	}
+	return writeObject(ctx, store.client, store.bucketName, objModelRevisionPath(model.ModelId, model.Version), bytes)
+}
+
+func (store s3Storage) addHyperparametersRevision(ctx context.Context, hyperparameters storage.Hyperparameters) error {
+	bytes, err := json.Marshal(storage.HyperparametersRevision{Hyperparameters: hyperparameters, CreatedAt: time.Now()})
+	if err != nil {
+		return err
+	}
+	objLoc := objHyperparametersRevisionPath(hyperparameters.ModelId, hyperparameters.HyperparametersId, hyperparameters.Version)
+	return writeObject(ctx, store.client, store.bucketName, objLoc, bytes)
+}
+
+// readRevision - unmarshals the revision at objLoc into v, returning RevisionDoesNotExistError if
+// there is none.
+func (store s3Storage) readRevision(ctx context.Context, objLoc string, v interface{}) error {
+	bytes, err := readObject(ctx, store.client, store.bucketName, objLoc)
+	if err != nil {
+		if err == errObjectNotExist {
+			return storage.RevisionDoesNotExistError
+		}
+		return err
+	}
+	return json.Unmarshal(bytes, v)
+}
+
+// listRevisions - returns the keys of up to maxItems revision objects under prefix whose versions
+// come after afterVersion, in order of version. As with the audit log, the prefix is listed in full
+// and filtered here.
+func (store s3Storage) listRevisions(ctx context.Context, prefix string, afterVersion int64, maxItems int) ([]string, error) {
+	res := make([]string, 0)
+	marker := storage.RevisionKey(afterVersion)
+	input := &s3.ListObjectsInput{
+		Bucket: aws.String(store.bucketName),
+		Prefix: aws.String(prefix),
+	}
+	err := store.client.ListObjectsPagesWithContext(ctx, input, func(page *s3.ListObjectsOutput, lastPage bool) bool {
+		for _, object := range page.Contents {
+			if len(res) == maxItems {
+				return false
+			}
+
+			key := aws.StringValue(object.Key)
+			if strings.TrimSuffix(strings.TrimPrefix(key, prefix), ".json") <= marker {
+				continue
+			}
+
+			res = append(res, key)
+		}
+		return len(res) < maxItems
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
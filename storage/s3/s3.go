@@ -0,0 +1,885 @@
+package s3
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3iface"
+	"github.com/doc-ai/tensorio-models/api"
+	"github.com/doc-ai/tensorio-models/storage"
+)
+
+const (
+	StorageType string = "S3"
+	// batchGetConcurrency - how many objects the BatchGet* methods read at a time.
+	batchGetConcurrency = 16
+)
+
+var errObjectNotExist = errors.New("Object does not exist")
+var errURLExpired = errors.New("URL would already have expired")
+
+type s3Storage struct {
+	auditLog
+	webhookStore
+	bucketName string
+	client     s3iface.S3API
+}
+
+// NewS3ClientFromEnv - Creates an S3 client using the standard AWS environment variables
+// (AWS_REGION, AWS_ACCESS_KEY_ID, AWS_SECRET_ACCESS_KEY, ...) and shared configuration files.
+// If AWS_S3_ENDPOINT is set, requests are sent to that endpoint using path-style addressing,
+// which is what S3-compatible stores like MinIO expect.
+func NewS3ClientFromEnv() s3iface.S3API {
+	config := aws.NewConfig()
+	endpoint := os.Getenv("AWS_S3_ENDPOINT")
+	if endpoint != "" {
+		config = config.WithEndpoint(endpoint).WithS3ForcePathStyle(true)
+	}
+
+	sess, err := session.NewSessionWithOptions(session.Options{
+		Config:            *config,
+		SharedConfigState: session.SharedConfigEnable,
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	return s3.New(sess)
+}
+
+// GenerateNewS3StorageFromEnv - Uses the REPOSITORY_S3_BUCKET environment variable (along with the
+// variables described in NewS3ClientFromEnv) to instantiate an S3 Storage backend for
+// tensorio-models repository
+func GenerateNewS3StorageFromEnv() storage.RepositoryStorage {
+	bucketName := os.Getenv("REPOSITORY_S3_BUCKET")
+	if bucketName == "" {
+		err := errors.New("REPOSITORY_S3_BUCKET environment variable not defined")
+		panic(err)
+	}
+
+	return NewS3Storage(NewS3ClientFromEnv(), bucketName)
+}
+
+// NewS3Storage - Creates an S3-backed instance of storage.RepositoryStorage interface
+func NewS3Storage(client s3iface.S3API, bucketName string) storage.RepositoryStorage {
+	return &s3Storage{
+		auditLog:     auditLog{auditClient: client, auditBucketName: bucketName},
+		webhookStore: webhookStore{webhooksClient: client, webhooksBucketName: bucketName},
+		client:       client,
+		bucketName:   bucketName,
+	}
+}
+
+func (store s3Storage) GetStorageType() string {
+	return StorageType
+}
+
+// GetBucketName - authentication tokens can only be loaded from GCS buckets, so the S3 backend
+// reports no bucket and tokens are read from the local filesystem instead.
+func (store s3Storage) GetBucketName() string {
+	return ""
+}
+
+func (store s3Storage) ListModels(ctx context.Context, marker string, maxItems int) (storage.ListResult, error) {
+	res, err := listObjects(ctx, store.client, store.bucketName, objModelsPrefix(), marker, maxItems+1)
+	if err != nil {
+		return storage.ListResult{}, err
+	}
+
+	return storage.NewListResult(res, maxItems), nil
+}
+
+func (store s3Storage) GetModel(ctx context.Context, modelId string) (storage.Model, error) {
+	bytes, err := readObject(ctx, store.client, store.bucketName, objModelPath(modelId))
+	if err != nil {
+		if err == errObjectNotExist {
+			return storage.Model{}, storage.ModelDoesNotExistError
+		}
+		return storage.Model{}, err
+	}
+
+	model := storage.Model{}
+
+	err = json.Unmarshal(bytes, &model)
+	if err != nil {
+		return storage.Model{}, err
+	}
+
+	return model, nil
+}
+
+func (store s3Storage) BatchGetModels(ctx context.Context, modelIds []string) ([]storage.Model, error) {
+	models := make([]storage.Model, len(modelIds))
+	err := storage.BatchGet(len(modelIds), batchGetConcurrency, func(i int) error {
+		var err error
+		models[i], err = store.GetModel(ctx, modelIds[i])
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return models, nil
+}
+
+func (store s3Storage) AddModel(ctx context.Context, model storage.Model) error {
+	objLoc := objModelPath(model.ModelId)
+
+	exists, err := objectExists(ctx, store.client, store.bucketName, objLoc)
+	if err != nil {
+		return err
+	}
+	if exists {
+		return storage.ModelExistsError
+	}
+
+	model.Version = 1
+	bytes, err := json.Marshal(model)
+	if err != nil {
+		return err
+	}
+
+	err = writeObject(ctx, store.client, store.bucketName, objLoc, bytes)
+	if err != nil {
+		return err
+	}
+
+	return store.addModelRevision(ctx, model)
+}
+
+func (store s3Storage) UpdateModel(ctx context.Context, model storage.Model) (storage.Model, error) {
+	return store.updateModel(ctx, model, false)
+}
+
+func (store s3Storage) ReplaceModel(ctx context.Context, model storage.Model) (storage.Model, error) {
+	return store.updateModel(ctx, model, true)
+}
+
+// updateModel - merges model into the stored model as UpdateModel does or, if replace is
+// set, replaces it as ReplaceModel does.
+func (store s3Storage) updateModel(ctx context.Context, model storage.Model, replace bool) (storage.Model, error) {
+	objLoc := objModelPath(model.ModelId)
+
+	bytes, etag, err := readObjectETag(ctx, store.client, store.bucketName, objLoc)
+	if err != nil {
+		if err == errObjectNotExist {
+			return storage.Model{}, storage.ModelDoesNotExistError
+		}
+		return storage.Model{}, err
+	}
+
+	storedModel := storage.Model{}
+	err = json.Unmarshal(bytes, &storedModel)
+	if err != nil {
+		return storage.Model{}, err
+	}
+
+	err = storage.CheckVersion(storedModel.Version, model.Version)
+	if err != nil {
+		return storage.Model{}, err
+	}
+
+	err = storage.CheckModelReferences(ctx, store, storedModel, model)
+	if err != nil {
+		return storage.Model{}, err
+	}
+
+	if replace {
+		storedModel = storage.ReplacedModel(storedModel, model)
+	} else {
+		if strings.TrimSpace(model.CanonicalHyperparameters) != "" {
+			storedModel.CanonicalHyperparameters = model.CanonicalHyperparameters
+		}
+		if strings.TrimSpace(model.Details) != "" {
+			storedModel.Details = model.Details
+		}
+		if model.Labels != nil {
+			storedModel.Labels = model.Labels
+		}
+		if model.Card != nil {
+			storedModel.Card = model.Card
+		}
+	}
+
+	storedModel.Version++
+	bytes, err = json.Marshal(storedModel)
+	if err != nil {
+		return storage.Model{}, err
+	}
+
+	err = writeObjectIfMatch(ctx, store.client, store.bucketName, objLoc, bytes, etag)
+	if err != nil {
+		if isPreconditionFailed(err) {
+			return storage.Model{}, storage.ErrVersionMismatch
+		}
+		return storage.Model{}, err
+	}
+
+	err = store.addModelRevision(ctx, storedModel)
+	if err != nil {
+		return storage.Model{}, err
+	}
+
+	return storedModel, nil
+}
+
+func (store s3Storage) ListHyperparameters(ctx context.Context, modelId, marker string, maxItems int) (storage.ListResult, error) {
+	_, err := store.GetModel(ctx, modelId)
+	if err != nil {
+		return storage.ListResult{}, err
+	}
+
+	res, err := listObjects(ctx, store.client, store.bucketName, objHyperparametersPrefix(modelId), marker, maxItems+1)
+	if err != nil {
+		return storage.ListResult{}, err
+	}
+
+	return storage.NewListResult(res, maxItems), nil
+}
+
+func (store s3Storage) GetHyperparameters(ctx context.Context, modelId string, hyperparametersId string) (storage.Hyperparameters, error) {
+	_, err := store.GetModel(ctx, modelId)
+	if err != nil {
+		return storage.Hyperparameters{}, err
+	}
+
+	bytes, err := readObject(ctx, store.client, store.bucketName, objHyperparametersPath(modelId, hyperparametersId))
+	if err != nil {
+		if err == errObjectNotExist {
+			return storage.Hyperparameters{}, storage.HyperparametersDoesNotExistError
+		}
+		return storage.Hyperparameters{}, err
+	}
+
+	hyperparameters := storage.Hyperparameters{}
+
+	err = json.Unmarshal(bytes, &hyperparameters)
+	if err != nil {
+		return storage.Hyperparameters{}, err
+	}
+
+	return hyperparameters, nil
+}
+
+func (store s3Storage) BatchGetHyperparameters(ctx context.Context, modelId string, hyperparametersIds []string) ([]storage.Hyperparameters, error) {
+	_, err := store.GetModel(ctx, modelId)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]storage.Hyperparameters, len(hyperparametersIds))
+	err = storage.BatchGet(len(hyperparametersIds), batchGetConcurrency, func(i int) error {
+		bytes, err := readObject(ctx, store.client, store.bucketName, objHyperparametersPath(modelId, hyperparametersIds[i]))
+		if err != nil {
+			if err == errObjectNotExist {
+				return storage.HyperparametersDoesNotExistError
+			}
+			return err
+		}
+		return json.Unmarshal(bytes, &res[i])
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (store s3Storage) AddHyperparameters(ctx context.Context, hyperparameters storage.Hyperparameters) error {
+	objLoc := objHyperparametersPath(hyperparameters.ModelId, hyperparameters.HyperparametersId)
+
+	_, err := store.GetModel(ctx, hyperparameters.ModelId)
+	if err != nil {
+		return err
+	}
+
+	exists, err := objectExists(ctx, store.client, store.bucketName, objLoc)
+	if err != nil {
+		return err
+	}
+	if exists {
+		return storage.HyperparametersExistsError
+	}
+
+	hyperparameters.Version = 1
+	bytes, err := json.Marshal(hyperparameters)
+	if err != nil {
+		return err
+	}
+
+	err = writeObject(ctx, store.client, store.bucketName, objLoc, bytes)
+	if err != nil {
+		return err
+	}
+
+	return store.addHyperparametersRevision(ctx, hyperparameters)
+}
+
+func (store s3Storage) UpdateHyperparameters(ctx context.Context, hyperparameters storage.Hyperparameters) (storage.Hyperparameters, error) {
+	return store.updateHyperparameters(ctx, hyperparameters, false)
+}
+
+func (store s3Storage) ReplaceHyperparameters(ctx context.Context, hyperparameters storage.Hyperparameters) (storage.Hyperparameters, error) {
+	return store.updateHyperparameters(ctx, hyperparameters, true)
+}
+
+// updateHyperparameters - merges hyperparameters into the stored hyperparameters as
+// UpdateHyperparameters does or, if replace is set, replaces them as ReplaceHyperparameters does.
+func (store s3Storage) updateHyperparameters(ctx context.Context, hyperparameters storage.Hyperparameters, replace bool) (storage.Hyperparameters, error) {
+	_, err := store.GetModel(ctx, hyperparameters.ModelId)
+	if err != nil {
+		return storage.Hyperparameters{}, err
+	}
+
+	objLoc := objHyperparametersPath(hyperparameters.ModelId, hyperparameters.HyperparametersId)
+
+	bytes, etag, err := readObjectETag(ctx, store.client, store.bucketName, objLoc)
+	if err != nil {
+		if err == errObjectNotExist {
+			return storage.Hyperparameters{}, storage.HyperparametersDoesNotExistError
+		}
+		return storage.Hyperparameters{}, err
+	}
+
+	storedHyperparameters := storage.Hyperparameters{}
+	err = json.Unmarshal(bytes, &storedHyperparameters)
+	if err != nil {
+		return storage.Hyperparameters{}, err
+	}
+
+	err = storage.CheckVersion(storedHyperparameters.Version, hyperparameters.Version)
+	if err != nil {
+		return storage.Hyperparameters{}, err
+	}
+
+	err = storage.CheckHyperparametersReferences(ctx, store, storedHyperparameters, hyperparameters)
+	if err != nil {
+		return storage.Hyperparameters{}, err
+	}
+
+	if replace {
+		storedHyperparameters = storage.ReplacedHyperparameters(storedHyperparameters, hyperparameters)
+	} else {
+		if strings.TrimSpace(hyperparameters.CanonicalCheckpoint) != "" {
+			storedHyperparameters.CanonicalCheckpoint = hyperparameters.CanonicalCheckpoint
+		}
+		if strings.TrimSpace(hyperparameters.UpgradeTo) != "" {
+			storedHyperparameters.UpgradeTo = hyperparameters.UpgradeTo
+		}
+		if hyperparameters.Labels != nil {
+			storedHyperparameters.Labels = hyperparameters.Labels
+		}
+		if hyperparameters.Rollout != nil {
+			storedHyperparameters.Rollout = hyperparameters.Rollout
+		}
+
+		if hyperparameters.Hyperparameters != nil {
+			if storedHyperparameters.Hyperparameters == nil {
+				storedHyperparameters.Hyperparameters = make(map[string]string)
+			}
+			for k, v := range hyperparameters.Hyperparameters {
+				storedHyperparameters.Hyperparameters[k] = v
+			}
+		}
+	}
+
+	storedHyperparameters.Version++
+	bytes, err = json.Marshal(storedHyperparameters)
+	if err != nil {
+		return storage.Hyperparameters{}, err
+	}
+
+	err = writeObjectIfMatch(ctx, store.client, store.bucketName, objLoc, bytes, etag)
+	if err != nil {
+		if isPreconditionFailed(err) {
+			return storage.Hyperparameters{}, storage.ErrVersionMismatch
+		}
+		return storage.Hyperparameters{}, err
+	}
+
+	err = store.addHyperparametersRevision(ctx, storedHyperparameters)
+	if err != nil {
+		return storage.Hyperparameters{}, err
+	}
+
+	return storedHyperparameters, nil
+}
+
+func (store s3Storage) ListCheckpoints(ctx context.Context, modelId, hyperparametersId, marker string, maxItems int, includeArchived bool) (storage.ListResult, error) {
+	_, err := store.GetHyperparameters(ctx, modelId, hyperparametersId)
+	if err != nil {
+		return storage.ListResult{}, err
+	}
+
+	prefix := objCheckpointsPrefix(modelId, hyperparametersId)
+	listPage := func(marker string, maxItems int) ([]string, error) {
+		return listObjects(ctx, store.client, store.bucketName, prefix, marker, maxItems)
+	}
+
+	res, err := storage.ListVisibleCheckpoints(marker, maxItems+1, includeArchived, listPage, func(checkpointId string) (storage.Checkpoint, error) {
+		return store.GetCheckpoint(ctx, modelId, hyperparametersId, checkpointId)
+	})
+	if err != nil {
+		return storage.ListResult{}, err
+	}
+
+	return storage.NewListResult(res, maxItems), nil
+}
+
+func (store s3Storage) GetCheckpoint(ctx context.Context, modelId, hyperparametersId, checkpointId string) (storage.Checkpoint, error) {
+	_, err := store.GetHyperparameters(ctx, modelId, hyperparametersId)
+	if err != nil {
+		return storage.Checkpoint{}, err
+	}
+
+	objLoc := objCheckpointPath(modelId, hyperparametersId, checkpointId)
+	bytes, err := readObject(ctx, store.client, store.bucketName, objLoc)
+	if err != nil {
+		if err == errObjectNotExist {
+			return storage.Checkpoint{}, storage.CheckpointDoesNotExistError
+		}
+		return storage.Checkpoint{}, err
+	}
+
+	checkpoint := storage.Checkpoint{}
+
+	err = json.Unmarshal(bytes, &checkpoint)
+	if err != nil {
+		return storage.Checkpoint{}, err
+	}
+
+	return checkpoint, nil
+}
+
+func (store s3Storage) BatchGetCheckpoints(ctx context.Context, modelId, hyperparametersId string, checkpointIds []string) ([]storage.Checkpoint, error) {
+	_, err := store.GetHyperparameters(ctx, modelId, hyperparametersId)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]storage.Checkpoint, len(checkpointIds))
+	err = storage.BatchGet(len(checkpointIds), batchGetConcurrency, func(i int) error {
+		bytes, err := readObject(ctx, store.client, store.bucketName, objCheckpointPath(modelId, hyperparametersId, checkpointIds[i]))
+		if err != nil {
+			if err == errObjectNotExist {
+				return storage.CheckpointDoesNotExistError
+			}
+			return err
+		}
+		return json.Unmarshal(bytes, &res[i])
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (store s3Storage) AddCheckpoint(ctx context.Context, checkpoint storage.Checkpoint) error {
+	objLoc := objCheckpointPath(checkpoint.ModelId, checkpoint.HyperparametersId, checkpoint.CheckpointId)
+
+	_, err := store.GetHyperparameters(ctx, checkpoint.ModelId, checkpoint.HyperparametersId)
+	if err != nil {
+		return err
+	}
+
+	exists, err := objectExists(ctx, store.client, store.bucketName, objLoc)
+	if err != nil {
+		return err
+	}
+	if exists {
+		return storage.CheckpointExistsError
+	}
+
+	bytes, err := json.Marshal(checkpoint)
+	if err != nil {
+		return err
+	}
+
+	return writeObject(ctx, store.client, store.bucketName, objLoc, bytes)
+}
+
+func (store s3Storage) UpdateCheckpointState(ctx context.Context, modelId, hyperparametersId, checkpointId string, state api.CheckpointState) (storage.Checkpoint, error) {
+	checkpoint, err := store.GetCheckpoint(ctx, modelId, hyperparametersId, checkpointId)
+	if err != nil {
+		return storage.Checkpoint{}, err
+	}
+	checkpoint.State = state
+
+	bytes, err := json.Marshal(checkpoint)
+	if err != nil {
+		return storage.Checkpoint{}, err
+	}
+
+	objLoc := objCheckpointPath(modelId, hyperparametersId, checkpointId)
+	err = writeObject(ctx, store.client, store.bucketName, objLoc, bytes)
+	if err != nil {
+		return storage.Checkpoint{}, err
+	}
+
+	return checkpoint, nil
+}
+
+func (store s3Storage) UpdateCheckpointInfo(ctx context.Context, modelId, hyperparametersId, checkpointId string, info map[string]string) (storage.Checkpoint, error) {
+	checkpoint, err := store.GetCheckpoint(ctx, modelId, hyperparametersId, checkpointId)
+	if err != nil {
+		return storage.Checkpoint{}, err
+	}
+	checkpoint.Info = info
+
+	bytes, err := json.Marshal(checkpoint)
+	if err != nil {
+		return storage.Checkpoint{}, err
+	}
+
+	objLoc := objCheckpointPath(modelId, hyperparametersId, checkpointId)
+	err = writeObject(ctx, store.client, store.bucketName, objLoc, bytes)
+	if err != nil {
+		return storage.Checkpoint{}, err
+	}
+
+	return checkpoint, nil
+}
+
+func (store s3Storage) DeleteModel(ctx context.Context, modelId string, options storage.DeleteOptions) error {
+	_, err := store.GetModel(ctx, modelId)
+	if err != nil {
+		return err
+	}
+
+	if !options.Cascade {
+		hasChildren, err := hasObjects(ctx, store.client, store.bucketName, objHyperparametersPrefix(modelId))
+		if err != nil {
+			return err
+		}
+		if hasChildren {
+			return storage.ErrResourceHasChildren
+		}
+	}
+
+	// Remove the model itself first so that it disappears before its children are cleaned up.
+	err = deleteObject(ctx, store.client, store.bucketName, objModelPath(modelId))
+	if err != nil {
+		return err
+	}
+	err = deleteObjects(ctx, store.client, store.bucketName, fmt.Sprintf("models/%s/", modelId))
+	if err != nil {
+		return err
+	}
+	return deleteObjects(ctx, store.client, store.bucketName, storage.ModelBundlesPrefix(modelId))
+}
+
+func (store s3Storage) DeleteHyperparameters(ctx context.Context, modelId, hyperparametersId string, options storage.DeleteOptions) error {
+	model, err := store.GetModel(ctx, modelId)
+	if err != nil {
+		return err
+	}
+
+	_, err = store.GetHyperparameters(ctx, modelId, hyperparametersId)
+	if err != nil {
+		return err
+	}
+
+	if !options.Cascade {
+		hasChildren, err := hasObjects(ctx, store.client, store.bucketName, objCheckpointsPrefix(modelId, hyperparametersId))
+		if err != nil {
+			return err
+		}
+		if hasChildren {
+			return storage.ErrResourceHasChildren
+		}
+	}
+
+	if !options.Force {
+		siblings, err := storage.GetAllHyperparameters(ctx, store, modelId)
+		if err != nil {
+			return err
+		}
+		if storage.IsHyperparametersReferenced(model, siblings, hyperparametersId) {
+			return storage.ErrResourceIsReferenced
+		}
+		tags, err := store.ListTags(ctx, modelId, "")
+		if err != nil {
+			return err
+		}
+		if storage.IsTagged(tags, hyperparametersId) {
+			return storage.ErrResourceIsReferenced
+		}
+	}
+
+	err = deleteObject(ctx, store.client, store.bucketName, objHyperparametersPath(modelId, hyperparametersId))
+	if err != nil {
+		return err
+	}
+	err = deleteObjects(ctx, store.client, store.bucketName, fmt.Sprintf("models/%s/hyperparameters/%s/", modelId, hyperparametersId))
+	if err != nil {
+		return err
+	}
+	return deleteObjects(ctx, store.client, store.bucketName, storage.HyperparametersBundlesPrefix(modelId, hyperparametersId))
+}
+
+func (store s3Storage) DeleteCheckpoint(ctx context.Context, modelId, hyperparametersId, checkpointId string, options storage.DeleteOptions) error {
+	hyperparameters, err := store.GetHyperparameters(ctx, modelId, hyperparametersId)
+	if err != nil {
+		return err
+	}
+
+	_, err = store.GetCheckpoint(ctx, modelId, hyperparametersId, checkpointId)
+	if err != nil {
+		return err
+	}
+
+	if !options.Force {
+		if hyperparameters.CanonicalCheckpoint == checkpointId || storage.IsInRollout(hyperparameters.Rollout, checkpointId) {
+			return storage.ErrResourceIsReferenced
+		}
+		tags, err := store.ListTags(ctx, modelId, hyperparametersId)
+		if err != nil {
+			return err
+		}
+		if storage.IsTagged(tags, checkpointId) {
+			return storage.ErrResourceIsReferenced
+		}
+	}
+
+	err = deleteObject(ctx, store.client, store.bucketName, objCheckpointPath(modelId, hyperparametersId, checkpointId))
+	if err != nil {
+		return err
+	}
+	// Deleting a bundle which was never uploaded is not an error on S3.
+	return deleteObject(ctx, store.client, store.bucketName, storage.CheckpointBundlePath(modelId, hyperparametersId, checkpointId))
+}
+
+// CheckpointUploadURL - returns a presigned PUT URL for the bundle, which is stored in the same bucket
+// as everything else. S3 caps the expiry of presigned URLs at maxPresignExpiry.
+func (store s3Storage) CheckpointUploadURL(ctx context.Context, modelId, hyperparametersId, checkpointId string, expires time.Time) (string, string, error) {
+	objLoc := storage.CheckpointBundlePath(modelId, hyperparametersId, checkpointId)
+	signer := presignedURLSigner{client: store.client, bucketName: store.bucketName}
+	url, err := signer.GetSignedURL("PUT", objLoc, expires, storage.BundleContentType)
+	if err != nil {
+		return "", "", err
+	}
+	return url, fmt.Sprintf("s3://%s/%s", store.bucketName, objLoc), nil
+}
+
+func (store s3Storage) CheckpointBundleExists(ctx context.Context, modelId, hyperparametersId, checkpointId string) (bool, error) {
+	objLoc := storage.CheckpointBundlePath(modelId, hyperparametersId, checkpointId)
+	return objectExists(ctx, store.client, store.bucketName, objLoc)
+}
+
+// SignCheckpointLink - presigns s3:// links to objects in the repository's bucket, which includes
+// every uploaded bundle. Other links are returned unchanged.
+func (store s3Storage) SignCheckpointLink(ctx context.Context, link string, expires time.Time) (string, error) {
+	prefix := fmt.Sprintf("s3://%s/", store.bucketName)
+	if !strings.HasPrefix(link, prefix) {
+		return link, nil
+	}
+	signer := presignedURLSigner{client: store.client, bucketName: store.bucketName}
+	return signer.GetSignedURL("GET", strings.TrimPrefix(link, prefix), expires, "")
+}
+
+// OpenCheckpointLink - opens s3:// links to objects in the repository's bucket.
+func (store s3Storage) OpenCheckpointLink(ctx context.Context, link string) (io.ReadCloser, error) {
+	prefix := fmt.Sprintf("s3://%s/", store.bucketName)
+	if !strings.HasPrefix(link, prefix) {
+		return nil, storage.ErrLinkNotReadable
+	}
+	output, err := store.client.GetObjectWithContext(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(store.bucketName),
+		Key:    aws.String(strings.TrimPrefix(link, prefix)),
+	})
+	if err != nil {
+		if isNotFound(err) {
+			return nil, storage.ErrLinkDoesNotExist
+		}
+		return nil, err
+	}
+	return output.Body, nil
+}
+
+func isNotFound(err error) bool {
+	if aerr, ok := err.(awserr.RequestFailure); ok {
+		return aerr.StatusCode() == http.StatusNotFound
+	}
+	if aerr, ok := err.(awserr.Error); ok {
+		return aerr.Code() == s3.ErrCodeNoSuchKey
+	}
+	return false
+}
+
+func readObject(ctx context.Context, client s3iface.S3API, bucketName, objLoc string) ([]byte, error) {
+	output, err := client.GetObjectWithContext(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(bucketName),
+		Key:    aws.String(objLoc),
+	})
+	if err != nil {
+		if isNotFound(err) {
+			return nil, errObjectNotExist
+		}
+		return nil, err
+	}
+	defer output.Body.Close()
+
+	// TODO this is dangerous, we should change this eventually to read a limited amount of data
+	return ioutil.ReadAll(output.Body)
+}
+
+// readObjectETag - like readObject, but also returns the ETag of the object which was read.
+func readObjectETag(ctx context.Context, client s3iface.S3API, bucketName, objLoc string) ([]byte, string, error) {
+	output, err := client.GetObjectWithContext(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(bucketName),
+		Key:    aws.String(objLoc),
+	})
+	if err != nil {
+		if isNotFound(err) {
+			return nil, "", errObjectNotExist
+		}
+		return nil, "", err
+	}
+	defer output.Body.Close()
+
+	// TODO this is dangerous, we should change this eventually to read a limited amount of data
+	bytes, err := ioutil.ReadAll(output.Body)
+	return bytes, aws.StringValue(output.ETag), err
+}
+
+func objectExists(ctx context.Context, client s3iface.S3API, bucketName, objLoc string) (bool, error) {
+	_, err := client.HeadObjectWithContext(ctx, &s3.HeadObjectInput{
+		Bucket: aws.String(bucketName),
+		Key:    aws.String(objLoc),
+	})
+	if err != nil {
+		if isNotFound(err) {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
+
+// writeObject creates or replaces the object at objLoc. S3 PUTs are atomic, so readers see either
+// the old or the new object and never a partial write.
+func writeObject(ctx context.Context, client s3iface.S3API, bucketName, objLoc string, data []byte) error {
+	_, err := client.PutObjectWithContext(ctx, &s3.PutObjectInput{
+		Bucket:      aws.String(bucketName),
+		Key:         aws.String(objLoc),
+		Body:        bytes.NewReader(data),
+		ContentType: aws.String("application/json"),
+	})
+	return err
+}
+
+// writeObjectIfMatch - like writeObject, but only replaces the object if its ETag still matches the
+// given one, so that a read-modify-write cycle fails instead of overwriting a concurrent update.
+// The PutObjectInput of this SDK version has no IfMatch field, so the header is set directly.
+// ETags which the server did not report are not checked.
+func writeObjectIfMatch(ctx context.Context, client s3iface.S3API, bucketName, objLoc string, data []byte, etag string) error {
+	_, err := client.PutObjectWithContext(ctx, &s3.PutObjectInput{
+		Bucket:      aws.String(bucketName),
+		Key:         aws.String(objLoc),
+		Body:        bytes.NewReader(data),
+		ContentType: aws.String("application/json"),
+	}, func(req *request.Request) {
+		if etag != "" {
+			req.HTTPRequest.Header.Set("If-Match", etag)
+		}
+	})
+	return err
+}
+
+// isPreconditionFailed - whether a request failed because one of its preconditions did not hold.
+func isPreconditionFailed(err error) bool {
+	aerr, ok := err.(awserr.RequestFailure)
+	return ok && aerr.StatusCode() == http.StatusPreconditionFailed
+}
+
+func deleteObject(ctx context.Context, client s3iface.S3API, bucketName, objLoc string) error {
+	_, err := client.DeleteObjectWithContext(ctx, &s3.DeleteObjectInput{
+		Bucket: aws.String(bucketName),
+		Key:    aws.String(objLoc),
+	})
+	return err
+}
+
+func hasObjects(ctx context.Context, client s3iface.S3API, bucketName, prefix string) (bool, error) {
+	output, err := client.ListObjectsWithContext(ctx, &s3.ListObjectsInput{
+		Bucket:  aws.String(bucketName),
+		Prefix:  aws.String(prefix),
+		MaxKeys: aws.Int64(1),
+	})
+	if err != nil {
+		return false, err
+	}
+	return len(output.Contents) > 0, nil
+}
+
+func deleteObjects(ctx context.Context, client s3iface.S3API, bucketName, prefix string) error {
+	var keys []string
+	input := &s3.ListObjectsInput{
+		Bucket: aws.String(bucketName),
+		Prefix: aws.String(prefix),
+	}
+	err := client.ListObjectsPagesWithContext(ctx, input, func(page *s3.ListObjectsOutput, lastPage bool) bool {
+		for _, object := range page.Contents {
+			keys = append(keys, aws.StringValue(object.Key))
+		}
+		return true
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, key := range keys {
+		err = deleteObject(ctx, client, bucketName, key)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// listObjects returns the names of the "directories" directly under prefix which come after
+// marker, in lexicographic order.
+func listObjects(ctx context.Context, client s3iface.S3API, bucketName, prefix, marker string, maxItems int) ([]string, error) {
+	res := make([]string, 0)
+	input := &s3.ListObjectsInput{
+		Bucket:    aws.String(bucketName),
+		Prefix:    aws.String(prefix),
+		Delimiter: aws.String("/"),
+	}
+	err := client.ListObjectsPagesWithContext(ctx, input, func(page *s3.ListObjectsOutput, lastPage bool) bool {
+		for _, commonPrefix := range page.CommonPrefixes {
+			if len(res) == maxItems {
+				return false
+			}
+
+			name := extractObjectName(aws.StringValue(commonPrefix.Prefix))
+
+			if name <= marker {
+				continue
+			}
+
+			res = append(res, name)
+		}
+		return len(res) < maxItems
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
@@ -0,0 +1,295 @@
+package s3_test
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	awss3 "github.com/aws/aws-sdk-go/service/s3"
+	"github.com/doc-ai/tensorio-models/api"
+	"github.com/doc-ai/tensorio-models/internal/tests"
+	"github.com/doc-ai/tensorio-models/storage"
+	"github.com/doc-ai/tensorio-models/storage/s3"
+	"github.com/johannesboyne/gofakes3"
+	"github.com/johannesboyne/gofakes3/backend/s3mem"
+	"github.com/stretchr/testify/assert"
+)
+
+// ifMatchHandler - gofakes3 ignores If-Match on PUT, so this checks it against the ETag of the
+// stored object before passing the request on, which lets the tests exercise conditional writes.
+type ifMatchHandler struct {
+	handler http.Handler
+	lock    sync.Mutex
+}
+
+func (h *ifMatchHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != "PUT" {
+		h.handler.ServeHTTP(w, r)
+		return
+	}
+
+	h.lock.Lock()
+	defer h.lock.Unlock()
+	etag := r.Header.Get("If-Match")
+	if etag != "" {
+		head := httptest.NewRecorder()
+		h.handler.ServeHTTP(head, httptest.NewRequest("HEAD", r.URL.String(), nil))
+		if head.Code != http.StatusOK || head.Header().Get("ETag") != etag {
+			w.WriteHeader(http.StatusPreconditionFailed)
+			w.Write([]byte("<Error><Code>PreconditionFailed</Code><Message>At least one of the pre-conditions you specified did not hold</Message></Error>"))
+			return
+		}
+	}
+	h.handler.ServeHTTP(w, r)
+}
+
+func newTestClient(t *testing.T, bucketNames ...string) (*awss3.S3, *httptest.Server) {
+	backend := s3mem.New()
+	for _, bucketName := range bucketNames {
+		err := backend.CreateBucket(bucketName)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	server := httptest.NewServer(&ifMatchHandler{handler: gofakes3.New(backend).Server()})
+
+	sess, err := session.NewSession(aws.NewConfig().
+		WithCredentials(credentials.NewStaticCredentials("id", "secret", "")).
+		WithEndpoint(server.URL).
+		WithRegion("us-east-1").
+		WithDisableSSL(true).
+		WithS3ForcePathStyle(true))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return awss3.New(sess), server
+}
+
+func newTestStorage(t *testing.T, bucketName string) (storage.RepositoryStorage, *httptest.Server) {
+	client, server := newTestClient(t, bucketName)
+	return s3.NewS3Storage(client, bucketName), server
+}
+
+func TestS3_AddModel(t *testing.T) {
+	store, server := newTestStorage(t, "add-model")
+	defer server.Close()
+	tests.Test_AddModel(t, store)
+}
+
+func TestS3_ListModels(t *testing.T) {
+	store, server := newTestStorage(t, "list-models")
+	defer server.Close()
+	tests.Test_ListModels(t, store)
+}
+
+func TestS3_UpdateModel(t *testing.T) {
+	store, server := newTestStorage(t, "update-model")
+	defer server.Close()
+	tests.Test_UpdateModels(t, store)
+}
+
+func TestS3_AddHyperparameters(t *testing.T) {
+	store, server := newTestStorage(t, "add-hyperparameters")
+	defer server.Close()
+	tests.Test_AddHyperparameters(t, store)
+}
+
+func TestS3_ListHyperparameters(t *testing.T) {
+	store, server := newTestStorage(t, "list-hyperparameters")
+	defer server.Close()
+	tests.Test_ListHyperparams(t, store)
+}
+
+func TestS3_UpdateHyperparameters(t *testing.T) {
+	store, server := newTestStorage(t, "update-hyperparameters")
+	defer server.Close()
+	tests.Test_UpdateHyperparams(t, store)
+}
+
+func TestS3_UpdateVersions(t *testing.T) {
+	store, server := newTestStorage(t, "update-versions")
+	defer server.Close()
+	tests.Test_UpdateVersions(t, store)
+}
+
+func TestS3_ConcurrentUpdates(t *testing.T) {
+	store, server := newTestStorage(t, "concurrent-updates")
+	defer server.Close()
+	tests.Test_ConcurrentUpdates(t, store)
+}
+
+func TestS3_Revisions(t *testing.T) {
+	store, server := newTestStorage(t, "revisions")
+	defer server.Close()
+	tests.Test_Revisions(t, store)
+}
+
+func TestS3_Replace(t *testing.T) {
+	store, server := newTestStorage(t, "revisions")
+	defer server.Close()
+	tests.Test_Replace(t, store)
+}
+
+func TestS3_AddCheckpoint(t *testing.T) {
+	store, server := newTestStorage(t, "add-checkpoint")
+	defer server.Close()
+	tests.Test_AddCheckpoint(t, store)
+}
+
+func TestS3_ListCheckpoints(t *testing.T) {
+	store, server := newTestStorage(t, "list-checkpoints")
+	defer server.Close()
+	tests.Test_ListCheckpoints(t, store)
+}
+
+func TestS3_UpdateCheckpointState(t *testing.T) {
+	store, server := newTestStorage(t, "update-checkpoint-state")
+	defer server.Close()
+	tests.Test_UpdateCheckpointState(t, store)
+}
+
+func TestS3_DeleteModel(t *testing.T) {
+	store, server := newTestStorage(t, "delete-model")
+	defer server.Close()
+	tests.Test_DeleteModel(t, store)
+}
+
+func TestS3_DeleteHyperparameters(t *testing.T) {
+	store, server := newTestStorage(t, "delete-hyperparameters")
+	defer server.Close()
+	tests.Test_DeleteHyperparameters(t, store)
+}
+
+func TestS3_DeleteCheckpoint(t *testing.T) {
+	store, server := newTestStorage(t, "delete-checkpoint")
+	defer server.Close()
+	tests.Test_DeleteCheckpoint(t, store)
+}
+
+func TestS3_Tags(t *testing.T) {
+	store, server := newTestStorage(t, "tags")
+	defer server.Close()
+	tests.Test_Tags(t, store)
+}
+
+func TestS3_Rollouts(t *testing.T) {
+	store, server := newTestStorage(t, "rollouts")
+	defer server.Close()
+	tests.Test_Rollouts(t, store)
+}
+
+func TestS3_FindDanglingReferences(t *testing.T) {
+	store, server := newTestStorage(t, "find-dangling-references")
+	defer server.Close()
+	tests.Test_FindDanglingReferences(t, store)
+}
+
+func TestS3_BatchGet(t *testing.T) {
+	store, server := newTestStorage(t, "batch-get")
+	defer server.Close()
+	tests.Test_BatchGet(t, store)
+}
+
+func TestS3_Labels(t *testing.T) {
+	store, server := newTestStorage(t, "labels")
+	defer server.Close()
+	tests.Test_Labels(t, store)
+}
+
+func TestS3_AuditEvents(t *testing.T) {
+	store, server := newTestStorage(t, "audit-events")
+	defer server.Close()
+	tests.Test_AuditEvents(t, store)
+
+	client, fleaServer := newTestClient(t, "flea", "flea-uploads")
+	defer fleaServer.Close()
+	tests.Test_AuditEvents(t, s3.NewFleaS3Storage(client, "flea", "flea-uploads", "http://repository"))
+}
+
+func TestS3_FleaTasks(t *testing.T) {
+	client, server := newTestClient(t, "flea", "flea-uploads")
+	defer server.Close()
+	tests.Test_FleaTasks(t, s3.NewFleaS3Storage(client, "flea", "flea-uploads", "http://repository"))
+}
+
+func TestS3_Webhooks(t *testing.T) {
+	store, server := newTestStorage(t, "webhooks")
+	defer server.Close()
+	tests.Test_Webhooks(t, store)
+}
+
+func TestS3_SignCheckpointLink(t *testing.T) {
+	store, server := newTestStorage(t, "sign-checkpoint-link")
+	defer server.Close()
+	ctx := context.Background()
+	expires := time.Now().Add(time.Hour)
+
+	link, err := store.SignCheckpointLink(ctx, "s3://sign-checkpoint-link/bundles/ckpt.zip", expires)
+	assert.NoError(t, err)
+	assert.Contains(t, link, server.URL+"/sign-checkpoint-link/bundles/ckpt.zip?")
+	assert.Contains(t, link, "X-Amz-Signature=")
+
+	link, err = store.SignCheckpointLink(ctx, "s3://elsewhere/ckpt.zip", expires)
+	assert.NoError(t, err)
+	assert.Equal(t, "s3://elsewhere/ckpt.zip", link)
+
+	_, err = store.SignCheckpointLink(ctx, "s3://sign-checkpoint-link/bundles/ckpt.zip", time.Now().Add(-time.Minute))
+	assert.Error(t, err)
+}
+
+func TestS3_CheckpointUpload(t *testing.T) {
+	store, server := newTestStorage(t, "checkpoint-upload")
+	defer server.Close()
+	tests.Test_CheckpointUpload(t, store, func(uploadURL string, contents []byte) error {
+		req, err := http.NewRequest("PUT", uploadURL, bytes.NewReader(contents))
+		if err != nil {
+			return err
+		}
+		req.Header.Set("Content-Type", storage.BundleContentType)
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			return err
+		}
+		defer resp.Body.Close()
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		return nil
+	})
+}
+
+func TestS3_StartTaskPresignedUpload(t *testing.T) {
+	client, server := newTestClient(t, "flea", "flea-uploads")
+	defer server.Close()
+	store := s3.NewFleaS3Storage(client, "flea", "flea-uploads", "http://repository")
+
+	ctx := context.Background()
+	err := store.AddTask(ctx, api.TaskDetails{TaskId: "task1", ModelId: "m", Active: true})
+	assert.NoError(t, err)
+	err = store.AddTask(ctx, api.TaskDetails{TaskId: "task1"})
+	assert.Equal(t, storage.ErrDuplicateTaskId, err)
+
+	resp, err := store.StartTask(ctx, "task1")
+	assert.NoError(t, err)
+	assert.Equal(t, api.StartTaskResponse_APPROVED, resp.Status)
+
+	// The presigned URL must be usable without any further credentials.
+	req, err := http.NewRequest("PUT", resp.UploadTo, bytes.NewReader([]byte("zip")))
+	assert.NoError(t, err)
+	req.Header.Set("Content-Type", "application/zip")
+	httpResp, err := http.DefaultClient.Do(req)
+	assert.NoError(t, err)
+	httpResp.Body.Close()
+	assert.Equal(t, http.StatusOK, httpResp.StatusCode)
+
+	_, err = client.HeadObject(&awss3.HeadObjectInput{
+		Bucket: aws.String("flea-uploads"),
+		Key:    aws.String("tasksJobs/task1/" + resp.JobId + ".zip"),
+	})
+	assert.NoError(t, err)
+}
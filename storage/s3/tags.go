@@ -0,0 +1,120 @@
+package s3
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/doc-ai/tensorio-models/storage"
+)
+
+func (store s3Storage) SetTag(ctx context.Context, tag storage.Tag) (storage.Tag, error) {
+	err := store.checkTagsOwner(ctx, tag.ModelId, tag.HyperparametersId)
+	if err != nil {
+		return storage.Tag{}, err
+	}
+
+	tag.UpdatedAt = time.Now()
+	bytes, err := json.Marshal(tag)
+	if err != nil {
+		return storage.Tag{}, err
+	}
+	err = writeObject(ctx, store.client, store.bucketName, objTagPath(tag.ModelId, tag.HyperparametersId, tag.Tag), bytes)
+	if err != nil {
+		return storage.Tag{}, err
+	}
+	return tag, nil
+}
+
+func (store s3Storage) GetTag(ctx context.Context, modelId, hyperparametersId, tag string) (storage.Tag, error) {
+	err := store.checkTagsOwner(ctx, modelId, hyperparametersId)
+	if err != nil {
+		return storage.Tag{}, err
+	}
+
+	bytes, err := readObject(ctx, store.client, store.bucketName, objTagPath(modelId, hyperparametersId, tag))
+	if err == errObjectNotExist {
+		return storage.Tag{}, storage.TagDoesNotExistError
+	}
+	if err != nil {
+		return storage.Tag{}, err
+	}
+
+	res := storage.Tag{}
+	err = json.Unmarshal(bytes, &res)
+	if err != nil {
+		return storage.Tag{}, err
+	}
+	return res, nil
+}
+
+func (store s3Storage) ListTags(ctx context.Context, modelId, hyperparametersId string) ([]storage.Tag, error) {
+	err := store.checkTagsOwner(ctx, modelId, hyperparametersId)
+	if err != nil {
+		return nil, err
+	}
+
+	var keys []string
+	input := &s3.ListObjectsInput{
+		Bucket: aws.String(store.bucketName),
+		Prefix: aws.String(objTagsPrefix(modelId, hyperparametersId)),
+	}
+	err = store.client.ListObjectsPagesWithContext(ctx, input, func(page *s3.ListObjectsOutput, lastPage bool) bool {
+		for _, object := range page.Contents {
+			keys = append(keys, aws.StringValue(object.Key))
+		}
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]storage.Tag, len(keys))
+	for i, key := range keys {
+		bytes, err := readObject(ctx, store.client, store.bucketName, key)
+		if err != nil {
+			return nil, err
+		}
+		err = json.Unmarshal(bytes, &res[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+	// Keys sort differently from tag names because of their .json extension
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].Tag < res[j].Tag
+	})
+	return res, nil
+}
+
+func (store s3Storage) DeleteTag(ctx context.Context, modelId, hyperparametersId, tag string) error {
+	err := store.checkTagsOwner(ctx, modelId, hyperparametersId)
+	if err != nil {
+		return err
+	}
+
+	objLoc := objTagPath(modelId, hyperparametersId, tag)
+	// S3 does not report whether deleted objects existed
+	exists, err := objectExists(ctx, store.client, store.bucketName, objLoc)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return storage.TagDoesNotExistError
+	}
+	return deleteObject(ctx, store.client, store.bucketName, objLoc)
+}
+
+// checkTagsOwner - checks that the model (if hyperparametersId is "") or hyperparameters which tags
+// are on exist.
+func (store s3Storage) checkTagsOwner(ctx context.Context, modelId, hyperparametersId string) error {
+	if hyperparametersId == "" {
+		_, err := store.GetModel(ctx, modelId)
+		return err
+	}
+	_, err := store.GetHyperparameters(ctx, modelId, hyperparametersId)
+	return err
+}
@@ -0,0 +1,51 @@
+package s3
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3iface"
+)
+
+// presignedURLSigner - a signedURL.URLSigner for the objects in an S3 bucket, which hands out
+// presigned URLs.
+type presignedURLSigner struct {
+	client     s3iface.S3API
+	bucketName string
+}
+
+// GetSignedURL - presigns a GET or PUT request for the object at filePath. Expiry times further
+// out than maxPresignExpiry are brought forward to it.
+func (signer presignedURLSigner) GetSignedURL(method string, filePath string, expires time.Time, contentType string) (string, error) {
+	var req *request.Request
+	switch method {
+	case "GET":
+		req, _ = signer.client.GetObjectRequest(&s3.GetObjectInput{
+			Bucket: aws.String(signer.bucketName),
+			Key:    aws.String(filePath),
+		})
+	case "PUT":
+		input := &s3.PutObjectInput{
+			Bucket: aws.String(signer.bucketName),
+			Key:    aws.String(filePath),
+		}
+		if contentType != "" {
+			input.ContentType = aws.String(contentType)
+		}
+		req, _ = signer.client.PutObjectRequest(input)
+	default:
+		return "", fmt.Errorf("Cannot presign %s requests", method)
+	}
+
+	expiry := time.Until(expires)
+	if expiry <= 0 {
+		return "", errURLExpired
+	}
+	if expiry > maxPresignExpiry {
+		expiry = maxPresignExpiry
+	}
+	return req.Presign(expiry)
+}
@@ -0,0 +1,120 @@
+package s3
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3iface"
+	"github.com/doc-ai/tensorio-models/storage"
+)
+
+// webhookStore - stores each webhook in its own object under webhooks/, and each dead letter in
+// its own object under webhook-dead-letters/. Shared by the repository and FLEA storage.
+type webhookStore struct {
+	webhooksClient     s3iface.S3API
+	webhooksBucketName string
+}
+
+func (store webhookStore) AddWebhook(ctx context.Context, webhook storage.Webhook) error {
+	bytes, err := json.Marshal(webhook)
+	if err != nil {
+		return err
+	}
+	return writeObject(ctx, store.webhooksClient, store.webhooksBucketName, objWebhookPath(webhook.WebhookId), bytes)
+}
+
+func (store webhookStore) ListWebhooks(ctx context.Context) ([]storage.Webhook, error) {
+	keys, err := store.listKeys(ctx, objWebhooksPrefix(), "", -1)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]storage.Webhook, len(keys))
+	for i, key := range keys {
+		err = store.readJSON(ctx, key, &res[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+func (store webhookStore) DeleteWebhook(ctx context.Context, webhookId string) error {
+	objLoc := objWebhookPath(webhookId)
+	// S3 does not report whether deleted objects existed
+	exists, err := objectExists(ctx, store.webhooksClient, store.webhooksBucketName, objLoc)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return storage.WebhookDoesNotExistError
+	}
+	return deleteObject(ctx, store.webhooksClient, store.webhooksBucketName, objLoc)
+}
+
+func (store webhookStore) AddWebhookDeadLetter(ctx context.Context, deadLetter storage.WebhookDeadLetter) error {
+	bytes, err := json.Marshal(deadLetter)
+	if err != nil {
+		return err
+	}
+	// Delivery IDs are unique, so PUTs never replace earlier dead letters
+	return writeObject(ctx, store.webhooksClient, store.webhooksBucketName, objWebhookDeadLetterPath(deadLetter.DeliveryId), bytes)
+}
+
+func (store webhookStore) ListWebhookDeadLetters(ctx context.Context, marker string, maxItems int) ([]storage.WebhookDeadLetter, error) {
+	keys, err := store.listKeys(ctx, objWebhookDeadLettersPrefix(), marker, maxItems)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]storage.WebhookDeadLetter, len(keys))
+	for i, key := range keys {
+		err = store.readJSON(ctx, key, &res[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+// listKeys - returns the keys of up to maxItems objects under prefix whose names (without the
+// prefix and their .json extension) come after marker. A negative maxItems lists every object. As
+// with the audit log, the prefix is listed in full and filtered here.
+func (store webhookStore) listKeys(ctx context.Context, prefix, marker string, maxItems int) ([]string, error) {
+	res := make([]string, 0)
+	input := &s3.ListObjectsInput{
+		Bucket: aws.String(store.webhooksBucketName),
+		Prefix: aws.String(prefix),
+	}
+	err := store.webhooksClient.ListObjectsPagesWithContext(ctx, input, func(page *s3.ListObjectsOutput, lastPage bool) bool {
+		for _, object := range page.Contents {
+			if len(res) == maxItems {
+				return false
+			}
+
+			key := aws.StringValue(object.Key)
+			if strings.TrimSuffix(strings.TrimPrefix(key, prefix), ".json") <= marker {
+				continue
+			}
+
+			res = append(res, key)
+		}
+		return len(res) != maxItems
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (store webhookStore) readJSON(ctx context.Context, key string, v interface{}) error {
+	bytes, err := readObject(ctx, store.webhooksClient, store.webhooksBucketName, key)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(bytes, v)
+}
@@ -3,9 +3,13 @@ package storage
 import (
 	"context"
 	"errors"
+	"fmt"
+	"io"
+	"strings"
 	"time"
 
 	"github.com/doc-ai/tensorio-models/api"
+	"github.com/doc-ai/tensorio-models/common"
 	"github.com/golang/protobuf/ptypes/timestamp"
 )
 
@@ -18,10 +22,65 @@ var HyperparametersExistsError = errors.New("Hyperparameters already exists")
 var CheckpointDoesNotExistError = errors.New("Checkpoint does not exist")
 var CheckpointExistsError = errors.New("Checkpoint already exists")
 
+var ErrResourceHasChildren = errors.New("Resource still has children")
+var ErrResourceIsReferenced = errors.New("Resource is still referenced")
+
+var ErrCanonicalHyperparametersDoesNotExist = errors.New("CanonicalHyperparameters refers to hyperparameters which do not exist")
+var ErrCanonicalCheckpointDoesNotExist = errors.New("CanonicalCheckpoint refers to a checkpoint which does not exist")
+var ErrUpgradeToDoesNotExist = errors.New("UpgradeTo refers to hyperparameters which do not exist")
+
+// ErrVersionMismatch - returned by UpdateModel, UpdateHyperparameters, ReplaceModel and
+// ReplaceHyperparameters if the stored resource is no longer at the version which the update
+// expects.
+var ErrVersionMismatch = errors.New("Resource has been modified since it was read")
+
+// CheckpointContentError - returned by backends which verify checkpoints when the object behind a
+// checkpoint link does not match its Sha256, SizeBytes or ContentType.
+type CheckpointContentError struct {
+	// Field - the API field which does not match, e.g. "sha256".
+	Field  string
+	Reason string
+}
+
+func (err CheckpointContentError) Error() string {
+	return fmt.Sprintf("Checkpoint %s does not match its bundle: %s", err.Field, err.Reason)
+}
+
 type Model struct {
 	ModelId                  string
 	Details                  string
 	CanonicalHyperparameters string
+	// Labels - UpdateModel replaces the stored labels with these unless they are nil.
+	Labels map[string]string
+	// Card - structured metadata describing the model, or nil if it has none. UpdateModel replaces
+	// the stored card with this one unless it is nil.
+	Card *ModelCard
+	// Version - see CheckVersion.
+	Version int64
+}
+
+// ModelCardSchemaVersion - the current version of the model card schema.
+const ModelCardSchemaVersion = 1
+
+// ModelCard - structured, versioned metadata describing a model.
+type ModelCard struct {
+	SchemaVersion int32
+	Description   string
+	Owners        []string
+	Inputs        []TensorSpec
+	Outputs       []TensorSpec
+	License       string
+	IntendedUse   string
+	Framework     string
+}
+
+// TensorSpec - describes one of the input or output tensors of a model. Dimensions of any size
+// have a Shape of -1.
+type TensorSpec struct {
+	Name        string
+	Dtype       string
+	Shape       []int64
+	Description string
 }
 
 type Hyperparameters struct {
@@ -30,6 +89,14 @@ type Hyperparameters struct {
 	CanonicalCheckpoint string
 	UpgradeTo           string
 	Hyperparameters     map[string]string
+	// Labels - UpdateHyperparameters replaces the stored labels with these unless they are nil.
+	Labels map[string]string
+	// Rollout - checkpoints which are being rolled out to some of the clients of the
+	// hyperparameters, see PickRolloutCheckpoint. UpdateHyperparameters replaces the stored rollout
+	// with this unless it is nil, so an empty rollout ends it.
+	Rollout []RolloutTarget
+	// Version - see CheckVersion.
+	Version int64
 }
 
 type Checkpoint struct {
@@ -39,34 +106,289 @@ type Checkpoint struct {
 	Link              string
 	CreatedAt         time.Time
 	Info              map[string]string
+	// State - the lifecycle state of the checkpoint. The zero value is api.CheckpointState_ACTIVE.
+	State  api.CheckpointState
+	Labels map[string]string
+	// Sha256, SizeBytes and ContentType - optionally describe the bundle behind Link, so that
+	// clients can verify their downloads. Sha256 is hex encoded.
+	Sha256      string
+	SizeBytes   int64
+	ContentType string
+}
+
+// ListResult - a page of resource IDs returned by one of the List* methods of RepositoryStorage.
+type ListResult struct {
+	// Ids - the IDs of the listed resources (without the IDs of their parents).
+	Ids []string
+	// NextMarker - the marker from which to list the next page, or "" if this is the last page.
+	NextMarker string
+}
+
+// NewListResult - builds a ListResult from up to maxItems+1 IDs. Backends list one more item than
+// was asked for to find out whether there is another page, which this drops again.
+func NewListResult(ids []string, maxItems int) ListResult {
+	if len(ids) <= maxItems {
+		return ListResult{Ids: ids}
+	}
+	ids = ids[:maxItems]
+	res := ListResult{Ids: ids}
+	if maxItems > 0 {
+		res.NextMarker = ids[maxItems-1]
+	}
+	return res
+}
+
+// DeleteOptions - controls how the Delete* methods of RepositoryStorage treat related resources.
+type DeleteOptions struct {
+	// Cascade - delete the children of the resource (the hyperparameters of a model, the
+	// checkpoints of hyperparameters) along with it. Otherwise ErrResourceHasChildren is returned
+	// if there are any.
+	Cascade bool
+	// Force - delete the resource even if it is referenced as the CanonicalHyperparameters of its
+	// model, the CanonicalCheckpoint or Rollout of its hyperparameters, the UpgradeTo of other
+	// hyperparameters or the Target of a tag. Otherwise ErrResourceIsReferenced is returned. Forced
+	// deletes leave the references dangling.
+	Force bool
+}
+
+// IsHyperparametersReferenced - reports whether hyperparametersId is referenced by its model or by
+// the UpgradeTo of any of the given hyperparameters (other than itself).
+func IsHyperparametersReferenced(model Model, hyperparameters []Hyperparameters, hyperparametersId string) bool {
+	if model.CanonicalHyperparameters == hyperparametersId {
+		return true
+	}
+	for _, other := range hyperparameters {
+		if other.HyperparametersId != hyperparametersId && other.UpgradeTo == hyperparametersId {
+			return true
+		}
+	}
+	return false
+}
+
+// GetAllHyperparameters - pages through every set of hyperparameters registered under the given
+// model and fetches each of them.
+func GetAllHyperparameters(ctx context.Context, store RepositoryStorage, modelId string) ([]Hyperparameters, error) {
+	const pageSize = 100
+	var res []Hyperparameters
+	marker := ""
+	for {
+		page, err := store.ListHyperparameters(ctx, modelId, marker, pageSize)
+		if err != nil {
+			return nil, err
+		}
+		for _, hyperparametersId := range page.Ids {
+			hyperparameters, err := store.GetHyperparameters(ctx, modelId, hyperparametersId)
+			if err != nil {
+				return nil, err
+			}
+			res = append(res, hyperparameters)
+		}
+		if page.NextMarker == "" {
+			return res, nil
+		}
+		marker = page.NextMarker
+	}
+}
+
+// CheckVersion - models and hyperparameters are stored at Version 1 when they are added, and every
+// update increments their Version. An update which carries a Version only applies if the stored
+// resource is still at that version, so that concurrent read-modify-write cycles cannot silently
+// overwrite each other; updates without a Version (0) always apply. Resources stored before they
+// were versioned are at Version 0 until they are first updated.
+func CheckVersion(storedVersion, expectedVersion int64) error {
+	if expectedVersion != 0 && expectedVersion != storedVersion {
+		return ErrVersionMismatch
+	}
+	return nil
+}
+
+// CheckModelReferences - checks that the CanonicalHyperparameters which an update sets on a stored
+// model exist. References which the update leaves unchanged are not checked, so that models whose
+// references are already dangling can still be updated.
+func CheckModelReferences(ctx context.Context, store RepositoryStorage, stored, update Model) error {
+	canonicalHyperparameters := strings.TrimSpace(update.CanonicalHyperparameters)
+	if canonicalHyperparameters == "" || canonicalHyperparameters == stored.CanonicalHyperparameters {
+		return nil
+	}
+	_, err := store.GetHyperparameters(ctx, stored.ModelId, canonicalHyperparameters)
+	if err == HyperparametersDoesNotExistError {
+		return ErrCanonicalHyperparametersDoesNotExist
+	}
+	return err
+}
+
+// CheckHyperparametersReferences - checks that the CanonicalCheckpoint, UpgradeTo and Rollout
+// checkpoints which an update sets on stored hyperparameters exist. As with CheckModelReferences,
+// unchanged references are not checked.
+func CheckHyperparametersReferences(ctx context.Context, store RepositoryStorage, stored, update Hyperparameters) error {
+	canonicalCheckpoint := strings.TrimSpace(update.CanonicalCheckpoint)
+	if canonicalCheckpoint != "" && canonicalCheckpoint != stored.CanonicalCheckpoint {
+		_, err := store.GetCheckpoint(ctx, stored.ModelId, stored.HyperparametersId, canonicalCheckpoint)
+		if err == CheckpointDoesNotExistError {
+			return ErrCanonicalCheckpointDoesNotExist
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	upgradeTo := strings.TrimSpace(update.UpgradeTo)
+	if upgradeTo != "" && upgradeTo != stored.UpgradeTo {
+		_, err := store.GetHyperparameters(ctx, stored.ModelId, upgradeTo)
+		if err == HyperparametersDoesNotExistError {
+			return ErrUpgradeToDoesNotExist
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, target := range update.Rollout {
+		if IsInRollout(stored.Rollout, target.CheckpointId) {
+			continue
+		}
+		_, err := store.GetCheckpoint(ctx, stored.ModelId, stored.HyperparametersId, target.CheckpointId)
+		if err == CheckpointDoesNotExistError {
+			return ErrRolloutTargetDoesNotExist
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// IsListedCheckpoint - whether ListCheckpoints should include a checkpoint in the given state.
+// Pending checkpoints are never listed, archived checkpoints only if includeArchived is set.
+func IsListedCheckpoint(state api.CheckpointState, includeArchived bool) bool {
+	switch state {
+	case api.CheckpointState_PENDING:
+		return false
+	case api.CheckpointState_ARCHIVED:
+		return includeArchived
+	}
+	return true
+}
+
+// ListVisibleCheckpoints - collects up to maxItems checkpoint IDs following marker, skipping
+// checkpoints which IsListedCheckpoint rules out. listPage should list raw checkpoint IDs (in any
+// state) following the given marker, and getCheckpoint should fetch the checkpoint with the given
+// ID. This is meant for backends which cannot filter on the state of a checkpoint while listing.
+func ListVisibleCheckpoints(marker string, maxItems int, includeArchived bool, listPage func(marker string, maxItems int) ([]string, error), getCheckpoint func(checkpointId string) (Checkpoint, error)) ([]string, error) {
+	res := make([]string, 0)
+	for len(res) < maxItems {
+		pageSize := maxItems - len(res)
+		checkpointIds, err := listPage(marker, pageSize)
+		if err != nil {
+			return nil, err
+		}
+		for _, checkpointId := range checkpointIds {
+			checkpoint, err := getCheckpoint(checkpointId)
+			if err != nil {
+				return nil, err
+			}
+			if IsListedCheckpoint(checkpoint.State, includeArchived) {
+				res = append(res, checkpointId)
+			}
+		}
+		if len(checkpointIds) < pageSize {
+			break
+		}
+		marker = checkpointIds[len(checkpointIds)-1]
+	}
+	return res, nil
 }
 
 type RepositoryStorage interface {
 	GetStorageType() string
 	GetBucketName() string
 
+	// AUDIT LOG
+
+	AuditStorage
+
+	// WEBHOOKS
+
+	WebhookStorage
+
 	// MODELS
 
-	ListModels(ctx context.Context, marker string, maxItems int) ([]string, error)
+	ListModels(ctx context.Context, marker string, maxItems int) (ListResult, error)
 	GetModel(ctx context.Context, modelId string) (Model, error)
+	// BatchGetModels - returns the models with the given IDs, in the same order.
+	BatchGetModels(ctx context.Context, modelIds []string) ([]Model, error)
 
 	AddModel(ctx context.Context, model Model) error
 	UpdateModel(ctx context.Context, model Model) (Model, error)
+	// ReplaceModel - as UpdateModel, except that the stored model is replaced with the given one
+	// instead of being merged with it, so that fields which are empty in model are cleared.
+	ReplaceModel(ctx context.Context, model Model) (Model, error)
+	DeleteModel(ctx context.Context, modelId string, options DeleteOptions) error
+
+	// ListModelRevisions - returns up to maxItems revisions of the given model whose versions come
+	// after afterVersion, oldest first.
+	ListModelRevisions(ctx context.Context, modelId string, afterVersion int64, maxItems int) ([]ModelRevision, error)
+	// GetModelRevision - returns RevisionDoesNotExistError if the model has no revision at version.
+	GetModelRevision(ctx context.Context, modelId string, version int64) (ModelRevision, error)
 
 	// HYPERPARAMETERS
 
-	ListHyperparameters(ctx context.Context, modelId, marker string, maxItems int) ([]string, error)
+	ListHyperparameters(ctx context.Context, modelId, marker string, maxItems int) (ListResult, error)
 	GetHyperparameters(ctx context.Context, modelId string, hyperparametersId string) (Hyperparameters, error)
+	BatchGetHyperparameters(ctx context.Context, modelId string, hyperparametersIds []string) ([]Hyperparameters, error)
 
 	AddHyperparameters(ctx context.Context, hyperparameters Hyperparameters) error
 	UpdateHyperparameters(ctx context.Context, hyperparameters Hyperparameters) (Hyperparameters, error)
+	// ReplaceHyperparameters - as ReplaceModel, for hyperparameters.
+	ReplaceHyperparameters(ctx context.Context, hyperparameters Hyperparameters) (Hyperparameters, error)
+	DeleteHyperparameters(ctx context.Context, modelId, hyperparametersId string, options DeleteOptions) error
+
+	// ListHyperparametersRevisions and GetHyperparametersRevision - as ListModelRevisions and
+	// GetModelRevision, for hyperparameters.
+	ListHyperparametersRevisions(ctx context.Context, modelId, hyperparametersId string, afterVersion int64, maxItems int) ([]HyperparametersRevision, error)
+	GetHyperparametersRevision(ctx context.Context, modelId, hyperparametersId string, version int64) (HyperparametersRevision, error)
 
 	// CHECKPOINTS
 
-	ListCheckpoints(ctx context.Context, modelId, hyperparametersId, marker string, maxItems int) ([]string, error)
+	// ListCheckpoints - archived checkpoints are only listed if includeArchived is set, and pending
+	// checkpoints are never listed.
+	ListCheckpoints(ctx context.Context, modelId, hyperparametersId, marker string, maxItems int, includeArchived bool) (ListResult, error)
 	GetCheckpoint(ctx context.Context, modelId, hyperparametersId, checkpointId string) (Checkpoint, error)
+	BatchGetCheckpoints(ctx context.Context, modelId, hyperparametersId string, checkpointIds []string) ([]Checkpoint, error)
 
 	AddCheckpoint(ctx context.Context, checkpoint Checkpoint) error
+	UpdateCheckpointState(ctx context.Context, modelId, hyperparametersId, checkpointId string, state api.CheckpointState) (Checkpoint, error)
+	// UpdateCheckpointInfo - replaces the info of a checkpoint.
+	UpdateCheckpointInfo(ctx context.Context, modelId, hyperparametersId, checkpointId string, info map[string]string) (Checkpoint, error)
+	DeleteCheckpoint(ctx context.Context, modelId, hyperparametersId, checkpointId string, options DeleteOptions) error
+
+	// CheckpointUploadURL - returns a URL which the bundle of the given checkpoint can be PUT to
+	// (with BundleContentType) until expires, along with the link the bundle can be found at once
+	// it is uploaded.
+	CheckpointUploadURL(ctx context.Context, modelId, hyperparametersId, checkpointId string, expires time.Time) (string, string, error)
+	// CheckpointBundleExists - whether the bundle of the given checkpoint has been uploaded.
+	CheckpointBundleExists(ctx context.Context, modelId, hyperparametersId, checkpointId string) (bool, error)
+	// SignCheckpointLink - returns a URL which the bundle at the given checkpoint link can be
+	// downloaded from until expires. Links which the backend cannot sign are returned unchanged.
+	SignCheckpointLink(ctx context.Context, link string, expires time.Time) (string, error)
+	// OpenCheckpointLink - opens the object at the given checkpoint link for reading. Returns
+	// ErrLinkNotReadable for links the backend cannot read, and ErrLinkDoesNotExist if there is
+	// nothing at the link.
+	OpenCheckpointLink(ctx context.Context, link string) (io.ReadCloser, error)
+
+	// TAGS
+
+	// SetTag - adds the tag to its model (if its HyperparametersId is "") or hyperparameters, or
+	// moves it to a new Target if it already exists. Returns the tag as stored. See CheckTagTarget.
+	SetTag(ctx context.Context, tag Tag) (Tag, error)
+	// GetTag - returns TagDoesNotExistError if the model (if hyperparametersId is "") or
+	// hyperparameters have no such tag.
+	GetTag(ctx context.Context, modelId, hyperparametersId, tag string) (Tag, error)
+	// ListTags - returns every tag on the model (if hyperparametersId is "") or hyperparameters,
+	// ordered by name.
+	ListTags(ctx context.Context, modelId, hyperparametersId string) ([]Tag, error)
+	DeleteTag(ctx context.Context, modelId, hyperparametersId, tag string) error
 }
 
 type Job struct {
@@ -102,16 +424,85 @@ var ErrInvalidJobId = errors.New("Invalid JobId")
 var ErrInvalidModelId = errors.New("Invalid ModelId")
 var ErrInvalidHyperparametersId = errors.New("Invalid HyperparametersId")
 var ErrInvalidCheckpointId = errors.New("Invalid CheckpointId")
+var ErrArchivedCheckpoint = errors.New("Checkpoint is archived")
+var ErrPendingCheckpoint = errors.New("Checkpoint has not been finalized")
+
+// SetTaskPage - fills in the TaskIds of a ListTasks response from up to maxItems+1 matching task
+// IDs. If there are more than maxItems, the extra task is dropped and NextPageToken is set so that
+// the next page starts with it. A maxItems of 0 means that the listing is not limited.
+func SetTaskPage(resp *api.ListTasksResponse, taskIds []string, maxItems int32) {
+	if maxItems > 0 && len(taskIds) > int(maxItems) {
+		resp.NextPageToken = common.EncodePageToken(taskIds[maxItems])
+		taskIds = taskIds[:maxItems]
+	}
+	resp.TaskIds = taskIds
+}
+
+// TaskMatches - whether a task passes the filters of a ListTasks request. Inactive tasks only pass
+// if the request includes them.
+func TaskMatches(req api.ListTasksRequest, task api.TaskDetails) bool {
+	if !req.IncludeInactive && !task.Active {
+		return false
+	}
+	if task.ModelId != req.ModelId && req.ModelId != "" {
+		return false
+	}
+	if task.HyperparametersId != req.HyperparametersId && req.HyperparametersId != "" {
+		return false
+	}
+	if task.CheckpointId != req.CheckpointId && req.CheckpointId != "" {
+		return false
+	}
+	return true
+}
+
+// ListTaskCandidates - ListTasks for backends which list the IDs of their tasks and then look the
+// tasks up. Candidates have to be sorted. Starting at the StartTaskId of the request, they are
+// looked up with getTask until one more than MaxItems have passed TaskMatches, and the response is
+// paged with SetTaskPage.
+func ListTaskCandidates(req api.ListTasksRequest, candidates []string, getTask func(taskId string) (api.TaskDetails, error)) (api.ListTasksResponse, error) {
+	resp := api.ListTasksResponse{}
+	var taskIds []string
+	for _, taskId := range candidates {
+		if req.MaxItems > 0 && len(taskIds) > int(req.MaxItems) {
+			break
+		}
+		if req.StartTaskId != "" && taskId < req.StartTaskId {
+			continue
+		}
+		task, err := getTask(taskId)
+		if err != nil {
+			return resp, err
+		}
+		if TaskMatches(req, task) {
+			taskIds = append(taskIds, taskId)
+		}
+	}
+	SetTaskPage(&resp, taskIds, req.MaxItems)
+	resp.StartTaskId = req.StartTaskId
+	resp.MaxItems = req.MaxItems
+	return resp, nil
+}
 
 type FleaStorage interface {
 	GetStorageType() string
 	GetBucketName() string
 
+	// AUDIT LOG
+
+	AuditStorage
+
+	// WEBHOOKS
+
+	WebhookStorage
+
 	AddTask(ctx context.Context, req api.TaskDetails) error
 	ModifyTask(ctx context.Context, req api.ModifyTaskRequest) error
 
 	ListTasks(ctx context.Context, req api.ListTasksRequest) (resp api.ListTasksResponse, e error)
 	GetTask(ctx context.Context, taskId string) (api.TaskDetails, error)
+	// BatchGetTasks - returns the tasks with the given IDs, in the same order.
+	BatchGetTasks(ctx context.Context, taskIds []string) ([]api.TaskDetails, error)
 	StartTask(ctx context.Context, taskId string) (api.StartTaskResponse, error)
 
 	AddJobError(ctx context.Context, req api.JobErrorRequest) error
@@ -0,0 +1,50 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+var TagDoesNotExistError = errors.New("Tag does not exist")
+var ErrTagTargetDoesNotExist = errors.New("Tag refers to a resource which does not exist")
+
+// Tag - a named pointer, such as "production" or "staging", which can be moved from one resource to
+// another. Tags on a model point to one of its hyperparameters, and tags on hyperparameters point
+// to one of their checkpoints, the way CanonicalHyperparameters and CanonicalCheckpoint do. Tags
+// are deleted along with the model or hyperparameters they are on.
+type Tag struct {
+	ModelId string
+	// HyperparametersId - the hyperparameters the tag is on, or "" if it is on the model.
+	HyperparametersId string
+	Tag               string
+	// Target - the ID of the tagged hyperparameters (for tags on models) or checkpoint (for tags on
+	// hyperparameters).
+	Target    string
+	UpdatedAt time.Time
+}
+
+// CheckTagTarget - checks that the hyperparameters or checkpoint which a tag points to exist.
+func CheckTagTarget(ctx context.Context, store RepositoryStorage, tag Tag) error {
+	var err error
+	if tag.HyperparametersId == "" {
+		_, err = store.GetHyperparameters(ctx, tag.ModelId, tag.Target)
+	} else {
+		_, err = store.GetCheckpoint(ctx, tag.ModelId, tag.HyperparametersId, tag.Target)
+	}
+	if err == HyperparametersDoesNotExistError || err == CheckpointDoesNotExistError {
+		return ErrTagTargetDoesNotExist
+	}
+	return err
+}
+
+// IsTagged - reports whether any of the given tags point to target. Deleting a tagged resource
+// returns ErrResourceIsReferenced unless it is forced.
+func IsTagged(tags []Tag, target string) bool {
+	for _, tag := range tags {
+		if tag.Target == target {
+			return true
+		}
+	}
+	return false
+}
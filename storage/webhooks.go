@@ -0,0 +1,50 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+var WebhookDoesNotExistError = errors.New("Webhook does not exist")
+
+// Webhook - a URL which changes made through the repository or FLEA API are POSTed to.
+type Webhook struct {
+	WebhookId string
+	Url       string
+	// EventTypes - the names of the API methods whose changes are delivered, e.g. CreateCheckpoint.
+	EventTypes []string
+	// Secret - the key of the HMAC-SHA256 signature of each delivery. It is never returned by the
+	// API.
+	Secret    string
+	CreatedAt time.Time
+}
+
+// WebhookDeadLetter - a delivery which failed on every attempt.
+type WebhookDeadLetter struct {
+	// DeliveryId - the ID of the delivered audit event followed by the ID of the webhook, so that
+	// dead letters are listed in the order in which the changes were made.
+	DeliveryId string
+	WebhookId  string
+	Url        string
+	EventType  string
+	// Payload - the body of the delivery.
+	Payload   string
+	Attempts  int
+	LastError string
+	FailedAt  time.Time
+}
+
+// WebhookStorage - the webhooks registered with a backend, and the dead letters of their failed
+// deliveries. Like the audit log, dead letters are append-only.
+type WebhookStorage interface {
+	AddWebhook(ctx context.Context, webhook Webhook) error
+	ListWebhooks(ctx context.Context) ([]Webhook, error)
+	// DeleteWebhook - returns WebhookDoesNotExistError if there is no such webhook.
+	DeleteWebhook(ctx context.Context, webhookId string) error
+
+	AddWebhookDeadLetter(ctx context.Context, deadLetter WebhookDeadLetter) error
+	// ListWebhookDeadLetters - returns up to maxItems dead letters whose IDs come after marker, in
+	// the order of their IDs.
+	ListWebhookDeadLetters(ctx context.Context, marker string, maxItems int) ([]WebhookDeadLetter, error)
+}
@@ -0,0 +1,305 @@
+package tiobundle
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// Backends - the backends TensorIO can run models on. Bundles which do not name a backend run on
+// tflite.
+var Backends = []string{"tflite", "tensorflow", "pytorch"}
+
+// DefaultBackend - the backend of bundles whose model.json does not name one.
+const DefaultBackend = "tflite"
+
+var layerTypes = []string{"array", "image", "string"}
+var arrayDtypes = []string{"uint8", "float32", "int32", "int64"}
+var imageFormats = []string{"RGB", "BGR"}
+var standardRanges = []string{"[0,1]", "[-1,1]"}
+
+// Violation - one way in which a model.json does not follow the TensorIO bundle schema.
+type Violation struct {
+	// Field - the path of the offending field, e.g. inputs[0].shape.
+	Field       string
+	Description string
+}
+
+// ValidationError - returned by Parse for model.json files which do not follow the TensorIO bundle
+// schema. It lists every violation, not just the first.
+type ValidationError struct {
+	Violations []Violation
+}
+
+func (err *ValidationError) Error() string {
+	descriptions := make([]string, len(err.Violations))
+	for i, violation := range err.Violations {
+		descriptions[i] = fmt.Sprintf("%s: %s", violation.Field, violation.Description)
+	}
+	return "Invalid TensorIO bundle: " + strings.Join(descriptions, "; ")
+}
+
+// Layer - one of the inputs or outputs of a bundle's model.
+type Layer struct {
+	Name  string  `json:"name"`
+	Type  string  `json:"type"`
+	Shape []int64 `json:"shape"`
+	// Dtype - the element type of array layers, if the bundle states it.
+	Dtype string `json:"dtype,omitempty"`
+	// Format - the pixel format of image layers.
+	Format string `json:"format,omitempty"`
+}
+
+// Bundle - what the repository needs to know about a TensorIO bundle, as read from its model.json.
+type Bundle struct {
+	Backend   string
+	Quantized bool
+	Inputs    []Layer
+	Outputs   []Layer
+}
+
+// Parse - checks the contents of a bundle's model.json against the TensorIO bundle schema and
+// returns the bundle it describes. Violations of the schema are returned as a *ValidationError.
+func Parse(modelJSON []byte) (Bundle, error) {
+	var doc map[string]interface{}
+	decoder := json.NewDecoder(bytes.NewReader(modelJSON))
+	decoder.UseNumber()
+	err := decoder.Decode(&doc)
+	if err != nil {
+		return Bundle{}, &ValidationError{[]Violation{{Field: "model.json", Description: fmt.Sprintf("not a JSON object: %v", err)}}}
+	}
+
+	v := &validator{}
+	bundle := Bundle{Backend: DefaultBackend}
+
+	model, ok := v.object(doc, "model", "model", true)
+	if ok {
+		v.str(model, "file", "model.file", true, nil)
+		if quantized, present := model["quantized"]; !present {
+			v.violate("model.quantized", "is required")
+		} else if bundle.Quantized, ok = quantized.(bool); !ok {
+			v.violate("model.quantized", "must be true or false")
+		}
+		if backend, present := v.str(model, "backend", "model.backend", false, Backends); present {
+			bundle.Backend = backend
+		}
+		if modes, present := model["modes"]; present {
+			v.stringArray(modes, "model.modes", []string{"predict", "train", "eval"})
+		}
+	}
+
+	bundle.Inputs = v.layers(doc, "inputs", bundle.Quantized)
+	bundle.Outputs = v.layers(doc, "outputs", bundle.Quantized)
+
+	if len(v.violations) > 0 {
+		return Bundle{}, &ValidationError{v.violations}
+	}
+	return bundle, nil
+}
+
+// validator - collects the violations found while walking a model.json.
+type validator struct {
+	violations []Violation
+}
+
+func (v *validator) violate(field, format string, args ...interface{}) {
+	v.violations = append(v.violations, Violation{Field: field, Description: fmt.Sprintf(format, args...)})
+}
+
+func (v *validator) object(parent map[string]interface{}, key, field string, required bool) (map[string]interface{}, bool) {
+	value, present := parent[key]
+	if !present {
+		if required {
+			v.violate(field, "is required")
+		}
+		return nil, false
+	}
+	object, ok := value.(map[string]interface{})
+	if !ok {
+		v.violate(field, "must be an object")
+	}
+	return object, ok
+}
+
+// str - checks that parent[key] is a string, and one of choices unless choices is nil.
+func (v *validator) str(parent map[string]interface{}, key, field string, required bool, choices []string) (string, bool) {
+	value, present := parent[key]
+	if !present {
+		if required {
+			v.violate(field, "is required")
+		}
+		return "", false
+	}
+	s, ok := value.(string)
+	if !ok || s == "" {
+		v.violate(field, "must be a non-empty string")
+		return "", false
+	}
+	if choices != nil && !contains(choices, s) {
+		v.violate(field, "must be one of %s", strings.Join(choices, ", "))
+		return "", false
+	}
+	return s, true
+}
+
+func (v *validator) stringArray(value interface{}, field string, choices []string) {
+	values, ok := value.([]interface{})
+	if !ok {
+		v.violate(field, "must be an array")
+		return
+	}
+	for i, value := range values {
+		s, ok := value.(string)
+		if !ok || !contains(choices, s) {
+			v.violate(fmt.Sprintf("%s[%d]", field, i), "must be one of %s", strings.Join(choices, ", "))
+		}
+	}
+}
+
+// layers - checks the inputs or outputs of a model.
+func (v *validator) layers(doc map[string]interface{}, key string, quantized bool) []Layer {
+	value, present := doc[key]
+	if !present {
+		v.violate(key, "is required")
+		return nil
+	}
+	values, ok := value.([]interface{})
+	if !ok || len(values) == 0 {
+		v.violate(key, "must be a non-empty array")
+		return nil
+	}
+
+	isInput := key == "inputs"
+	layers := make([]Layer, 0, len(values))
+	names := make(map[string]bool, len(values))
+	for i, value := range values {
+		field := fmt.Sprintf("%s[%d]", key, i)
+		layerDoc, ok := value.(map[string]interface{})
+		if !ok {
+			v.violate(field, "must be an object")
+			continue
+		}
+		layer := Layer{}
+		layer.Name, _ = v.str(layerDoc, "name", field+".name", true, nil)
+		if layer.Name != "" {
+			if names[layer.Name] {
+				v.violate(field+".name", "%s is used by more than one of the %s", layer.Name, key)
+			}
+			names[layer.Name] = true
+		}
+		layer.Type, _ = v.str(layerDoc, "type", field+".type", true, layerTypes)
+		layer.Shape = v.shape(layerDoc, field+".shape")
+
+		switch layer.Type {
+		case "array":
+			layer.Dtype, _ = v.str(layerDoc, "dtype", field+".dtype", false, arrayDtypes)
+			v.transform(layerDoc, "quantize", field, isInput, quantized)
+			v.transform(layerDoc, "dequantize", field, !isInput, quantized)
+		case "image":
+			layer.Format, _ = v.str(layerDoc, "format", field+".format", true, imageFormats)
+			if n := len(layer.Shape); n > 0 && ((n != 3 && n != 4) || layer.Shape[n-1] != 3) {
+				v.violate(field+".shape", "must be [height, width, 3], optionally preceded by a batch dimension")
+			}
+			v.normalization(layerDoc, "normalize", field, isInput)
+			v.normalization(layerDoc, "denormalize", field, !isInput)
+		}
+		layers = append(layers, layer)
+	}
+	return layers
+}
+
+func (v *validator) shape(layerDoc map[string]interface{}, field string) []int64 {
+	value, present := layerDoc["shape"]
+	if !present {
+		v.violate(field, "is required")
+		return nil
+	}
+	values, ok := value.([]interface{})
+	if !ok || len(values) == 0 {
+		v.violate(field, "must be a non-empty array of dimensions")
+		return nil
+	}
+	shape := make([]int64, len(values))
+	for i, value := range values {
+		number, ok := value.(json.Number)
+		var dimension int64
+		var err error
+		if ok {
+			dimension, err = number.Int64()
+		}
+		if !ok || err != nil || dimension == 0 || dimension < -1 {
+			v.violate(fmt.Sprintf("%s[%d]", field, i), "must be a positive integer, or -1 for dimensions of any size")
+			continue
+		}
+		shape[i] = dimension
+	}
+	return shape
+}
+
+// transform - checks the quantize or dequantize transformation of an array layer, which is either
+// {"standard": range} or {"scale": number, "bias": number}.
+func (v *validator) transform(layerDoc map[string]interface{}, key, field string, allowed, quantized bool) {
+	transform, present := v.object(layerDoc, key, field+"."+key, false)
+	if !present {
+		return
+	}
+	field = field + "." + key
+	switch {
+	case !allowed:
+		v.violate(field, "is only allowed on %s", map[string]string{"quantize": "inputs", "dequantize": "outputs"}[key])
+	case !quantized:
+		v.violate(field, "is only allowed for quantized models")
+	}
+	v.scaling(transform, field, func(bias interface{}) bool {
+		_, ok := bias.(json.Number)
+		return ok
+	}, "a number")
+}
+
+// normalization - checks the normalize or denormalize transformation of an image layer, which is
+// either {"standard": range} or {"scale": number, "bias": {"r": number, "g": number, "b": number}}.
+func (v *validator) normalization(layerDoc map[string]interface{}, key, field string, allowed bool) {
+	normalization, present := v.object(layerDoc, key, field+"."+key, false)
+	if !present {
+		return
+	}
+	field = field + "." + key
+	if !allowed {
+		v.violate(field, "is only allowed on %s", map[string]string{"normalize": "inputs", "denormalize": "outputs"}[key])
+	}
+	v.scaling(normalization, field, func(bias interface{}) bool {
+		channels, ok := bias.(map[string]interface{})
+		if !ok || len(channels) != 3 {
+			return false
+		}
+		for _, channel := range []string{"r", "g", "b"} {
+			if _, ok := channels[channel].(json.Number); !ok {
+				return false
+			}
+		}
+		return true
+	}, `an object with numbers for "r", "g" and "b"`)
+}
+
+func (v *validator) scaling(transform map[string]interface{}, field string, validBias func(interface{}) bool, biasDescription string) {
+	if _, present := transform["standard"]; present {
+		v.str(transform, "standard", field+".standard", true, standardRanges)
+		return
+	}
+	if _, ok := transform["scale"].(json.Number); !ok {
+		v.violate(field+".scale", `must be a number (or use "standard": %s)`, strings.Join(standardRanges, " or "))
+	}
+	if !validBias(transform["bias"]) {
+		v.violate(field+".bias", "must be %s", biasDescription)
+	}
+}
+
+func contains(choices []string, s string) bool {
+	for _, choice := range choices {
+		if choice == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,161 @@
+package tiobundle
+
+import (
+	"archive/zip"
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const mobileNetModelJSON = `{
+	"name": "MobileNet V2 1.0 224",
+	"id": "mobilenet-v2-100-224-unquantized",
+	"version": "1",
+	"model": {
+		"file": "model.tflite",
+		"quantized": false,
+		"modes": ["predict"]
+	},
+	"inputs": [
+		{
+			"name": "image",
+			"type": "image",
+			"shape": [224, 224, 3],
+			"format": "RGB",
+			"normalize": {"standard": "[-1,1]"}
+		}
+	],
+	"outputs": [
+		{
+			"name": "classification",
+			"type": "array",
+			"shape": [1, 1000],
+			"labels": "labels.txt"
+		}
+	]
+}`
+
+func TestParse(t *testing.T) {
+	bundle, err := Parse([]byte(mobileNetModelJSON))
+	assert.NoError(t, err)
+	assert.Equal(t, Bundle{
+		Backend:   "tflite",
+		Quantized: false,
+		Inputs:    []Layer{{Name: "image", Type: "image", Shape: []int64{224, 224, 3}, Format: "RGB"}},
+		Outputs:   []Layer{{Name: "classification", Type: "array", Shape: []int64{1, 1000}}},
+	}, bundle)
+
+	bundle, err = Parse([]byte(`{
+		"model": {"file": "model.tflite", "quantized": true, "backend": "tensorflow"},
+		"inputs": [{"name": "x", "type": "array", "shape": [-1, 4], "dtype": "uint8", "quantize": {"scale": 0.5, "bias": 0}}],
+		"outputs": [{"name": "y", "type": "array", "shape": [1], "dequantize": {"standard": "[0,1]"}}]
+	}`))
+	assert.NoError(t, err)
+	assert.Equal(t, Bundle{
+		Backend:   "tensorflow",
+		Quantized: true,
+		Inputs:    []Layer{{Name: "x", Type: "array", Shape: []int64{-1, 4}, Dtype: "uint8"}},
+		Outputs:   []Layer{{Name: "y", Type: "array", Shape: []int64{1}}},
+	}, bundle)
+}
+
+func TestParseViolations(t *testing.T) {
+	type TestCase struct {
+		modelJSON string
+		fields    []string
+	}
+	output := `"outputs": [{"name": "y", "type": "array", "shape": [1]}]`
+	testCases := []TestCase{
+		{`[]`, []string{"model.json"}},
+		{`{}`, []string{"model", "inputs", "outputs"}},
+		{`{"model": {}, "inputs": [], "outputs": {}}`, []string{"model.file", "model.quantized", "inputs", "outputs"}},
+		{
+			`{"model": {"file": "m", "quantized": "no", "backend": "caffe", "modes": ["predict", "infer"]},
+			"inputs": [{"name": "x", "type": "array", "shape": [1]}], ` + output + `}`,
+			[]string{"model.quantized", "model.backend", "model.modes[1]"},
+		},
+		{
+			`{"model": {"file": "m", "quantized": false},
+			"inputs": [{"name": "x", "type": "tensor", "shape": [0, -2, 1.5]}, {"name": "x", "type": "array"}], ` + output + `}`,
+			[]string{"inputs[0].type", "inputs[0].shape[0]", "inputs[0].shape[1]", "inputs[0].shape[2]", "inputs[1].name", "inputs[1].shape"},
+		},
+		{
+			`{"model": {"file": "m", "quantized": false},
+			"inputs": [{"name": "x", "type": "array", "shape": [1], "dtype": "float16", "quantize": {"scale": 1, "bias": 0}, "dequantize": {"standard": "[0,255]"}}], ` + output + `}`,
+			[]string{"inputs[0].dtype", "inputs[0].quantize", "inputs[0].dequantize", "inputs[0].dequantize.standard"},
+		},
+		{
+			`{"model": {"file": "m", "quantized": false},
+			"inputs": [{"name": "x", "type": "image", "shape": [224, 224], "normalize": {"scale": "1", "bias": {"r": 0, "g": 0}}}],
+			"outputs": [{"name": "y", "type": "image", "shape": [1, 8, 8, 3], "format": "RGB", "normalize": {"standard": "[0,1]"}}]}`,
+			[]string{"inputs[0].format", "inputs[0].shape", "inputs[0].normalize.scale", "inputs[0].normalize.bias", "outputs[0].normalize"},
+		},
+	}
+
+	for _, testCase := range testCases {
+		_, err := Parse([]byte(testCase.modelJSON))
+		validationErr, ok := err.(*ValidationError)
+		if !assert.True(t, ok, testCase.modelJSON) {
+			continue
+		}
+		fields := make([]string, len(validationErr.Violations))
+		for i, violation := range validationErr.Violations {
+			fields[i] = violation.Field
+		}
+		assert.Equal(t, testCase.fields, fields, testCase.modelJSON)
+		assert.True(t, strings.HasPrefix(err.Error(), "Invalid TensorIO bundle: "))
+	}
+}
+
+func zipArchive(t *testing.T, files map[string]string) *bytes.Buffer {
+	var buf bytes.Buffer
+	writer := zip.NewWriter(&buf)
+	for name, contents := range files {
+		file, err := writer.Create(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		_, err = file.Write([]byte(contents))
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	err := writer.Close()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &buf
+}
+
+func TestReadZippedModelJSON(t *testing.T) {
+	for _, name := range []string{"model.json", "mobilenet.tiobundle/model.json"} {
+		archive := zipArchive(t, map[string]string{
+			name:           mobileNetModelJSON,
+			"model.tflite": "weights",
+		})
+		modelJSON, err := ReadZippedModelJSON(archive)
+		assert.NoError(t, err, name)
+		assert.Equal(t, mobileNetModelJSON, string(modelJSON), name)
+	}
+
+	for _, name := range []string{"assets/model.json", "outer/mobilenet.tiobundle/model.json", "model.json.bak"} {
+		_, err := ReadZippedModelJSON(zipArchive(t, map[string]string{name: mobileNetModelJSON}))
+		assert.Equal(t, ErrNoModelJSON, err, name)
+	}
+
+	_, err := ReadZippedModelJSON(zipArchive(t, map[string]string{
+		"model.json":                 mobileNetModelJSON,
+		"other.tiobundle/model.json": mobileNetModelJSON,
+	}))
+	assert.Error(t, err)
+
+	_, err = ReadZippedModelJSON(zipArchive(t, map[string]string{
+		"model.json": strings.Repeat(" ", MaxModelJSONSize+1),
+	}))
+	assert.Equal(t, ErrModelJSONTooLarge, err)
+
+	_, err = ReadZippedModelJSON(strings.NewReader("not a zip archive"))
+	assert.Error(t, err)
+}
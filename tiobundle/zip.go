@@ -0,0 +1,76 @@
+package tiobundle
+
+import (
+	"archive/zip"
+	"errors"
+	"io"
+	"io/ioutil"
+	"os"
+	"path"
+	"strings"
+)
+
+// ErrNoModelJSON - returned for zipped bundles without a model.json.
+var ErrNoModelJSON = errors.New("Bundle does not contain a model.json")
+
+// ErrModelJSONTooLarge - returned for model.json files larger than MaxModelJSONSize.
+var ErrModelJSONTooLarge = errors.New("Bundle model.json is too large")
+
+// MaxModelJSONSize - the size of the largest model.json which is read.
+const MaxModelJSONSize = 1 << 20
+
+// ReadModelJSON - reads a model.json file, refusing to read more than MaxModelJSONSize bytes.
+func ReadModelJSON(r io.Reader) ([]byte, error) {
+	modelJSON, err := ioutil.ReadAll(io.LimitReader(r, MaxModelJSONSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(modelJSON) > MaxModelJSONSize {
+		return nil, ErrModelJSONTooLarge
+	}
+	return modelJSON, nil
+}
+
+// ReadZippedModelJSON - reads the model.json of a zipped bundle. The archive is spooled to a
+// temporary file since zip archives cannot be read as a stream. The model.json has to be either at
+// the root of the archive or directly inside a single *.tiobundle directory.
+func ReadZippedModelJSON(r io.Reader) ([]byte, error) {
+	file, err := ioutil.TempFile("", "tiobundle-")
+	if err != nil {
+		return nil, err
+	}
+	defer os.Remove(file.Name())
+	defer file.Close()
+
+	size, err := io.Copy(file, r)
+	if err != nil {
+		return nil, err
+	}
+	archive, err := zip.NewReader(file, size)
+	if err != nil {
+		return nil, err
+	}
+
+	var modelJSON *zip.File
+	for _, entry := range archive.File {
+		dir, name := path.Split(entry.Name)
+		dir = strings.TrimSuffix(dir, "/")
+		if name != "model.json" || (dir != "" && (strings.Contains(dir, "/") || !strings.HasSuffix(dir, ".tiobundle"))) {
+			continue
+		}
+		if modelJSON != nil {
+			return nil, errors.New("Bundle contains more than one model.json")
+		}
+		modelJSON = entry
+	}
+	if modelJSON == nil {
+		return nil, ErrNoModelJSON
+	}
+
+	reader, err := modelJSON.Open()
+	if err != nil {
+		return nil, err
+	}
+	defer reader.Close()
+	return ReadModelJSON(reader)
+}
@@ -0,0 +1,389 @@
+// Package webhook delivers the changes recorded in the audit log to the webhooks registered with
+// the repository and FLEA APIs, and serves the webhook RPCs of both APIs.
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/doc-ai/tensorio-models/api"
+	"github.com/doc-ai/tensorio-models/audit"
+	"github.com/doc-ai/tensorio-models/common"
+	"github.com/doc-ai/tensorio-models/storage"
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/google/uuid"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Headers of each delivery, besides its JSON content type.
+const (
+	// EventTypeHeader - the name of the method which made the change, e.g. CreateCheckpoint.
+	EventTypeHeader = "X-Tensorio-Event"
+	// DeliveryIdHeader - the same for every attempt to deliver a change to a webhook.
+	DeliveryIdHeader = "X-Tensorio-Delivery"
+	// SignatureHeader - see Sign.
+	SignatureHeader = "X-Tensorio-Signature"
+)
+
+// maxConcurrentDeliveries - how many deliveries a Dispatcher attempts at the same time. Deliveries
+// which are waiting to be retried do not count.
+const maxConcurrentDeliveries = 16
+
+// RetryPolicy - how often, and how soon, a failed delivery is attempted again before it is added
+// to the dead letters. The delay doubles after each attempt, up to MaxBackoff.
+type RetryPolicy struct {
+	MaxAttempts    int
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+}
+
+// DefaultRetryPolicy - gives up on a delivery after about 15 seconds.
+var DefaultRetryPolicy = RetryPolicy{MaxAttempts: 5, InitialBackoff: time.Second, MaxBackoff: time.Minute}
+
+// Dispatcher - delivers changes to the webhooks registered with a backend, in the background.
+// Deliveries which are still in progress when the server stops are lost.
+type Dispatcher struct {
+	store  storage.WebhookStorage
+	client *http.Client
+	retry  RetryPolicy
+	// slots - holds a token for each delivery attempt in progress
+	slots   chan struct{}
+	pending *sync.WaitGroup
+}
+
+// NewDispatcher - creates a Dispatcher which POSTs changes to the webhooks registered with store
+// using client. Every delivery is attempted at least once.
+func NewDispatcher(store storage.WebhookStorage, client *http.Client, retry RetryPolicy) *Dispatcher {
+	if retry.MaxAttempts < 1 {
+		retry.MaxAttempts = 1
+	}
+	return &Dispatcher{
+		store:   store,
+		client:  client,
+		retry:   retry,
+		slots:   make(chan struct{}, maxConcurrentDeliveries),
+		pending: &sync.WaitGroup{},
+	}
+}
+
+// EventType - the event type of the change recorded by an audit event: the name of the method
+// which made it.
+func EventType(event storage.AuditEvent) string {
+	return event.Method[strings.LastIndex(event.Method, "/")+1:]
+}
+
+// Dispatch - delivers the change recorded by event to every webhook which subscribes to its event
+// type. It returns immediately; failures are logged and added to the dead letters.
+func (d *Dispatcher) Dispatch(event storage.AuditEvent) {
+	d.pending.Add(1)
+	go func() {
+		defer d.pending.Done()
+		d.dispatch(event)
+	}()
+}
+
+// Wait - waits until every change dispatched so far has been delivered or added to the dead
+// letters.
+func (d *Dispatcher) Wait() {
+	d.pending.Wait()
+}
+
+func (d *Dispatcher) dispatch(event storage.AuditEvent) {
+	ctx := context.Background()
+	eventType := EventType(event)
+	webhooks, err := d.store.ListWebhooks(ctx)
+	if err != nil {
+		log.Printf("ERROR: Could not list webhooks to deliver %s: %v", event.EventId, err)
+		return
+	}
+
+	var payload []byte
+	for _, webhook := range webhooks {
+		if !contains(webhook.EventTypes, eventType) {
+			continue
+		}
+		if payload == nil {
+			payload, err = encodePayload(event)
+			if err != nil {
+				log.Printf("ERROR: Could not encode %s for webhooks: %v", event.EventId, err)
+				return
+			}
+		}
+		d.pending.Add(1)
+		go func(webhook storage.Webhook) {
+			defer d.pending.Done()
+			d.deliver(ctx, webhook, event.EventId+"-"+webhook.WebhookId, eventType, payload)
+		}(webhook)
+	}
+}
+
+// deliver - POSTs payload to webhook until it succeeds or runs out of attempts, in which case it
+// is added to the dead letters.
+func (d *Dispatcher) deliver(ctx context.Context, webhook storage.Webhook, deliveryId, eventType string, payload []byte) {
+	backoff := d.retry.InitialBackoff
+	var err error
+	attempts := 0
+	for attempts < d.retry.MaxAttempts {
+		if attempts > 0 {
+			time.Sleep(backoff)
+			backoff *= 2
+			if backoff > d.retry.MaxBackoff {
+				backoff = d.retry.MaxBackoff
+			}
+		}
+		attempts++
+		d.slots <- struct{}{}
+		err = d.post(ctx, webhook, deliveryId, eventType, payload)
+		<-d.slots
+		if err == nil {
+			return
+		}
+		log.Printf("Delivery %s to webhook %s failed (attempt %d): %v", deliveryId, webhook.WebhookId, attempts, err)
+	}
+
+	deadLetter := storage.WebhookDeadLetter{
+		DeliveryId: deliveryId,
+		WebhookId:  webhook.WebhookId,
+		Url:        webhook.Url,
+		EventType:  eventType,
+		Payload:    string(payload),
+		Attempts:   attempts,
+		LastError:  err.Error(),
+		FailedAt:   time.Now(),
+	}
+	err = d.store.AddWebhookDeadLetter(ctx, deadLetter)
+	if err != nil {
+		log.Printf("ERROR: Could not add dead letter for delivery %s: %v", deliveryId, err)
+	}
+}
+
+func (d *Dispatcher) post(ctx context.Context, webhook storage.Webhook, deliveryId, eventType string, payload []byte) error {
+	req, err := http.NewRequest(http.MethodPost, webhook.Url, bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+	req = req.WithContext(ctx)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(EventTypeHeader, eventType)
+	req.Header.Set(DeliveryIdHeader, deliveryId)
+	req.Header.Set(SignatureHeader, Sign(webhook.Secret, payload))
+
+	resp, err := d.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	// Reads the body so that the connection can be reused
+	io.Copy(ioutil.Discard, resp.Body)
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return errors.New(resp.Status)
+	}
+	return nil
+}
+
+// Sign - returns the signature of a delivery's payload: "sha256=" followed by the hex encoded
+// HMAC-SHA256 of the payload keyed with the webhook's secret.
+func Sign(secret string, payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(payload)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// encodePayload - the body of a delivery is the audit event, as returned by ListAuditEvents.
+func encodePayload(event storage.AuditEvent) ([]byte, error) {
+	apiEvent, err := audit.EventToAPI(event)
+	if err != nil {
+		return nil, err
+	}
+	marshaler := jsonpb.Marshaler{}
+	encoded, err := marshaler.MarshalToString(apiEvent)
+	return []byte(encoded), err
+}
+
+// ResourcePath - the resource path of a webhook in the audit log.
+func ResourcePath(webhookId string) string {
+	return "/webhooks/" + webhookId
+}
+
+// CreateWebhook - handles CreateWebhook requests. eventTypes are the event types which the API
+// delivers.
+func CreateWebhook(ctx context.Context, store storage.WebhookStorage, eventTypes []string, req *api.CreateWebhookRequest) (*api.CreateWebhookResponse, error) {
+	log.Printf("CreateWebhook request - Url: %s, EventTypes: %v", req.Url, req.EventTypes)
+	if req.Url == "" {
+		return nil, api.MissingRequiredFieldError("url", "URL to deliver changes to").Err()
+	}
+	webhookURL, err := url.Parse(req.Url)
+	if err != nil || (webhookURL.Scheme != "http" && webhookURL.Scheme != "https") || webhookURL.Host == "" {
+		return nil, api.InvalidFieldValueError("url", "Webhook URLs must be absolute http or https URLs").Err()
+	}
+	if len(req.EventTypes) == 0 {
+		return nil, api.MissingRequiredFieldError("eventTypes", "event types to deliver").Err()
+	}
+	for _, eventType := range req.EventTypes {
+		if !contains(eventTypes, eventType) {
+			message := fmt.Sprintf("Unknown event type (%s), must be one of: %s", eventType, strings.Join(eventTypes, ", "))
+			return nil, api.InvalidFieldValueError("eventTypes", message).Err()
+		}
+	}
+	if req.Secret == "" {
+		return nil, api.MissingRequiredFieldError("secret", "secret to sign deliveries with").Err()
+	}
+
+	webhook := storage.Webhook{
+		WebhookId:  uuid.New().String(),
+		Url:        req.Url,
+		EventTypes: req.EventTypes,
+		Secret:     req.Secret,
+		CreatedAt:  time.Now(),
+	}
+	err = store.AddWebhook(ctx, webhook)
+	if err != nil {
+		log.Printf("ERROR: %v", err)
+		return nil, status.Error(codes.Unavailable, "Could not store webhook")
+	}
+
+	apiWebhook, err := toAPI(webhook)
+	if err != nil {
+		log.Printf("ERROR: %v", err)
+		return nil, status.Error(codes.Internal, "Could not convert webhook creation time")
+	}
+	return &api.CreateWebhookResponse{Webhook: apiWebhook}, nil
+}
+
+// Redact - returns a copy of req without its secret, for the audit log.
+func Redact(req *api.CreateWebhookRequest) *api.CreateWebhookRequest {
+	return &api.CreateWebhookRequest{Url: req.Url, EventTypes: req.EventTypes}
+}
+
+// ListWebhooks - handles ListWebhooks requests.
+func ListWebhooks(ctx context.Context, store storage.WebhookStorage, req *api.ListWebhooksRequest) (*api.ListWebhooksResponse, error) {
+	webhooks, err := store.ListWebhooks(ctx)
+	if err != nil {
+		log.Printf("ERROR: %v", err)
+		return nil, status.Error(codes.Unavailable, "Could not retrieve webhooks from storage")
+	}
+
+	res := &api.ListWebhooksResponse{Webhooks: make([]*api.Webhook, len(webhooks))}
+	for i, webhook := range webhooks {
+		res.Webhooks[i], err = toAPI(webhook)
+		if err != nil {
+			log.Printf("ERROR: %v", err)
+			return nil, status.Error(codes.Internal, "Could not convert webhook creation time")
+		}
+	}
+	return res, nil
+}
+
+// DeleteWebhook - handles DeleteWebhook requests. The response holds the deleted webhook.
+func DeleteWebhook(ctx context.Context, store storage.WebhookStorage, req *api.DeleteWebhookRequest) (*api.DeleteWebhookResponse, error) {
+	log.Printf("DeleteWebhook request - WebhookId: %s", req.WebhookId)
+	if req.WebhookId == "" {
+		return nil, api.MissingRequiredFieldError("webhookId", "webhook id to delete").Err()
+	}
+	notFound := status.Error(codes.NotFound, fmt.Sprintf("Webhook (%s) does not exist", req.WebhookId))
+	webhooks, err := ListWebhooks(ctx, store, &api.ListWebhooksRequest{})
+	if err != nil {
+		return nil, err
+	}
+	res := &api.DeleteWebhookResponse{}
+	for _, webhook := range webhooks.Webhooks {
+		if webhook.WebhookId == req.WebhookId {
+			res.Webhook = webhook
+		}
+	}
+	if res.Webhook == nil {
+		return nil, notFound
+	}
+
+	err = store.DeleteWebhook(ctx, req.WebhookId)
+	if err == storage.WebhookDoesNotExistError {
+		return nil, notFound
+	}
+	if err != nil {
+		log.Printf("ERROR: %v", err)
+		return nil, status.Error(codes.Unavailable, "Could not delete webhook")
+	}
+	return res, nil
+}
+
+// ListDeadLetters - handles ListWebhookDeadLetters requests.
+func ListDeadLetters(ctx context.Context, store storage.WebhookStorage, req *api.ListWebhookDeadLettersRequest) (*api.ListWebhookDeadLettersResponse, error) {
+	marker, err := common.DecodePageToken(req.PageToken)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	maxItems := int(req.MaxItems)
+	if maxItems <= 0 {
+		maxItems = 10
+	}
+	log.Printf("ListWebhookDeadLetters request - Marker: %s, MaxItems: %d", marker, maxItems)
+
+	// Lists one more dead letter than requested to find out whether there is another page
+	deadLetters, err := store.ListWebhookDeadLetters(ctx, marker, maxItems+1)
+	if err != nil {
+		log.Printf("ERROR: %v", err)
+		return nil, status.Error(codes.Unavailable, "Could not retrieve dead letters from storage")
+	}
+	res := &api.ListWebhookDeadLettersResponse{}
+	if len(deadLetters) > maxItems {
+		deadLetters = deadLetters[:maxItems]
+		res.NextPageToken = common.EncodePageToken(deadLetters[maxItems-1].DeliveryId)
+	}
+	res.DeadLetters = make([]*api.WebhookDeadLetter, len(deadLetters))
+	for i, deadLetter := range deadLetters {
+		failedAt, err := ptypes.TimestampProto(deadLetter.FailedAt)
+		if err != nil {
+			log.Printf("ERROR: %v", err)
+			return nil, status.Error(codes.Internal, "Could not convert dead letter time")
+		}
+		res.DeadLetters[i] = &api.WebhookDeadLetter{
+			DeliveryId: deadLetter.DeliveryId,
+			WebhookId:  deadLetter.WebhookId,
+			Url:        deadLetter.Url,
+			EventType:  deadLetter.EventType,
+			Payload:    deadLetter.Payload,
+			Attempts:   int32(deadLetter.Attempts),
+			LastError:  deadLetter.LastError,
+			FailedAt:   failedAt,
+		}
+	}
+	return res, nil
+}
+
+// toAPI - converts a webhook into its API representation, which leaves out its secret.
+func toAPI(webhook storage.Webhook) (*api.Webhook, error) {
+	createdAt, err := ptypes.TimestampProto(webhook.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+	return &api.Webhook{
+		WebhookId:  webhook.WebhookId,
+		Url:        webhook.Url,
+		EventTypes: webhook.EventTypes,
+		CreatedAt:  createdAt,
+	}, nil
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
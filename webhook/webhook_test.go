@@ -0,0 +1,198 @@
+package webhook_test
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/doc-ai/tensorio-models/api"
+	"github.com/doc-ai/tensorio-models/storage"
+	"github.com/doc-ai/tensorio-models/storage/memory"
+	"github.com/doc-ai/tensorio-models/webhook"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var testRetryPolicy = webhook.RetryPolicy{MaxAttempts: 3, InitialBackoff: time.Millisecond, MaxBackoff: 2 * time.Millisecond}
+
+// receiver - a local stand-in for a webhook endpoint, which fails the first failures requests.
+type receiver struct {
+	lock       sync.Mutex
+	failures   int
+	deliveries []*http.Request
+	bodies     []string
+}
+
+func (r *receiver) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	body, _ := ioutil.ReadAll(req.Body)
+	r.deliveries = append(r.deliveries, req)
+	r.bodies = append(r.bodies, string(body))
+	if r.failures > 0 {
+		r.failures--
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}
+}
+
+func testEvent(method string) storage.AuditEvent {
+	now := time.Now()
+	return storage.AuditEvent{
+		EventId:      storage.NewAuditEventId(now),
+		Time:         now,
+		Actor:        "ModelsWriter",
+		Method:       method,
+		ResourcePath: "/models/m/hyperparameters/h/checkpoints/c",
+		Request:      `{"modelId":"m"}`,
+		After:        `{"CheckpointId":"c"}`,
+	}
+}
+
+func createWebhook(t *testing.T, store storage.WebhookStorage, url string, eventTypes ...string) *api.Webhook {
+	resp, err := webhook.CreateWebhook(context.Background(), store, []string{"CreateCheckpoint", "JobError"}, &api.CreateWebhookRequest{
+		Url:        url,
+		EventTypes: eventTypes,
+		Secret:     "secret",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return resp.Webhook
+}
+
+func TestDeliveries(t *testing.T) {
+	store := memory.NewMemoryRepositoryStorage()
+	r := &receiver{failures: 1}
+	server := httptest.NewServer(r)
+	defer server.Close()
+	created := createWebhook(t, store, server.URL+"/hook", "CreateCheckpoint")
+	dispatcher := webhook.NewDispatcher(store, server.Client(), testRetryPolicy)
+
+	event := testEvent("/api.Repository/CreateCheckpoint")
+	dispatcher.Dispatch(event)
+	// Events which the webhook does not subscribe to are not delivered
+	dispatcher.Dispatch(testEvent("/api.Repository/UpdateModel"))
+	dispatcher.Wait()
+
+	// The first attempt fails and is retried with the same delivery ID
+	if assert.Len(t, r.deliveries, 2) {
+		for i, delivery := range r.deliveries {
+			assert.Equal(t, "/hook", delivery.URL.Path)
+			assert.Equal(t, "application/json", delivery.Header.Get("Content-Type"))
+			assert.Equal(t, "CreateCheckpoint", delivery.Header.Get(webhook.EventTypeHeader))
+			assert.Equal(t, event.EventId+"-"+created.WebhookId, delivery.Header.Get(webhook.DeliveryIdHeader))
+			assert.Equal(t, webhook.Sign("secret", []byte(r.bodies[i])), delivery.Header.Get(webhook.SignatureHeader))
+		}
+		payload := map[string]string{}
+		assert.NoError(t, json.Unmarshal([]byte(r.bodies[1]), &payload))
+		assert.Equal(t, event.EventId, payload["eventId"])
+		assert.Equal(t, "/api.Repository/CreateCheckpoint", payload["method"])
+		assert.Equal(t, event.After, payload["after"])
+	}
+
+	deadLetters, err := webhook.ListDeadLetters(context.Background(), store, &api.ListWebhookDeadLettersRequest{})
+	assert.NoError(t, err)
+	assert.Empty(t, deadLetters.DeadLetters)
+}
+
+func TestDeadLetters(t *testing.T) {
+	store := memory.NewMemoryFleaStorage("http://repository")
+	r := &receiver{failures: 100}
+	server := httptest.NewServer(r)
+	defer server.Close()
+	created := createWebhook(t, store, server.URL, "JobError")
+	dispatcher := webhook.NewDispatcher(store, server.Client(), testRetryPolicy)
+
+	events := []storage.AuditEvent{testEvent("/api.Flea/JobError"), testEvent("/api.Flea/JobError")}
+	for _, event := range events {
+		dispatcher.Dispatch(event)
+	}
+	dispatcher.Wait()
+	assert.Len(t, r.deliveries, 2*testRetryPolicy.MaxAttempts)
+
+	ctx := context.Background()
+	deadLetters, err := webhook.ListDeadLetters(ctx, store, &api.ListWebhookDeadLettersRequest{MaxItems: 1})
+	assert.NoError(t, err)
+	if assert.Len(t, deadLetters.DeadLetters, 1) {
+		deadLetter := deadLetters.DeadLetters[0]
+		assert.Equal(t, events[0].EventId+"-"+created.WebhookId, deadLetter.DeliveryId)
+		assert.Equal(t, created.WebhookId, deadLetter.WebhookId)
+		assert.Equal(t, server.URL, deadLetter.Url)
+		assert.Equal(t, "JobError", deadLetter.EventType)
+		assert.Contains(t, r.bodies, deadLetter.Payload)
+		assert.Contains(t, deadLetter.Payload, events[0].EventId)
+		assert.Equal(t, int32(testRetryPolicy.MaxAttempts), deadLetter.Attempts)
+		assert.Equal(t, "503 Service Unavailable", deadLetter.LastError)
+	}
+	assert.NotEmpty(t, deadLetters.NextPageToken)
+	deadLetters, err = webhook.ListDeadLetters(ctx, store, &api.ListWebhookDeadLettersRequest{PageToken: deadLetters.NextPageToken})
+	assert.NoError(t, err)
+	if assert.Len(t, deadLetters.DeadLetters, 1) {
+		assert.Equal(t, events[1].EventId+"-"+created.WebhookId, deadLetters.DeadLetters[0].DeliveryId)
+	}
+	assert.Equal(t, "", deadLetters.NextPageToken)
+
+	// Unreachable webhooks are dead-lettered too
+	server.Close()
+	dispatcher.Dispatch(testEvent("/api.Flea/JobError"))
+	dispatcher.Wait()
+	deadLetters, err = webhook.ListDeadLetters(ctx, store, &api.ListWebhookDeadLettersRequest{})
+	assert.NoError(t, err)
+	assert.Len(t, deadLetters.DeadLetters, 3)
+
+	_, err = webhook.ListDeadLetters(ctx, store, &api.ListWebhookDeadLettersRequest{PageToken: "not a token"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestRegistration(t *testing.T) {
+	store := memory.NewMemoryRepositoryStorage()
+	ctx := context.Background()
+	eventTypes := []string{"CreateCheckpoint", "UpdateHyperparameters"}
+
+	invalid := []*api.CreateWebhookRequest{
+		{EventTypes: eventTypes, Secret: "secret"},
+		{Url: "ftp://example.com/hook", EventTypes: eventTypes, Secret: "secret"},
+		{Url: "/hook", EventTypes: eventTypes, Secret: "secret"},
+		{Url: "https://example.com/hook", Secret: "secret"},
+		{Url: "https://example.com/hook", EventTypes: []string{"CreateTask"}, Secret: "secret"},
+		{Url: "https://example.com/hook", EventTypes: eventTypes},
+	}
+	for _, req := range invalid {
+		_, err := webhook.CreateWebhook(ctx, store, eventTypes, req)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err), req.String())
+	}
+
+	created, err := webhook.CreateWebhook(ctx, store, eventTypes, &api.CreateWebhookRequest{
+		Url:        "https://example.com/hook",
+		EventTypes: eventTypes,
+		Secret:     "secret",
+	})
+	assert.NoError(t, err)
+	assert.NotEmpty(t, created.Webhook.WebhookId)
+	assert.Equal(t, "https://example.com/hook", created.Webhook.Url)
+	assert.Equal(t, eventTypes, created.Webhook.EventTypes)
+	assert.NotNil(t, created.Webhook.CreatedAt)
+	assert.Equal(t, "", webhook.Redact(&api.CreateWebhookRequest{Url: "u", Secret: "secret"}).Secret)
+
+	listed, err := webhook.ListWebhooks(ctx, store, &api.ListWebhooksRequest{})
+	assert.NoError(t, err)
+	assert.Equal(t, []*api.Webhook{created.Webhook}, listed.Webhooks)
+	// Secrets are kept for signing, but never returned
+	stored, err := store.ListWebhooks(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, "secret", stored[0].Secret)
+
+	deleted, err := webhook.DeleteWebhook(ctx, store, &api.DeleteWebhookRequest{WebhookId: created.Webhook.WebhookId})
+	assert.NoError(t, err)
+	assert.Equal(t, created.Webhook, deleted.Webhook)
+	_, err = webhook.DeleteWebhook(ctx, store, &api.DeleteWebhookRequest{WebhookId: created.Webhook.WebhookId})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	_, err = webhook.DeleteWebhook(ctx, store, &api.DeleteWebhookRequest{})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}